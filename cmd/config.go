@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dagu-org/dagu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func configCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect the configuration",
+		Long:  `dagu config show [--resolved] | dagu config validate`,
+	}
+	cmd.AddCommand(configShowCmd())
+	cmd.AddCommand(configValidateCmd())
+	return cmd
+}
+
+func configShowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Print the configuration with the sources of the values",
+		Long: `dagu config show [--resolved] [--profile=<profile>]
+
+Prints the configuration files merged and the values set by the files, the
+environment variables and the flags. With --resolved, the defaults are
+printed as well, i.e. the effective value of every key.
+
+The layers in the order of precedence (the later wins):
+  defaults < config file < profile file < environment variables < flags`,
+		RunE: wrapRunE(runConfigShow),
+	}
+	cmd.Flags().Bool("resolved", false, "print the effective value of every key including the defaults")
+	return cmd
+}
+
+func runConfigShow(cmd *cobra.Command, _ []string) error {
+	resolved, _ := cmd.Flags().GetBool("resolved")
+
+	loader := config.NewConfigLoader()
+	cfg, err := loader.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	settings, err := loader.Settings()
+	if err != nil {
+		return fmt.Errorf("failed to resolve configuration: %w", err)
+	}
+	printConfig(cmd.OutOrStdout(), cfg.Profile, loader.Files(), settings, resolved)
+	return nil
+}
+
+func configValidateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "Check the configuration",
+		Long: `dagu config validate [--profile=<profile>]
+
+Checks the configuration as the other commands do at startup and prints all
+the problems found: the unknown keys, the invalid durations, the paths that
+can't be used, and the conflicting auth settings.`,
+		RunE: wrapRunE(runConfigValidate),
+	}
+}
+
+func runConfigValidate(cmd *cobra.Command, _ []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	if cfg.Profile != "" {
+		fmt.Fprintf(cmd.OutOrStdout(), "The configuration is valid (profile: %s).\n", cfg.Profile)
+	} else {
+		fmt.Fprintln(cmd.OutOrStdout(), "The configuration is valid.")
+	}
+	return nil
+}
+
+// printConfig prints the files and the settings in a table of the keys,
+// the values and the sources.
+func printConfig(w io.Writer, profile string, files []string, settings []config.Setting, resolved bool) {
+	if profile != "" {
+		fmt.Fprintf(w, "Profile: %s\n", profile)
+	}
+	fmt.Fprintln(w, "Files:")
+	if len(files) == 0 {
+		fmt.Fprintln(w, "  (none)")
+	}
+	for _, file := range files {
+		fmt.Fprintf(w, "  %s\n", file)
+	}
+	fmt.Fprintln(w)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KEY\tVALUE\tSOURCE")
+	for _, s := range settings {
+		if s.Source == config.SourceDefault && !resolved {
+			continue
+		}
+		source := s.Source
+		if s.Origin != "" {
+			source += " (" + s.Origin + ")"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", s.Key, formatSettingValue(s), source)
+	}
+	_ = tw.Flush()
+}
+
+// secretKeys is the parts of the keys whose values are masked.
+var secretKeys = []string{"password", "token", "apikey", "dsn", "secret"}
+
+// secretSettings is the keys whose values are masked, matched as a whole.
+var secretSettings = map[string]bool{"encryption.key": true}
+
+// formatSettingValue formats the value masking the secrets.
+func formatSettingValue(s config.Setting) string {
+	if s.Value == nil {
+		return ""
+	}
+	if str, ok := s.Value.(string); ok && str != "" {
+		key := strings.ToLower(s.Key)
+		if secretSettings[key] {
+			return "********"
+		}
+		for _, secret := range secretKeys {
+			if strings.Contains(key, secret) {
+				return "********"
+			}
+		}
+	}
+	return fmt.Sprint(s.Value)
+}
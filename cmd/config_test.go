@@ -0,0 +1,35 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dagu-org/dagu/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintConfig(t *testing.T) {
+	settings := []config.Setting{
+		{Key: "auth.basic.password", Value: "secret", Source: "/etc/dagu/config.yaml"},
+		{Key: "host", Value: "0.0.0.0", Source: config.SourceEnv, Origin: "DAGU_HOST"},
+		{Key: "port", Value: 8080, Source: config.SourceDefault},
+	}
+	files := []string{"/etc/dagu/config.yaml", "/etc/dagu/config.prod.yaml"}
+
+	t.Run("Overrides", func(t *testing.T) {
+		var buf bytes.Buffer
+		printConfig(&buf, "prod", files, settings, false)
+		out := buf.String()
+		require.Contains(t, out, "Profile: prod")
+		require.Contains(t, out, "/etc/dagu/config.prod.yaml")
+		require.Regexp(t, `host\s+0\.0\.0\.0\s+env \(DAGU_HOST\)`, out)
+		require.Regexp(t, `auth\.basic\.password\s+\*+\s+/etc/dagu/config\.yaml`, out)
+		require.NotContains(t, out, "secret")
+		require.NotContains(t, out, "port")
+	})
+	t.Run("Resolved", func(t *testing.T) {
+		var buf bytes.Buffer
+		printConfig(&buf, "", files, settings, true)
+		require.Regexp(t, `port\s+8080\s+default`, buf.String())
+	})
+}
@@ -29,16 +29,22 @@ func runDry(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
-	setup := newSetup(cfg)
+	setup, err := newSetup(cfg)
+	if err != nil {
+		return err
+	}
 
 	cmd.Flags().StringP("params", "p", "", "parameters")
 
 	ctx := setup.loggerContext(cmd.Context(), false)
 
-	loadOpts := []digraph.LoadOption{
-		digraph.WithBaseConfig(setup.cfg.Paths.BaseConfig),
+	specPath, err := setup.resolveDAG(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to resolve DAG %s: %w", args[0], err)
 	}
 
+	loadOpts := setup.loadOptions(specPath)
+
 	var params string
 	if argsLenAtDash := cmd.ArgsLenAtDash(); argsLenAtDash != -1 {
 		// Get parameters from command line arguments after "--"
@@ -52,7 +58,7 @@ func runDry(cmd *cobra.Command, args []string) error {
 		loadOpts = append(loadOpts, digraph.WithParams(removeQuotes(params)))
 	}
 
-	dag, err := digraph.Load(ctx, args[0], loadOpts...)
+	dag, err := digraph.Load(ctx, specPath, loadOpts...)
 	if err != nil {
 		return fmt.Errorf("failed to load DAG from %s: %w", args[0], err)
 	}
@@ -75,6 +81,11 @@ func runDry(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to initialize DAG store: %w", err)
 	}
 
+	historyStore, err := setup.historyStore()
+	if err != nil {
+		return fmt.Errorf("failed to initialize history store: %w", err)
+	}
+
 	cli, err := setup.client()
 	if err != nil {
 		return fmt.Errorf("failed to initialize client: %w", err)
@@ -87,7 +98,7 @@ func runDry(cmd *cobra.Command, args []string) error {
 		logFile.Name(),
 		cli,
 		dagStore,
-		setup.historyStore(),
+		historyStore,
 		agent.Options{Dry: true},
 	)
 
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/config"
+	"github.com/dagu-org/dagu/internal/persistence/jsondb"
+	"github.com/spf13/cobra"
+)
+
+func historyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Maintain the history of the DAG runs",
+		Long: `dagu history verify [--repair]
+dagu history compact [--after-days=N]`,
+	}
+	cmd.AddCommand(historyVerifyCmd())
+	cmd.AddCommand(historyCompactCmd())
+	return cmd
+}
+
+func historyVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Check the status files of the runs for corruption",
+		Long: `dagu history verify [--repair]
+
+Checks the checksums and the contents of the status files in the data
+directory and prints the files with corrupt or truncated records, e.g. left
+by a crash or a full disk. With --repair, each corrupt file is moved to the
+.quarantine directory in the data directory and replaced by its valid
+records, or removed if it has none. A status file keeps only its last valid
+record and a monthly summary keeps all of them.
+
+Run it while no DAG is running, as the file of a running DAG may have its
+last record being written.`,
+		RunE: wrapRunE(runHistoryVerify),
+	}
+	cmd.Flags().Bool("repair", false, "quarantine the corrupt files and keep their last valid record")
+	return cmd
+}
+
+func runHistoryVerify(cmd *cobra.Command, _ []string) error {
+	repair, _ := cmd.Flags().GetBool("repair")
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	setup, err := newSetup(cfg)
+	if err != nil {
+		return err
+	}
+
+	if err := setup.requireFileBackend(); err != nil {
+		return err
+	}
+
+	db := jsondb.New(setup.cfg.Paths.DataDir)
+	reports, err := db.Verify(cmd.Context(), repair)
+	if err != nil {
+		return fmt.Errorf("failed to verify the history: %w", err)
+	}
+
+	w := cmd.OutOrStdout()
+	var corrupt int
+	for _, r := range reports {
+		if r.OK() {
+			continue
+		}
+		corrupt++
+		var problems []string
+		if len(r.Corrupt) > 0 {
+			lines := make([]string, len(r.Corrupt))
+			for i, n := range r.Corrupt {
+				lines[i] = fmt.Sprint(n)
+			}
+			problems = append(problems, "corrupt lines "+strings.Join(lines, ", "))
+		}
+		if r.Truncated {
+			problems = append(problems, "truncated")
+		}
+		fmt.Fprintf(w, "%s: %s (%d valid records)\n", r.File, strings.Join(problems, "; "), r.Records)
+		switch {
+		case r.Salvaged:
+			fmt.Fprintf(w, "  salvaged the valid records; the original is in %s\n", r.Quarantined)
+		case r.Quarantined != "":
+			fmt.Fprintf(w, "  no valid record; moved to %s\n", r.Quarantined)
+		}
+	}
+
+	fmt.Fprintf(w, "Checked %d status files: %d corrupt.\n", len(reports), corrupt)
+	if corrupt > 0 && !repair {
+		return fmt.Errorf("%d corrupt status files found; run with --repair to fix them", corrupt)
+	}
+	return nil
+}
+
+func historyCompactCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compact",
+		Short: "Roll up the status files of the old runs into monthly summaries",
+		Long: `dagu history compact [--after-days=N]
+
+Moves the status files of the runs older than the given number of days into
+a summary file per DAG and month, like the scheduler does every
+historyCompaction.interval. The runs in the summaries are still listed in
+the history and can be retried. The latest run of each DAG and the running
+runs are kept as they are.`,
+		RunE: wrapRunE(runHistoryCompact),
+	}
+	cmd.Flags().Int("after-days", 0, "age in days of the runs to roll up (default historyCompaction.afterDays)")
+	return cmd
+}
+
+func runHistoryCompact(cmd *cobra.Command, _ []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	setup, err := newSetup(cfg)
+	if err != nil {
+		return err
+	}
+
+	afterDays := cfg.HistoryCompaction.AfterDays
+	if cmd.Flags().Changed("after-days") {
+		afterDays, _ = cmd.Flags().GetInt("after-days")
+	}
+	if afterDays < 1 {
+		return fmt.Errorf("--after-days must be at least 1: %d", afterDays)
+	}
+
+	if err := setup.requireFileBackend(); err != nil {
+		return err
+	}
+
+	db := jsondb.New(setup.cfg.Paths.DataDir, jsondb.WithDAGsDirs(cfg.Paths.DAGDirs()...))
+	n, err := db.RollUp(cmd.Context(), time.Now().AddDate(0, 0, -afterDays))
+	if err != nil {
+		return fmt.Errorf("failed to compact the history: %w", err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Rolled up %d status files older than %d days.\n", n, afterDays)
+	return nil
+}
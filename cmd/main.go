@@ -4,6 +4,7 @@ import (
 	"os"
 
 	"github.com/dagu-org/dagu/internal/build"
+	"github.com/dagu-org/dagu/internal/config"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -13,6 +14,7 @@ var (
 	version = "0.0.0"
 
 	cfgFile string
+	profile string
 
 	rootCmd = &cobra.Command{
 		Use:   build.Slug,
@@ -38,6 +40,13 @@ func init() {
 			"config file (default is $HOME/.config/dagu/config.yaml)",
 		)
 
+	rootCmd.PersistentFlags().
+		StringVar(
+			&profile, "profile", "",
+			"configuration profile to apply over the config file (e.g. prod for config.prod.yaml)",
+		)
+	_ = config.BindFlag("profile", rootCmd.PersistentFlags().Lookup("profile"))
+
 	cobra.OnInitialize(func() {
 		if cfgFile != "" {
 			viper.SetConfigFile(cfgFile)
@@ -56,4 +65,7 @@ func registerCommands() {
 	rootCmd.AddCommand(schedulerCmd())
 	rootCmd.AddCommand(retryCmd())
 	rootCmd.AddCommand(startAllCmd())
+	rootCmd.AddCommand(configCmd())
+	rootCmd.AddCommand(historyCmd())
+	rootCmd.AddCommand(timelineCmd())
 }
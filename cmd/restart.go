@@ -14,6 +14,7 @@ import (
 	"github.com/dagu-org/dagu/internal/digraph/scheduler"
 	"github.com/dagu-org/dagu/internal/logger"
 	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/dagu-org/dagu/internal/sentry"
 	"github.com/spf13/cobra"
 )
 
@@ -39,7 +40,10 @@ func runRestart(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w", err)
 	}
-	setup := newSetup(cfg)
+	setup, err := newSetup(cfg)
+	if err != nil {
+		return err
+	}
 
 	quiet, err := cmd.Flags().GetBool("quiet")
 	if err != nil {
@@ -47,11 +51,16 @@ func runRestart(cmd *cobra.Command, args []string) error {
 	}
 
 	ctx := setup.loggerContext(cmd.Context(), quiet)
+	ctx = setup.sentryContext(ctx)
+	defer sentry.Recover(ctx)
 
-	specFilePath := args[0]
+	specFilePath, err := setup.resolveDAG(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to resolve DAG %s: %w", args[0], err)
+	}
 
 	// Load initial DAG configuration
-	dag, err := digraph.Load(ctx, specFilePath, digraph.WithBaseConfig(cfg.Paths.BaseConfig))
+	dag, err := digraph.Load(ctx, specFilePath, setup.loadOptions(specFilePath)...)
 	if err != nil {
 		logger.Error(ctx, "Failed to load DAG", "path", specFilePath, "err", err)
 		return fmt.Errorf("failed to load DAG from %s: %w", specFilePath, err)
@@ -86,9 +95,7 @@ func handleRestartProcess(ctx context.Context, setup *setup, dag *digraph.DAG, q
 		return fmt.Errorf("failed to get previous execution parameters: %w", err)
 	}
 
-	loadOpts := []digraph.LoadOption{
-		digraph.WithBaseConfig(setup.cfg.Paths.BaseConfig),
-	}
+	loadOpts := setup.loadOptions(specFilePath)
 	if status.Params != "" {
 		// backward compatibility
 		loadOpts = append(loadOpts, digraph.WithParams(status.Params))
@@ -129,6 +136,12 @@ func executeDAG(ctx context.Context, cli client.Client, setup *setup,
 		return fmt.Errorf("failed to initialize DAG store: %w", err)
 	}
 
+	historyStore, err := setup.historyStore()
+	if err != nil {
+		logger.Error(ctx, "Failed to initialize history store", "err", err)
+		return fmt.Errorf("failed to initialize history store: %w", err)
+	}
+
 	agt := agent.New(
 		requestID,
 		dag,
@@ -136,8 +149,17 @@ func executeDAG(ctx context.Context, cli client.Client, setup *setup,
 		logFile.Name(),
 		cli,
 		dagStore,
-		setup.historyStore(),
-		agent.Options{Dry: false})
+		historyStore,
+		agent.Options{
+			Notifiers:       setup.notifiers(ctx),
+			MailQueue:       setup.mailQueue(),
+			PublicURL:       setup.cfg.PublicURL,
+			StatsD:          setup.statsd(),
+			Tracing:         setup.tracing(),
+			LockDir:         setup.lockDir(),
+			StatusIndexAddr: setup.statusIndexAddr(),
+			Trigger:         model.TriggerRestart,
+		})
 
 	listenSignals(ctx, agt)
 	if err := agt.Run(ctx); err != nil {
@@ -178,7 +200,7 @@ func stopRunningDAG(ctx context.Context, cli client.Client, dag *digraph.DAG) er
 			return nil
 		}
 
-		if err := cli.Stop(ctx, dag); err != nil {
+		if _, err := cli.Stop(ctx, dag, status.RequestID); err != nil {
 			return fmt.Errorf("failed to stop DAG: %w", err)
 		}
 
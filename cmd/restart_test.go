@@ -55,7 +55,8 @@ func TestRestartCommand(t *testing.T) {
 		dag, err := digraph.Load(th.Context, dagFile.Path, digraph.WithBaseConfig(th.Config.Paths.BaseConfig))
 		require.NoError(t, err)
 
-		setup := newSetup(th.Config)
+		setup, err := newSetup(th.Config)
+		require.NoError(t, err)
 		client, err := setup.client()
 		require.NoError(t, err)
 
@@ -11,6 +11,7 @@ import (
 	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/dagu-org/dagu/internal/logger"
 	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/dagu-org/dagu/internal/sentry"
 	"github.com/spf13/cobra"
 )
 
@@ -30,6 +31,7 @@ func retryCmd() *cobra.Command {
 	cmd.Flags().StringP("req", "r", "", "request-id")
 	_ = cmd.MarkFlagRequired("req")
 	cmd.Flags().BoolP("quiet", "q", false, "suppress output")
+	cmd.Flags().StringP("step", "s", "", "retry only the step with the name in the same run")
 	return cmd
 }
 
@@ -39,7 +41,10 @@ func runRetry(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to load configuration: %w", err)
 	}
 
-	setup := newSetup(cfg)
+	setup, err := newSetup(cfg)
+	if err != nil {
+		return err
+	}
 
 	// Get quiet flag
 	quiet, err := cmd.Flags().GetBool("quiet")
@@ -52,9 +57,19 @@ func runRetry(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get request ID: %w", err)
 	}
 
+	step, err := cmd.Flags().GetString("step")
+	if err != nil {
+		return fmt.Errorf("failed to get step flag: %w", err)
+	}
+
 	ctx := setup.loggerContext(cmd.Context(), quiet)
+	ctx = setup.sentryContext(ctx)
+	defer sentry.Recover(ctx)
 
-	specFilePath := args[0]
+	specFilePath, err := setup.resolveDAG(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to resolve DAG %s: %w", args[0], err)
+	}
 
 	absolutePath, err := filepath.Abs(specFilePath)
 	if err != nil {
@@ -62,15 +77,17 @@ func runRetry(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to resolve absolute path for %s: %w", specFilePath, err)
 	}
 
-	status, err := setup.historyStore().FindByRequestID(ctx, absolutePath, requestID)
+	historyStore, err := setup.historyStore()
+	if err != nil {
+		return fmt.Errorf("failed to initialize history store: %w", err)
+	}
+	status, err := historyStore.FindByRequestID(ctx, absolutePath, requestID)
 	if err != nil {
 		logger.Error(ctx, "Failed to retrieve historical execution", "requestID", requestID, "err", err)
 		return fmt.Errorf("failed to retrieve historical execution for request ID %s: %w", requestID, err)
 	}
 
-	loadOpts := []digraph.LoadOption{
-		digraph.WithBaseConfig(cfg.Paths.BaseConfig),
-	}
+	loadOpts := setup.loadOptions(absolutePath)
 
 	if status.Status.Params != "" {
 		// backward compatibility
@@ -88,7 +105,7 @@ func runRetry(cmd *cobra.Command, args []string) error {
 	}
 
 	// Execute DAG retry
-	if err := executeRetry(ctx, dag, setup, status, quiet); err != nil {
+	if err := executeRetry(ctx, dag, setup, status, step, quiet); err != nil {
 		logger.Error(ctx, "Failed to execute retry", "path", specFilePath, "err", err)
 		return fmt.Errorf("failed to execute retry: %w", err)
 	}
@@ -96,10 +113,14 @@ func runRetry(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func executeRetry(ctx context.Context, dag *digraph.DAG, setup *setup, originalStatus *model.StatusFile, quiet bool) error {
-	newRequestID, err := generateRequestID()
-	if err != nil {
-		return fmt.Errorf("failed to generate new request ID: %w", err)
+func executeRetry(ctx context.Context, dag *digraph.DAG, setup *setup, originalStatus *model.StatusFile, step string, quiet bool) error {
+	newRequestID := originalStatus.Status.RequestID
+	if step == "" {
+		var err error
+		newRequestID, err = generateRequestID()
+		if err != nil {
+			return fmt.Errorf("failed to generate new request ID: %w", err)
+		}
 	}
 
 	logFile, err := setup.openLogFile(ctx, retryPrefix, dag, newRequestID)
@@ -108,7 +129,7 @@ func executeRetry(ctx context.Context, dag *digraph.DAG, setup *setup, originalS
 	}
 	defer logFile.Close()
 
-	logger.Info(ctx, "DAG retry initiated", "DAG", dag.Name, "originalRequestID", originalStatus.Status.RequestID, "newRequestID", newRequestID, "logFile", logFile.Name())
+	logger.Info(ctx, "DAG retry initiated", "DAG", dag.Name, "originalRequestID", originalStatus.Status.RequestID, "newRequestID", newRequestID, "step", step, "logFile", logFile.Name())
 
 	ctx = setup.loggerContextWithFile(ctx, quiet, logFile)
 
@@ -118,6 +139,12 @@ func executeRetry(ctx context.Context, dag *digraph.DAG, setup *setup, originalS
 		return fmt.Errorf("failed to initialize DAG store: %w", err)
 	}
 
+	historyStore, err := setup.historyStore()
+	if err != nil {
+		logger.Error(ctx, "Failed to initialize history store", "err", err)
+		return fmt.Errorf("failed to initialize history store: %w", err)
+	}
+
 	cli, err := setup.client()
 	if err != nil {
 		logger.Error(ctx, "Failed to initialize client", "err", err)
@@ -131,8 +158,18 @@ func executeRetry(ctx context.Context, dag *digraph.DAG, setup *setup, originalS
 		logFile.Name(),
 		cli,
 		dagStore,
-		setup.historyStore(),
-		agent.Options{RetryTarget: &originalStatus.Status},
+		historyStore,
+		agent.Options{
+			RetryTarget:     &originalStatus.Status,
+			RetryStep:       step,
+			Notifiers:       setup.notifiers(ctx),
+			MailQueue:       setup.mailQueue(),
+			PublicURL:       setup.cfg.PublicURL,
+			StatsD:          setup.statsd(),
+			Tracing:         setup.tracing(),
+			LockDir:         setup.lockDir(),
+			StatusIndexAddr: setup.statusIndexAddr(),
+		},
 	)
 
 	listenSignals(ctx, agt)
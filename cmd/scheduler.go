@@ -5,8 +5,8 @@ import (
 
 	"github.com/dagu-org/dagu/internal/config"
 	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/sentry"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 func schedulerCmd() *cobra.Command {
@@ -23,7 +23,7 @@ func schedulerCmd() *cobra.Command {
 		"",
 		"location of DAG files (default is $HOME/.config/dagu/dags)",
 	)
-	_ = viper.BindPFlag("dags", cmd.Flags().Lookup("dags"))
+	_ = config.BindFlag("dags", cmd.Flags().Lookup("dags"))
 
 	return cmd
 }
@@ -33,9 +33,14 @@ func runScheduler(cmd *cobra.Command, _ []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w", err)
 	}
-	setup := newSetup(cfg)
+	setup, err := newSetup(cfg)
+	if err != nil {
+		return err
+	}
 
 	ctx := setup.loggerContext(cmd.Context(), false)
+	ctx = setup.sentryContext(ctx)
+	defer sentry.Recover(ctx)
 
 	// Update DAGs directory if specified
 	if dagsDir, _ := cmd.Flags().GetString("dags"); dagsDir != "" {
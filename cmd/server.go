@@ -5,8 +5,8 @@ import (
 
 	"github.com/dagu-org/dagu/internal/config"
 	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/sentry"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 const (
@@ -30,7 +30,7 @@ func serverCmd() *cobra.Command {
 func bindFlags(cmd *cobra.Command, _ []string) error {
 	flags := []string{"port", "host", "dags"}
 	for _, flag := range flags {
-		if err := viper.BindPFlag(flag, cmd.Flags().Lookup(flag)); err != nil {
+		if err := config.BindFlag(flag, cmd.Flags().Lookup(flag)); err != nil {
 			return fmt.Errorf("failed to bind flag %s: %w", flag, err)
 		}
 	}
@@ -42,9 +42,14 @@ func runServer(cmd *cobra.Command, _ []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w", err)
 	}
-	setup := newSetup(cfg)
+	setup, err := newSetup(cfg)
+	if err != nil {
+		return err
+	}
 
 	ctx := setup.loggerContext(cmd.Context(), false)
+	ctx = setup.sentryContext(ctx)
+	defer sentry.Recover(ctx)
 
 	logger.Info(ctx, "Server initialization", "host", cfg.Host, "port", cfg.Port)
 
@@ -2,29 +2,44 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/dagu-org/dagu/internal/client"
 	"github.com/dagu-org/dagu/internal/cmdutil"
 	"github.com/dagu-org/dagu/internal/config"
+	"github.com/dagu-org/dagu/internal/crypt"
 	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/executor"
 	"github.com/dagu-org/dagu/internal/fileutil"
 	"github.com/dagu-org/dagu/internal/frontend"
 	"github.com/dagu-org/dagu/internal/frontend/server"
 	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/mailer"
+	"github.com/dagu-org/dagu/internal/metrics"
+	"github.com/dagu-org/dagu/internal/namespace"
+	"github.com/dagu-org/dagu/internal/notifier"
 	"github.com/dagu-org/dagu/internal/persistence"
 	"github.com/dagu-org/dagu/internal/persistence/filecache"
 	"github.com/dagu-org/dagu/internal/persistence/jsondb"
 	"github.com/dagu-org/dagu/internal/persistence/local"
 	"github.com/dagu-org/dagu/internal/persistence/local/storage"
 	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/dagu-org/dagu/internal/persistence/sqlite"
+	"github.com/dagu-org/dagu/internal/persistence/statusindex"
 	"github.com/dagu-org/dagu/internal/scheduler"
+	"github.com/dagu-org/dagu/internal/sentry"
+	"github.com/dagu-org/dagu/internal/statsd"
+	"github.com/dagu-org/dagu/internal/steplock"
 	"github.com/dagu-org/dagu/internal/stringutil"
+	"github.com/dagu-org/dagu/internal/tracing"
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 )
@@ -41,10 +56,34 @@ func wrapRunE(f func(cmd *cobra.Command, args []string) error) func(cmd *cobra.C
 
 type setup struct {
 	cfg *config.Config
+
+	// db is the database of the sqlite backend of the persistence.
+	dbOnce sync.Once
+	db     *sql.DB
+	dbErr  error
 }
 
-func newSetup(cfg *config.Config) *setup {
-	return &setup{cfg: cfg}
+// newSetup returns the setup of the configuration. It enables the
+// encryption of the status files and the logs of the process if a key is
+// configured, and sets the cgroup root of the steps with resource limits.
+func newSetup(cfg *config.Config) (*setup, error) {
+	enc := cfg.Encryption
+	key, err := crypt.LoadKey(context.Background(), enc.Key, enc.KeyFile, enc.KeyCommand)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the encryption key: %w", err)
+	}
+	var c *crypt.Cipher
+	if key != nil {
+		if c, err = crypt.New(key); err != nil {
+			return nil, fmt.Errorf("failed to set up the encryption: %w", err)
+		}
+	}
+	crypt.SetDefault(c)
+	executor.SetCgroupRoot(cfg.CgroupRoot)
+	if err := digraph.SetHostEnvPolicy(cfg.HostEnv.Allow, cfg.HostEnv.Deny); err != nil {
+		return nil, err
+	}
+	return &setup{cfg: cfg}, nil
 }
 
 func (s *setup) loggerContext(ctx context.Context, quiet bool) context.Context {
@@ -67,7 +106,8 @@ func (s *setup) loggerContextWithFile(ctx context.Context, quiet bool, f *os.Fil
 		opts = append(opts, logger.WithQuiet())
 	}
 	if f != nil {
-		opts = append(opts, logger.WithWriter(f))
+		// Each log entry is written at once, so it becomes a record.
+		opts = append(opts, logger.WithWriter(crypt.NewWriter(f)))
 	}
 	return logger.WithLogger(ctx, logger.NewLogger(opts...))
 }
@@ -106,35 +146,59 @@ func (s *setup) client(opts ...clientOption) (client.Client, error) {
 	}
 	historyStore := options.historyStore
 	if historyStore == nil {
-		historyStore = s.historyStore()
+		var err error
+		historyStore, err = s.historyStore()
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize history store: %w", err)
+		}
 	}
 	flagStore := local.NewFlagStore(storage.NewStorage(
 		s.cfg.Paths.SuspendFlagsDir,
 	))
+	delayedStartStore := local.NewDelayedStartStore(storage.NewStorage(
+		filepath.Join(s.cfg.Paths.DataDir, local.DelayedStartDirName),
+	))
 
 	return client.New(
 		dagStore,
 		historyStore,
 		flagStore,
+		delayedStartStore,
 		s.cfg.Paths.Executable,
 		s.cfg.WorkDir,
 	), nil
 }
 
-func (s *setup) server(ctx context.Context) (*server.Server, error) {
+// server creates the web UI server. The metrics of the collectors are
+// served with the metrics of the runs.
+func (s *setup) server(ctx context.Context, collectors ...metrics.Collector) (*server.Server, error) {
 	dagCache := filecache.New[*digraph.DAG](0, time.Hour*12)
 	dagCache.StartEviction(ctx)
-	dagStore := s.dagStoreWithCache(dagCache)
+	dagStore, err := s.dagStoreWithCache(dagCache)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize DAG store: %w", err)
+	}
 
 	historyCache := filecache.New[*model.Status](0, time.Hour*12)
 	historyCache.StartEviction(ctx)
-	historyStore := s.historyStoreWithCache(historyCache)
+	store, err := s.historyStoreWithCache(historyCache)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize history store: %w", err)
+	}
+	runs := metrics.NewRuns()
+	historyStore := statusindex.New(store, statusindex.WithObserver(runs.Observe))
+	go func() {
+		if err := historyStore.Serve(ctx, s.statusIndexAddr()); err != nil {
+			logger.Error(ctx, "Failed to serve the status index", "err", err)
+		}
+	}()
 
 	cli, err := s.client(withDAGStore(dagStore), withHistoryStore(historyStore))
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize client: %w", err)
 	}
-	return frontend.New(s.cfg, cli), nil
+	collectors = append([]metrics.Collector{runs, metrics.Queue(cli)}, collectors...)
+	return frontend.New(s.cfg, cli, collectors...), nil
 }
 
 func (s *setup) scheduler() (*scheduler.Scheduler, error) {
@@ -145,6 +209,29 @@ func (s *setup) scheduler() (*scheduler.Scheduler, error) {
 	return scheduler.New(s.cfg, cli), nil
 }
 
+// loadOptions returns the options to load the DAG file with its base
+// configs and the namespace of its directory.
+func (s *setup) loadOptions(specPath string) []digraph.LoadOption {
+	opts := []digraph.LoadOption{
+		digraph.WithNamespace(namespace.Of(s.cfg.Paths.DAGDirs(), specPath)),
+	}
+	for _, base := range s.cfg.Paths.BaseConfigsFor(specPath) {
+		opts = append(opts, digraph.WithBaseConfig(base))
+	}
+	return opts
+}
+
+// resolveDAG returns the path of the DAG file given by its name or path on
+// the command line. The name is looked up in the DAG directories.
+func (s *setup) resolveDAG(nameOrPath string) (string, error) {
+	file, err := local.LocateDAG(s.cfg.Paths.DAGDirs(), nameOrPath)
+	if errors.Is(err, os.ErrNotExist) {
+		// Let the loader report the missing file.
+		return nameOrPath, nil
+	}
+	return file, err
+}
+
 func (s *setup) dagStore() (persistence.DAGStore, error) {
 	baseDir := s.cfg.Paths.DAGsDir
 	_, err := os.Stat(baseDir)
@@ -154,24 +241,80 @@ func (s *setup) dagStore() (persistence.DAGStore, error) {
 		}
 	}
 
-	return local.NewDAGStore(s.cfg.Paths.DAGsDir), nil
+	return s.newDAGStore(s.dagSearchPaths())
 }
 
-func (s *setup) dagStoreWithCache(cache *filecache.Cache[*digraph.DAG]) persistence.DAGStore {
-	return local.NewDAGStore(s.cfg.Paths.DAGsDir, local.WithFileCache(cache))
+func (s *setup) dagStoreWithCache(cache *filecache.Cache[*digraph.DAG]) (persistence.DAGStore, error) {
+	return s.newDAGStore(local.WithFileCache(cache), s.dagSearchPaths())
 }
 
-func (s *setup) historyStore() persistence.HistoryStore {
-	return jsondb.New(s.cfg.Paths.DataDir, jsondb.WithLatestStatusToday(
-		s.cfg.LatestStatusToday,
-	))
+func (s *setup) dagSearchPaths() local.DAGStoreOption {
+	return local.WithSearchPaths(s.cfg.Paths.DAGDirs()[1:]...)
 }
 
-func (s *setup) historyStoreWithCache(cache *filecache.Cache[*model.Status]) persistence.HistoryStore {
-	return jsondb.New(s.cfg.Paths.DataDir,
-		jsondb.WithLatestStatusToday(s.cfg.LatestStatusToday),
-		jsondb.WithFileCache(cache),
-	)
+func (s *setup) historyStore() (persistence.HistoryStore, error) {
+	return s.newHistoryStore(nil)
+}
+
+func (s *setup) historyStoreWithCache(cache *filecache.Cache[*model.Status]) (persistence.HistoryStore, error) {
+	return s.newHistoryStore(cache)
+}
+
+// newDAGStore creates the DAG store of the backend of the persistence.
+func (s *setup) newDAGStore(opts ...local.DAGStoreOption) (persistence.DAGStore, error) {
+	if s.cfg.Persistence.Backend != config.PersistenceBackendSQLite {
+		return local.NewDAGStore(s.cfg.Paths.DAGsDir, opts...), nil
+	}
+	db, err := s.sqliteDB()
+	if err != nil {
+		return nil, err
+	}
+	return sqlite.NewDAGStore(db, s.cfg.Paths.DAGsDir, opts...), nil
+}
+
+// newHistoryStore creates the history store of the backend of the
+// persistence. The cache is used by the file backend only.
+func (s *setup) newHistoryStore(cache *filecache.Cache[*model.Status]) (persistence.HistoryStore, error) {
+	if s.cfg.Persistence.Backend != config.PersistenceBackendSQLite {
+		opts := []jsondb.Option{
+			jsondb.WithLatestStatusToday(s.cfg.LatestStatusToday),
+			jsondb.WithDAGsDirs(s.cfg.Paths.DAGDirs()...),
+		}
+		if cache != nil {
+			opts = append(opts, jsondb.WithFileCache(cache))
+		}
+		return jsondb.New(s.cfg.Paths.DataDir, opts...), nil
+	}
+	db, err := s.sqliteDB()
+	if err != nil {
+		return nil, err
+	}
+	return sqlite.NewHistoryStore(db, sqlite.WithLatestStatusToday(s.cfg.LatestStatusToday)), nil
+}
+
+// sqliteDB returns the database of the sqlite backend. It's opened once
+// and shared by the stores of the process.
+func (s *setup) sqliteDB() (*sql.DB, error) {
+	s.dbOnce.Do(func() {
+		file := s.cfg.Persistence.SQLitePath
+		if file == "" {
+			file = filepath.Join(s.cfg.Paths.DataDir, sqlite.FileName)
+		}
+		s.db, s.dbErr = sqlite.Open(context.Background(), file)
+	})
+	if s.dbErr != nil {
+		return nil, fmt.Errorf("failed to open the database: %w", s.dbErr)
+	}
+	return s.db, nil
+}
+
+// requireFileBackend returns an error if the histories are not stored in
+// the status files, for the commands working on the files.
+func (s *setup) requireFileBackend() error {
+	if s.cfg.Persistence.Backend == config.PersistenceBackendSQLite {
+		return fmt.Errorf("the command is not supported by the %s persistence backend", s.cfg.Persistence.Backend)
+	}
+	return nil
 }
 
 func (s *setup) openLogFile(
@@ -206,6 +349,84 @@ func (s *setup) openLogFile(
 	return createLogFile(filepath.Join(outputDir, filename))
 }
 
+// notifiers loads the notifier plugins from the plugins directory. The
+// DAG runs without the notifiers if the plugins fail to load.
+func (s *setup) notifiers(ctx context.Context) *notifier.Registry {
+	notifiers, err := notifier.Load(s.cfg.Paths.PluginsDir)
+	if err != nil {
+		logger.Error(ctx, "Failed to load the notifier plugins", "err", err)
+		return nil
+	}
+	return notifiers
+}
+
+// mailQueue returns the options of the queue of the report mails of the
+// agents.
+// lockDir returns the directory of the locks shared by the steps of all the
+// DAG runs.
+func (s *setup) lockDir() string {
+	return filepath.Join(s.cfg.Paths.DataDir, steplock.DirName)
+}
+
+// statusIndexAddr returns the address of the unix socket the agents notify
+// the server of the status changes through.
+func (s *setup) statusIndexAddr() string {
+	return statusindex.SockAddr(s.cfg.Paths.DataDir)
+}
+
+func (s *setup) mailQueue() *mailer.QueueOptions {
+	return &mailer.QueueOptions{
+		Dir:          filepath.Join(s.cfg.Paths.DataDir, mailer.QueueDirName),
+		MaxAttempts:  s.cfg.MailQueue.MaxAttempts,
+		FlushTimeout: s.cfg.MailQueue.FlushTimeout,
+	}
+}
+
+// statsd returns the options of the StatsD client of the agents. It
+// returns nil if the StatsD address is not configured.
+func (s *setup) statsd() *statsd.Options {
+	if s.cfg.StatsD.Addr == "" {
+		return nil
+	}
+	return &statsd.Options{
+		Addr:    s.cfg.StatsD.Addr,
+		Prefix:  s.cfg.StatsD.Prefix,
+		Tags:    s.cfg.StatsD.Tags,
+		Datadog: s.cfg.StatsD.Datadog,
+	}
+}
+
+// tracing returns the options of the trace exporter of the agents. It
+// returns nil if no exporter is configured.
+func (s *setup) tracing() *tracing.Options {
+	if s.cfg.Tracing.Exporter == "" {
+		return nil
+	}
+	return &tracing.Options{
+		Exporter: s.cfg.Tracing.Exporter,
+		Endpoint: s.cfg.Tracing.Endpoint,
+		Service:  s.cfg.Tracing.Service,
+		APIKey:   s.cfg.Tracing.APIKey,
+	}
+}
+
+// sentryContext returns a context reporting the panics and the errors to
+// Sentry if the DSN is configured.
+func (s *setup) sentryContext(ctx context.Context) context.Context {
+	if s.cfg.Sentry.DSN == "" {
+		return ctx
+	}
+	client, err := sentry.New(sentry.Options{
+		DSN:         s.cfg.Sentry.DSN,
+		Environment: s.cfg.Sentry.Environment,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to create the Sentry client", "err", err)
+		return ctx
+	}
+	return sentry.WithClient(ctx, client)
+}
+
 // generateRequestID generates a new request ID.
 // For simplicity, we use UUIDs as request IDs.
 func generateRequestID() (string, error) {
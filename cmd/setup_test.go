@@ -9,6 +9,11 @@ import (
 
 	"github.com/dagu-org/dagu/internal/config"
 	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/frontend/gen/restapi/operations/dags"
+	"github.com/dagu-org/dagu/internal/persistence/jsondb"
+	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/dagu-org/dagu/internal/persistence/sqlite"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -17,11 +22,12 @@ func TestOpenLogFile(t *testing.T) {
 	t.Run("successful log file creation", func(t *testing.T) {
 		tempDir := t.TempDir() // Using t.TempDir() for automatic cleanup
 
-		setup := newSetup(&config.Config{
+		setup, err := newSetup(&config.Config{
 			Paths: config.PathsConfig{
 				LogDir: tempDir,
 			},
 		})
+		require.NoError(t, err)
 
 		ctx := setup.loggerContext(context.Background(), false)
 		file, err := setup.openLogFile(ctx, "test_", &digraph.DAG{
@@ -181,3 +187,57 @@ func TestValidateSettings(t *testing.T) {
 		})
 	}
 }
+
+func TestStoreFactory(t *testing.T) {
+	newTestSetup := func(t *testing.T, backend string) *setup {
+		t.Helper()
+		tmpDir := t.TempDir()
+		setup, err := newSetup(&config.Config{
+			Paths: config.PathsConfig{
+				DAGsDir: filepath.Join(tmpDir, "dags"),
+				DataDir: filepath.Join(tmpDir, "data"),
+			},
+			Persistence: config.Persistence{Backend: backend},
+		})
+		require.NoError(t, err)
+		return setup
+	}
+
+	t.Run("File", func(t *testing.T) {
+		setup := newTestSetup(t, config.PersistenceBackendFile)
+		historyStore, err := setup.historyStore()
+		require.NoError(t, err)
+		assert.IsType(t, &jsondb.JSONDB{}, historyStore)
+		assert.NoFileExists(t, filepath.Join(setup.cfg.Paths.DataDir, sqlite.FileName))
+	})
+	t.Run("SQLite", func(t *testing.T) {
+		setup := newTestSetup(t, config.PersistenceBackendSQLite)
+		dagStore, err := setup.dagStore()
+		require.NoError(t, err)
+		assert.IsType(t, &sqlite.DAGStore{}, dagStore)
+		historyStore, err := setup.historyStore()
+		require.NoError(t, err)
+		assert.IsType(t, &sqlite.HistoryStore{}, historyStore)
+		assert.FileExists(t, filepath.Join(setup.cfg.Paths.DataDir, sqlite.FileName))
+
+		// The DAGs and their runs are listed through the client.
+		ctx := context.Background()
+		cli, err := setup.client()
+		require.NoError(t, err)
+		id, err := cli.CreateDAG(ctx, "test")
+		require.NoError(t, err)
+		dag, err := dagStore.GetMetadata(ctx, id)
+		require.NoError(t, err)
+		require.NoError(t, historyStore.Open(ctx, dag.Location, time.Now(), "request-1"))
+		require.NoError(t, historyStore.Write(ctx, model.NewStatusFactory(dag).Create("request-1", scheduler.StatusSuccess, 0, time.Now())))
+		require.NoError(t, historyStore.Close(ctx))
+
+		statuses, result, err := cli.GetAllStatusPagination(ctx, dags.ListDagsParams{})
+		require.NoError(t, err)
+		require.Empty(t, result.ErrorList)
+		require.Len(t, statuses, 1)
+		assert.Equal(t, "request-1", statuses[0].Status.RequestID)
+
+		require.Error(t, setup.requireFileBackend())
+	})
+}
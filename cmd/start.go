@@ -2,14 +2,21 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/dagu-org/dagu/internal/agent"
+	"github.com/dagu-org/dagu/internal/client"
 	"github.com/dagu-org/dagu/internal/config"
 	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/dagu-org/dagu/internal/sentry"
+	"github.com/dagu-org/dagu/internal/stringutil"
 	"github.com/spf13/cobra"
 )
 
@@ -32,6 +39,19 @@ func initStartFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("params", "p", "", "parameters")
 	cmd.Flags().StringP("requestID", "r", "", "specify request ID")
 	cmd.Flags().BoolP("quiet", "q", false, "suppress output")
+	cmd.Flags().String("idempotencyKey", "", "skip the run if a run with the same key exists")
+	cmd.Flags().StringP("labels", "l", "", "labels of the run (e.g. customer=acme,backfill=true)")
+	cmd.Flags().String("parentRequestID", "", "request ID of the parent DAG run")
+	cmd.Flags().String("parentDAG", "", "ID of the DAG of the parent run")
+	cmd.Flags().String("trigger", "", "what started the run: manual, api, schedule or parent (recorded in the lineage)")
+	cmd.Flags().String("at", "", "register the run to be started by the scheduler at the time (RFC 3339 or \"2006-01-02 15:04:05\")")
+	cmd.Flags().Duration("delay", 0, "register the run to be started by the scheduler after the delay (e.g. 30m)")
+	cmd.Flags().String("schedule", "", "cron expression of the schedule that started the run, set by the scheduler")
+	cmd.Flags().String("scheduledTime", "", "time the run was scheduled to start at (RFC 3339), set by the scheduler")
+	cmd.Flags().String("executionDate", "", "logical date of the data the run processes (e.g. 2024-02-01), for backfills")
+	cmd.Flags().StringArray("skip", nil, "name of the step to skip in the run (can be repeated)")
+	cmd.Flags().String("from", "", "name of the step to start the run from, skipping the steps it depends on")
+	cmd.Flags().String("target", "", "name of the step to run with the steps it depends on, skipping the others")
 }
 
 func runStart(cmd *cobra.Command, args []string) error {
@@ -40,7 +60,10 @@ func runStart(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to load configuration: %w", err)
 	}
 
-	setup := newSetup(cfg)
+	setup, err := newSetup(cfg)
+	if err != nil {
+		return err
+	}
 
 	quiet, err := cmd.Flags().GetBool("quiet")
 	if err != nil {
@@ -52,14 +75,90 @@ func runStart(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get request ID: %w", err)
 	}
 
+	idempotencyKey, err := cmd.Flags().GetString("idempotencyKey")
+	if err != nil {
+		return fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	labelsStr, err := cmd.Flags().GetString("labels")
+	if err != nil {
+		return fmt.Errorf("failed to get labels: %w", err)
+	}
+	labels, err := model.ParseLabels(removeQuotes(labelsStr))
+	if err != nil {
+		return fmt.Errorf("failed to parse labels: %w", err)
+	}
+
+	parentRequestID, err := cmd.Flags().GetString("parentRequestID")
+	if err != nil {
+		return fmt.Errorf("failed to get parent request ID: %w", err)
+	}
+
+	parentDAG, err := cmd.Flags().GetString("parentDAG")
+	if err != nil {
+		return fmt.Errorf("failed to get parent DAG: %w", err)
+	}
+
+	var trigger model.Trigger
+	if s, _ := cmd.Flags().GetString("trigger"); s != "" {
+		if trigger, err = model.ParseTrigger(s); err != nil {
+			return err
+		}
+	}
+
+	schedule, err := cmd.Flags().GetString("schedule")
+	if err != nil {
+		return fmt.Errorf("failed to get schedule: %w", err)
+	}
+
+	startAt, err := delayedStartTime(cmd)
+	if err != nil {
+		return err
+	}
+
+	var scheduledTime time.Time
+	if s, _ := cmd.Flags().GetString("scheduledTime"); s != "" {
+		if scheduledTime, err = stringutil.ParseTime(s); err != nil {
+			return fmt.Errorf("invalid scheduled time %q: %w", s, err)
+		}
+	}
+
+	executionDate, err := executionDateFlag(cmd)
+	if err != nil {
+		return err
+	}
+
+	skipSteps, err := cmd.Flags().GetStringArray("skip")
+	if err != nil {
+		return fmt.Errorf("failed to get the steps to skip: %w", err)
+	}
+
+	from, err := cmd.Flags().GetString("from")
+	if err != nil {
+		return fmt.Errorf("failed to get the step to start from: %w", err)
+	}
+
+	target, err := cmd.Flags().GetString("target")
+	if err != nil {
+		return fmt.Errorf("failed to get the target step: %w", err)
+	}
+
 	ctx := setup.loggerContext(cmd.Context(), quiet)
+	ctx = setup.sentryContext(ctx)
+	defer sentry.Recover(ctx)
 
-	loadOpts := []digraph.LoadOption{
-		digraph.WithBaseConfig(setup.cfg.Paths.BaseConfig),
+	specPath, err := setup.resolveDAG(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to resolve DAG %s: %w", args[0], err)
 	}
 
+	loadOpts := setup.loadOptions(specPath)
+
 	var params string
 	if argsLenAtDash := cmd.ArgsLenAtDash(); argsLenAtDash != -1 {
+		if !startAt.IsZero() {
+			return errors.New("the positional parameters can't be used with --at or --delay; use --params instead")
+		}
 		// Get parameters from command line arguments after "--"
 		loadOpts = append(loadOpts, digraph.WithParams(args[argsLenAtDash:]))
 	} else {
@@ -71,16 +170,48 @@ func runStart(cmd *cobra.Command, args []string) error {
 		loadOpts = append(loadOpts, digraph.WithParams(removeQuotes(params)))
 	}
 
-	return executeDag(ctx, setup, args[0], loadOpts, quiet, requestID)
+	if !startAt.IsZero() {
+		return registerDelayedStart(ctx, cmd.OutOrStdout(), setup, specPath, loadOpts, startAt, client.StartOptions{
+			Params:         removeQuotes(params),
+			RequestID:      requestID,
+			IdempotencyKey: idempotencyKey,
+			Labels:         labels,
+			Trigger:        trigger,
+			ExecutionDate:  executionDate,
+			SkipSteps:      skipSteps,
+			From:           from,
+			Target:         target,
+		})
+	}
+
+	return executeDag(ctx, setup, specPath, loadOpts, quiet, requestID, agent.Options{
+		IdempotencyKey:  idempotencyKey,
+		Labels:          labels,
+		ParentRequestID: parentRequestID,
+		ParentDAG:       parentDAG,
+		Schedule:        schedule,
+		Trigger:         trigger,
+		ScheduledTime:   scheduledTime,
+		ExecutionDate:   executionDate,
+		SkipSteps:       skipSteps,
+		From:            from,
+		Target:          target,
+	})
 }
 
-func executeDag(ctx context.Context, setup *setup, specPath string, loadOpts []digraph.LoadOption, quiet bool, requestID string) error {
+func executeDag(ctx context.Context, setup *setup, specPath string, loadOpts []digraph.LoadOption, quiet bool, requestID string, opts agent.Options) error {
 	dag, err := digraph.Load(ctx, specPath, loadOpts...)
 	if err != nil {
 		logger.Error(ctx, "Failed to load DAG", "path", specPath, "err", err)
 		return fmt.Errorf("failed to load DAG from %s: %w", specPath, err)
 	}
 
+	cli, err := setup.client()
+	if err != nil {
+		logger.Error(ctx, "Failed to initialize client", "err", err)
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+
 	if requestID == "" {
 		var err error
 		requestID, err = generateRequestID()
@@ -90,6 +221,19 @@ func executeDag(ctx context.Context, setup *setup, specPath string, loadOpts []d
 		}
 	}
 
+	if opts.IdempotencyKey != "" {
+		// Do not start a duplicate run if the key is reserved for another run.
+		reserved, err := cli.ReserveIdempotencyKey(ctx, dag, opts.IdempotencyKey, requestID)
+		if err != nil {
+			logger.Error(ctx, "Failed to reserve the idempotency key", "err", err)
+			return fmt.Errorf("failed to reserve the idempotency key: %w", err)
+		}
+		if reserved != requestID {
+			logger.Info(ctx, "DAG run with the idempotency key already exists", "DAG", dag.Name, "requestID", reserved, "idempotencyKey", opts.IdempotencyKey)
+			return nil
+		}
+	}
+
 	logFile, err := setup.openLogFile(ctx, startPrefix, dag, requestID)
 	if err != nil {
 		logger.Error(ctx, "failed to initialize log file", "DAG", dag.Name, "err", err)
@@ -107,12 +251,19 @@ func executeDag(ctx context.Context, setup *setup, specPath string, loadOpts []d
 		return fmt.Errorf("failed to initialize DAG store: %w", err)
 	}
 
-	cli, err := setup.client()
+	historyStore, err := setup.historyStore()
 	if err != nil {
-		logger.Error(ctx, "Failed to initialize client", "err", err)
-		return fmt.Errorf("failed to initialize client: %w", err)
+		logger.Error(ctx, "Failed to initialize history store", "err", err)
+		return fmt.Errorf("failed to initialize history store: %w", err)
 	}
 
+	opts.Notifiers = setup.notifiers(ctx)
+	opts.MailQueue = setup.mailQueue()
+	opts.PublicURL = setup.cfg.PublicURL
+	opts.StatsD = setup.statsd()
+	opts.Tracing = setup.tracing()
+	opts.LockDir = setup.lockDir()
+	opts.StatusIndexAddr = setup.statusIndexAddr()
 	agt := agent.New(
 		requestID,
 		dag,
@@ -120,8 +271,8 @@ func executeDag(ctx context.Context, setup *setup, specPath string, loadOpts []d
 		logFile.Name(),
 		cli,
 		dagStore,
-		setup.historyStore(),
-		agent.Options{},
+		historyStore,
+		opts,
 	)
 
 	listenSignals(ctx, agt)
@@ -144,6 +295,99 @@ func executeDag(ctx context.Context, setup *setup, specPath string, loadOpts []d
 	return nil
 }
 
+// delayedStartTime returns the time given by the --at or the --delay flag,
+// or the zero time if neither is set.
+func delayedStartTime(cmd *cobra.Command) (time.Time, error) {
+	at, err := cmd.Flags().GetString("at")
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get the start time: %w", err)
+	}
+	delay, err := cmd.Flags().GetDuration("delay")
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get the delay: %w", err)
+	}
+	switch {
+	case at != "" && delay != 0:
+		return time.Time{}, errors.New("only one of --at and --delay can be set")
+	case delay < 0:
+		return time.Time{}, fmt.Errorf("the delay must be positive: %s", delay)
+	case delay > 0:
+		return time.Now().Add(delay), nil
+	case at == "":
+		return time.Time{}, nil
+	}
+	startAt, err := stringutil.ParseTime(at)
+	if err != nil || startAt.IsZero() {
+		return time.Time{}, fmt.Errorf("invalid start time %q: use RFC 3339 or \"2006-01-02 15:04:05\"", at)
+	}
+	if !startAt.After(time.Now()) {
+		return time.Time{}, fmt.Errorf("the start time is in the past: %s", at)
+	}
+	return startAt, nil
+}
+
+// executionDateFlag returns the time given by the --executionDate flag, or
+// the zero time if it's not set. A date without the time is the midnight
+// of the date in the local time zone.
+func executionDateFlag(cmd *cobra.Command) (time.Time, error) {
+	s, err := cmd.Flags().GetString("executionDate")
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get the execution date: %w", err)
+	}
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, s, time.Local); err == nil {
+		return t, nil
+	}
+	t, err := stringutil.ParseTime(s)
+	if err != nil || t.IsZero() {
+		return time.Time{}, fmt.Errorf("invalid execution date %q: use 2006-01-02, RFC 3339 or \"2006-01-02 15:04:05\"", s)
+	}
+	return t, nil
+}
+
+// registerDelayedStart registers the run to be started by the scheduler at
+// the time and prints its request ID.
+func registerDelayedStart(ctx context.Context, w io.Writer, setup *setup, specPath string, loadOpts []digraph.LoadOption, startAt time.Time, opts client.StartOptions) error {
+	// The DAG is loaded to check it before it's registered.
+	dag, err := digraph.Load(ctx, specPath, loadOpts...)
+	if err != nil {
+		return fmt.Errorf("failed to load DAG from %s: %w", specPath, err)
+	}
+
+	cli, err := setup.client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+
+	if opts.RequestID == "" {
+		if opts.RequestID, err = generateRequestID(); err != nil {
+			return fmt.Errorf("failed to generate request ID: %w", err)
+		}
+	}
+
+	if opts.IdempotencyKey != "" {
+		reserved, err := cli.ReserveIdempotencyKey(ctx, dag, opts.IdempotencyKey, opts.RequestID)
+		if err != nil {
+			return fmt.Errorf("failed to reserve the idempotency key: %w", err)
+		}
+		if reserved != opts.RequestID {
+			fmt.Fprintln(w, reserved)
+			return nil
+		}
+	}
+	if err := cli.StartLater(ctx, dag, opts, startAt); err != nil {
+		if opts.IdempotencyKey != "" {
+			_ = cli.ReleaseIdempotencyKey(ctx, dag, opts.IdempotencyKey, opts.RequestID)
+		}
+		return fmt.Errorf("failed to register the delayed start of DAG %s: %w", dag.Name, err)
+	}
+	logger.Info(ctx, "DAG run registered to start later", "DAG", dag.Name, "requestID", opts.RequestID, "startAt", startAt.Format(time.RFC3339))
+	fmt.Fprintln(w, opts.RequestID)
+	return nil
+}
+
 // removeQuotes removes the surrounding quotes from the string.
 func removeQuotes(s string) string {
 	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
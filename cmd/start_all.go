@@ -5,8 +5,8 @@ import (
 
 	"github.com/dagu-org/dagu/internal/config"
 	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/sentry"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 func startAllCmd() *cobra.Command {
@@ -25,7 +25,7 @@ func startAllCmd() *cobra.Command {
 func bindStartAllFlags(cmd *cobra.Command, _ []string) error {
 	flags := []string{"port", "host", "dags"}
 	for _, flag := range flags {
-		if err := viper.BindPFlag(flag, cmd.Flags().Lookup(flag)); err != nil {
+		if err := config.BindFlag(flag, cmd.Flags().Lookup(flag)); err != nil {
 			return fmt.Errorf("failed to bind flag %s: %w", flag, err)
 		}
 	}
@@ -38,7 +38,10 @@ func runStartAll(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("failed to load configuration: %w", err)
 	}
 
-	setup := newSetup(cfg)
+	setup, err := newSetup(cfg)
+	if err != nil {
+		return err
+	}
 
 	// Update DAGs directory if specified
 	if dagsDir, _ := cmd.Flags().GetString("dags"); dagsDir != "" {
@@ -46,6 +49,8 @@ func runStartAll(cmd *cobra.Command, _ []string) error {
 	}
 
 	ctx := setup.loggerContext(cmd.Context(), false)
+	ctx = setup.sentryContext(ctx)
+	defer sentry.Recover(ctx)
 
 	scheduler, err := setup.scheduler()
 	if err != nil {
@@ -64,7 +69,8 @@ func runStartAll(cmd *cobra.Command, _ []string) error {
 		errChan <- nil
 	}()
 
-	server, err := setup.server(ctx)
+	// The metrics of the scheduler are served with the server.
+	server, err := setup.server(ctx, scheduler.Metrics())
 	if err != nil {
 		return fmt.Errorf("failed to initialize server: %w", err)
 	}
@@ -81,18 +87,21 @@ func runStartAll(cmd *cobra.Command, _ []string) error {
 		serverErr <- nil
 	}()
 
-	// Wait for either error to occur
-	select {
-	case err := <-errChan:
-		if err != nil {
-			return err
-		}
-	case err := <-serverErr:
-		if err != nil {
-			return err
+	// Wait for both to finish draining the running DAGs, or for either
+	// error to occur
+	for i := 0; i < 2; i++ {
+		select {
+		case err := <-errChan:
+			if err != nil {
+				return err
+			}
+		case err := <-serverErr:
+			if err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
 		}
-	case <-ctx.Done():
-		return ctx.Err()
 	}
 
 	return nil
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/dagu-org/dagu/internal/config"
 	"github.com/dagu-org/dagu/internal/digraph"
@@ -26,12 +27,20 @@ func runStatus(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to load configuration: %w", err)
 	}
 
-	setup := newSetup(cfg)
+	setup, err := newSetup(cfg)
+	if err != nil {
+		return err
+	}
 
 	ctx := setup.loggerContext(cmd.Context(), false)
 
+	specPath, err := setup.resolveDAG(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to resolve DAG %s: %w", args[0], err)
+	}
+
 	// Load the DAG
-	dag, err := digraph.Load(ctx, args[0], digraph.WithBaseConfig(cfg.Paths.BaseConfig))
+	dag, err := digraph.Load(ctx, specPath, setup.loadOptions(specPath)...)
 	if err != nil {
 		logger.Error(ctx, "Failed to load DAG", "path", args[0], "err", err)
 		return fmt.Errorf("failed to load DAG from %s: %w", args[0], err)
@@ -52,5 +61,14 @@ func runStatus(cmd *cobra.Command, args []string) error {
 	// Log the status information
 	logger.Info(ctx, "Current status", "pid", status.PID, "status", status.Status)
 
+	starts, err := cli.GetDelayedStarts(ctx, dag)
+	if err != nil {
+		logger.Error(ctx, "Failed to retrieve the delayed starts", "dag", dag.Name, "err", err)
+		return fmt.Errorf("failed to retrieve the delayed starts: %w", err)
+	}
+	for _, start := range starts {
+		logger.Info(ctx, "Delayed start", "requestID", start.RequestID, "startAt", start.StartAt.Format(time.RFC3339))
+	}
+
 	return nil
 }
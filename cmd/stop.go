@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 
+	"github.com/dagu-org/dagu/internal/client"
 	"github.com/dagu-org/dagu/internal/config"
 	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/dagu-org/dagu/internal/logger"
@@ -11,12 +12,13 @@ import (
 
 func stopCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "stop /path/to/spec.yaml",
+		Use:   "stop [flags] /path/to/spec.yaml",
 		Short: "Stop the running DAG",
-		Long:  `dagu stop /path/to/spec.yaml`,
+		Long:  `dagu stop [--requestID=<request ID>] /path/to/spec.yaml`,
 		Args:  cobra.ExactArgs(1),
 		RunE:  wrapRunE(runStop),
 	}
+	cmd.Flags().StringP("requestID", "r", "", "stop only the run with the request ID")
 	return cmd
 }
 
@@ -26,11 +28,24 @@ func runStop(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to load configuration: %w", err)
 	}
 
-	setup := newSetup(cfg)
+	setup, err := newSetup(cfg)
+	if err != nil {
+		return err
+	}
 
 	ctx := setup.loggerContext(cmd.Context(), false)
 
-	dag, err := digraph.Load(cmd.Context(), args[0], digraph.WithBaseConfig(cfg.Paths.BaseConfig))
+	requestID, err := cmd.Flags().GetString("requestID")
+	if err != nil {
+		return fmt.Errorf("failed to get request ID: %w", err)
+	}
+
+	specPath, err := setup.resolveDAG(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to resolve DAG %s: %w", args[0], err)
+	}
+
+	dag, err := digraph.Load(cmd.Context(), specPath, setup.loadOptions(specPath)...)
 	if err != nil {
 		logger.Error(ctx, "Failed to load DAG", "err", err)
 		return fmt.Errorf("failed to load DAG from %s: %w", args[0], err)
@@ -44,11 +59,19 @@ func runStop(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to initialize client: %w", err)
 	}
 
-	if err := cli.Stop(cmd.Context(), dag); err != nil {
+	result, err := cli.Stop(cmd.Context(), dag, requestID)
+	if err != nil {
 		logger.Error(ctx, "Failed to stop DAG", "dag", dag.Name, "err", err)
 		return fmt.Errorf("failed to stop DAG: %w", err)
 	}
 
-	logger.Info(ctx, "DAG stopped", "dag", dag.Name)
+	switch result {
+	case client.StopSignalled:
+		logger.Info(ctx, "DAG stopped", "dag", dag.Name)
+	case client.StopAlreadyStopped:
+		logger.Info(ctx, "DAG run is already stopped", "dag", dag.Name, "requestID", requestID)
+	case client.StopNotRunning:
+		logger.Info(ctx, "DAG is not running", "dag", dag.Name)
+	}
 	return nil
 }
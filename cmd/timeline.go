@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/calendar"
+	"github.com/dagu-org/dagu/internal/config"
+	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/spf13/cobra"
+)
+
+// maxTimelineRuns is the maximum number of the runs of a schedule printed,
+// so that a schedule that fires every minute doesn't flood the output.
+const maxTimelineRuns = 500
+
+func timelineCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timeline [flags] [DAG ...]",
+		Short: "Print the runs expected in the next hours",
+		Long: `dagu timeline [--hours=24] [DAG ...]
+
+Simulates the scheduler to print the starts, the stops and the restarts
+expected from the schedules of the DAGs in the next hours, so that a change
+can be checked before a busy window. The runs expected to be skipped are
+printed with the reason: the DAG is suspended at the time, or it's out of the
+time window or the weekdays of its preconditions.
+
+The missed runs are not caught up by the scheduler, so only the runs after
+now are printed.`,
+		RunE: wrapRunE(runTimeline),
+	}
+	cmd.Flags().Int("hours", 24, "number of the hours from now to simulate")
+	return cmd
+}
+
+func runTimeline(cmd *cobra.Command, args []string) error {
+	hours, _ := cmd.Flags().GetInt("hours")
+	if hours <= 0 {
+		return fmt.Errorf("hours must be positive: %d", hours)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	setup, err := newSetup(cfg)
+	if err != nil {
+		return err
+	}
+	ctx := setup.loggerContext(cmd.Context(), true)
+
+	cli, err := setup.client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	statuses, errs, err := cli.GetAllStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list the DAGs: %w", err)
+	}
+	for _, e := range errs {
+		logger.Warn(ctx, "Failed to load a DAG", "err", e)
+	}
+
+	var scheduled []calendar.ScheduledDAG
+	for _, s := range statuses {
+		if s.Error != nil || s.DAG == nil {
+			continue
+		}
+		if len(args) > 0 && !slices.Contains(args, s.DAG.ID()) && !slices.Contains(args, s.DAG.Name) {
+			continue
+		}
+		scheduled = append(scheduled, calendar.ScheduledDAG{DAG: s.DAG, Suspension: s.Suspension})
+	}
+
+	from := time.Now().In(cfg.Location)
+	to := from.Add(time.Duration(hours) * time.Hour)
+	printTimeline(cmd.OutOrStdout(), calendar.Timeline(scheduled, from, to, maxTimelineRuns))
+	return nil
+}
+
+// printTimeline prints the runs in a table.
+func printTimeline(w io.Writer, runs []calendar.Run) {
+	if len(runs) == 0 {
+		fmt.Fprintln(w, "No runs are scheduled.")
+		return
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "TIME\tOPERATION\tDAG\tSCHEDULE\tNOTE")
+	var skipped int
+	for _, run := range runs {
+		note := ""
+		if run.Skipped {
+			note = "skipped: " + run.Reason
+			skipped++
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", run.Time.Format("2006-01-02 15:04 MST"), run.Operation, run.DAG, run.Schedule, note)
+	}
+	_ = tw.Flush()
+	fmt.Fprintf(w, "\n%d runs, %d expected to be skipped.\n", len(runs), skipped)
+}
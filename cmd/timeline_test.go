@@ -0,0 +1,29 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/calendar"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintTimeline(t *testing.T) {
+	at := time.Date(2024, 1, 1, 22, 0, 0, 0, time.UTC)
+	runs := []calendar.Run{
+		{DAG: "etl", Time: at, Operation: calendar.OperationStart, Schedule: "0 22 * * *"},
+		{DAG: "team-a/report", Time: at.Add(time.Hour), Operation: calendar.OperationStart, Schedule: "0 * * * *", Skipped: true, Reason: "suspended: migration"},
+	}
+
+	var buf bytes.Buffer
+	printTimeline(&buf, runs)
+	out := buf.String()
+	require.Regexp(t, `2024-01-01 22:00 UTC\s+start\s+etl\s+0 22 \* \* \*`, out)
+	require.Regexp(t, `team-a/report\s+0 \* \* \* \*\s+skipped: suspended: migration`, out)
+	require.Contains(t, out, "2 runs, 1 expected to be skipped.")
+
+	buf.Reset()
+	printTimeline(&buf, nil)
+	require.Equal(t, "No runs are scheduled.\n", buf.String())
+}
@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"net/http"
 	"os"
+	"path/filepath"
 	"regexp"
 	"runtime/debug"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -16,13 +19,22 @@ import (
 	"time"
 
 	"github.com/dagu-org/dagu/internal/client"
+	"github.com/dagu-org/dagu/internal/cmdutil"
 	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/executor"
 	"github.com/dagu-org/dagu/internal/digraph/scheduler"
 	"github.com/dagu-org/dagu/internal/logger"
 	"github.com/dagu-org/dagu/internal/mailer"
+	"github.com/dagu-org/dagu/internal/notifier"
 	"github.com/dagu-org/dagu/internal/persistence"
 	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/dagu-org/dagu/internal/persistence/statusindex"
+	"github.com/dagu-org/dagu/internal/sentry"
 	"github.com/dagu-org/dagu/internal/sock"
+	"github.com/dagu-org/dagu/internal/statsd"
+	"github.com/dagu-org/dagu/internal/stringutil"
+	"github.com/dagu-org/dagu/internal/tracing"
+	"golang.org/x/sys/unix"
 )
 
 // Agent is responsible for running the DAG and handling communication
@@ -35,6 +47,7 @@ type Agent struct {
 	dag          *digraph.DAG
 	dry          bool
 	retryTarget  *model.Status
+	retryStep    string
 	dagStore     persistence.DAGStore
 	client       client.Client
 	scheduler    *scheduler.Scheduler
@@ -42,14 +55,77 @@ type Agent struct {
 	reporter     *reporter
 	historyStore persistence.HistoryStore
 	socketServer *sock.Server
+	notifiers    *notifier.Registry
 	logDir       string
 	logFile      string
 
+	// mailQueueOpts is the options of the queue of the report mails. The
+	// mails are sent synchronously if it's nil.
+	mailQueueOpts *mailer.QueueOptions
+	mailQueue     *mailer.Queue
+
+	// publicURL is the URL of the Web UI.
+	publicURL string
+
+	// statsdOpts is the options of the StatsD client to push the metrics
+	// of the run. The metrics are not sent if it's nil.
+	statsdOpts *statsd.Options
+	statsd     *statsd.Client
+
+	// tracingOpts is the options of the exporter of the trace of the run.
+	// The trace is not exported if it's nil.
+	tracingOpts *tracing.Options
+	tracer      tracing.Exporter
+
+	// lockDir is the directory of the locks of the steps.
+	lockDir string
+
+	// statusIndexAddr is the address of the socket of the server's status
+	// index notified when the status of the run changes.
+	statusIndexAddr string
+
 	// requestID is request ID to identify DAG execution uniquely.
 	// The request ID can be used for history lookup, retry, etc.
 	requestID string
 	finished  atomic.Bool
 
+	// stopRequested is set when the stop of the run is requested.
+	stopRequested atomic.Bool
+
+	// heartbeat is the time in unix nanoseconds the agent was last alive.
+	heartbeat atomic.Int64
+
+	// deadlineExceeded is set when the run exceeded the max run duration.
+	// deadlineNote records the reason in the status.
+	deadlineExceeded atomic.Bool
+	deadlineNote     model.Note
+
+	// idempotencyKey is the key given by the submitter of the run.
+	idempotencyKey string
+
+	// labels is the key/value metadata given at the start of the run.
+	// Steps can add more labels by writing to the labels file, which is
+	// merged into it when the status of a step changes.
+	labels map[string]string
+
+	// parentRequestID is the request ID of the parent DAG run when the
+	// DAG is started as a sub workflow.
+	parentRequestID string
+	// lineage is what started the run.
+	lineage *model.Lineage
+	// scheduledTime is the time the run was scheduled to start at. It's
+	// the start time of the run if the run is not scheduled.
+	scheduledTime time.Time
+	// executionDate is the logical date of the data the run processes.
+	executionDate time.Time
+	// skipSteps is the names of the steps requested to be skipped before
+	// the run started.
+	skipSteps []string
+	// from is the name of the step the run starts from.
+	from string
+	// target is the name of the step run with its dependencies only.
+	target string
+
 	lock    sync.RWMutex
 	lastErr error
 }
@@ -63,6 +139,65 @@ type Options struct {
 	// If it's specified the agent will execute the DAG with the same
 	// configuration as the specified history.
 	RetryTarget *model.Status
+	// RetryStep is the name of the step to retry. If it's specified, only
+	// the step is executed again and the status of the retry target is
+	// updated instead of creating a new history.
+	RetryStep string
+	// IdempotencyKey is the key given by the submitter of the run.
+	// It's recorded in the status to detect duplicate submissions.
+	IdempotencyKey string
+	// Labels is the arbitrary key/value metadata of the run.
+	Labels map[string]string
+	// ParentRequestID is the request ID of the DAG run that started
+	// this run as a sub workflow.
+	ParentRequestID string
+	// ParentDAG is the ID of the DAG of the parent run.
+	ParentDAG string
+	// Schedule is the cron expression of the schedule that started the run.
+	Schedule string
+	// Trigger is what started the run. It defaults to the parent run if
+	// ParentRequestID is set and to the command line otherwise. The retries
+	// of a run are always recorded as retries.
+	Trigger model.Trigger
+	// Notifiers is the notifier plugins used by the notify field of the
+	// DAG. The notifiers are not sent if it's nil.
+	Notifiers *notifier.Registry
+	// MailQueue is the options of the queue to send the report mails in
+	// the background. The mails are sent synchronously if it's nil.
+	MailQueue *mailer.QueueOptions
+	// PublicURL is the URL of the Web UI used for the links to the run in
+	// the lifecycle handlers.
+	PublicURL string
+	// StatsD is the options of the StatsD client to push the counters and
+	// timings of the run and its steps. The metrics are not sent if it's nil.
+	StatsD *statsd.Options
+	// Tracing is the options of the exporter to send the trace of the run
+	// and its steps to an APM service. The trace is not exported if it's nil.
+	Tracing *tracing.Options
+	// LockDir is the directory of the locks shared by the steps of the DAG
+	// runs. The locks of the steps are ignored if it's empty.
+	LockDir string
+	// StatusIndexAddr is the address of the unix socket of the server to
+	// notify when the run starts and finishes, so that the server updates
+	// the latest status of the DAG in memory. It's not notified if empty.
+	StatusIndexAddr string
+	// ScheduledTime is the time the run was scheduled to start at, e.g. the
+	// time of the cron schedule or the time of the delayed start. It's kept
+	// by the retries and defaults to the start time of the run.
+	ScheduledTime time.Time
+	// ExecutionDate is the logical date of the data the run processes, e.g.
+	// the time of the cron schedule or the date given to a backfill. It's
+	// kept by the retries and defaults to the scheduled time.
+	ExecutionDate time.Time
+	// SkipSteps is the names of the steps to skip in the run. The steps
+	// after them run as if the skipped steps succeeded.
+	SkipSteps []string
+	// From is the name of the step to start the run from. The steps it
+	// depends on are skipped as if they succeeded.
+	From string
+	// Target is the name of the step to run with the steps it depends on.
+	// The other steps are skipped.
+	Target string
 }
 
 // New creates a new Agent.
@@ -76,28 +211,132 @@ func New(
 	historyStore persistence.HistoryStore,
 	opts Options,
 ) *Agent {
+	idempotencyKey := opts.IdempotencyKey
+	if idempotencyKey == "" && opts.RetryTarget != nil {
+		// Keep the key of the original run.
+		idempotencyKey = opts.RetryTarget.IdempotencyKey
+	}
+	parentRequestID := opts.ParentRequestID
+	if parentRequestID == "" && opts.RetryTarget != nil {
+		parentRequestID = opts.RetryTarget.ParentRequestID
+	}
+	if opts.RetryStep != "" && opts.RetryTarget != nil {
+		// The step is retried in the same run.
+		requestID = opts.RetryTarget.RequestID
+	}
+	scheduledTime := opts.ScheduledTime
+	if scheduledTime.IsZero() && opts.RetryTarget != nil {
+		scheduledTime, _ = stringutil.ParseTime(opts.RetryTarget.ScheduledTime)
+	}
+	if scheduledTime.IsZero() {
+		scheduledTime = time.Now()
+	}
+	executionDate := opts.ExecutionDate
+	if executionDate.IsZero() && opts.RetryTarget != nil {
+		executionDate, _ = stringutil.ParseTime(opts.RetryTarget.ExecutionDate)
+	}
+	if executionDate.IsZero() {
+		executionDate = scheduledTime
+	}
+	labels := make(map[string]string)
+	lineage := newLineage(opts, parentRequestID)
+	if opts.RetryTarget != nil {
+		for k, v := range opts.RetryTarget.Labels {
+			labels[k] = v
+		}
+	}
+	for k, v := range opts.Labels {
+		labels[k] = v
+	}
 	return &Agent{
 		requestID:    requestID,
 		dag:          dag,
 		dry:          opts.Dry,
 		retryTarget:  opts.RetryTarget,
+		retryStep:    opts.RetryStep,
 		logDir:       logDir,
 		logFile:      logFile,
 		client:       cli,
 		dagStore:     dagStore,
 		historyStore: historyStore,
+		notifiers:    opts.Notifiers,
+
+		mailQueueOpts: opts.MailQueue,
+		publicURL:     opts.PublicURL,
+		statsdOpts:    opts.StatsD,
+		tracingOpts:   opts.Tracing,
+		lockDir:       opts.LockDir,
+
+		statusIndexAddr: opts.StatusIndexAddr,
+
+		idempotencyKey:  idempotencyKey,
+		labels:          labels,
+		parentRequestID: parentRequestID,
+		lineage:         lineage,
+		scheduledTime:   scheduledTime,
+		executionDate:   executionDate,
+		skipSteps:       opts.SkipSteps,
+		from:            opts.From,
+		target:          opts.Target,
 	}
 }
 
+// newLineage returns the lineage of the run started with the options.
+func newLineage(opts Options, parentRequestID string) *model.Lineage {
+	if opts.RetryTarget != nil && opts.RetryStep != "" {
+		// The step is retried in the same run.
+		return opts.RetryTarget.Lineage
+	}
+	lineage := &model.Lineage{Trigger: opts.Trigger, ParentDAG: opts.ParentDAG, Schedule: opts.Schedule}
+	if opts.RetryTarget != nil {
+		lineage.Trigger = model.TriggerRetry
+		lineage.RetryOf = opts.RetryTarget.RequestID
+		if lineage.ParentDAG == "" && opts.RetryTarget.Lineage != nil {
+			lineage.ParentDAG = opts.RetryTarget.Lineage.ParentDAG
+		}
+	}
+	if lineage.Trigger == "" {
+		lineage.Trigger = model.TriggerManual
+		if parentRequestID != "" {
+			lineage.Trigger = model.TriggerParent
+		}
+	}
+	return lineage
+}
+
 // Run setups the scheduler and runs the DAG.
 func (a *Agent) Run(ctx context.Context) error {
+	// Report the panics and the errors of the run with its context.
+	ctx = sentry.WithTags(ctx, map[string]string{
+		sentry.TagDAG:       a.dag.Name,
+		sentry.TagRequestID: a.requestID,
+	})
 	if err := a.setup(ctx); err != nil {
+		sentry.CaptureError(ctx, fmt.Errorf("failed to setup the DAG run: %w", err), nil)
 		return err
 	}
+	if a.mailQueue != nil {
+		// Wait for the queued mails up to the flush timeout. The mails not
+		// sent by then are redelivered by the scheduler.
+		defer a.mailQueue.Close(ctx)
+	}
+	if a.statsd != nil {
+		defer func() {
+			_ = a.statsd.Close()
+		}()
+	}
 
-	// Create a new context for the DAG execution
+	// Create a new context for the DAG execution. The outputs of the commands
+	// in backticks are shared by the steps in the run.
+	ctx = cmdutil.WithSubstitution(ctx, a.dag.SubstitutionTimeout)
 	dbClient := newDBClient(a.historyStore, a.dagStore)
 	ctx = digraph.NewContext(ctx, a.dag, dbClient, a.requestID, a.logFile)
+	ctx = digraph.WithContext(ctx, digraph.GetContext(ctx).WithEnv(digraph.EnvKeyLabelsFile, a.labelsFile()))
+	ctx = digraph.WithContext(ctx, digraph.GetContext(ctx).WithEnv(digraph.EnvKeyScheduledTime, stringutil.FormatTime(a.scheduledTime)))
+	ctx = digraph.WithContext(ctx, digraph.GetContext(ctx).WithEnv(digraph.EnvKeyExecutionDate, stringutil.FormatTime(a.executionDate)))
+	if a.parentRequestID != "" {
+		ctx = digraph.WithContext(ctx, digraph.GetContext(ctx).WithEnv(digraph.EnvKeyParentRequestID, a.parentRequestID))
+	}
 
 	// It should not run the DAG if the condition is unmet.
 	if err := a.checkPreconditions(ctx); err != nil {
@@ -116,6 +355,15 @@ func (a *Agent) Run(ctx context.Context) error {
 		return err
 	}
 
+	// Mark the previous runs interrupted by a crash as failed.
+	if _, err := a.client.RecoverStaleRuns(ctx, a.dag); err != nil {
+		logger.Error(ctx, "Failed to recover the interrupted runs", "err", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(a.labelsFile()), 0755); err != nil {
+		return fmt.Errorf("failed to create labels directory: %w", err)
+	}
+
 	// Make a connection to the database.
 	// It should close the connection to the history database when the DAG
 	// execution is finished.
@@ -126,8 +374,11 @@ func (a *Agent) Run(ctx context.Context) error {
 		if err := a.historyStore.Close(ctx); err != nil {
 			logger.Error(ctx, "Failed to close history store", "err", err)
 		}
+		// Notify the server after the status file is compacted.
+		a.notifyStatusIndex(ctx)
 	}()
 
+	a.heartbeat.Store(time.Now().UnixNano())
 	if err := a.historyStore.Write(ctx, a.Status()); err != nil {
 		logger.Error(ctx, "Failed to write status", "err", err)
 	}
@@ -157,6 +408,48 @@ func (a *Agent) Run(ctx context.Context) error {
 		return fmt.Errorf("failed to start the unix socket server: %w", err)
 	}
 
+	// Start the service containers and remove them when finishing the DAG
+	// execution. The addresses of the services are set to the environment
+	// variables of the steps.
+	var startErr error
+	for _, svc := range a.dag.Services {
+		cfg, err := cmdutil.EvalStringFields(ctx, svc)
+		if err != nil {
+			startErr = fmt.Errorf("failed to evaluate service %s: %w", svc.Name, err)
+			break
+		}
+		logger.Info(ctx, "Starting service", "name", svc.Name, "image", cfg.Image)
+		c, err := executor.StartServiceContainer(ctx, cfg)
+		if err != nil {
+			startErr = fmt.Errorf("failed to start service %s: %w", svc.Name, err)
+			break
+		}
+		defer c.Remove(ctx)
+		dagCtx := digraph.GetContext(ctx)
+		for k, v := range c.Env() {
+			dagCtx = dagCtx.WithEnv(k, v)
+		}
+		ctx = digraph.WithContext(ctx, dagCtx)
+	}
+
+	// Start the container shared by the docker steps and remove it when
+	// finishing the DAG execution.
+	if a.dag.Container != nil && startErr == nil {
+		if c, err := a.startSharedContainer(ctx); err != nil {
+			startErr = err
+		} else {
+			defer c.Remove(ctx)
+			ctx = executor.WithSharedContainer(ctx, c)
+		}
+	}
+
+	// The run fails without running the steps if the containers failed to
+	// start, and the failure is recorded and reported like the failed runs.
+	if startErr != nil {
+		logger.Error(ctx, "Failed to start the containers of the run", "err", startErr)
+		a.scheduler.Abort(startErr)
+	}
+
 	// Setup channels to receive status updates for each node in the DAG.
 	// It should receive node instance when the node status changes, for
 	// example, when started, stopped, or cancelled, etc.
@@ -164,6 +457,7 @@ func (a *Agent) Run(ctx context.Context) error {
 	defer close(done)
 	go execWithRecovery(ctx, func() {
 		for node := range done {
+			a.loadLabels(ctx)
 			status := a.Status()
 			if err := a.historyStore.Write(ctx, status); err != nil {
 				logger.Error(ctx, "Failed to write status", "err", err)
@@ -184,17 +478,33 @@ func (a *Agent) Run(ctx context.Context) error {
 		if err := a.historyStore.Write(ctx, a.Status()); err != nil {
 			logger.Error(ctx, "Status write failed", "err", err)
 		}
+		a.notifyStatusIndex(ctx)
+	})
+
+	// Record the heartbeat and enforce the deadline while the DAG is running.
+	scheduleDone := make(chan struct{})
+	go execWithRecovery(ctx, func() {
+		a.beat(ctx, scheduleDone)
 	})
+	if a.dag.MaxRunDuration > 0 {
+		go execWithRecovery(ctx, func() {
+			a.enforceMaxRunDuration(ctx, scheduleDone)
+		})
+	}
 
 	// Start the DAG execution.
 	logger.Info(ctx, "DAG execution started", "reqId", a.requestID, "name", a.dag.Name, "params", a.dag.Params)
 	lastErr := a.scheduler.Schedule(ctx, a.graph, done)
+	close(scheduleDone)
 
 	// Update the finished status to the history database.
+	a.loadLabels(ctx)
 	finishedStatus := a.Status()
 	logger.Info(ctx, "DAG execution finished", "status", finishedStatus.Status)
 	if err := a.historyStore.Write(ctx, a.Status()); err != nil {
 		logger.Error(ctx, "Status write failed", "err", err)
+	} else {
+		a.removeLabelsFile(ctx)
 	}
 
 	// Send the execution report if necessary.
@@ -202,6 +512,17 @@ func (a *Agent) Run(ctx context.Context) error {
 	if err := a.reporter.send(ctx, a.dag, finishedStatus, lastErr); err != nil {
 		logger.Error(ctx, "Mail notification failed", "err", err)
 	}
+	if err := a.reporter.notify(ctx, a.dag, finishedStatus, lastErr, a.notifiers); err != nil {
+		logger.Error(ctx, "Notification failed", "err", err)
+	}
+	if err := a.reporter.callback(ctx, a.dag, finishedStatus, lastErr); err != nil {
+		logger.Error(ctx, "Callback failed", "err", err)
+	}
+	a.sendMetrics(ctx, finishedStatus)
+	a.exportTrace(ctx, finishedStatus)
+	if finishedStatus.Status == scheduler.StatusError {
+		a.captureError(ctx, finishedStatus, lastErr)
+	}
 
 	// Mark the agent finished.
 	a.finished.Store(true)
@@ -210,6 +531,31 @@ func (a *Agent) Run(ctx context.Context) error {
 	return lastErr
 }
 
+// startSharedContainer starts the container shared by the docker steps.
+func (a *Agent) startSharedContainer(ctx context.Context) (*executor.SharedContainer, error) {
+	cfg, err := cmdutil.EvalStringFields(ctx, *a.dag.Container)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate container config: %w", err)
+	}
+	logger.Info(ctx, "Starting container", "image", cfg.Image)
+	c, err := executor.StartSharedContainer(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start container: %w", err)
+	}
+	return c, nil
+}
+
+// notifyStatusIndex notifies the server that the status of the run has
+// changed. The server may not be running, so the failure is not an error.
+func (a *Agent) notifyStatusIndex(ctx context.Context) {
+	if a.statusIndexAddr == "" {
+		return
+	}
+	if err := statusindex.Notify(a.statusIndexAddr, a.dag.Location); err != nil {
+		logger.Debug(ctx, "Failed to notify the status index", "err", err)
+	}
+}
+
 func (a *Agent) PrintSummary(ctx context.Context) {
 	status := a.Status()
 	summary := a.reporter.getSummary(ctx, status, a.lastErr)
@@ -227,6 +573,10 @@ func (a *Agent) Status() model.Status {
 		// Match the status to the execution graph.
 		schedulerStatus = scheduler.StatusRunning
 	}
+	if schedulerStatus == scheduler.StatusSuccess && a.retryStep != "" && a.hasFailedNode() {
+		// The other steps of the run may still be failed.
+		schedulerStatus = scheduler.StatusError
+	}
 
 	// Create the status object to record the current status.
 	return model.NewStatusFactory(a.dag).
@@ -242,9 +592,96 @@ func (a *Agent) Status() model.Status {
 			model.WithOnSuccessNode(a.scheduler.HandlerNode(digraph.HandlerOnSuccess)),
 			model.WithOnFailureNode(a.scheduler.HandlerNode(digraph.HandlerOnFailure)),
 			model.WithOnCancelNode(a.scheduler.HandlerNode(digraph.HandlerOnCancel)),
+			model.WithOnTimeoutNode(a.scheduler.HandlerNode(digraph.HandlerOnTimeout)),
+			model.WithStages(a.scheduler.Stages(a.graph)),
+			model.WithArtifactDir(a.artifactDir()),
+			model.WithIdempotencyKey(a.idempotencyKey),
+			model.WithLabels(maps.Clone(a.labels)),
+			model.WithParentRequestID(a.parentRequestID),
+			model.WithLineage(a.lineage),
+			model.WithScheduledTime(a.scheduledTime),
+			model.WithExecutionDate(a.executionDate),
+			model.WithNotes(a.notes()),
+			model.WithHeartbeat(a.lastHeartbeat()),
 		)
 }
 
+// hasFailedNode returns true if any node in the graph has failed.
+func (a *Agent) hasFailedNode() bool {
+	for _, node := range a.graph.Nodes() {
+		if node.State().Status == scheduler.NodeStatusError {
+			return true
+		}
+	}
+	return false
+}
+
+// heartbeatInterval is the interval to record the heartbeat in the status.
+const heartbeatInterval = time.Second * 5
+
+// lastHeartbeat returns the time the agent was last alive.
+func (a *Agent) lastHeartbeat() time.Time {
+	if t := a.heartbeat.Load(); t != 0 {
+		return time.Unix(0, t)
+	}
+	return time.Time{}
+}
+
+// beat records the heartbeat in the status periodically until the done
+// channel is closed.
+func (a *Agent) beat(ctx context.Context, done <-chan struct{}) {
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if a.finished.Load() {
+				return
+			}
+			a.heartbeat.Store(time.Now().UnixNano())
+			if err := a.historyStore.Write(ctx, a.Status()); err != nil {
+				logger.Error(ctx, "Failed to write heartbeat", "err", err)
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// notes returns the notes attached to the run. A retry keeps the notes of
+// the original run.
+func (a *Agent) notes() []model.Note {
+	var notes []model.Note
+	if a.retryTarget != nil {
+		notes = append(notes, a.retryTarget.Notes...)
+	}
+	if a.deadlineExceeded.Load() {
+		notes = append(notes, a.deadlineNote)
+	}
+	return notes
+}
+
+// enforceMaxRunDuration stops the run when it exceeds the max run duration
+// of the DAG in the same way as the stop request.
+func (a *Agent) enforceMaxRunDuration(ctx context.Context, done <-chan struct{}) {
+	timer := time.NewTimer(a.dag.MaxRunDuration)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		return
+	case <-timer.C:
+	}
+
+	logger.Warn(ctx, "Run exceeded the max run duration", "maxRunDuration", a.dag.MaxRunDuration)
+	a.deadlineNote = model.NewNote(
+		fmt.Sprintf("Run was stopped after exceeding the max run duration (%s)", a.dag.MaxRunDuration), time.Now(),
+	)
+	a.deadlineExceeded.Store(true)
+	a.signal(ctx, syscall.SIGTERM, true)
+}
+
 // Signal sends the signal to the processes running
 func (a *Agent) Signal(ctx context.Context, sig os.Signal) {
 	a.signal(ctx, sig, false)
@@ -257,6 +694,8 @@ const waitForRunning = time.Millisecond * 100
 var (
 	statusRe = regexp.MustCompile(`^/status[/]?$`)
 	stopRe   = regexp.MustCompile(`^/stop[/]?$`)
+	skipRe   = regexp.MustCompile(`^/steps/(.+)/skip[/]?$`)
+	signalRe = regexp.MustCompile(`^/steps/(.+)/signal[/]?$`)
 )
 
 // HandleHTTP handles HTTP requests via unix socket.
@@ -277,12 +716,39 @@ func (a *Agent) HandleHTTP(ctx context.Context) sock.HTTPHandlerFunc {
 			_, _ = w.Write(statusJSON)
 		case r.Method == http.MethodPost && stopRe.MatchString(r.URL.Path):
 			// Handle Stop request for the DAG execution.
+			w.Header().Set("content-type", "text/plain")
+			result := a.requestStop(r.URL.Query().Get("requestId"))
+			if result == client.StopNotRunning {
+				// Another run of the DAG is running.
+				w.WriteHeader(http.StatusConflict)
+			} else {
+				w.WriteHeader(http.StatusOK)
+			}
+			_, _ = w.Write([]byte(result))
+			if result == client.StopSignalled {
+				go func() {
+					logger.Info(ctx, "Stop request received")
+					a.signal(ctx, syscall.SIGTERM, true)
+				}()
+			}
+		case r.Method == http.MethodPost && skipRe.MatchString(r.URL.Path):
+			// Skip the step which has not started yet.
+			step := skipRe.FindStringSubmatch(r.URL.Path)[1]
+			if err := a.skipStep(ctx, step); err != nil {
+				encodeError(w, err)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("OK"))
+		case r.Method == http.MethodPost && signalRe.MatchString(r.URL.Path):
+			// Send the signal to the running step.
+			step := signalRe.FindStringSubmatch(r.URL.Path)[1]
+			if err := a.signalStep(ctx, step, r.URL.Query().Get("signal")); err != nil {
+				encodeError(w, err)
+				return
+			}
 			w.WriteHeader(http.StatusOK)
 			_, _ = w.Write([]byte("OK"))
-			go func() {
-				logger.Info(ctx, "Stop request received")
-				a.signal(ctx, syscall.SIGTERM, true)
-			}()
 		default:
 			// Unknown request
 			encodeError(
@@ -292,6 +758,57 @@ func (a *Agent) HandleHTTP(ctx context.Context) sock.HTTPHandlerFunc {
 	}
 }
 
+// signalStep sends the signal to the process of the running step.
+func (a *Agent) signalStep(ctx context.Context, step, signal string) error {
+	sig := unix.SignalNum(signal)
+	if sig == 0 {
+		return &httpError{Code: http.StatusBadRequest, Message: fmt.Sprintf("invalid signal: %s", signal)}
+	}
+	err := a.graph.SignalStep(ctx, step, sig)
+	switch {
+	case errors.Is(err, scheduler.ErrStepNotFound):
+		return &httpError{Code: http.StatusNotFound, Message: err.Error()}
+	case errors.Is(err, scheduler.ErrStepNotRunning):
+		return &httpError{Code: http.StatusConflict, Message: err.Error()}
+	case err != nil:
+		return &httpError{Code: http.StatusInternalServerError, Message: err.Error()}
+	}
+	logger.Info(ctx, "Signal request received", "step", step, "signal", signal)
+	return nil
+}
+
+// requestStop records the stop request for the run with the request ID. An
+// empty request ID is for the run, whichever it is. The run is signalled
+// only for the first request.
+func (a *Agent) requestStop(requestID string) client.StopResult {
+	if requestID != "" && requestID != a.requestID {
+		return client.StopNotRunning
+	}
+	if a.stopRequested.Swap(true) {
+		return client.StopAlreadyStopped
+	}
+	return client.StopSignalled
+}
+
+// skipStep marks the step to be skipped in the running DAG and records it
+// in the status.
+func (a *Agent) skipStep(ctx context.Context, step string) error {
+	err := a.graph.RequestSkip(step)
+	switch {
+	case errors.Is(err, scheduler.ErrStepNotFound):
+		return &httpError{Code: http.StatusNotFound, Message: err.Error()}
+	case errors.Is(err, scheduler.ErrStepAlreadyStarted):
+		return &httpError{Code: http.StatusConflict, Message: err.Error()}
+	case err != nil:
+		return &httpError{Code: http.StatusInternalServerError, Message: err.Error()}
+	}
+	logger.Info(ctx, "Skip request received", "step", step)
+	if err := a.historyStore.Write(ctx, a.Status()); err != nil {
+		logger.Error(ctx, "Failed to write status", "err", err)
+	}
+	return nil
+}
+
 // setup the agent instance for DAG execution.
 func (a *Agent) setup(ctx context.Context) error {
 	// Lock to prevent race condition.
@@ -299,15 +816,164 @@ func (a *Agent) setup(ctx context.Context) error {
 	defer a.lock.Unlock()
 
 	a.scheduler = a.newScheduler()
-	mailer := mailer.New(mailer.Config{
-		Host:     a.dag.SMTP.Host,
-		Port:     a.dag.SMTP.Port,
-		Username: a.dag.SMTP.Username,
-		Password: a.dag.SMTP.Password,
-	})
-	a.reporter = newReporter(mailer)
+	mailerConfig, err := cmdutil.EvalStringFields(ctx, a.dag.SMTP.MailerConfig())
+	if err != nil {
+		return fmt.Errorf("failed to evaluate SMTP config: %w", err)
+	}
+	if a.mailQueueOpts != nil {
+		a.mailQueue = mailer.NewQueue(ctx, mailerConfig, *a.mailQueueOpts)
+		a.reporter = newReporter(a.mailQueue)
+	} else {
+		a.reporter = newReporter(mailer.New(mailerConfig))
+	}
+	if a.statsdOpts != nil {
+		// The DAG runs without the metrics if the client fails.
+		client, err := statsd.New(*a.statsdOpts)
+		if err != nil {
+			logger.Error(ctx, "Failed to create the StatsD client", "err", err)
+		} else {
+			a.statsd = client
+		}
+	}
+	if a.tracingOpts != nil {
+		exporter, err := tracing.NewExporter(*a.tracingOpts)
+		if err != nil {
+			logger.Error(ctx, "Failed to create the trace exporter", "err", err)
+		} else {
+			a.tracer = exporter
+		}
+	}
+
+	if err := a.setupGraph(ctx); err != nil {
+		return err
+	}
+	for _, step := range a.skipSteps {
+		if err := a.graph.RequestSkip(step); err != nil {
+			return fmt.Errorf("failed to skip the step: %w", err)
+		}
+	}
+	if a.from != "" {
+		if err := a.graph.StartFrom(a.from); err != nil {
+			return fmt.Errorf("failed to start from the step: %w", err)
+		}
+	}
+	if a.target != "" {
+		if err := a.graph.Target(a.target); err != nil {
+			return fmt.Errorf("failed to set the target step: %w", err)
+		}
+	}
+	return nil
+}
+
+// sendMetrics pushes the count and the duration of the run and of the
+// steps executed in it to StatsD.
+func (a *Agent) sendMetrics(ctx context.Context, status model.Status) {
+	if a.statsd == nil {
+		return
+	}
+	runStatus := status.Status.String()
+	dagTag := statsd.Tag{Name: statsd.TagDAG, Value: a.dag.Name}
+	statusTag := statsd.Tag{Name: statsd.TagStatus, Value: runStatus}
+	errs := []error{
+		a.statsd.Count("run.finished", 1, dagTag, statusTag),
+		a.statsd.Timing("run.duration", a.graph.Duration(), dagTag, statusTag),
+	}
+
+	startedAt := a.graph.StartAt()
+	for _, node := range a.graph.Nodes() {
+		state := node.State()
+		if state.StartedAt.IsZero() || state.StartedAt.Before(startedAt) {
+			// The step didn't run or ran in the retried run.
+			continue
+		}
+		tags := []statsd.Tag{
+			dagTag,
+			{Name: statsd.TagStatus, Value: state.Status.String()},
+			{Name: statsd.TagStep, Value: node.Data().Step.Name},
+		}
+		errs = append(errs, a.statsd.Count("step.finished", 1, tags...))
+		if !state.FinishedAt.IsZero() {
+			errs = append(errs, a.statsd.Timing("step.duration", state.FinishedAt.Sub(state.StartedAt), tags...))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		logger.Warn(ctx, "Failed to send the metrics to StatsD", "err", err)
+	}
+}
+
+// exportTrace sends the trace of the run to the APM service. The run is the
+// root span and the steps executed in it are the children.
+func (a *Agent) exportTrace(ctx context.Context, status model.Status) {
+	if a.tracer == nil {
+		return
+	}
+	traceID := tracing.NewID()
+	root := tracing.Span{
+		TraceID:  traceID,
+		SpanID:   tracing.NewID(),
+		Name:     "dag.run",
+		Resource: a.dag.Name,
+		Start:    a.graph.StartAt(),
+		Duration: a.graph.Duration(),
+		Error:    status.Status == scheduler.StatusError,
+		Attributes: map[string]string{
+			"dag":        a.dag.Name,
+			"request_id": a.requestID,
+			"status":     status.Status.String(),
+		},
+	}
+	spans := []tracing.Span{root}
+
+	for _, node := range a.graph.Nodes() {
+		state := node.State()
+		if state.StartedAt.IsZero() || state.StartedAt.Before(root.Start) {
+			// The step didn't run or ran in the retried run.
+			continue
+		}
+		span := tracing.Span{
+			TraceID:  traceID,
+			SpanID:   tracing.NewID(),
+			ParentID: root.SpanID,
+			Name:     "dag.step",
+			Resource: node.Data().Step.Name,
+			Start:    state.StartedAt,
+			Error:    state.Status == scheduler.NodeStatusError,
+			Attributes: map[string]string{
+				"dag":       a.dag.Name,
+				"step":      node.Data().Step.Name,
+				"status":    state.Status.String(),
+				"exit_code": strconv.Itoa(state.ExitCode),
+			},
+		}
+		if !state.FinishedAt.IsZero() {
+			span.Duration = state.FinishedAt.Sub(state.StartedAt)
+		}
+		if state.Error != nil {
+			span.Attributes["error.message"] = state.Error.Error()
+		}
+		spans = append(spans, span)
+	}
 
-	return a.setupGraph(ctx)
+	if err := a.tracer.Export(ctx, spans); err != nil {
+		logger.Warn(ctx, "Failed to export the trace", "err", err)
+	}
+}
+
+// captureError reports the failure of the run to Sentry with the names of
+// the failed steps.
+func (a *Agent) captureError(ctx context.Context, status model.Status, err error) {
+	var failedSteps []string
+	for _, node := range status.Nodes {
+		if node.Status == scheduler.NodeStatusError {
+			failedSteps = append(failedSteps, node.Step.Name)
+		}
+	}
+	if err == nil {
+		err = errors.New("the DAG run failed")
+	}
+	sentry.CaptureError(ctx, err, map[string]string{
+		sentry.TagStep: strings.Join(failedSteps, ","),
+	})
 }
 
 // newScheduler creates a scheduler instance for the DAG execution.
@@ -319,6 +985,17 @@ func (a *Agent) newScheduler() *scheduler.Scheduler {
 		Delay:         a.dag.Delay,
 		Dry:           a.dry,
 		ReqID:         a.requestID,
+		ArtifactDir:   a.artifactDir(),
+		CacheDir:      filepath.Join(a.logDir, "cache"),
+		LockDir:       a.lockDir,
+
+		MaxFailedSteps:        a.dag.MaxFailedSteps,
+		MaxFailedStepsPercent: a.dag.MaxFailedStepsPercent,
+		Stages:                a.dag.Stages,
+		Hooks:                 a.dag.Hooks,
+		DAGName:               a.dag.Name,
+		Params:                a.dag.Params,
+		PublicURL:             a.publicURL,
 	}
 
 	if a.dag.HandlerOn.Exit != nil {
@@ -337,9 +1014,59 @@ func (a *Agent) newScheduler() *scheduler.Scheduler {
 		cfg.OnCancel = a.dag.HandlerOn.Cancel
 	}
 
+	if a.dag.HandlerOn.Timeout != nil {
+		cfg.OnTimeout = a.dag.HandlerOn.Timeout
+	}
+
 	return scheduler.New(cfg)
 }
 
+// artifactDir returns the directory where the artifacts of the run are
+// staged. A retry keeps using the directory of the original run so that
+// the artifacts produced before the retry are still available.
+func (a *Agent) artifactDir() string {
+	if a.retryTarget != nil && a.retryTarget.ArtifactDir != "" {
+		return a.retryTarget.ArtifactDir
+	}
+	return filepath.Join(a.logDir, "artifacts", a.requestID)
+}
+
+// labelsFile returns the file where steps can write labels of the run,
+// one "key=value" per line.
+func (a *Agent) labelsFile() string {
+	return filepath.Join(a.logDir, "labels", a.requestID+".txt")
+}
+
+// loadLabels merges the labels written by the steps into the labels of the
+// run. It's called when the status of a step changes so that the labels
+// file is not read for every status.
+func (a *Agent) loadLabels(ctx context.Context) {
+	f, err := os.Open(a.labelsFile())
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	written, err := model.ReadLabels(f)
+	if err != nil {
+		logger.Warn(ctx, "Failed to read labels", "file", a.labelsFile(), "err", err)
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	for k, v := range written {
+		a.labels[k] = v
+	}
+}
+
+// removeLabelsFile removes the labels file after the labels are recorded in
+// the final status of the run.
+func (a *Agent) removeLabelsFile(ctx context.Context) {
+	if err := os.Remove(a.labelsFile()); err != nil && !os.IsNotExist(err) {
+		logger.Warn(ctx, "Failed to remove labels file", "file", a.labelsFile(), "err", err)
+	}
+}
+
 // dryRun performs a dry-run of the DAG. It only simulates the execution of
 // the DAG without running the actual command.
 func (a *Agent) dryRun(ctx context.Context) error {
@@ -428,7 +1155,15 @@ func (a *Agent) setupGraphForRetry(ctx context.Context) error {
 	for _, n := range a.retryTarget.Nodes {
 		nodes = append(nodes, n.ToNode())
 	}
-	graph, err := scheduler.CreateRetryExecutionGraph(ctx, nodes...)
+	var (
+		graph *scheduler.ExecutionGraph
+		err   error
+	)
+	if a.retryStep != "" {
+		graph, err = scheduler.CreateStepRetryExecutionGraph(ctx, a.retryStep, nodes...)
+	} else {
+		graph, err = scheduler.CreateRetryExecutionGraph(ctx, nodes...)
+	}
 	if err != nil {
 		return err
 	}
@@ -443,9 +1178,40 @@ func (a *Agent) setupDatabase(ctx context.Context) error {
 		logger.Error(ctx, "History data cleanup failed", "err", err)
 	}
 
+	if a.retryStep != "" {
+		// Update the status of the original run instead of creating a new
+		// history.
+		a.historyStore = &updateStore{
+			HistoryStore: a.historyStore,
+			key:          a.dag.Location,
+			requestID:    a.requestID,
+		}
+		return nil
+	}
+
 	return a.historyStore.Open(ctx, a.dag.Location, time.Now(), a.requestID)
 }
 
+// updateStore is a history store that writes the status to the existing
+// history of the request ID.
+type updateStore struct {
+	persistence.HistoryStore
+	key       string
+	requestID string
+}
+
+func (s *updateStore) Open(_ context.Context, _ string, _ time.Time, _ string) error {
+	return nil
+}
+
+func (s *updateStore) Write(ctx context.Context, status model.Status) error {
+	return s.HistoryStore.Update(ctx, s.key, s.requestID, status)
+}
+
+func (s *updateStore) Close(_ context.Context) error {
+	return nil
+}
+
 // setupSocketServer create socket server instance.
 func (a *Agent) setupSocketServer(ctx context.Context) error {
 	socketServer, err := sock.NewServer(a.dag.SockAddr(), a.HandleHTTP(ctx))
@@ -490,8 +1256,9 @@ func execWithRecovery(ctx context.Context, fn func()) {
 			if !ok {
 				err = fmt.Errorf("panic: %v", panicObj)
 			}
-			st := string(debug.Stack())
-			logger.Error(ctx, "Panic occurred", "err", err, "st", st)
+			st := debug.Stack()
+			logger.Error(ctx, "Panic occurred", "err", err, "st", string(st))
+			sentry.CapturePanic(ctx, panicObj, st, nil)
 		}
 	}()
 
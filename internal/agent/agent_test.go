@@ -1,16 +1,27 @@
 package agent_test
 
 import (
+	"encoding/json"
+	"io"
+	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/dagu-org/dagu/internal/agent"
 	"github.com/dagu-org/dagu/internal/test"
 
+	"github.com/dagu-org/dagu/internal/client"
 	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/dagu-org/dagu/internal/digraph/scheduler"
 	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/dagu-org/dagu/internal/sentry"
+	"github.com/dagu-org/dagu/internal/statsd"
+	"github.com/dagu-org/dagu/internal/tracing"
 	"github.com/stretchr/testify/require"
 )
 
@@ -101,6 +112,18 @@ func TestAgent_Run(t *testing.T) {
 		// Check if the status is saved correctly
 		require.Equal(t, scheduler.StatusError, dagAgent.Status().Status)
 	})
+	t.Run("MaxRunDuration", func(t *testing.T) {
+		th := test.Setup(t)
+		dag := th.LoadDAGFile(t, "max_run_duration.yaml")
+		dagAgent := dag.Agent()
+		dagAgent.RunCancel(t)
+
+		// The run is stopped and the reason is recorded in the status.
+		status := dagAgent.Status()
+		require.Equal(t, scheduler.StatusCancel, status.Status)
+		require.Len(t, status.Notes, 1)
+		require.Contains(t, status.Notes[0].Text, "max run duration (1s)")
+	})
 	t.Run("ReceiveSignal", func(t *testing.T) {
 		th := test.Setup(t)
 		dag := th.LoadDAGFile(t, "sleep.yaml")
@@ -119,6 +142,131 @@ func TestAgent_Run(t *testing.T) {
 		// wait for the DAG to be canceled
 		dag.AssertLatestStatus(t, scheduler.StatusCancel)
 	})
+	t.Run("Heartbeat", func(t *testing.T) {
+		th := test.Setup(t)
+		dag := th.LoadDAGFile(t, "run.yaml")
+		dagAgent := dag.Agent()
+		dagAgent.RunSuccess(t)
+
+		// The heartbeat is recorded in the status.
+		status := dagAgent.Status()
+		require.NotEmpty(t, status.Heartbeat)
+	})
+	t.Run("Labels", func(t *testing.T) {
+		th := test.Setup(t)
+		dag := th.LoadDAGFile(t, "labels.yaml")
+		dagAgent := dag.Agent(test.WithAgentOptions(agent.Options{
+			Labels: map[string]string{"customer": "acme"},
+		}))
+		dagAgent.RunSuccess(t)
+
+		// Labels given at the start are merged with the ones written by steps.
+		status := dagAgent.Status()
+		require.Equal(t, map[string]string{"customer": "acme", "region": "us"}, status.Labels)
+
+		// The labels file is removed once the labels are recorded.
+		files, err := filepath.Glob(filepath.Join(th.Config.Paths.LogDir, "labels", "*"))
+		require.NoError(t, err)
+		require.Empty(t, files)
+	})
+	t.Run("ParentRequestID", func(t *testing.T) {
+		th := test.Setup(t)
+		dag := th.LoadDAGFile(t, "parent_request_id.yaml")
+		dagAgent := dag.Agent(test.WithAgentOptions(agent.Options{
+			ParentRequestID: "parent-request-id",
+			ParentDAG:       "parent",
+		}))
+		dagAgent.RunSuccess(t)
+
+		// The parent request ID is recorded and exposed to the steps.
+		status := dagAgent.Status()
+		require.Equal(t, "parent-request-id", status.ParentRequestID)
+		require.Equal(t, "parent-request-id", status.Nodes[0].Step.OutputVariables.Variables()["PARENT_REQUEST_ID"])
+		require.Equal(t, &model.Lineage{Trigger: model.TriggerParent, ParentDAG: "parent"}, status.Lineage)
+	})
+	t.Run("ScheduledTime", func(t *testing.T) {
+		th := test.Setup(t)
+		dag := th.LoadDAGFile(t, "scheduled_time.yaml")
+		scheduledTime := time.Date(2024, 10, 1, 22, 30, 0, 0, time.Local)
+		dagAgent := dag.Agent(test.WithAgentOptions(agent.Options{
+			Trigger:       model.TriggerSchedule,
+			Schedule:      "30 22 * * *",
+			ScheduledTime: scheduledTime,
+		}))
+		dagAgent.RunSuccess(t)
+
+		// The scheduled time is recorded and exposed to the steps.
+		status := dagAgent.Status()
+		expected := scheduledTime.Format(time.RFC3339)
+		require.Equal(t, expected, status.ScheduledTime)
+		require.Equal(t, expected, status.Nodes[0].Step.OutputVariables.Variables()["SCHEDULED_TIME"])
+
+		// The execution date defaults to the scheduled time.
+		require.Equal(t, expected, status.ExecutionDate)
+		require.Equal(t, expected, status.Nodes[1].Step.OutputVariables.Variables()["EXECUTION_DATE"])
+
+		// The schedule that started the run is recorded in the lineage.
+		require.Equal(t, &model.Lineage{Trigger: model.TriggerSchedule, Schedule: "30 22 * * *"}, status.Lineage)
+	})
+	t.Run("ExecutionDate", func(t *testing.T) {
+		th := test.Setup(t)
+		dag := th.LoadDAGFile(t, "scheduled_time.yaml")
+		executionDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+		dagAgent := dag.Agent(test.WithAgentOptions(agent.Options{
+			ExecutionDate: executionDate,
+		}))
+		dagAgent.RunSuccess(t)
+
+		// The execution date is distinct from the scheduled time.
+		status := dagAgent.Status()
+		expected := executionDate.Format(time.RFC3339)
+		require.Equal(t, expected, status.ExecutionDate)
+		require.NotEqual(t, expected, status.ScheduledTime)
+		require.Equal(t, expected, status.Nodes[1].Step.OutputVariables.Variables()["EXECUTION_DATE"])
+	})
+	t.Run("SkipSteps", func(t *testing.T) {
+		th := test.Setup(t)
+		dag := th.LoadDAGFile(t, "skip_steps.yaml")
+		dagAgent := dag.Agent(test.WithAgentOptions(agent.Options{
+			SkipSteps: []string{"1"},
+		}))
+		dagAgent.RunSuccess(t)
+
+		// The skipped step doesn't stop the steps after it.
+		status := dagAgent.Status()
+		require.Equal(t, scheduler.NodeStatusSkipped, status.Nodes[0].Status)
+		require.True(t, status.Nodes[0].SkipRequested)
+		require.Equal(t, scheduler.NodeStatusSuccess, status.Nodes[1].Status)
+	})
+	t.Run("From", func(t *testing.T) {
+		th := test.Setup(t)
+		dag := th.LoadDAGFile(t, "from.yaml")
+		dagAgent := dag.Agent(test.WithAgentOptions(agent.Options{
+			From: "3",
+		}))
+		dagAgent.RunSuccess(t)
+
+		// The steps before the step to start from are skipped.
+		status := dagAgent.Status()
+		require.Equal(t, scheduler.NodeStatusSkipped, status.Nodes[0].Status)
+		require.Equal(t, scheduler.NodeStatusSkipped, status.Nodes[1].Status)
+		require.Equal(t, scheduler.NodeStatusSuccess, status.Nodes[2].Status)
+	})
+	t.Run("Target", func(t *testing.T) {
+		th := test.Setup(t)
+		dag := th.LoadDAGFile(t, "target.yaml")
+		dagAgent := dag.Agent(test.WithAgentOptions(agent.Options{
+			Target: "2",
+		}))
+		dagAgent.RunSuccess(t)
+
+		// Only the target step and its dependencies run.
+		status := dagAgent.Status()
+		require.Equal(t, scheduler.NodeStatusSuccess, status.Nodes[0].Status)
+		require.Equal(t, scheduler.NodeStatusSuccess, status.Nodes[1].Status)
+		require.Equal(t, scheduler.NodeStatusSkipped, status.Nodes[2].Status)
+		require.Equal(t, scheduler.NodeStatusSkipped, status.Nodes[3].Status)
+	})
 	t.Run("ExitHandler", func(t *testing.T) {
 		th := test.Setup(t)
 		dag := th.LoadDAGFile(t, "on_exit.yaml")
@@ -135,6 +283,123 @@ func TestAgent_Run(t *testing.T) {
 		// Check if the exit handler is executed
 		require.Equal(t, scheduler.NodeStatusSuccess.String(), status.OnExit.Status.String())
 	})
+	t.Run("ServiceFailure", func(t *testing.T) {
+		th := test.Setup(t)
+		dag := th.LoadDAGFile(t, "service_failure.yaml")
+		dagAgent := dag.Agent()
+		dagAgent.RunError(t)
+
+		// The failure is recorded and handled without running the steps.
+		dag.AssertLatestStatus(t, scheduler.StatusError)
+		status := dagAgent.Status()
+		require.Equal(t, scheduler.NodeStatusSkipped, status.Nodes[0].Status)
+		require.Equal(t, scheduler.NodeStatusSuccess, status.OnFailure.Status)
+		require.Equal(t, scheduler.NodeStatusSuccess, status.OnExit.Status)
+	})
+	t.Run("ContainerFailure", func(t *testing.T) {
+		th := test.Setup(t)
+		dag := th.LoadDAGFile(t, "container_failure.yaml")
+		dagAgent := dag.Agent()
+		dagAgent.RunError(t)
+
+		// The failure is recorded and handled without running the steps.
+		dag.AssertLatestStatus(t, scheduler.StatusError)
+		status := dagAgent.Status()
+		require.Equal(t, scheduler.NodeStatusSkipped, status.Nodes[0].Status)
+		require.Equal(t, scheduler.NodeStatusSuccess, status.OnFailure.Status)
+		require.Equal(t, scheduler.NodeStatusSuccess, status.OnExit.Status)
+	})
+	t.Run("StatsD", func(t *testing.T) {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer conn.Close()
+
+		th := test.Setup(t)
+		dag := th.LoadDAGFile(t, "multiple_steps.yaml")
+		dagAgent := dag.Agent(test.WithAgentOptions(agent.Options{
+			StatsD: &statsd.Options{
+				Addr:    conn.LocalAddr().String(),
+				Prefix:  "dagu.",
+				Tags:    []string{statsd.TagStatus, statsd.TagStep},
+				Datadog: true,
+			},
+		}))
+		dagAgent.RunSuccess(t)
+
+		// The run and each step send a counter and a timing.
+		var metrics []string
+		buf := make([]byte, 1024)
+		for len(metrics) < 6 {
+			require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+			n, _, err := conn.ReadFrom(buf)
+			require.NoError(t, err)
+			metrics = append(metrics, string(buf[:n]))
+		}
+		require.Contains(t, metrics, "dagu.run.finished:1|c|#status:finished")
+		require.Contains(t, metrics, "dagu.step.finished:1|c|#status:finished,step:1")
+		require.Contains(t, metrics, "dagu.step.finished:1|c|#status:finished,step:2")
+	})
+	t.Run("Tracing", func(t *testing.T) {
+		bodies := make(chan []byte, 1)
+		srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			bodies <- body
+		}))
+		defer srv.Close()
+
+		th := test.Setup(t)
+		dag := th.LoadDAGFile(t, "multiple_steps.yaml")
+		dagAgent := dag.Agent(test.WithAgentOptions(agent.Options{
+			Tracing: &tracing.Options{Exporter: tracing.ExporterDatadog, Endpoint: srv.URL},
+		}))
+		dagAgent.RunSuccess(t)
+
+		// The run is the root span and the steps are its children.
+		var traces [][]struct {
+			TraceID  uint64 `json:"trace_id"`
+			SpanID   uint64 `json:"span_id"`
+			ParentID uint64 `json:"parent_id"`
+			Resource string `json:"resource"`
+		}
+		require.NoError(t, json.Unmarshal(<-bodies, &traces))
+		require.Len(t, traces, 1)
+		require.Len(t, traces[0], 3)
+		root := traces[0][0]
+		require.Equal(t, dag.Name, root.Resource)
+		for _, span := range traces[0][1:] {
+			require.Equal(t, root.TraceID, span.TraceID)
+			require.Equal(t, root.SpanID, span.ParentID)
+		}
+		require.Equal(t, "1", traces[0][1].Resource)
+		require.Equal(t, "2", traces[0][2].Resource)
+	})
+	t.Run("Sentry", func(t *testing.T) {
+		bodies := make(chan []byte, 1)
+		srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			bodies <- body
+		}))
+		defer srv.Close()
+		client, err := sentry.New(sentry.Options{DSN: strings.Replace(srv.URL, "http://", "http://key@", 1) + "/1"})
+		require.NoError(t, err)
+
+		th := test.Setup(t)
+		th.Context = sentry.WithClient(th.Context, client)
+		dag := th.LoadDAGFile(t, "error.yaml")
+		dagAgent := dag.Agent()
+		dagAgent.RunError(t)
+
+		// The failure is reported with the run context.
+		lines := strings.Split(strings.TrimSpace(string(<-bodies)), "\n")
+		require.Len(t, lines, 3)
+		var event struct {
+			Tags map[string]string `json:"tags"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(lines[2]), &event))
+		require.Equal(t, dag.Name, event.Tags[sentry.TagDAG])
+		require.Equal(t, dagAgent.Status().RequestID, event.Tags[sentry.TagRequestID])
+		require.Equal(t, "1", event.Tags[sentry.TagStep])
+	})
 }
 
 func TestAgent_DryRun(t *testing.T) {
@@ -177,6 +442,9 @@ func TestAgent_Retry(t *testing.T) {
 		}))
 		dagAgent.RunSuccess(t)
 
+		// The retry is linked to the run it retries.
+		require.Equal(t, &model.Lineage{Trigger: model.TriggerRetry, RetryOf: status.RequestID}, dagAgent.Status().Lineage)
+
 		for _, node := range dagAgent.Status().Nodes {
 			if node.Status != scheduler.NodeStatusSuccess &&
 				node.Status != scheduler.NodeStatusSkipped {
@@ -184,6 +452,35 @@ func TestAgent_Retry(t *testing.T) {
 			}
 		}
 	})
+	t.Run("RetryStep", func(t *testing.T) {
+		th := test.Setup(t)
+		dag := th.LoadDAGFile(t, "retry.yaml")
+		dagAgent := dag.Agent()
+
+		dagAgent.RunError(t)
+
+		// Make the failed step "2" successful and retry only the step
+		status := dagAgent.Status()
+		for i := range status.Nodes {
+			status.Nodes[i].Step.CmdArgsSys = "true"
+		}
+		dagAgent = dag.Agent(test.WithAgentOptions(agent.Options{
+			RetryTarget: &status,
+			RetryStep:   "2",
+		}))
+		require.NoError(t, dagAgent.Run(th.Context))
+
+		// The status of the original run is updated
+		statusFile, err := th.HistoryStore.FindByRequestID(th.Context, dag.Location, status.RequestID)
+		require.NoError(t, err)
+		require.Equal(t, scheduler.NodeStatusSuccess, statusFile.Status.Nodes[1].Status)
+		// The other failed steps are not executed again
+		require.Equal(t, scheduler.NodeStatusError, statusFile.Status.Nodes[4].Status)
+		require.Equal(t, scheduler.StatusError, statusFile.Status.Status)
+
+		recent := th.HistoryStore.ReadStatusRecent(th.Context, dag.Location, 10)
+		require.Len(t, recent, 1)
+	})
 }
 
 func TestAgent_HandleHTTP(t *testing.T) {
@@ -261,19 +558,112 @@ func TestAgent_HandleHTTP(t *testing.T) {
 		// Wait for the DAG to start
 		dag.AssertLatestStatus(t, scheduler.StatusRunning)
 
+		stop := func(rawQuery string) mockResponseWriter {
+			var w mockResponseWriter
+			dagAgent.HandleHTTP(th.Context)(&w, &http.Request{
+				Method: "POST",
+				URL:    &url.URL{Path: "/stop", RawQuery: rawQuery},
+			})
+			return w
+		}
+
+		// The request for another run is rejected.
+		resp := stop("requestId=another-run")
+		require.Equal(t, http.StatusConflict, resp.status)
+		require.Equal(t, string(client.StopNotRunning), resp.body)
+
 		// Cancel the DAG
-		var mockResponseWriter = mockResponseWriter{}
-		dagAgent.HandleHTTP(th.Context)(&mockResponseWriter, &http.Request{
-			Method: "POST",
-			URL:    &url.URL{Path: "/stop"},
-		})
-		require.Equal(t, http.StatusOK, mockResponseWriter.status)
-		require.Equal(t, "OK", mockResponseWriter.body)
+		resp = stop("")
+		require.Equal(t, http.StatusOK, resp.status)
+		require.Equal(t, string(client.StopSignalled), resp.body)
+
+		// The second request doesn't signal the run again.
+		resp = stop("")
+		require.Equal(t, http.StatusOK, resp.status)
+		require.Equal(t, string(client.StopAlreadyStopped), resp.body)
 
 		// Wait for the DAG to stop
 		<-done
 		dag.AssertLatestStatus(t, scheduler.StatusCancel)
 	})
+	t.Run("HTTP_HandleSkip", func(t *testing.T) {
+		th := test.Setup(t)
+
+		dag := th.LoadDAGFile(t, "handle_http_skip.yaml")
+		dagAgent := dag.Agent()
+
+		done := make(chan struct{})
+		go func() {
+			dagAgent.RunSuccess(t)
+			close(done)
+		}()
+
+		// Wait for the DAG to start
+		dag.AssertLatestStatus(t, scheduler.StatusRunning)
+
+		// Skip the step which has not started
+		var skipResponseWriter = mockResponseWriter{}
+		dagAgent.HandleHTTP(th.Context)(&skipResponseWriter, &http.Request{
+			Method: "POST",
+			URL:    &url.URL{Path: "/steps/2/skip"},
+		})
+		require.Equal(t, http.StatusOK, skipResponseWriter.status)
+		require.Equal(t, "OK", skipResponseWriter.body)
+
+		// The running step can't be skipped
+		var conflictResponseWriter = mockResponseWriter{}
+		dagAgent.HandleHTTP(th.Context)(&conflictResponseWriter, &http.Request{
+			Method: "POST",
+			URL:    &url.URL{Path: "/steps/1/skip"},
+		})
+		require.Equal(t, http.StatusConflict, conflictResponseWriter.status)
+
+		<-done
+		status := dagAgent.Status()
+		require.Equal(t, scheduler.NodeStatusSkipped, status.Nodes[1].Status)
+		require.Equal(t, scheduler.NodeStatusSuccess, status.Nodes[2].Status)
+	})
+	t.Run("HTTP_HandleSignal", func(t *testing.T) {
+		th := test.Setup(t)
+
+		dag := th.LoadDAGFile(t, "handle_http_signal.yaml")
+		dagAgent := dag.Agent()
+
+		done := make(chan struct{})
+		go func() {
+			dagAgent.RunSuccess(t)
+			close(done)
+		}()
+
+		dag.AssertLatestStatus(t, scheduler.StatusRunning)
+
+		signal := func(step, sig string) mockResponseWriter {
+			var w mockResponseWriter
+			dagAgent.HandleHTTP(th.Context)(&w, &http.Request{
+				Method: "POST",
+				URL:    &url.URL{Path: "/steps/" + step + "/signal", RawQuery: "signal=" + sig},
+			})
+			return w
+		}
+
+		require.Equal(t, http.StatusBadRequest, signal("1", "SIGFOO").status)
+		require.Equal(t, http.StatusNotFound, signal("3", "SIGHUP").status)
+		// The step which has not started can't be signalled.
+		require.Equal(t, http.StatusConflict, signal("2", "SIGHUP").status)
+
+		// The step exits successfully on SIGHUP once the trap is set.
+		require.Eventually(t, func() bool {
+			return dagAgent.Status().Nodes[0].Status == scheduler.NodeStatusRunning
+		}, 5*time.Second, 50*time.Millisecond)
+		time.Sleep(500 * time.Millisecond)
+		require.Equal(t, http.StatusOK, signal("1", "SIGHUP").status)
+
+		<-done
+		status := dagAgent.Status()
+		require.Equal(t, scheduler.StatusSuccess, status.Status)
+		require.Equal(t, scheduler.NodeStatusSuccess, status.Nodes[0].Status)
+		require.Equal(t, scheduler.NodeStatusSuccess, status.Nodes[1].Status)
+	})
 }
 
 // Assert that mockResponseWriter implements http.ResponseWriter
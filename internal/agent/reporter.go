@@ -3,12 +3,20 @@ package agent
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/dagu-org/dagu/internal/cmdutil"
 	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/executor"
 	"github.com/dagu-org/dagu/internal/digraph/scheduler"
 	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/notifier"
 	"github.com/dagu-org/dagu/internal/persistence/model"
 	"github.com/jedib0t/go-pretty/v6/table"
 )
@@ -35,16 +43,35 @@ func (r *reporter) reportStep(
 		logger.Info(ctx, "Step execution finished", "step", node.Data().Step.Name, "status", nodeStatus)
 	}
 	if nodeStatus == scheduler.NodeStatusError && node.Data().Step.MailOnError {
-		fromAddress := dag.ErrorMail.From
-		toAddresses := []string{dag.ErrorMail.To}
-		subject := fmt.Sprintf("%s %s (%s)", dag.ErrorMail.Prefix, dag.Name, status.Status)
-		html := renderHTML(status.Nodes)
-		attachments := addAttachments(dag.ErrorMail.AttachLogs, status.Nodes)
-		return r.sender.Send(ctx, fromAddress, toAddresses, subject, html, attachments)
+		return r.sendMail(ctx, dag, dag.ErrorMail, status, true)
 	}
 	return nil
 }
 
+// sendMail sends the report mail with the mail configuration. The fields
+// of the configuration are evaluated when the mail is sent. The graph image
+// of the run is attached to the failure mails.
+func (r *reporter) sendMail(
+	ctx context.Context, dag *digraph.DAG, mailConfig *digraph.MailConfig, status model.Status, failure bool,
+) error {
+	cfg, err := cmdutil.EvalStringFields(ctx, *mailConfig)
+	if err != nil {
+		return fmt.Errorf("failed to evaluate mail config: %w", err)
+	}
+	subject := fmt.Sprintf("%s %s (%s)", cfg.Prefix, dag.Name, status.Status)
+	html := renderHTML(status.Nodes)
+	attachments := addAttachments(cfg.AttachLogs, status.Nodes)
+	if failure {
+		graph, err := writeGraph(status)
+		if err != nil {
+			logger.Warn(ctx, "Failed to attach the graph image", "err", err)
+		} else if graph != "" {
+			attachments = append(attachments, graph)
+		}
+	}
+	return r.sender.Send(ctx, cfg.From, []string{cfg.To}, subject, html, attachments)
+}
+
 // report is a function that reports the status of the scheduler.
 func (r *reporter) getSummary(_ context.Context, status model.Status, err error) string {
 	var buf bytes.Buffer
@@ -61,26 +88,130 @@ func (r *reporter) getSummary(_ context.Context, status model.Status, err error)
 func (r *reporter) send(ctx context.Context, dag *digraph.DAG, status model.Status, err error) error {
 	if err != nil || status.Status == scheduler.StatusError {
 		if dag.MailOn != nil && dag.MailOn.Failure {
-			fromAddress := dag.ErrorMail.From
-			toAddresses := []string{dag.ErrorMail.To}
-			subject := fmt.Sprintf("%s %s (%s)", dag.ErrorMail.Prefix, dag.Name, status.Status)
-			html := renderHTML(status.Nodes)
-			attachments := addAttachments(dag.ErrorMail.AttachLogs, status.Nodes)
-			return r.sender.Send(ctx, fromAddress, toAddresses, subject, html, attachments)
+			return r.sendMail(ctx, dag, dag.ErrorMail, status, true)
 		}
 	} else if status.Status == scheduler.StatusSuccess {
 		if dag.MailOn != nil && dag.MailOn.Success {
-			fromAddress := dag.InfoMail.From
-			toAddresses := []string{dag.InfoMail.To}
-			subject := fmt.Sprintf("%s %s (%s)", dag.InfoMail.Prefix, dag.Name, status.Status)
-			html := renderHTML(status.Nodes)
-			attachments := addAttachments(dag.InfoMail.AttachLogs, status.Nodes)
-			_ = r.sender.Send(ctx, fromAddress, toAddresses, subject, html, attachments)
+			_ = r.sendMail(ctx, dag, dag.InfoMail, status, false)
 		}
 	}
 	return nil
 }
 
+// notify sends the result of the run to the notifiers of the DAG that are
+// configured for the status.
+func (r *reporter) notify(ctx context.Context, dag *digraph.DAG, status model.Status, err error, notifiers *notifier.Registry) error {
+	if len(dag.Notify) == 0 || notifiers == nil {
+		return nil
+	}
+	n, ok := newNotification(dag, status, err)
+	if !ok {
+		return nil
+	}
+
+	var errs []error
+	for _, cfg := range dag.Notify {
+		if !slices.Contains(cfg.On, n.Status) {
+			continue
+		}
+		n.Config = cfg.Config
+		if err := notifiers.Notify(ctx, cfg.Type, n); err != nil {
+			errs = append(errs, fmt.Errorf("notifier %s: %w", cfg.Type, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// callback calls the webhooks of the DAG that are configured for the status
+// of the run, retrying the failed calls.
+func (r *reporter) callback(ctx context.Context, dag *digraph.DAG, status model.Status, err error) error {
+	if len(dag.Callbacks) == 0 {
+		return nil
+	}
+	n, ok := newNotification(dag, status, err)
+	if !ok {
+		return nil
+	}
+
+	var errs []error
+	for _, cb := range dag.Callbacks {
+		if !slices.Contains(cb.Events, n.Status) {
+			continue
+		}
+		if err := sendCallback(ctx, cb, n); err != nil {
+			errs = append(errs, fmt.Errorf("callback %s: %w", cb.URL, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sendCallback(ctx context.Context, cb digraph.Callback, n notifier.Notification) error {
+	url, err := cmdutil.EvalString(ctx, cb.URL)
+	if err != nil {
+		return fmt.Errorf("failed to evaluate the url: %w", err)
+	}
+	headers := make(map[string]string, len(cb.Headers))
+	for k, v := range cb.Headers {
+		if headers[k], err = cmdutil.EvalString(ctx, v); err != nil {
+			return fmt.Errorf("failed to evaluate the header %s: %w", k, err)
+		}
+	}
+	webhook, err := notifier.NewWebhook(notifier.WebhookDef{
+		URL:     url,
+		Method:  cb.Method,
+		Headers: headers,
+		Body:    cb.Payload,
+	})
+	if err != nil {
+		return err
+	}
+	return notifier.NotifyWithRetry(ctx, webhook, n, cb.Retries, cb.RetryInterval)
+}
+
+// newNotification creates the notification of the result of the run. It
+// returns false if the run didn't complete.
+func newNotification(dag *digraph.DAG, status model.Status, err error) (notifier.Notification, bool) {
+	var on string
+	switch {
+	case status.Status == scheduler.StatusCancel:
+		on = digraph.NotifyOnCancel
+	case err != nil || status.Status == scheduler.StatusError:
+		on = digraph.NotifyOnFailure
+	case status.Status == scheduler.StatusSuccess:
+		on = digraph.NotifyOnSuccess
+	default:
+		return notifier.Notification{}, false
+	}
+
+	n := notifier.Notification{
+		DAG:        dag.Name,
+		RequestID:  status.RequestID,
+		Status:     on,
+		StartedAt:  status.StartedAt,
+		FinishedAt: status.FinishedAt,
+		Params:     status.Params,
+		Graph:      model.RenderGraph(status.Nodes),
+	}
+	if err != nil {
+		n.Error = err.Error()
+	}
+	return n, true
+}
+
+// writeGraph writes the graph image of the run next to the log file of the
+// run and returns the path. It returns an empty path if the run has no log
+// file.
+func writeGraph(status model.Status) (string, error) {
+	if status.Log == "" {
+		return "", nil
+	}
+	file := strings.TrimSuffix(status.Log, filepath.Ext(status.Log)) + ".svg"
+	if err := os.WriteFile(file, []byte(model.RenderGraph(status.Nodes)), 0600); err != nil {
+		return "", fmt.Errorf("failed to write the graph image: %w", err)
+	}
+	return file, nil
+}
+
 var dagHeader = table.Row{
 	"RequestID",
 	"Name",
@@ -119,6 +250,8 @@ var stepHeader = table.Row{
 	"Finished At",
 	"Status",
 	"Command",
+	"CPU Time",
+	"Max RSS",
 	"Error",
 }
 
@@ -140,6 +273,8 @@ func renderStepSummary(nodes []*model.Node) string {
 		} else {
 			dataRow = append(dataRow, "")
 		}
+		cpuTime, maxRSS := formatResourceUsage(n.ResourceUsage)
+		dataRow = append(dataRow, cpuTime, maxRSS)
 		dataRow = append(dataRow, n.Error)
 		stepTable.AppendRow(dataRow)
 	}
@@ -164,6 +299,8 @@ func renderHTML(nodes []*model.Node) string {
 				<th align="center" style="padding: 10px;">Started At</th>
 				<th align="center" style="padding: 10px;">Finished At</th>
 				<th align="center" style="padding: 10px;">Status</th>
+				<th align="center" style="padding: 10px;">CPU Time</th>
+				<th align="center" style="padding: 10px;">Max RSS</th>
 				<th align="center" style="padding: 10px;">Error</th>
 			</tr>
 		</thead>
@@ -187,6 +324,9 @@ func renderHTML(nodes []*model.Node) string {
 		addValFunc(n.StartedAt)
 		addValFunc(n.FinishedAt)
 		addStatusFunc(n.Status)
+		cpuTime, maxRSS := formatResourceUsage(n.ResourceUsage)
+		addValFunc(cpuTime)
+		addValFunc(maxRSS)
 		addValFunc(n.Error)
 		_, _ = buffer.WriteString("</tr>")
 	}
@@ -194,6 +334,17 @@ func renderHTML(nodes []*model.Node) string {
 	return buffer.String()
 }
 
+// formatResourceUsage returns the total CPU time and the max RSS of the
+// step to show in the reports. They are empty if the executor of the step
+// doesn't measure them.
+func formatResourceUsage(usage *executor.ResourceUsage) (cpuTime, maxRSS string) {
+	if usage == nil || usage.MaxRSS == 0 {
+		return "", ""
+	}
+	cpu := (usage.UserCPUTime + usage.SystemCPUTime).Round(time.Millisecond)
+	return cpu.String(), fmt.Sprintf("%.1f MiB", float64(usage.MaxRSS)/(1<<20))
+}
+
 func addAttachments(
 	trigger bool, nodes []*model.Node,
 ) (attachments []string) {
@@ -4,11 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/executor"
 	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/notifier"
 	"github.com/dagu-org/dagu/internal/persistence/model"
 	"github.com/dagu-org/dagu/internal/stringutil"
 	"github.com/stretchr/testify/require"
@@ -23,6 +31,9 @@ func TestReporter(t *testing.T) {
 		"create success mail": testSuccessMail,
 		"create summary":      testRenderSummary,
 		"create node list":    testRenderTable,
+		"notify":              testNotify,
+		"callback":            testCallback,
+		"render graph":        testRenderGraph,
 	} {
 		t.Run(scenario, func(t *testing.T) {
 
@@ -128,6 +139,139 @@ func testRenderTable(t *testing.T, _ *reporter, _ *digraph.DAG, nodes []*model.N
 	summary := renderStepSummary(nodes)
 	require.Contains(t, summary, nodes[0].Step.Name)
 	require.Contains(t, summary, nodes[0].Step.Args[0])
+
+	nodes[0].ResourceUsage = &executor.ResourceUsage{
+		UserCPUTime:   1200 * time.Millisecond,
+		SystemCPUTime: 300 * time.Millisecond,
+		MaxRSS:        64 << 20,
+	}
+	summary = renderStepSummary(nodes)
+	require.Contains(t, summary, "1.5s")
+	require.Contains(t, summary, "64.0 MiB")
+	require.Contains(t, renderHTML(nodes), "64.0 MiB")
+}
+
+func testNotify(t *testing.T, rp *reporter, dag *digraph.DAG, nodes []*model.Node) {
+	dag.Notify = []digraph.Notify{
+		{Type: "mock", On: []string{digraph.NotifyOnFailure}, Config: map[string]any{"channel": "alerts"}},
+		{Type: "mock", On: []string{digraph.NotifyOnSuccess}},
+	}
+	mock := &mockNotifier{}
+	notifiers := notifier.NewRegistry()
+	notifiers.Register("mock", mock)
+
+	err := rp.notify(context.Background(), dag, model.Status{
+		RequestID: "request-id",
+		Status:    scheduler.StatusError,
+		Nodes:     nodes,
+	}, errors.New("test error"), notifiers)
+	require.NoError(t, err)
+	require.Len(t, mock.notifications, 1)
+	require.Equal(t, notifier.Notification{
+		DAG:       dag.Name,
+		RequestID: "request-id",
+		Status:    digraph.NotifyOnFailure,
+		Error:     "test error",
+		Graph:     model.RenderGraph(nodes),
+		Config:    map[string]any{"channel": "alerts"},
+	}, mock.notifications[0])
+
+	// The notifier that is not registered is reported as an error.
+	dag.Notify = append(dag.Notify, digraph.Notify{Type: "missing", On: []string{digraph.NotifyOnSuccess}})
+	err = rp.notify(context.Background(), dag, model.Status{
+		Status: scheduler.StatusSuccess,
+		Nodes:  nodes,
+	}, nil, notifiers)
+	require.Error(t, err)
+	require.Len(t, mock.notifications, 2)
+	require.Equal(t, digraph.NotifyOnSuccess, mock.notifications[1].Status)
+}
+
+func testCallback(t *testing.T, rp *reporter, dag *digraph.DAG, nodes []*model.Node) {
+	var (
+		calls     int
+		gotPath   string
+		gotHeader string
+		gotBody   string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			// The first call fails and is retried.
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		gotPath = r.URL.Path
+		gotHeader = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+	}))
+	defer srv.Close()
+
+	t.Setenv("CALLBACK_TOKEN", "secret")
+	dag.Callbacks = []digraph.Callback{
+		{
+			URL:           srv.URL + "/runs/{{ .RequestID }}",
+			Headers:       map[string]string{"Authorization": "Bearer ${CALLBACK_TOKEN}"},
+			Payload:       `{"dag": {{ json .DAG }}, "status": {{ json .Status }}}`,
+			Events:        []string{digraph.NotifyOnFailure},
+			Retries:       1,
+			RetryInterval: time.Millisecond,
+		},
+		{URL: srv.URL, Events: []string{digraph.NotifyOnSuccess}},
+	}
+
+	err := rp.callback(context.Background(), dag, model.Status{
+		RequestID: "request-id",
+		Status:    scheduler.StatusError,
+		Nodes:     nodes,
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+	require.Equal(t, "/runs/request-id", gotPath)
+	require.Equal(t, "Bearer secret", gotHeader)
+	require.Equal(t, `{"dag": "test DAG", "status": "failure"}`, gotBody)
+
+	// The callback is not called for the running DAG.
+	err = rp.callback(context.Background(), dag, model.Status{
+		Status: scheduler.StatusRunning,
+		Nodes:  nodes,
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+}
+
+func testRenderGraph(t *testing.T, rp *reporter, dag *digraph.DAG, nodes []*model.Node) {
+	nodes[0].Status = scheduler.NodeStatusSuccess
+	nodes = append(nodes, &model.Node{
+		Step:   digraph.Step{Name: "deploy <prod>", Depends: []string{"test-step"}},
+		Status: scheduler.NodeStatusError,
+	})
+
+	// The graph image is attached to the failure mail.
+	logFile := filepath.Join(t.TempDir(), "run.log")
+	err := rp.send(context.Background(), dag, model.Status{
+		Status: scheduler.StatusError,
+		Nodes:  nodes,
+		Log:    logFile,
+	}, nil)
+	require.NoError(t, err)
+	mock, ok := rp.sender.(*mockSender)
+	require.True(t, ok)
+	graphFile := strings.TrimSuffix(logFile, ".log") + ".svg"
+	require.Equal(t, []string{graphFile}, mock.attachments)
+	data, err := os.ReadFile(graphFile)
+	require.NoError(t, err)
+	require.Equal(t, model.RenderGraph(nodes), string(data))
+}
+
+type mockNotifier struct {
+	notifications []notifier.Notification
+}
+
+func (m *mockNotifier) Notify(_ context.Context, n notifier.Notification) error {
+	m.notifications = append(m.notifications, n)
+	return nil
 }
 
 type mockSender struct {
@@ -136,10 +280,13 @@ type mockSender struct {
 	subject string
 	body    string
 	count   int
+
+	attachments []string
 }
 
-func (m *mockSender) Send(_ context.Context, from string, to []string, subject, body string, _ []string) error {
+func (m *mockSender) Send(_ context.Context, from string, to []string, subject, body string, attachments []string) error {
 	m.count += 1
+	m.attachments = attachments
 	m.from = from
 	m.to = to
 	m.subject = subject
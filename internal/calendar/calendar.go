@@ -0,0 +1,108 @@
+// Package calendar exports the scheduled runs of the DAGs as an iCalendar
+// (RFC 5545) feed that calendar applications can subscribe to, and lays them
+// out on a timeline telling which ones are expected to be skipped.
+package calendar
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/robfig/cron/v3"
+)
+
+// icalTime is the format of the date-time values in UTC.
+const icalTime = "20060102T150405Z"
+
+// maxLineLength is the maximum length of a content line in octets without
+// the line break.
+const maxLineLength = 75
+
+// Event is a scheduled run.
+type Event struct {
+	// UID is the unique identifier of the event. It must be stable so that
+	// the calendar applications update the events instead of adding them.
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time
+	End         time.Time
+}
+
+// Calendar is a set of the scheduled runs.
+type Calendar struct {
+	Name   string
+	Events []Event
+}
+
+// Occurrences returns the times the schedule fires after from and until to,
+// up to limit times.
+func Occurrences(schedule cron.Schedule, from, to time.Time, limit int) []time.Time {
+	var times []time.Time
+	for next := schedule.Next(from); !next.IsZero() && !next.After(to); next = schedule.Next(next) {
+		if len(times) >= limit {
+			break
+		}
+		times = append(times, next)
+	}
+	return times
+}
+
+// Write writes the calendar in the iCalendar format. now is the time the
+// calendar is created.
+func (c *Calendar) Write(w io.Writer, now time.Time) error {
+	bw := bufio.NewWriter(w)
+	line := func(name, value string) {
+		writeLine(bw, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//Dagu//Dagu//EN")
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		line("X-WR-CALNAME", escapeText(c.Name))
+	}
+	for _, e := range c.Events {
+		line("BEGIN", "VEVENT")
+		line("UID", escapeText(e.UID))
+		line("DTSTAMP", now.UTC().Format(icalTime))
+		line("DTSTART", e.Start.UTC().Format(icalTime))
+		line("DTEND", e.End.UTC().Format(icalTime))
+		line("SUMMARY", escapeText(e.Summary))
+		if e.Description != "" {
+			line("DESCRIPTION", escapeText(e.Description))
+		}
+		line("END", "VEVENT")
+	}
+	line("END", "VCALENDAR")
+	return bw.Flush()
+}
+
+// escapeText escapes a TEXT value.
+func escapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`,
+	).Replace(s)
+}
+
+// writeLine writes a content line folded at 75 octets. The continuation
+// lines start with a space and the line is never split inside a UTF-8
+// character.
+func writeLine(w *bufio.Writer, s string) {
+	limit := maxLineLength
+	for len(s) > limit {
+		i := limit
+		for i > 0 && !utf8.RuneStart(s[i]) {
+			i--
+		}
+		_, _ = w.WriteString(s[:i] + "\r\n ")
+		s = s[i:]
+		// The leading space counts towards the length.
+		limit = maxLineLength - 1
+	}
+	_, _ = w.WriteString(s + "\r\n")
+}
@@ -0,0 +1,129 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/persistence"
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOccurrences(t *testing.T) {
+	schedule, err := cron.ParseStandard("0 9 * * 1-5")
+	require.NoError(t, err)
+	// Monday.
+	from := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	times := Occurrences(schedule, from, from.AddDate(0, 0, 7), 100)
+	require.Len(t, times, 5)
+	require.Equal(t, time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC), times[0])
+	require.Equal(t, time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC), times[4])
+
+	t.Run("Limit", func(t *testing.T) {
+		require.Len(t, Occurrences(schedule, from, from.AddDate(0, 0, 7), 2), 2)
+	})
+}
+
+func TestCalendarWrite(t *testing.T) {
+	start := time.Date(2024, 1, 2, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+	c := &Calendar{
+		Name: "Dagu",
+		Events: []Event{
+			{
+				UID:         "backup-20240102T000000Z@dagu",
+				Summary:     "backup",
+				Description: "Backs up the database; then uploads it\nSchedule: 0 9 * * *",
+				Start:       start,
+				End:         start.Add(time.Hour),
+			},
+		},
+	}
+
+	var buf strings.Builder
+	require.NoError(t, c.Write(&buf, start))
+	out := buf.String()
+
+	require.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	require.True(t, strings.HasSuffix(out, "END:VEVENT\r\nEND:VCALENDAR\r\n"))
+	require.Contains(t, out, "DTSTART:20240102T000000Z\r\n")
+	require.Contains(t, out, "DTEND:20240102T010000Z\r\n")
+	require.Contains(t, out, `DESCRIPTION:Backs up the database\; then uploads it\nSchedule: 0 9 * * *`)
+
+	t.Run("Fold", func(t *testing.T) {
+		var buf strings.Builder
+		c := &Calendar{Events: []Event{{Summary: strings.Repeat("あ", 40)}}}
+		require.NoError(t, c.Write(&buf, start))
+
+		var summary string
+		for _, line := range strings.Split(buf.String(), "\r\n") {
+			require.LessOrEqual(t, len(line), maxLineLength)
+			if strings.HasPrefix(line, "SUMMARY:") {
+				summary = line
+			} else if strings.HasPrefix(line, " ") {
+				summary += line[1:]
+			}
+		}
+		require.Equal(t, "SUMMARY:"+strings.Repeat("あ", 40), summary)
+	})
+}
+
+func TestTimeline(t *testing.T) {
+	schedule := func(expr string) digraph.Schedule {
+		parsed, err := cron.ParseStandard(expr)
+		require.NoError(t, err)
+		return digraph.Schedule{Expression: expr, Parsed: parsed}
+	}
+	// Monday 00:00.
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	dags := []ScheduledDAG{
+		{
+			DAG: &digraph.DAG{
+				Location:      "/dags/report.yaml",
+				Schedule:      []digraph.Schedule{schedule("0 */6 * * *")},
+				Preconditions: []digraph.Condition{{TimeWindow: "09:00-17:00"}},
+			},
+		},
+		{
+			DAG: &digraph.DAG{
+				Location:     "/dags/server.yaml",
+				StopSchedule: []digraph.Schedule{schedule("0 3 * * *")},
+			},
+			Suspension: &persistence.Suspension{Reason: "migration", ExpiresAt: from.Add(4 * time.Hour)},
+		},
+	}
+
+	runs := Timeline(dags, from, to, 100)
+	require.Len(t, runs, 5)
+
+	require.Equal(t, "server", runs[0].DAG)
+	require.Equal(t, OperationStop, runs[0].Operation)
+	require.True(t, runs[0].Skipped)
+	require.Contains(t, runs[0].Reason, "suspended: migration")
+
+	// 06:00 and 18:00 are outside of the window.
+	require.Equal(t, time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC), runs[1].Time)
+	require.True(t, runs[1].Skipped)
+	require.Contains(t, runs[1].Reason, "09:00-17:00")
+	require.Equal(t, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), runs[2].Time)
+	require.False(t, runs[2].Skipped)
+	require.True(t, runs[3].Skipped)
+	require.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), runs[4].Time)
+
+	// The preconditions are evaluated in the time zone of the DAG: 03:00
+	// UTC is 12:00 in Tokyo.
+	runs = Timeline([]ScheduledDAG{{
+		DAG: &digraph.DAG{
+			Location:      "/dags/tokyo.yaml",
+			Schedule:      []digraph.Schedule{schedule("CRON_TZ=Asia/Tokyo 0 12 * * *")},
+			Preconditions: []digraph.Condition{{TimeWindow: "09:00-17:00"}},
+		},
+	}}, from, to, 100)
+	require.Len(t, runs, 1)
+	require.True(t, time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC).Equal(runs[0].Time))
+	require.False(t, runs[0].Skipped)
+}
@@ -0,0 +1,107 @@
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/persistence"
+)
+
+// Operation is what the scheduler does to a DAG at a scheduled time.
+type Operation string
+
+const (
+	OperationStart   Operation = "start"
+	OperationStop    Operation = "stop"
+	OperationRestart Operation = "restart"
+)
+
+// Run is a start, a stop or a restart of a DAG expected from the schedules.
+type Run struct {
+	// DAG is the ID of the DAG.
+	DAG       string
+	Time      time.Time
+	Operation Operation
+	// Schedule is the cron expression firing the run.
+	Schedule string
+	// Skipped is whether the scheduler is expected not to run it, e.g.
+	// because the DAG is suspended at the time. Reason tells why.
+	Skipped bool
+	Reason  string
+}
+
+// ScheduledDAG is a DAG to put on the timeline and its suspension, nil if
+// it's not suspended.
+type ScheduledDAG struct {
+	DAG        *digraph.DAG
+	Suspension *persistence.Suspension
+}
+
+// Timeline returns the runs of the DAGs scheduled after from and until to,
+// sorted by time, up to limit runs per schedule. The runs are skipped while
+// the DAG is suspended, and the starts are skipped when a precondition of
+// the DAG depending only on the time, i.e. a time window or the weekdays, is
+// not met. The schedules are evaluated in the location of from.
+func Timeline(dags []ScheduledDAG, from, to time.Time, limit int) []Run {
+	var runs []Run
+	for _, d := range dags {
+		for _, s := range []struct {
+			operation Operation
+			schedules []digraph.Schedule
+		}{
+			{OperationStart, d.DAG.Schedule},
+			{OperationStop, d.DAG.StopSchedule},
+			{OperationRestart, d.DAG.RestartSchedule},
+		} {
+			for _, schedule := range s.schedules {
+				for _, t := range Occurrences(schedule.Parsed, from, to, limit) {
+					run := Run{
+						DAG:       d.DAG.ID(),
+						Time:      t,
+						Operation: s.operation,
+						Schedule:  schedule.Expression,
+					}
+					run.Reason = skipReason(d, s.operation, t)
+					run.Skipped = run.Reason != ""
+					runs = append(runs, run)
+				}
+			}
+		}
+	}
+	sort.SliceStable(runs, func(i, j int) bool {
+		if !runs[i].Time.Equal(runs[j].Time) {
+			return runs[i].Time.Before(runs[j].Time)
+		}
+		return runs[i].DAG < runs[j].DAG
+	})
+	return runs
+}
+
+// skipReason returns why the run at the time is expected to be skipped, or
+// an empty string if it's expected to happen.
+func skipReason(d ScheduledDAG, operation Operation, t time.Time) string {
+	if s := d.Suspension; s != nil && !s.Expired(t) {
+		reason := "suspended"
+		if s.Reason != "" {
+			reason += ": " + s.Reason
+		}
+		if !s.ExpiresAt.IsZero() {
+			reason += fmt.Sprintf(" (until %s)", s.ExpiresAt.In(t.Location()).Format(time.RFC3339))
+		}
+		return reason
+	}
+	if operation != OperationStart {
+		return ""
+	}
+	if loc := d.DAG.TimeLocation(); loc != nil {
+		t = t.In(loc)
+	}
+	for _, cond := range d.DAG.Preconditions {
+		if known, err := cond.EvalAt(t); known && err != nil {
+			return err.Error()
+		}
+	}
+	return ""
+}
@@ -4,19 +4,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"os/exec"
-	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 	"syscall"
+	"time"
 
 	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/dagu-org/dagu/internal/digraph/scheduler"
 	"github.com/dagu-org/dagu/internal/frontend/gen/restapi/operations/dags"
 	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/namespace"
 	"github.com/dagu-org/dagu/internal/persistence"
 	"github.com/dagu-org/dagu/internal/persistence/model"
 	"github.com/dagu-org/dagu/internal/sock"
+	"github.com/dagu-org/dagu/internal/stringutil"
 )
 
 // New creates a new Client instance.
@@ -25,26 +30,36 @@ func New(
 	dagStore persistence.DAGStore,
 	historyStore persistence.HistoryStore,
 	flagStore persistence.FlagStore,
+	delayedStartStore persistence.DelayedStartStore,
 	executable string,
 	workDir string,
 ) Client {
 	return &client{
-		dagStore:     dagStore,
-		historyStore: historyStore,
-		flagStore:    flagStore,
-		executable:   executable,
-		workDir:      workDir,
+		dagStore:          dagStore,
+		historyStore:      historyStore,
+		flagStore:         flagStore,
+		delayedStartStore: delayedStartStore,
+		executable:        executable,
+		workDir:           workDir,
+		runs:              make(map[*exec.Cmd]*digraph.DAG),
 	}
 }
 
 var _ Client = (*client)(nil)
 
 type client struct {
-	dagStore     persistence.DAGStore
-	historyStore persistence.HistoryStore
-	flagStore    persistence.FlagStore
-	executable   string
-	workDir      string
+	dagStore          persistence.DAGStore
+	historyStore      persistence.HistoryStore
+	flagStore         persistence.FlagStore
+	delayedStartStore persistence.DelayedStartStore
+	executable        string
+	workDir           string
+
+	// runs are the processes started by the client that are still running.
+	runs     map[*exec.Cmd]*digraph.DAG
+	runsMu   sync.Mutex
+	runsWg   sync.WaitGroup
+	draining bool
 }
 
 var (
@@ -66,10 +81,20 @@ func (e *client) CreateDAG(ctx context.Context, name string) (string, error) {
 	return id, nil
 }
 
-func (e *client) Grep(ctx context.Context, pattern string) (
-	[]*persistence.GrepResult, []string, error,
+func (e *client) Search(ctx context.Context, query string) (
+	[]*persistence.SearchResult, []string, error,
 ) {
-	return e.dagStore.Grep(ctx, pattern)
+	ret, errs, err := e.dagStore.Search(ctx, query)
+	if err != nil {
+		return nil, errs, err
+	}
+	allowed := make([]*persistence.SearchResult, 0, len(ret))
+	for _, item := range ret {
+		if ns, _ := namespace.Split(item.Name); namespace.Allowed(ctx, ns) {
+			allowed = append(allowed, item)
+		}
+	}
+	return allowed, errs, nil
 }
 
 func (e *client) Rename(ctx context.Context, oldID, newID string) error {
@@ -90,11 +115,27 @@ func (e *client) Rename(ctx context.Context, oldID, newID string) error {
 	return nil
 }
 
-func (e *client) Stop(_ context.Context, dag *digraph.DAG) error {
-	// TODO: fix this not to connect to the DAG directly
+func (e *client) Stop(ctx context.Context, dag *digraph.DAG, requestID string) (StopResult, error) {
 	client := sock.NewClient(dag.SockAddr())
-	_, err := client.Request("POST", "/stop")
-	return err
+	ret, err := client.Request("POST", "/stop?requestId="+url.QueryEscape(requestID))
+	if err != nil && errors.Is(err, sock.ErrTimeout) {
+		return "", err
+	}
+	switch result := StopResult(ret); {
+	case err == nil && (result == StopSignalled || result == StopAlreadyStopped):
+		return result, nil
+	case err == nil && result != StopNotRunning:
+		return "", fmt.Errorf("failed to stop the DAG: %s", strings.TrimSpace(ret))
+	}
+
+	// No agent is running the run.
+	if requestID == "" {
+		return StopNotRunning, nil
+	}
+	if _, err := e.historyStore.FindByRequestID(ctx, dag.Location, requestID); err != nil {
+		return "", err
+	}
+	return StopAlreadyStopped, nil
 }
 
 func (e *client) StartAsync(ctx context.Context, dag *digraph.DAG, opts StartOptions) {
@@ -114,6 +155,36 @@ func (e *client) Start(_ context.Context, dag *digraph.DAG, opts StartOptions) e
 	if opts.Quiet {
 		args = append(args, "-q")
 	}
+	if opts.RequestID != "" {
+		args = append(args, "-r", opts.RequestID)
+	}
+	if opts.IdempotencyKey != "" {
+		args = append(args, "--idempotencyKey", opts.IdempotencyKey)
+	}
+	if len(opts.Labels) > 0 {
+		args = append(args, "-l", model.FormatLabels(opts.Labels))
+	}
+	if opts.Trigger != "" {
+		args = append(args, "--trigger", string(opts.Trigger))
+	}
+	if opts.Schedule != "" {
+		args = append(args, "--schedule", opts.Schedule)
+	}
+	if !opts.ScheduledTime.IsZero() {
+		args = append(args, "--scheduledTime", opts.ScheduledTime.Format(time.RFC3339))
+	}
+	if !opts.ExecutionDate.IsZero() {
+		args = append(args, "--executionDate", opts.ExecutionDate.Format(time.RFC3339))
+	}
+	for _, step := range opts.SkipSteps {
+		args = append(args, "--skip", step)
+	}
+	if opts.From != "" {
+		args = append(args, "--from", opts.From)
+	}
+	if opts.Target != "" {
+		args = append(args, "--target", opts.Target)
+	}
 	args = append(args, dag.Location)
 	// nolint:gosec
 	cmd := exec.Command(e.executable, args...)
@@ -122,12 +193,41 @@ func (e *client) Start(_ context.Context, dag *digraph.DAG, opts StartOptions) e
 	cmd.Env = os.Environ()
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
+	return e.run(cmd, dag)
+}
+
+func (e *client) StartLater(_ context.Context, dag *digraph.DAG, opts StartOptions, at time.Time) error {
+	if opts.RequestID == "" {
+		return errors.New("the request ID of the delayed start is required")
+	}
+	return e.delayedStartStore.Add(persistence.DelayedStart{
+		DAG:            dag.Location,
+		RequestID:      opts.RequestID,
+		Params:         opts.Params,
+		IdempotencyKey: opts.IdempotencyKey,
+		Labels:         opts.Labels,
+		Trigger:        opts.Trigger,
+		StartAt:        at,
+		RegisteredAt:   time.Now(),
+		ExecutionDate:  opts.ExecutionDate,
+		SkipSteps:      opts.SkipSteps,
+		From:           opts.From,
+		Target:         opts.Target,
+	})
+}
 
-	err := cmd.Start()
+func (e *client) GetDelayedStarts(_ context.Context, dag *digraph.DAG) ([]persistence.DelayedStart, error) {
+	starts, err := e.delayedStartStore.List()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return cmd.Wait()
+	var ret []persistence.DelayedStart
+	for _, start := range starts {
+		if start.DAG == dag.Location {
+			ret = append(ret, start)
+		}
+	}
+	return ret, nil
 }
 
 func (e *client) Restart(_ context.Context, dag *digraph.DAG, opts RestartOptions) error {
@@ -141,11 +241,7 @@ func (e *client) Restart(_ context.Context, dag *digraph.DAG, opts RestartOption
 	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true, Pgid: 0}
 	cmd.Dir = e.workDir
 	cmd.Env = os.Environ()
-	err := cmd.Start()
-	if err != nil {
-		return err
-	}
-	return cmd.Wait()
+	return e.run(cmd, dag)
 }
 
 func (e *client) Retry(_ context.Context, dag *digraph.DAG, requestID string) error {
@@ -157,13 +253,179 @@ func (e *client) Retry(_ context.Context, dag *digraph.DAG, requestID string) er
 	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true, Pgid: 0}
 	cmd.Dir = e.workDir
 	cmd.Env = os.Environ()
-	err := cmd.Start()
+	return e.run(cmd, dag)
+}
+
+func (e *client) RetryStep(_ context.Context, dag *digraph.DAG, requestID, step string) error {
+	args := []string{"retry"}
+	args = append(args, fmt.Sprintf("--req=%s", requestID))
+	args = append(args, fmt.Sprintf("--step=%s", step))
+	args = append(args, dag.Location)
+	// nolint:gosec
+	cmd := exec.Command(e.executable, args...)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true, Pgid: 0}
+	cmd.Dir = e.workDir
+	cmd.Env = os.Environ()
+	return e.run(cmd, dag)
+}
+
+func (e *client) SkipStep(ctx context.Context, dag *digraph.DAG, requestID, step string) error {
+	queued, err := e.skipQueuedStep(dag, requestID, step)
+	if err != nil || queued {
+		return err
+	}
+
+	status, err := e.GetCurrentStatus(ctx, dag)
+	if err != nil {
+		return err
+	}
+	if status.Status != scheduler.StatusRunning || status.RequestID != requestID {
+		return fmt.Errorf("%w: %s", ErrRunNotActive, requestID)
+	}
+	for _, node := range status.Nodes {
+		if node.Step.Name == step && node.Status != scheduler.NodeStatusNone {
+			return fmt.Errorf("%w: %s", scheduler.ErrStepAlreadyStarted, step)
+		}
+	}
+	client := sock.NewClient(dag.SockAddr())
+	ret, err := client.Request("POST", "/steps/"+url.PathEscape(step)+"/skip")
 	if err != nil {
 		return err
 	}
+	if ret != "OK" {
+		return fmt.Errorf("failed to skip the step %s: %s", step, strings.TrimSpace(ret))
+	}
+	return nil
+}
+
+func (e *client) SignalStep(ctx context.Context, dag *digraph.DAG, requestID, step, signal string) error {
+	status, err := e.GetCurrentStatus(ctx, dag)
+	if err != nil {
+		return err
+	}
+	if status.Status != scheduler.StatusRunning || status.RequestID != requestID {
+		return fmt.Errorf("%w: %s", ErrRunNotActive, requestID)
+	}
+	for _, node := range status.Nodes {
+		if node.Step.Name == step && node.Status != scheduler.NodeStatusRunning {
+			return fmt.Errorf("%w: %s", scheduler.ErrStepNotRunning, step)
+		}
+	}
+	client := sock.NewClient(dag.SockAddr())
+	ret, err := client.Request(
+		"POST", "/steps/"+url.PathEscape(step)+"/signal?signal="+url.QueryEscape(signal),
+	)
+	if err != nil {
+		return err
+	}
+	if ret != "OK" {
+		return fmt.Errorf("failed to send the signal to the step %s: %s", step, strings.TrimSpace(ret))
+	}
+	return nil
+}
+
+// skipQueuedStep adds the step to the steps to skip of the queued run. It
+// returns false if the run is not queued.
+func (e *client) skipQueuedStep(dag *digraph.DAG, requestID, step string) (bool, error) {
+	starts, err := e.delayedStartStore.List()
+	if err != nil {
+		return false, err
+	}
+	for _, start := range starts {
+		if start.RequestID != requestID || start.DAG != dag.Location {
+			continue
+		}
+		if slices.Contains(start.SkipSteps, step) {
+			return true, nil
+		}
+		// The run is removed first in the same way as the scheduler takes
+		// it, so that the run started meanwhile is not queued again.
+		if err := e.delayedStartStore.Remove(requestID); err != nil {
+			if errors.Is(err, persistence.ErrRequestIDNotFound) {
+				// The run has just been started.
+				return false, nil
+			}
+			return false, err
+		}
+		start.SkipSteps = append(start.SkipSteps, step)
+		return true, e.delayedStartStore.Add(start)
+	}
+	return false, nil
+}
+
+// run starts the command and waits for it to finish. The command is tracked
+// so that Drain can wait for it. It returns ErrDraining if the client is
+// draining.
+func (e *client) run(cmd *exec.Cmd, dag *digraph.DAG) error {
+	e.runsMu.Lock()
+	if e.draining {
+		e.runsMu.Unlock()
+		return ErrDraining
+	}
+	if err := cmd.Start(); err != nil {
+		e.runsMu.Unlock()
+		return err
+	}
+	e.runs[cmd] = dag
+	e.runsWg.Add(1)
+	e.runsMu.Unlock()
+
+	defer func() {
+		e.runsMu.Lock()
+		delete(e.runs, cmd)
+		e.runsMu.Unlock()
+		e.runsWg.Done()
+	}()
+
 	return cmd.Wait()
 }
 
+// drainStopTimeout is the time to wait for the runs to exit after they are
+// requested to stop at the end of the grace period.
+var drainStopTimeout = 30 * time.Second
+
+func (e *client) Drain(ctx context.Context, gracePeriod time.Duration) DrainResult {
+	e.runsMu.Lock()
+	e.draining = true
+	e.runsMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		e.runsWg.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(gracePeriod)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		return DrainResult{Clean: true}
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	// Stop the runs that did not finish within the grace period.
+	var ret DrainResult
+	e.runsMu.Lock()
+	for cmd, dag := range e.runs {
+		ret.Stopped = append(ret.Stopped, dag.Name)
+		logger.Warn(ctx, "Stopping the DAG run after the grace period", "DAG", dag.Name)
+		if result, err := e.Stop(ctx, dag, ""); err != nil || result == StopNotRunning {
+			// The agent may not be ready to accept the request yet.
+			_ = cmd.Process.Signal(syscall.SIGTERM)
+		}
+	}
+	e.runsMu.Unlock()
+
+	select {
+	case <-done:
+	case <-time.After(drainStopTimeout):
+		logger.Warn(ctx, "DAG runs did not exit after the stop request", "DAGs", strings.Join(ret.Stopped, ","))
+	}
+	return ret
+}
+
 func (*client) GetCurrentStatus(_ context.Context, dag *digraph.DAG) (*model.Status, error) {
 	client := sock.NewClient(dag.SockAddr())
 	ret, err := client.Request("GET", "/status")
@@ -193,6 +455,14 @@ func (e *client) GetStatusByRequestID(ctx context.Context, dag *digraph.DAG, req
 	return &ret.Status, err
 }
 
+func (e *client) ReserveIdempotencyKey(ctx context.Context, dag *digraph.DAG, key, requestID string) (string, error) {
+	return e.historyStore.ReserveIdempotencyKey(ctx, dag.Location, key, requestID)
+}
+
+func (e *client) ReleaseIdempotencyKey(ctx context.Context, dag *digraph.DAG, key, requestID string) error {
+	return e.historyStore.ReleaseIdempotencyKey(ctx, dag.Location, key, requestID)
+}
+
 func (*client) currentStatus(_ context.Context, dag *digraph.DAG) (*model.Status, error) {
 	client := sock.NewClient(dag.SockAddr())
 	ret, err := client.Request("GET", "/status")
@@ -208,6 +478,12 @@ func (e *client) GetLatestStatus(ctx context.Context, dag *digraph.DAG) (model.S
 		return *currStatus, nil
 	}
 	status, err := e.historyStore.ReadStatusToday(ctx, dag.Location)
+	return latestStatusOf(dag, status, err)
+}
+
+// latestStatusOf returns the latest status of the DAG read from the history
+// store. The DAG is not running if its agent didn't answer.
+func latestStatusOf(dag *digraph.DAG, status *model.Status, err error) (model.Status, error) {
 	if err != nil {
 		status := model.NewStatusFactory(dag).CreateDefault()
 		if errors.Is(err, persistence.ErrNoStatusDataToday) ||
@@ -221,12 +497,80 @@ func (e *client) GetLatestStatus(ctx context.Context, dag *digraph.DAG) (model.S
 	return *status, nil
 }
 
+func (e *client) ReadStatusFile(ctx context.Context, file string) (*model.Status, error) {
+	return e.historyStore.ReadStatusFile(ctx, file)
+}
+
 func (e *client) GetRecentHistory(ctx context.Context, dag *digraph.DAG, n int) []model.StatusFile {
 	return e.historyStore.ReadStatusRecent(ctx, dag.Location, n)
 }
 
 var errDAGIsRunning = errors.New("the DAG is running")
 
+// staleRunLookupLimit is the number of recent runs to look up for the stale
+// runs.
+const staleRunLookupLimit = 10
+
+// heartbeatTimeout is the time since the last heartbeat after which the
+// agent is considered dead.
+const heartbeatTimeout = time.Minute
+
+// staleRunReason is the reason recorded in the status of the stale runs.
+const staleRunReason = "the process running the DAG was not found"
+
+func (e *client) RecoverStaleRuns(ctx context.Context, dag *digraph.DAG) ([]model.Status, error) {
+	var ret []model.Status
+	for _, file := range e.historyStore.ReadStatusRecent(ctx, dag.Location, staleRunLookupLimit) {
+		status := file.Status
+		if status.Status != scheduler.StatusRunning {
+			continue
+		}
+		current, err := e.currentStatus(ctx, dag)
+		if err == nil && current.RequestID == status.RequestID {
+			continue
+		}
+		heartbeat, _ := stringutil.ParseTime(status.Heartbeat)
+		if !heartbeat.IsZero() && time.Since(heartbeat) < heartbeatTimeout {
+			// The agent is alive even if the socket did not respond.
+			continue
+		}
+		if heartbeat.IsZero() && errors.Is(err, sock.ErrTimeout) {
+			// The process may be busy; it's not safe to mark it as failed
+			// without the heartbeat.
+			continue
+		}
+		status.MarkInterrupted(staleRunReason, time.Now())
+		if err := e.historyStore.Update(ctx, dag.Location, status.RequestID, status); err != nil {
+			return ret, fmt.Errorf("failed to update the status of the stale run %s: %w", status.RequestID, err)
+		}
+		logger.Warn(ctx, "Marked the stale run as failed", "DAG", dag.Name, "reqId", status.RequestID)
+		ret = append(ret, status)
+	}
+	return ret, nil
+}
+
+func (e *client) RecoverAllStaleRuns(ctx context.Context) ([]RecoveredRun, error) {
+	dags, errs, err := e.dagStore.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, msg := range errs {
+		logger.Warn(ctx, "Failed to read DAG", "err", msg)
+	}
+
+	var ret []RecoveredRun
+	for _, dag := range dags {
+		statuses, err := e.RecoverStaleRuns(ctx, dag)
+		if err != nil {
+			logger.Error(ctx, "Failed to recover the stale runs", "DAG", dag.Name, "err", err)
+		}
+		for _, status := range statuses {
+			ret = append(ret, RecoveredRun{DAG: dag, Status: status})
+		}
+	}
+	return ret, nil
+}
+
 func (e *client) UpdateStatus(ctx context.Context, dag *digraph.DAG, status model.Status) error {
 	client := sock.NewClient(dag.SockAddr())
 	res, err := client.Request("GET", "/status")
@@ -244,8 +588,15 @@ func (e *client) UpdateStatus(ctx context.Context, dag *digraph.DAG, status mode
 	return e.historyStore.Update(ctx, dag.Location, status.RequestID, status)
 }
 
-func (e *client) UpdateDAG(ctx context.Context, id string, spec string) error {
-	return e.dagStore.UpdateSpec(ctx, id, []byte(spec))
+func (e *client) UpdateDAG(ctx context.Context, id string, spec string) ([]digraph.LintWarning, error) {
+	warnings, err := digraph.Lint(ctx, []byte(spec))
+	if err != nil {
+		return nil, err
+	}
+	if err := e.dagStore.UpdateSpec(ctx, id, []byte(spec)); err != nil {
+		return nil, err
+	}
+	return warnings, nil
 }
 
 func (e *client) DeleteDAG(ctx context.Context, name, loc string) error {
@@ -261,16 +612,8 @@ func (e *client) GetAllStatus(ctx context.Context) (
 ) {
 	dagList, errs, err := e.dagStore.List(ctx)
 
-	var ret []DAGStatus
-	for _, d := range dagList {
-		status, err := e.readStatus(ctx, d)
-		if err != nil {
-			errs = append(errs, err.Error())
-		}
-		ret = append(ret, status)
-	}
-
-	return ret, errs, err
+	ret, readErrs := e.readStatuses(ctx, dagList)
+	return ret, append(errs, readErrs...), err
 }
 
 func (e *client) getPageCount(total int, limit int) int {
@@ -293,26 +636,29 @@ func (e *client) GetAllStatusPagination(ctx context.Context, params dags.ListDag
 		limit = int(*params.Limit)
 	}
 
-	if dagListPaginationResult, err = e.dagStore.ListPagination(ctx, persistence.DAGListPaginationArgs{
-		Page:  page,
-		Limit: limit,
-		Name:  fromPtr(params.SearchName),
-		Tag:   fromPtr(params.SearchTag),
-	}); err != nil {
-		return dagStatusList, &DagListPaginationSummaryResult{PageCount: 1}, err
+	args := persistence.DAGListPaginationArgs{
+		Page:       page,
+		Limit:      limit,
+		Name:       fromPtr(params.SearchName),
+		Tag:        fromPtr(params.SearchTag),
+		Namespaces: listedNamespaces(ctx, fromPtr(params.Namespace)),
 	}
-
-	for _, currentDag := range dagListPaginationResult.DagList {
-		var (
-			currentStatus DAGStatus
-			err           error
-		)
-		if currentStatus, err = e.readStatus(ctx, currentDag); err != nil {
-			dagListPaginationResult.ErrorList = append(dagListPaginationResult.ErrorList, err.Error())
+	if since := fromPtr(params.Since); since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return dagStatusList, &DagListPaginationSummaryResult{PageCount: 1}, fmt.Errorf("invalid since %q: %w", since, err)
 		}
-		dagStatusList = append(dagStatusList, currentStatus)
+		args.Filter = e.changedSince(t, time.Now())
 	}
 
+	if dagListPaginationResult, err = e.dagStore.ListPagination(ctx, args); err != nil {
+		return dagStatusList, &DagListPaginationSummaryResult{PageCount: 1}, err
+	}
+
+	statuses, errs := e.readStatuses(ctx, dagListPaginationResult.DagList)
+	dagStatusList = append(dagStatusList, statuses...)
+	dagListPaginationResult.ErrorList = append(dagListPaginationResult.ErrorList, errs...)
+
 	return dagStatusList, &DagListPaginationSummaryResult{
 		PageCount: e.getPageCount(dagListPaginationResult.Count, limit),
 		ErrorList: dagListPaginationResult.ErrorList,
@@ -332,28 +678,122 @@ func (e *client) GetStatus(ctx context.Context, id string) (DAGStatus, error) {
 	}
 	if err == nil {
 		// check the dag is correct in terms of graph
-		_, err = scheduler.NewExecutionGraph(dag.Steps...)
+		if _, graphErr := scheduler.NewExecutionGraph(dag.Steps...); graphErr != nil {
+			err = digraph.InvalidSpec(graphErr)
+		}
 	}
 	latestStatus, _ := e.GetLatestStatus(ctx, dag)
 	return newDAGStatus(
-		dag, latestStatus, e.IsSuspended(ctx, id), err,
+		dag, latestStatus, e.activeSuspension(id), err,
 	), err
 }
 
-func (e *client) ToggleSuspend(_ context.Context, id string, suspend bool) error {
-	return e.flagStore.ToggleSuspend(id, suspend)
+// changedSince returns the filter of the DAGs changed at or after since: the
+// latest status changed, the DAG file was modified, or the DAG was suspended
+// or resumed. Every DAG is changed if the history store doesn't track the
+// changes of the statuses.
+func (e *client) changedSince(since, now time.Time) func(dag *digraph.DAG) bool {
+	tracker, ok := e.historyStore.(persistence.StatusChangeTracker)
+	return func(dag *digraph.DAG) bool {
+		if !ok || tracker.ChangedSince(dag.Location, since) {
+			return true
+		}
+		if info, err := os.Stat(dag.Location); err != nil || !info.ModTime().Before(since) {
+			return true
+		}
+		// The suspension expiring changes the DAG as well.
+		suspension, err := e.flagStore.GetSuspension(dag.ID())
+		return err != nil || suspension != nil &&
+			suspension.Expired(now) && !suspension.Expired(since)
+	}
 }
 
-func (e *client) readStatus(ctx context.Context, dag *digraph.DAG) (DAGStatus, error) {
-	latestStatus, err := e.GetLatestStatus(ctx, dag)
-	id := strings.TrimSuffix(
-		filepath.Base(dag.Location),
-		filepath.Ext(dag.Location),
-	)
+func (e *client) ToggleSuspend(ctx context.Context, id string, suspend bool) error {
+	if err := e.flagStore.ToggleSuspend(id, suspend); err != nil {
+		return err
+	}
+	e.markChanged(ctx, id)
+	return nil
+}
 
-	return newDAGStatus(
-		dag, latestStatus, e.IsSuspended(ctx, id), err,
-	), err
+func (e *client) Suspend(ctx context.Context, id string, suspension persistence.Suspension) error {
+	if err := e.flagStore.Suspend(id, suspension); err != nil {
+		return err
+	}
+	e.markChanged(ctx, id)
+	return nil
+}
+
+// markChanged records the change of the DAG other than its status so that
+// the DAG is listed as changed.
+func (e *client) markChanged(ctx context.Context, id string) {
+	tracker, ok := e.historyStore.(persistence.StatusChangeTracker)
+	if !ok {
+		return
+	}
+	dag, err := e.dagStore.GetMetadata(ctx, id)
+	if err != nil {
+		return
+	}
+	tracker.MarkChanged(dag.Location)
+}
+
+func (e *client) GetSuspension(_ context.Context, id string) (*persistence.Suspension, error) {
+	return e.flagStore.GetSuspension(id)
+}
+
+// activeSuspension returns the suspension of the DAG unless it's expired.
+func (e *client) activeSuspension(id string) *persistence.Suspension {
+	if !e.flagStore.IsSuspended(id) {
+		return nil
+	}
+	suspension, err := e.flagStore.GetSuspension(id)
+	if err != nil || suspension == nil {
+		// The flag is broken, but the DAG is still suspended.
+		return &persistence.Suspension{}
+	}
+	return suspension
+}
+
+// readStatuses reads the latest statuses of the DAGs at once. Only the
+// agents of the DAGs recorded as running are asked for the current status.
+func (e *client) readStatuses(ctx context.Context, dags []*digraph.DAG) ([]DAGStatus, []string) {
+	keys := make([]string, 0, len(dags))
+	for _, dag := range dags {
+		keys = append(keys, dag.Location)
+	}
+	results := e.historyStore.BatchReadLatest(ctx, keys)
+
+	var (
+		ret  []DAGStatus
+		errs []string
+	)
+	for i, dag := range dags {
+		var (
+			latestStatus model.Status
+			current      *model.Status
+			err          error
+		)
+		if status := results[i].Status; status != nil && status.Status == scheduler.StatusRunning {
+			current, _ = e.currentStatus(ctx, dag)
+			if current == nil {
+				// The run may have finished or crashed after the status
+				// was read, e.g. the status is kept in memory by the server.
+				status, err := e.historyStore.ReadStatusToday(ctx, dag.Location)
+				results[i] = persistence.LatestStatus{Status: status, Err: err}
+			}
+		}
+		if current != nil {
+			latestStatus = *current
+		} else {
+			latestStatus, err = latestStatusOf(dag, results[i].Status, results[i].Err)
+		}
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+		ret = append(ret, newDAGStatus(dag, latestStatus, e.activeSuspension(dag.ID()), err))
+	}
+	return ret, errs
 }
 
 func (*client) emptyDAGIfNil(dag *digraph.DAG, dagLocation string) *digraph.DAG {
@@ -387,6 +827,22 @@ func (e *client) GetTagList(ctx context.Context) ([]string, []string, error) {
 	return e.dagStore.TagList(ctx)
 }
 
+// listedNamespaces returns the namespaces to list: the requested one, or
+// all the ones the context is allowed to access. nil lists all of them.
+func listedNamespaces(ctx context.Context, requested string) []string {
+	if requested != "" {
+		if !namespace.Allowed(ctx, requested) {
+			return []string{}
+		}
+		return []string{requested}
+	}
+	allowed, restricted := namespace.AllowedList(ctx)
+	if !restricted {
+		return nil
+	}
+	return allowed
+}
+
 func fromPtr[T any](p *T) T {
 	var zero T
 	if p == nil {
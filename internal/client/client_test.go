@@ -1,19 +1,28 @@
 package client_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dagu-org/dagu/internal/client"
 	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/frontend/gen/restapi/operations/dags"
+	"github.com/dagu-org/dagu/internal/persistence"
+	"github.com/dagu-org/dagu/internal/persistence/local"
+	"github.com/dagu-org/dagu/internal/persistence/local/storage"
 	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/dagu-org/dagu/internal/persistence/statusindex"
 	"github.com/dagu-org/dagu/internal/sock"
 	"github.com/dagu-org/dagu/internal/test"
 )
@@ -102,6 +111,47 @@ func TestClient_GetStatus(t *testing.T) {
 		require.Equal(t, 1, len(status.Nodes))
 		require.Equal(t, newStatus, statusByRequestID.Nodes[0].Status)
 	})
+	t.Run("IdempotencyKey", func(t *testing.T) {
+		dag := th.LoadDAGFile(t, "update_status.yaml")
+		ctx := th.Context
+		cli := th.Client
+
+		// Only one of the concurrent starts with the same key reserves it.
+		requestIDs := make([]string, 10)
+		reserved := make([]string, len(requestIDs))
+		var wg sync.WaitGroup
+		for i := range requestIDs {
+			requestIDs[i] = fmt.Sprintf("test-idempotency-key-%d", i)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				var err error
+				reserved[i], err = cli.ReserveIdempotencyKey(ctx, dag.DAG, "webhook-delivery-1", requestIDs[i])
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+		owner := reserved[0]
+		require.Contains(t, requestIDs, owner)
+		for _, r := range reserved {
+			require.Equal(t, owner, r)
+		}
+
+		// Another key is reserved for another run.
+		other, err := cli.ReserveIdempotencyKey(ctx, dag.DAG, "webhook-delivery-2", "test-idempotency-key-other")
+		require.NoError(t, err)
+		require.Equal(t, "test-idempotency-key-other", other)
+
+		// Only the run the key is reserved for releases it.
+		require.NoError(t, cli.ReleaseIdempotencyKey(ctx, dag.DAG, "webhook-delivery-2", "test-idempotency-key-0"))
+		other, err = cli.ReserveIdempotencyKey(ctx, dag.DAG, "webhook-delivery-2", "test-idempotency-key-next")
+		require.NoError(t, err)
+		require.Equal(t, "test-idempotency-key-other", other)
+		require.NoError(t, cli.ReleaseIdempotencyKey(ctx, dag.DAG, "webhook-delivery-2", "test-idempotency-key-other"))
+		other, err = cli.ReserveIdempotencyKey(ctx, dag.DAG, "webhook-delivery-2", "test-idempotency-key-next")
+		require.NoError(t, err)
+		require.Equal(t, "test-idempotency-key-next", other)
+	})
 	t.Run("InvalidUpdateStatusWithInvalidReqID", func(t *testing.T) {
 		wrongReqID := "invalid-request-id"
 		dag := th.LoadDAGFile(t, "invalid_reqid.yaml")
@@ -141,10 +191,28 @@ func TestClient_RunDAG(t *testing.T) {
 
 		dag.AssertLatestStatus(t, scheduler.StatusRunning)
 
-		err := th.Client.Stop(ctx, dag.DAG)
+		status, err := th.Client.GetCurrentStatus(ctx, dag.DAG)
+		require.NoError(t, err)
+		requestID := status.RequestID
+
+		// Another run is not stopped.
+		_, err = th.Client.Stop(ctx, dag.DAG, "another-run")
+		require.ErrorIs(t, err, persistence.ErrRequestIDNotFound)
+
+		result, err := th.Client.Stop(ctx, dag.DAG, requestID)
 		require.NoError(t, err)
+		require.Equal(t, client.StopSignalled, result)
 
 		dag.AssertLatestStatus(t, scheduler.StatusCancel)
+
+		// Stopping the run again is not an error.
+		result, err = th.Client.Stop(ctx, dag.DAG, requestID)
+		require.NoError(t, err)
+		require.Equal(t, client.StopAlreadyStopped, result)
+
+		result, err = th.Client.Stop(ctx, dag.DAG, "")
+		require.NoError(t, err)
+		require.Equal(t, client.StopNotRunning, result)
 	})
 	t.Run("Restart", func(t *testing.T) {
 		dag := th.LoadDAGFile(t, "restart.yaml")
@@ -186,6 +254,123 @@ func TestClient_RunDAG(t *testing.T) {
 		require.NotEqual(t, previousRequestID, status.RequestID)
 		require.Equal(t, previousParams, status.Params)
 	})
+	t.Run("DelayedStart", func(t *testing.T) {
+		dag := th.LoadDAGFile(t, "run_dag.yaml")
+		ctx := th.Context
+		cli := th.Client
+
+		startAt := time.Now().Add(time.Hour)
+		err := cli.StartLater(ctx, dag.DAG, client.StartOptions{RequestID: "delayed"}, startAt)
+		require.NoError(t, err)
+
+		starts, err := cli.GetDelayedStarts(ctx, dag.DAG)
+		require.NoError(t, err)
+		require.Len(t, starts, 1)
+		require.Equal(t, "delayed", starts[0].RequestID)
+
+		// The run is not started before the time.
+		started, err := cli.StartDelayedRuns(ctx, time.Now())
+		require.NoError(t, err)
+		require.Empty(t, started)
+
+		started, err = cli.StartDelayedRuns(ctx, startAt)
+		require.NoError(t, err)
+		require.Len(t, started, 1)
+
+		dag.AssertLatestStatus(t, scheduler.StatusSuccess)
+		status, err := cli.GetLatestStatus(ctx, dag.DAG)
+		require.NoError(t, err)
+		require.Equal(t, "delayed", status.RequestID)
+
+		starts, err = cli.GetDelayedStarts(ctx, dag.DAG)
+		require.NoError(t, err)
+		require.Empty(t, starts)
+	})
+}
+
+func TestClient_Queue(t *testing.T) {
+	th := test.Setup(t)
+	dag := th.LoadDAGFile(t, "run_dag.yaml")
+	ctx := th.Context
+	cli := th.Client
+
+	now := time.Now()
+	first, second := now.Add(time.Hour), now.Add(2*time.Hour)
+	require.NoError(t, cli.StartLater(ctx, dag.DAG, client.StartOptions{RequestID: "first"}, first))
+	require.NoError(t, cli.StartLater(ctx, dag.DAG, client.StartOptions{RequestID: "second"}, second))
+
+	// The runs wait for their start time in the order of the time.
+	runs, err := cli.GetQueue(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	require.Equal(t, "first", runs[0].RequestID)
+	require.Equal(t, 1, runs[0].Position)
+	require.Equal(t, client.QueueReasonDelayed, runs[0].Reason)
+	require.Equal(t, "second", runs[1].RequestID)
+	require.Equal(t, 2, runs[1].Position)
+
+	// The due runs are started in the order of the priority.
+	require.NoError(t, cli.SetQueuedRunPriority(ctx, "second", 10))
+	runs, err = cli.GetQueue(ctx, second)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	require.Equal(t, "second", runs[0].RequestID)
+	require.Equal(t, 10, runs[0].Priority)
+	require.Equal(t, client.QueueReasonDue, runs[0].Reason)
+	require.Equal(t, "first", runs[1].RequestID)
+
+	// The cancelled run is never started.
+	require.NoError(t, cli.CancelQueuedRun(ctx, "second"))
+	require.ErrorIs(t, cli.CancelQueuedRun(ctx, "second"), persistence.ErrRequestIDNotFound)
+	require.ErrorIs(t, cli.SetQueuedRunPriority(ctx, "second", 1), persistence.ErrRequestIDNotFound)
+	runs, err = cli.GetQueue(ctx, second)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	require.Equal(t, "first", runs[0].RequestID)
+	require.Equal(t, 1, runs[0].Position)
+	require.NoError(t, cli.CancelQueuedRun(ctx, "first"))
+}
+
+func TestClient_SkipStep(t *testing.T) {
+	th := test.Setup(t)
+	dag := th.LoadDAGFile(t, "run_dag.yaml")
+	ctx := th.Context
+	cli := th.Client
+
+	// The step to skip is recorded in the queued run.
+	require.NoError(t, cli.StartLater(ctx, dag.DAG, client.StartOptions{RequestID: "queued"}, time.Now().Add(time.Hour)))
+	require.NoError(t, cli.SkipStep(ctx, dag.DAG, "queued", "1"))
+	require.NoError(t, cli.SkipStep(ctx, dag.DAG, "queued", "1"))
+	runs, err := cli.GetQueue(ctx, time.Now())
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	require.Equal(t, []string{"1"}, runs[0].SkipSteps)
+	require.NoError(t, cli.CancelQueuedRun(ctx, "queued"))
+
+	// The run which is neither queued nor running can't be changed.
+	require.ErrorIs(t, cli.SkipStep(ctx, dag.DAG, "queued", "1"), client.ErrRunNotActive)
+}
+
+func TestClient_SignalStep(t *testing.T) {
+	th := test.Setup(t)
+	dag := th.LoadDAGFile(t, "stop.yaml")
+	ctx := th.Context
+	cli := th.Client
+
+	require.ErrorIs(t, cli.SignalStep(ctx, dag.DAG, "not-running", "1", "SIGHUP"), client.ErrRunNotActive)
+
+	cli.StartAsync(ctx, dag.DAG, client.StartOptions{})
+	dag.AssertLatestStatus(t, scheduler.StatusRunning)
+	status, err := cli.GetCurrentStatus(ctx, dag.DAG)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, cli.SignalStep(ctx, dag.DAG, "another-run", "1", "SIGHUP"), client.ErrRunNotActive)
+
+	// The step terminated by the signal fails instead of being cancelled.
+	require.Eventually(t, func() bool {
+		return cli.SignalStep(ctx, dag.DAG, status.RequestID, "1", "SIGTERM") == nil
+	}, 5*time.Second, 100*time.Millisecond)
+	dag.AssertLatestStatus(t, scheduler.StatusError)
 }
 
 func TestClient_UpdateDAG(t *testing.T) {
@@ -204,7 +389,7 @@ steps:
     command: "true"
 `
 		// Update Error: the DAG does not exist
-		err := cli.UpdateDAG(ctx, "non-existing-dag", validDAG)
+		_, err := cli.UpdateDAG(ctx, "non-existing-dag", validDAG)
 		require.Error(t, err)
 
 		// create a new DAG file
@@ -212,7 +397,7 @@ steps:
 		require.NoError(t, err)
 
 		// Update the DAG
-		err = cli.UpdateDAG(ctx, id, validDAG)
+		_, err = cli.UpdateDAG(ctx, id, validDAG)
 		require.NoError(t, err)
 
 		// Check the content of the DAG file
@@ -220,6 +405,29 @@ steps:
 		require.NoError(t, err)
 		require.Equal(t, validDAG, spec)
 	})
+	t.Run("LintWarnings", func(t *testing.T) {
+		ctx := th.Context
+		cli := th.Client
+
+		id, err := cli.CreateDAG(ctx, "lint-dag-file")
+		require.NoError(t, err)
+
+		// The missing executable is reported as a warning
+		warnings, err := cli.UpdateDAG(ctx, id, `steps:
+  - name: "1"
+    command: dagu-missing-executable
+`)
+		require.NoError(t, err)
+		require.Len(t, warnings, 1)
+
+		// The cycle in the dependencies fails
+		_, err = cli.UpdateDAG(ctx, id, `steps:
+  - name: "1"
+    command: "true"
+    depends: "1"
+`)
+		require.Error(t, err)
+	})
 	t.Run("Remove", func(t *testing.T) {
 		ctx := th.Context
 		cli := th.Client
@@ -231,7 +439,7 @@ steps:
 `
 		id, err := cli.CreateDAG(ctx, "test")
 		require.NoError(t, err)
-		err = cli.UpdateDAG(ctx, id, spec)
+		_, err = cli.UpdateDAG(ctx, id, spec)
 		require.NoError(t, err)
 
 		// check file
@@ -316,6 +524,76 @@ func testNewStatus(dag *digraph.DAG, requestID string, status scheduler.Status,
 	)
 }
 
+func TestClient_RecoverStaleRuns(t *testing.T) {
+	t.Parallel()
+
+	th := test.Setup(t)
+	ctx := th.Context
+	cli := th.Client
+
+	dag := th.LoadDAGFile(t, "update_status.yaml")
+
+	// Write a status of a run left running without a live process.
+	requestID := "test-stale-run"
+	err := th.HistoryStore.Open(ctx, dag.Location, time.Now(), requestID)
+	require.NoError(t, err)
+	status := testNewStatus(dag.DAG, requestID, scheduler.StatusRunning, scheduler.NodeStatusRunning)
+	err = th.HistoryStore.Write(ctx, status)
+	require.NoError(t, err)
+	_ = th.HistoryStore.Close(ctx)
+
+	recovered, err := cli.RecoverStaleRuns(ctx, dag.DAG)
+	require.NoError(t, err)
+	require.Len(t, recovered, 1)
+	require.Equal(t, requestID, recovered[0].RequestID)
+
+	stored, err := cli.GetStatusByRequestID(ctx, dag.DAG, requestID)
+	require.NoError(t, err)
+	require.Equal(t, scheduler.StatusError, stored.Status)
+	require.Equal(t, scheduler.NodeStatusError, stored.Nodes[0].Status)
+	require.NotEmpty(t, stored.Nodes[0].Error)
+	require.Len(t, stored.Notes, 1)
+
+	// The run is not recovered twice.
+	recovered, err = cli.RecoverStaleRuns(ctx, dag.DAG)
+	require.NoError(t, err)
+	require.Empty(t, recovered)
+}
+
+func TestClient_RecoverStaleRunsWithHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	th := test.Setup(t)
+	ctx := th.Context
+	cli := th.Client
+
+	dag := th.LoadDAGFile(t, "update_status.yaml")
+
+	// Write a status of a run with a recent heartbeat.
+	requestID := "test-heartbeat"
+	err := th.HistoryStore.Open(ctx, dag.Location, time.Now(), requestID)
+	require.NoError(t, err)
+	status := testNewStatus(dag.DAG, requestID, scheduler.StatusRunning, scheduler.NodeStatusRunning)
+	model.WithHeartbeat(time.Now())(&status)
+	err = th.HistoryStore.Write(ctx, status)
+	require.NoError(t, err)
+
+	// The run is alive while the heartbeat is recent.
+	recovered, err := cli.RecoverStaleRuns(ctx, dag.DAG)
+	require.NoError(t, err)
+	require.Empty(t, recovered)
+
+	// The run is stale after the heartbeat stops.
+	model.WithHeartbeat(time.Now().Add(-time.Hour))(&status)
+	err = th.HistoryStore.Write(ctx, status)
+	require.NoError(t, err)
+	_ = th.HistoryStore.Close(ctx)
+
+	recovered, err = cli.RecoverStaleRuns(ctx, dag.DAG)
+	require.NoError(t, err)
+	require.Len(t, recovered, 1)
+}
+
 func TestClient_GetTagList(t *testing.T) {
 	th := test.Setup(t)
 
@@ -332,7 +610,7 @@ func TestClient_GetTagList(t *testing.T) {
 		} else {
 			spec = "tags: tag2,tag3\nsteps:\n  - name: step1\n    command: echo hello\n"
 		}
-		if err = cli.UpdateDAG(ctx, id, spec); err != nil {
+		if _, err = cli.UpdateDAG(ctx, id, spec); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -351,3 +629,131 @@ func TestClient_GetTagList(t *testing.T) {
 	require.True(t, mapTags["tag2"])
 	require.True(t, mapTags["tag3"])
 }
+
+func TestClient_GetAllStatusPaginationSince(t *testing.T) {
+	th := test.Setup(t)
+
+	ctx := th.Context
+	cfg := th.Config
+	idx := statusindex.New(th.HistoryStore)
+	cli := client.New(
+		th.DAGStore, idx,
+		local.NewFlagStore(storage.NewStorage(cfg.Paths.SuspendFlagsDir)),
+		local.NewDelayedStartStore(storage.NewStorage(filepath.Join(cfg.Paths.DataDir, local.DelayedStartDirName))),
+		cfg.Paths.Executable, cfg.WorkDir,
+	)
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		id, err := cli.CreateDAG(ctx, fmt.Sprintf("since-test-%d", i))
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	list := func(since time.Time, limit int64) ([]client.DAGStatus, int) {
+		t.Helper()
+		params := dags.ListDagsParams{Limit: &limit}
+		if !since.IsZero() {
+			s := since.Format(time.RFC3339Nano)
+			params.Since = &s
+		}
+		statuses, result, err := cli.GetAllStatusPagination(ctx, params)
+		require.NoError(t, err)
+		return statuses, result.PageCount
+	}
+
+	// The statuses not read yet are changed.
+	statuses, _ := list(time.Now(), 100)
+	require.Len(t, statuses, 3)
+
+	time.Sleep(time.Millisecond * 10)
+	since := time.Now()
+	statuses, pageCount := list(since, 100)
+	require.Empty(t, statuses)
+	require.Equal(t, 1, pageCount)
+
+	t.Run("StatusChanged", func(t *testing.T) {
+		dag, err := th.DAGStore.GetMetadata(ctx, ids[1])
+		require.NoError(t, err)
+		require.NoError(t, th.HistoryStore.Open(ctx, dag.Location, time.Now(), "request-1"))
+		require.NoError(t, th.HistoryStore.Write(ctx, model.NewStatusFactory(dag).Create("request-1", scheduler.StatusSuccess, 0, time.Now())))
+		require.NoError(t, th.HistoryStore.Close(ctx))
+		require.NoError(t, idx.Refresh(ctx, dag.Location))
+
+		// The page count is of the changed DAGs.
+		statuses, pageCount := list(since, 1)
+		require.Len(t, statuses, 1)
+		require.Equal(t, 1, pageCount)
+		require.Equal(t, "request-1", statuses[0].Status.RequestID)
+	})
+	t.Run("Suspended", func(t *testing.T) {
+		require.NoError(t, cli.ToggleSuspend(ctx, ids[2], true))
+
+		statuses, pageCount := list(since, 1)
+		require.Len(t, statuses, 1)
+		require.Equal(t, 2, pageCount)
+	})
+	t.Run("InvalidSince", func(t *testing.T) {
+		since := "yesterday"
+		_, _, err := cli.GetAllStatusPagination(ctx, dags.ListDagsParams{Since: &since})
+		require.Error(t, err)
+	})
+}
+
+func TestClient_Drain(t *testing.T) {
+	t.Parallel()
+
+	// newClient returns a client that runs the script instead of the
+	// executable.
+	newClient := func(t *testing.T, script string) client.Client {
+		t.Helper()
+		dir := t.TempDir()
+		executable := filepath.Join(dir, "dagu")
+		err := os.WriteFile(executable, []byte("#!/bin/sh\n"+script+"\n"), 0755)
+		require.NoError(t, err)
+		return client.New(nil, nil, nil, nil, executable, dir)
+	}
+	dag := &digraph.DAG{Name: "drain", Location: "drain.yaml"}
+
+	t.Run("FinishedWithinGracePeriod", func(t *testing.T) {
+		cli := newClient(t, "sleep 0.5")
+		ctx := context.Background()
+
+		done := make(chan error, 1)
+		go func() { done <- cli.Start(ctx, dag, client.StartOptions{}) }()
+		time.Sleep(time.Millisecond * 100)
+
+		result := cli.Drain(ctx, time.Second*10)
+		require.True(t, result.Clean)
+		require.Empty(t, result.Stopped)
+		require.NoError(t, <-done)
+
+		// New runs are not accepted after draining
+		err := cli.Start(ctx, dag, client.StartOptions{})
+		require.ErrorIs(t, err, client.ErrDraining)
+	})
+	t.Run("StoppedAfterGracePeriod", func(t *testing.T) {
+		cli := newClient(t, "exec sleep 10")
+		ctx := context.Background()
+
+		done := make(chan error, 1)
+		go func() { done <- cli.Start(ctx, dag, client.StartOptions{}) }()
+		time.Sleep(time.Millisecond * 100)
+
+		result := cli.Drain(ctx, time.Millisecond*100)
+		require.False(t, result.Clean)
+		require.Equal(t, []string{"drain"}, result.Stopped)
+		require.Error(t, <-done)
+	})
+	t.Run("ShutdownMarker", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "scheduler.shutdown")
+		err := client.WriteShutdownMarker(file, "scheduler", client.DrainResult{Clean: true})
+		require.NoError(t, err)
+
+		marker, err := client.ReadShutdownMarker(file)
+		require.NoError(t, err)
+		require.Equal(t, "scheduler", marker.Service)
+		require.True(t, marker.Clean)
+		require.False(t, marker.ShutdownAt.IsZero())
+	})
+}
@@ -2,10 +2,13 @@ package client
 
 import (
 	"context"
+	"errors"
 	"path/filepath"
+	"time"
 
 	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/dagu-org/dagu/internal/frontend/gen/restapi/operations/dags"
+	"github.com/dagu-org/dagu/internal/namespace"
 	"github.com/dagu-org/dagu/internal/persistence"
 	"github.com/dagu-org/dagu/internal/persistence/model"
 )
@@ -13,33 +16,177 @@ import (
 type Client interface {
 	CreateDAG(ctx context.Context, id string) (string, error)
 	GetDAGSpec(ctx context.Context, id string) (string, error)
-	Grep(ctx context.Context, pattern string) ([]*persistence.GrepResult, []string, error)
+	Search(ctx context.Context, query string) ([]*persistence.SearchResult, []string, error)
 	Rename(ctx context.Context, oldID, newID string) error
-	Stop(ctx context.Context, dag *digraph.DAG) error
+	// Stop stops the run of the DAG with the request ID, or the running run
+	// of the DAG if the request ID is empty. The agent verifies the request
+	// ID so that another run of the DAG is never stopped. It returns
+	// persistence.ErrRequestIDNotFound if the run doesn't exist.
+	Stop(ctx context.Context, dag *digraph.DAG, requestID string) (StopResult, error)
 	StartAsync(ctx context.Context, dag *digraph.DAG, opts StartOptions)
 	Start(ctx context.Context, dag *digraph.DAG, opts StartOptions) error
+	// StartLater registers the run to be started by the scheduler at the
+	// time. The request ID of the options is required.
+	StartLater(ctx context.Context, dag *digraph.DAG, opts StartOptions, at time.Time) error
+	// GetDelayedStarts returns the runs of the DAG registered to be started
+	// later, the earliest first.
+	GetDelayedStarts(ctx context.Context, dag *digraph.DAG) ([]persistence.DelayedStart, error)
+	// StartDelayedRuns starts the registered runs due at the time and
+	// returns them. The runs of a DAG that is running stay in the queue.
+	StartDelayedRuns(ctx context.Context, now time.Time) ([]persistence.DelayedStart, error)
+	// GetQueue returns the runs registered to be started later in the
+	// order they are started, with the reason each one is waiting.
+	GetQueue(ctx context.Context, now time.Time) ([]QueuedRun, error)
+	// CancelQueuedRun removes the run from the queue. It returns
+	// persistence.ErrRequestIDNotFound if the run is not queued.
+	CancelQueuedRun(ctx context.Context, requestID string) error
+	// SetQueuedRunPriority changes the priority of the queued run. The due
+	// runs of a DAG are started in the order of the priority.
+	SetQueuedRunPriority(ctx context.Context, requestID string, priority int) error
 	Restart(ctx context.Context, dag *digraph.DAG, opts RestartOptions) error
 	Retry(ctx context.Context, dag *digraph.DAG, requestID string) error
+	RetryStep(ctx context.Context, dag *digraph.DAG, requestID, step string) error
+	// SkipStep marks the step of the queued or running run to be skipped.
+	// The steps after it run as if it succeeded. It returns
+	// ErrRunNotActive if the run is neither queued nor running.
+	SkipStep(ctx context.Context, dag *digraph.DAG, requestID, step string) error
+	// SignalStep sends the signal (e.g. "SIGHUP") to the process group of
+	// the running step without stopping the run. It returns ErrRunNotActive
+	// if the run is not running and scheduler.ErrStepNotRunning if the step
+	// is not running.
+	SignalStep(ctx context.Context, dag *digraph.DAG, requestID, step, signal string) error
 	GetCurrentStatus(ctx context.Context, dag *digraph.DAG) (*model.Status, error)
 	GetStatusByRequestID(ctx context.Context, dag *digraph.DAG, requestID string) (*model.Status, error)
+	// ReserveIdempotencyKey reserves the idempotency key of the DAG for the
+	// run with the request ID. It returns the request ID of the run the key
+	// is reserved for; the run must not be started if it's not requestID.
+	ReserveIdempotencyKey(ctx context.Context, dag *digraph.DAG, key, requestID string) (string, error)
+	// ReleaseIdempotencyKey releases the idempotency key reserved for the
+	// run that failed to be started.
+	ReleaseIdempotencyKey(ctx context.Context, dag *digraph.DAG, key, requestID string) error
 	GetLatestStatus(ctx context.Context, dag *digraph.DAG) (model.Status, error)
 	GetRecentHistory(ctx context.Context, dag *digraph.DAG, n int) []model.StatusFile
+	// ReadStatusFile reads the status in the File of a model.StatusFile of
+	// the history.
+	ReadStatusFile(ctx context.Context, file string) (*model.Status, error)
 	UpdateStatus(ctx context.Context, dag *digraph.DAG, status model.Status) error
-	UpdateDAG(ctx context.Context, id string, spec string) error
+	// UpdateDAG saves the spec of the DAG. It returns the lint warnings of
+	// the spec; hard errors such as a cycle in the dependencies fail.
+	UpdateDAG(ctx context.Context, id string, spec string) ([]digraph.LintWarning, error)
 	DeleteDAG(ctx context.Context, id, loc string) error
 	GetAllStatus(ctx context.Context) (statuses []DAGStatus, errs []string, err error)
 	GetAllStatusPagination(ctx context.Context, params dags.ListDagsParams) ([]DAGStatus, *DagListPaginationSummaryResult, error)
 	GetStatus(ctx context.Context, dagLocation string) (DAGStatus, error)
 	IsSuspended(ctx context.Context, id string) bool
 	ToggleSuspend(ctx context.Context, id string, suspend bool) error
+	// Suspend suspends the DAG with the reason and the expiry.
+	Suspend(ctx context.Context, id string, suspension persistence.Suspension) error
+	// GetSuspension returns the suspension of the DAG including an expired
+	// one, or nil if the DAG is not suspended.
+	GetSuspension(ctx context.Context, id string) (*persistence.Suspension, error)
 	GetTagList(ctx context.Context) ([]string, []string, error)
+	// Drain stops accepting new runs and waits up to the grace period for
+	// the runs started by the client to finish. The runs still running
+	// after the grace period are stopped.
+	Drain(ctx context.Context, gracePeriod time.Duration) DrainResult
+	// RecoverStaleRuns marks the runs of the DAG that are recorded as running
+	// but have no live process as failed and returns the recovered runs.
+	RecoverStaleRuns(ctx context.Context, dag *digraph.DAG) ([]model.Status, error)
+	// RecoverAllStaleRuns recovers the stale runs of all the DAGs.
+	RecoverAllStaleRuns(ctx context.Context) ([]RecoveredRun, error)
+}
+
+// RecoveredRun is a run marked as failed because its process was gone.
+type RecoveredRun struct {
+	DAG    *digraph.DAG
+	Status model.Status
+}
+
+// ErrDraining is returned when a run is requested while the client is
+// draining.
+var ErrDraining = errors.New("not accepting new runs: shutting down")
+
+// ErrRunNotActive is returned if the run is neither queued nor running.
+var ErrRunNotActive = errors.New("the run is neither queued nor running")
+
+// StopResult is the result of stopping a run.
+type StopResult string
+
+const (
+	// StopSignalled is the result when the run was requested to stop.
+	StopSignalled StopResult = "signalled"
+	// StopAlreadyStopped is the result when the run had finished or had
+	// been requested to stop before.
+	StopAlreadyStopped StopResult = "already-stopped"
+	// StopNotRunning is the result when the DAG has no running run.
+	StopNotRunning StopResult = "not-running"
+)
+
+// DrainResult is the result of draining the runs.
+type DrainResult struct {
+	// Clean is true if all the runs finished within the grace period.
+	Clean bool
+	// Stopped is the names of the DAGs stopped after the grace period.
+	Stopped []string
 }
 
 type StartOptions struct {
 	Params string
 	Quiet  bool
+	// RequestID is the request ID of the run. It's generated if empty.
+	RequestID string
+	// IdempotencyKey prevents starting a duplicate run. If a run with the
+	// same key exists, the DAG is not started.
+	IdempotencyKey string
+	// Labels is the arbitrary key/value metadata of the run.
+	Labels map[string]string
+	// Trigger is what started the run. It's recorded in the lineage of the
+	// run and defaults to the command line.
+	Trigger model.Trigger
+	// Schedule is the cron expression of the schedule that started the
+	// run. It's recorded in the lineage of the run.
+	Schedule string
+	// ScheduledTime is the time the run was scheduled to start at. It
+	// defaults to the time the run is started.
+	ScheduledTime time.Time
+	// ExecutionDate is the logical date of the data the run processes. It
+	// defaults to the scheduled time.
+	ExecutionDate time.Time
+	// SkipSteps is the names of the steps to skip in the run.
+	SkipSteps []string
+	// From is the name of the step to start the run from. The steps it
+	// depends on are skipped.
+	From string
+	// Target is the name of the step to run with the steps it depends on.
+	// The other steps are skipped.
+	Target string
+}
+
+// QueuedRun is a run waiting in the queue to be started by the scheduler.
+type QueuedRun struct {
+	persistence.DelayedStart
+	// DAG is the DAG of the run. It's nil if the DAG can't be loaded.
+	DAG *digraph.DAG
+	// Position is the 1-based position of the run in the queue of its DAG.
+	Position int
+	// Reason is why the run has not started yet.
+	Reason QueueReason
 }
 
+// QueueReason is the reason a queued run is waiting.
+type QueueReason string
+
+const (
+	// QueueReasonDelayed is the run waiting for its start time.
+	QueueReasonDelayed QueueReason = "delayed"
+	// QueueReasonConcurrency is the due run waiting for the current run of
+	// the DAG to finish.
+	QueueReasonConcurrency QueueReason = "concurrency"
+	// QueueReasonDue is the due run to be started in the next round of the
+	// scheduler.
+	QueueReasonDue QueueReason = "due"
+)
+
 type RestartOptions struct {
 	Quiet bool
 }
@@ -50,8 +197,11 @@ type DAGStatus struct {
 	DAG       *digraph.DAG
 	Status    model.Status
 	Suspended bool
-	Error     error
-	ErrorT    *string
+	// Suspension is the reason and the expiry of the suspension. It's nil if
+	// the DAG is not suspended.
+	Suspension *persistence.Suspension
+	Error      error
+	ErrorT     *string
 }
 
 type DagListPaginationSummaryResult struct {
@@ -60,15 +210,16 @@ type DagListPaginationSummaryResult struct {
 }
 
 func newDAGStatus(
-	dag *digraph.DAG, status model.Status, suspended bool, err error,
+	dag *digraph.DAG, status model.Status, suspension *persistence.Suspension, err error,
 ) DAGStatus {
 	ret := DAGStatus{
-		File:      filepath.Base(dag.Location),
-		Dir:       filepath.Dir(dag.Location),
-		DAG:       dag,
-		Status:    status,
-		Suspended: suspended,
-		Error:     err,
+		File:       namespace.Join(dag.Namespace, filepath.Base(dag.Location)),
+		Dir:        filepath.Dir(dag.Location),
+		DAG:        dag,
+		Status:     status,
+		Suspended:  suspension != nil,
+		Suspension: suspension,
+		Error:      err,
 	}
 	if err != nil {
 		errT := err.Error()
@@ -0,0 +1,175 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/namespace"
+	"github.com/dagu-org/dagu/internal/persistence"
+)
+
+func (e *client) GetQueue(ctx context.Context, now time.Time) ([]QueuedRun, error) {
+	starts, err := e.delayedStartStore.List()
+	if err != nil {
+		return nil, err
+	}
+	sortQueue(starts, now)
+
+	var ret []QueuedRun
+	dags := make(map[string]*digraph.DAG)
+	positions := make(map[string]int)
+	for _, start := range starts {
+		dag, ok := dags[start.DAG]
+		if !ok {
+			if dag, err = e.dagStore.GetMetadata(ctx, start.DAG); err != nil {
+				// The DAG was deleted after the run was queued.
+				logger.Warn(ctx, "Failed to load the DAG of the queued run", "DAG", start.DAG, "reqId", start.RequestID, "err", err)
+			}
+			dags[start.DAG] = dag
+		}
+		if dag != nil && !namespace.Allowed(ctx, dag.Namespace) {
+			continue
+		}
+		positions[start.DAG]++
+		run := QueuedRun{
+			DelayedStart: start,
+			DAG:          dag,
+			Position:     positions[start.DAG],
+			Reason:       QueueReasonDelayed,
+		}
+		if !start.StartAt.After(now) {
+			run.Reason = QueueReasonDue
+			if dag != nil && e.isRunning(ctx, dag) {
+				run.Reason = QueueReasonConcurrency
+			}
+		}
+		ret = append(ret, run)
+	}
+	return ret, nil
+}
+
+func (e *client) CancelQueuedRun(_ context.Context, requestID string) error {
+	return e.delayedStartStore.Remove(requestID)
+}
+
+func (e *client) SetQueuedRunPriority(_ context.Context, requestID string, priority int) error {
+	starts, err := e.delayedStartStore.List()
+	if err != nil {
+		return err
+	}
+	for _, start := range starts {
+		if start.RequestID != requestID {
+			continue
+		}
+		// The run is removed first in the same way as the scheduler takes
+		// it, so that the run started meanwhile is not queued again.
+		if err := e.delayedStartStore.Remove(requestID); err != nil {
+			return err
+		}
+		start.Priority = priority
+		return e.delayedStartStore.Add(start)
+	}
+	return fmt.Errorf("%w: %s", persistence.ErrRequestIDNotFound, requestID)
+}
+
+func (e *client) StartDelayedRuns(ctx context.Context, now time.Time) ([]persistence.DelayedStart, error) {
+	starts, err := e.delayedStartStore.List()
+	if err != nil {
+		return nil, err
+	}
+	sortQueue(starts, now)
+
+	var ret []persistence.DelayedStart
+	// Only the first due run of each DAG is started at a time as a DAG
+	// runs one at a time. The others wait for the next round.
+	taken := make(map[string]bool)
+	for _, start := range starts {
+		if start.StartAt.After(now) {
+			// The rest are later.
+			break
+		}
+		if taken[start.DAG] {
+			continue
+		}
+		taken[start.DAG] = true
+
+		dag, err := e.dagStore.GetMetadata(ctx, start.DAG)
+		if err != nil {
+			logger.Error(ctx, "Failed to load the DAG of the delayed start", "DAG", start.DAG, "reqId", start.RequestID, "err", err)
+			continue
+		}
+		if e.isRunning(ctx, dag) {
+			// The run stays in the queue until the current run finishes.
+			continue
+		}
+		if err := e.delayedStartStore.Remove(start.RequestID); err != nil {
+			if !errors.Is(err, persistence.ErrRequestIDNotFound) {
+				logger.Error(ctx, "Failed to remove the delayed start", "reqId", start.RequestID, "err", err)
+			}
+			// Another process started the run.
+			continue
+		}
+		e.StartAsync(ctx, dag, StartOptions{
+			Params:         start.Params,
+			Quiet:          true,
+			RequestID:      start.RequestID,
+			IdempotencyKey: start.IdempotencyKey,
+			Labels:         start.Labels,
+			Trigger:        start.Trigger,
+			ScheduledTime:  start.StartAt,
+			ExecutionDate:  start.ExecutionDate,
+			SkipSteps:      start.SkipSteps,
+			From:           start.From,
+			Target:         start.Target,
+		})
+		ret = append(ret, start)
+	}
+	return ret, nil
+}
+
+// isRunning returns true if the DAG is running or its run was started by
+// the client and has not recorded its status yet.
+func (e *client) isRunning(ctx context.Context, dag *digraph.DAG) bool {
+	e.runsMu.Lock()
+	for _, d := range e.runs {
+		if d.Location == dag.Location {
+			e.runsMu.Unlock()
+			return true
+		}
+	}
+	e.runsMu.Unlock()
+
+	status, err := e.GetLatestStatus(ctx, dag)
+	return err == nil && status.Status == scheduler.StatusRunning
+}
+
+// sortQueue sorts the runs in the order they are started. The runs due at
+// the time are started first in the order of the priority, and the later
+// ones are in the order of the start time.
+func sortQueue(starts []persistence.DelayedStart, now time.Time) {
+	startAt := func(s persistence.DelayedStart) time.Time {
+		if s.StartAt.Before(now) {
+			return now
+		}
+		return s.StartAt
+	}
+	sort.SliceStable(starts, func(i, j int) bool {
+		a, b := starts[i], starts[j]
+		if ta, tb := startAt(a), startAt(b); !ta.Equal(tb) {
+			return ta.Before(tb)
+		}
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		if !a.StartAt.Equal(b.StartAt) {
+			return a.StartAt.Before(b.StartAt)
+		}
+		return a.RegisteredAt.Before(b.RegisteredAt)
+	})
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ShutdownMarker is persisted when a service shuts down after draining the
+// runs it started.
+type ShutdownMarker struct {
+	// Service is the name of the service (e.g. scheduler).
+	Service string `json:"service"`
+	// ShutdownAt is the time the service finished draining.
+	ShutdownAt time.Time `json:"shutdownAt"`
+	// Clean is true if all the runs finished within the grace period.
+	Clean bool `json:"clean"`
+	// Stopped is the names of the DAGs stopped after the grace period.
+	Stopped []string `json:"stopped,omitempty"`
+}
+
+// WriteShutdownMarker writes the marker of the drain result to the file.
+func WriteShutdownMarker(file, service string, result DrainResult) error {
+	marker := ShutdownMarker{
+		Service:    service,
+		ShutdownAt: time.Now(),
+		Clean:      result.Clean,
+		Stopped:    result.Stopped,
+	}
+	data, err := json.Marshal(marker)
+	if err != nil {
+		return fmt.Errorf("failed to marshal shutdown marker: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return fmt.Errorf("failed to create directory for shutdown marker: %w", err)
+	}
+	if err := os.WriteFile(file, data, 0600); err != nil {
+		return fmt.Errorf("failed to write shutdown marker: %w", err)
+	}
+	return nil
+}
+
+// ReadShutdownMarker reads the shutdown marker from the file.
+func ReadShutdownMarker(file string) (*ShutdownMarker, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var marker ShutdownMarker
+	if err := json.Unmarshal(data, &marker); err != nil {
+		return nil, fmt.Errorf("failed to parse shutdown marker: %w", err)
+	}
+	return &marker, nil
+}
@@ -128,10 +128,27 @@ func SplitCommand(cmd string) (string, []string, error) {
 	return command[0], command[1:], nil
 }
 
-var ErrCommandIsEmpty = fmt.Errorf("command is empty")
+var (
+	ErrCommandIsEmpty    = fmt.Errorf("command is empty")
+	ErrUnterminatedQuote = fmt.Errorf("unterminated quote")
+)
 
 // ParsePipedCommand splits a shell-style command string into a pipeline ([][]string).
-// Each sub-slice represents a single command. Unquoted "|" tokens define the boundaries.
+// Each sub-slice is the arguments of a single command. Unquoted "|" tokens
+// define the boundaries.
+//
+// The words are split in the same way as a POSIX shell:
+//   - Single quotes preserve the literal value of the characters in them.
+//   - Double quotes preserve the literal value of the characters in them,
+//     except that a backslash escapes '"', '\', '$', '`' and a newline.
+//   - A backslash outside of the quotes escapes the next character, and a
+//     backslash followed by a newline joins the lines.
+//   - The quotes are removed from the words.
+//   - The command substitutions (`...` and $(...)) are kept as they are,
+//     even when they contain spaces, quotes or pipes.
+//
+// It returns ErrUnterminatedQuote if a quote, a backtick or a $( is not
+// closed.
 //
 // Example:
 //
@@ -145,61 +162,184 @@ var ErrCommandIsEmpty = fmt.Errorf("command is empty")
 //	parsePipedCommand(`echo "hello|world"`) =>
 //	  [][]string{ {"echo", "hello|world"} } // single command
 func ParsePipedCommand(cmdString string) ([][]string, error) {
-	var inQuote, inBacktick, inEscape bool
-	var current []rune
-	var tokens []string
+	var (
+		pipeline [][]string
+		command  []string
+		word     strings.Builder
+		inWord   bool
+	)
+	endWord := func() {
+		if inWord {
+			command = append(command, word.String())
+			word.Reset()
+			inWord = false
+		}
+	}
+	unterminated := func() error {
+		return fmt.Errorf("%w: %s", ErrUnterminatedQuote, cmdString)
+	}
 
-	for _, r := range cmdString {
+	runes := []rune(cmdString)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
 		switch {
-		case inEscape:
-			current = append(current, r)
-			inEscape = false
 		case r == '\\':
-			current = append(current, r)
-			inEscape = true
-		case r == '"' && !inBacktick:
-			current = append(current, r)
-			inQuote = !inQuote
-		case r == '`':
-			current = append(current, r)
-			inBacktick = !inBacktick
-		case r == '|' && !inQuote && !inBacktick:
-			if len(current) > 0 {
-				tokens = append(tokens, string(current))
-				current = nil
+			if i+1 == len(runes) {
+				word.WriteRune(r)
+				inWord = true
+				continue
+			}
+			i++
+			if runes[i] == '\n' {
+				// Line continuation.
+				continue
+			}
+			word.WriteRune(runes[i])
+			inWord = true
+
+		case r == '\'':
+			end := indexRune(runes, i+1, '\'')
+			if end < 0 {
+				return nil, unterminated()
+			}
+			word.WriteString(string(runes[i+1 : end]))
+			inWord = true
+			i = end
+
+		case r == '"':
+			end, ok := readDoubleQuoted(runes, i+1, &word)
+			if !ok {
+				return nil, unterminated()
+			}
+			inWord = true
+			i = end
+
+		case r == '`', r == '$' && i+1 < len(runes) && runes[i+1] == '(':
+			end := skipSubstitution(runes, i)
+			if end < 0 {
+				return nil, unterminated()
 			}
-			tokens = append(tokens, "|")
-		case unicode.IsSpace(r) && !inQuote && !inBacktick:
-			if len(current) > 0 {
-				tokens = append(tokens, string(current))
-				current = nil
+			word.WriteString(string(runes[i : end+1]))
+			inWord = true
+			i = end
+
+		case r == '|':
+			endWord()
+			if len(command) > 0 {
+				pipeline = append(pipeline, command)
+				command = nil
 			}
+
+		case unicode.IsSpace(r):
+			endWord()
+
 		default:
-			current = append(current, r)
+			word.WriteRune(r)
+			inWord = true
 		}
 	}
 
-	if len(current) > 0 {
-		tokens = append(tokens, string(current))
+	endWord()
+	if len(command) > 0 {
+		pipeline = append(pipeline, command)
 	}
 
-	var pipeline [][]string
-	var currentCmd []string
+	return pipeline, nil
+}
+
+// readDoubleQuoted reads the double-quoted string starting at start into
+// the word. It returns the index of the closing quote.
+func readDoubleQuoted(runes []rune, start int, word *strings.Builder) (int, bool) {
+	for i := start; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '"':
+			return i, true
 
-	for _, token := range tokens {
-		if token == "|" {
-			if len(currentCmd) > 0 {
-				pipeline = append(pipeline, currentCmd)
-				currentCmd = nil
+		case r == '\\' && i+1 < len(runes):
+			switch next := runes[i+1]; next {
+			case '\n':
+				// Line continuation.
+			case '"', '\\', '$', '`':
+				word.WriteRune(next)
+			default:
+				word.WriteRune(r)
+				word.WriteRune(next)
 			}
-		} else {
-			currentCmd = append(currentCmd, token)
+			i++
+
+		case r == '`', r == '$' && i+1 < len(runes) && runes[i+1] == '(':
+			end := skipSubstitution(runes, i)
+			if end < 0 {
+				return 0, false
+			}
+			word.WriteString(string(runes[i : end+1]))
+			i = end
+
+		default:
+			word.WriteRune(r)
 		}
 	}
+	return 0, false
+}
 
-	if len(currentCmd) > 0 {
-		pipeline = append(pipeline, currentCmd)
+// skipSubstitution returns the index of the end of the command substitution
+// starting at start, or -1 if it is not closed.
+func skipSubstitution(runes []rune, start int) int {
+	if runes[start] == '`' {
+		for i := start + 1; i < len(runes); i++ {
+			switch runes[i] {
+			case '\\':
+				i++
+			case '`':
+				return i
+			}
+		}
+		return -1
 	}
 
-	return pipeline, nil
+	// $( ... ) with nested parentheses and quotes.
+	depth := 0
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			i++
+		case '\'':
+			end := indexRune(runes, i+1, '\'')
+			if end < 0 {
+				return -1
+			}
+			i = end
+		case '"':
+			var discard strings.Builder
+			end, ok := readDoubleQuoted(runes, i+1, &discard)
+			if !ok {
+				return -1
+			}
+			i = end
+		case '`':
+			end := skipSubstitution(runes, i)
+			if end < 0 {
+				return -1
+			}
+			i = end
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func indexRune(runes []rune, start int, r rune) int {
+	for i := start; i < len(runes); i++ {
+		if runes[i] == r {
+			return i
+		}
+	}
+	return -1
 }
@@ -1,6 +1,7 @@
 package cmdutil
 
 import (
+	"errors"
 	"os"
 	"reflect"
 	"testing"
@@ -22,14 +23,21 @@ func TestSplitCommandWithQuotes(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, "echo", cmd)
 		require.Len(t, args, 1)
-		require.Equal(t, `{"key":"value"}`, args[0])
+		// The quotes are removed as the shell does.
+		require.Equal(t, `{key:value}`, args[0])
 	})
 	t.Run("WithQuotedJSON", func(t *testing.T) {
 		cmd, args, err := SplitCommand(`echo "{\"key\":\"value\"}"`)
 		require.NoError(t, err)
 		require.Equal(t, "echo", cmd)
 		require.Len(t, args, 1)
-		require.Equal(t, `"{\"key\":\"value\"}"`, args[0])
+		require.Equal(t, `{"key":"value"}`, args[0])
+	})
+	t.Run("WithSingleQuotedJSON", func(t *testing.T) {
+		cmd, args, err := SplitCommand(`echo '{"key":"value"}'`)
+		require.NoError(t, err)
+		require.Equal(t, "echo", cmd)
+		require.Equal(t, []string{`{"key":"value"}`}, args)
 	})
 }
 
@@ -70,7 +78,7 @@ func TestSplitCommand(t *testing.T) {
 			name:     "command with quoted args",
 			input:    `echo "hello world"`,
 			wantCmd:  "echo",
-			wantArgs: []string{"\"hello world\""},
+			wantArgs: []string{"hello world"},
 		},
 		{
 			name:     "command with pipe",
@@ -88,7 +96,7 @@ func TestSplitCommand(t *testing.T) {
 			name:     "command with quoted pipe",
 			input:    `echo "hello|world"`,
 			wantCmd:  "echo",
-			wantArgs: []string{"\"hello|world\""},
+			wantArgs: []string{"hello|world"},
 		},
 		{
 			name:      "empty command",
@@ -100,13 +108,67 @@ func TestSplitCommand(t *testing.T) {
 			name:     "command with escaped quotes",
 			input:    `echo "\"hello world\""`,
 			wantCmd:  "echo",
-			wantArgs: []string{`"\"hello world\""`},
+			wantArgs: []string{`"hello world"`},
 		},
 		{
 			name:     "command with JSON",
 			input:    `echo "{\n\t\"key\": \"value\"\n}"`,
 			wantCmd:  "echo",
-			wantArgs: []string{`"{\n\t\"key\": \"value\"\n}"`},
+			wantArgs: []string{`{\n\t"key": "value"\n}`},
+		},
+		{
+			name:     "command with single quotes",
+			input:    `echo 'hello "world"' 'it'\''s'`,
+			wantCmd:  "echo",
+			wantArgs: []string{`hello "world"`, `it's`},
+		},
+		{
+			name:     "command with empty args",
+			input:    `echo "" ''`,
+			wantCmd:  "echo",
+			wantArgs: []string{"", ""},
+		},
+		{
+			name:     "command with adjacent quoted parts",
+			input:    `echo a"b c"'d e'f`,
+			wantCmd:  "echo",
+			wantArgs: []string{"ab cd ef"},
+		},
+		{
+			name:     "command with embedded newline",
+			input:    "echo \"hello\nworld\"",
+			wantCmd:  "echo",
+			wantArgs: []string{"hello\nworld"},
+		},
+		{
+			name:     "command with line continuation",
+			input:    "echo hello \\\n  world",
+			wantCmd:  "echo",
+			wantArgs: []string{"hello", "world"},
+		},
+		{
+			name:     "command with dollar paren",
+			input:    `echo $(echo "a | b") "x $(date +%s)"`,
+			wantCmd:  "echo",
+			wantArgs: []string{`$(echo "a | b")`, "x $(date +%s)"},
+		},
+		{
+			name:     "command with nested dollar paren",
+			input:    `echo $(basename $(pwd))`,
+			wantCmd:  "echo",
+			wantArgs: []string{`$(basename $(pwd))`},
+		},
+		{
+			name:     "command with backtick in double quotes",
+			input:    "echo \"today is `date \"+%Y\"`\"",
+			wantCmd:  "echo",
+			wantArgs: []string{"today is `date \"+%Y\"`"},
+		},
+		{
+			name:      "unterminated quote",
+			input:     `echo "hello`,
+			wantErr:   true,
+			errorType: ErrUnterminatedQuote,
 		},
 	}
 
@@ -119,7 +181,7 @@ func TestSplitCommand(t *testing.T) {
 					t.Errorf("splitCommand() error = nil, want error")
 					return
 				}
-				if tt.errorType != nil && err != tt.errorType {
+				if tt.errorType != nil && !errors.Is(err, tt.errorType) {
 					t.Errorf("splitCommand() error = %v, want %v", err, tt.errorType)
 				}
 				return
@@ -252,7 +314,7 @@ func TestParsePipedCommand(t *testing.T) {
 		{
 			name:  "command with quoted args",
 			input: `echo "hello world"`,
-			want:  [][]string{{"echo", `"hello world"`}},
+			want:  [][]string{{"echo", "hello world"}},
 		},
 		{
 			name:  "command with pipe",
@@ -267,7 +329,7 @@ func TestParsePipedCommand(t *testing.T) {
 		{
 			name:  "pipe in quotes",
 			input: `echo "hello|world"`,
-			want:  [][]string{{"echo", `"hello|world"`}},
+			want:  [][]string{{"echo", "hello|world"}},
 		},
 		{
 			name:  "multiple spaces between commands",
@@ -287,12 +349,12 @@ func TestParsePipedCommand(t *testing.T) {
 		{
 			name:  "escaped quotes",
 			input: `echo "Hello \"World\""`,
-			want:  [][]string{{"echo", `"Hello \"World\""`}},
+			want:  [][]string{{"echo", `Hello "World"`}},
 		},
 		{
 			name:  "escaped pipe",
 			input: `echo foo\|bar`,
-			want:  [][]string{{"echo", `foo\|bar`}},
+			want:  [][]string{{"echo", "foo|bar"}},
 		},
 		{
 			name:  "empty command",
@@ -302,14 +364,14 @@ func TestParsePipedCommand(t *testing.T) {
 		{
 			name:  "mixed quotes and backticks",
 			input: "echo \"hello\" world `date`",
-			want:  [][]string{{"echo", `"hello"`, "world", "`date`"}},
+			want:  [][]string{{"echo", "hello", "world", "`date`"}},
 		},
 		{
 			name:  "complex pipeline",
 			input: `find . -name "*.go" | xargs grep "fmt" | sort | uniq -c`,
 			want: [][]string{
-				{"find", ".", "-name", `"*.go"`},
-				{"xargs", "grep", `"fmt"`},
+				{"find", ".", "-name", "*.go"},
+				{"xargs", "grep", "fmt"},
 				{"sort"},
 				{"uniq", "-c"},
 			},
@@ -319,6 +381,11 @@ func TestParsePipedCommand(t *testing.T) {
 			input: `echo $HOME | grep home`,
 			want:  [][]string{{"echo", "$HOME"}, {"grep", "home"}},
 		},
+		{
+			name:  "pipe in dollar paren",
+			input: `echo $(echo foo | grep foo) | wc -c`,
+			want:  [][]string{{"echo", "$(echo foo | grep foo)"}, {"wc", "-c"}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -341,38 +408,35 @@ func TestParsePipedCommand(t *testing.T) {
 // TestParsePipedCommandErrors tests error cases for ParsePipedCommand
 func TestParsePipedCommandErrors(t *testing.T) {
 	tests := []struct {
-		name     string
-		input    string
-		wantPipe [][]string // what we expect even in case of errors
+		name  string
+		input string
 	}{
 		{
-			name:     "unterminated quote",
-			input:    `echo "hello`,
-			wantPipe: [][]string{{"echo", `"hello`}},
+			name:  "unterminated quote",
+			input: `echo "hello`,
+		},
+		{
+			name:  "unterminated single quote",
+			input: `echo 'hello`,
+		},
+		{
+			name:  "unterminated backtick",
+			input: "echo `date",
 		},
 		{
-			name:     "unterminated backtick",
-			input:    "echo `date",
-			wantPipe: [][]string{{"echo", "`date"}},
+			name:  "unterminated dollar paren",
+			input: "echo $(date",
 		},
 		{
-			name:     "mixed unterminated quotes",
-			input:    "echo \"hello `date`\"",
-			wantPipe: [][]string{{"echo", "\"hello `date`\""}},
+			name:  "unterminated backtick in quotes",
+			input: "echo \"hello `date\"",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := ParsePipedCommand(tt.input)
-			// Currently, ParsePipedCommand doesn't return errors for malformed input
-			// But we still want to verify the output matches expected behavior
-			if err != nil {
-				t.Errorf("ParsePipedCommand() unexpected error = %v", err)
-			}
-			if !reflect.DeepEqual(got, tt.wantPipe) {
-				t.Errorf("ParsePipedCommand() = %v, want %v", got, tt.wantPipe)
-			}
+			_, err := ParsePipedCommand(tt.input)
+			require.ErrorIs(t, err, ErrUnterminatedQuote)
 		})
 	}
 }
@@ -17,7 +17,10 @@ import (
 type EvalOptions struct {
 	ExpandEnv  bool
 	Substitute bool
-	Variables  []map[string]string
+	// Memoize reuses the output of the commands in backticks run in the
+	// context created with WithSubstitution.
+	Memoize   bool
+	Variables []map[string]string
 }
 
 type EvalOption func(*EvalOptions)
@@ -40,6 +43,14 @@ func WithoutSubstitute() EvalOption {
 	}
 }
 
+// WithoutMemoize runs the commands in backticks every time, e.g. for the
+// commands of the steps that are retried or repeated.
+func WithoutMemoize() EvalOption {
+	return func(opts *EvalOptions) {
+		opts.Memoize = false
+	}
+}
+
 func OnlyReplaceVars() EvalOption {
 	return func(opts *EvalOptions) {
 		opts.ExpandEnv = false
@@ -86,45 +97,67 @@ func BuildCommandEscapedString(command string, args []string) string {
 	return fmt.Sprintf("%s %s", command, strings.Join(quotedArgs, " "))
 }
 
-// EvalString substitutes environment variables and commands in the input string
+// escapedDollar is the placeholder of an escaped "$$" while the string is
+// evaluated. It's a character of the private use area so that it does not
+// conflict with the input.
+const escapedDollar = "\uE000"
+
+// EvalString evaluates the input string. All the fields of the DAG are
+// evaluated in the same order:
+//
+//  1. The references to the variables (e.g. ${OUT} or ${OUT.foo.bar}) are
+//     replaced with the values.
+//  2. The commands in backticks are substituted with the output.
+//  3. The environment variables are expanded, except in the output of the
+//     commands.
+//
+// When the environment variables are expanded, "$$" is a literal "$" and
+// "\`" is a literal backtick. They are kept as they are when the string is
+// passed to a shell (WithoutExpandEnv) so that the shell applies its own
+// escaping rules.
 func EvalString(ctx context.Context, input string, opts ...EvalOption) (string, error) {
 	options := newEvalOptions()
 	for _, opt := range opts {
 		opt(options)
 	}
+	return evalString(ctx, input, options)
+}
+
+func evalString(ctx context.Context, input string, opts *EvalOptions) (string, error) {
 	value := input
-	for _, vars := range options.Variables {
+	if opts.ExpandEnv {
+		value = strings.ReplaceAll(value, "$$", escapedDollar)
+	}
+	for _, vars := range opts.Variables {
 		value = ExpandReferences(ctx, value, vars)
 		value = replaceVars(value, vars)
 	}
-	if options.Substitute {
+	if opts.Substitute {
+		transform := func(s string) string { return s }
+		if opts.ExpandEnv {
+			// The output of the commands is not expanded.
+			transform = func(s string) string { return strings.ReplaceAll(s, "$", escapedDollar) }
+		}
 		var err error
-		value, err = substituteCommands(value)
+		value, err = substituteCommandsWith(ctx, value, opts.Memoize, transform)
 		if err != nil {
 			return "", fmt.Errorf("failed to substitute string in %q: %w", input, err)
 		}
 	}
-	if options.ExpandEnv {
+	if opts.ExpandEnv {
 		value = os.ExpandEnv(value)
+		value = strings.ReplaceAll(value, escapedDollar, "$")
+		if opts.Substitute {
+			value = strings.ReplaceAll(value, "\\`", "`")
+		}
 	}
 	return value, nil
 }
 
-// EvalIntString substitutes environment variables and commands in the input string
+// EvalIntString evaluates the input string in the same way as EvalString
+// and converts the result to an int.
 func EvalIntString(ctx context.Context, input string, opts ...EvalOption) (int, error) {
-	options := newEvalOptions()
-	for _, opt := range opts {
-		opt(options)
-	}
-	value := input
-	for _, vars := range options.Variables {
-		value = ExpandReferences(ctx, value, vars)
-		value = replaceVars(value, vars)
-	}
-	if options.ExpandEnv {
-		value = os.ExpandEnv(value)
-	}
-	value, err := substituteCommands(value)
+	value, err := EvalString(ctx, input, opts...)
 	if err != nil {
 		return 0, err
 	}
@@ -135,9 +168,10 @@ func EvalIntString(ctx context.Context, input string, opts ...EvalOption) (int,
 	return v, nil
 }
 
-// EvalStringFields processes all string fields in a struct by expanding environment
-// variables and substituting command outputs. It takes a struct value and returns a new
-// modified struct value.
+// EvalStringFields evaluates the strings in a struct in the same way as
+// EvalString. The strings in the nested structs, pointers, slices, maps and
+// interfaces are evaluated as well. It takes a struct value and returns a
+// new modified struct value without modifying the input.
 func EvalStringFields[T any](ctx context.Context, obj T, opts ...EvalOption) (T, error) {
 	options := newEvalOptions()
 	for _, opt := range opts {
@@ -149,53 +183,99 @@ func EvalStringFields[T any](ctx context.Context, obj T, opts ...EvalOption) (T,
 		return obj, fmt.Errorf("input must be a struct, got %T", obj)
 	}
 
-	modified := reflect.New(v.Type()).Elem()
-	modified.Set(v)
-
-	if err := processStructFields(ctx, modified, options); err != nil {
+	modified, err := evalValue(ctx, v, options)
+	if err != nil {
 		return obj, fmt.Errorf("failed to process fields: %w", err)
 	}
 
 	return modified.Interface().(T), nil
 }
 
-func processStructFields(ctx context.Context, v reflect.Value, opts *EvalOptions) error {
-	t := v.Type()
-	for i := 0; i < v.NumField(); i++ {
-		field := v.Field(i)
-		if !field.CanSet() {
-			continue
+// evalValue returns a copy of the value with the strings evaluated. The
+// slices and maps are copied so that the input is not modified.
+func evalValue(ctx context.Context, v reflect.Value, opts *EvalOptions) (reflect.Value, error) {
+	// nolint:exhaustive
+	switch v.Kind() {
+	case reflect.String:
+		value, err := evalString(ctx, v.String(), opts)
+		if err != nil {
+			return v, err
 		}
-
-		// nolint:exhaustive
-		switch field.Kind() {
-		case reflect.String:
-			value := field.String()
-			for _, vars := range opts.Variables {
-				value = replaceVars(value, vars)
+		return reflect.ValueOf(value).Convert(v.Type()), nil
+
+	case reflect.Struct:
+		t := v.Type()
+		modified := reflect.New(t).Elem()
+		modified.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			field := modified.Field(i)
+			if !field.CanSet() {
+				continue
 			}
-
-			if opts.Substitute {
-				var err error
-				value, err = substituteCommands(value)
-				if err != nil {
-					return fmt.Errorf("field %q: %w", t.Field(i).Name, err)
-				}
+			value, err := evalValue(ctx, field, opts)
+			if err != nil {
+				return v, fmt.Errorf("field %q: %w", t.Field(i).Name, err)
 			}
+			field.Set(value)
+		}
+		return modified, nil
 
-			if opts.ExpandEnv {
-				value = os.ExpandEnv(value)
-			}
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v, nil
+		}
+		value, err := evalValue(ctx, v.Elem(), opts)
+		if err != nil {
+			return v, err
+		}
+		ptr := reflect.New(v.Type().Elem())
+		ptr.Elem().Set(value)
+		return ptr, nil
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return v, nil
+		}
+		value, err := evalValue(ctx, v.Elem(), opts)
+		if err != nil {
+			return v, err
+		}
+		modified := reflect.New(v.Type()).Elem()
+		modified.Set(value)
+		return modified, nil
 
-			field.SetString(value)
+	case reflect.Slice:
+		if v.IsNil() {
+			return v, nil
+		}
+		modified := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			value, err := evalValue(ctx, v.Index(i), opts)
+			if err != nil {
+				return v, err
+			}
+			modified.Index(i).Set(value)
+		}
+		return modified, nil
 
-		case reflect.Struct:
-			if err := processStructFields(ctx, field, opts); err != nil {
-				return err
+	case reflect.Map:
+		if v.IsNil() {
+			return v, nil
+		}
+		modified := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			value, err := evalValue(ctx, iter.Value(), opts)
+			if err != nil {
+				return v, fmt.Errorf("key %v: %w", iter.Key(), err)
 			}
+			modified.SetMapIndex(iter.Key(), value)
 		}
+		return modified, nil
+
+	default:
+		return v, nil
 	}
-	return nil
 }
 
 // ExpandReferences finds all occurrences of ${NAME.foo.bar} in the input string,
@@ -311,5 +391,6 @@ func newEvalOptions() *EvalOptions {
 	return &EvalOptions{
 		ExpandEnv:  true,
 		Substitute: true,
+		Memoize:    true,
 	}
 }
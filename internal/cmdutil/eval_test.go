@@ -343,3 +343,69 @@ func TestExpandReferences(t *testing.T) {
 		})
 	}
 }
+
+func TestEvalString_Escape(t *testing.T) {
+	os.Setenv("TEST_VAR", "test_value")
+	defer os.Unsetenv("TEST_VAR")
+
+	ctx := context.Background()
+	vars := WithVariables(map[string]string{"OUT": "output"})
+
+	tests := []struct {
+		name  string
+		input string
+		opts  []EvalOption
+		want  string
+	}{
+		{name: "Dollar", input: "price: $$5", want: "price: $5"},
+		{name: "EscapedEnv", input: "$$TEST_VAR is $TEST_VAR", want: "$TEST_VAR is test_value"},
+		{name: "EscapedVariable", input: "$${OUT} is ${OUT}", opts: []EvalOption{vars}, want: "${OUT} is output"},
+		{name: "EscapedInCommand", input: "`echo '$$TEST_VAR'`", want: "$TEST_VAR"},
+		{name: "Backtick", input: "\\`echo hello\\`", want: "`echo hello`"},
+		{name: "Shell", input: "echo $$ $${OUT} ${OUT}", opts: []EvalOption{vars, WithoutExpandEnv()}, want: "echo $$ $output output"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EvalString(ctx, tt.input, tt.opts...)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEvalStringFields_Collections(t *testing.T) {
+	os.Setenv("TEST_VAR", "test_value")
+	defer os.Unsetenv("TEST_VAR")
+
+	type Nested struct {
+		Field string
+	}
+	type Root struct {
+		List    []string
+		Map     map[string]string
+		Config  map[string]any
+		Pointer *Nested
+	}
+
+	input := Root{
+		List:    []string{"$TEST_VAR", "`echo list`"},
+		Map:     map[string]string{"key": "${OUT.foo}"},
+		Config:  map[string]any{"nested": map[string]any{"list": []any{"$TEST_VAR", 1}}},
+		Pointer: &Nested{Field: "$$TEST_VAR"},
+	}
+
+	got, err := EvalStringFields(context.Background(), input,
+		WithVariables(map[string]string{"OUT": `{"foo":"bar"}`}))
+	require.NoError(t, err)
+	require.Equal(t, Root{
+		List:    []string{"test_value", "list"},
+		Map:     map[string]string{"key": "bar"},
+		Config:  map[string]any{"nested": map[string]any{"list": []any{"test_value", 1}}},
+		Pointer: &Nested{Field: "$TEST_VAR"},
+	}, got)
+
+	// The input is not modified.
+	require.Equal(t, "$TEST_VAR", input.List[0])
+	require.Equal(t, "${OUT.foo}", input.Map["key"])
+	require.Equal(t, "$$TEST_VAR", input.Pointer.Field)
+}
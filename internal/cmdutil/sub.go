@@ -2,17 +2,99 @@ package cmdutil
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"strings"
+	"sync"
+	"time"
 )
 
+// DefaultSubstitutionTimeout is the timeout of a command in backticks when
+// no timeout is set with WithSubstitution.
+const DefaultSubstitutionTimeout = time.Minute
+
+// substitution is the settings of the command substitution shared by the
+// evaluations in the context.
+type substitution struct {
+	timeout time.Duration
+
+	mu      sync.Mutex
+	outputs map[string]*substitutionOutput
+}
+
+// substitutionOutput is the memoized output of a command.
+type substitutionOutput struct {
+	once   sync.Once
+	output string
+	err    error
+}
+
+type substitutionKey struct{}
+
+// WithSubstitution returns a context in which each command in backticks is
+// killed when it runs longer than the timeout, and the output of each
+// command is memoized so the same command is run only once. The default
+// timeout is used if the timeout is zero.
+func WithSubstitution(ctx context.Context, timeout time.Duration) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if timeout <= 0 {
+		timeout = DefaultSubstitutionTimeout
+	}
+	return context.WithValue(ctx, substitutionKey{}, &substitution{
+		timeout: timeout,
+		outputs: make(map[string]*substitutionOutput),
+	})
+}
+
 // runCommand executes cmdStr in a shell, capturing stdout (and ignoring stderr).
-func runCommand(cmdStr string) (string, error) {
+// The output is memoized if memoize is true and the context is created with
+// WithSubstitution.
+func runCommand(ctx context.Context, cmdStr string, memoize bool) (string, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	sub, ok := ctx.Value(substitutionKey{}).(*substitution)
+	if !ok {
+		return execCommand(ctx, cmdStr, DefaultSubstitutionTimeout)
+	}
+	if !memoize {
+		return execCommand(ctx, cmdStr, sub.timeout)
+	}
+
+	sub.mu.Lock()
+	out, ok := sub.outputs[cmdStr]
+	if !ok {
+		out = &substitutionOutput{}
+		sub.outputs[cmdStr] = out
+	}
+	sub.mu.Unlock()
+
+	out.once.Do(func() {
+		out.output, out.err = execCommand(ctx, cmdStr, sub.timeout)
+	})
+	return out.output, out.err
+}
+
+var errSubstitutionTimeout = errors.New("command substitution timed out")
+
+func execCommand(ctx context.Context, cmdStr string, timeout time.Duration) (string, error) {
+	// The escaped "$$" is a literal "$" passed to the shell.
+	cmdStr = strings.ReplaceAll(cmdStr, escapedDollar, "$")
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	sh := GetShellCommand("")
-	cmd := exec.Command(sh, "-c", cmdStr)
+	cmd := exec.CommandContext(ctx, sh, "-c", cmdStr)
 	cmd.Env = os.Environ()
+	// Don't wait for the children holding the output after the shell is
+	// killed.
+	cmd.WaitDelay = time.Second
 
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
@@ -20,6 +102,9 @@ func runCommand(cmdStr string) (string, error) {
 	cmd.Stderr = &stderr
 
 	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return "", fmt.Errorf("%w after %s: %q", errSubstitutionTimeout, timeout, cmdStr)
+		}
 		return "", fmt.Errorf(
 			"failed to execute command %q: %w\nstderr=%s",
 			cmdStr, err, stderr.String(),
@@ -33,6 +118,12 @@ func runCommand(cmdStr string) (string, error) {
 // (i.e. a backslash immediately before a backtick). If we see "\`", we treat it as a real
 // backtick delimiter, not a literal backslash + backtick. Commands are executed via runCommand().
 func substituteCommands(input string) (string, error) {
+	return substituteCommandsWith(context.Background(), input, false, func(s string) string { return s })
+}
+
+// substituteCommandsWith is substituteCommands with the transform applied
+// to the output of the commands.
+func substituteCommandsWith(ctx context.Context, input string, memoize bool, transform func(string) string) (string, error) {
 	var result strings.Builder     // final output
 	var cmdBuilder strings.Builder // accumulates text inside a command
 	inCommand := false             // whether we're currently capturing a command
@@ -58,11 +149,11 @@ func substituteCommands(input string) (string, error) {
 					result.WriteString("``")
 				} else {
 					// We are closing a command
-					output, err := runCommand(cmdBuilder.String())
+					output, err := runCommand(ctx, cmdBuilder.String(), memoize)
 					if err != nil {
 						return "", err
 					}
-					result.WriteString(output)
+					result.WriteString(transform(output))
 				}
 				cmdBuilder.Reset()
 				inCommand = false
@@ -1,9 +1,13 @@
 package cmdutil
 
 import (
+	"context"
 	"os"
 	"runtime"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestSubstituteCommands(t *testing.T) {
@@ -184,3 +188,44 @@ func TestSubstituteCommandsEdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestWithSubstitution(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping tests on Windows")
+	}
+
+	t.Run("Timeout", func(t *testing.T) {
+		ctx := WithSubstitution(context.Background(), 100*time.Millisecond)
+		start := time.Now()
+		_, err := EvalString(ctx, "`sleep 10`")
+		require.ErrorIs(t, err, errSubstitutionTimeout)
+		require.Less(t, time.Since(start), 5*time.Second)
+	})
+	t.Run("Memoize", func(t *testing.T) {
+		counter := t.TempDir() + "/counter"
+		cmd := "`echo x >> " + counter + "; wc -l < " + counter + "`"
+
+		ctx := WithSubstitution(context.Background(), 0)
+		for i := 0; i < 3; i++ {
+			got, err := EvalString(ctx, cmd)
+			require.NoError(t, err)
+			require.Equal(t, "1", got)
+		}
+
+		// The output is not shared with another context.
+		got, err := EvalString(WithSubstitution(context.Background(), 0), cmd)
+		require.NoError(t, err)
+		require.Equal(t, "2", got)
+	})
+	t.Run("WithoutMemoize", func(t *testing.T) {
+		counter := t.TempDir() + "/counter"
+		cmd := "`echo x >> " + counter + "; wc -l < " + counter + "`"
+
+		ctx := WithSubstitution(context.Background(), 0)
+		for _, want := range []string{"1", "2"} {
+			got, err := EvalString(ctx, cmd, WithoutMemoize())
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		}
+	})
+}
@@ -2,10 +2,16 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
+
+	"github.com/dagu-org/dagu/internal/namespace"
 )
 
 // Config represents the server configuration with both new and legacy fields
@@ -18,6 +24,13 @@ type Config struct {
 	APIBasePath string `mapstructure:"apiBasePath"`
 	APIBaseURL  string `mapstructure:"apiBaseURL"` // For backward compatibility
 	WorkDir     string `mapstructure:"workDir"`
+	// PublicURL is the URL the Web UI is reachable at, used for the links to
+	// the runs sent from the DAGs. It's http://<host>:<port><basePath> by
+	// default.
+	PublicURL string `mapstructure:"publicURL"`
+	// Profile is the name of the configuration profile whose file overrides
+	// the config file, e.g. "prod" for config.prod.yaml.
+	Profile string `mapstructure:"profile"`
 
 	// Authentication
 	Auth Auth `mapstructure:"auth"`
@@ -70,6 +83,48 @@ type Config struct {
 
 	UI UI `mapstructure:"ui"`
 
+	// Scheduler service settings
+	Scheduler Scheduler `mapstructure:"scheduler"`
+
+	// MailQueue is the settings of the queue of the mails sent by the agents.
+	MailQueue MailQueue `mapstructure:"mailQueue"`
+
+	// Persistence is the settings of the storage of the histories and the
+	// metadata of the DAGs.
+	Persistence Persistence `mapstructure:"persistence"`
+
+	// HistoryCompaction is the settings of the compaction of the old status
+	// files into the monthly summaries by the scheduler.
+	HistoryCompaction HistoryCompaction `mapstructure:"historyCompaction"`
+
+	// StatsD is the settings to push the metrics of the DAG runs to StatsD
+	// or the Datadog agent.
+	StatsD StatsD `mapstructure:"statsd"`
+
+	// Tracing is the settings to export the traces of the DAG runs to an
+	// APM service.
+	Tracing Tracing `mapstructure:"tracing"`
+
+	// Sentry is the settings to report the panics and the errors to Sentry.
+	Sentry Sentry `mapstructure:"sentry"`
+
+	// Encryption is the settings to encrypt the status files and the logs
+	// at rest.
+	Encryption Encryption `mapstructure:"encryption"`
+
+	// CgroupRoot is the cgroup v2 directory under which the cgroups of the
+	// steps with the CPU or the memory limits are created. It must be
+	// writable by Dagu with the cpu and the memory controllers delegated.
+	CgroupRoot string `mapstructure:"cgroupRoot"`
+
+	// HostEnv is the policy of the environment variables of the host passed
+	// to the processes of the steps.
+	HostEnv HostEnv `mapstructure:"hostEnv"`
+
+	// ShutdownGracePeriod is the time the scheduler and the server wait for
+	// the running DAGs to finish on shutdown before stopping them.
+	ShutdownGracePeriod time.Duration `mapstructure:"shutdownGracePeriod"`
+
 	// Remote nodes configuration
 	RemoteNodes []RemoteNode `mapstructure:"remoteNodes"`
 
@@ -88,12 +143,33 @@ type AuthBasic struct {
 	Enabled  bool   `mapstructure:"enabled"`
 	Username string `mapstructure:"username"`
 	Password string `mapstructure:"password"`
+	// Users is the additional users allowed to access only their
+	// namespaces.
+	Users []AuthUser `mapstructure:"users"`
+}
+
+// AuthUser represents a user of the basic authentication scoped to the
+// namespaces. "*" allows all the namespaces.
+type AuthUser struct {
+	Username   string   `mapstructure:"username"`
+	Password   string   `mapstructure:"password"`
+	Namespaces []string `mapstructure:"namespaces"`
 }
 
 // AuthToken represents the authentication token configuration
 type AuthToken struct {
 	Enabled bool   `mapstructure:"enabled"`
 	Value   string `mapstructure:"value"`
+	// Tokens is the additional tokens allowed to access only their
+	// namespaces.
+	Tokens []AuthScopedToken `mapstructure:"tokens"`
+}
+
+// AuthScopedToken represents a token scoped to the namespaces. "*" allows
+// all the namespaces.
+type AuthScopedToken struct {
+	Value      string   `mapstructure:"value"`
+	Namespaces []string `mapstructure:"namespaces"`
 }
 
 // Paths represents the file system paths configuration
@@ -105,6 +181,63 @@ type PathsConfig struct {
 	SuspendFlagsDir string `mapstructure:"suspendFlagsDir"`
 	AdminLogsDir    string `mapstructure:"adminLogsDir"`
 	BaseConfig      string `mapstructure:"baseConfig"`
+	PluginsDir      string `mapstructure:"pluginsDir"`
+	// DAGSearchPaths is the directories searched for the DAGs after DAGsDir.
+	DAGSearchPaths []DAGSearchPath `mapstructure:"dagSearchPaths"`
+}
+
+// DAGSearchPath is an additional directory of the DAGs.
+type DAGSearchPath struct {
+	Dir string `mapstructure:"dir"`
+	// BaseConfig is the base config of the DAGs in the directory. The base
+	// config of the paths is used if it's empty.
+	BaseConfig string `mapstructure:"baseConfig"`
+}
+
+// DAGDirs returns the directories of the DAGs in the order they are
+// searched: DAGsDir first and then the search paths.
+func (p PathsConfig) DAGDirs() []string {
+	dirs := []string{p.DAGsDir}
+	for _, sp := range p.DAGSearchPaths {
+		dirs = append(dirs, sp.Dir)
+	}
+	return dirs
+}
+
+// BaseConfigFor returns the base config of the DAG file: the one of the
+// search path containing the file, or BaseConfig.
+func (p PathsConfig) BaseConfigFor(file string) string {
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return p.BaseConfig
+	}
+	dir := filepath.Dir(abs)
+	if namespace.Of(p.DAGDirs(), abs) != "" {
+		// The DAGs in a namespace use the base config of its directory.
+		dir = filepath.Dir(dir)
+	}
+	for _, sp := range p.DAGSearchPaths {
+		if sp.BaseConfig == "" {
+			continue
+		}
+		if spDir, err := filepath.Abs(sp.Dir); err == nil && spDir == dir {
+			return sp.BaseConfig
+		}
+	}
+	return p.BaseConfig
+}
+
+// BaseConfigsFor returns the base configs applied to the DAG file in order:
+// the one of its directory and then the one of its namespace.
+func (p PathsConfig) BaseConfigsFor(file string) []string {
+	var ret []string
+	if base := p.BaseConfigFor(file); base != "" {
+		ret = append(ret, base)
+	}
+	if abs, err := filepath.Abs(file); err == nil && namespace.Of(p.DAGDirs(), abs) != "" {
+		ret = append(ret, filepath.Join(filepath.Dir(abs), namespace.BaseConfigFile))
+	}
+	return ret
 }
 
 type UI struct {
@@ -114,6 +247,143 @@ type UI struct {
 	MaxDashboardPageLimit int    `mapstructure:"maxDashboardPageLimit"`
 }
 
+// Scheduler represents the scheduler service configuration
+type Scheduler struct {
+	// MetricsAddr is the address to serve the metrics of the scheduler
+	// (e.g. ":9090"). The metrics are not served if it's empty.
+	MetricsAddr string `mapstructure:"metricsAddr"`
+	// ResumeInterruptedRuns enables retrying the runs interrupted by a crash
+	// from the last completed step when the scheduler starts. The runs are
+	// only marked as failed if it's disabled.
+	ResumeInterruptedRuns bool `mapstructure:"resumeInterruptedRuns"`
+	// ZombieCheckInterval is the interval to scan the histories for the runs
+	// stuck in the running status whose process is gone. The scan is
+	// disabled if it's zero.
+	ZombieCheckInterval time.Duration `mapstructure:"zombieCheckInterval"`
+	// ZombieNotify enables sending the error mail of the DAG when a zombie
+	// run is found.
+	ZombieNotify bool `mapstructure:"zombieNotify"`
+}
+
+// MailQueue represents the queue of the report mails. The agents spool the
+// mails under the data directory and send them in the background. The
+// scheduler redelivers the mails left by the agents.
+type MailQueue struct {
+	// MaxAttempts is the number of the delivery attempts of a mail before
+	// it's moved to the dead letters.
+	MaxAttempts int `mapstructure:"maxAttempts"`
+	// FlushTimeout is the time an agent waits for the queued mails to be
+	// sent after the DAG run finished.
+	FlushTimeout time.Duration `mapstructure:"flushTimeout"`
+	// RedeliverInterval is the interval of the scheduler to redeliver the
+	// mails left by the agents. The redelivery is disabled if it's zero.
+	RedeliverInterval time.Duration `mapstructure:"redeliverInterval"`
+}
+
+// The backends of the persistence.
+const (
+	// PersistenceBackendFile stores the histories in the status files.
+	PersistenceBackendFile = "file"
+	// PersistenceBackendSQLite stores the histories and the metadata of the
+	// DAGs in a SQLite database.
+	PersistenceBackendSQLite = "sqlite"
+)
+
+// Persistence represents the storage of the histories and the metadata of
+// the DAGs. The DAG files are the specs of the DAGs with any backend.
+type Persistence struct {
+	// Backend is PersistenceBackendFile or PersistenceBackendSQLite.
+	Backend string `mapstructure:"backend"`
+	// SQLitePath is the database file of the sqlite backend. It's dagu.db
+	// in the data directory if it's empty.
+	SQLitePath string `mapstructure:"sqlitePath"`
+}
+
+// HistoryCompaction represents the compaction of the histories. The status
+// files of the old runs of each DAG are rolled up into a summary file per
+// month, which keeps the number of the files in the data directory bounded.
+type HistoryCompaction struct {
+	// AfterDays is the age in days of the runs whose status files are rolled
+	// up. The latest run of each DAG is never rolled up.
+	AfterDays int `mapstructure:"afterDays"`
+	// Interval is the interval of the scheduler to compact the histories.
+	// The compaction is disabled if it's zero.
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// StatsD represents the StatsD server the agents push the counters and
+// timings of the runs and the steps to.
+type StatsD struct {
+	// Addr is the UDP address of the server (e.g. "127.0.0.1:8125"). The
+	// metrics are not sent if it's empty.
+	Addr string `mapstructure:"addr"`
+	// Prefix is prepended to the names of the metrics.
+	Prefix string `mapstructure:"prefix"`
+	// Tags is the names of the tags attached to the metrics: dag, status
+	// and step. All the tags are attached if it's empty.
+	Tags []string `mapstructure:"tags"`
+	// Datadog enables the DogStatsD tags. The values of the tags are
+	// appended to the names of the metrics otherwise.
+	Datadog bool `mapstructure:"datadog"`
+}
+
+// HostEnv represents which environment variables of the host are passed to
+// the processes of the steps. Only the base variables like PATH and HOME are
+// passed by default, so that the secrets of the host don't leak into the
+// commands. The variables set by the DAGs are always passed.
+type HostEnv struct {
+	// Allow is the patterns of the names of the variables passed in
+	// addition to the base ones, e.g. "AWS_*". "*" passes all of them.
+	Allow []string `mapstructure:"allow"`
+	// Deny is the patterns of the names of the variables never passed,
+	// even if they're allowed.
+	Deny []string `mapstructure:"deny"`
+}
+
+// Tracing represents the APM service the agents export the traces of the
+// runs to.
+type Tracing struct {
+	// Exporter is the name of the exporter: datadog or newrelic. The traces
+	// are not exported if it's empty.
+	Exporter string `mapstructure:"exporter"`
+	// Endpoint is the URL of the Datadog agent or the New Relic Trace API.
+	// The default of the exporter is used if it's empty.
+	Endpoint string `mapstructure:"endpoint"`
+	// Service is the name of the service of the spans.
+	Service string `mapstructure:"service"`
+	// APIKey is the license key of New Relic.
+	APIKey string `mapstructure:"apiKey"`
+}
+
+// Sentry represents the Sentry project the agents and the services report
+// the panics, the setup failures and the failed runs to.
+type Sentry struct {
+	// DSN is the client key of the project. The events are not reported if
+	// it's empty.
+	DSN string `mapstructure:"dsn"`
+	// Environment is the environment of the events, e.g. "production".
+	Environment string `mapstructure:"environment"`
+}
+
+// Encryption is the key to encrypt the status files and the step logs with
+// AES-256-GCM. The files are written in plaintext if no key is set. At most
+// one of the fields may be set.
+type Encryption struct {
+	// Key is the base64 encoded 32 bytes key, e.g. generated by
+	// "openssl rand -base64 32".
+	Key string `mapstructure:"key"`
+	// KeyFile is the file containing the base64 encoded key.
+	KeyFile string `mapstructure:"keyFile"`
+	// KeyCommand is the shell command printing the base64 encoded key, e.g.
+	// one decrypting a data key with a KMS.
+	KeyCommand string `mapstructure:"keyCommand"`
+}
+
+// Enabled returns whether a key is set.
+func (e Encryption) Enabled() bool {
+	return e.Key != "" || e.KeyFile != "" || e.KeyCommand != ""
+}
+
 // RemoteNode represents a remote node configuration
 type RemoteNode struct {
 	Name              string `mapstructure:"name"`
@@ -148,6 +418,8 @@ func (c *Config) MigrateLegacyConfig() {
 
 	// Clean base path
 	c.cleanBasePath()
+
+	c.setPublicURL()
 }
 
 func (c *Config) migrateServerSettings() {
@@ -220,6 +492,21 @@ func (c *Config) cleanBasePath() {
 	}
 }
 
+func (c *Config) setPublicURL() {
+	if c.PublicURL != "" {
+		c.PublicURL = strings.TrimSuffix(c.PublicURL, "/")
+		return
+	}
+	if c.Host == "" || c.Port == 0 {
+		return
+	}
+	scheme := "http"
+	if c.TLS != nil {
+		scheme = "https"
+	}
+	c.PublicURL = fmt.Sprintf("%s://%s%s", scheme, net.JoinHostPort(c.Host, strconv.Itoa(c.Port)), c.BasePath)
+}
+
 // Load creates a new configuration with backward compatibility
 func Load() (*Config, error) {
 	loader := NewConfigLoader()
@@ -236,6 +523,11 @@ func Load() (*Config, error) {
 	// Migrate legacy configuration
 	cfg.MigrateLegacyConfig()
 
+	// Check the paths after the deprecated ones are migrated.
+	if err := cfg.validatePaths(); err != nil {
+		return nil, err
+	}
+
 	// Set environment variables
 	cfg.setEnvVariables()
 
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 )
 
@@ -122,6 +124,30 @@ func TestConfig_MigrateLegacyConfig(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "default public url",
+			setup: func(cfg *Config) {
+				cfg.Host = "127.0.0.1"
+				cfg.Port = 8080
+				cfg.BasePath = "/dagu"
+			},
+			validate: func(t *testing.T, cfg *Config) {
+				if cfg.PublicURL != "http://127.0.0.1:8080/dagu" {
+					t.Errorf("PublicURL = %v, want http://127.0.0.1:8080/dagu", cfg.PublicURL)
+				}
+			},
+		},
+		{
+			name: "public url",
+			setup: func(cfg *Config) {
+				cfg.PublicURL = "https://example.com/dagu/"
+			},
+			validate: func(t *testing.T, cfg *Config) {
+				if cfg.PublicURL != "https://example.com/dagu" {
+					t.Errorf("PublicURL = %v, want https://example.com/dagu", cfg.PublicURL)
+				}
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -265,6 +291,89 @@ func TestConfigLoader_ValidateConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "conflicting basic auth",
+			setup: func(cfg *Config) {
+				cfg.UI.MaxDashboardPageLimit = 100
+				cfg.IsBasicAuth = true
+				cfg.BasicAuthUsername = "legacy"
+				cfg.BasicAuthPassword = "pass"
+				cfg.Auth.Basic.Username = "user"
+			},
+			wantErr: true,
+		},
+		{
+			name: "conflicting token auth",
+			setup: func(cfg *Config) {
+				cfg.UI.MaxDashboardPageLimit = 100
+				cfg.IsAuthToken = true
+				cfg.AuthToken = "legacy"
+				cfg.Auth.Token.Enabled = true
+				cfg.Auth.Token.Value = "token"
+			},
+			wantErr: true,
+		},
+		{
+			name: "namespaced users only",
+			setup: func(cfg *Config) {
+				cfg.UI.MaxDashboardPageLimit = 100
+				cfg.Auth.Basic.Enabled = true
+				cfg.Auth.Basic.Users = []AuthUser{{Username: "alice", Password: "pass", Namespaces: []string{"team-a"}}}
+				cfg.Auth.Token.Enabled = true
+				cfg.Auth.Token.Tokens = []AuthScopedToken{{Value: "token", Namespaces: []string{"*"}}}
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid namespaced user",
+			setup: func(cfg *Config) {
+				cfg.UI.MaxDashboardPageLimit = 100
+				cfg.Auth.Basic.Username = "alice"
+				cfg.Auth.Basic.Users = []AuthUser{{Username: "alice", Password: "pass", Namespaces: []string{"team/a"}}}
+			},
+			wantErr: true,
+		},
+		{
+			name: "namespaced token without namespaces",
+			setup: func(cfg *Config) {
+				cfg.UI.MaxDashboardPageLimit = 100
+				cfg.Auth.Token.Tokens = []AuthScopedToken{{Value: "token"}}
+			},
+			wantErr: true,
+		},
+		{
+			name: "conflicting encryption keys",
+			setup: func(cfg *Config) {
+				cfg.UI.MaxDashboardPageLimit = 100
+				cfg.Encryption.Key = "AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE="
+				cfg.Encryption.KeyCommand = "vault read -field=key secret/dagu"
+			},
+			wantErr: true,
+		},
+		{
+			name: "short encryption key",
+			setup: func(cfg *Config) {
+				cfg.UI.MaxDashboardPageLimit = 100
+				cfg.Encryption.Key = "c2hvcnQ="
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown persistence backend",
+			setup: func(cfg *Config) {
+				cfg.UI.MaxDashboardPageLimit = 100
+				cfg.Persistence.Backend = "postgres"
+			},
+			wantErr: true,
+		},
+		{
+			name: "conflicting remote node auth",
+			setup: func(cfg *Config) {
+				cfg.UI.MaxDashboardPageLimit = 100
+				cfg.RemoteNodes = []RemoteNode{{Name: "dev", IsBasicAuth: true, IsAuthToken: true}}
+			},
+			wantErr: true,
+		},
 	}
 
 	loader := NewConfigLoader()
@@ -324,3 +433,46 @@ func TestConfigLoader_LoadLegacyEnv(t *testing.T) {
 		t.Errorf("Paths.DataDir = %v, want /data/legacy", cfg.Paths.DataDir)
 	}
 }
+
+func TestConfig_ValidatePaths(t *testing.T) {
+	tmpDir := t.TempDir()
+	file := filepath.Join(tmpDir, "file")
+	if err := os.WriteFile(file, []byte("x"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := &Config{}
+		cfg.Paths.DAGsDir = tmpDir
+		cfg.Paths.DataDir = filepath.Join(tmpDir, "not-created-yet")
+		cfg.Paths.Executable = file
+		if err := cfg.validatePaths(); err != nil {
+			t.Errorf("validatePaths() error = %v", err)
+		}
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		cfg := &Config{WorkDir: filepath.Join(tmpDir, "missing")}
+		cfg.Paths.DAGsDir = file
+		cfg.Paths.Executable = file
+		cfg.Paths.DAGSearchPaths = []DAGSearchPath{{Dir: filepath.Join(tmpDir, "team")}, {BaseConfig: tmpDir}}
+		cfg.TLS = &TLSConfig{CertFile: tmpDir, KeyFile: filepath.Join(tmpDir, "key.pem")}
+
+		err := cfg.validatePaths()
+		var verr *ValidationError
+		if !errors.As(err, &verr) {
+			t.Fatalf("validatePaths() error = %v, want ValidationError", err)
+		}
+		want := []string{
+			"paths.dagSearchPaths[1].dir: the directory is required",
+			"paths.dagsDir: " + file + " is not a directory",
+			"workDir: " + filepath.Join(tmpDir, "missing") + " does not exist",
+			"paths.dagSearchPaths[0].dir: " + filepath.Join(tmpDir, "team") + " does not exist",
+			"paths.dagSearchPaths[1].baseConfig: " + tmpDir + " is a directory, not a file",
+			"tls.certFile: " + tmpDir + " is a directory, not a file",
+			"tls.keyFile: " + filepath.Join(tmpDir, "key.pem") + " does not exist",
+		}
+		if !reflect.DeepEqual(verr.Problems, want) {
+			t.Errorf("Problems = %q, want %q", verr.Problems, want)
+		}
+	})
+}
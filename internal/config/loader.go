@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -15,27 +16,38 @@ import (
 	"github.com/spf13/viper"
 )
 
+// ConfigLoader loads the configuration from the layers in the order of
+// precedence: the defaults, the config file, the profile file, the
+// environment variables, and the command-line flags.
 type ConfigLoader struct {
 	lock sync.Mutex
+	// envNames is the environment variables bound to the keys.
+	envNames map[string][]string
+	// files is the configuration files merged, in the order of precedence.
+	files []string
 }
 
 func NewConfigLoader() *ConfigLoader {
-	return &ConfigLoader{}
+	return &ConfigLoader{envNames: make(map[string][]string)}
 }
 
 func (l *ConfigLoader) Load() (*Config, error) {
 	l.lock.Lock()
 	defer l.lock.Unlock()
 
-	if err := l.setupViper(); err != nil {
+	resolver, err := l.setupViper()
+	if err != nil {
 		return nil, fmt.Errorf("viper setup failed: %w", err)
 	}
 
 	var cfg Config
+	l.files = nil
 	if err := viper.ReadInConfig(); err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
 			return nil, fmt.Errorf("failed to read config: %w", err)
 		}
+	} else {
+		l.files = append(l.files, viper.ConfigFileUsed())
 	}
 
 	// Backward compatibility for 'admin.yaml' renamed to 'config.yaml'
@@ -44,6 +56,19 @@ func (l *ConfigLoader) Load() (*Config, error) {
 		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
 			return nil, fmt.Errorf("failed to read admin config: %w", err)
 		}
+	} else if file := viper.ConfigFileUsed(); !slices.Contains(l.files, file) {
+		l.files = append(l.files, file)
+	}
+
+	// The profile file overrides the config file.
+	if err := l.mergeProfile(resolver); err != nil {
+		return nil, err
+	}
+
+	// Check the raw values to report the unknown keys and the invalid
+	// durations precisely instead of a decoding error.
+	if err := l.validateKeys(); err != nil {
+		return nil, err
 	}
 
 	if err := viper.Unmarshal(&cfg); err != nil {
@@ -62,16 +87,16 @@ func (l *ConfigLoader) Load() (*Config, error) {
 
 	// Validate the configuration
 	if err := l.validateConfig(&cfg); err != nil {
-		return nil, fmt.Errorf("invalid configuration: %w", err)
+		return nil, err
 	}
 
 	return &cfg, nil
 }
 
-func (l *ConfigLoader) setupViper() error {
+func (l *ConfigLoader) setupViper() (PathResolver, error) {
 	homeDir, err := l.getHomeDir()
 	if err != nil {
-		return err
+		return PathResolver{}, err
 	}
 	xdgConfig := l.getXDGConfig(homeDir)
 	resolver := newResolver("DAGU_HOME", filepath.Join(homeDir, ".dagu"), xdgConfig)
@@ -80,7 +105,38 @@ func (l *ConfigLoader) setupViper() error {
 	l.bindEnvironmentVariables()
 	l.setDefaultValues(resolver)
 
-	return l.setExecutableDefault()
+	return resolver, l.setExecutableDefault()
+}
+
+// mergeProfile merges the file of the selected profile. The file of the
+// profile "prod" is config.prod.yaml in the config directory, or next to
+// the config file given with --config, e.g. /etc/dagu/dagu.prod.yaml for
+// /etc/dagu/dagu.yaml.
+func (l *ConfigLoader) mergeProfile(resolver PathResolver) error {
+	profile := viper.GetString("profile")
+	if profile == "" {
+		return nil
+	}
+	file := filepath.Join(resolver.ConfigDir, "config."+profile+".yaml")
+	if len(l.files) > 0 {
+		base := l.files[0]
+		ext := filepath.Ext(base)
+		file = strings.TrimSuffix(base, ext) + "." + profile + ext
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("profile %q not found: %s does not exist", profile, file)
+		}
+		return fmt.Errorf("failed to read profile %q: %w", profile, err)
+	}
+	defer f.Close()
+	if err := viper.MergeConfig(f); err != nil {
+		return fmt.Errorf("failed to read profile %q: %w", profile, err)
+	}
+	l.files = append(l.files, file)
+	return nil
 }
 
 func (l *ConfigLoader) getHomeDir() (string, error) {
@@ -116,6 +172,7 @@ func (l *ConfigLoader) setDefaultValues(resolver PathResolver) {
 	viper.SetDefault("paths.logDir", resolver.LogsDir)
 	viper.SetDefault("paths.adminLogsDir", resolver.AdminLogsDir)
 	viper.SetDefault("paths.baseConfig", resolver.BaseConfigFile)
+	viper.SetDefault("paths.pluginsDir", resolver.PluginsDir)
 
 	// Server settings
 	viper.SetDefault("host", "127.0.0.1")
@@ -124,6 +181,17 @@ func (l *ConfigLoader) setDefaultValues(resolver PathResolver) {
 	viper.SetDefault("basePath", "")
 	viper.SetDefault("apiBaseURL", "/api/v1")
 	viper.SetDefault("latestStatusToday", false)
+	viper.SetDefault("shutdownGracePeriod", "60s")
+	viper.SetDefault("scheduler.zombieCheckInterval", "5m")
+	viper.SetDefault("mailQueue.maxAttempts", 5)
+	viper.SetDefault("mailQueue.flushTimeout", "10s")
+	viper.SetDefault("mailQueue.redeliverInterval", "1m")
+	viper.SetDefault("persistence.backend", PersistenceBackendFile)
+	viper.SetDefault("historyCompaction.afterDays", 30)
+	viper.SetDefault("historyCompaction.interval", "24h")
+	viper.SetDefault("statsd.prefix", "dagu.")
+	viper.SetDefault("tracing.service", "dagu")
+	viper.SetDefault("cgroupRoot", "/sys/fs/cgroup/dagu")
 
 	// UI settings
 	viper.SetDefault("ui.navbarTitle", build.AppName)
@@ -135,14 +203,57 @@ func (l *ConfigLoader) setDefaultValues(resolver PathResolver) {
 }
 
 func (l *ConfigLoader) bindEnvironmentVariables() {
+	// Profile
+	l.bindEnv("profile", "PROFILE")
+
 	// Server configurations
 	l.bindEnv("logFormat", "LOG_FORMAT")
 	l.bindEnv("basePath", "BASE_PATH")
+	l.bindEnv("publicURL", "PUBLIC_URL")
 	l.bindEnv("apiBaseURL", "API_BASE_URL")
 	l.bindEnv("tz", "TZ")
 	l.bindEnv("host", "HOST")
 	l.bindEnv("port", "PORT")
 	l.bindEnv("debug", "DEBUG")
+	l.bindEnv("shutdownGracePeriod", "SHUTDOWN_GRACE_PERIOD")
+	l.bindEnv("cgroupRoot", "CGROUP_ROOT")
+	l.bindEnv("hostEnv.allow", "HOST_ENV_ALLOW")
+	l.bindEnv("hostEnv.deny", "HOST_ENV_DENY")
+
+	// Scheduler configurations
+	l.bindEnv("scheduler.metricsAddr", "SCHEDULER_METRICS_ADDR")
+	l.bindEnv("scheduler.resumeInterruptedRuns", "SCHEDULER_RESUME_INTERRUPTED_RUNS")
+	l.bindEnv("scheduler.zombieCheckInterval", "SCHEDULER_ZOMBIE_CHECK_INTERVAL")
+	l.bindEnv("scheduler.zombieNotify", "SCHEDULER_ZOMBIE_NOTIFY")
+
+	// Mail queue configurations
+	l.bindEnv("mailQueue.maxAttempts", "MAIL_QUEUE_MAX_ATTEMPTS")
+	l.bindEnv("mailQueue.flushTimeout", "MAIL_QUEUE_FLUSH_TIMEOUT")
+	l.bindEnv("mailQueue.redeliverInterval", "MAIL_QUEUE_REDELIVER_INTERVAL")
+	l.bindEnv("persistence.backend", "PERSISTENCE_BACKEND")
+	l.bindEnv("persistence.sqlitePath", "PERSISTENCE_SQLITE_PATH")
+	l.bindEnv("historyCompaction.afterDays", "HISTORY_COMPACTION_AFTER_DAYS")
+	l.bindEnv("historyCompaction.interval", "HISTORY_COMPACTION_INTERVAL")
+
+	// StatsD configurations
+	l.bindEnv("statsd.addr", "STATSD_ADDR")
+	l.bindEnv("statsd.prefix", "STATSD_PREFIX")
+	l.bindEnv("statsd.datadog", "STATSD_DATADOG")
+
+	// Tracing configurations
+	l.bindEnv("tracing.exporter", "TRACING_EXPORTER")
+	l.bindEnv("tracing.endpoint", "TRACING_ENDPOINT")
+	l.bindEnv("tracing.service", "TRACING_SERVICE")
+	l.bindEnv("tracing.apiKey", "TRACING_API_KEY")
+
+	// Sentry configurations
+	l.bindEnv("sentry.dsn", "SENTRY_DSN")
+	l.bindEnv("sentry.environment", "SENTRY_ENVIRONMENT")
+
+	// Encryption configurations
+	l.bindEnv("encryption.key", "ENCRYPTION_KEY")
+	l.bindEnv("encryption.keyFile", "ENCRYPTION_KEY_FILE")
+	l.bindEnv("encryption.keyCommand", "ENCRYPTION_KEY_COMMAND")
 
 	// UI configurations
 	l.bindEnv("ui.maxDashboardPageLimit", "UI_MAX_DASHBOARD_PAGE_LIMIT")
@@ -184,6 +295,7 @@ func (l *ConfigLoader) bindEnvironmentVariables() {
 	l.bindEnv("suspendFlagsDir", "SUSPEND_FLAGS_DIR")
 	l.bindEnv("adminLogsDir", "ADMIN_LOG_DIR")
 	l.bindEnv("executable", "EXECUTABLE")
+	l.bindEnv("paths.pluginsDir", "PLUGINS_DIR")
 
 	// UI customization
 	l.bindEnv("latestStatusToday", "LATEST_STATUS_TODAY")
@@ -192,6 +304,7 @@ func (l *ConfigLoader) bindEnvironmentVariables() {
 func (l *ConfigLoader) bindEnv(key, env string) {
 	prefix := strings.ToUpper(build.Slug) + "_"
 	_ = viper.BindEnv(key, prefix+env)
+	l.envNames[key] = append(l.envNames[key], prefix+env)
 }
 
 func (l *ConfigLoader) LoadLegacyEnv(cfg *Config) error {
@@ -284,31 +397,57 @@ func (l *ConfigLoader) setExecutableDefault() error {
 }
 
 func (l *ConfigLoader) validateConfig(cfg *Config) error {
+	var v validator
+
 	if cfg.Port < 0 || cfg.Port > 65535 {
-		return fmt.Errorf("invalid port number: %d", cfg.Port)
+		v.addf("port: invalid port number: %d", cfg.Port)
 	}
 
-	if cfg.Auth.Basic.Enabled && (cfg.Auth.Basic.Username == "" || cfg.Auth.Basic.Password == "") {
-		return fmt.Errorf("basic auth enabled but username or password is not set")
+	// The admin user and token may be omitted when the scoped ones are set.
+	basic, token := cfg.Auth.Basic, cfg.Auth.Token
+	if basic.Enabled && (basic.Username == "" || basic.Password == "") &&
+		(basic.Username != "" || basic.Password != "" || len(basic.Users) == 0) {
+		v.addf("auth.basic: basic auth enabled but username or password is not set")
 	}
 
-	if cfg.Auth.Token.Enabled && cfg.Auth.Token.Value == "" {
-		return fmt.Errorf("auth token enabled but token is not set")
+	if token.Enabled && token.Value == "" && len(token.Tokens) == 0 {
+		v.addf("auth.token: auth token enabled but token is not set")
+	}
+	v.checkScopedAuth(cfg.Auth)
+	v.checkEncryption(cfg.Encryption)
+	switch cfg.Persistence.Backend {
+	case "", PersistenceBackendFile, PersistenceBackendSQLite:
+	default:
+		v.addf("persistence.backend: must be %q or %q: %q", PersistenceBackendFile, PersistenceBackendSQLite, cfg.Persistence.Backend)
+	}
+	if cfg.HistoryCompaction.Interval > 0 && cfg.HistoryCompaction.AfterDays < 1 {
+		v.addf("historyCompaction.afterDays: must be at least 1: %d", cfg.HistoryCompaction.AfterDays)
 	}
 
-	if cfg.TLS != nil {
-		if cfg.TLS.CertFile == "" || cfg.TLS.KeyFile == "" {
-			return fmt.Errorf("TLS configuration incomplete: both cert and key files are required")
+	// The deprecated settings override the new ones, so setting both is
+	// likely a mistake.
+	if cfg.IsBasicAuth && (cfg.Auth.Basic.Enabled || cfg.Auth.Basic.Username != "" || cfg.Auth.Basic.Password != "") {
+		v.addf("auth.basic: conflicts with the deprecated isBasicAuth; remove isBasicAuth, basicAuthUsername and basicAuthPassword")
+	}
+	if cfg.IsAuthToken && (cfg.Auth.Token.Enabled || cfg.Auth.Token.Value != "") {
+		v.addf("auth.token: conflicts with the deprecated isAuthToken; remove isAuthToken and authToken")
+	}
+
+	for i, node := range cfg.RemoteNodes {
+		if node.IsBasicAuth && node.IsAuthToken {
+			v.addf("remoteNodes[%d] (%s): isBasicAuth and isAuthToken are both enabled; enable only one", i, node.Name)
 		}
 	}
 
-	if cfg.Port < 0 || cfg.Port > 65535 {
-		return fmt.Errorf("invalid port number: %d", cfg.Port)
+	if cfg.TLS != nil {
+		if cfg.TLS.CertFile == "" || cfg.TLS.KeyFile == "" {
+			v.addf("tls: TLS configuration incomplete: both cert and key files are required")
+		}
 	}
 
 	if cfg.UI.MaxDashboardPageLimit < 1 {
-		return fmt.Errorf("invalid max dashboard page limit: %d", cfg.UI.MaxDashboardPageLimit)
+		v.addf("ui.maxDashboardPageLimit: invalid max dashboard page limit: %d", cfg.UI.MaxDashboardPageLimit)
 	}
 
-	return nil
+	return v.err()
 }
@@ -1,10 +1,14 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -61,7 +65,7 @@ func TestConfigLoader_SetupViper(t *testing.T) {
 	tmpDir := setupTestEnv(t)
 
 	loader := NewConfigLoader()
-	err := loader.setupViper()
+	_, err := loader.setupViper()
 	if err != nil {
 		t.Fatalf("setupViper() error = %v", err)
 	}
@@ -189,6 +193,7 @@ func TestConfigLoader_BindEnvironmentVariables(t *testing.T) {
 		"DAGU_AUTH_BASIC_USERNAME": "env-user",
 		"DAGU_AUTH_BASIC_PASSWORD": "env-pass",
 		"DAGU_UI_NAVBAR_TITLE":     "Env Title",
+		"DAGU_HOST_ENV_ALLOW":      "AWS_*,GOPATH",
 	}
 
 	// Set environment variables
@@ -233,6 +238,9 @@ func TestConfigLoader_BindEnvironmentVariables(t *testing.T) {
 	if cfg.UI.NavbarTitle != "Env Title" {
 		t.Errorf("UI.NavbarTitle = %v, want Env Title", cfg.UI.NavbarTitle)
 	}
+	if !reflect.DeepEqual(cfg.HostEnv.Allow, []string{"AWS_*", "GOPATH"}) {
+		t.Errorf("HostEnv.Allow = %v, want [AWS_* GOPATH]", cfg.HostEnv.Allow)
+	}
 }
 
 func TestConfigLoader_DefaultValues(t *testing.T) {
@@ -260,6 +268,45 @@ func TestConfigLoader_DefaultValues(t *testing.T) {
 	if cfg.UI.LogEncodingCharset != "utf-8" {
 		t.Errorf("UI.LogEncodingCharset = %v, want utf-8", cfg.UI.LogEncodingCharset)
 	}
+	if cfg.ShutdownGracePeriod != time.Minute {
+		t.Errorf("ShutdownGracePeriod = %v, want 1m", cfg.ShutdownGracePeriod)
+	}
+	if cfg.Scheduler.ZombieCheckInterval != 5*time.Minute {
+		t.Errorf("Scheduler.ZombieCheckInterval = %v, want 5m", cfg.Scheduler.ZombieCheckInterval)
+	}
+	if cfg.MailQueue.MaxAttempts != 5 {
+		t.Errorf("MailQueue.MaxAttempts = %v, want 5", cfg.MailQueue.MaxAttempts)
+	}
+	if cfg.MailQueue.FlushTimeout != 10*time.Second {
+		t.Errorf("MailQueue.FlushTimeout = %v, want 10s", cfg.MailQueue.FlushTimeout)
+	}
+	if cfg.MailQueue.RedeliverInterval != time.Minute {
+		t.Errorf("MailQueue.RedeliverInterval = %v, want 1m", cfg.MailQueue.RedeliverInterval)
+	}
+	if cfg.Persistence.Backend != PersistenceBackendFile {
+		t.Errorf("Persistence.Backend = %v, want %v", cfg.Persistence.Backend, PersistenceBackendFile)
+	}
+	if cfg.HistoryCompaction.AfterDays != 30 {
+		t.Errorf("HistoryCompaction.AfterDays = %v, want 30", cfg.HistoryCompaction.AfterDays)
+	}
+	if cfg.HistoryCompaction.Interval != 24*time.Hour {
+		t.Errorf("HistoryCompaction.Interval = %v, want 24h", cfg.HistoryCompaction.Interval)
+	}
+	if cfg.StatsD.Addr != "" {
+		t.Errorf("StatsD.Addr = %v, want empty", cfg.StatsD.Addr)
+	}
+	if cfg.StatsD.Prefix != "dagu." {
+		t.Errorf("StatsD.Prefix = %v, want dagu.", cfg.StatsD.Prefix)
+	}
+	if cfg.Tracing.Service != "dagu" {
+		t.Errorf("Tracing.Service = %v, want dagu", cfg.Tracing.Service)
+	}
+	if cfg.CgroupRoot != "/sys/fs/cgroup/dagu" {
+		t.Errorf("CgroupRoot = %v, want /sys/fs/cgroup/dagu", cfg.CgroupRoot)
+	}
+	if len(cfg.HostEnv.Allow) != 0 || len(cfg.HostEnv.Deny) != 0 {
+		t.Errorf("HostEnv = %v, want empty", cfg.HostEnv)
+	}
 }
 
 func TestConfigLoader_ConfigFileOverride(t *testing.T) {
@@ -302,3 +349,167 @@ ui:
 		t.Errorf("UI.MaxDashboardPageLimit = %v, want 200", cfg.UI.MaxDashboardPageLimit)
 	}
 }
+
+func TestConfigLoader_Profile(t *testing.T) {
+	tmpDir := setupTestEnv(t)
+
+	configDir := filepath.Join(tmpDir, ".config", "dagu")
+	if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte("host: base-host\nport: 7000\n"), 0644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(configDir, "config.prod.yaml"), []byte("port: 7100\ndebug: true\n"), 0644); err != nil {
+		t.Fatalf("failed to write profile file: %v", err)
+	}
+
+	t.Run("Layering", func(t *testing.T) {
+		t.Setenv("DAGU_PROFILE", "prod")
+		t.Setenv("DAGU_DEBUG", "false")
+
+		loader := NewConfigLoader()
+		cfg, err := loader.Load()
+		if err != nil {
+			t.Fatalf("Load() error = %v", err)
+		}
+
+		// The profile overrides the config file and the environment
+		// variables override the profile.
+		if cfg.Profile != "prod" {
+			t.Errorf("Profile = %v, want prod", cfg.Profile)
+		}
+		if cfg.Host != "base-host" {
+			t.Errorf("Host = %v, want base-host", cfg.Host)
+		}
+		if cfg.Port != 7100 {
+			t.Errorf("Port = %v, want 7100", cfg.Port)
+		}
+		if cfg.Debug {
+			t.Error("Debug = true, want false")
+		}
+
+		files := loader.Files()
+		if len(files) != 2 || filepath.Base(files[1]) != "config.prod.yaml" {
+			t.Errorf("Files() = %v, want config.yaml and config.prod.yaml", files)
+		}
+
+		settings, err := loader.Settings()
+		if err != nil {
+			t.Fatalf("Settings() error = %v", err)
+		}
+		want := map[string]string{
+			"host":                   filepath.Join(configDir, "config.yaml"),
+			"port":                   filepath.Join(configDir, "config.prod.yaml"),
+			"debug":                  SourceEnv,
+			"ui.navbarTitle":         SourceDefault,
+			"paths.dataDir":          SourceDefault,
+			"mailQueue.flushTimeout": SourceDefault,
+		}
+		for _, s := range settings {
+			if source, ok := want[s.Key]; ok {
+				if s.Source != source {
+					t.Errorf("source of %s = %v, want %v", s.Key, s.Source, source)
+				}
+				delete(want, s.Key)
+			}
+		}
+		if len(want) > 0 {
+			t.Errorf("settings not found: %v", want)
+		}
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		t.Setenv("DAGU_PROFILE", "staging")
+
+		loader := NewConfigLoader()
+		if _, err := loader.Load(); err == nil {
+			t.Error("Load() error = nil, want profile not found")
+		}
+	})
+}
+
+func TestConfigLoader_ValidateKeys(t *testing.T) {
+	tmpDir := setupTestEnv(t)
+
+	configFile := filepath.Join(tmpDir, ".config", "dagu", "config.yaml")
+	testConfig := []byte(`
+hots: "0.0.0.0"
+ui:
+  navbarTitel: "Title"
+mailQueue:
+  flushTimeout: 10x
+shutdownGracePeriod: -5s
+`)
+	if err := os.WriteFile(configFile, testConfig, 0644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	loader := NewConfigLoader()
+	_, err := loader.Load()
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Load() error = %v, want ValidationError", err)
+	}
+	want := []string{
+		`unknown key "hots" in ` + configFile + ` (did you mean "host"?)`,
+		`unknown key "ui.navbartitel" in ` + configFile + ` (did you mean "ui.navbarTitle"?)`,
+		`mailQueue.flushTimeout: invalid duration "10x"; use a number with a unit such as "30s" or "5m"`,
+		`shutdownGracePeriod: the duration must not be negative: "-5s"`,
+	}
+	if !reflect.DeepEqual(verr.Problems, want) {
+		t.Errorf("Problems = %q, want %q", verr.Problems, want)
+	}
+}
+
+func TestConfigLoader_DAGSearchPaths(t *testing.T) {
+	tmpDir := setupTestEnv(t)
+
+	teamDir := filepath.Join(tmpDir, "team")
+	if err := os.MkdirAll(teamDir, 0755); err != nil {
+		t.Fatalf("failed to create directory: %v", err)
+	}
+	teamBase := filepath.Join(tmpDir, "team-base.yaml")
+	configData := fmt.Sprintf(`
+paths:
+  dagsDir: %s
+  baseConfig: %s
+  dagSearchPaths:
+    - dir: %s
+      baseConfig: %s
+    - dir: %s
+`, filepath.Join(tmpDir, "dags"), filepath.Join(tmpDir, "base.yaml"), teamDir, teamBase, tmpDir)
+	configFile := filepath.Join(tmpDir, ".config", "dagu", "config.yaml")
+	if err := os.WriteFile(configFile, []byte(configData), 0644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	cfg, err := NewConfigLoader().Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	wantDirs := []string{filepath.Join(tmpDir, "dags"), teamDir, tmpDir}
+	if dirs := cfg.Paths.DAGDirs(); !reflect.DeepEqual(dirs, wantDirs) {
+		t.Errorf("DAGDirs() = %v, want %v", dirs, wantDirs)
+	}
+
+	// The DAGs in a search path use its base config if it has one.
+	for file, want := range map[string]string{
+		filepath.Join(teamDir, "etl.yaml"):        teamBase,
+		filepath.Join(tmpDir, "etl.yaml"):         filepath.Join(tmpDir, "base.yaml"),
+		filepath.Join(tmpDir, "dags", "etl.yaml"): filepath.Join(tmpDir, "base.yaml"),
+	} {
+		if got := cfg.Paths.BaseConfigFor(file); got != want {
+			t.Errorf("BaseConfigFor(%s) = %v, want %v", file, got, want)
+		}
+	}
+
+	// The base config of the namespace is applied after the one of its
+	// directory.
+	for file, want := range map[string][]string{
+		filepath.Join(tmpDir, "dags", "etl.yaml"):           {filepath.Join(tmpDir, "base.yaml")},
+		filepath.Join(tmpDir, "dags", "team-a", "etl.yaml"): {filepath.Join(tmpDir, "base.yaml"), filepath.Join(tmpDir, "dags", "team-a", ".base.yaml")},
+		filepath.Join(teamDir, "team-b", "etl.yaml"):        {teamBase, filepath.Join(teamDir, "team-b", ".base.yaml")},
+	} {
+		if got := cfg.Paths.BaseConfigsFor(file); !reflect.DeepEqual(got, want) {
+			t.Errorf("BaseConfigsFor(%s) = %v, want %v", file, got, want)
+		}
+	}
+}
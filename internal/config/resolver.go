@@ -21,6 +21,7 @@ type Paths struct {
 	LogsDir         string
 	AdminLogsDir    string
 	BaseConfigFile  string
+	PluginsDir      string
 }
 
 type XDGConfig struct {
@@ -56,6 +57,7 @@ func (r *PathResolver) setXDGPaths() {
 	r.AdminLogsDir = filepath.Join(r.DataHome, build.Slug, "logs", "admin")
 	r.SuspendFlagsDir = filepath.Join(r.DataHome, build.Slug, "suspend")
 	r.DAGsDir = filepath.Join(r.ConfigHome, build.Slug, "dags")
+	r.PluginsDir = filepath.Join(r.ConfigHome, build.Slug, "plugins")
 }
 
 func (r *PathResolver) setLegacyPaths() {
@@ -65,4 +67,5 @@ func (r *PathResolver) setLegacyPaths() {
 	r.AdminLogsDir = filepath.Join(r.ConfigDir, "logs", "admin")
 	r.SuspendFlagsDir = filepath.Join(r.ConfigDir, "suspend")
 	r.DAGsDir = filepath.Join(r.ConfigDir, "dags")
+	r.PluginsDir = filepath.Join(r.ConfigDir, "plugins")
 }
@@ -30,6 +30,7 @@ func TestResolver(t *testing.T) {
 				LogsDir:         filepath.Join(tmpDir, build.Slug, "logs"),
 				AdminLogsDir:    filepath.Join(tmpDir, build.Slug, "logs/admin"),
 				BaseConfigFile:  filepath.Join(tmpDir, build.Slug, "base.yaml"),
+				PluginsDir:      filepath.Join(tmpDir, build.Slug, "plugins"),
 			},
 		})
 	})
@@ -53,6 +54,7 @@ func TestResolver(t *testing.T) {
 				LogsDir:         filepath.Join(tmpDir, hiddenDir, "logs"),
 				AdminLogsDir:    filepath.Join(tmpDir, hiddenDir, "logs", "admin"),
 				BaseConfigFile:  filepath.Join(tmpDir, hiddenDir, "base.yaml"),
+				PluginsDir:      filepath.Join(tmpDir, hiddenDir, "plugins"),
 			},
 		})
 	})
@@ -70,6 +72,7 @@ func TestResolver(t *testing.T) {
 				LogsDir:         path.Join("/home/user/.local/share", build.Slug, "logs"),
 				AdminLogsDir:    path.Join("/home/user/.local/share", build.Slug, "logs", "admin"),
 				BaseConfigFile:  path.Join("/home/user/.config", build.Slug, "base.yaml"),
+				PluginsDir:      path.Join("/home/user/.config", build.Slug, "plugins"),
 			},
 			XDGConfig: XDGConfig{
 				DataHome:   "/home/user/.local/share",
@@ -0,0 +1,159 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dagu-org/dagu/internal/build"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// Sources of the settings other than the files.
+const (
+	SourceDefault = "default"
+	SourceEnv     = "env"
+	SourceFlag    = "flag"
+)
+
+// Setting is a key of the configuration with its effective value and the
+// layer the value comes from.
+type Setting struct {
+	Key   string
+	Value any
+	// Source is "default", "env", "flag", or the path of the file.
+	Source string
+	// Origin is the name of the environment variable or the flag.
+	Origin string
+}
+
+var (
+	flagsMu sync.Mutex
+	// boundFlags is the command-line flags bound to the keys.
+	boundFlags = make(map[string]*pflag.Flag)
+)
+
+// BindFlag binds the command-line flag to the key. The flag takes
+// precedence over the other layers when it's given.
+func BindFlag(key string, flag *pflag.Flag) error {
+	if err := viper.BindPFlag(key, flag); err != nil {
+		return err
+	}
+	flagsMu.Lock()
+	defer flagsMu.Unlock()
+	boundFlags[key] = flag
+	return nil
+}
+
+// Files returns the configuration files merged by the last load, in the
+// order of precedence.
+func (l *ConfigLoader) Files() []string {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return slices.Clone(l.files)
+}
+
+// Settings returns the settings resolved by the last load sorted by key.
+func (l *ConfigLoader) Settings() ([]Setting, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	// Read each file alone to find the last one setting the key.
+	files := make([]*viper.Viper, 0, len(l.files))
+	for _, file := range l.files {
+		v := viper.New()
+		v.SetConfigFile(file)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		files = append(files, v)
+	}
+
+	names := keyNames()
+	var settings []Setting
+	for _, key := range viper.AllKeys() {
+		setting := Setting{Key: key, Value: viper.Get(key), Source: SourceDefault}
+		if name, ok := names[key]; ok {
+			setting.Key = name
+		}
+		for i, v := range files {
+			if v.IsSet(key) {
+				setting.Source = l.files[i]
+			}
+		}
+		if env, ok := l.lookupEnv(key); ok {
+			setting.Source, setting.Origin = SourceEnv, env
+		}
+		if flag, ok := lookupFlag(key); ok {
+			setting.Source, setting.Origin = SourceFlag, "--"+flag.Name
+		}
+		settings = append(settings, setting)
+	}
+	sort.Slice(settings, func(i, j int) bool {
+		return settings[i].Key < settings[j].Key
+	})
+	return settings, nil
+}
+
+// lookupEnv returns the environment variable set for the key. The variable
+// is either bound explicitly or the automatic one, e.g. DAGU_HOST.
+func (l *ConfigLoader) lookupEnv(key string) (string, bool) {
+	for name, envs := range l.envNames {
+		if !strings.EqualFold(name, key) {
+			continue
+		}
+		for _, env := range envs {
+			if _, ok := os.LookupEnv(env); ok {
+				return env, true
+			}
+		}
+	}
+	env := strings.ToUpper(build.Slug) + "_" + strings.ToUpper(strings.ReplaceAll(key, "-", "_"))
+	if _, ok := os.LookupEnv(env); ok {
+		return env, true
+	}
+	return "", false
+}
+
+// lookupFlag returns the flag given for the key.
+func lookupFlag(key string) (*pflag.Flag, bool) {
+	flagsMu.Lock()
+	defer flagsMu.Unlock()
+	for name, flag := range boundFlags {
+		if strings.EqualFold(name, key) && flag.Changed {
+			return flag, true
+		}
+	}
+	return nil, false
+}
+
+// keyNames maps the lowercase keys of viper to the names of the fields in
+// the config file, e.g. "paths.dagsdir" to "paths.dagsDir".
+func keyNames() map[string]string {
+	names := make(map[string]string)
+	var walk func(t reflect.Type, prefix string)
+	walk = func(t reflect.Type, prefix string) {
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return
+		}
+		for i := 0; i < t.NumField(); i++ {
+			tag := t.Field(i).Tag.Get("mapstructure")
+			if tag == "" || tag == "-" {
+				continue
+			}
+			name := prefix + tag
+			names[strings.ToLower(name)] = name
+			walk(t.Field(i).Type, name+".")
+		}
+	}
+	walk(reflect.TypeOf(Config{}), "")
+	return names
+}
@@ -0,0 +1,291 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/crypt"
+	"github.com/dagu-org/dagu/internal/namespace"
+	"github.com/spf13/viper"
+)
+
+// ValidationError is the problems found in the configuration. All the
+// problems found by a check are reported at once so that they can be
+// fixed together.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Problems) == 1 {
+		return "invalid configuration: " + e.Problems[0]
+	}
+	return "invalid configuration:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+// validator collects the problems of the configuration.
+type validator struct {
+	problems []string
+}
+
+func (v *validator) addf(format string, args ...any) {
+	v.problems = append(v.problems, fmt.Sprintf(format, args...))
+}
+
+func (v *validator) err() error {
+	if len(v.problems) == 0 {
+		return nil
+	}
+	return &ValidationError{Problems: v.problems}
+}
+
+// validateKeys checks the raw values before they are decoded: the keys in
+// the files must be known and the durations must be parsable.
+func (l *ConfigLoader) validateKeys() error {
+	var v validator
+	names := keyNames()
+
+	for _, file := range l.files {
+		fv := viper.New()
+		fv.SetConfigFile(file)
+		if err := fv.ReadInConfig(); err != nil {
+			v.addf("failed to read %s: %v", file, err)
+			continue
+		}
+		keys := fv.AllKeys()
+		sort.Strings(keys)
+		for _, key := range keys {
+			if _, ok := names[key]; ok {
+				continue
+			}
+			msg := fmt.Sprintf("unknown key %q in %s", key, file)
+			if suggestion := suggestKey(key, names); suggestion != "" {
+				msg += fmt.Sprintf(" (did you mean %q?)", suggestion)
+			}
+			v.addf("%s", msg)
+		}
+	}
+
+	for _, key := range durationKeys() {
+		s, ok := viper.Get(key).(string)
+		if !ok || s == "" {
+			continue
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			v.addf("%s: invalid duration %q; use a number with a unit such as \"30s\" or \"5m\"", key, s)
+		} else if d < 0 {
+			v.addf("%s: the duration must not be negative: %q", key, s)
+		}
+	}
+	return v.err()
+}
+
+// durationKeys returns the keys of the duration fields.
+func durationKeys() []string {
+	var keys []string
+	durationType := reflect.TypeOf(time.Duration(0))
+	var walk func(t reflect.Type, prefix string)
+	walk = func(t reflect.Type, prefix string) {
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return
+		}
+		for i := 0; i < t.NumField(); i++ {
+			tag := t.Field(i).Tag.Get("mapstructure")
+			if tag == "" || tag == "-" {
+				continue
+			}
+			if t.Field(i).Type == durationType {
+				keys = append(keys, prefix+tag)
+				continue
+			}
+			walk(t.Field(i).Type, prefix+tag+".")
+		}
+	}
+	walk(reflect.TypeOf(Config{}), "")
+	return keys
+}
+
+// suggestKey returns the known key closest to the unknown one, or an empty
+// string if none is close enough to be a typo.
+func suggestKey(key string, names map[string]string) string {
+	best, bestDist := "", 3
+	for lower, name := range names {
+		if d := editDistance(key, lower); d < bestDist || (d == bestDist && name < best) {
+			best, bestDist = name, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance of the strings.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// pathCheck is a path of the configuration to check.
+type pathCheck struct {
+	key  string
+	path string
+	// required is whether the path must exist. The directories that don't
+	// exist yet are created when they are used.
+	required bool
+}
+
+// validatePaths checks that the paths of the configuration can be used.
+func (c *Config) validatePaths() error {
+	var v validator
+
+	dirs := []pathCheck{
+		{key: "paths.dagsDir", path: c.Paths.DAGsDir},
+		{key: "paths.logDir", path: c.Paths.LogDir},
+		{key: "paths.dataDir", path: c.Paths.DataDir},
+		{key: "paths.suspendFlagsDir", path: c.Paths.SuspendFlagsDir},
+		{key: "paths.adminLogsDir", path: c.Paths.AdminLogsDir},
+		{key: "paths.pluginsDir", path: c.Paths.PluginsDir},
+		{key: "workDir", path: c.WorkDir, required: true},
+	}
+	for i, sp := range c.Paths.DAGSearchPaths {
+		key := fmt.Sprintf("paths.dagSearchPaths[%d].dir", i)
+		if sp.Dir == "" {
+			v.addf("%s: the directory is required", key)
+		}
+		dirs = append(dirs, pathCheck{key: key, path: sp.Dir, required: true})
+	}
+	for _, dir := range dirs {
+		v.checkPath(dir, true)
+	}
+
+	files := []pathCheck{
+		{key: "paths.baseConfig", path: c.Paths.BaseConfig},
+		{key: "paths.executable", path: c.Paths.Executable, required: true},
+	}
+	for i, sp := range c.Paths.DAGSearchPaths {
+		files = append(files, pathCheck{key: fmt.Sprintf("paths.dagSearchPaths[%d].baseConfig", i), path: sp.BaseConfig})
+	}
+	if c.TLS != nil {
+		files = append(files,
+			pathCheck{key: "tls.certFile", path: c.TLS.CertFile, required: true},
+			pathCheck{key: "tls.keyFile", path: c.TLS.KeyFile, required: true},
+		)
+	}
+	for _, file := range files {
+		v.checkPath(file, false)
+	}
+	return v.err()
+}
+
+// checkScopedAuth checks the users and the tokens scoped to the namespaces.
+func (v *validator) checkScopedAuth(auth Auth) {
+	checkNamespaces := func(key string, namespaces []string) {
+		if len(namespaces) == 0 {
+			v.addf("%s: at least one namespace is required; use \"*\" for all of them", key)
+		}
+		for _, ns := range namespaces {
+			if ns != namespace.All && !namespace.Valid(ns) {
+				v.addf("%s: invalid namespace %q", key, ns)
+			}
+		}
+	}
+
+	usernames := make(map[string]bool)
+	if auth.Basic.Username != "" {
+		usernames[auth.Basic.Username] = true
+	}
+	for i, user := range auth.Basic.Users {
+		key := fmt.Sprintf("auth.basic.users[%d]", i)
+		if user.Username == "" || user.Password == "" {
+			v.addf("%s: the username and the password are required", key)
+		} else if usernames[user.Username] {
+			v.addf("%s: duplicate username %q", key, user.Username)
+		}
+		usernames[user.Username] = true
+		checkNamespaces(key+".namespaces", user.Namespaces)
+	}
+
+	for i, token := range auth.Token.Tokens {
+		key := fmt.Sprintf("auth.token.tokens[%d]", i)
+		if token.Value == "" {
+			v.addf("%s: the value is required", key)
+		}
+		checkNamespaces(key+".namespaces", token.Namespaces)
+	}
+}
+
+// checkEncryption checks that the key of the encryption is given only once
+// and can be used.
+func (v *validator) checkEncryption(enc Encryption) {
+	var set []string
+	for _, f := range []struct{ key, value string }{
+		{"encryption.key", enc.Key},
+		{"encryption.keyFile", enc.KeyFile},
+		{"encryption.keyCommand", enc.KeyCommand},
+	} {
+		if f.value != "" {
+			set = append(set, f.key)
+		}
+	}
+	if len(set) > 1 {
+		v.addf("encryption: %s are set; set only one of them", strings.Join(set, ", "))
+		return
+	}
+	if enc.Key != "" {
+		if _, err := crypt.ParseKey(enc.Key); err != nil {
+			v.addf("encryption.key: %v", err)
+		}
+	}
+	v.checkPath(pathCheck{key: "encryption.keyFile", path: enc.KeyFile, required: true}, false)
+}
+
+// checkPath checks that the path is a readable directory or file.
+func (v *validator) checkPath(check pathCheck, dir bool) {
+	if check.path == "" {
+		return
+	}
+	info, err := os.Stat(check.path)
+	switch {
+	case os.IsNotExist(err):
+		if check.required {
+			v.addf("%s: %s does not exist", check.key, check.path)
+		}
+		return
+	case err != nil:
+		v.addf("%s: %s is not accessible: %v", check.key, check.path, err)
+		return
+	case dir && !info.IsDir():
+		v.addf("%s: %s is not a directory", check.key, check.path)
+		return
+	case !dir && info.IsDir():
+		v.addf("%s: %s is a directory, not a file", check.key, check.path)
+		return
+	}
+	f, err := os.Open(check.path)
+	if err != nil {
+		v.addf("%s: %s is not readable: %v", check.key, check.path, err)
+		return
+	}
+	_ = f.Close()
+}
@@ -0,0 +1,266 @@
+// Package crypt encrypts the status files and the logs at rest with
+// AES-256-GCM. The encrypted files are made of lines, each one a record
+// holding the prefix and the base64 of the nonce and the ciphertext, so
+// that the files can still be appended to and read while they are written.
+// The lines without the prefix are read as they are, which keeps the files
+// written before the encryption was enabled readable.
+package crypt
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// KeySize is the size of the keys in bytes.
+const KeySize = 32
+
+// recordPrefix starts the lines holding an encrypted record.
+var recordPrefix = []byte("dagu:enc:v1:")
+
+var (
+	// ErrNoKey is returned when reading an encrypted file without a key.
+	ErrNoKey = errors.New("the file is encrypted but no encryption key is configured")
+	// ErrDecrypt is returned when a record can't be decrypted, e.g. because
+	// it was encrypted with another key.
+	ErrDecrypt = errors.New("failed to decrypt the record; the key may be wrong")
+)
+
+// Cipher encrypts and decrypts the records.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// New returns the cipher of the key, which must be KeySize bytes long.
+func New(key []byte) (*Cipher, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("the encryption key must be %d bytes, got %d", KeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+// Seal returns the record of the plaintext, without the newline.
+func (c *Cipher) Seal(plaintext []byte) []byte {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		// The system's random source never fails on the supported platforms.
+		panic(fmt.Sprintf("failed to generate a nonce: %v", err))
+	}
+	sealed := c.aead.Seal(nonce, nonce, plaintext, nil)
+	ret := make([]byte, len(recordPrefix)+base64.StdEncoding.EncodedLen(len(sealed)))
+	copy(ret, recordPrefix)
+	base64.StdEncoding.Encode(ret[len(recordPrefix):], sealed)
+	return ret
+}
+
+// Open returns the plaintext of the record.
+func (c *Cipher) Open(record []byte) ([]byte, error) {
+	data := bytes.TrimPrefix(record, recordPrefix)
+	sealed := make([]byte, base64.StdEncoding.DecodedLen(len(data)))
+	n, err := base64.StdEncoding.Decode(sealed, data)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	sealed = sealed[:n]
+	size := c.aead.NonceSize()
+	if len(sealed) < size {
+		return nil, ErrDecrypt
+	}
+	plaintext, err := c.aead.Open(nil, sealed[:size], sealed[size:], nil)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
+
+// IsEncrypted returns whether the line is an encrypted record.
+func IsEncrypted(line []byte) bool {
+	return bytes.HasPrefix(line, recordPrefix)
+}
+
+var (
+	defaultMu     sync.RWMutex
+	defaultCipher *Cipher
+)
+
+// SetDefault sets the cipher used by the files of the process. The files are
+// written in plaintext if it's nil.
+func SetDefault(c *Cipher) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultCipher = c
+}
+
+// Default returns the cipher used by the files of the process, or nil if
+// the encryption is disabled.
+func Default() *Cipher {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+	return defaultCipher
+}
+
+// SealLine returns the line to write for the plaintext line: its record if
+// the encryption is enabled, or the line itself.
+func SealLine(line []byte) []byte {
+	if c := Default(); c != nil {
+		return c.Seal(line)
+	}
+	return line
+}
+
+// OpenLine returns the plaintext of the line read from a file. The lines
+// that aren't encrypted are returned as they are.
+func OpenLine(line []byte) ([]byte, error) {
+	if !IsEncrypted(line) {
+		return line, nil
+	}
+	c := Default()
+	if c == nil {
+		return nil, ErrNoKey
+	}
+	return c.Open(line)
+}
+
+// NewWriter returns the writer encrypting the data written to w if the
+// encryption is enabled, or w itself. Each write becomes a record, so the
+// writes should be buffered.
+func NewWriter(w io.Writer) io.Writer {
+	c := Default()
+	if c == nil {
+		return w
+	}
+	return &writer{w: w, c: c}
+}
+
+type writer struct {
+	w io.Writer
+	c *Cipher
+}
+
+func (w *writer) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	record := append(w.c.Seal(p), '\n')
+	// The record is written at once so that the readers see either the
+	// whole record or a partial last line.
+	if _, err := w.w.Write(record); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// NewReader returns the reader of the plaintext of the file read from r.
+func NewReader(r io.Reader) io.Reader {
+	return &reader{r: bufio.NewReader(r)}
+}
+
+type reader struct {
+	r   *bufio.Reader
+	buf []byte
+	err error
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.fill()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// fill reads the next line into the buffer.
+func (r *reader) fill() {
+	line, err := r.r.ReadBytes('\n')
+	if err != nil {
+		r.err = err
+	}
+	if !IsEncrypted(line) {
+		r.buf = line
+		return
+	}
+	complete := bytes.HasSuffix(line, []byte("\n"))
+	plaintext, openErr := OpenLine(bytes.TrimSuffix(line, []byte("\n")))
+	switch {
+	case openErr == nil:
+		r.buf = plaintext
+	case !complete && !errors.Is(openErr, ErrNoKey):
+		// The last record is still being written.
+		r.err = io.EOF
+	default:
+		r.err = openErr
+	}
+}
+
+// ReadFile reads the plaintext of the file.
+func ReadFile(name string) ([]byte, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return io.ReadAll(NewReader(f))
+}
+
+// ParseKey decodes the base64 key, e.g. the output of
+// "openssl rand -base64 32".
+func ParseKey(s string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, fmt.Errorf("the encryption key must be base64 encoded: %w", err)
+	}
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("the encryption key must be %d bytes, got %d", KeySize, len(key))
+	}
+	return key, nil
+}
+
+// LoadKey returns the key given either as it is, in a file, or as the output
+// of a command such as the one decrypting a data key with a KMS. It returns
+// nil if none of them is set.
+func LoadKey(ctx context.Context, key, keyFile, keyCommand string) ([]byte, error) {
+	switch {
+	case key != "":
+		return ParseKey(key)
+	case keyFile != "":
+		data, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the encryption key file: %w", err)
+		}
+		return ParseKey(string(data))
+	case keyCommand != "":
+		cmd := exec.CommandContext(ctx, "sh", "-c", keyCommand)
+		cmd.Stderr = os.Stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("failed to run the encryption key command: %w", err)
+		}
+		return ParseKey(string(out))
+	}
+	return nil, nil
+}
@@ -0,0 +1,96 @@
+package crypt
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testCipher(t *testing.T, b byte) *Cipher {
+	t.Helper()
+	c, err := New(bytes.Repeat([]byte{b}, KeySize))
+	require.NoError(t, err)
+	return c
+}
+
+func TestWriterAndReader(t *testing.T) {
+	c := testCipher(t, 1)
+	SetDefault(c)
+	defer SetDefault(nil)
+
+	var buf bytes.Buffer
+	buf.WriteString("written before the encryption\n")
+	w := bufio.NewWriterSize(NewWriter(&buf), 16)
+	_, err := w.WriteString("first line\nsecond line\nthe third one is long enough to span records\n")
+	require.NoError(t, err)
+	require.NoError(t, w.Flush())
+	require.NotContains(t, buf.String(), "second line")
+
+	read := func(data []byte) (string, error) {
+		ret, err := io.ReadAll(NewReader(bytes.NewReader(data)))
+		return string(ret), err
+	}
+
+	t.Run("Read", func(t *testing.T) {
+		got, err := read(buf.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, "written before the encryption\nfirst line\nsecond line\nthe third one is long enough to span records\n", got)
+	})
+	t.Run("PartialRecord", func(t *testing.T) {
+		data := append(bytes.Clone(buf.Bytes()), c.Seal([]byte("being written"))[:20]...)
+		got, err := read(data)
+		require.NoError(t, err)
+		require.Contains(t, got, "the third one")
+	})
+	t.Run("WrongKey", func(t *testing.T) {
+		SetDefault(testCipher(t, 2))
+		defer SetDefault(c)
+		_, err := read(buf.Bytes())
+		require.ErrorIs(t, err, ErrDecrypt)
+	})
+	t.Run("NoKey", func(t *testing.T) {
+		SetDefault(nil)
+		defer SetDefault(c)
+		_, err := read(buf.Bytes())
+		require.ErrorIs(t, err, ErrNoKey)
+
+		// The files are written in plaintext.
+		var plain bytes.Buffer
+		_, err = NewWriter(&plain).Write([]byte("plaintext\n"))
+		require.NoError(t, err)
+		require.Equal(t, "plaintext\n", plain.String())
+	})
+}
+
+func TestLoadKey(t *testing.T) {
+	ctx := context.Background()
+	encoded := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, KeySize))
+
+	key, err := LoadKey(ctx, "", "", "")
+	require.NoError(t, err)
+	require.Nil(t, key)
+
+	key, err = LoadKey(ctx, encoded, "", "")
+	require.NoError(t, err)
+	require.Len(t, key, KeySize)
+
+	file := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(file, []byte(encoded+"\n"), 0600))
+	key, err = LoadKey(ctx, "", file, "")
+	require.NoError(t, err)
+	require.Len(t, key, KeySize)
+
+	key, err = LoadKey(ctx, "", "", "echo "+encoded)
+	require.NoError(t, err)
+	require.Len(t, key, KeySize)
+
+	_, err = LoadKey(ctx, base64.StdEncoding.EncodeToString([]byte("short")), "", "")
+	require.Error(t, err)
+}
@@ -40,8 +40,8 @@ func assertStepDef(def stepDef, funcs []*funcDef) error {
 
 	// TODO: Validate executor config for each executor type.
 
-	if def.Command == nil {
-		if def.Executor == nil && def.Script == "" && def.Call == nil && def.Run == "" {
+	if def.Command == nil && def.CommandList == nil {
+		if def.Executor == nil && def.Script == "" && def.Call == nil && def.Run == "" && def.HTTP == nil {
 			return errStepCommandIsRequired
 		}
 	}
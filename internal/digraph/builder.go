@@ -3,12 +3,19 @@ package digraph
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/dagu-org/dagu/internal/cmdutil"
+	"github.com/dagu-org/dagu/internal/digraph/hook"
 	"github.com/dagu-org/dagu/internal/fileutil"
+	"github.com/dagu-org/dagu/internal/mailer"
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/joho/godotenv"
 	"golang.org/x/sys/unix"
@@ -38,8 +45,9 @@ func (c BuildContext) WithFile(file string) BuildContext {
 
 // buildOpts is used to control the behavior of the builder.
 type buildOpts struct {
-	// base specifies the base configuration file for the DAG.
-	base string
+	// bases specifies the base configuration files for the DAG in the order
+	// they are applied.
+	bases []string
 	// onlyMetadata specifies whether to build only the metadata.
 	onlyMetadata bool
 	// parameters specifies the parameters to the DAG.
@@ -58,15 +66,25 @@ var builderRegistry = []builderEntry{
 	{metadata: true, name: "params", fn: buildParams},
 	{name: "dotenv", fn: buildDotenv},
 	{name: "mailOn", fn: buildMailOn},
+	{name: "notify", fn: buildNotify},
+	{name: "callbacks", fn: buildCallbacks},
+	{name: "hooks", fn: buildHooks},
+	{name: "container", fn: buildContainer},
+	{name: "services", fn: buildServices},
 	{name: "steps", fn: buildSteps},
 	{name: "logDir", fn: buildLogDir},
 	{name: "handlers", fn: buildHandlers},
+	{name: "stages", fn: buildStages},
 	{name: "smtpConfig", fn: buildSMTPConfig},
 	{name: "errMailConfig", fn: buildErrMailConfig},
 	{name: "infoMailConfig", fn: buildInfoMailConfig},
 	{name: "maxHistoryRetentionDays", fn: maxHistoryRetentionDays},
 	{name: "maxCleanUpTime", fn: maxCleanUpTime},
-	{name: "preconditions", fn: buildPrecondition},
+	{name: "maxFailedSteps", fn: maxFailedSteps},
+	{name: "maxRunDuration", fn: maxRunDuration},
+	// The preconditions are in the metadata to tell the scheduled runs out of
+	// their time window or weekdays.
+	{metadata: true, name: "preconditions", fn: buildPrecondition},
 }
 
 type builderEntry struct {
@@ -80,11 +98,20 @@ var stepBuilderRegistry = []stepBuilderEntry{
 	{name: "command", fn: buildCommand},
 	{name: "depends", fn: buildDepends},
 	{name: "subworkflow", fn: buildSubWorkflow},
+	{name: "http", fn: buildHTTP},
 	{name: "continueOn", fn: buildContinueOn},
 	{name: "retryPolicy", fn: buildRetryPolicy},
 	{name: "repeatPolicy", fn: buildRepeatPolicy},
 	{name: "signalOnStop", fn: buildSignalOnStop},
+	{name: "timeout", fn: buildStepTimeout},
 	{name: "precondition", fn: buildStepPrecondition},
+	{name: "postconditions", fn: buildStepPostconditions},
+	{name: "artifacts", fn: buildArtifacts},
+	{name: "cache", fn: buildCache},
+	{name: "stdin", fn: buildStdin},
+	{name: "foreach", fn: buildForeach},
+	{name: "parallel", fn: buildParallel},
+	{name: "service", fn: buildService},
 }
 
 type stepBuilderEntry struct {
@@ -107,8 +134,13 @@ func build(ctx BuildContext, spec *definition) (*DAG, error) {
 		RestartWait:   time.Second * time.Duration(spec.RestartWaitSec),
 		Tags:          parseTags(spec.Tags),
 		MaxActiveRuns: spec.MaxActiveRuns,
+
+		SubstitutionTimeout: time.Second * time.Duration(spec.SubstitutionTimeoutSec),
 	}
 
+	// The commands in backticks are run only once while the DAG is built.
+	ctx.ctx = cmdutil.WithSubstitution(ctx.ctx, dag.SubstitutionTimeout)
+
 	var errs errorList
 	for _, builder := range builderRegistry {
 		if !builder.metadata && ctx.opts.onlyMetadata {
@@ -272,9 +304,19 @@ func buildDotenv(ctx BuildContext, spec *definition, dag *DAG) error {
 			if err != nil {
 				continue
 			}
-			if err := godotenv.Load(resolvedPath); err != nil {
+			vars, err := godotenv.Read(resolvedPath)
+			if err != nil {
 				return wrapError("dotenv", filePath, fmt.Errorf("failed to load dotenv file %s: %w", filePath, err))
 			}
+			for k, v := range vars {
+				// The variables already set aren't overridden.
+				if _, ok := os.LookupEnv(k); !ok {
+					if err := os.Setenv(k, v); err != nil {
+						return wrapError("dotenv", filePath, err)
+					}
+				}
+				dag.dotenvKeys = append(dag.dotenvKeys, k)
+			}
 			// Break after the first successful load.
 			break
 		}
@@ -294,6 +336,150 @@ func buildMailOn(_ BuildContext, spec *definition, dag *DAG) error {
 	return nil
 }
 
+// buildNotify parses the notifiers of the result of the run.
+func buildNotify(_ BuildContext, spec *definition, dag *DAG) error {
+	for _, def := range spec.Notify {
+		typ, _ := def["type"].(string)
+		if typ == "" {
+			return wrapError("notify.type", def["type"], errNotifyTypeRequired)
+		}
+
+		onDef, ok := def["on"]
+		if !ok {
+			// YAML 1.1 parses the unquoted key "on" as a boolean.
+			onDef = def[true]
+		}
+		var on []string
+		switch v := onDef.(type) {
+		case nil:
+			on = []string{NotifyOnFailure}
+		case string:
+			on = []string{v}
+		case []any:
+			for _, vv := range v {
+				s, ok := vv.(string)
+				if !ok {
+					return wrapError("notify.on", vv, errInvalidNotifyOn)
+				}
+				on = append(on, s)
+			}
+		default:
+			return wrapError("notify.on", v, errInvalidNotifyOn)
+		}
+		for _, s := range on {
+			switch s {
+			case NotifyOnSuccess, NotifyOnFailure, NotifyOnCancel:
+			default:
+				return wrapError("notify.on", s, errInvalidNotifyOn)
+			}
+		}
+
+		var config map[string]any
+		if v, ok := def["config"]; ok && v != nil {
+			m, ok := v.(map[any]any)
+			if !ok {
+				return wrapError("notify.config", v, errNotifyConfigMustBeMap)
+			}
+			config = make(map[string]any, len(m))
+			for k, vv := range m {
+				key, err := parseKey(k)
+				if err != nil {
+					return wrapError("notify.config", k, err)
+				}
+				config[key] = vv
+			}
+			if err := convertMap(config); err != nil {
+				return wrapError("notify.config", v, err)
+			}
+		}
+		dag.Notify = append(dag.Notify, Notify{
+			Type:   typ,
+			On:     on,
+			Config: config,
+		})
+	}
+	return nil
+}
+
+// Default retry policy of the callbacks.
+const (
+	defaultCallbackRetries       = 3
+	defaultCallbackRetryInterval = 5 * time.Second
+)
+
+// buildCallbacks parses the webhooks called when the run completes.
+func buildCallbacks(_ BuildContext, spec *definition, dag *DAG) error {
+	for _, def := range spec.Callbacks {
+		if def.URL == "" {
+			return wrapError("callbacks.url", def.URL, errCallbackURLRequired)
+		}
+
+		events := def.Events
+		if len(events) == 0 {
+			events = []string{NotifyOnSuccess, NotifyOnFailure, NotifyOnCancel}
+		}
+		for _, e := range events {
+			switch e {
+			case NotifyOnSuccess, NotifyOnFailure, NotifyOnCancel:
+			default:
+				return wrapError("callbacks.events", e, errInvalidCallbackEvent)
+			}
+		}
+
+		retries := defaultCallbackRetries
+		if def.Retries != nil {
+			retries = *def.Retries
+		}
+		interval := defaultCallbackRetryInterval
+		if def.RetryIntervalSec != 0 {
+			interval = time.Duration(def.RetryIntervalSec) * time.Second
+		}
+		if retries < 0 || interval < 0 {
+			return wrapError("callbacks.retries", retries, errInvalidCallbackRetries)
+		}
+
+		dag.Callbacks = append(dag.Callbacks, Callback{
+			URL:           def.URL,
+			Method:        def.Method,
+			Headers:       def.Headers,
+			Payload:       def.Payload,
+			Events:        events,
+			Retries:       retries,
+			RetryInterval: interval,
+		})
+	}
+	return nil
+}
+
+// buildHooks parses the scripts run at the hook points of the run.
+func buildHooks(_ BuildContext, spec *definition, dag *DAG) error {
+	if spec.Hooks == nil {
+		return nil
+	}
+	hooks := &Hooks{
+		BeforeRun:  spec.Hooks.BeforeRun,
+		BeforeStep: spec.Hooks.BeforeStep,
+		AfterStep:  spec.Hooks.AfterStep,
+	}
+	for _, h := range []struct {
+		point  hook.Point
+		script string
+	}{
+		{hook.BeforeRun, hooks.BeforeRun},
+		{hook.BeforeStep, hooks.BeforeStep},
+		{hook.AfterStep, hooks.AfterStep},
+	} {
+		if h.script == "" {
+			continue
+		}
+		if err := hook.Check(h.point, h.script); err != nil {
+			return wrapError("hooks."+string(h.point), nil, fmt.Errorf("%w: %s", errInvalidHook, err))
+		}
+	}
+	dag.Hooks = hooks
+	return nil
+}
+
 // buildEnvs builds the environment variables for the DAG.
 // Case 1: env is an array of maps with string keys and string values.
 // Case 2: env is a map with string keys and string values.
@@ -317,8 +503,8 @@ func buildLogDir(_ BuildContext, spec *definition, dag *DAG) (err error) {
 }
 
 // buildHandlers builds the handlers for the DAG.
-// The handlers are executed when the DAG is stopped, succeeded, failed, or
-// cancelled.
+// The handlers are executed when the DAG is stopped, succeeded, failed,
+// cancelled, or timed out.
 func buildHandlers(ctx BuildContext, spec *definition, dag *DAG) (err error) {
 	if spec.HandlerOn.Exit != nil {
 		spec.HandlerOn.Exit.Name = HandlerOnExit.String()
@@ -348,6 +534,62 @@ func buildHandlers(ctx BuildContext, spec *definition, dag *DAG) (err error) {
 		}
 	}
 
+	if spec.HandlerOn.Timeout != nil {
+		spec.HandlerOn.Timeout.Name = HandlerOnTimeout.String()
+		if dag.HandlerOn.Timeout, err = buildStep(ctx, *spec.HandlerOn.Timeout, spec.Functions); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// buildStages builds the stages of the DAG. The stages referred by the steps
+// without declaration in the stages field are added in the order of the steps.
+func buildStages(ctx BuildContext, spec *definition, dag *DAG) error {
+	defined := make(map[string]bool)
+	for _, def := range spec.Stages {
+		if def.Name == "" {
+			return wrapError("stages", def, errStageNameRequired)
+		}
+		if defined[def.Name] {
+			return wrapError("stages", def.Name, errDuplicateStage)
+		}
+		defined[def.Name] = true
+
+		stage := Stage{Name: def.Name}
+		handlers := []struct {
+			typ  HandlerType
+			def  *stepDef
+			step **Step
+		}{
+			{HandlerOnExit, def.HandlerOn.Exit, &stage.HandlerOn.Exit},
+			{HandlerOnSuccess, def.HandlerOn.Success, &stage.HandlerOn.Success},
+			{HandlerOnFailure, def.HandlerOn.Failure, &stage.HandlerOn.Failure},
+			{HandlerOnCancel, def.HandlerOn.Cancel, &stage.HandlerOn.Cancel},
+		}
+		for _, h := range handlers {
+			if h.def == nil {
+				continue
+			}
+			h.def.Name = fmt.Sprintf("%s.%s", def.Name, h.typ)
+			step, err := buildStep(ctx, *h.def, spec.Functions)
+			if err != nil {
+				return err
+			}
+			*h.step = step
+		}
+		dag.Stages = append(dag.Stages, stage)
+	}
+
+	for _, step := range dag.Steps {
+		if step.Stage == "" || defined[step.Stage] {
+			continue
+		}
+		defined[step.Stage] = true
+		dag.Stages = append(dag.Stages, Stage{Name: step.Stage})
+	}
+
 	return nil
 }
 
@@ -403,6 +645,86 @@ func parsePrecondition(ctx BuildContext, precondition any) ([]Condition, error)
 					return nil, wrapError("preconditions", vv, errPreconditionValueMustBeString)
 				}
 
+			case "expr":
+				ret.Expr, ok = vv.(string)
+				if !ok {
+					return nil, wrapError("preconditions", vv, errPreconditionValueMustBeString)
+				}
+
+			case "allof", "anyof":
+				if _, ok := vv.([]any); !ok {
+					return nil, wrapError("preconditions", vv, errPreconditionGroupMustBeArray)
+				}
+				conds, err := parsePrecondition(ctx, vv)
+				if err != nil {
+					return nil, err
+				}
+				if strings.ToLower(key) == "allof" {
+					ret.AllOf = conds
+				} else {
+					ret.AnyOf = conds
+				}
+
+			case "not":
+				conds, err := parsePrecondition(ctx, vv)
+				if err != nil {
+					return nil, err
+				}
+				switch len(conds) {
+				case 0:
+					return nil, wrapError("preconditions", vv, errPreconditionNotIsEmpty)
+				case 1:
+					ret.Not = &conds[0]
+				default:
+					// Negate the conjunction of the conditions.
+					ret.Not = &Condition{AllOf: conds}
+				}
+
+			case "fileexists":
+				ret.FileExists, ok = vv.(string)
+				if !ok {
+					return nil, wrapError("preconditions", vv, errPreconditionValueMustBeString)
+				}
+
+			case "filenotempty":
+				ret.FileNotEmpty, ok = vv.(string)
+				if !ok {
+					return nil, wrapError("preconditions", vv, errPreconditionValueMustBeString)
+				}
+
+			case "http":
+				ret.HTTP, ok = vv.(string)
+				if !ok {
+					return nil, wrapError("preconditions", vv, errPreconditionValueMustBeString)
+				}
+
+			case "timewindow":
+				ret.TimeWindow, ok = vv.(string)
+				if !ok {
+					return nil, wrapError("preconditions", vv, errPreconditionValueMustBeString)
+				}
+
+			case "weekdays":
+				switch days := vv.(type) {
+				case string:
+					for _, day := range strings.Split(days, ",") {
+						ret.Weekdays = append(ret.Weekdays, strings.TrimSpace(day))
+					}
+
+				case []any:
+					for _, day := range days {
+						d, ok := day.(string)
+						if !ok {
+							return nil, wrapError("preconditions", day, errPreconditionWeekdaysMustBeArray)
+						}
+						ret.Weekdays = append(ret.Weekdays, d)
+					}
+
+				default:
+					return nil, wrapError("preconditions", vv, errPreconditionWeekdaysMustBeArray)
+
+				}
+
 			default:
 				return nil, wrapError("preconditions", k, fmt.Errorf("%w: %s", errPreconditionHasInvalidKey, key))
 
@@ -439,6 +761,69 @@ func maxCleanUpTime(_ BuildContext, spec *definition, dag *DAG) error {
 	return nil
 }
 
+// maxFailedSteps parses the failure threshold of the DAG.
+// It can be a number of steps (e.g. 3) or a percentage (e.g. "10%").
+func maxFailedSteps(_ BuildContext, spec *definition, dag *DAG) error {
+	switch v := spec.MaxFailedSteps.(type) {
+	case nil:
+		return nil
+
+	case int:
+		if v < 0 {
+			return wrapError("maxFailedSteps", v, errInvalidMaxFailedSteps)
+		}
+		dag.MaxFailedSteps = v
+
+	case string:
+		s := strings.TrimSpace(v)
+		if percent, ok := strings.CutSuffix(s, "%"); ok {
+			n, err := strconv.Atoi(strings.TrimSpace(percent))
+			if err != nil || n < 0 || n > 100 {
+				return wrapError("maxFailedSteps", v, errInvalidMaxFailedSteps)
+			}
+			dag.MaxFailedStepsPercent = n
+			return nil
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			return wrapError("maxFailedSteps", v, errInvalidMaxFailedSteps)
+		}
+		dag.MaxFailedSteps = n
+
+	default:
+		return wrapError("maxFailedSteps", v, errInvalidMaxFailedSteps)
+
+	}
+	return nil
+}
+
+// maxRunDuration parses the hard deadline of the run.
+// It can be a duration (e.g. "2h") or a number of seconds.
+func maxRunDuration(_ BuildContext, spec *definition, dag *DAG) error {
+	switch v := spec.MaxRunDuration.(type) {
+	case nil:
+		return nil
+
+	case int:
+		if v < 0 {
+			return wrapError("maxRunDuration", v, errInvalidMaxRunDuration)
+		}
+		dag.MaxRunDuration = time.Second * time.Duration(v)
+
+	case string:
+		d, err := time.ParseDuration(strings.TrimSpace(v))
+		if err != nil || d < 0 {
+			return wrapError("maxRunDuration", v, errInvalidMaxRunDuration)
+		}
+		dag.MaxRunDuration = d
+
+	default:
+		return wrapError("maxRunDuration", v, errInvalidMaxRunDuration)
+
+	}
+	return nil
+}
+
 func maxHistoryRetentionDays(_ BuildContext, spec *definition, dag *DAG) error {
 	if spec.HistRetentionDays != nil {
 		dag.HistRetentionDays = *spec.HistRetentionDays
@@ -474,6 +859,10 @@ func buildSteps(ctx BuildContext, spec *definition, dag *DAG) error {
 			}
 			dag.Steps = append(dag.Steps, *step)
 		}
+		if err := applyStepGroups(spec, dag.Steps); err != nil {
+			return err
+		}
+		dag.Steps = expandForeachSteps(dag.Steps)
 
 		return nil
 
@@ -494,6 +883,10 @@ func buildSteps(ctx BuildContext, spec *definition, dag *DAG) error {
 			}
 			dag.Steps = append(dag.Steps, *step)
 		}
+		if err := applyStepGroups(spec, dag.Steps); err != nil {
+			return err
+		}
+		dag.Steps = expandForeachSteps(dag.Steps)
 
 		return nil
 
@@ -503,13 +896,130 @@ func buildSteps(ctx BuildContext, spec *definition, dag *DAG) error {
 	}
 }
 
+// applyStepGroups sets the parallelism limit of the step groups to the steps
+// in the groups.
+func applyStepGroups(spec *definition, steps []Step) error {
+	for name, group := range spec.StepGroups {
+		if group.MaxParallel < 0 {
+			return wrapError("stepGroups", name, errStepGroupMaxParallelMustBePositive)
+		}
+	}
+	for i := range steps {
+		if steps[i].ParallelGroup == "" {
+			continue
+		}
+		group, ok := spec.StepGroups[steps[i].ParallelGroup]
+		if !ok {
+			return wrapError("group", steps[i].ParallelGroup, errStepGroupNotFound)
+		}
+		steps[i].MaxParallel = group.MaxParallel
+	}
+	return nil
+}
+
+// buildContainer builds the container shared by the docker steps.
+func buildContainer(_ BuildContext, spec *definition, dag *DAG) error {
+	if spec.Container == nil {
+		return nil
+	}
+	if spec.Container.Image == "" {
+		return wrapError("container.image", nil, errContainerImageRequired)
+	}
+	pull := true
+	if spec.Container.Pull != nil {
+		pull = *spec.Container.Pull
+	}
+	dag.Container = &Container{
+		Image:      spec.Container.Image,
+		Pull:       pull,
+		Env:        spec.Container.Env,
+		Volumes:    spec.Container.Volumes,
+		WorkingDir: spec.Container.WorkingDir,
+		User:       spec.Container.User,
+	}
+	return nil
+}
+
+// serviceNamePattern is the pattern of the service names. The names are
+// used as the prefix of the environment variables.
+var serviceNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+
+// servicePortPattern is the pattern of the ports of the services.
+var servicePortPattern = regexp.MustCompile(`^[0-9]{1,5}(/(tcp|udp|sctp))?$`)
+
+// buildServices builds the auxiliary containers run during the run.
+func buildServices(_ BuildContext, spec *definition, dag *DAG) error {
+	names := make(map[string]bool)
+	for _, def := range spec.Services {
+		if !serviceNamePattern.MatchString(def.Name) {
+			return wrapError("services.name", def.Name, errInvalidServiceName)
+		}
+		if names[def.Name] {
+			return wrapError("services.name", def.Name, errDuplicateService)
+		}
+		names[def.Name] = true
+		if def.Image == "" {
+			return wrapError("services.image", def.Name, errServiceImageRequired)
+		}
+
+		var ports []string
+		for _, p := range def.Ports {
+			port := fmt.Sprint(p)
+			if !servicePortPattern.MatchString(port) {
+				return wrapError("services.ports", p, errInvalidServicePort)
+			}
+			ports = append(ports, port)
+		}
+
+		pull := true
+		if def.Pull != nil {
+			pull = *def.Pull
+		}
+		dag.Services = append(dag.Services, Service{
+			Name:    def.Name,
+			Image:   def.Image,
+			Pull:    pull,
+			Env:     def.Env,
+			Command: def.Command,
+			Ports:   ports,
+		})
+	}
+	return nil
+}
+
 // buildSMTPConfig builds the SMTP configuration for the DAG.
 func buildSMTPConfig(_ BuildContext, spec *definition, dag *DAG) (err error) {
+	switch spec.SMTP.TLS {
+	case "", mailer.TLSAuto, mailer.TLSStartTLS, mailer.TLSImplicit, mailer.TLSNone:
+	default:
+		return wrapError("smtp.tls", spec.SMTP.TLS, errInvalidSMTPTLS)
+	}
+	switch spec.SMTP.Auth {
+	case "", mailer.AuthPlain, mailer.AuthLogin, mailer.AuthXOAuth2:
+	default:
+		return wrapError("smtp.auth", spec.SMTP.Auth, errInvalidSMTPAuth)
+	}
+	if spec.SMTP.TimeoutSec < 0 {
+		return wrapError("smtp.timeoutSec", spec.SMTP.TimeoutSec, errInvalidSMTPTimeout)
+	}
+
 	dag.SMTP = &SMTPConfig{
 		Host:     spec.SMTP.Host,
 		Port:     spec.SMTP.Port,
 		Username: spec.SMTP.Username,
 		Password: spec.SMTP.Password,
+		TLS:      spec.SMTP.TLS,
+		Auth:     spec.SMTP.Auth,
+		Timeout:  time.Duration(spec.SMTP.TimeoutSec) * time.Second,
+	}
+	if o := spec.SMTP.OAuth2; o != nil {
+		dag.SMTP.OAuth2 = &SMTPOAuth2{
+			AccessToken:  o.AccessToken,
+			TokenURL:     o.TokenURL,
+			ClientID:     o.ClientID,
+			ClientSecret: o.ClientSecret,
+			RefreshToken: o.RefreshToken,
+		}
 	}
 
 	return nil
@@ -554,6 +1064,9 @@ func buildStep(ctx BuildContext, def stepDef, fns []*funcDef) (*Step, error) {
 		Stderr:         def.Stderr,
 		Output:         def.Output,
 		Dir:            def.Dir,
+		Stage:          def.Stage,
+		ParallelGroup:  def.Group,
+		Lock:           strings.TrimSpace(def.Lock),
 		MailOnError:    def.MailOnError,
 		ExecutorConfig: ExecutorConfig{Config: make(map[string]any)},
 	}
@@ -569,9 +1082,40 @@ func buildStep(ctx BuildContext, def stepDef, fns []*funcDef) (*Step, error) {
 		}
 	}
 
+	// The expand template is built separately as it's built by buildStep.
+	if err := buildExpand(ctx, def, step); err != nil {
+		return nil, fmt.Errorf("expand: %w", err)
+	}
+
 	return step, nil
 }
 
+// buildExpand builds the template of the steps generated at runtime from
+// the output of the step.
+func buildExpand(ctx BuildContext, def stepDef, step *Step) error {
+	if def.Expand == nil {
+		return nil
+	}
+	if step.Output == "" {
+		return wrapError("expand", def.Name, errExpandRequiresOutput)
+	}
+
+	tmplDef := *def.Expand
+	if tmplDef.Depends != nil {
+		return wrapError("expand.depends", tmplDef.Depends, errExpandDependsNotAllowed)
+	}
+	if tmplDef.Name == "" {
+		tmplDef.Name = def.Name
+	}
+	tmpl, err := buildStep(ctx, tmplDef, nil)
+	if err != nil {
+		return err
+	}
+	step.Expand = tmpl
+
+	return nil
+}
+
 func buildContinueOn(_ BuildContext, def stepDef, step *Step) error {
 	if def.ContinueOn == nil {
 		return nil
@@ -642,6 +1186,108 @@ func buildStepPrecondition(ctx BuildContext, def stepDef, step *Step) error {
 	return nil
 }
 
+// buildStepPostconditions parses the conditions checked after the step
+// finishes. It accepts the same forms as preconditions.
+func buildStepPostconditions(ctx BuildContext, def stepDef, step *Step) error {
+	conditions, err := parsePrecondition(ctx, def.Postconditions)
+	if err != nil {
+		return err
+	}
+	step.Postconditions = conditions
+	return nil
+}
+
+// buildArtifacts parses the artifacts definition of a step.
+// Both `produces` and `consumes` accept a string or an array of strings.
+func buildArtifacts(_ BuildContext, def stepDef, step *Step) error {
+	if def.Artifacts == nil {
+		return nil
+	}
+
+	produces, err := parseStringOrArray(def.Artifacts.Produces)
+	if err != nil {
+		return wrapError("artifacts.produces", def.Artifacts.Produces, errArtifactsMustBeStringOrArray)
+	}
+	consumes, err := parseStringOrArray(def.Artifacts.Consumes)
+	if err != nil {
+		return wrapError("artifacts.consumes", def.Artifacts.Consumes, errArtifactsMustBeStringOrArray)
+	}
+
+	// Artifacts are stored in a flat directory keyed by the file name, so
+	// the names must be unique within a step.
+	names := make(map[string]struct{}, len(produces))
+	for _, p := range produces {
+		name := filepath.Base(p)
+		if _, ok := names[name]; ok {
+			return wrapError("artifacts.produces", p, errDuplicateArtifact)
+		}
+		names[name] = struct{}{}
+	}
+	for _, c := range consumes {
+		if filepath.Base(c) != c {
+			return wrapError("artifacts.consumes", c, errInvalidArtifactName)
+		}
+	}
+
+	step.Artifacts = Artifacts{Produces: produces, Consumes: consumes}
+	return nil
+}
+
+// buildCache parses the cache definition of a step.
+func buildCache(_ BuildContext, def stepDef, step *Step) error {
+	if def.Cache == nil {
+		return nil
+	}
+
+	inputs, err := parseStringOrArray(def.Cache.Inputs)
+	if err != nil {
+		return wrapError("cache.inputs", def.Cache.Inputs, errCacheInputsMustBeStringOrArray)
+	}
+
+	step.Cache = &Cache{Inputs: inputs}
+	return nil
+}
+
+// buildStdin parses the stdin field of the step.
+// Case 1: stdin is a string passed to the command as it is (after the
+// variables are expanded)
+// Case 2: stdin is a map with the file the standard input is read from
+func buildStdin(_ BuildContext, def stepDef, step *Step) error {
+	switch v := def.Stdin.(type) {
+	case nil:
+		return nil
+
+	case string:
+		step.Stdin = v
+		return nil
+
+	case map[any]any:
+		if len(v) != 1 {
+			return wrapError("stdin", v, errStdinMustBeStringOrMap)
+		}
+		file, ok := v["file"].(string)
+		if !ok || strings.TrimSpace(file) == "" {
+			return wrapError("stdin", v, errStdinMustBeStringOrMap)
+		}
+		step.StdinFile = file
+		return nil
+
+	default:
+		return wrapError("stdin", v, errStdinMustBeStringOrMap)
+
+	}
+}
+
+// buildStepTimeout builds the time limit of the step.
+func buildStepTimeout(_ BuildContext, def stepDef, step *Step) error {
+	if def.TimeoutSec < 0 {
+		return wrapError("timeoutSec", def.TimeoutSec, errInvalidStepTimeout)
+	}
+	step.Timeout = time.Second * time.Duration(def.TimeoutSec)
+	step.CancelOnTimeout = def.CancelOnTimeout
+	return nil
+}
+
 func buildSignalOnStop(_ BuildContext, def stepDef, step *Step) error {
 	if def.SignalOnStop != nil {
 		sigDef := *def.SignalOnStop
@@ -661,13 +1307,18 @@ const commandRun = "run"
 
 // buildSubWorkflow parses the subworkflow definition and sets the step fields.
 func buildSubWorkflow(_ BuildContext, def stepDef, step *Step) error {
-	name, params := def.Run, def.Params
+	name := def.Run
 
 	// if the run field is not set, return nil.
 	if name == "" {
 		return nil
 	}
 
+	params, err := parseSubWorkflowParams(def.Params)
+	if err != nil {
+		return wrapError("params", def.Params, fmt.Errorf("%w: %s", errInvalidParamValue, err))
+	}
+
 	// Set the step fields for the subworkflow.
 	step.SubWorkflow = &SubWorkflow{Name: name, Params: params}
 	step.ExecutorConfig.Type = ExecutorTypeSubWorkflow
@@ -677,17 +1328,168 @@ func buildSubWorkflow(_ BuildContext, def stepDef, step *Step) error {
 	return nil
 }
 
+// buildHTTP builds the step that sends the HTTP request with the http
+// executor. The body is a template of the results of the run.
+func buildHTTP(_ BuildContext, def stepDef, step *Step) error {
+	if def.HTTP == nil {
+		return nil
+	}
+	if def.Command != nil || def.CommandList != nil || def.Executor != nil || def.Run != "" {
+		return wrapError("http", def.Name, errHTTPWithCommand)
+	}
+	if def.HTTP.URL == "" {
+		return wrapError("http.url", def.HTTP.URL, errHTTPURLRequired)
+	}
+
+	method := strings.ToUpper(def.HTTP.Method)
+	if method == "" {
+		method = "POST"
+	}
+	step.ExecutorConfig.Type = ExecutorTypeHTTP
+	step.ExecutorConfig.Config = map[string]any{
+		"headers":  def.HTTP.Headers,
+		"query":    def.HTTP.Query,
+		"body":     def.HTTP.Body,
+		"timeout":  def.HTTP.Timeout,
+		"template": true,
+	}
+	step.Command = method
+	step.Args = []string{def.HTTP.URL}
+	step.CmdWithArgs = method + " " + def.HTTP.URL
+	return nil
+}
+
+// parseSubWorkflowParams converts the parameters for a sub workflow into
+// the string form "KEY=value KEY2=value2". The values are not evaluated
+// here; they are evaluated when the sub workflow is called.
+func parseSubWorkflowParams(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+
+	case string:
+		return v, nil
+
+	case map[any]any:
+		values := make(map[string]string, len(v))
+		keys := make([]string, 0, len(v))
+		for key, value := range v {
+			name, ok := key.(string)
+			if !ok {
+				return "", fmt.Errorf("parameter name must be a string, got %T", key)
+			}
+			values[name] = fmt.Sprint(value)
+			keys = append(keys, name)
+		}
+		// Sort the keys to make the order of the parameters stable.
+		sort.Strings(keys)
+
+		var params []string
+		for _, key := range keys {
+			params = append(params, paramPair{Name: key, Value: values[key]}.Escaped())
+		}
+		return strings.Join(params, " "), nil
+
+	case []any:
+		// A list keeps the order of the parameters.
+		var params []string
+		for _, item := range v {
+			param, err := parseSubWorkflowParams(item)
+			if err != nil {
+				return "", err
+			}
+			params = append(params, param)
+		}
+		return strings.Join(params, " "), nil
+
+	default:
+		return "", fmt.Errorf("string or map expected, got %T", v)
+
+	}
+}
+
 const (
 	executorKeyType   = "type"
 	executorKeyConfig = "config"
 )
 
+// buildDepends parses the depends field in the step definition.
+// Each dependency is either a step name, a map with the step name and
+// the condition of the dependency, e.g. {step: load, on: failure}, or
+// a group of steps where any one of them needs to succeed, e.g.
+// {anyOf: [source1, source2]}.
 func buildDepends(_ BuildContext, def stepDef, step *Step) error {
-	deps, err := parseStringOrArray(def.Depends)
-	if err != nil {
+	var items []any
+	switch v := def.Depends.(type) {
+	case nil:
+		return nil
+
+	case string:
+		items = []any{v}
+
+	case []any:
+		items = v
+
+	default:
 		return wrapError("depends", def.Depends, errDependsMustBeStringOrArray)
+
+	}
+
+	for _, item := range items {
+		switch v := item.(type) {
+		case string:
+			step.Depends = append(step.Depends, v)
+
+		case map[any]any:
+			if anyOf, ok := v["anyOf"]; ok {
+				group, err := parseStringOrArray(anyOf)
+				if err != nil || len(group) == 0 {
+					return wrapError("depends", item, errDependsAnyOfMustBeArray)
+				}
+				for _, name := range group {
+					if !slices.Contains(step.Depends, name) {
+						step.Depends = append(step.Depends, name)
+					}
+				}
+				step.DependsAnyOf = append(step.DependsAnyOf, group)
+				continue
+			}
+
+			name, ok := v["step"].(string)
+			if !ok || name == "" {
+				return wrapError("depends", item, errDependsStepRequired)
+			}
+			step.Depends = append(step.Depends, name)
+
+			on, ok := v["on"]
+			if !ok {
+				// YAML 1.1 parses the unquoted key "on" as a boolean.
+				on, ok = v[true]
+			}
+			if !ok {
+				continue
+			}
+			onStr, _ := on.(string)
+			switch cond := DependencyCondition(onStr); cond {
+			case DependencyOnSuccess:
+				// Success is the default condition.
+
+			case DependencyOnFailure, DependencyOnAlways:
+				if step.DependsOn == nil {
+					step.DependsOn = make(map[string]DependencyCondition)
+				}
+				step.DependsOn[name] = cond
+
+			default:
+				return wrapError("depends", on, errInvalidDependsCondition)
+
+			}
+
+		default:
+			return wrapError("depends", item, errDependsMustBeStringOrArray)
+
+		}
 	}
-	step.Depends = deps
 
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -107,6 +108,18 @@ func TestBuildDAGError(t *testing.T) {
 	t.Run("InvalidSchedule", func(t *testing.T) {
 		loadTestYAMLError(t, "invalid_schedule.yaml", errInvalidSchedule)
 	})
+	t.Run("SubstitutionTimeout", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_substitution_timeout.yaml", errInvalidEnvValue)
+	})
+	t.Run("ContainerWithoutImage", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_container.yaml", errContainerImageRequired)
+	})
+	t.Run("DuplicateService", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_services.yaml", errDuplicateService)
+	})
+	t.Run("InvalidServicePort", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_service_port.yaml", errInvalidServicePort)
+	})
 }
 
 func TestBuildStepError(t *testing.T) {
@@ -114,6 +127,60 @@ func TestBuildStepError(t *testing.T) {
 	t.Run("NoCommand", func(t *testing.T) {
 		loadTestYAMLError(t, "invalid_no_command.yaml", errStepCommandIsRequired)
 	})
+	t.Run("CommandAndCommandList", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_command_list.yaml", errStepCommandAndCommandList)
+	})
+	t.Run("UnterminatedQuote", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_command_quote.yaml", cmdutil.ErrUnterminatedQuote)
+	})
+	t.Run("InvalidArtifacts", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_artifacts.yaml", errInvalidArtifactName)
+	})
+	t.Run("InvalidMaxFailedSteps", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_max_failed_steps.yaml", errInvalidMaxFailedSteps)
+	})
+	t.Run("InvalidMaxRunDuration", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_max_run_duration.yaml", errInvalidMaxRunDuration)
+	})
+	t.Run("InvalidNotify", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_notify.yaml", errInvalidNotifyOn)
+	})
+	t.Run("InvalidHTTP", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_http.yaml", errHTTPWithCommand)
+	})
+	t.Run("InvalidCallbacks", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_callbacks.yaml", errInvalidCallbackEvent)
+	})
+	t.Run("InvalidHooks", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_hooks.yaml", errInvalidHook)
+	})
+	t.Run("InvalidExpand", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_expand.yaml", errExpandRequiresOutput)
+	})
+	t.Run("InvalidForeach", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_foreach.yaml", errInvalidForeach)
+	})
+	t.Run("InvalidParallel", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_parallel.yaml", errParallelWithForeach)
+	})
+	t.Run("InvalidService", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_service.yaml", errServiceWithPolicy)
+	})
+	t.Run("InvalidStdin", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_stdin.yaml", errStdinMustBeStringOrMap)
+	})
+	t.Run("InvalidStepTimeout", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_step_timeout.yaml", errInvalidStepTimeout)
+	})
+	t.Run("InvalidStepGroup", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_step_group.yaml", errStepGroupNotFound)
+	})
+	t.Run("DuplicateStage", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_duplicate_stage.yaml", errDuplicateStage)
+	})
+	t.Run("InvalidDepends", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_depends.yaml", errInvalidDependsCondition)
+	})
 }
 
 func TestBuildDAG(t *testing.T) {
@@ -134,6 +201,23 @@ func TestBuildDAG(t *testing.T) {
 		}
 		assert.True(t, found, "expected env key not found")
 	})
+	t.Run("SubstitutionMemoized", func(t *testing.T) {
+		th := loadTestYAML(t, "substitution.yaml")
+		assert.Equal(t, 5*time.Second, th.SubstitutionTimeout)
+		require.Len(t, th.Env, 2)
+		// The order of the variables in Env is not fixed.
+		var first, second string
+		for _, env := range th.Env {
+			if v, ok := strings.CutPrefix(env, "FIRST="); ok {
+				first = v
+			}
+			if v, ok := strings.CutPrefix(env, "SECOND="); ok {
+				second = v
+			}
+		}
+		assert.NotEmpty(t, first)
+		assert.Equal(t, first, second)
+	})
 	t.Run("ValidEnvWithSubstitutionAndEnv", func(t *testing.T) {
 		th := loadTestYAML(t, "valid_env_substitution_and_env.yaml")
 		// find the env key in the map
@@ -226,6 +310,29 @@ func TestBuildDAG(t *testing.T) {
 		assert.True(t, th.MailOn.Failure)
 		assert.True(t, th.MailOn.Success)
 	})
+	t.Run("SMTPConfig", func(t *testing.T) {
+		th := loadTestYAML(t, "smtp_config.yaml")
+		require.Equal(t, &SMTPConfig{
+			Host:     "smtp.office365.com",
+			Port:     "587",
+			Username: "user@example.com",
+			TLS:      "starttls",
+			Auth:     "xoauth2",
+			OAuth2: &SMTPOAuth2{
+				TokenURL:     "https://login.microsoftonline.com/common/oauth2/v2.0/token",
+				ClientID:     "client",
+				ClientSecret: "secret",
+				RefreshToken: "refresh",
+			},
+			Timeout: 10 * time.Second,
+		}, th.SMTP)
+	})
+	t.Run("InvalidSMTPTLS", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_smtp_tls.yaml", errInvalidSMTPTLS)
+	})
+	t.Run("InvalidSMTPAuth", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_smtp_auth.yaml", errInvalidSMTPAuth)
+	})
 	t.Run("ValidTags", func(t *testing.T) {
 		th := loadTestYAML(t, "valid_tags.yaml")
 		assert.True(t, th.HasTag("daily"))
@@ -265,11 +372,140 @@ func TestBuildDAG(t *testing.T) {
 		th := loadTestYAML(t, "max_cleanup_time.yaml")
 		assert.Equal(t, time.Duration(10*time.Second), th.MaxCleanUpTime)
 	})
+	t.Run("MaxFailedSteps", func(t *testing.T) {
+		th := loadTestYAML(t, "max_failed_steps.yaml")
+		assert.Equal(t, 3, th.MaxFailedSteps)
+		assert.Equal(t, 0, th.MaxFailedStepsPercent)
+	})
+	t.Run("MaxFailedStepsPercent", func(t *testing.T) {
+		th := loadTestYAML(t, "max_failed_steps_percent.yaml")
+		assert.Equal(t, 0, th.MaxFailedSteps)
+		assert.Equal(t, 10, th.MaxFailedStepsPercent)
+	})
+	t.Run("MaxRunDuration", func(t *testing.T) {
+		th := loadTestYAML(t, "max_run_duration.yaml")
+		assert.Equal(t, 2*time.Hour, th.MaxRunDuration)
+	})
+	t.Run("Notify", func(t *testing.T) {
+		th := loadTestYAML(t, "notify.yaml")
+		require.Len(t, th.Notify, 2)
+		assert.Equal(t, Notify{
+			Type: "slack",
+			On:   []string{NotifyOnFailure},
+			Config: map[string]any{
+				"channel": "#alerts",
+				"mention": map[string]any{"user": "oncall"},
+			},
+		}, th.Notify[0])
+		assert.Equal(t, []string{NotifyOnFailure, NotifyOnCancel}, th.Notify[1].On)
+	})
+	t.Run("HTTPHandler", func(t *testing.T) {
+		th := loadTestYAML(t, "http_handler.yaml")
+		step := th.HandlerOn.Failure
+		require.NotNil(t, step)
+		assert.Equal(t, ExecutorTypeHTTP, step.ExecutorConfig.Type)
+		assert.Equal(t, "POST", step.Command)
+		assert.Equal(t, []string{"https://example.com/hooks"}, step.Args)
+		assert.Equal(t, `{"failed": {{ json .FailedSteps }}}`, step.ExecutorConfig.Config["body"])
+		assert.Equal(t, map[string]string{"Authorization": "Bearer ${TOKEN}"}, step.ExecutorConfig.Config["headers"])
+		assert.Equal(t, true, step.ExecutorConfig.Config["template"])
+	})
+	t.Run("Callbacks", func(t *testing.T) {
+		th := loadTestYAML(t, "callbacks.yaml")
+		require.Len(t, th.Callbacks, 2)
+		assert.Equal(t, Callback{
+			URL:           "https://example.com/hooks/{{ .DAG }}",
+			Headers:       map[string]string{"Authorization": "Bearer ${TOKEN}"},
+			Payload:       `{"status": {{ json .Status }}}`,
+			Events:        []string{NotifyOnFailure},
+			Retries:       0,
+			RetryInterval: defaultCallbackRetryInterval,
+		}, th.Callbacks[0])
+		assert.Equal(t, "PUT", th.Callbacks[1].Method)
+		assert.Equal(t, []string{NotifyOnSuccess, NotifyOnFailure, NotifyOnCancel}, th.Callbacks[1].Events)
+		assert.Equal(t, defaultCallbackRetries, th.Callbacks[1].Retries)
+		assert.Equal(t, 30*time.Second, th.Callbacks[1].RetryInterval)
+	})
+	t.Run("Hooks", func(t *testing.T) {
+		th := loadTestYAML(t, "hooks.yaml")
+		require.NotNil(t, th.Hooks)
+		assert.Contains(t, th.Hooks.BeforeRun, `fail("ENV is required")`)
+		assert.Contains(t, th.Hooks.BeforeStep, `skip("not in prod")`)
+		assert.Empty(t, th.Hooks.AfterStep)
+	})
+	t.Run("Container", func(t *testing.T) {
+		th := loadTestYAML(t, "container.yaml")
+		require.NotNil(t, th.Container)
+		assert.Equal(t, "python:3.12-slim", th.Container.Image)
+		assert.False(t, th.Container.Pull)
+		assert.Equal(t, []string{"PYTHONUNBUFFERED=1"}, th.Container.Env)
+		assert.Equal(t, []string{"/tmp/data:/data"}, th.Container.Volumes)
+		assert.Equal(t, "/data", th.Container.WorkingDir)
+		assert.Equal(t, "docker", th.Steps[0].ExecutorConfig.Type)
+	})
+	t.Run("Services", func(t *testing.T) {
+		th := loadTestYAML(t, "services.yaml")
+		require.Len(t, th.Services, 2)
+		assert.Equal(t, Service{
+			Name:  "db",
+			Image: "postgres:16",
+			Pull:  true,
+			Env:   []string{"POSTGRES_PASSWORD=secret"},
+			Ports: []string{"5432"},
+		}, th.Services[0])
+		assert.False(t, th.Services[1].Pull)
+		assert.Equal(t, []string{"redis-server", "--save", ""}, th.Services[1].Command)
+		assert.Equal(t, []string{"6379/tcp"}, th.Services[1].Ports)
+	})
 	t.Run("Preconditions", func(t *testing.T) {
 		th := loadTestYAML(t, "preconditions.yaml")
 		assert.Len(t, th.Preconditions, 1)
 		assert.Equal(t, Condition{Condition: "test -f file.txt", Expected: "true"}, th.Preconditions[0])
 	})
+	t.Run("BuiltinPreconditions", func(t *testing.T) {
+		th := loadTestYAML(t, "builtin_preconditions.yaml")
+		assert.Equal(t, []Condition{
+			{FileExists: "/data/input/*.csv"},
+			{HTTP: "https://example.com/health"},
+			{TimeWindow: "09:00-17:00"},
+			{Weekdays: []string{"mon", "tue", "wed", "thu", "fri"}},
+			{Weekdays: []string{"sat", "sun"}},
+		}, th.Preconditions)
+	})
+	t.Run("InvalidTimeWindow", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_time_window.yaml", errInvalidTimeWindow)
+	})
+	t.Run("ExprPreconditions", func(t *testing.T) {
+		th := loadTestYAML(t, "expr_preconditions.yaml")
+		assert.Equal(t, []Condition{{Expr: `params.ENV == "prod"`}}, th.Preconditions)
+		assert.Equal(t, []Condition{{Expr: `int(outputs.COUNT) > 0 && params.ENV == "prod"`}}, th.Steps[1].Preconditions)
+	})
+	t.Run("InvalidExpr", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_expr.yaml", errExprMustBeBool)
+	})
+	t.Run("CombinedPreconditions", func(t *testing.T) {
+		th := loadTestYAML(t, "combined_preconditions.yaml")
+		assert.Equal(t, []Condition{
+			{AnyOf: []Condition{
+				{AllOf: []Condition{
+					{FileExists: "/data/input/*.csv"},
+					{Weekdays: []string{"mon", "wed", "fri"}},
+				}},
+				{Condition: "${FORCE}", Expected: "true"},
+			}},
+			{Not: &Condition{FileExists: "/data/input/.lock"}},
+		}, th.Steps[0].Preconditions)
+	})
+	t.Run("InvalidCombinedPreconditions", func(t *testing.T) {
+		loadTestYAMLError(t, "invalid_combined_preconditions.yaml", errPreconditionGroupMustBeArray)
+	})
+	t.Run("Handlers", func(t *testing.T) {
+		th := loadTestYAML(t, "valid_handlers.yaml")
+		require.NotNil(t, th.HandlerOn.Timeout)
+		assert.Equal(t, HandlerOnTimeout.String(), th.HandlerOn.Timeout.Name)
+		assert.Equal(t, "echo timeout", th.HandlerOn.Timeout.CmdWithArgs)
+		assert.Equal(t, HandlerOnTimeout, ParseHandlerType("onTimeout"))
+	})
 	t.Run("MaxActiveRuns", func(t *testing.T) {
 		th := loadTestYAML(t, "max_active_runs.yaml")
 		assert.Equal(t, 3, th.MaxActiveRuns)
@@ -306,6 +542,13 @@ func TestBuildStep(t *testing.T) {
 		assert.Equal(t, []string{"1"}, th.Steps[0].Args)
 		assert.Equal(t, "step 1", th.Steps[0].Name)
 	})
+	t.Run("ValidCommandList", func(t *testing.T) {
+		th := loadTestYAML(t, "valid_command_list.yaml")
+		assert.Len(t, th.Steps, 1)
+		assert.Equal(t, []string{"echo", `"hello world"`, "1"}, th.Steps[0].CommandList)
+		assert.Equal(t, "echo", th.Steps[0].Command)
+		assert.Equal(t, []string{`"hello world"`, "1"}, th.Steps[0].Args)
+	})
 	t.Run("HTTPExecutor", func(t *testing.T) {
 		th := loadTestYAML(t, "http_executor.yaml")
 		assert.Len(t, th.Steps, 1)
@@ -332,6 +575,128 @@ func TestBuildStep(t *testing.T) {
 			"param1=value1 param2=value2",
 		}, th.Steps[0].Args)
 	})
+	t.Run("SubWorkflowParamsMap", func(t *testing.T) {
+		th := loadTestYAML(t, "subworkflow_params_map.yaml")
+		assert.Len(t, th.Steps, 1)
+		require.NotNil(t, th.Steps[0].SubWorkflow)
+		// The values are kept as-is and evaluated when the sub workflow is called.
+		assert.Equal(t, `DATE="${DATE}" REGION="us-east-1"`, th.Steps[0].SubWorkflow.Params)
+	})
+	t.Run("DependsOnFailure", func(t *testing.T) {
+		th := loadTestYAML(t, "depends_on_failure.yaml")
+		assert.Len(t, th.Steps, 4)
+		assert.Equal(t, []string{"load"}, th.Steps[1].Depends)
+		assert.Nil(t, th.Steps[1].DependsOn)
+		assert.Equal(t, []string{"load"}, th.Steps[2].Depends)
+		assert.Equal(t, DependencyOnFailure, th.Steps[2].DependencyConditionOf("load"))
+		assert.Equal(t, []string{"notify", "cleanup"}, th.Steps[3].Depends)
+		assert.Equal(t, DependencyOnSuccess, th.Steps[3].DependencyConditionOf("notify"))
+		assert.Equal(t, DependencyOnAlways, th.Steps[3].DependencyConditionOf("cleanup"))
+	})
+	t.Run("DependsAnyOf", func(t *testing.T) {
+		th := loadTestYAML(t, "depends_any_of.yaml")
+		assert.Len(t, th.Steps, 4)
+		assert.Equal(t, []string{"validate", "source1", "source2"}, th.Steps[3].Depends)
+		assert.Equal(t, [][]string{{"source1", "source2"}}, th.Steps[3].DependsAnyOf)
+		assert.True(t, th.Steps[3].IsAnyOfDependency("source1"))
+		assert.False(t, th.Steps[3].IsAnyOfDependency("validate"))
+	})
+	t.Run("Expand", func(t *testing.T) {
+		th := loadTestYAML(t, "expand.yaml")
+		assert.Len(t, th.Steps, 2)
+		tmpl := th.Steps[0].Expand
+		require.NotNil(t, tmpl)
+		assert.Equal(t, "process", tmpl.Name)
+		assert.Equal(t, "echo", tmpl.Command)
+		assert.Equal(t, []string{"${ITEM}"}, tmpl.Args)
+		assert.Equal(t, th.Steps[0].Dir, tmpl.Dir)
+		assert.Nil(t, th.Steps[1].Expand)
+	})
+	t.Run("Foreach", func(t *testing.T) {
+		th := loadTestYAML(t, "foreach.yaml")
+
+		var names []string
+		for _, step := range th.Steps {
+			names = append(names, step.Name)
+		}
+		assert.Equal(t, []string{
+			"process[0]", "process[1]", "process[2]",
+			"count[0]", "count[1]", "count[2]",
+			"deploy[0]", "deploy[1]",
+			"report",
+		}, names)
+
+		assert.Equal(t, &ExpandItem{Source: "process", Index: 2, Value: `{"key":"c"}`}, th.Steps[2].ExpandItem)
+		assert.Equal(t, "", th.Steps[2].ParallelGroup)
+
+		assert.Equal(t, &ExpandItem{Source: "count", Index: 0, Value: "1"}, th.Steps[3].ExpandItem)
+		assert.Equal(t, "count", th.Steps[3].ParallelGroup)
+		assert.Equal(t, 2, th.Steps[3].MaxParallel)
+
+		assert.Equal(t, "eu", th.Steps[7].ExpandItem.Value)
+
+		// The dependencies on the foreach steps are replaced by the iterations.
+		report := th.Steps[8]
+		assert.Equal(t, []string{"process[0]", "process[1]", "process[2]", "deploy[0]", "deploy[1]"}, report.Depends)
+		assert.Equal(t, DependencyOnAlways, report.DependencyConditionOf("deploy[1]"))
+		assert.Equal(t, DependencyOnSuccess, report.DependencyConditionOf("process[0]"))
+	})
+	t.Run("Parallel", func(t *testing.T) {
+		th := loadTestYAML(t, "parallel.yaml")
+		require.Len(t, th.Steps, 3)
+		assert.Nil(t, th.Steps[0].Parallel)
+		assert.Equal(t, &Parallel{Items: "${PARTITIONS}"}, th.Steps[1].Parallel)
+		assert.Equal(t, &Parallel{Items: "${PARTITIONS}", MaxParallel: 2}, th.Steps[2].Parallel)
+	})
+	t.Run("Service", func(t *testing.T) {
+		th := loadTestYAML(t, "service.yaml")
+		require.Len(t, th.Steps, 3)
+		assert.Equal(t, &StepService{
+			Readiness:         []Condition{{Command: "curl -sf http://localhost:8080/health"}},
+			ReadinessInterval: 2 * time.Second,
+			ReadinessTimeout:  time.Minute,
+			RestartLimit:      3,
+			RestartInterval:   5 * time.Second,
+		}, th.Steps[0].Service)
+		assert.Equal(t, &StepService{
+			ReadinessInterval: time.Second,
+			ReadinessTimeout:  time.Minute,
+		}, th.Steps[1].Service)
+		assert.Nil(t, th.Steps[2].Service)
+	})
+	t.Run("StepGroups", func(t *testing.T) {
+		th := loadTestYAML(t, "step_groups.yaml")
+		require.Len(t, th.Steps, 5)
+		for _, step := range th.Steps[:4] {
+			assert.Equal(t, "downloads", step.ParallelGroup, step.Name)
+			assert.Equal(t, 5, step.MaxParallel, step.Name)
+		}
+		assert.Equal(t, "", th.Steps[4].ParallelGroup)
+		assert.Equal(t, 0, th.Steps[4].MaxParallel)
+	})
+	t.Run("StepLock", func(t *testing.T) {
+		th := loadTestYAML(t, "step_lock.yaml")
+		require.Len(t, th.Steps, 2)
+		assert.Equal(t, "db-primary", th.Steps[0].Lock)
+		assert.Equal(t, "", th.Steps[1].Lock)
+	})
+	t.Run("Stages", func(t *testing.T) {
+		th := loadTestYAML(t, "stages.yaml")
+		require.Len(t, th.Steps, 3)
+		assert.Equal(t, "build", th.Steps[0].Stage)
+		assert.Equal(t, "verify", th.Steps[1].Stage)
+		assert.Equal(t, "deploy", th.Steps[2].Stage)
+
+		// stages not declared in stages are added after the declared ones
+		require.Len(t, th.Stages, 3)
+		assert.Equal(t, "build", th.Stages[0].Name)
+		require.NotNil(t, th.Stages[0].HandlerOn.Failure)
+		assert.Equal(t, "build.onFailure", th.Stages[0].HandlerOn.Failure.Name)
+		assert.Equal(t, "deploy", th.Stages[1].Name)
+		require.NotNil(t, th.Stages[1].HandlerOn.Exit)
+		assert.Equal(t, "verify", th.Stages[2].Name)
+		assert.Nil(t, th.Stages[2].HandlerOn.Exit)
+	})
 	t.Run("ContinueOn", func(t *testing.T) {
 		th := loadTestYAML(t, "continue_on.yaml")
 		assert.Len(t, th.Steps, 1)
@@ -357,12 +722,48 @@ func TestBuildStep(t *testing.T) {
 		assert.Len(t, th.Steps, 1)
 		assert.Equal(t, "SIGINT", th.Steps[0].SignalOnStop)
 	})
+	t.Run("StepTimeout", func(t *testing.T) {
+		th := loadTestYAML(t, "step_timeout.yaml")
+		assert.Len(t, th.Steps, 2)
+		assert.Equal(t, 30*time.Second, th.Steps[0].Timeout)
+		assert.False(t, th.Steps[0].CancelOnTimeout)
+		assert.Equal(t, 10*time.Second, th.Steps[1].Timeout)
+		assert.True(t, th.Steps[1].CancelOnTimeout)
+	})
 	t.Run("Preconditions", func(t *testing.T) {
 		th := loadTestYAML(t, "step_preconditions.yaml")
 		assert.Len(t, th.Steps, 1)
 		assert.Len(t, th.Steps[0].Preconditions, 1)
 		assert.Equal(t, Condition{Condition: "test -f file.txt", Expected: "true"}, th.Steps[0].Preconditions[0])
 	})
+	t.Run("Postconditions", func(t *testing.T) {
+		th := loadTestYAML(t, "step_postconditions.yaml")
+		assert.Len(t, th.Steps, 1)
+		assert.Equal(t, []Condition{
+			{FileNotEmpty: "/tmp/report.csv"},
+			{Condition: "${COUNT}", Expected: "re:^[1-9][0-9]*$"},
+		}, th.Steps[0].Postconditions)
+	})
+	t.Run("Artifacts", func(t *testing.T) {
+		th := loadTestYAML(t, "artifacts.yaml")
+		assert.Len(t, th.Steps, 2)
+		assert.Equal(t, []string{"dist/app.tar.gz", "report.txt"}, th.Steps[0].Artifacts.Produces)
+		assert.Equal(t, []string{"app.tar.gz"}, th.Steps[1].Artifacts.Consumes)
+	})
+	t.Run("Stdin", func(t *testing.T) {
+		th := loadTestYAML(t, "stdin.yaml")
+		require.Len(t, th.Steps, 2)
+		assert.Equal(t, "${ITEMS}", th.Steps[0].Stdin)
+		assert.Equal(t, "", th.Steps[0].StdinFile)
+		assert.Equal(t, "", th.Steps[1].Stdin)
+		assert.Equal(t, "data/names.txt", th.Steps[1].StdinFile)
+	})
+	t.Run("Cache", func(t *testing.T) {
+		th := loadTestYAML(t, "cache.yaml")
+		assert.Len(t, th.Steps, 1)
+		require.NotNil(t, th.Steps[0].Cache)
+		assert.Equal(t, []string{"src/*.go", "go.mod"}, th.Steps[0].Cache.Inputs)
+	})
 }
 
 func TestOverrideBaseConfig(t *testing.T) {
@@ -392,6 +793,43 @@ func TestOverrideBaseConfig(t *testing.T) {
 		// The MailOn key should be the same as the base config.
 		require.Equal(t, &MailOn{Failure: true, Success: false}, dag.MailOn)
 		require.Equal(t, dag.HistRetentionDays, 30)
+		require.Equal(t, "smtp.host", dag.SMTP.Host)
+		require.Equal(t, "base-user", dag.SMTP.Username)
+	})
+	t.Run("OverrideSMTP", func(t *testing.T) {
+		baseConfig := filepath.Join(testdataDir, "base.yaml")
+
+		// The SMTP config of the DAG replaces the one of the base config
+		// including the credentials.
+		filePath := filepath.Join(testdataDir, "smtp_override.yaml")
+		dag, err := Load(context.Background(), filePath, WithBaseConfig(baseConfig))
+		require.NoError(t, err)
+
+		require.Equal(t, &SMTPConfig{
+			Host: "smtp.dag.host",
+			Port: "465",
+			TLS:  "tls",
+		}, dag.SMTP)
+		require.Equal(t, "error@mail.com", dag.ErrorMail.To)
+	})
+	t.Run("Chain", func(t *testing.T) {
+		// The namespace base config overrides the global one, and the DAG
+		// overrides both.
+		dag, err := Load(context.Background(), filepath.Join(testdataDir, "no_overwrite.yaml"),
+			WithBaseConfig(filepath.Join(testdataDir, "base.yaml")),
+			WithBaseConfig(filepath.Join(testdataDir, "namespace_base.yaml")))
+		require.NoError(t, err)
+		require.Equal(t, 14, dag.HistRetentionDays)
+		require.Equal(t, "smtp.team.host", dag.SMTP.Host)
+		require.Equal(t, "", dag.SMTP.Username)
+		require.Equal(t, "team@mail.com", dag.InfoMail.To)
+		require.Equal(t, "[INFO]", dag.InfoMail.Prefix)
+
+		dag, err = Load(context.Background(), filepath.Join(testdataDir, "overwrite.yaml"),
+			WithBaseConfig(filepath.Join(testdataDir, "base.yaml")),
+			WithBaseConfig(filepath.Join(testdataDir, "namespace_base.yaml")))
+		require.NoError(t, err)
+		require.Equal(t, 7, dag.HistRetentionDays)
 	})
 }
 
@@ -33,6 +33,13 @@ import (
 func buildCommand(_ BuildContext, def stepDef, step *Step) error {
 	command := def.Command
 
+	if def.CommandList != nil {
+		if command != nil {
+			return wrapError("commandList", def.CommandList, errStepCommandAndCommandList)
+		}
+		return buildCommandList(def.CommandList, step)
+	}
+
 	// Case 1: command is nil
 	if command == nil {
 		return nil
@@ -94,3 +101,38 @@ func buildCommand(_ BuildContext, def stepDef, step *Step) error {
 
 	return nil
 }
+
+// buildCommandList parses the commandList field in the step definition.
+// The first item is the command and the rest are the arguments. They are
+// passed to the command as they are, without a shell.
+//
+// Example:
+// ```yaml
+// step:
+//   - name: "echo hello"
+//     commandList: ["echo", "hello \"world\""]
+//
+// ```
+func buildCommandList(list []any, step *Step) error {
+	if len(list) == 0 {
+		return wrapError("commandList", list, errStepCommandListIsEmpty)
+	}
+
+	argv := make([]string, 0, len(list))
+	for _, v := range list {
+		val, ok := v.(string)
+		if !ok {
+			val = fmt.Sprintf("%v", v)
+		}
+		argv = append(argv, val)
+	}
+	if argv[0] == "" {
+		return wrapError("commandList", list, errStepCommandListIsEmpty)
+	}
+
+	step.CommandList = argv
+	step.Command = argv[0]
+	step.Args = argv[1:]
+	step.CmdWithArgs = cmdutil.BuildCommandEscapedString(step.Command, step.Args)
+	return nil
+}
@@ -4,7 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/dagu-org/dagu/internal/cmdutil"
 	"github.com/dagu-org/dagu/internal/stringutil"
@@ -16,21 +23,69 @@ var ErrConditionNotMet = fmt.Errorf("condition was not met")
 // Conditions are evaluated and compared to the expected value.
 // The condition can be a command substitution or an environment variable.
 // The expected value must be a string without any substitutions.
+//
+// The built-in checks (FileExists, FileNotEmpty, HTTP, TimeWindow and
+// Weekdays) are evaluated natively without running a command. Expr is a CEL
+// expression evaluated with the parameters, the environment variables and
+// the output variables.
+//
+// AllOf, AnyOf and Not combine other conditions.
 type Condition struct {
-	Command   string `json:"Command,omitempty"`   // Command to evaluate
-	Condition string `json:"Condition,omitempty"` // Condition to evaluate
-	Expected  string `json:"Expected,omitempty"`  // Expected value
+	Command      string   `json:"Command,omitempty"`      // Command to evaluate
+	Condition    string   `json:"Condition,omitempty"`    // Condition to evaluate
+	Expected     string   `json:"Expected,omitempty"`     // Expected value
+	Expr         string   `json:"Expr,omitempty"`         // CEL expression that must be true
+	FileExists   string   `json:"FileExists,omitempty"`   // Path or glob pattern that must match a file
+	FileNotEmpty string   `json:"FileNotEmpty,omitempty"` // Path or glob pattern that must match a non-empty file
+	HTTP         string   `json:"HTTP,omitempty"`         // URL that must return 200 OK
+	TimeWindow   string   `json:"TimeWindow,omitempty"`   // Time window of the day (e.g. 09:00-17:00)
+	Weekdays     []string `json:"Weekdays,omitempty"`     // Days of the week (e.g. mon, tue)
+
+	AllOf []Condition `json:"AllOf,omitempty"` // Conditions that must all be met
+	AnyOf []Condition `json:"AnyOf,omitempty"` // Conditions of which at least one must be met
+	Not   *Condition  `json:"Not,omitempty"`   // Condition that must not be met
 }
 
 func (c Condition) Validate() error {
 	switch {
+	case len(c.AllOf) > 0, len(c.AnyOf) > 0, c.Not != nil:
+		for _, sub := range c.subConditions() {
+			if err := sub.Validate(); err != nil {
+				return err
+			}
+		}
+
 	case c.Condition != "":
 		if c.Expected == "" {
 			return fmt.Errorf("expected value is required for condition: Condition=%s", c.Condition)
 		}
+		if err := validateExpected(c.Expected); err != nil {
+			return err
+		}
+
+	case c.Expr != "":
+		if _, err := compileExpr(c.Expr); err != nil {
+			return err
+		}
 
 	case c.Command != "":
 		// Command is required
+
+	case c.FileExists != "", c.FileNotEmpty != "", c.HTTP != "":
+		// The path and URL are evaluated at runtime
+
+	case c.TimeWindow != "":
+		if _, _, err := parseTimeWindow(c.TimeWindow); err != nil {
+			return err
+		}
+
+	case len(c.Weekdays) > 0:
+		for _, day := range c.Weekdays {
+			if _, err := parseWeekday(day); err != nil {
+				return err
+			}
+		}
+
 	default:
 		return fmt.Errorf("invalid condition: Condition=%s", c.Condition)
 	}
@@ -42,44 +97,171 @@ func (c Condition) Validate() error {
 // It returns an error if the evaluation failed or the condition is invalid.
 func (c Condition) eval(ctx context.Context) (bool, error) {
 	switch {
+	case len(c.AllOf) > 0:
+		return c.evalAllOf(ctx)
+
+	case len(c.AnyOf) > 0:
+		return c.evalAnyOf(ctx)
+
+	case c.Not != nil:
+		return c.evalNot(ctx)
+
 	case c.Condition != "":
 		return c.evalCondition(ctx)
 
+	case c.Expr != "":
+		return c.evalExpr(ctx)
+
 	case c.Command != "":
 		return c.evalCommand(ctx)
 
+	case c.FileExists != "":
+		return c.evalFileExists(ctx)
+
+	case c.FileNotEmpty != "":
+		return c.evalFileNotEmpty(ctx)
+
+	case c.HTTP != "":
+		return c.evalHTTP(ctx)
+
+	case c.TimeWindow != "":
+		return c.evalTimeWindow(currentTime(ctx))
+
+	case len(c.Weekdays) > 0:
+		return c.evalWeekdays(currentTime(ctx))
+
 	default:
 		return false, fmt.Errorf("invalid condition: Condition=%s", c.Condition)
 	}
 }
 
-func (c Condition) evalCommand(ctx context.Context) (bool, error) {
-	var commandToRun string
-	if IsStepContext(ctx) {
-		command, err := GetStepContext(ctx).EvalString(c.Command, cmdutil.OnlyReplaceVars())
-		if err != nil {
-			return false, err
+// EvalAt evaluates the condition at the time without running anything, e.g.
+// to tell whether a scheduled run will be skipped. known is false if the
+// result depends on anything else than TimeWindow and Weekdays. The error
+// wraps ErrConditionNotMet if the condition is known not to be met.
+func (c Condition) EvalAt(t time.Time) (known bool, err error) {
+	switch {
+	case len(c.AllOf) > 0:
+		known = true
+		for _, sub := range c.AllOf {
+			subKnown, err := sub.EvalAt(t)
+			if subKnown && err != nil {
+				return true, err
+			}
+			known = known && subKnown
 		}
-		commandToRun = command
-	} else if IsContext(ctx) {
-		command, err := GetContext(ctx).EvalString(c.Command, cmdutil.OnlyReplaceVars())
-		if err != nil {
-			return false, err
+		return known, nil
+
+	case len(c.AnyOf) > 0:
+		var errs []string
+		known = true
+		for _, sub := range c.AnyOf {
+			subKnown, err := sub.EvalAt(t)
+			if subKnown && err == nil {
+				return true, nil
+			}
+			if err != nil {
+				errs = append(errs, err.Error())
+			}
+			known = known && subKnown
 		}
-		commandToRun = command
-	} else {
-		command, err := cmdutil.EvalString(ctx, c.Command, cmdutil.OnlyReplaceVars())
-		if err != nil {
+		if !known {
+			return false, nil
+		}
+		return true, fmt.Errorf("%w: none of the conditions is met: %s", ErrConditionNotMet, strings.Join(errs, "; "))
+
+	case c.Not != nil:
+		known, err := c.Not.EvalAt(t)
+		switch {
+		case !known:
+			return false, nil
+		case err == nil:
+			return true, fmt.Errorf("%w: Not %s", ErrConditionNotMet, c.Not)
+		default:
+			return true, nil
+		}
+
+	case c.TimeWindow != "":
+		_, err := c.evalTimeWindow(t)
+		return true, err
+
+	case len(c.Weekdays) > 0:
+		_, err := c.evalWeekdays(t)
+		return true, err
+
+	default:
+		return false, nil
+	}
+}
+
+// subConditions returns the conditions combined by the condition.
+func (c Condition) subConditions() []Condition {
+	subs := append(slices.Clone(c.AllOf), c.AnyOf...)
+	if c.Not != nil {
+		subs = append(subs, *c.Not)
+	}
+	return subs
+}
+
+// evalAllOf checks if all the conditions are met.
+func (c Condition) evalAllOf(ctx context.Context) (bool, error) {
+	for _, sub := range c.AllOf {
+		if err := evalCondition(ctx, sub); err != nil {
 			return false, err
 		}
-		commandToRun = command
+	}
+	return true, nil
+}
+
+// evalAnyOf checks if at least one of the conditions is met.
+func (c Condition) evalAnyOf(ctx context.Context) (bool, error) {
+	var errs []string
+	for _, sub := range c.AnyOf {
+		err := evalCondition(ctx, sub)
+		if err == nil {
+			return true, nil
+		}
+		errs = append(errs, err.Error())
+	}
+	return false, fmt.Errorf("%w: none of the conditions is met: %s", ErrConditionNotMet, strings.Join(errs, "; "))
+}
+
+// evalNot checks if the condition is not met. An error evaluating the
+// condition is not treated as the condition not being met.
+func (c Condition) evalNot(ctx context.Context) (bool, error) {
+	err := evalCondition(ctx, *c.Not)
+	switch {
+	case err == nil:
+		return false, fmt.Errorf("%w: Not %s", ErrConditionNotMet, c.Not)
+	case errors.Is(err, ErrConditionNotMet):
+		return true, nil
+	default:
+		return false, err
+	}
+}
+
+// evalVars evaluates the string with the variables in the context.
+func evalVars(ctx context.Context, s string, opts ...cmdutil.EvalOption) (string, error) {
+	if IsStepContext(ctx) {
+		return GetStepContext(ctx).EvalString(s, opts...)
+	} else if IsContext(ctx) {
+		return GetContext(ctx).EvalString(s, opts...)
+	}
+	return cmdutil.EvalString(ctx, s, opts...)
+}
+
+func (c Condition) evalCommand(ctx context.Context) (bool, error) {
+	// The command is evaluated by the shell.
+	commandToRun, err := evalVars(ctx, c.Command, cmdutil.OnlyReplaceVars())
+	if err != nil {
+		return false, err
 	}
 
 	shell := cmdutil.GetShellCommand("")
 	if shell == "" {
 		// Run the command directly
 		cmd := exec.CommandContext(ctx, commandToRun)
-		_, err := cmd.Output()
+		_, err = cmd.Output()
 		if err != nil {
 			return false, fmt.Errorf("%w: %s", ErrConditionNotMet, err)
 		}
@@ -88,7 +270,7 @@ func (c Condition) evalCommand(ctx context.Context) (bool, error) {
 
 	// Run the command through a shell
 	cmd := exec.CommandContext(ctx, shell, "-c", commandToRun)
-	_, err := cmd.Output()
+	_, err = cmd.Output()
 	if err != nil {
 		return false, fmt.Errorf("%w: %s", ErrConditionNotMet, err)
 	}
@@ -110,15 +292,308 @@ func (c Condition) evalCondition(ctx context.Context) (bool, error) {
 		return false, err
 	}
 
-	if stringutil.MatchPattern(ctx, evaluatedVal, []string{c.Expected}, stringutil.WithExactMatch()) {
+	matched, err := matchExpected(ctx, evaluatedVal, c.Expected)
+	if err != nil {
+		return false, err
+	}
+	if matched {
 		return true, nil
 	}
 
 	return false, fmt.Errorf("%w: Condition=%s Expected=%s", ErrConditionNotMet, c.Condition, c.Expected)
 }
 
+// comparisonOperators is the operators of the expected value. The longer
+// operators come first so that ">=" is not parsed as ">".
+var comparisonOperators = []string{">=", "<=", "==", "!=", ">", "<"}
+
+// The prefixes of the expected value opting in to the comparisons and the
+// negation. The values without a prefix keep matching literally, e.g. "!0"
+// or "> 100".
+const (
+	expectedCmpPrefix = "cmp:"
+	expectedNotPrefix = "not:"
+)
+
+// matchExpected checks if the value matches the expected value. The
+// expected value is one of:
+//   - a literal that must match exactly.
+//   - a regular expression prefixed with "re:".
+//   - a comparison prefixed with "cmp:", i.e. an operator (==, !=, >, >=,
+//     <, <=) and an operand. The values are compared as numbers if both are
+//     numbers, otherwise == and != compare them as strings.
+//   - any of the above prefixed with "not:" to negate it.
+func matchExpected(ctx context.Context, value, expected string) (bool, error) {
+	if rest, ok := strings.CutPrefix(expected, expectedNotPrefix); ok {
+		matched, err := matchExpected(ctx, value, rest)
+		return !matched, err
+	}
+
+	cmp, ok := strings.CutPrefix(expected, expectedCmpPrefix)
+	if !ok {
+		return stringutil.MatchPattern(ctx, value, []string{expected}, stringutil.WithExactMatch()), nil
+	}
+	op, operand, ok := parseComparison(cmp)
+	if !ok {
+		return false, fmt.Errorf("%w: %s", errInvalidComparisonOperator, expected)
+	}
+
+	value = strings.TrimSpace(value)
+	x, errX := strconv.ParseFloat(value, 64)
+	y, errY := strconv.ParseFloat(operand, 64)
+	if errX != nil || errY != nil {
+		switch op {
+		case "==":
+			return value == operand, nil
+		case "!=":
+			return value != operand, nil
+		}
+		if errY != nil {
+			return false, fmt.Errorf("%w: %s", errInvalidComparison, expected)
+		}
+		return false, fmt.Errorf("%w: %q is not a number", ErrConditionNotMet, value)
+	}
+
+	switch op {
+	case "==":
+		return x == y, nil
+	case "!=":
+		return x != y, nil
+	case ">":
+		return x > y, nil
+	case ">=":
+		return x >= y, nil
+	case "<":
+		return x < y, nil
+	default: // "<="
+		return x <= y, nil
+	}
+}
+
+// parseComparison splits the comparison into the operator and the operand.
+// It returns false if the comparison has no operator.
+func parseComparison(cmp string) (string, string, bool) {
+	cmp = strings.TrimSpace(cmp)
+	for _, op := range comparisonOperators {
+		if rest, ok := strings.CutPrefix(cmp, op); ok {
+			return op, strings.TrimSpace(rest), true
+		}
+	}
+	return "", "", false
+}
+
+// validateExpected checks that the comparisons have an operator and that
+// the operand of the numeric comparisons is a number.
+func validateExpected(expected string) error {
+	for {
+		rest, ok := strings.CutPrefix(expected, expectedNotPrefix)
+		if !ok {
+			break
+		}
+		expected = rest
+	}
+	cmp, ok := strings.CutPrefix(expected, expectedCmpPrefix)
+	if !ok {
+		return nil
+	}
+	op, operand, ok := parseComparison(cmp)
+	if !ok {
+		return fmt.Errorf("%w: %s", errInvalidComparisonOperator, expected)
+	}
+	if op == "==" || op == "!=" {
+		return nil
+	}
+	if _, err := strconv.ParseFloat(operand, 64); err != nil {
+		return fmt.Errorf("%w: %s", errInvalidComparison, expected)
+	}
+	return nil
+}
+
+// evalFileExists checks if the path exists. The path can be a glob pattern,
+// in which case at least one file must match.
+func (c Condition) evalFileExists(ctx context.Context) (bool, error) {
+	pattern, err := evalVars(ctx, c.FileExists)
+	if err != nil {
+		return false, err
+	}
+
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return false, fmt.Errorf("invalid file pattern %q: %w", pattern, err)
+	}
+	if len(matches) == 0 {
+		return false, fmt.Errorf("%w: no file matches %s", ErrConditionNotMet, pattern)
+	}
+	return true, nil
+}
+
+// evalFileNotEmpty checks if at least one of the files matching the path
+// is not empty.
+func (c Condition) evalFileNotEmpty(ctx context.Context) (bool, error) {
+	pattern, err := evalVars(ctx, c.FileNotEmpty)
+	if err != nil {
+		return false, err
+	}
+
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return false, fmt.Errorf("invalid file pattern %q: %w", pattern, err)
+	}
+	for _, match := range matches {
+		if info, err := os.Stat(match); err == nil && info.Mode().IsRegular() && info.Size() > 0 {
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%w: no non-empty file matches %s", ErrConditionNotMet, pattern)
+}
+
+// httpConditionTimeout is the timeout of the request of the HTTP condition.
+const httpConditionTimeout = 30 * time.Second
+
+// evalHTTP checks if a GET request to the URL returns 200 OK.
+func (c Condition) evalHTTP(ctx context.Context) (bool, error) {
+	url, err := evalVars(ctx, c.HTTP)
+	if err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, httpConditionTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return false, fmt.Errorf("invalid URL %q: %w", url, err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s", ErrConditionNotMet, err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("%w: %s returned %s", ErrConditionNotMet, url, resp.Status)
+	}
+	return true, nil
+}
+
+// currentTime returns the current time in the time zone of the DAG being
+// run, so that the time windows and the weekdays match its schedules.
+func currentTime(ctx context.Context) time.Time {
+	now := time.Now()
+	if dagCtx, ok := ctx.Value(ctxKey{}).(Context); ok && dagCtx.dag != nil {
+		if loc := dagCtx.dag.TimeLocation(); loc != nil {
+			return now.In(loc)
+		}
+	}
+	return now
+}
+
+// evalTimeWindow checks if the time of the day is within the window. The
+// window wraps around midnight if the end is before the start
+// (e.g. 22:00-06:00).
+func (c Condition) evalTimeWindow(now time.Time) (bool, error) {
+	start, end, err := parseTimeWindow(c.TimeWindow)
+	if err != nil {
+		return false, err
+	}
+
+	current := time.Duration(now.Hour())*time.Hour + time.Duration(now.Minute())*time.Minute
+	var within bool
+	if start <= end {
+		within = start <= current && current < end
+	} else {
+		within = current >= start || current < end
+	}
+	if !within {
+		return false, fmt.Errorf("%w: %s is not within %s", ErrConditionNotMet, now.Format("15:04"), c.TimeWindow)
+	}
+	return true, nil
+}
+
+// evalWeekdays checks if the day of the week is one of the weekdays.
+func (c Condition) evalWeekdays(now time.Time) (bool, error) {
+	for _, day := range c.Weekdays {
+		weekday, err := parseWeekday(day)
+		if err != nil {
+			return false, err
+		}
+		if weekday == now.Weekday() {
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%w: %s is not one of %s", ErrConditionNotMet, now.Weekday(), strings.Join(c.Weekdays, ","))
+}
+
+// parseTimeWindow parses a time window in the HH:MM-HH:MM format and returns
+// the start and end as the durations since midnight.
+func parseTimeWindow(window string) (time.Duration, time.Duration, error) {
+	startStr, endStr, ok := strings.Cut(window, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("%w: %s", errInvalidTimeWindow, window)
+	}
+	start, err := time.Parse("15:04", strings.TrimSpace(startStr))
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %s", errInvalidTimeWindow, window)
+	}
+	end, err := time.Parse("15:04", strings.TrimSpace(endStr))
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %s", errInvalidTimeWindow, window)
+	}
+	sinceMidnight := func(t time.Time) time.Duration {
+		return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+	return sinceMidnight(start), sinceMidnight(end), nil
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+func parseWeekday(day string) (time.Weekday, error) {
+	weekday, ok := weekdays[strings.ToLower(strings.TrimSpace(day))]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", errInvalidWeekday, day)
+	}
+	return weekday, nil
+}
+
 func (c Condition) String() string {
-	return fmt.Sprintf("Condition=%s Expected=%s", c.Condition, c.Expected)
+	join := func(conds []Condition) string {
+		s := make([]string, 0, len(conds))
+		for _, cond := range conds {
+			s = append(s, cond.String())
+		}
+		return "[" + strings.Join(s, ", ") + "]"
+	}
+
+	switch {
+	case len(c.AllOf) > 0:
+		return fmt.Sprintf("AllOf=%s", join(c.AllOf))
+	case len(c.AnyOf) > 0:
+		return fmt.Sprintf("AnyOf=%s", join(c.AnyOf))
+	case c.Not != nil:
+		return fmt.Sprintf("Not=[%s]", c.Not)
+	case c.Expr != "":
+		return fmt.Sprintf("Expr=%s", c.Expr)
+	case c.FileExists != "":
+		return fmt.Sprintf("FileExists=%s", c.FileExists)
+	case c.FileNotEmpty != "":
+		return fmt.Sprintf("FileNotEmpty=%s", c.FileNotEmpty)
+	case c.HTTP != "":
+		return fmt.Sprintf("HTTP=%s", c.HTTP)
+	case c.TimeWindow != "":
+		return fmt.Sprintf("TimeWindow=%s", c.TimeWindow)
+	case len(c.Weekdays) > 0:
+		return fmt.Sprintf("Weekdays=%s", strings.Join(c.Weekdays, ","))
+	default:
+		return fmt.Sprintf("Condition=%s Expected=%s", c.Condition, c.Expected)
+	}
 }
 
 // evalCondition evaluates a single condition and checks the result.
@@ -129,7 +604,7 @@ func evalCondition(ctx context.Context, c Condition) error {
 		if errors.Is(err, ErrConditionNotMet) {
 			return err
 		}
-		return fmt.Errorf("failed to evaluate condition: Condition=%s Error=%v", c.Condition, err)
+		return fmt.Errorf("failed to evaluate condition: %s Error=%v", c, err)
 	}
 
 	if !matched {
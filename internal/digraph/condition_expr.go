@@ -0,0 +1,100 @@
+package digraph
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+)
+
+// exprEnv is the CEL environment of the expression conditions. The
+// parameters, the environment variables and the output variables are
+// available as maps of strings.
+var exprEnv = sync.OnceValues(func() (*cel.Env, error) {
+	stringMap := cel.MapType(cel.StringType, cel.StringType)
+	return cel.NewEnv(
+		cel.Variable("params", stringMap),
+		cel.Variable("env", stringMap),
+		cel.Variable("outputs", stringMap),
+	)
+})
+
+// compileExpr compiles the CEL expression. The expression must evaluate to
+// a boolean.
+func compileExpr(expr string) (cel.Program, error) {
+	env, err := exprEnv()
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidExpr, issues.Err())
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("%w: %s returns %s", errExprMustBeBool, expr, ast.OutputType())
+	}
+	return env.Program(ast)
+}
+
+// evalExpr evaluates the CEL expression with the parameters, the
+// environment variables and the output variables of the context.
+func (c Condition) evalExpr(ctx context.Context) (bool, error) {
+	prg, err := compileExpr(c.Expr)
+	if err != nil {
+		return false, err
+	}
+	out, _, err := prg.ContextEval(ctx, exprVars(ctx))
+	if err != nil {
+		return false, err
+	}
+	if matched, ok := out.Value().(bool); !ok || !matched {
+		return false, fmt.Errorf("%w: Expr=%s", ErrConditionNotMet, c.Expr)
+	}
+	return true, nil
+}
+
+// exprVars returns the variables of the expression from the context.
+func exprVars(ctx context.Context) map[string]any {
+	var (
+		dagCtx  Context
+		envs    []string
+		outputs = map[string]string{}
+	)
+	switch {
+	case IsStepContext(ctx):
+		stepCtx := GetStepContext(ctx)
+		dagCtx = stepCtx.Context
+		envs = stepCtx.AllEnvs()
+		outputs = stepCtx.outputVariables.Variables()
+	case IsContext(ctx):
+		dagCtx = GetContext(ctx)
+		envs = dagCtx.AllEnvs()
+	default:
+		envs = os.Environ()
+	}
+
+	params := map[string]string{}
+	if dagCtx.dag != nil {
+		for _, p := range dagCtx.dag.Params {
+			if k, v, ok := strings.Cut(p, "="); ok {
+				params[k] = v
+			}
+		}
+	}
+
+	env := make(map[string]string, len(envs))
+	for _, e := range envs {
+		if k, v, ok := strings.Cut(e, "="); ok {
+			env[k] = v
+		}
+	}
+
+	return map[string]any{
+		"params":  params,
+		"env":     env,
+		"outputs": outputs,
+	}
+}
@@ -2,8 +2,12 @@ package digraph
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -99,6 +103,51 @@ func TestCondition_Eval(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:      "GreaterThan",
+			condition: []Condition{{Condition: "`echo 150`", Expected: "cmp:> 100"}},
+		},
+		{
+			name:      "GreaterThanNotMet",
+			condition: []Condition{{Condition: "`echo 50`", Expected: "cmp:>100"}},
+			wantErr:   true,
+		},
+		{
+			name:      "LessOrEqual",
+			condition: []Condition{{Condition: "${TEST_CONDITION}", Expected: "cmp:<= 100"}},
+		},
+		{
+			name:      "NumericEqual",
+			condition: []Condition{{Condition: "1.0", Expected: "cmp:== 1"}},
+		},
+		{
+			name:      "StringNotEqual",
+			condition: []Condition{{Condition: "running", Expected: "cmp:!= done"}},
+		},
+		{
+			name:      "NotANumber",
+			condition: []Condition{{Condition: "abc", Expected: "cmp:> 1"}},
+			wantErr:   true,
+		},
+		{
+			name:      "Negation",
+			condition: []Condition{{Condition: "`echo 1`", Expected: "not:0"}},
+		},
+		{
+			name:      "NegatedRegex",
+			condition: []Condition{{Condition: "error: failed", Expected: "not:re:^error"}},
+			wantErr:   true,
+		},
+		{
+			// The values without a prefix match literally.
+			name:      "LiteralOperator",
+			condition: []Condition{{Condition: "!0", Expected: "!0"}},
+		},
+		{
+			name:      "LiteralComparison",
+			condition: []Condition{{Condition: "150", Expected: "> 100"}},
+			wantErr:   true,
+		},
 	}
 
 	// Set environment variable for testing
@@ -117,3 +166,252 @@ func TestCondition_Eval(t *testing.T) {
 		})
 	}
 }
+
+func TestCondition_Builtin(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "data.csv"), []byte("a,b"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "empty.txt"), nil, 0600))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name      string
+		condition Condition
+		wantErr   bool
+	}{
+		{
+			name:      "FileExists",
+			condition: Condition{FileExists: filepath.Join(dir, "data.csv")},
+		},
+		{
+			name:      "FileGlobMatches",
+			condition: Condition{FileExists: filepath.Join(dir, "*.csv")},
+		},
+		{
+			name:      "FileNotExists",
+			condition: Condition{FileExists: filepath.Join(dir, "*.json")},
+			wantErr:   true,
+		},
+		{
+			name:      "FileNotEmpty",
+			condition: Condition{FileNotEmpty: filepath.Join(dir, "*.csv")},
+		},
+		{
+			name:      "FileEmpty",
+			condition: Condition{FileNotEmpty: filepath.Join(dir, "empty.txt")},
+			wantErr:   true,
+		},
+		{
+			name:      "HTTPOK",
+			condition: Condition{HTTP: server.URL + "/health"},
+		},
+		{
+			name:      "HTTPNotOK",
+			condition: Condition{HTTP: server.URL + "/other"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := EvalConditions(context.Background(), []Condition{tt.condition})
+			require.Equal(t, tt.wantErr, err != nil, err)
+			if err != nil {
+				require.ErrorIs(t, err, ErrConditionNotMet)
+			}
+		})
+	}
+}
+
+func TestCondition_TimeWindow(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		window string
+		now    time.Time
+		want   bool
+	}{
+		{window: "09:00-17:00", now: at(9, 0), want: true},
+		{window: "09:00-17:00", now: at(16, 59), want: true},
+		{window: "09:00-17:00", now: at(17, 0), want: false},
+		{window: "09:00-17:00", now: at(8, 59), want: false},
+		{window: "22:00-06:00", now: at(23, 30), want: true},
+		{window: "22:00-06:00", now: at(5, 0), want: true},
+		{window: "22:00-06:00", now: at(12, 0), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.window+"@"+tt.now.Format("15:04"), func(t *testing.T) {
+			ok, err := Condition{TimeWindow: tt.window}.evalTimeWindow(tt.now)
+			require.Equal(t, tt.want, ok)
+			if !tt.want {
+				require.ErrorIs(t, err, ErrConditionNotMet)
+			}
+		})
+	}
+
+	require.ErrorIs(t, Condition{TimeWindow: "9-17"}.Validate(), errInvalidTimeWindow)
+}
+
+func TestCondition_CurrentTime(t *testing.T) {
+	schedules, err := buildScheduler([]string{"CRON_TZ=Asia/Tokyo 0 9 * * *"})
+	require.NoError(t, err)
+
+	// The time windows and the weekdays are evaluated in the time zone of
+	// the schedules of the DAG.
+	ctx := NewContext(context.Background(), &DAG{Name: "test", Schedule: schedules}, nil, "", "")
+	require.Equal(t, "Asia/Tokyo", currentTime(ctx).Location().String())
+
+	ctx = NewContext(context.Background(), &DAG{Name: "test"}, nil, "", "")
+	require.Equal(t, time.Local, currentTime(ctx).Location())
+}
+
+func TestCondition_ValidateExpected(t *testing.T) {
+	require.NoError(t, Condition{Condition: "${COUNT}", Expected: "cmp:>= 10"}.Validate())
+	require.NoError(t, Condition{Condition: "${STATUS}", Expected: "cmp:!= done"}.Validate())
+	require.NoError(t, Condition{Condition: "${COUNT}", Expected: "> ten"}.Validate())
+	require.ErrorIs(t, Condition{Condition: "${COUNT}", Expected: "cmp:> ten"}.Validate(), errInvalidComparison)
+	require.ErrorIs(t, Condition{Condition: "${COUNT}", Expected: "not:cmp:< ten"}.Validate(), errInvalidComparison)
+	require.ErrorIs(t, Condition{Condition: "${COUNT}", Expected: "cmp:10"}.Validate(), errInvalidComparisonOperator)
+}
+
+func TestCondition_Weekdays(t *testing.T) {
+	monday := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	ok, err := Condition{Weekdays: []string{"Mon", "tue"}}.evalWeekdays(monday)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = Condition{Weekdays: []string{"saturday", "sunday"}}.evalWeekdays(monday)
+	require.ErrorIs(t, err, ErrConditionNotMet)
+	require.False(t, ok)
+
+	require.ErrorIs(t, Condition{Weekdays: []string{"someday"}}.Validate(), errInvalidWeekday)
+}
+
+func TestCondition_Expr(t *testing.T) {
+	dag := &DAG{Name: "test", Params: []string{"ENV=prod"}}
+	ctx := NewContext(context.Background(), dag, nil, "request-id", "")
+	outputs := &SyncMap{}
+	outputs.Store("COUNT", "COUNT=3")
+	stepCtx := NewStepContext(ctx, Step{Name: "step"})
+	stepCtx.LoadOutputVariables(outputs)
+	ctx = WithStepContext(ctx, stepCtx)
+
+	_ = os.Setenv("TEST_EXPR", "on")
+	t.Cleanup(func() {
+		_ = os.Unsetenv("TEST_EXPR")
+	})
+
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{name: "Met", expr: `int(outputs.COUNT) > 0 && params.ENV == "prod"`},
+		{name: "NotMet", expr: `int(outputs.COUNT) > 5`, wantErr: true},
+		{name: "Env", expr: `env.TEST_EXPR == "on" && env.DAG_NAME == "test"`},
+		{name: "Has", expr: `!has(outputs.MISSING)`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := EvalConditions(ctx, []Condition{{Expr: tt.expr}})
+			require.Equal(t, tt.wantErr, err != nil, err)
+			if err != nil {
+				require.ErrorIs(t, err, ErrConditionNotMet)
+			}
+		})
+	}
+
+	require.ErrorIs(t, Condition{Expr: `params.ENV ==`}.Validate(), errInvalidExpr)
+	require.ErrorIs(t, Condition{Expr: `params.ENV`}.Validate(), errExprMustBeBool)
+}
+
+func TestCondition_Combinators(t *testing.T) {
+	met := Condition{Condition: "1", Expected: "1"}
+	notMet := Condition{Condition: "1", Expected: "2"}
+	invalid := Condition{Condition: "1", Expected: "cmp:> x"}
+
+	tests := []struct {
+		name      string
+		condition Condition
+		wantErr   bool
+	}{
+		{name: "AllOf", condition: Condition{AllOf: []Condition{met, met}}},
+		{name: "AllOfNotMet", condition: Condition{AllOf: []Condition{met, notMet}}, wantErr: true},
+		{name: "AnyOf", condition: Condition{AnyOf: []Condition{notMet, met}}},
+		{name: "AnyOfNotMet", condition: Condition{AnyOf: []Condition{notMet, notMet}}, wantErr: true},
+		{name: "Not", condition: Condition{Not: &notMet}},
+		{name: "NotMet", condition: Condition{Not: &met}, wantErr: true},
+		{
+			// (met AND notMet) OR (NOT notMet)
+			name: "Nested",
+			condition: Condition{AnyOf: []Condition{
+				{AllOf: []Condition{met, notMet}},
+				{Not: &notMet},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := EvalConditions(context.Background(), []Condition{tt.condition})
+			require.Equal(t, tt.wantErr, err != nil, err)
+			if err != nil {
+				require.ErrorIs(t, err, ErrConditionNotMet)
+			}
+		})
+	}
+
+	t.Run("NotWithEvalError", func(t *testing.T) {
+		err := EvalConditions(context.Background(), []Condition{{Not: &invalid}})
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrConditionNotMet)
+	})
+	t.Run("Validate", func(t *testing.T) {
+		require.NoError(t, Condition{AnyOf: []Condition{met, {Not: &notMet}}}.Validate())
+		require.ErrorIs(t, Condition{AllOf: []Condition{met, invalid}}.Validate(), errInvalidComparison)
+	})
+}
+
+func TestCondition_EvalAt(t *testing.T) {
+	// Monday 23:00
+	at := time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC)
+	night := Condition{TimeWindow: "22:00-06:00"}
+	weekend := Condition{Weekdays: []string{"sat", "sun"}}
+	command := Condition{Command: "true"}
+
+	tests := []struct {
+		name      string
+		condition Condition
+		known     bool
+		wantErr   bool
+	}{
+		{name: "TimeWindow", condition: night, known: true},
+		{name: "Weekdays", condition: weekend, known: true, wantErr: true},
+		{name: "Command", condition: command},
+		{name: "AllOfNotMet", condition: Condition{AllOf: []Condition{command, weekend}}, known: true, wantErr: true},
+		{name: "AllOfUnknown", condition: Condition{AllOf: []Condition{command, night}}},
+		{name: "AnyOfMet", condition: Condition{AnyOf: []Condition{command, night}}, known: true},
+		{name: "AnyOfUnknown", condition: Condition{AnyOf: []Condition{command, weekend}}},
+		{name: "Not", condition: Condition{Not: &weekend}, known: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			known, err := tt.condition.EvalAt(at)
+			require.Equal(t, tt.known, known)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			if err != nil {
+				require.ErrorIs(t, err, ErrConditionNotMet)
+			}
+		})
+	}
+}
@@ -13,4 +13,22 @@ const (
 	EnvKeyDAGName          = "DAG_NAME"
 	EnvKeyDAGStepName      = "DAG_STEP_NAME"
 	EnvKeyDAGStepLogPath   = "DAG_STEP_LOG_PATH"
+	EnvKeyArtifactsDir     = "DAG_ARTIFACTS_DIR"
+	EnvKeyLabelsFile       = "DAG_LABELS_FILE"
+	EnvKeyParentRequestID  = "DAG_PARENT_REQUEST_ID"
+	EnvKeyFailedSteps      = "DAG_FAILED_STEPS"
+	EnvKeyFirstError       = "DAG_FIRST_ERROR"
+	EnvKeyRunURL           = "DAG_RUN_URL"
+	EnvKeyDAGLogPath       = "DAG_LOG_PATH"
+	EnvKeyScheduledTime    = "DAG_SCHEDULED_TIME"
+	EnvKeyExecutionDate    = "DAG_EXECUTION_DATE"
+)
+
+// Prefixes of the environment variables set for each step of the DAG in
+// the lifecycle handlers. The suffix is the step name in upper case with
+// the characters other than letters and digits replaced by underscores.
+const (
+	EnvKeyStepExitCodePrefix = "DAG_STEP_EXIT_CODE_"
+	EnvKeyStepLogPathPrefix  = "DAG_STEP_LOG_PATH_"
+	EnvKeyStepLogURLPrefix   = "DAG_STEP_LOG_URL_"
 )
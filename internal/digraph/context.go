@@ -23,8 +23,20 @@ func (c Context) GetResult(name, requestID string) (*Status, error) {
 	return c.client.GetStatus(c.ctx, name, requestID)
 }
 
+// DAG returns the DAG of the current execution.
+func (c Context) DAG() *DAG {
+	return c.dag
+}
+
+// RequestID returns the request ID of the current DAG execution.
+func (c Context) RequestID() string {
+	return c.envs[EnvKeyRequestID]
+}
+
+// AllEnvs returns the environment of the processes of the steps. The
+// variables of the host are filtered by the policy of SetHostEnvPolicy.
 func (c Context) AllEnvs() []string {
-	envs := os.Environ()
+	envs := filterHostEnv(os.Environ(), c.dag)
 	envs = append(envs, c.dag.Env...)
 	for k, v := range c.envs {
 		envs = append(envs, k+"="+v)
@@ -57,6 +69,7 @@ func NewContext(ctx context.Context, dag *DAG, client DBClient, requestID, logFi
 		client: client,
 		envs: map[string]string{
 			EnvKeySchedulerLogPath: logFile,
+			EnvKeyDAGLogPath:       logFile,
 			EnvKeyRequestID:        requestID,
 			EnvKeyDAGName:          dag.Name,
 		},
@@ -14,6 +14,30 @@ type StepContext struct {
 	outputVariables *SyncMap
 	step            Step
 	envs            map[string]string
+	result          *RunResult
+}
+
+// RunResult is the results of the steps of the run exposed to the templates
+// of the lifecycle handlers, e.g. the body of an http handler.
+type RunResult struct {
+	DAG         string
+	RequestID   string
+	FailedSteps []string
+	FirstError  string
+	// URL is the URL of the DAG in the Web UI.
+	URL   string
+	Steps []StepResult
+}
+
+// StepResult is the result of a step of the run.
+type StepResult struct {
+	Name     string
+	Status   string
+	ExitCode int
+	Error    string
+	Log      string
+	// LogURL is the URL of the log of the step in the Web UI.
+	LogURL string
 }
 
 func NewStepContext(ctx context.Context, step Step) StepContext {
@@ -52,12 +76,7 @@ func (c StepContext) LoadOutputVariables(vars *SyncMap) {
 }
 
 func (c StepContext) MailerConfig() (mailer.Config, error) {
-	return EvalStringFields(c, mailer.Config{
-		Host:     c.dag.SMTP.Host,
-		Port:     c.dag.SMTP.Port,
-		Username: c.dag.SMTP.Username,
-		Password: c.dag.SMTP.Password,
-	})
+	return EvalStringFields(c, c.dag.SMTP.MailerConfig())
 }
 
 func (c StepContext) EvalString(s string, opts ...cmdutil.EvalOption) (string, error) {
@@ -86,6 +105,25 @@ func (c StepContext) WithEnv(key, value string) StepContext {
 	return c
 }
 
+// WithRunResult sets the results of the run for the lifecycle handlers.
+func (c StepContext) WithRunResult(result *RunResult) StepContext {
+	c.result = result
+	return c
+}
+
+// RunResult returns the results of the run. Outside of the lifecycle
+// handlers, only the DAG and the request ID are set.
+func (c StepContext) RunResult() *RunResult {
+	if c.result != nil {
+		return c.result
+	}
+	result := &RunResult{RequestID: c.RequestID()}
+	if c.dag != nil {
+		result.DAG = c.dag.Name
+	}
+	return result
+}
+
 func WithStepContext(ctx context.Context, stepContext StepContext) context.Context {
 	return context.WithValue(ctx, stepCtxKey{}, stepContext)
 }
@@ -8,6 +8,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dagu-org/dagu/internal/mailer"
+	"github.com/dagu-org/dagu/internal/namespace"
 	"github.com/robfig/cron/v3"
 )
 
@@ -26,6 +28,9 @@ type DAG struct {
 	Group string `json:"Group"`
 	// Name is the name of the DAG. The default is the filename without the extension.
 	Name string `json:"Name"`
+	// Namespace is the namespace of the DAG, i.e. the subdirectory of the
+	// DAG directory it's in. It's empty for the DAGs in the root namespace.
+	Namespace string `json:"Namespace,omitempty"`
 	// Dotenv is the path to the dotenv file. This is optional.
 	Dotenv []string `json:"Dotenv"`
 	// Tags contains the list of tags for the DAG. This is optional.
@@ -51,6 +56,8 @@ type DAG struct {
 	Steps []Step `json:"Steps"`
 	// HandlerOn contains the steps to be executed on different events.
 	HandlerOn HandlerOn `json:"HandlerOn"`
+	// Stages contains the named phases grouping the steps in the DAG.
+	Stages []Stage `json:"Stages,omitempty"`
 	// Preconditions contains the conditions to be met before running the DAG.
 	Preconditions []Condition `json:"Preconditions"`
 	// SMTP contains the SMTP configuration.
@@ -61,18 +68,45 @@ type DAG struct {
 	InfoMail *MailConfig `json:"InfoMail"`
 	// MailOn contains the conditions to send mail.
 	MailOn *MailOn `json:"MailOn"`
+	// Notify contains the notifiers to send the result of the run.
+	Notify []Notify `json:"Notify,omitempty"`
+	// Callbacks contains the webhooks called when the run completes.
+	Callbacks []Callback `json:"Callbacks,omitempty"`
+	// Hooks contains the scripts run at the hook points of the run.
+	Hooks *Hooks `json:"Hooks,omitempty"`
+	// Container is the container started at the start of the run and
+	// shared by the docker steps. It's removed at the end of the run.
+	Container *Container `json:"Container,omitempty"`
+	// Services contains the auxiliary containers started before the first
+	// step and removed at the end of the run.
+	Services []Service `json:"Services,omitempty"`
 	// Timeout specifies the maximum execution time of the DAG task.
 	Timeout time.Duration `json:"Timeout"`
 	// Delay is the delay before starting the DAG.
 	Delay time.Duration `json:"Delay"`
 	// RestartWait is the time to wait before restarting the DAG.
 	RestartWait time.Duration `json:"RestartWait"`
+	// SubstitutionTimeout is the timeout of each command in backticks. The
+	// default timeout is used if it's zero.
+	SubstitutionTimeout time.Duration `json:"SubstitutionTimeout,omitempty"`
 	// MaxActiveRuns specifies the maximum concurrent steps to run in an execution.
 	MaxActiveRuns int `json:"MaxActiveRuns"`
+	// MaxFailedSteps is the number of steps allowed to fail without failing
+	// the DAG. Any failure fails the DAG if it's zero.
+	MaxFailedSteps int `json:"MaxFailedSteps,omitempty"`
+	// MaxFailedStepsPercent is the percentage of steps allowed to fail
+	// without failing the DAG. It's ignored if it's zero.
+	MaxFailedStepsPercent int `json:"MaxFailedStepsPercent,omitempty"`
+	// MaxRunDuration is the hard deadline of the run. The agent stops the
+	// run when it's exceeded, however the run was started.
+	MaxRunDuration time.Duration `json:"MaxRunDuration,omitempty"`
 	// MaxCleanUpTime is the maximum time to wait for cleanup when the DAG is stopped.
 	MaxCleanUpTime time.Duration `json:"MaxCleanUpTime"`
 	// HistRetentionDays is the number of days to keep the history.
 	HistRetentionDays int `json:"HistRetentionDays"`
+
+	// dotenvKeys is the names of the variables loaded from the dotenv file.
+	dotenvKeys []string
 }
 
 // Schedule contains the cron expression and the parsed cron schedule.
@@ -83,12 +117,23 @@ type Schedule struct {
 	Parsed cron.Schedule `json:"-"`
 }
 
+// Stage is a named phase of the DAG grouping the steps with the same Stage.
+type Stage struct {
+	// Name is the name of the stage.
+	Name string `json:"Name"`
+	// HandlerOn contains the steps to be executed when all the steps in the
+	// stage have finished.
+	HandlerOn HandlerOn `json:"HandlerOn"`
+}
+
 // HandlerOn contains the steps to be executed on different events in the DAG.
 type HandlerOn struct {
 	Failure *Step `json:"Failure"`
 	Success *Step `json:"Success"`
 	Cancel  *Step `json:"Cancel"`
 	Exit    *Step `json:"Exit"`
+	// Timeout is executed when the run is canceled by the timeout.
+	Timeout *Step `json:"Timeout,omitempty"`
 }
 
 // MailOn contains the conditions to send mail.
@@ -97,12 +142,141 @@ type MailOn struct {
 	Success bool `json:"Success"`
 }
 
+// Notify values for the status of the run to notify on.
+const (
+	NotifyOnSuccess = "success"
+	NotifyOnFailure = "failure"
+	NotifyOnCancel  = "cancel"
+)
+
+// Notify is a notifier of the result of the run. The notifiers are
+// provided by the plugins.
+type Notify struct {
+	// Type is the type of the notifier plugin.
+	Type string `json:"Type"`
+	// On is the status of the run to notify on. It's failure by default.
+	On []string `json:"On"`
+	// Config is the configuration passed to the notifier.
+	Config map[string]any `json:"Config,omitempty"`
+}
+
+// Callback is a webhook of the DAG called when the run completes. Unlike
+// the notifiers, it's defined in the DAG and needs no plugin.
+type Callback struct {
+	// URL is the URL of the webhook. It's a template of the notification.
+	URL string `json:"URL"`
+	// Method is the HTTP method. It's POST by default.
+	Method string `json:"Method,omitempty"`
+	// Headers is the templates of the HTTP headers.
+	Headers map[string]string `json:"Headers,omitempty"`
+	// Payload is the template of the request body. The notification is sent
+	// as JSON if it's empty.
+	Payload string `json:"Payload,omitempty"`
+	// Events is the statuses of the run to call the webhook on. It's all the
+	// statuses by default.
+	Events []string `json:"Events"`
+	// Retries is the number of the retries when the webhook fails.
+	Retries int `json:"Retries"`
+	// RetryInterval is the interval before the first retry. It doubles
+	// after each retry.
+	RetryInterval time.Duration `json:"RetryInterval"`
+}
+
+// Hooks contains the Starlark scripts run at the hook points of the run.
+type Hooks struct {
+	// BeforeRun is run before the first step. The run fails if it fails.
+	BeforeRun string `json:"BeforeRun,omitempty"`
+	// BeforeStep is run before each step. It can skip or fail the step.
+	BeforeStep string `json:"BeforeStep,omitempty"`
+	// AfterStep is run after each step. The step fails if it fails.
+	AfterStep string `json:"AfterStep,omitempty"`
+}
+
+// Container contains the configuration of the container shared by the
+// docker steps of a run.
+type Container struct {
+	// Image is the image of the container.
+	Image string `json:"Image"`
+	// Pull is true if the image is pulled before the container is started.
+	Pull bool `json:"Pull"`
+	// Env is the environment variables of the container (KEY=value).
+	Env []string `json:"Env,omitempty"`
+	// Volumes is the volumes mounted to the container (host:container).
+	Volumes []string `json:"Volumes,omitempty"`
+	// WorkingDir is the working directory of the commands.
+	WorkingDir string `json:"WorkingDir,omitempty"`
+	// User is the user who runs the commands.
+	User string `json:"User,omitempty"`
+}
+
+// Service contains the configuration of an auxiliary container, e.g. a
+// database, run during the run.
+type Service struct {
+	// Name is the name of the service. The addresses of the service are
+	// set to the environment variables prefixed with the name.
+	Name string `json:"Name"`
+	// Image is the image of the container.
+	Image string `json:"Image"`
+	// Pull is true if the image is pulled before the container is started.
+	Pull bool `json:"Pull"`
+	// Env is the environment variables of the container (KEY=value).
+	Env []string `json:"Env,omitempty"`
+	// Command is the command of the container. The default command of the
+	// image is used if it's empty.
+	Command []string `json:"Command,omitempty"`
+	// Ports is the ports of the container published to the host, e.g. 5432
+	// or 53/udp.
+	Ports []string `json:"Ports,omitempty"`
+}
+
 // SMTPConfig contains the SMTP configuration.
 type SMTPConfig struct {
 	Host     string `json:"Host"`
 	Port     string `json:"Port"`
 	Username string `json:"Username"`
 	Password string `json:"Password"`
+	// TLS is the TLS mode: auto, starttls, tls or none.
+	TLS string `json:"TLS,omitempty"`
+	// Auth is the authentication mechanism: plain, login or xoauth2.
+	Auth string `json:"Auth,omitempty"`
+	// OAuth2 is the token of the XOAUTH2 authentication.
+	OAuth2 *SMTPOAuth2 `json:"OAuth2,omitempty"`
+	// Timeout is the timeout to connect to the server and send an email.
+	Timeout time.Duration `json:"Timeout,omitempty"`
+}
+
+// SMTPOAuth2 contains the access token of the XOAUTH2 authentication, or
+// the client credentials and the refresh token to get one.
+type SMTPOAuth2 struct {
+	AccessToken  string `json:"AccessToken,omitempty"`
+	TokenURL     string `json:"TokenURL,omitempty"`
+	ClientID     string `json:"ClientID,omitempty"`
+	ClientSecret string `json:"ClientSecret,omitempty"`
+	RefreshToken string `json:"RefreshToken,omitempty"`
+}
+
+// MailerConfig returns the config of the mailer. The strings are not
+// evaluated.
+func (c *SMTPConfig) MailerConfig() mailer.Config {
+	cfg := mailer.Config{
+		Host:     c.Host,
+		Port:     c.Port,
+		Username: c.Username,
+		Password: c.Password,
+		TLS:      c.TLS,
+		Auth:     c.Auth,
+		Timeout:  c.Timeout,
+	}
+	if c.OAuth2 != nil {
+		cfg.OAuth2 = mailer.OAuth2Config{
+			AccessToken:  c.OAuth2.AccessToken,
+			TokenURL:     c.OAuth2.TokenURL,
+			ClientID:     c.OAuth2.ClientID,
+			ClientSecret: c.OAuth2.ClientSecret,
+			RefreshToken: c.OAuth2.RefreshToken,
+		}
+	}
+	return cfg
 }
 
 // MailConfig contains the mail configuration.
@@ -121,6 +295,7 @@ const (
 	HandlerOnFailure HandlerType = "onFailure"
 	HandlerOnCancel  HandlerType = "onCancel"
 	HandlerOnExit    HandlerType = "onExit"
+	HandlerOnTimeout HandlerType = "onTimeout"
 )
 
 func (h HandlerType) String() string {
@@ -137,6 +312,7 @@ var handlerMapping = map[string]HandlerType{
 	"onFailure": HandlerOnFailure,
 	"onCancel":  HandlerOnCancel,
 	"onExit":    HandlerOnExit,
+	"onTimeout": HandlerOnTimeout,
 }
 
 // HasTag checks if the DAG has the given tag.
@@ -149,6 +325,14 @@ func (d *DAG) HasTag(tag string) bool {
 	return false
 }
 
+// ID returns the ID of the DAG used in the API and the suspend flags: the
+// file name without the extension, prefixed with the namespace if any,
+// e.g. "team-a/etl".
+func (d *DAG) ID() string {
+	name := strings.TrimSuffix(filepath.Base(d.Location), filepath.Ext(d.Location))
+	return namespace.Join(d.Namespace, name)
+}
+
 // SockAddr returns the unix socket address for the DAG.
 // The address is used to communicate with the agent process.
 func (d *DAG) SockAddr() string {
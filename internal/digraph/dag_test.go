@@ -4,6 +4,7 @@ import (
 	"context"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/dagu-org/dagu/internal/fileutil"
 	"github.com/stretchr/testify/require"
@@ -42,3 +43,42 @@ func TestDAG_SockAddr(t *testing.T) {
 		)
 	})
 }
+
+func TestSchedule(t *testing.T) {
+	schedules, err := buildScheduler([]string{
+		"0 9 * * 1-5",
+		"CRON_TZ=Asia/Tokyo 30 9 * * *",
+		"0 0 29 2 *",
+	})
+	require.NoError(t, err)
+	weekdays, tokyo, leapDay := schedules[0], schedules[1], schedules[2]
+	// Saturday.
+	now := time.Date(2024, 1, 6, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Cron", func(t *testing.T) {
+		require.Equal(t, "0 9 * * 1-5", weekdays.Cron())
+		require.Equal(t, "30 9 * * *", tokyo.Cron())
+	})
+	t.Run("Timezone", func(t *testing.T) {
+		require.Empty(t, weekdays.Timezone())
+		require.Equal(t, "Asia/Tokyo", tokyo.Timezone())
+	})
+	t.Run("TimeLocation", func(t *testing.T) {
+		require.Nil(t, (&DAG{Schedule: []Schedule{weekdays}}).TimeLocation())
+		dag := &DAG{Schedule: []Schedule{weekdays}, StopSchedule: []Schedule{tokyo}}
+		require.Equal(t, "Asia/Tokyo", dag.TimeLocation().String())
+	})
+	t.Run("Next", func(t *testing.T) {
+		require.Equal(t, time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC), weekdays.Next(now))
+		require.True(t, time.Date(2024, 1, 7, 0, 30, 0, 0, time.UTC).Equal(tokyo.Next(now)))
+		require.True(t, Schedule{Expression: "0 9 * * *"}.Next(now).IsZero())
+	})
+	t.Run("Prev", func(t *testing.T) {
+		require.Equal(t, time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC), weekdays.Prev(now))
+		require.True(t, time.Date(2024, 1, 6, 0, 30, 0, 0, time.UTC).Equal(tokyo.Prev(now)))
+		require.True(t, time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC).Equal(leapDay.Prev(now)))
+		// A fire time equal to the time is the previous one.
+		fire := time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC)
+		require.Equal(t, fire, weekdays.Prev(fire))
+	})
+}
@@ -24,6 +24,21 @@ func (e *LoadError) Unwrap() error {
 	return e.Err
 }
 
+// Is reports that the error is an error in the definition of the DAG.
+func (e *LoadError) Is(target error) bool {
+	return target == ErrInvalidSpec
+}
+
+// ErrInvalidSpec matches the errors in the definition of a DAG, e.g. a
+// syntax error of the YAML or an invalid field.
+var ErrInvalidSpec = errors.New("invalid DAG spec")
+
+// InvalidSpec marks the error as an error in the definition of the DAG
+// keeping its message.
+func InvalidSpec(err error) error {
+	return &errorList{err}
+}
+
 // wrapError wraps an error with field context
 func wrapError(field string, value any, err error) error {
 	return &LoadError{
@@ -46,6 +61,8 @@ var (
 	errStepCommandIsRequired               = errors.New("step command is required")
 	errStepCommandIsEmpty                  = errors.New("step command is empty")
 	errStepCommandMustBeArrayOrString      = errors.New("step command must be an array of strings or a string")
+	errStepCommandListIsEmpty              = errors.New("step commandList is empty")
+	errStepCommandAndCommandList           = errors.New("step command and commandList cannot be used together")
 	errInvalidParamValue                   = errors.New("invalid parameter value")
 	errCallFunctionNotFound                = errors.New("call must specify a functions that exists")
 	errNumberOfParamsMismatch              = errors.New("the number of parameters defined in the function does not match the number of parameters given")
@@ -63,10 +80,62 @@ var (
 	errPreconditionKeyMustBeString         = errors.New("precondition key must be a string")
 	errPreconditionValueMustBeString       = errors.New("precondition value must be a string")
 	errPreconditionHasInvalidKey           = errors.New("precondition has invalid key")
+	errPreconditionWeekdaysMustBeArray     = errors.New("precondition weekdays must be a string or an array of strings")
+	errInvalidTimeWindow                   = errors.New("time window must be in the HH:MM-HH:MM format")
+	errInvalidWeekday                      = errors.New("invalid day of the week")
+	errInvalidExpr                         = errors.New("invalid expression")
+	errExprMustBeBool                      = errors.New("expression must return a boolean")
+	errInvalidComparison                   = errors.New("the operand of the comparison must be a number")
+	errInvalidComparisonOperator           = errors.New("the comparison must start with ==, !=, >, >=, < or <=")
+	errPreconditionGroupMustBeArray        = errors.New("precondition allOf and anyOf must be an array of conditions")
+	errPreconditionNotIsEmpty              = errors.New("precondition not must have a condition")
 	errContinueOnOutputMustBeStringOrArray = errors.New("continueOn.Output must be a string or an array of strings")
 	errContinueOnExitCodeMustBeIntOrArray  = errors.New("continueOn.ExitCode must be an int or an array of ints")
 	errDependsMustBeStringOrArray          = errors.New("depends must be a string or an array of strings")
+	errDependsStepRequired                 = errors.New("depends must have a step name")
+	errDependsAnyOfMustBeArray             = errors.New("depends.anyOf must be an array of step names")
+	errInvalidDependsCondition             = errors.New("depends.on must be one of success, failure or always")
+	errDependsStepNotFound                 = errors.New("depends must refer to an existing step")
+	errDependsCycle                        = errors.New("depends has a cycle")
 	errStepsMustBeArrayOrMap               = errors.New("steps must be an array or a map")
+	errArtifactsMustBeStringOrArray        = errors.New("artifacts must be a string or an array of strings")
+	errDuplicateArtifact                   = errors.New("duplicate artifact name")
+	errInvalidArtifactName                 = errors.New("artifact name must not contain a path separator")
+	errCacheInputsMustBeStringOrArray      = errors.New("cache inputs must be a string or an array of strings")
+	errStdinMustBeStringOrMap              = errors.New("stdin must be a string or a map with the file key")
+	errExpandRequiresOutput                = errors.New("expand requires the output field to read the list of items")
+	errExpandDependsNotAllowed             = errors.New("expand template must not have depends")
+	errInvalidForeach                      = errors.New("foreach must be a list, a range (e.g. 1..10) or a string")
+	errForeachMaxParallelMustBeInt         = errors.New("foreach.maxParallel must be a non-negative integer")
+	errForeachWithExpand                   = errors.New("foreach cannot be used with expand")
+	errInvalidParallel                     = errors.New("parallel must be a string or a map with items and maxParallel")
+	errParallelWithForeach                 = errors.New("parallel cannot be used with foreach or expand")
+	errInvalidService                      = errors.New("service must be a boolean or a map with readiness, readinessIntervalSec, readinessTimeoutSec, restartLimit and restartIntervalSec")
+	errServiceWithPolicy                   = errors.New("service cannot be used with retryPolicy, repeatPolicy or parallel")
+	errStageNameRequired                   = errors.New("stage name is required")
+	errDuplicateStage                      = errors.New("duplicate stage name")
+	errStepGroupNotFound                   = errors.New("step group is not defined in stepGroups")
+	errStepGroupMaxParallelMustBePositive  = errors.New("maxParallel of the step group must be a non-negative integer")
+	errInvalidMaxFailedSteps               = errors.New("maxFailedSteps must be a non-negative number or a percentage (e.g. 10%)")
+	errInvalidMaxRunDuration               = errors.New("maxRunDuration must be a duration (e.g. 2h) or a non-negative number of seconds")
+	errInvalidStepTimeout                  = errors.New("timeoutSec must be a non-negative integer")
+	errNotifyTypeRequired                  = errors.New("notify type is required")
+	errInvalidNotifyOn                     = errors.New("notify on must be success, failure or cancel")
+	errNotifyConfigMustBeMap               = errors.New("notify config must be a map")
+	errCallbackURLRequired                 = errors.New("callback url is required")
+	errHTTPURLRequired                     = errors.New("http url is required")
+	errHTTPWithCommand                     = errors.New("http cannot be used with command, executor or run")
+	errInvalidCallbackEvent                = errors.New("callback events must be success, failure or cancel")
+	errInvalidCallbackRetries              = errors.New("callback retries and retryIntervalSec must be non-negative")
+	errInvalidHook                         = errors.New("invalid hook script")
+	errContainerImageRequired              = errors.New("container image is required")
+	errInvalidServiceName                  = errors.New("service name must start with a letter and contain only letters, digits, '_' and '-'")
+	errDuplicateService                    = errors.New("duplicate service name")
+	errServiceImageRequired                = errors.New("service image is required")
+	errInvalidServicePort                  = errors.New("service port must be a port number with an optional protocol (e.g. 5432 or 53/udp)")
+	errInvalidSMTPTLS                      = errors.New("smtp tls must be one of auto, starttls, tls or none")
+	errInvalidSMTPAuth                     = errors.New("smtp auth must be one of plain, login or xoauth2")
+	errInvalidSMTPTimeout                  = errors.New("smtp timeoutSec must be positive")
 )
 
 // errorList is just a list of errors.
@@ -89,3 +158,13 @@ func (e *errorList) Error() string {
 	}
 	return strings.Join(errStrings, "; ")
 }
+
+// Unwrap returns the errors in the list.
+func (e *errorList) Unwrap() []error {
+	return *e
+}
+
+// Is reports that the error is an error in the definition of the DAG.
+func (e *errorList) Is(target error) bool {
+	return target == ErrInvalidSpec
+}
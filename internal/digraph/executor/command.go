@@ -12,15 +12,21 @@ import (
 	"github.com/dagu-org/dagu/internal/cmdutil"
 	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/dagu-org/dagu/internal/fileutil"
+	"github.com/dagu-org/dagu/internal/logger"
 )
 
 var _ Executor = (*commandExecutor)(nil)
 var _ ExitCoder = (*commandExecutor)(nil)
+var _ ResourceUsageReporter = (*commandExecutor)(nil)
+var _ StdinSetter = (*commandExecutor)(nil)
 
 type commandExecutor struct {
 	cmd      *exec.Cmd
 	lock     sync.Mutex
 	exitCode int
+	// limits is the priority and the resource limits of the processes. It's
+	// nil if none is set.
+	limits *processLimits
 }
 
 // ExitCode implements ExitCoder.
@@ -28,16 +34,38 @@ func (e *commandExecutor) ExitCode() int {
 	return e.exitCode
 }
 
-func (e *commandExecutor) Run(_ context.Context) error {
-	e.lock.Lock()
-	err := e.cmd.Start()
-	e.lock.Unlock()
-	if err != nil {
-		e.exitCode = exitCodeFromError(err)
-		return err
+// ResourceUsage implements ResourceUsageReporter.
+func (e *commandExecutor) ResourceUsage() *ResourceUsage {
+	return processUsage(e.cmd)
+}
+
+func (e *commandExecutor) Run(ctx context.Context) error {
+	if e.limits == nil {
+		if err := startProcess(ctx, &e.lock, e.cmd); err != nil {
+			e.exitCode = exitCodeFromError(err)
+			return err
+		}
+		return nil
+	}
+
+	var cg *cgroup
+	if e.limits.needsCgroup() {
+		var err error
+		if cg, err = newCgroup(e.limits, e.cmd); err != nil {
+			e.exitCode = 1
+			return err
+		}
+		defer func() {
+			if err := cg.remove(); err != nil {
+				logger.Warn(ctx, "Failed to remove the cgroup of the step", "err", err)
+			}
+		}()
 	}
-	if err := e.cmd.Wait(); err != nil {
+	if err := startProcessWith(ctx, &e.lock, e.cmd, e.limits.applyPriority); err != nil {
 		e.exitCode = exitCodeFromError(err)
+		if cg != nil && cg.oomKilled() {
+			return fmt.Errorf("%w: the memory limit %s was exceeded", err, e.limits.MemoryMax)
+		}
 		return err
 	}
 	return nil
@@ -51,13 +79,13 @@ func (e *commandExecutor) SetStderr(out io.Writer) {
 	e.cmd.Stderr = out
 }
 
+// SetStdin implements StdinSetter.
+func (e *commandExecutor) SetStdin(in io.Reader) {
+	e.cmd.Stdin = in
+}
+
 func (e *commandExecutor) Kill(sig os.Signal) error {
-	e.lock.Lock()
-	defer e.lock.Unlock()
-	if e.cmd == nil || e.cmd.Process == nil {
-		return nil
-	}
-	return syscall.Kill(-e.cmd.Process.Pid, sig.(syscall.Signal))
+	return killProcess(&e.lock, e.cmd, sig)
 }
 
 func init() {
@@ -85,7 +113,7 @@ func newCommand(ctx context.Context, step digraph.Step) (Executor, error) {
 
 	stepContext := digraph.GetStepContext(ctx)
 
-	cmd, err := createCommand(ctx, step)
+	cmd, err := createCommand(step)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create command: %w", err)
 	}
@@ -97,25 +125,32 @@ func newCommand(ctx context.Context, step digraph.Step) (Executor, error) {
 		Pgid:    0,
 	}
 
-	return &commandExecutor{cmd: cmd}, nil
+	limits, err := parseProcessLimits(step.ExecutorConfig.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commandExecutor{cmd: cmd, limits: limits}, nil
 }
 
-func createCommand(ctx context.Context, step digraph.Step) (*exec.Cmd, error) {
+// createCommand creates the command of the step. The process is killed
+// when the context of Run is cancelled.
+func createCommand(step digraph.Step) (*exec.Cmd, error) {
 	shellCommand := cmdutil.GetShellCommand(step.Shell)
 	shellCmdArgs := step.ShellCmdArgs
 	if shellCommand == "" || shellCmdArgs == "" {
-		return createDirectCommand(ctx, step, step.Args), nil
+		return createDirectCommand(step, step.Args), nil
 	}
-	return createShellCommand(ctx, shellCommand, shellCmdArgs), nil
+	return createShellCommand(shellCommand, shellCmdArgs), nil
 }
 
 // createDirectCommand creates a command that runs directly without a shell
-func createDirectCommand(ctx context.Context, step digraph.Step, args []string) *exec.Cmd {
+func createDirectCommand(step digraph.Step, args []string) *exec.Cmd {
 	// nolint: gosec
-	return exec.CommandContext(ctx, step.Command, args...)
+	return exec.Command(step.Command, args...)
 }
 
 // createShellCommand creates a command that runs through a shell
-func createShellCommand(ctx context.Context, shell, shellCmd string) *exec.Cmd {
-	return exec.CommandContext(ctx, shell, "-c", shellCmd)
+func createShellCommand(shell, shellCmd string) *exec.Cmd {
+	return exec.Command(shell, "-c", shellCmd)
 }
@@ -2,18 +2,27 @@ package executor
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/client"
 	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/docker/go-connections/nat"
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
 )
 
 // Docker executor runs a command in a Docker container.
@@ -40,20 +49,52 @@ steps:
        image: alpine:latest
        autoRemove: true
    command: echo "Hello from new container"
+
+ - name: reuse-named
+   executor:
+     type: docker
+     config:
+       image: alpine:latest
+       containerName: <container-name>
+       reuse: true       # created if missing, kept for the next steps
+   command: echo "Hello from reused container"
+
+ - name: start-service
+   executor:
+     type: docker
+     config:
+       image: postgres:16
+       waitHealthy: true       # succeeds when the healthcheck passes
+       waitHealthyTimeoutSec: 60
+
+ - name: cleanup
+   executor:
+     type: docker
+     config:
+       containerName: <container-name>
+       action: remove
+
+ - name: exec-in-shared
+   executor: docker  # runs in the container of the DAG
+   command: echo "Hello from the shared container"
 ```
 */
 
 var _ Executor = (*docker)(nil)
+var _ ResourceUsageReporter = (*docker)(nil)
 
 type docker struct {
+	stopper
 	image         string
 	containerName string
 	pull          bool
 	autoRemove    bool
 	step          digraph.Step
 	stdout        io.Writer
-	context       context.Context
-	cancel        func()
+	// lock protects cli and containerID used to signal the container.
+	lock        sync.Mutex
+	cli         *client.Client
+	containerID string
 	// containerConfig is the configuration for new container creation
 	// See https://pkg.go.dev/github.com/docker/docker/api/types/container#Config
 	containerConfig *container.Config
@@ -63,6 +104,241 @@ type docker struct {
 	// execConfig is configuration for exec in existing container
 	// See https://pkg.go.dev/github.com/docker/docker/api/types/container#ExecOptions
 	execConfig container.ExecOptions
+	// reuse is true if the container named containerName is reused when it
+	// exists, or created from the image and kept otherwise.
+	reuse bool
+	// action is the action of the step, e.g. removing the container.
+	action string
+	// waitHealthy is true if the step finishes when the healthcheck of the
+	// container reports healthy. The container is kept running.
+	waitHealthy        bool
+	waitHealthyTimeout time.Duration
+	// usage is the resource usage of the container created by the last run.
+	usage *ResourceUsage
+}
+
+// dockerActionRemove is the action to remove the container named
+// containerName.
+const dockerActionRemove = "remove"
+
+// defaultWaitHealthyTimeout is the default time to wait for the container
+// to become healthy.
+const defaultWaitHealthyTimeout = 5 * time.Minute
+
+// healthCheckInterval is the interval to check the health of the container.
+const healthCheckInterval = time.Second
+
+// keepAliveEntrypoint keeps the containers running for the commands to be
+// executed in them.
+var keepAliveEntrypoint = []string{"tail", "-f", "/dev/null"}
+
+// SharedContainer is the container started at the start of a run and
+// shared by the docker steps that specify neither an image nor a container.
+type SharedContainer struct {
+	cli *client.Client
+	id  string
+}
+
+// StartSharedContainer pulls the image if necessary and starts the
+// container. The container keeps running until it's removed.
+func StartSharedContainer(ctx context.Context, cfg digraph.Container) (*SharedContainer, error) {
+	cli, err := client.NewClientWithOpts(
+		client.FromEnv, client.WithAPIVersionNegotiation(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Pull {
+		reader, err := cli.ImagePull(ctx, cfg.Image, image.PullOptions{})
+		if err != nil {
+			_ = cli.Close()
+			return nil, fmt.Errorf("failed to pull image %s: %w", cfg.Image, err)
+		}
+		// Wait for the pull to finish.
+		_, err = io.Copy(io.Discard, reader)
+		_ = reader.Close()
+		if err != nil {
+			_ = cli.Close()
+			return nil, err
+		}
+	}
+
+	resp, err := cli.ContainerCreate(ctx, &container.Config{
+		Image:      cfg.Image,
+		Env:        cfg.Env,
+		WorkingDir: cfg.WorkingDir,
+		User:       cfg.User,
+		// Keep the container running for the steps to exec into it.
+		Entrypoint: keepAliveEntrypoint,
+	}, &container.HostConfig{
+		Binds: cfg.Volumes,
+	}, nil, nil, "")
+	if err != nil {
+		_ = cli.Close()
+		return nil, fmt.Errorf("failed to create container: %w", err)
+	}
+
+	c := &SharedContainer{cli: cli, id: resp.ID}
+	if err := cli.ContainerStart(ctx, resp.ID, container.StartOptions{}); err != nil {
+		c.Remove(ctx)
+		return nil, fmt.Errorf("failed to start container: %w", err)
+	}
+	return c, nil
+}
+
+// Remove removes the container even if the context is cancelled.
+func (c *SharedContainer) Remove(ctx context.Context) {
+	ctx = context.WithoutCancel(ctx)
+	if err := c.cli.ContainerRemove(ctx, c.id, container.RemoveOptions{
+		Force: true,
+	}); err != nil {
+		logger.Error(ctx, "docker executor: remove shared container", "err", err)
+	}
+	_ = c.cli.Close()
+}
+
+// ServiceContainer is an auxiliary container, e.g. a database, run during a
+// run. Its ports are published to the loopback address of the host.
+type ServiceContainer struct {
+	cli  *client.Client
+	id   string
+	name string
+	// hostPorts is the host ports the ports of the container are
+	// published to.
+	hostPorts map[string]string
+	ports     []string
+}
+
+// serviceHealthyTimeout is the time to wait for the service container to
+// become healthy if it has a healthcheck.
+const serviceHealthyTimeout = 5 * time.Minute
+
+// StartServiceContainer starts the service container and waits for it to
+// become healthy if the image or the container has a healthcheck.
+func StartServiceContainer(ctx context.Context, svc digraph.Service) (*ServiceContainer, error) {
+	cli, err := client.NewClientWithOpts(
+		client.FromEnv, client.WithAPIVersionNegotiation(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if svc.Pull {
+		reader, err := cli.ImagePull(ctx, svc.Image, image.PullOptions{})
+		if err != nil {
+			_ = cli.Close()
+			return nil, fmt.Errorf("failed to pull image %s: %w", svc.Image, err)
+		}
+		// Wait for the pull to finish.
+		_, err = io.Copy(io.Discard, reader)
+		_ = reader.Close()
+		if err != nil {
+			_ = cli.Close()
+			return nil, err
+		}
+	}
+
+	exposed, bindings, err := nat.ParsePortSpecs(svc.Ports)
+	if err != nil {
+		_ = cli.Close()
+		return nil, fmt.Errorf("invalid ports: %w", err)
+	}
+	for port := range bindings {
+		// Publish the ports to random ports on the loopback address.
+		bindings[port] = []nat.PortBinding{{HostIP: "127.0.0.1"}}
+	}
+
+	resp, err := cli.ContainerCreate(ctx, &container.Config{
+		Image:        svc.Image,
+		Env:          svc.Env,
+		Cmd:          svc.Command,
+		ExposedPorts: exposed,
+	}, &container.HostConfig{
+		PortBindings: bindings,
+	}, nil, nil, "")
+	if err != nil {
+		_ = cli.Close()
+		return nil, fmt.Errorf("failed to create container: %w", err)
+	}
+
+	c := &ServiceContainer{cli: cli, id: resp.ID, name: svc.Name, ports: svc.Ports}
+	if err := c.start(ctx); err != nil {
+		c.Remove(ctx)
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *ServiceContainer) start(ctx context.Context) error {
+	if err := c.cli.ContainerStart(ctx, c.id, container.StartOptions{}); err != nil {
+		return fmt.Errorf("failed to start container: %w", err)
+	}
+	err := waitForHealthy(ctx, c.cli, c.id, serviceHealthyTimeout, io.Discard)
+	if err != nil && !errors.Is(err, errNoHealthcheck) {
+		return err
+	}
+
+	info, err := c.cli.ContainerInspect(ctx, c.id)
+	if err != nil {
+		return fmt.Errorf("failed to inspect container: %w", err)
+	}
+	c.hostPorts = make(map[string]string)
+	for _, p := range c.ports {
+		port := nat.Port(p)
+		if !strings.Contains(p, "/") {
+			port = nat.Port(p + "/tcp")
+		}
+		bindings := info.NetworkSettings.Ports[port]
+		if len(bindings) == 0 {
+			return fmt.Errorf("port %s is not published", p)
+		}
+		c.hostPorts[p] = bindings[0].HostPort
+	}
+	return nil
+}
+
+// Env returns the environment variables of the addresses of the service.
+// For the service named db with the port 5432, DB_HOST is the host and
+// DB_PORT and DB_PORT_5432 are the port on the host.
+func (c *ServiceContainer) Env() map[string]string {
+	prefix := strings.ToUpper(strings.ReplaceAll(c.name, "-", "_"))
+	env := map[string]string{prefix + "_HOST": "127.0.0.1"}
+	for i, p := range c.ports {
+		hostPort := c.hostPorts[p]
+		if i == 0 {
+			env[prefix+"_PORT"] = hostPort
+		}
+		port, _, _ := strings.Cut(p, "/")
+		env[prefix+"_PORT_"+port] = hostPort
+	}
+	return env
+}
+
+// Remove removes the container even if the context is cancelled.
+func (c *ServiceContainer) Remove(ctx context.Context) {
+	ctx = context.WithoutCancel(ctx)
+	if err := c.cli.ContainerRemove(ctx, c.id, container.RemoveOptions{
+		Force:         true,
+		RemoveVolumes: true,
+	}); err != nil {
+		logger.Error(ctx, "docker executor: remove service container", "service", c.name, "err", err)
+	}
+	_ = c.cli.Close()
+}
+
+// WithSharedContainer returns the context with the shared container used by
+// the docker steps created with the context.
+func WithSharedContainer(ctx context.Context, c *SharedContainer) context.Context {
+	return context.WithValue(ctx, sharedContainerKey{}, c)
+}
+
+type sharedContainerKey struct{}
+
+// ResourceUsage implements ResourceUsageReporter. It's the usage of the
+// container created by the step sampled by the docker stats.
+func (e *docker) ResourceUsage() *ResourceUsage {
+	return e.usage
 }
 
 func (e *docker) SetStdout(out io.Writer) {
@@ -73,17 +349,29 @@ func (e *docker) SetStderr(out io.Writer) {
 	e.stdout = out
 }
 
-func (e *docker) Kill(_ os.Signal) error {
-	if e.cancel != nil {
-		e.cancel()
+// Kill sends the signal to the container created by the executor. The run
+// is cancelled if the signal is SIGKILL or it can't be sent, e.g. to the
+// command run in an existing container.
+func (e *docker) Kill(sig os.Signal) error {
+	e.lock.Lock()
+	cli, containerID := e.cli, e.containerID
+	e.lock.Unlock()
+
+	if isForceKill(sig) || cli == nil || containerID == "" {
+		e.stop()
+		return nil
+	}
+	signal := unix.SignalName(sig.(syscall.Signal))
+	if err := cli.ContainerKill(context.Background(), containerID, signal); err != nil {
+		e.stop()
+		return fmt.Errorf("failed to send %s to container %s: %w", signal, containerID, err)
 	}
 	return nil
 }
 
-func (e *docker) Run(_ context.Context) error {
-	ctx, cancelFunc := context.WithCancel(context.Background())
-	e.context = ctx
-	e.cancel = cancelFunc
+func (e *docker) Run(ctx context.Context) error {
+	ctx, cancel := e.start(ctx)
+	defer cancel()
 
 	cli, err := client.NewClientWithOpts(
 		client.FromEnv, client.WithAPIVersionNegotiation(),
@@ -93,21 +381,25 @@ func (e *docker) Run(_ context.Context) error {
 	}
 	defer cli.Close()
 
+	if e.action == dockerActionRemove {
+		return e.removeContainer(ctx, cli)
+	}
+
+	if e.reuse {
+		if err := e.prepareReusedContainer(ctx, cli); err != nil {
+			return err
+		}
+		return e.execInContainer(ctx, cli)
+	}
+
 	// If containerName is set, use exec instead of creating a new container
-	if e.containerName != "" {
+	if e.containerName != "" && !e.waitHealthy {
 		return e.execInContainer(ctx, cli)
 	}
 
 	// New container creation logic
-	if e.pull {
-		reader, err := cli.ImagePull(ctx, e.image, image.PullOptions{})
-		if err != nil {
-			return err
-		}
-		_, err = io.Copy(e.stdout, reader)
-		if err != nil {
-			return err
-		}
+	if err := e.pullImage(ctx, cli); err != nil {
+		return err
 	}
 
 	if e.image != "" {
@@ -116,33 +408,43 @@ func (e *docker) Run(_ context.Context) error {
 
 	e.containerConfig.Cmd = append([]string{e.step.Command}, e.step.Args...)
 
+	// The service container is named containerName to be referred to by
+	// the following steps.
 	resp, err := cli.ContainerCreate(
-		ctx, e.containerConfig, e.hostConfig, nil, nil, "",
+		ctx, e.containerConfig, e.hostConfig, nil, nil, e.containerName,
 	)
 	if err != nil {
 		return err
 	}
 
-	removing := false
-	removeContainer := func() {
-		if !e.autoRemove || removing {
-			return
-		}
-		removing = true
-		if err := cli.ContainerRemove(
-			ctx, resp.ID, container.RemoveOptions{
-				Force: true,
-			},
-		); err != nil {
-			logger.Error(ctx, "docker executor: remove container", "err", err)
-		}
+	var healthy bool
+	if e.autoRemove {
+		defer func() {
+			// The healthy service container is kept running for the
+			// following steps.
+			if healthy {
+				return
+			}
+			// The container is removed even if the run is cancelled.
+			ctx := context.WithoutCancel(ctx)
+			if err := cli.ContainerRemove(
+				ctx, resp.ID, container.RemoveOptions{
+					Force: true,
+				},
+			); err != nil {
+				logger.Error(ctx, "docker executor: remove container", "err", err)
+			}
+		}()
 	}
 
-	defer removeContainer()
-	e.cancel = func() {
-		removeContainer()
-		cancelFunc()
-	}
+	e.lock.Lock()
+	e.cli, e.containerID = cli, resp.ID
+	e.lock.Unlock()
+	defer func() {
+		e.lock.Lock()
+		e.cli, e.containerID = nil, ""
+		e.lock.Unlock()
+	}()
 
 	if err := cli.ContainerStart(
 		ctx, resp.ID, container.StartOptions{},
@@ -150,9 +452,191 @@ func (e *docker) Run(_ context.Context) error {
 		return err
 	}
 
+	// Sample the resource usage while the container is running.
+	statsCtx, stopStats := context.WithCancel(ctx)
+	usage := make(chan *ResourceUsage, 1)
+	go func() {
+		usage <- containerUsage(statsCtx, cli, resp.ID)
+	}()
+	defer func() {
+		stopStats()
+		e.usage = <-usage
+	}()
+
+	// The container is killed when the run is cancelled.
+	stop := context.AfterFunc(ctx, func() {
+		ctx := context.WithoutCancel(ctx)
+		if err := cli.ContainerKill(ctx, resp.ID, "SIGKILL"); err != nil {
+			logger.Error(ctx, "docker executor: kill container", "err", err)
+		}
+	})
+	defer stop()
+
+	if e.waitHealthy {
+		if err := waitForHealthy(ctx, cli, resp.ID, e.waitHealthyTimeout, e.stdout); err != nil {
+			ctx := context.WithoutCancel(ctx)
+			if err := cli.ContainerKill(ctx, resp.ID, "SIGKILL"); err != nil {
+				logger.Error(ctx, "docker executor: kill container", "err", err)
+			}
+			return err
+		}
+		healthy = true
+		return nil
+	}
+
 	return e.attachAndWait(ctx, cli, resp.ID)
 }
 
+// containerUsage reads the stats of the container until the container
+// stops or the context is cancelled, and returns the usage of the last
+// stats with the maximum memory usage.
+func containerUsage(ctx context.Context, cli *client.Client, containerID string) *ResourceUsage {
+	stats, err := cli.ContainerStats(ctx, containerID, true)
+	if err != nil {
+		return nil
+	}
+	defer stats.Body.Close()
+
+	var usage *ResourceUsage
+	dec := json.NewDecoder(stats.Body)
+	for {
+		var s container.StatsResponse
+		if err := dec.Decode(&s); err != nil {
+			return usage
+		}
+		// The stats of the stopped container are empty.
+		if s.CPUStats.CPUUsage.TotalUsage == 0 {
+			continue
+		}
+		if usage == nil {
+			usage = &ResourceUsage{}
+		}
+		usage.UserCPUTime = time.Duration(s.CPUStats.CPUUsage.UsageInUsermode)
+		usage.SystemCPUTime = time.Duration(s.CPUStats.CPUUsage.UsageInKernelmode)
+		usage.MaxRSS = max(usage.MaxRSS, int64(max(s.MemoryStats.MaxUsage, s.MemoryStats.Usage)))
+	}
+}
+
+// errNoHealthcheck is returned when the container to wait for has no
+// healthcheck.
+var errNoHealthcheck = errors.New("container has no healthcheck")
+
+// waitForHealthy writes the logs of the container to out until the
+// healthcheck of the container reports healthy. It returns an error if the
+// container becomes unhealthy, exits or doesn't become healthy within the
+// timeout.
+func waitForHealthy(ctx context.Context, cli *client.Client, containerID string, timeout time.Duration, out io.Writer) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	logs, err := cli.ContainerLogs(
+		ctx, containerID, container.LogsOptions{
+			ShowStdout: true,
+			ShowStderr: true,
+			Follow:     true,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	defer logs.Close()
+	go func() {
+		_, _ = stdcopy.StdCopy(out, out, logs)
+	}()
+
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		info, err := cli.ContainerInspect(ctx, containerID)
+		if err != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("container did not become healthy within %v", timeout)
+			}
+			return fmt.Errorf("failed to inspect container: %w", err)
+		}
+		if !info.State.Running {
+			return fmt.Errorf("container exited with status %d before becoming healthy", info.State.ExitCode)
+		}
+		if info.State.Health == nil {
+			return errNoHealthcheck
+		}
+		switch info.State.Health.Status {
+		case types.Healthy:
+			return nil
+		case types.Unhealthy:
+			return errors.New("container is unhealthy")
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("container did not become healthy within %v", timeout)
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *docker) pullImage(ctx context.Context, cli *client.Client) error {
+	if !e.pull {
+		return nil
+	}
+	reader, err := cli.ImagePull(ctx, e.image, image.PullOptions{})
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	_, err = io.Copy(e.stdout, reader)
+	return err
+}
+
+// prepareReusedContainer starts the container named containerName if it
+// exists but isn't running. Otherwise, it creates the container from the
+// image and keeps it running so that the next steps or runs can reuse it.
+func (e *docker) prepareReusedContainer(ctx context.Context, cli *client.Client) error {
+	info, err := cli.ContainerInspect(ctx, e.containerName)
+	switch {
+	case err == nil:
+		if info.State.Running {
+			return nil
+		}
+		logger.Info(ctx, "docker executor: start container", "name", e.containerName)
+		return cli.ContainerStart(ctx, info.ID, container.StartOptions{})
+
+	case client.IsErrNotFound(err):
+		if err := e.pullImage(ctx, cli); err != nil {
+			return err
+		}
+		e.containerConfig.Image = e.image
+		e.containerConfig.Entrypoint = keepAliveEntrypoint
+		e.containerConfig.Cmd = nil
+		resp, err := cli.ContainerCreate(
+			ctx, e.containerConfig, e.hostConfig, nil, nil, e.containerName,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create container %s: %w", e.containerName, err)
+		}
+		logger.Info(ctx, "docker executor: create container", "name", e.containerName)
+		return cli.ContainerStart(ctx, resp.ID, container.StartOptions{})
+
+	default:
+		return fmt.Errorf("failed to inspect container %s: %w", e.containerName, err)
+	}
+}
+
+// removeContainer removes the container named containerName. It succeeds if
+// the container doesn't exist.
+func (e *docker) removeContainer(ctx context.Context, cli *client.Client) error {
+	err := cli.ContainerRemove(ctx, e.containerName, container.RemoveOptions{
+		Force: true,
+	})
+	if err != nil && !client.IsErrNotFound(err) {
+		return fmt.Errorf("failed to remove container %s: %w", e.containerName, err)
+	}
+	return nil
+}
+
 func (e *docker) execInContainer(ctx context.Context, cli *client.Client) error {
 	// Check if containerInfo exists and is running
 	containerInfo, err := cli.ContainerInspect(ctx, e.containerName)
@@ -332,6 +816,17 @@ func newDocker(
 		}
 	}
 
+	// keepContainer keeps the container after the step for debugging.
+	if k, ok := execCfg.Config["keepContainer"]; ok {
+		keep, err := stepContext.EvalBool(k)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate keepContainer value: %w", err)
+		}
+		if keep {
+			autoRemove = false
+		}
+	}
+
 	pull := true
 	if p, ok := execCfg.Config["pull"]; ok {
 		var err error
@@ -341,6 +836,44 @@ func newDocker(
 		}
 	}
 
+	reuse := false
+	if r, ok := execCfg.Config["reuse"]; ok {
+		var err error
+		reuse, err = stepContext.EvalBool(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate reuse value: %w", err)
+		}
+	}
+
+	waitHealthy := false
+	if w, ok := execCfg.Config["waitHealthy"]; ok {
+		var err error
+		waitHealthy, err = stepContext.EvalBool(w)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate waitHealthy value: %w", err)
+		}
+	}
+	waitHealthyTimeout := defaultWaitHealthyTimeout
+	if t, ok := execCfg.Config["waitHealthyTimeoutSec"]; ok {
+		sec, err := strconv.Atoi(fmt.Sprint(t))
+		if err != nil || sec <= 0 {
+			return nil, fmt.Errorf("waitHealthyTimeoutSec must be a positive integer: %v", t)
+		}
+		waitHealthyTimeout = time.Duration(sec) * time.Second
+	}
+
+	var action string
+	if a, ok := execCfg.Config["action"].(string); ok {
+		value, err := stepContext.EvalString(a)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate action: %w", err)
+		}
+		if value != dockerActionRemove {
+			return nil, fmt.Errorf("unknown action %q", value)
+		}
+		action = value
+	}
+
 	exec := &docker{
 		pull:            pull,
 		step:            step,
@@ -349,15 +882,26 @@ func newDocker(
 		hostConfig:      hostConfig,
 		execConfig:      execConfig,
 		autoRemove:      autoRemove,
+		reuse:           reuse,
+		action:          action,
+
+		waitHealthy:        waitHealthy,
+		waitHealthyTimeout: waitHealthyTimeout,
 	}
 
-	// Check for existing container name first
 	if containerName, ok := execCfg.Config["containerName"].(string); ok {
 		value, err := stepContext.EvalString(containerName)
 		if err != nil {
 			return nil, fmt.Errorf("failed to evaluate containerName: %w", err)
 		}
 		exec.containerName = value
+	}
+	if (reuse || action != "") && exec.containerName == "" {
+		return nil, errors.New("containerName must be specified to reuse or remove the container")
+	}
+
+	// Check for existing container name first
+	if exec.containerName != "" && !reuse && !waitHealthy {
 		return exec, nil
 	}
 
@@ -370,8 +914,17 @@ func newDocker(
 		exec.image = value
 		return exec, nil
 	}
+	if reuse || waitHealthy {
+		return nil, errors.New("image must be specified to reuse the container or wait for it to be healthy")
+	}
+
+	// Exec into the container shared by the steps of the run
+	if c, ok := ctx.Value(sharedContainerKey{}).(*SharedContainer); ok {
+		exec.containerName = c.id
+		return exec, nil
+	}
 
-	return nil, errors.New("either containerName or image must be specified")
+	return nil, errors.New("either containerName or image must be specified, or the DAG must have a container")
 }
 
 func init() {
@@ -0,0 +1,138 @@
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDockerExecutor(t *testing.T) {
+	t.Parallel()
+
+	step := digraph.Step{
+		Name:           "docker-exec",
+		Command:        "echo",
+		Args:           []string{"hello"},
+		ExecutorConfig: digraph.ExecutorConfig{Type: "docker"},
+	}
+
+	t.Run("SharedContainer", func(t *testing.T) {
+		ctx := WithSharedContainer(context.Background(), &SharedContainer{id: "shared"})
+		exec, err := newDocker(ctx, step)
+		require.NoError(t, err)
+		assert.Equal(t, "shared", exec.(*docker).containerName)
+	})
+	t.Run("ImageOverSharedContainer", func(t *testing.T) {
+		step := step
+		step.ExecutorConfig.Config = map[string]any{"image": "alpine"}
+		ctx := WithSharedContainer(context.Background(), &SharedContainer{id: "shared"})
+		exec, err := newDocker(ctx, step)
+		require.NoError(t, err)
+		assert.Equal(t, "alpine", exec.(*docker).image)
+		assert.Empty(t, exec.(*docker).containerName)
+	})
+	t.Run("KeepContainer", func(t *testing.T) {
+		step := step
+		step.ExecutorConfig.Config = map[string]any{
+			"image":         "alpine",
+			"autoRemove":    true,
+			"keepContainer": true,
+		}
+		exec, err := newDocker(context.Background(), step)
+		require.NoError(t, err)
+		assert.False(t, exec.(*docker).autoRemove)
+	})
+	t.Run("Reuse", func(t *testing.T) {
+		step := step
+		step.ExecutorConfig.Config = map[string]any{
+			"image":         "alpine",
+			"containerName": "workspace",
+			"reuse":         true,
+		}
+		exec, err := newDocker(context.Background(), step)
+		require.NoError(t, err)
+		assert.True(t, exec.(*docker).reuse)
+		assert.Equal(t, "alpine", exec.(*docker).image)
+		assert.Equal(t, "workspace", exec.(*docker).containerName)
+	})
+	t.Run("ReuseWithoutImage", func(t *testing.T) {
+		step := step
+		step.ExecutorConfig.Config = map[string]any{
+			"containerName": "workspace",
+			"reuse":         true,
+		}
+		_, err := newDocker(context.Background(), step)
+		require.Error(t, err)
+	})
+	t.Run("RemoveAction", func(t *testing.T) {
+		step := digraph.Step{
+			Name: "cleanup",
+			ExecutorConfig: digraph.ExecutorConfig{
+				Type: "docker",
+				Config: map[string]any{
+					"containerName": "workspace",
+					"action":        "remove",
+				},
+			},
+		}
+		exec, err := newDocker(context.Background(), step)
+		require.NoError(t, err)
+		assert.Equal(t, dockerActionRemove, exec.(*docker).action)
+
+		step.ExecutorConfig.Config = map[string]any{"action": "remove"}
+		_, err = newDocker(context.Background(), step)
+		require.Error(t, err)
+
+		step.ExecutorConfig.Config = map[string]any{"containerName": "workspace", "action": "stop"}
+		_, err = newDocker(context.Background(), step)
+		require.Error(t, err)
+	})
+	t.Run("WaitHealthy", func(t *testing.T) {
+		step := step
+		step.ExecutorConfig.Config = map[string]any{
+			"image":       "postgres",
+			"waitHealthy": true,
+		}
+		exec, err := newDocker(context.Background(), step)
+		require.NoError(t, err)
+		assert.True(t, exec.(*docker).waitHealthy)
+		assert.Equal(t, defaultWaitHealthyTimeout, exec.(*docker).waitHealthyTimeout)
+
+		step.ExecutorConfig.Config["waitHealthyTimeoutSec"] = "30"
+		exec, err = newDocker(context.Background(), step)
+		require.NoError(t, err)
+		assert.Equal(t, 30*time.Second, exec.(*docker).waitHealthyTimeout)
+
+		step.ExecutorConfig.Config["containerName"] = "db"
+		exec, err = newDocker(context.Background(), step)
+		require.NoError(t, err)
+		assert.Equal(t, "postgres", exec.(*docker).image)
+		assert.Equal(t, "db", exec.(*docker).containerName)
+
+		step.ExecutorConfig.Config["waitHealthyTimeoutSec"] = -1
+		_, err = newDocker(context.Background(), step)
+		require.Error(t, err)
+	})
+	t.Run("NoContainer", func(t *testing.T) {
+		_, err := newDocker(context.Background(), step)
+		require.Error(t, err)
+	})
+}
+
+func TestServiceContainerEnv(t *testing.T) {
+	c := &ServiceContainer{
+		name:      "my-db",
+		ports:     []string{"5432", "8080/tcp"},
+		hostPorts: map[string]string{"5432": "49153", "8080/tcp": "49154"},
+	}
+	assert.Equal(t, map[string]string{
+		"MY_DB_HOST":      "127.0.0.1",
+		"MY_DB_PORT":      "49153",
+		"MY_DB_PORT_5432": "49153",
+		"MY_DB_PORT_8080": "49154",
+	}, c.Env())
+}
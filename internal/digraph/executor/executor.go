@@ -6,14 +6,26 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
+	"runtime"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/dagu-org/dagu/internal/digraph"
 )
 
+// Executor runs a step.
 type Executor interface {
 	SetStdout(out io.Writer)
 	SetStderr(out io.Writer)
+	// Kill stops the running step. SIGKILL stops the step immediately, and
+	// the other signals ask the step to stop gracefully, e.g. the signal is
+	// sent to the process. The executors that can't stop the step
+	// gracefully stop it immediately.
 	Kill(sig os.Signal) error
+	// Run runs the step until it finishes. The context is the step context
+	// and the step is stopped immediately when it's cancelled.
 	Run(ctx context.Context) error
 }
 
@@ -21,6 +33,38 @@ type ExitCoder interface {
 	ExitCode() int
 }
 
+// StdinSetter is implemented by the executors that pass the standard input
+// of the step to the command.
+type StdinSetter interface {
+	SetStdin(in io.Reader)
+}
+
+// ResourceUsage is the resource usage of the command of a step.
+type ResourceUsage struct {
+	// UserCPUTime is the CPU time spent in the user mode.
+	UserCPUTime time.Duration `json:"UserCPUTime"`
+	// SystemCPUTime is the CPU time spent in the kernel mode.
+	SystemCPUTime time.Duration `json:"SystemCPUTime"`
+	// MaxRSS is the maximum resident set size in bytes.
+	MaxRSS int64 `json:"MaxRSS"`
+	// WallTime is the elapsed time of the command.
+	WallTime time.Duration `json:"WallTime"`
+}
+
+// ResourceUsageReporter is implemented by the executors that measure the
+// CPU time and the memory of the command.
+type ResourceUsageReporter interface {
+	// ResourceUsage returns the resource usage of the last run, or nil if
+	// it's not available.
+	ResourceUsage() *ResourceUsage
+}
+
+// SubRunner is implemented by the executors that run another DAG.
+type SubRunner interface {
+	// SubRequestID returns the request ID of the run of the other DAG.
+	SubRequestID() string
+}
+
 type Creator func(ctx context.Context, step digraph.Step) (Executor, error)
 
 var (
@@ -33,9 +77,113 @@ func NewExecutor(ctx context.Context, step digraph.Step) (Executor, error) {
 	if ok {
 		return f(ctx, step)
 	}
+	if path, ok := lookupPlugin(step.ExecutorConfig.Type); ok {
+		return newPlugin(ctx, step, path)
+	}
 	return nil, fmt.Errorf("%w: %s", errInvalidExecutor, step.ExecutorConfig)
 }
 
+// Register registers the executor of the type. The registered executor
+// takes precedence over the plugin of the same type.
 func Register(name string, register Creator) {
 	executors[name] = register
 }
+
+// isForceKill returns true if the signal stops the step immediately.
+func isForceKill(sig os.Signal) bool {
+	return sig == syscall.SIGKILL
+}
+
+// stopper stops the running step by cancelling the context of the run. It's
+// embedded by the executors that can't stop the step gracefully.
+type stopper struct {
+	mu      sync.Mutex
+	cancel  context.CancelFunc
+	stopped bool
+}
+
+// start returns the context of the run which is cancelled by stop.
+func (s *stopper) start(ctx context.Context) (context.Context, context.CancelFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ctx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	if s.stopped {
+		// The step was killed before it started.
+		cancel()
+	}
+	return ctx, cancel
+}
+
+// stop cancels the context of the run.
+func (s *stopper) stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stopped = true
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+// startProcess starts the command and waits until it finishes. The process
+// group of the command is killed when the context is cancelled.
+func startProcess(ctx context.Context, lock *sync.Mutex, cmd *exec.Cmd) error {
+	return startProcessWith(ctx, lock, cmd, nil)
+}
+
+// startProcessWith is startProcess calling onStart as soon as the process
+// is started. If onStart fails, the process group is killed.
+func startProcessWith(ctx context.Context, lock *sync.Mutex, cmd *exec.Cmd, onStart func(*os.Process) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	lock.Lock()
+	err := cmd.Start()
+	if err == nil && onStart != nil {
+		if err = onStart(cmd.Process); err != nil {
+			_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+			_ = cmd.Wait()
+		}
+	}
+	lock.Unlock()
+	if err != nil {
+		return err
+	}
+	stop := context.AfterFunc(ctx, func() {
+		_ = killProcess(lock, cmd, syscall.SIGKILL)
+	})
+	defer stop()
+	return cmd.Wait()
+}
+
+// processUsage returns the resource usage of the exited process, or nil if
+// it's not available.
+func processUsage(cmd *exec.Cmd) *ResourceUsage {
+	if cmd.ProcessState == nil {
+		return nil
+	}
+	ru, ok := cmd.ProcessState.SysUsage().(*syscall.Rusage)
+	if !ok {
+		return nil
+	}
+	maxRSS := int64(ru.Maxrss)
+	// ru_maxrss is in kilobytes except on macOS.
+	if runtime.GOOS != "darwin" {
+		maxRSS *= 1024
+	}
+	return &ResourceUsage{
+		UserCPUTime:   time.Duration(ru.Utime.Nano()),
+		SystemCPUTime: time.Duration(ru.Stime.Nano()),
+		MaxRSS:        maxRSS,
+	}
+}
+
+// killProcess sends the signal to the process group of the command.
+func killProcess(lock *sync.Mutex, cmd *exec.Cmd, sig os.Signal) error {
+	lock.Lock()
+	defer lock.Unlock()
+	if cmd == nil || cmd.Process == nil {
+		return nil
+	}
+	return syscall.Kill(-cmd.Process.Pid, sig.(syscall.Signal))
+}
@@ -0,0 +1,221 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutorStop(t *testing.T) {
+	ctx := digraph.NewContext(context.Background(), &digraph.DAG{}, nil, "", "")
+
+	t.Run("CommandContextCancel", func(t *testing.T) {
+		exec, err := NewExecutor(ctx, digraph.Step{
+			Name:    "step",
+			Command: "sleep",
+			Args:    []string{"10"},
+		})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		require.Error(t, exec.Run(ctx))
+		require.Less(t, time.Since(start), 5*time.Second)
+	})
+	t.Run("CommandGracefulKill", func(t *testing.T) {
+		exec, err := NewExecutor(ctx, digraph.Step{
+			Name:         "step",
+			Shell:        "sh",
+			ShellCmdArgs: `trap 'echo stopping; exit 0' TERM; sleep 10 & wait`,
+		})
+		require.NoError(t, err)
+		var out bytes.Buffer
+		exec.SetStdout(&out)
+
+		done := make(chan error)
+		go func() { done <- exec.Run(ctx) }()
+		time.Sleep(200 * time.Millisecond)
+		require.NoError(t, exec.Kill(syscall.SIGTERM))
+
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+			require.Contains(t, out.String(), "stopping")
+		case <-time.After(5 * time.Second):
+			t.Fatal("the command was not stopped")
+		}
+	})
+	t.Run("HTTPKill", func(t *testing.T) {
+		srv := httptest.NewServer(nethttp.HandlerFunc(func(_ nethttp.ResponseWriter, r *nethttp.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(10 * time.Second):
+			}
+		}))
+		defer srv.Close()
+
+		exec, err := NewExecutor(ctx, digraph.Step{
+			Name:           "step",
+			Command:        "GET",
+			Args:           []string{srv.URL},
+			ExecutorConfig: digraph.ExecutorConfig{Type: "http"},
+		})
+		require.NoError(t, err)
+
+		done := make(chan error)
+		go func() { done <- exec.Run(ctx) }()
+		time.Sleep(100 * time.Millisecond)
+		require.NoError(t, exec.Kill(syscall.SIGTERM))
+
+		select {
+		case err := <-done:
+			require.ErrorIs(t, err, context.Canceled)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the request was not cancelled")
+		}
+	})
+	t.Run("KillBeforeRun", func(t *testing.T) {
+		exec, err := NewExecutor(ctx, digraph.Step{
+			Name:           "step",
+			Command:        "GET",
+			Args:           []string{"http://localhost:1"},
+			ExecutorConfig: digraph.ExecutorConfig{Type: "http"},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, exec.Kill(syscall.SIGKILL))
+		require.ErrorIs(t, exec.Run(ctx), context.Canceled)
+	})
+}
+
+func TestCommandLimits(t *testing.T) {
+	ctx := digraph.NewContext(context.Background(), &digraph.DAG{}, nil, "", "")
+
+	t.Run("Nice", func(t *testing.T) {
+		exec, err := NewExecutor(ctx, digraph.Step{
+			Name:           "step",
+			Shell:          "sh",
+			ShellCmdArgs:   "sleep 0.2; nice",
+			ExecutorConfig: digraph.ExecutorConfig{Type: "command", Config: map[string]any{"nice": 10}},
+		})
+		require.NoError(t, err)
+		var out bytes.Buffer
+		exec.SetStdout(&out)
+
+		require.NoError(t, exec.Run(ctx))
+		require.Equal(t, "10", strings.TrimSpace(out.String()))
+	})
+	t.Run("InvalidConfig", func(t *testing.T) {
+		for _, config := range []map[string]any{
+			{"nice": 20},
+			{"ioniceClass": "low"},
+			{"ioniceLevel": 8},
+			{"memoryMax": "lots"},
+			{"cpuMax": -1},
+			{"unknown": true},
+		} {
+			_, err := NewExecutor(ctx, digraph.Step{
+				Name:           "step",
+				Command:        "true",
+				ExecutorConfig: digraph.ExecutorConfig{Type: "command", Config: config},
+			})
+			require.Error(t, err, config)
+		}
+	})
+	t.Run("MemorySize", func(t *testing.T) {
+		for s, want := range map[string]int64{
+			"1024": 1024,
+			"512M": 512 << 20,
+			"2g":   2 << 30,
+			"1GB":  1 << 30,
+		} {
+			got, err := parseMemorySize(s)
+			require.NoError(t, err, s)
+			require.Equal(t, want, got, s)
+		}
+	})
+}
+
+func TestHTTPTemplate(t *testing.T) {
+	var gotBody string
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(_ nethttp.ResponseWriter, r *nethttp.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+	}))
+	defer srv.Close()
+
+	ctx := digraph.NewContext(context.Background(), &digraph.DAG{Name: "etl"}, nil, "", "")
+	step := digraph.Step{
+		Name:    "onFailure",
+		Command: "POST",
+		Args:    []string{srv.URL},
+		ExecutorConfig: digraph.ExecutorConfig{Type: "http", Config: map[string]any{
+			"body":     `{"dag": {{ json .DAG }}, "failed": {{ json .FailedSteps }}, "log": {{ json (index .Steps 0).LogURL }}}`,
+			"template": true,
+			"silent":   true,
+		}},
+	}
+	stepCtx := digraph.NewStepContext(ctx, step).WithRunResult(&digraph.RunResult{
+		DAG:         "etl",
+		FailedSteps: []string{"load"},
+		Steps:       []digraph.StepResult{{Name: "load", LogURL: "http://dagu/dags/etl/log?step=load"}},
+	})
+	ctx = digraph.WithStepContext(ctx, stepCtx)
+
+	exec, err := NewExecutor(ctx, step)
+	require.NoError(t, err)
+	exec.SetStdout(io.Discard)
+	require.NoError(t, exec.Run(ctx))
+	require.Equal(t, `{"dag": "etl", "failed": ["load"], "log": "http://dagu/dags/etl/log?step=load"}`, gotBody)
+}
+
+type stubDBClient struct {
+	names []string
+}
+
+func (c *stubDBClient) GetDAG(_ context.Context, name string) (*digraph.DAG, error) {
+	c.names = append(c.names, name)
+	return &digraph.DAG{Name: "child", Location: name}, nil
+}
+
+func (c *stubDBClient) GetStatus(_ context.Context, name, _ string) (*digraph.Status, error) {
+	return &digraph.Status{Name: name}, nil
+}
+
+func TestSubWorkflow(t *testing.T) {
+	dir := t.TempDir()
+	child := filepath.Join(dir, "child.yaml")
+	require.NoError(t, os.WriteFile(child, []byte("steps:\n  - name: s\n    command: true\n"), 0600))
+
+	parent := &digraph.DAG{Name: "parent", Location: filepath.Join(dir, "parent.yaml")}
+	db := &stubDBClient{}
+	ctx := digraph.NewContext(context.Background(), parent, db, "parent-request", "")
+	ctx = digraph.WithStepContext(ctx, digraph.NewStepContext(ctx, digraph.Step{}))
+
+	for _, name := range []string{"./child.yaml", "child", "./missing.yaml"} {
+		exec, err := NewExecutor(ctx, digraph.Step{
+			Name:           "sub",
+			SubWorkflow:    &digraph.SubWorkflow{Name: name},
+			ExecutorConfig: digraph.ExecutorConfig{Type: digraph.ExecutorTypeSubWorkflow},
+		})
+		require.NoError(t, err)
+
+		runner, ok := exec.(SubRunner)
+		require.True(t, ok)
+		require.NotEmpty(t, runner.SubRequestID())
+	}
+	// The relative path is resolved against the directory of the parent.
+	require.Equal(t, []string{child, "child", "./missing.yaml"}, db.names)
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/dagu-org/dagu/internal/digraph"
@@ -18,12 +19,12 @@ import (
 var _ Executor = (*http)(nil)
 
 type http struct {
-	stdout    io.Writer
-	req       *resty.Request
-	reqCancel context.CancelFunc
-	url       string
-	method    string
-	cfg       *httpConfig
+	stopper
+	stdout io.Writer
+	req    *resty.Request
+	url    string
+	method string
+	cfg    *httpConfig
 }
 
 type httpConfig struct {
@@ -34,6 +35,9 @@ type httpConfig struct {
 	Silent  bool              `json:"silent"`
 	Debug   bool              `json:"debug"`
 	Json    bool              `json:"json"`
+	// Template renders the body as a Go template of the results of the run
+	// instead of expanding the variables.
+	Template bool `json:"template"`
 }
 
 type httpJSONResult struct {
@@ -55,7 +59,15 @@ func newHTTP(ctx context.Context, step digraph.Step) (Executor, error) {
 		); err != nil {
 			return nil, err
 		}
-		body, err := stepContext.EvalString(reqCfg.Body)
+		var (
+			body string
+			err  error
+		)
+		if reqCfg.Template {
+			body, err = renderHTTPBody(reqCfg.Body, stepContext.RunResult())
+		} else {
+			body, err = stepContext.EvalString(reqCfg.Body)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("failed to evaluate body: %w", err)
 		}
@@ -86,8 +98,6 @@ func newHTTP(ctx context.Context, step digraph.Step) (Executor, error) {
 		return nil, fmt.Errorf("failed to evaluate method: %w", err)
 	}
 
-	ctx, cancel := context.WithCancel(ctx)
-
 	client := resty.New()
 	if reqCfg.Debug {
 		client.SetDebug(true)
@@ -95,7 +105,7 @@ func newHTTP(ctx context.Context, step digraph.Step) (Executor, error) {
 	if reqCfg.Timeout > 0 {
 		client.SetTimeout(time.Second * time.Duration(reqCfg.Timeout))
 	}
-	req := client.R().SetContext(ctx)
+	req := client.R()
 	if len(reqCfg.Headers) > 0 {
 		req = req.SetHeaders(reqCfg.Headers)
 	}
@@ -105,12 +115,11 @@ func newHTTP(ctx context.Context, step digraph.Step) (Executor, error) {
 	req = req.SetBody([]byte(reqCfg.Body))
 
 	return &http{
-		stdout:    os.Stdout,
-		req:       req,
-		reqCancel: cancel,
-		method:    method,
-		url:       url,
-		cfg:       &reqCfg,
+		stdout: os.Stdout,
+		req:    req,
+		method: method,
+		url:    url,
+		cfg:    &reqCfg,
 	}, nil
 }
 
@@ -122,8 +131,9 @@ func (e *http) SetStderr(out io.Writer) {
 	e.stdout = out
 }
 
+// Kill cancels the request. The request can't be stopped gracefully.
 func (e *http) Kill(_ os.Signal) error {
-	e.reqCancel()
+	e.stop()
 	return nil
 }
 
@@ -173,8 +183,11 @@ func (e *http) writeTextResult(rsp *resty.Response) error {
 	return nil
 }
 
-func (e *http) Run(_ context.Context) error {
-	rsp, err := e.req.Execute(strings.ToUpper(e.method), e.url)
+func (e *http) Run(ctx context.Context) error {
+	ctx, cancel := e.start(ctx)
+	defer cancel()
+
+	rsp, err := e.req.SetContext(ctx).Execute(strings.ToUpper(e.method), e.url)
 	if err != nil {
 		return err
 	}
@@ -197,6 +210,28 @@ func (e *http) Run(_ context.Context) error {
 	return nil
 }
 
+var httpTemplateFuncs = template.FuncMap{
+	// json encodes the value as JSON, e.g. to quote a string in the body.
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+// renderHTTPBody renders the template of the body with the results of the
+// run, e.g. {"failed": {{ json .FailedSteps }}}.
+func renderHTTPBody(text string, result *digraph.RunResult) (string, error) {
+	tmpl, err := template.New("body").Funcs(httpTemplateFuncs).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, result); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 func decodeHTTPConfig(dat map[string]any, cfg *httpConfig) error {
 	md, _ := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
 		WeaklyTypedInput: true,
@@ -16,6 +16,7 @@ import (
 var _ Executor = (*jq)(nil)
 
 type jq struct {
+	stopper
 	stdout io.Writer
 	stderr io.Writer
 	query  string
@@ -61,16 +62,20 @@ func (e *jq) SetStderr(out io.Writer) {
 	e.stderr = out
 }
 
-func (*jq) Kill(_ os.Signal) error {
+func (e *jq) Kill(_ os.Signal) error {
+	e.stop()
 	return nil
 }
 
-func (e *jq) Run(_ context.Context) error {
+func (e *jq) Run(ctx context.Context) error {
+	ctx, cancel := e.start(ctx)
+	defer cancel()
+
 	query, err := gojq.Parse(e.query)
 	if err != nil {
 		return err
 	}
-	iter := query.Run(e.input)
+	iter := query.RunWithContext(ctx, e.input)
 	for {
 		v, ok := iter.Next()
 		if !ok {
@@ -0,0 +1,155 @@
+package executor
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/go-viper/mapstructure/v2"
+)
+
+// processLimits is the priority and the resource limits of the processes
+// of a command step, set in the config of the command executor.
+type processLimits struct {
+	// Nice is the CPU niceness from -20 (the highest priority) to 19.
+	Nice *int `mapstructure:"nice"`
+	// IOClass is the IO scheduling class: "realtime", "best-effort" or
+	// "idle". It's only supported on Linux.
+	IOClass string `mapstructure:"ioniceClass"`
+	// IOLevel is the IO priority in the class from 0 (the highest) to 7.
+	IOLevel *int `mapstructure:"ioniceLevel"`
+	// MemoryMax is the memory limit of the cgroup of the step, e.g. "512M".
+	// It's only supported on Linux with cgroup v2.
+	MemoryMax string `mapstructure:"memoryMax"`
+	// CPUMax is the CPU limit of the cgroup of the step in cores, e.g. 0.5.
+	// It's only supported on Linux with cgroup v2.
+	CPUMax float64 `mapstructure:"cpuMax"`
+
+	memoryMax int64
+}
+
+// IO scheduling classes of ioprio_set(2).
+const (
+	ioClassRealtime   = 1
+	ioClassBestEffort = 2
+	ioClassIdle       = 3
+)
+
+var ioClasses = map[string]int{
+	"realtime":    ioClassRealtime,
+	"best-effort": ioClassBestEffort,
+	"idle":        ioClassIdle,
+}
+
+// parseProcessLimits returns the limits in the config of the command
+// executor, or nil if none is set.
+func parseProcessLimits(config map[string]any) (*processLimits, error) {
+	if len(config) == 0 {
+		return nil, nil
+	}
+	limits := new(processLimits)
+	md, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           limits,
+		WeaklyTypedInput: true,
+		ErrorUnused:      true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create decoder: %w", err)
+	}
+	if err := md.Decode(config); err != nil {
+		return nil, fmt.Errorf("failed to decode command config: %w", err)
+	}
+
+	if limits.Nice != nil && (*limits.Nice < -20 || *limits.Nice > 19) {
+		return nil, fmt.Errorf("nice must be between -20 and 19: %d", *limits.Nice)
+	}
+	if limits.IOClass != "" {
+		if _, ok := ioClasses[limits.IOClass]; !ok {
+			return nil, fmt.Errorf("invalid ioniceClass %q: must be realtime, best-effort or idle", limits.IOClass)
+		}
+	}
+	if limits.IOLevel != nil {
+		if *limits.IOLevel < 0 || *limits.IOLevel > 7 {
+			return nil, fmt.Errorf("ioniceLevel must be between 0 and 7: %d", *limits.IOLevel)
+		}
+		if limits.IOClass == "" {
+			limits.IOClass = "best-effort"
+		}
+	}
+	if limits.MemoryMax != "" {
+		if limits.memoryMax, err = parseMemorySize(limits.MemoryMax); err != nil {
+			return nil, err
+		}
+	}
+	if limits.CPUMax < 0 {
+		return nil, fmt.Errorf("cpuMax must be positive: %v", limits.CPUMax)
+	}
+	return limits, nil
+}
+
+// parseMemorySize parses the size in bytes with an optional K, M, G or T
+// suffix of the powers of 1024.
+func parseMemorySize(s string) (int64, error) {
+	size := strings.ToUpper(strings.TrimSpace(s))
+	size = strings.TrimSuffix(size, "B")
+	unit := int64(1)
+	for i, suffix := range []string{"K", "M", "G", "T"} {
+		if strings.HasSuffix(size, suffix) {
+			unit = 1 << (10 * (i + 1))
+			size = strings.TrimSuffix(size, suffix)
+			break
+		}
+	}
+	n, err := strconv.ParseInt(size, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid memoryMax %q: e.g. 512M or 2G", s)
+	}
+	return n * unit, nil
+}
+
+// needsCgroup returns whether the limits need a cgroup.
+func (l *processLimits) needsCgroup() bool {
+	return l.memoryMax > 0 || l.CPUMax > 0
+}
+
+// applyPriority sets the niceness and the IO priority of the process group
+// of the started process.
+func (l *processLimits) applyPriority(proc *os.Process) error {
+	if l.Nice != nil {
+		if err := syscall.Setpriority(syscall.PRIO_PGRP, proc.Pid, *l.Nice); err != nil {
+			return fmt.Errorf("failed to set the niceness to %d: %w", *l.Nice, err)
+		}
+	}
+	if l.IOClass != "" {
+		level := 4
+		if l.IOLevel != nil {
+			level = *l.IOLevel
+		}
+		if err := setIOPriority(proc.Pid, ioClasses[l.IOClass], level); err != nil {
+			return fmt.Errorf("failed to set the IO priority: %w", err)
+		}
+	}
+	return nil
+}
+
+var (
+	cgroupRootMu sync.RWMutex
+	cgroupRoot   = "/sys/fs/cgroup/dagu"
+)
+
+// SetCgroupRoot sets the cgroup v2 directory under which the cgroups of the
+// steps with the CPU or the memory limits are created.
+func SetCgroupRoot(dir string) {
+	cgroupRootMu.Lock()
+	defer cgroupRootMu.Unlock()
+	cgroupRoot = dir
+}
+
+func getCgroupRoot() string {
+	cgroupRootMu.RLock()
+	defer cgroupRootMu.RUnlock()
+	return cgroupRoot
+}
@@ -0,0 +1,137 @@
+package executor
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// ioprioWhoPgrp is IOPRIO_WHO_PGRP of ioprio_set(2).
+const ioprioWhoPgrp = 2
+
+// setIOPriority sets the IO scheduling class and level of the process group.
+func setIOPriority(pgid, class, level int) error {
+	prio := class<<13 | level
+	_, _, errno := syscall.Syscall(syscall.SYS_IOPRIO_SET, ioprioWhoPgrp, uintptr(pgid), uintptr(prio))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// cgroup2SuperMagic is the type of the cgroup v2 file system.
+const cgroup2SuperMagic = 0x63677270
+
+// cgroupCPUPeriod is the period of cpu.max in microseconds.
+const cgroupCPUPeriod = 100000
+
+// cgroup is the cgroup v2 of a step. The processes of the step are started
+// in it, so its limits apply to all of them.
+type cgroup struct {
+	dir string
+	fd  *os.File
+}
+
+// newCgroup creates the cgroup with the limits under the cgroup root and
+// sets the command to start in it.
+func newCgroup(limits *processLimits, cmd *exec.Cmd) (*cgroup, error) {
+	root := getCgroupRoot()
+	dir := root
+	if _, err := os.Stat(root); errors.Is(err, os.ErrNotExist) {
+		// The root is created in the parent cgroup.
+		dir = filepath.Dir(root)
+	}
+	var fs syscall.Statfs_t
+	if err := syscall.Statfs(dir, &fs); err != nil || fs.Type != cgroup2SuperMagic {
+		return nil, fmt.Errorf("%s is not in a cgroup v2 hierarchy; the cpuMax and memoryMax limits require cgroup v2", dir)
+	}
+	if err := os.Mkdir(root, 0755); err != nil && !errors.Is(err, os.ErrExist) {
+		return nil, fmt.Errorf("failed to create the cgroup root %s: %w", root, err)
+	}
+	var controllers []string
+	if limits.CPUMax > 0 {
+		controllers = append(controllers, "+cpu")
+	}
+	if limits.memoryMax > 0 {
+		controllers = append(controllers, "+memory")
+	}
+	if err := os.WriteFile(filepath.Join(root, "cgroup.subtree_control"), []byte(strings.Join(controllers, " ")), 0); err != nil {
+		return nil, fmt.Errorf("failed to enable the controllers in %s (cgroup v2 with the cpu and memory controllers delegated is required): %w", root, err)
+	}
+
+	dir, err := os.MkdirTemp(root, "step-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the cgroup of the step: %w", err)
+	}
+	cg := &cgroup{dir: dir}
+	if limits.CPUMax > 0 {
+		quota := int64(limits.CPUMax * cgroupCPUPeriod)
+		if err := cg.write("cpu.max", fmt.Sprintf("%d %d", quota, cgroupCPUPeriod)); err != nil {
+			_ = cg.remove()
+			return nil, err
+		}
+	}
+	if limits.memoryMax > 0 {
+		if err := cg.write("memory.max", strconv.FormatInt(limits.memoryMax, 10)); err != nil {
+			_ = cg.remove()
+			return nil, err
+		}
+	}
+
+	if cg.fd, err = os.Open(dir); err != nil {
+		_ = cg.remove()
+		return nil, err
+	}
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	// The process is created in the cgroup, so that none of its children
+	// escapes the limits.
+	cmd.SysProcAttr.UseCgroupFD = true
+	cmd.SysProcAttr.CgroupFD = int(cg.fd.Fd())
+	return cg, nil
+}
+
+func (cg *cgroup) write(file, value string) error {
+	if err := os.WriteFile(filepath.Join(cg.dir, file), []byte(value), 0); err != nil {
+		return fmt.Errorf("failed to set %s of the cgroup: %w", file, err)
+	}
+	return nil
+}
+
+// oomKilled returns whether a process of the cgroup was killed for
+// exceeding the memory limit.
+func (cg *cgroup) oomKilled() bool {
+	f, err := os.Open(filepath.Join(cg.dir, "memory.events"))
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if n, ok := strings.CutPrefix(scanner.Text(), "oom_kill "); ok {
+			count, _ := strconv.Atoi(n)
+			return count > 0
+		}
+	}
+	return false
+}
+
+// remove removes the cgroup. It's left if the processes started in the
+// background by the step are still running, so that they stay limited.
+func (cg *cgroup) remove() error {
+	if cg.fd != nil {
+		_ = cg.fd.Close()
+	}
+	err := os.Remove(cg.dir)
+	if errors.Is(err, syscall.EBUSY) {
+		return fmt.Errorf("the cgroup %s is left because processes of the step are still running", cg.dir)
+	}
+	return err
+}
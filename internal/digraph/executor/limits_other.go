@@ -0,0 +1,29 @@
+//go:build !linux
+
+package executor
+
+import (
+	"errors"
+	"os/exec"
+)
+
+var errLinuxOnly = errors.New("only supported on Linux")
+
+func setIOPriority(_, _, _ int) error {
+	return errLinuxOnly
+}
+
+// cgroup is the cgroup v2 of a step, which is only supported on Linux.
+type cgroup struct{}
+
+func newCgroup(_ *processLimits, _ *exec.Cmd) (*cgroup, error) {
+	return nil, errors.New("the cpuMax and memoryMax limits are only supported on Linux")
+}
+
+func (*cgroup) oomKilled() bool {
+	return false
+}
+
+func (*cgroup) remove() error {
+	return nil
+}
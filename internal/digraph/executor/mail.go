@@ -14,6 +14,7 @@ import (
 var _ Executor = (*mail)(nil)
 
 type mail struct {
+	stopper
 	stdout io.Writer
 	stderr io.Writer
 	mailer *mailer.Mailer
@@ -59,7 +60,8 @@ func (e *mail) SetStderr(out io.Writer) {
 	e.stderr = out
 }
 
-func (*mail) Kill(_ os.Signal) error {
+func (e *mail) Kill(_ os.Signal) error {
+	e.stop()
 	return nil
 }
 
@@ -73,6 +75,9 @@ message: %s
 `
 
 func (e *mail) Run(ctx context.Context) error {
+	ctx, cancel := e.start(ctx)
+	defer cancel()
+
 	_, _ = e.stdout.Write(
 		[]byte(fmt.Sprintf(
 			mailLogTemplate,
@@ -82,14 +87,25 @@ func (e *mail) Run(ctx context.Context) error {
 			e.cfg.Message,
 		)),
 	)
-	err := e.mailer.Send(
-		ctx,
-		e.cfg.From,
-		[]string{e.cfg.To},
-		e.cfg.Subject,
-		e.cfg.Message,
-		[]string{},
-	)
+	// The mailer doesn't stop sending when the context is cancelled, so the
+	// step doesn't wait for it.
+	sent := make(chan error, 1)
+	go func() {
+		sent <- e.mailer.Send(
+			ctx,
+			e.cfg.From,
+			[]string{e.cfg.To},
+			e.cfg.Subject,
+			e.cfg.Message,
+			[]string{},
+		)
+	}()
+	var err error
+	select {
+	case err = <-sent:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
 	if err != nil {
 		_, _ = e.stdout.Write([]byte("error occurred."))
 	} else {
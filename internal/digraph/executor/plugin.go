@@ -0,0 +1,85 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"syscall"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/fileutil"
+)
+
+// pluginPrefix is the prefix of the name of the executables that implement
+// the executors not built into dagu. The executor of the type "foo" is
+// implemented by the executable "dagu-executor-foo" in the PATH.
+const pluginPrefix = "dagu-executor-"
+
+// pluginRequest is the step passed to the plugin as JSON on the standard
+// input.
+type pluginRequest struct {
+	Name    string         `json:"name"`
+	Command string         `json:"command,omitempty"`
+	Args    []string       `json:"args,omitempty"`
+	Script  string         `json:"script,omitempty"`
+	Dir     string         `json:"dir,omitempty"`
+	Config  map[string]any `json:"config,omitempty"`
+}
+
+// lookupPlugin returns the path to the plugin of the executor type.
+func lookupPlugin(executorType string) (string, bool) {
+	if executorType == "" {
+		return "", false
+	}
+	path, err := exec.LookPath(pluginPrefix + executorType)
+	if err != nil {
+		return "", false
+	}
+	return path, true
+}
+
+// newPlugin creates the executor that runs the plugin executable. The step
+// is written to the standard input of the plugin and the output of the
+// plugin is the output of the step. The exit code of the plugin is the exit
+// code of the step.
+func newPlugin(ctx context.Context, step digraph.Step, path string) (Executor, error) {
+	if len(step.Dir) > 0 && !fileutil.FileExists(step.Dir) {
+		return nil, fmt.Errorf("directory %q does not exist", step.Dir)
+	}
+
+	stepContext := digraph.GetStepContext(ctx)
+	cfg, err := digraph.EvalStringFields(stepContext, struct {
+		Config map[string]any
+	}{
+		Config: step.ExecutorConfig.Config,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to substitute string fields: %w", err)
+	}
+
+	req, err := json.Marshal(pluginRequest{
+		Name:    step.Name,
+		Command: step.Command,
+		Args:    step.Args,
+		Script:  step.Script,
+		Dir:     step.Dir,
+		Config:  cfg.Config,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the step for the plugin %s: %w", path, err)
+	}
+
+	// nolint: gosec
+	cmd := exec.Command(path)
+	cmd.Stdin = bytes.NewReader(req)
+	cmd.Env = append(cmd.Env, stepContext.AllEnvs()...)
+	cmd.Dir = step.Dir
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setpgid: true,
+		Pgid:    0,
+	}
+
+	return &commandExecutor{cmd: cmd}, nil
+}
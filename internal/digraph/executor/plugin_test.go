@@ -0,0 +1,56 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlugin(t *testing.T) {
+	dir := t.TempDir()
+	plugin := filepath.Join(dir, pluginPrefix+"test-plugin")
+	require.NoError(t, os.WriteFile(plugin, []byte("#!/bin/sh\ncat\nexit 3\n"), 0755)) // nolint: gosec
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	ctx := digraph.NewContext(context.Background(), &digraph.DAG{}, nil, "", "")
+
+	t.Run("Run", func(t *testing.T) {
+		exec, err := NewExecutor(ctx, digraph.Step{
+			Name:    "step",
+			Command: "run",
+			Args:    []string{"job"},
+			ExecutorConfig: digraph.ExecutorConfig{
+				Type:   "test-plugin",
+				Config: map[string]any{"queue": "default"},
+			},
+		})
+		require.NoError(t, err)
+
+		var out bytes.Buffer
+		exec.SetStdout(&out)
+		exec.SetStderr(&out)
+		require.Error(t, exec.Run(ctx))
+		require.Equal(t, 3, exec.(ExitCoder).ExitCode())
+
+		var req pluginRequest
+		require.NoError(t, json.Unmarshal(out.Bytes(), &req))
+		require.Equal(t, pluginRequest{
+			Name:    "step",
+			Command: "run",
+			Args:    []string{"job"},
+			Config:  map[string]any{"queue": "default"},
+		}, req)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := NewExecutor(ctx, digraph.Step{
+			ExecutorConfig: digraph.ExecutorConfig{Type: "missing-plugin"},
+		})
+		require.ErrorIs(t, err, errInvalidExecutor)
+	})
+}
@@ -0,0 +1,553 @@
+package executor
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/go-viper/mapstructure/v2"
+)
+
+var _ Executor = (*s3)(nil)
+
+// s3 puts a file to or gets a file from a bucket of Amazon S3 or a storage
+// compatible with it. The requests are signed with the AWS Signature
+// Version 4.
+type s3 struct {
+	stopper
+	stdout io.Writer
+	stderr io.Writer
+	cfg    *s3Config
+	client *nethttp.Client
+}
+
+type s3Config struct {
+	// Action is put or get.
+	Action string `mapstructure:"action"`
+	Bucket string `mapstructure:"bucket"`
+	Key    string `mapstructure:"key"`
+	// File is the local file to upload or to save the object to. The object
+	// is written to the standard output if it's empty on get.
+	File   string `mapstructure:"file"`
+	Region string `mapstructure:"region"`
+	// Endpoint is the URL of the storage compatible with S3, e.g. MinIO.
+	// The bucket is put in the path of the URL instead of the host.
+	Endpoint string `mapstructure:"endpoint"`
+	// The static credentials. The credentials are read from the environment
+	// variables or the IAM role of the container or the instance if they
+	// are empty.
+	AccessKeyID     string `mapstructure:"accessKeyId"`
+	SecretAccessKey string `mapstructure:"secretAccessKey"`
+	SessionToken    string `mapstructure:"sessionToken"`
+}
+
+const (
+	s3ActionPut = "put"
+	s3ActionGet = "get"
+
+	defaultS3Region = "us-east-1"
+)
+
+var (
+	errS3InvalidAction   = errors.New("s3 action must be put or get")
+	errS3BucketRequired  = errors.New("s3 bucket is required")
+	errS3KeyRequired     = errors.New("s3 key is required")
+	errS3FileRequired    = errors.New("s3 file is required to put")
+	errS3PartialStatic   = errors.New("both accessKeyId and secretAccessKey are required")
+	errS3NoCredentials   = errors.New("no AWS credentials found")
+	errS3RequestFailed   = errors.New("s3 request failed")
+	errS3InvalidEndpoint = errors.New("invalid s3 endpoint")
+)
+
+func newS3(ctx context.Context, step digraph.Step) (Executor, error) {
+	var cfg s3Config
+	if err := decodeS3Config(step.ExecutorConfig.Config, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode s3 config: %w", err)
+	}
+
+	cfg, err := digraph.EvalStringFields(digraph.GetStepContext(ctx), cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to substitute string fields: %w", err)
+	}
+
+	switch cfg.Action {
+	case s3ActionPut:
+		if cfg.File == "" {
+			return nil, errS3FileRequired
+		}
+	case s3ActionGet:
+	default:
+		return nil, fmt.Errorf("%w: %q", errS3InvalidAction, cfg.Action)
+	}
+	if cfg.Bucket == "" {
+		return nil, errS3BucketRequired
+	}
+	if cfg.Key == "" {
+		return nil, errS3KeyRequired
+	}
+	if (cfg.AccessKeyID == "") != (cfg.SecretAccessKey == "") {
+		return nil, errS3PartialStatic
+	}
+	if cfg.Region == "" {
+		cfg.Region = firstNonEmpty(os.Getenv("AWS_REGION"), os.Getenv("AWS_DEFAULT_REGION"), defaultS3Region)
+	}
+	if cfg.File != "" && !filepath.IsAbs(cfg.File) && step.Dir != "" {
+		cfg.File = filepath.Join(step.Dir, cfg.File)
+	}
+
+	return &s3{
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+		cfg:    &cfg,
+		client: &nethttp.Client{},
+	}, nil
+}
+
+func (e *s3) SetStdout(out io.Writer) {
+	e.stdout = out
+}
+
+func (e *s3) SetStderr(out io.Writer) {
+	e.stderr = out
+}
+
+func (e *s3) Kill(_ os.Signal) error {
+	e.stop()
+	return nil
+}
+
+func (e *s3) Run(ctx context.Context) error {
+	ctx, cancel := e.start(ctx)
+	defer cancel()
+
+	creds, err := resolveAWSCredentials(ctx, e.client, e.cfg)
+	if err != nil {
+		return err
+	}
+	objectURL, err := e.objectURL()
+	if err != nil {
+		return err
+	}
+
+	switch e.cfg.Action {
+	case s3ActionPut:
+		return e.put(ctx, objectURL, creds)
+	default:
+		return e.get(ctx, objectURL, creds)
+	}
+}
+
+func (e *s3) put(ctx context.Context, objectURL *url.URL, creds awsCredentials) error {
+	f, err := os.Open(e.cfg.File)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", e.cfg.File, err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", e.cfg.File, err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to read %s: %w", e.cfg.File, err)
+	}
+
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodPut, objectURL.String(), f)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	signS3Request(req, creds, e.cfg.Region, hex.EncodeToString(hash.Sum(nil)), time.Now())
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errS3RequestFailed, err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if err := checkS3Response(resp); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(e.stdout, "uploaded %s to s3://%s/%s (%d bytes)\n", e.cfg.File, e.cfg.Bucket, e.cfg.Key, size)
+	return nil
+}
+
+func (e *s3) get(ctx context.Context, objectURL *url.URL, creds awsCredentials) error {
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodGet, objectURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	signS3Request(req, creds, e.cfg.Region, emptyPayloadHash, time.Now())
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errS3RequestFailed, err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if err := checkS3Response(resp); err != nil {
+		return err
+	}
+
+	if e.cfg.File == "" {
+		_, err := io.Copy(e.stdout, resp.Body)
+		return err
+	}
+
+	// The object is written to a temporary file first so that the file is
+	// not left half-written when the download fails.
+	if err := os.MkdirAll(filepath.Dir(e.cfg.File), 0755); err != nil {
+		return fmt.Errorf("failed to create the directory of %s: %w", e.cfg.File, err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(e.cfg.File), "."+filepath.Base(e.cfg.File)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", e.cfg.File, err)
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+	size, err := io.Copy(tmp, resp.Body)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to download s3://%s/%s: %w", e.cfg.Bucket, e.cfg.Key, err)
+	}
+	if err := os.Rename(tmp.Name(), e.cfg.File); err != nil {
+		return fmt.Errorf("failed to save %s: %w", e.cfg.File, err)
+	}
+
+	_, _ = fmt.Fprintf(e.stdout, "downloaded s3://%s/%s to %s (%d bytes)\n", e.cfg.Bucket, e.cfg.Key, e.cfg.File, size)
+	return nil
+}
+
+// objectURL returns the URL of the object. The bucket is in the host for
+// Amazon S3 and in the path for the custom endpoint.
+func (e *s3) objectURL() (*url.URL, error) {
+	key := strings.TrimPrefix(e.cfg.Key, "/")
+	if e.cfg.Endpoint == "" {
+		return &url.URL{
+			Scheme:  "https",
+			Host:    fmt.Sprintf("%s.s3.%s.amazonaws.com", e.cfg.Bucket, e.cfg.Region),
+			Path:    "/" + key,
+			RawPath: "/" + s3EscapePath(key),
+		}, nil
+	}
+
+	endpoint := e.cfg.Endpoint
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("%w: %s", errS3InvalidEndpoint, e.cfg.Endpoint)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + e.cfg.Bucket + "/" + key
+	u.RawPath = s3EscapePath(u.Path)
+	return u, nil
+}
+
+// checkS3Response returns the error in the response if the request failed.
+func checkS3Response(resp *nethttp.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	return fmt.Errorf("%w: %s: %s", errS3RequestFailed, resp.Status, strings.TrimSpace(string(body)))
+}
+
+func decodeS3Config(dat map[string]any, cfg *s3Config) error {
+	md, _ := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		WeaklyTypedInput: true,
+		ErrorUnused:      false,
+		Result:           cfg,
+	})
+	return md.Decode(dat)
+}
+
+// awsCredentials are the credentials to sign the requests.
+type awsCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+// credentialsTimeout is the timeout of each request to get the credentials
+// of the IAM role.
+const credentialsTimeout = 2 * time.Second
+
+// resolveAWSCredentials returns the credentials in the order of the static
+// credentials in the config, the environment variables, the IAM role of
+// the container (ECS) and the IAM role of the instance (EC2).
+func resolveAWSCredentials(ctx context.Context, client *nethttp.Client, cfg *s3Config) (awsCredentials, error) {
+	if cfg.AccessKeyID != "" {
+		return awsCredentials{
+			AccessKeyID:     cfg.AccessKeyID,
+			SecretAccessKey: cfg.SecretAccessKey,
+			SessionToken:    cfg.SessionToken,
+		}, nil
+	}
+
+	if id, secret := os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY"); id != "" && secret != "" {
+		return awsCredentials{
+			AccessKeyID:     id,
+			SecretAccessKey: secret,
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		}, nil
+	}
+
+	if uri := containerCredentialsURI(); uri != "" {
+		creds, err := containerCredentials(ctx, client, uri)
+		if err != nil {
+			return awsCredentials{}, fmt.Errorf("failed to get the credentials of the container: %w", err)
+		}
+		return creds, nil
+	}
+
+	creds, err := instanceCredentials(ctx, client)
+	if err != nil {
+		return awsCredentials{}, fmt.Errorf("%w: %w", errS3NoCredentials, err)
+	}
+	return creds, nil
+}
+
+// containerCredentialsURI returns the URI of the credentials of the task
+// role set by ECS, or empty if it's not running on ECS.
+func containerCredentialsURI() string {
+	if uri := os.Getenv("AWS_CONTAINER_CREDENTIALS_FULL_URI"); uri != "" {
+		return uri
+	}
+	if path := os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"); path != "" {
+		return "http://169.254.170.2" + path
+	}
+	return ""
+}
+
+// roleCredentials is the response of the credentials of the IAM role.
+type roleCredentials struct {
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string `json:"SecretAccessKey"`
+	Token           string `json:"Token"`
+}
+
+func containerCredentials(ctx context.Context, client *nethttp.Client, uri string) (awsCredentials, error) {
+	header := nethttp.Header{}
+	if token := os.Getenv("AWS_CONTAINER_AUTHORIZATION_TOKEN"); token != "" {
+		header.Set("Authorization", token)
+	}
+	return fetchRoleCredentials(ctx, client, uri, header)
+}
+
+// instanceCredentials gets the credentials of the IAM role of the EC2
+// instance from the instance metadata service (IMDSv2).
+func instanceCredentials(ctx context.Context, client *nethttp.Client) (awsCredentials, error) {
+	endpoint := firstNonEmpty(os.Getenv("AWS_EC2_METADATA_SERVICE_ENDPOINT"), "http://169.254.169.254")
+	endpoint = strings.TrimSuffix(endpoint, "/")
+
+	token, err := metadataRequest(ctx, client, nethttp.MethodPut, endpoint+"/latest/api/token", nethttp.Header{
+		"X-Aws-Ec2-Metadata-Token-Ttl-Seconds": []string{"21600"},
+	})
+	if err != nil {
+		return awsCredentials{}, err
+	}
+	header := nethttp.Header{"X-Aws-Ec2-Metadata-Token": []string{token}}
+
+	roles, err := metadataRequest(ctx, client, nethttp.MethodGet, endpoint+"/latest/meta-data/iam/security-credentials/", header)
+	if err != nil {
+		return awsCredentials{}, err
+	}
+	role, _, _ := strings.Cut(strings.TrimSpace(roles), "\n")
+	if role == "" {
+		return awsCredentials{}, errors.New("no IAM role is attached to the instance")
+	}
+	return fetchRoleCredentials(ctx, client, endpoint+"/latest/meta-data/iam/security-credentials/"+role, header)
+}
+
+func fetchRoleCredentials(ctx context.Context, client *nethttp.Client, uri string, header nethttp.Header) (awsCredentials, error) {
+	body, err := metadataRequest(ctx, client, nethttp.MethodGet, uri, header)
+	if err != nil {
+		return awsCredentials{}, err
+	}
+	var creds roleCredentials
+	if err := json.Unmarshal([]byte(body), &creds); err != nil {
+		return awsCredentials{}, fmt.Errorf("failed to parse the credentials: %w", err)
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return awsCredentials{}, errors.New("the credentials are empty")
+	}
+	return awsCredentials{
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.Token,
+	}, nil
+}
+
+func metadataRequest(ctx context.Context, client *nethttp.Client, method, uri string, header nethttp.Header) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialsTimeout)
+	defer cancel()
+
+	req, err := nethttp.NewRequestWithContext(ctx, method, uri, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header = header
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != nethttp.StatusOK {
+		return "", fmt.Errorf("%s: %s", uri, resp.Status)
+	}
+	return string(body), nil
+}
+
+// emptyPayloadHash is the SHA-256 hash of the empty body.
+const emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// signS3Request signs the request with the AWS Signature Version 4. The
+// host and the headers set in the request are signed.
+func signS3Request(req *nethttp.Request, creds awsCredentials, region, payloadHash string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.Join(values, ",")
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+creds.SecretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		creds.AccessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		values := query[k]
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, s3Escape(k, true)+"="+s3Escape(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// s3EscapePath escapes the path of the object keeping the slashes.
+func s3EscapePath(p string) string {
+	return s3Escape(p, false)
+}
+
+// s3Escape escapes the characters other than the unreserved ones as
+// required by the signature.
+func s3Escape(s string, escapeSlash bool) string {
+	var sb strings.Builder
+	for _, b := range []byte(s) {
+		switch {
+		case 'A' <= b && b <= 'Z', 'a' <= b && b <= 'z', '0' <= b && b <= '9',
+			b == '-', b == '_', b == '.', b == '~':
+			sb.WriteByte(b)
+		case b == '/' && !escapeSlash:
+			sb.WriteByte(b)
+		default:
+			fmt.Fprintf(&sb, "%%%02X", b)
+		}
+	}
+	return sb.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func init() {
+	Register("s3", newS3)
+}
@@ -8,21 +8,32 @@ import (
 	"net"
 	"os"
 	"strings"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/go-viper/mapstructure/v2"
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/sys/unix"
 
+	"github.com/dagu-org/dagu/internal/cmdutil"
 	"github.com/dagu-org/dagu/internal/digraph"
 )
 
 var _ Executor = (*sshExec)(nil)
 
 type sshExec struct {
+	stopper
 	step      digraph.Step
 	config    *sshExecConfig
 	sshConfig *ssh.ClientConfig
 	stdout    io.Writer
+	lock      sync.Mutex
 	session   *ssh.Session
+	stdin     io.Writer
+	// graceTimer closes the connection when the remote command doesn't
+	// stop within the grace period after the signal.
+	graceTimer *time.Timer
 }
 
 type sshExecConfigDefinition struct {
@@ -32,14 +43,34 @@ type sshExecConfigDefinition struct {
 	Key                   string
 	Password              string
 	StrictHostKeyChecking bool
+	// Pty requests a pseudo-terminal for the remote command so that the
+	// command is hung up when the connection is closed.
+	Pty bool
+	// GracePeriodSec is the time in seconds to wait for the remote command
+	// to stop after the signal before the connection is closed.
+	GracePeriodSec *int
 }
 
 type sshExecConfig struct {
-	User     string
-	IP       string
-	Port     string
-	Key      string
-	Password string
+	User        string
+	IP          string
+	Port        string
+	Key         string
+	Password    string
+	Pty         bool
+	GracePeriod time.Duration
+}
+
+// defaultSSHGracePeriod is the default time to wait for the remote command
+// to stop after the signal.
+const defaultSSHGracePeriod = 10 * time.Second
+
+// ptyControlChars is the control characters written to the pseudo-terminal
+// to send the signals. The servers that don't support the signal requests
+// still deliver them to the remote command.
+var ptyControlChars = map[syscall.Signal]string{
+	syscall.SIGINT:  "\x03",
+	syscall.SIGQUIT: "\x1c",
 }
 
 // selectSSHAuthMethod selects the authentication method based on the configuration.
@@ -82,12 +113,18 @@ func newSSHExec(ctx context.Context, step digraph.Step) (Executor, error) {
 	}
 
 	stepContext := digraph.GetStepContext(ctx)
+	gracePeriod := defaultSSHGracePeriod
+	if def.GracePeriodSec != nil {
+		gracePeriod = time.Duration(*def.GracePeriodSec) * time.Second
+	}
 	cfg, err := digraph.EvalStringFields(stepContext, sshExecConfig{
-		User:     def.User,
-		IP:       def.IP,
-		Key:      def.Key,
-		Password: def.Password,
-		Port:     def.Port,
+		User:        def.User,
+		IP:          def.IP,
+		Key:         def.Key,
+		Password:    def.Password,
+		Port:        def.Port,
+		Pty:         def.Pty,
+		GracePeriod: gracePeriod,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to substitute string fields for ssh config: %w", err)
@@ -98,11 +135,6 @@ func newSSHExec(ctx context.Context, step digraph.Step) (Executor, error) {
 		return nil, errStrictHostKey
 	}
 
-	cfg, err = digraph.EvalStringFields(stepContext, cfg)
-	if err != nil {
-		return nil, fmt.Errorf("failed to substitute string fields for ssh config: %w", err)
-	}
-
 	// Select the authentication method.
 	authMethod, err := selectSSHAuthMethod(&cfg)
 	if err != nil {
@@ -136,34 +168,97 @@ func (e *sshExec) SetStderr(out io.Writer) {
 	e.stdout = out
 }
 
-func (e *sshExec) Kill(_ os.Signal) error {
-	if e.session != nil {
-		return e.session.Close()
+// Kill sends the signal to the remote command and closes the connection if
+// the command doesn't stop within the grace period. The connection is
+// closed immediately if the signal is SIGKILL or it can't be sent.
+func (e *sshExec) Kill(sig os.Signal) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.session == nil || isForceKill(sig) {
+		e.stop()
+		return nil
+	}
+
+	signal := sig.(syscall.Signal)
+	name := strings.TrimPrefix(unix.SignalName(signal), "SIG")
+	if err := e.session.Signal(ssh.Signal(name)); err != nil {
+		e.stop()
+		return nil
+	}
+	if c, ok := ptyControlChars[signal]; ok && e.stdin != nil {
+		_, _ = io.WriteString(e.stdin, c)
+	}
+	if e.graceTimer == nil {
+		e.graceTimer = time.AfterFunc(e.config.GracePeriod, e.stop)
 	}
 	return nil
 }
 
-func (e *sshExec) Run(_ context.Context) error {
+func (e *sshExec) Run(ctx context.Context) error {
+	ctx, cancel := e.start(ctx)
+	defer cancel()
+
 	addr := net.JoinHostPort(e.config.IP, e.config.Port)
-	conn, err := ssh.Dial("tcp", addr, e.sshConfig)
+	var dialer net.Dialer
+	netConn, err := dialer.DialContext(ctx, "tcp", addr)
 	if err != nil {
 		return err
 	}
+	clientConn, chans, reqs, err := ssh.NewClientConn(netConn, addr, e.sshConfig)
+	if err != nil {
+		_ = netConn.Close()
+		return err
+	}
+	conn := ssh.NewClient(clientConn, chans, reqs)
+	defer conn.Close()
+
+	// Closing the connection stops the remote command.
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.Close()
+	})
+	defer stop()
 
 	session, err := conn.NewSession()
 	if err != nil {
 		return err
 	}
-	e.session = session
 	defer session.Close()
 
+	var stdin io.Writer
+	if e.config.Pty {
+		modes := ssh.TerminalModes{ssh.ECHO: 0}
+		if err := session.RequestPty("xterm", 40, 80, modes); err != nil {
+			return fmt.Errorf("failed to request pty: %w", err)
+		}
+		if stdin, err = session.StdinPipe(); err != nil {
+			return err
+		}
+	}
+
+	e.lock.Lock()
+	e.session, e.stdin = session, stdin
+	e.lock.Unlock()
+	defer func() {
+		e.lock.Lock()
+		defer e.lock.Unlock()
+		e.session, e.stdin = nil, nil
+		if e.graceTimer != nil {
+			e.graceTimer.Stop()
+			e.graceTimer = nil
+		}
+	}()
+
 	// Once a Session is created, you can execute a single command on
 	// the remote side using the Run method.
 	session.Stdout = e.stdout
 	session.Stderr = e.stdout
-	command := strings.Join(
-		append([]string{e.step.Command}, e.step.Args...), " ",
-	)
+	// The command is run by the shell on the remote host. The command is
+	// used as it's written if it's given as a string.
+	command := e.step.ShellCmdArgs
+	if command == "" {
+		command = cmdutil.BuildCommandEscapedString(e.step.Command, e.step.Args)
+	}
 	return session.Run(command)
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/stretchr/testify/assert"
@@ -37,6 +38,31 @@ func TestSSHExecutor(t *testing.T) {
 		assert.Equal(t, "testip", sshExec.config.IP)
 		assert.Equal(t, "25", sshExec.config.Port)
 		assert.Equal(t, "testpassword", sshExec.config.Password)
+		assert.False(t, sshExec.config.Pty)
+		assert.Equal(t, defaultSSHGracePeriod, sshExec.config.GracePeriod)
+	})
+
+	t.Run("PtyAndGracePeriod", func(t *testing.T) {
+		step := digraph.Step{
+			Name: "ssh-exec",
+			ExecutorConfig: digraph.ExecutorConfig{
+				Type: "ssh",
+				Config: map[string]any{
+					"User":           "testuser",
+					"IP":             "testip",
+					"Pty":            true,
+					"GracePeriodSec": "30",
+				},
+			},
+		}
+		exec, err := newSSHExec(context.Background(), step)
+		require.NoError(t, err)
+
+		sshExec, ok := exec.(*sshExec)
+		require.True(t, ok)
+
+		assert.True(t, sshExec.config.Pty)
+		assert.Equal(t, 30*time.Second, sshExec.config.GracePeriod)
 	})
 
 	t.Run("ExpandEnv", func(t *testing.T) {
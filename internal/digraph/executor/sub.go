@@ -7,6 +7,8 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"strings"
 	"sync"
 	"syscall"
 
@@ -16,6 +18,8 @@ import (
 )
 
 var _ Executor = (*subWorkflow)(nil)
+var _ ResourceUsageReporter = (*subWorkflow)(nil)
+var _ SubRunner = (*subWorkflow)(nil)
 
 type subWorkflow struct {
 	subDAG    string
@@ -48,7 +52,9 @@ func newSubWorkflow(
 		return nil, fmt.Errorf("failed to substitute string fields: %w", err)
 	}
 
-	subDAG, err := stepContext.GetDAGByName(config.Name)
+	subDAG, err := stepContext.GetDAGByName(
+		resolveSubDAG(stepContext.DAG(), config.Name),
+	)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"failed to find subworkflow %q: %w", config.Name, err,
@@ -63,21 +69,27 @@ func newSubWorkflow(
 	args := []string{
 		"start",
 		fmt.Sprintf("--requestID=%s", requestID),
+		fmt.Sprintf("--parentRequestID=%s", stepContext.RequestID()),
 		"--quiet",
-		subDAG.Location,
 	}
+	if parent := stepContext.DAG(); parent != nil {
+		args = append(args, fmt.Sprintf("--parentDAG=%s", parent.ID()))
+	}
+	args = append(args, subDAG.Location)
 
 	if config.Params != "" {
 		args = append(args, "--")
 		args = append(args, config.Params)
 	}
 
-	cmd := exec.CommandContext(ctx, executable, args...)
+	cmd := exec.Command(executable, args...)
 	if len(step.Dir) > 0 && !fileutil.FileExists(step.Dir) {
 		return nil, errWorkingDirNotExist
 	}
 	cmd.Dir = step.Dir
-	cmd.Env = append(cmd.Env, stepContext.AllEnvs()...)
+	// The sub DAG is run by Dagu itself, which needs its configuration in
+	// the environment. Its steps are filtered by its own agent.
+	cmd.Env = append(os.Environ(), stepContext.AllEnvs()...)
 
 	cmd.SysProcAttr = &syscall.SysProcAttr{
 		Setpgid: true,
@@ -92,13 +104,7 @@ func newSubWorkflow(
 }
 
 func (e *subWorkflow) Run(ctx context.Context) error {
-	e.lock.Lock()
-	err := e.cmd.Start()
-	e.lock.Unlock()
-	if err != nil {
-		return err
-	}
-	if err := e.cmd.Wait(); err != nil {
+	if err := startProcess(ctx, &e.lock, e.cmd); err != nil {
 		return err
 	}
 
@@ -121,6 +127,16 @@ func (e *subWorkflow) Run(ctx context.Context) error {
 	return nil
 }
 
+// SubRequestID implements SubRunner.
+func (e *subWorkflow) SubRequestID() string {
+	return e.requestID
+}
+
+// ResourceUsage implements ResourceUsageReporter.
+func (e *subWorkflow) ResourceUsage() *ResourceUsage {
+	return processUsage(e.cmd)
+}
+
 func (e *subWorkflow) SetStdout(out io.Writer) {
 	e.cmd.Stdout = out
 	e.writer = out
@@ -131,18 +147,30 @@ func (e *subWorkflow) SetStderr(out io.Writer) {
 }
 
 func (e *subWorkflow) Kill(sig os.Signal) error {
-	e.lock.Lock()
-	defer e.lock.Unlock()
-	if e.cmd == nil || e.cmd.Process == nil {
-		return nil
-	}
-	return syscall.Kill(-e.cmd.Process.Pid, sig.(syscall.Signal))
+	return killProcess(&e.lock, e.cmd, sig)
 }
 
 func init() {
 	Register(digraph.ExecutorTypeSubWorkflow, newSubWorkflow)
 }
 
+// resolveSubDAG resolves the relative path of the sub DAG, e.g.
+// `./child.yaml`, against the directory of the parent DAG file. The names
+// without a path are looked up in the DAGs directory.
+func resolveSubDAG(parent *digraph.DAG, name string) string {
+	if parent == nil || parent.Location == "" || filepath.IsAbs(name) {
+		return name
+	}
+	if !strings.ContainsRune(name, filepath.Separator) {
+		return name
+	}
+	resolved := filepath.Join(filepath.Dir(parent.Location), name)
+	if !fileutil.FileExists(resolved) {
+		return name
+	}
+	return resolved
+}
+
 // generateRequestID generates a new request ID.
 // For simplicity, we use UUIDs as request IDs.
 func generateRequestID() (string, error) {
@@ -0,0 +1,262 @@
+package digraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dagu-org/dagu/internal/cmdutil"
+)
+
+// reForeachRange matches a range of integers, e.g. "1..10".
+var reForeachRange = regexp.MustCompile(`^\s*(-?\d+)\s*\.\.\s*(-?\d+)\s*$`)
+
+// maxForeachItems is the maximum number of items in a foreach list.
+const maxForeachItems = 10000
+
+// buildForeach parses the foreach field in the step definition.
+// Case 1: foreach is a list of items
+// Case 2: foreach is a range of integers (e.g. "1..10")
+// Case 3: foreach is a string evaluated to a JSON list or space-separated values
+// Case 4: foreach is a map with "items" (one of the above) and "maxParallel"
+func buildForeach(ctx BuildContext, def stepDef, step *Step) error {
+	if def.Foreach == nil {
+		return nil
+	}
+	if def.Expand != nil {
+		return wrapError("foreach", def.Foreach, errForeachWithExpand)
+	}
+
+	value := def.Foreach
+	var maxParallel int
+	if m, ok := value.(map[any]any); ok {
+		for key, v := range m {
+			switch key {
+			case "items":
+				value = v
+
+			case "maxParallel":
+				n, ok := v.(int)
+				if !ok || n < 0 {
+					return wrapError("foreach.maxParallel", v, errForeachMaxParallelMustBeInt)
+				}
+				maxParallel = n
+
+			default:
+				return wrapError("foreach", key, fmt.Errorf("%w: unknown key %v", errInvalidForeach, key))
+
+			}
+		}
+		if _, ok := m["items"]; !ok {
+			return wrapError("foreach", def.Foreach, fmt.Errorf("%w: items is required", errInvalidForeach))
+		}
+	}
+
+	items, evaluated, err := parseForeachItems(ctx, value)
+	if err != nil {
+		return wrapError("foreach", value, fmt.Errorf("%w: %s", errInvalidForeach, err))
+	}
+	if !evaluated {
+		// The items are not known without evaluation.
+		return nil
+	}
+	if len(items) > maxForeachItems {
+		return wrapError("foreach", value, fmt.Errorf("%w: too many items (max %d)", errInvalidForeach, maxForeachItems))
+	}
+
+	step.foreach = &foreach{items: items, maxParallel: maxParallel}
+	return nil
+}
+
+// parseForeachItems parses the items of the foreach field. It returns false
+// if the items depend on variables and the evaluation is disabled.
+func parseForeachItems(ctx BuildContext, value any) ([]string, bool, error) {
+	switch v := value.(type) {
+	case []any:
+		items, err := foreachItemsFromList(v)
+		return items, true, err
+
+	case string:
+		if m := reForeachRange.FindStringSubmatch(v); m != nil {
+			items, err := foreachItemsFromRange(m[1], m[2])
+			return items, true, err
+		}
+		if ctx.opts.noEval {
+			return nil, false, nil
+		}
+		evaluated, err := cmdutil.EvalString(ctx.ctx, v)
+		if err != nil {
+			return nil, false, err
+		}
+		evaluated = strings.TrimSpace(evaluated)
+		if strings.HasPrefix(evaluated, "[") {
+			var list []any
+			if err := json.Unmarshal([]byte(evaluated), &list); err != nil {
+				return nil, false, fmt.Errorf("failed to parse JSON list: %w", err)
+			}
+			items, err := foreachItemsFromList(list)
+			return items, true, err
+		}
+		return strings.Fields(evaluated), true, nil
+
+	default:
+		return nil, false, fmt.Errorf("list or string expected, got %T", v)
+
+	}
+}
+
+func foreachItemsFromList(list []any) ([]string, error) {
+	items := make([]string, 0, len(list))
+	for _, item := range list {
+		switch v := item.(type) {
+		case string:
+			items = append(items, v)
+
+		case map[any]any, map[string]any, []any:
+			data, err := json.Marshal(jsonCompatible(v))
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, string(data))
+
+		default:
+			items = append(items, fmt.Sprint(v))
+
+		}
+	}
+	return items, nil
+}
+
+func foreachItemsFromRange(from, to string) ([]string, error) {
+	start, err := strconv.Atoi(from)
+	if err != nil {
+		return nil, err
+	}
+	end, err := strconv.Atoi(to)
+	if err != nil {
+		return nil, err
+	}
+	if start > end {
+		return nil, fmt.Errorf("invalid range %d..%d", start, end)
+	}
+	if end-start >= maxForeachItems {
+		return nil, fmt.Errorf("too many items (max %d)", maxForeachItems)
+	}
+	var items []string
+	for i := start; i <= end; i++ {
+		items = append(items, strconv.Itoa(i))
+	}
+	return items, nil
+}
+
+// jsonCompatible converts the maps decoded from YAML to maps with string
+// keys so that they can be encoded in JSON.
+func jsonCompatible(v any) any {
+	switch v := v.(type) {
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for key, value := range v {
+			m[fmt.Sprint(key)] = jsonCompatible(value)
+		}
+		return m
+
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for key, value := range v {
+			m[key] = jsonCompatible(value)
+		}
+		return m
+
+	case []any:
+		list := make([]any, len(v))
+		for i, value := range v {
+			list[i] = jsonCompatible(value)
+		}
+		return list
+
+	default:
+		return v
+
+	}
+}
+
+// expandForeachSteps replaces the steps with a foreach list by a step for
+// each item named "<name>[<index>]". The dependencies on the replaced steps
+// are changed to the dependencies on all of the generated steps.
+func expandForeachSteps(steps []Step) []Step {
+	iterations := make(map[string][]string)
+	var ret []Step
+	for _, step := range steps {
+		if step.foreach == nil {
+			ret = append(ret, step)
+			continue
+		}
+
+		names := []string{}
+		for i, item := range step.foreach.items {
+			it := step
+			it.foreach = nil
+			it.Name = fmt.Sprintf("%s[%d]", step.Name, i)
+			it.ExpandItem = &ExpandItem{Source: step.Name, Index: i, Value: item}
+			if step.foreach.maxParallel > 0 {
+				it.ParallelGroup = step.Name
+				it.MaxParallel = step.foreach.maxParallel
+			}
+			ret = append(ret, it)
+			names = append(names, it.Name)
+		}
+		iterations[step.Name] = names
+	}
+
+	if len(iterations) == 0 {
+		return ret
+	}
+
+	for i := range ret {
+		step := &ret[i]
+
+		var depends []string
+		for _, dep := range step.Depends {
+			if names, ok := iterations[dep]; ok {
+				depends = append(depends, names...)
+				continue
+			}
+			depends = append(depends, dep)
+		}
+		step.Depends = depends
+
+		if step.DependsOn != nil {
+			dependsOn := make(map[string]DependencyCondition)
+			for dep, cond := range step.DependsOn {
+				if names, ok := iterations[dep]; ok {
+					for _, name := range names {
+						dependsOn[name] = cond
+					}
+					continue
+				}
+				dependsOn[dep] = cond
+			}
+			step.DependsOn = dependsOn
+		}
+
+		var anyOf [][]string
+		for _, group := range step.DependsAnyOf {
+			var g []string
+			for _, dep := range group {
+				if names, ok := iterations[dep]; ok {
+					g = append(g, names...)
+					continue
+				}
+				g = append(g, dep)
+			}
+			if len(g) > 0 {
+				anyOf = append(anyOf, g)
+			}
+		}
+		step.DependsAnyOf = anyOf
+	}
+
+	return ret
+}
@@ -0,0 +1,179 @@
+// Package hook runs the scripts at the hook points of the run of a DAG.
+//
+// The scripts are written in Starlark, a dialect of Python. The following
+// names are available to the scripts:
+//
+//   - dag_name: the name of the DAG.
+//   - params: the dict of the parameters of the DAG.
+//   - outputs: the dict of the output variables of the finished steps.
+//   - step: the step with the name, status, exit_code and error fields,
+//     or None in the beforeRun hook.
+//   - skip(reason): skips the step. It's only available in the beforeStep
+//     hook.
+//
+// The built-in fail(msg) fails the hook. The output of print is written to
+// the log of the run.
+package hook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/dagu-org/dagu/internal/logger"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"go.starlark.net/syntax"
+)
+
+// Point is the point in the run where a hook is run.
+type Point string
+
+const (
+	BeforeRun  Point = "beforeRun"
+	BeforeStep Point = "beforeStep"
+	AfterStep  Point = "afterStep"
+)
+
+// maxExecutionSteps is the maximum number of the computation steps of a
+// hook to stop the scripts that never finish.
+const maxExecutionSteps = 10_000_000
+
+// ErrSkip is returned when the beforeStep hook skips the step.
+var ErrSkip = errors.New("skipped by hook")
+
+var errSkipNotAllowed = errors.New("skip() is only available in the beforeStep hook")
+
+// fileOptions allows the control statements at the top level so that the
+// scripts don't need to be wrapped in a function.
+var fileOptions = &syntax.FileOptions{
+	Set:             true,
+	While:           true,
+	TopLevelControl: true,
+	GlobalReassign:  true,
+}
+
+// predeclared is the names predeclared for the scripts.
+var predeclared = map[string]struct{}{
+	"dag_name": {},
+	"params":   {},
+	"outputs":  {},
+	"step":     {},
+	"skip":     {},
+}
+
+// Vars is the variables available to the scripts.
+type Vars struct {
+	DAGName string
+	Params  map[string]string
+	Outputs map[string]string
+	// Step is the step of the beforeStep and afterStep hooks.
+	Step *Step
+}
+
+// Step is the step available to the scripts.
+type Step struct {
+	Name     string
+	Status   string
+	ExitCode int
+	Error    string
+}
+
+// Check parses the script and returns an error if it's invalid.
+func Check(point Point, script string) error {
+	_, _, err := starlark.SourceProgramOptions(fileOptions, string(point), script, isPredeclared)
+	return err
+}
+
+// Run runs the script of the hook point. It returns an error wrapping
+// ErrSkip if the script skips the step, or the error of the script if it
+// fails.
+func Run(ctx context.Context, point Point, script string, vars Vars) error {
+	var skipReason *string
+	skip := starlark.NewBuiltin("skip", func(
+		_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple,
+	) (starlark.Value, error) {
+		if point != BeforeStep {
+			return nil, errSkipNotAllowed
+		}
+		var reason string
+		if err := starlark.UnpackArgs(b.Name(), args, kwargs, "reason?", &reason); err != nil {
+			return nil, err
+		}
+		skipReason = &reason
+		return starlark.None, nil
+	})
+
+	var step starlark.Value = starlark.None
+	if vars.Step != nil {
+		step = starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+			"name":      starlark.String(vars.Step.Name),
+			"status":    starlark.String(vars.Step.Status),
+			"exit_code": starlark.MakeInt(vars.Step.ExitCode),
+			"error":     starlark.String(vars.Step.Error),
+		})
+	}
+
+	env := starlark.StringDict{
+		"dag_name": starlark.String(vars.DAGName),
+		"params":   toDict(vars.Params),
+		"outputs":  toDict(vars.Outputs),
+		"step":     step,
+		"skip":     skip,
+	}
+
+	thread := &starlark.Thread{
+		Name: string(point),
+		Print: func(_ *starlark.Thread, msg string) {
+			logger.Info(ctx, "Hook output", "hook", point, "msg", msg)
+		},
+	}
+	thread.SetMaxExecutionSteps(maxExecutionSteps)
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			thread.Cancel(ctx.Err().Error())
+		case <-done:
+		}
+	}()
+
+	if _, err := starlark.ExecFileOptions(fileOptions, thread, string(point), script, env); err != nil {
+		var evalErr *starlark.EvalError
+		if errors.As(err, &evalErr) {
+			return fmt.Errorf("%s hook failed: %s", point, evalErr.Msg)
+		}
+		return fmt.Errorf("%s hook failed: %w", point, err)
+	}
+	if skipReason != nil {
+		if *skipReason == "" {
+			return ErrSkip
+		}
+		return fmt.Errorf("%w: %s", ErrSkip, *skipReason)
+	}
+	return nil
+}
+
+func isPredeclared(name string) bool {
+	_, ok := predeclared[name]
+	return ok
+}
+
+// toDict converts the map to the frozen dict sorted by the keys.
+func toDict(m map[string]string) *starlark.Dict {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	dict := starlark.NewDict(len(m))
+	for _, k := range keys {
+		_ = dict.SetKey(starlark.String(k), starlark.String(m[k]))
+	}
+	dict.Freeze()
+	return dict
+}
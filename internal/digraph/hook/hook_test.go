@@ -0,0 +1,76 @@
+package hook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		script := `
+if params["ENV"] == "prod" and step.name == "deploy":
+    skip("not in prod")
+`
+		require.NoError(t, Check(BeforeStep, script))
+	})
+	t.Run("SyntaxError", func(t *testing.T) {
+		require.Error(t, Check(BeforeStep, "if :"))
+	})
+	t.Run("UndefinedName", func(t *testing.T) {
+		require.Error(t, Check(BeforeRun, "print(unknown)"))
+	})
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	vars := Vars{
+		DAGName: "test",
+		Params:  map[string]string{"ENV": "prod"},
+		Outputs: map[string]string{"RESULT": "ok"},
+		Step:    &Step{Name: "deploy", Status: "success", ExitCode: 0},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		script := `
+if dag_name != "test" or outputs["RESULT"] != "ok":
+    fail("unexpected variables")
+`
+		require.NoError(t, Run(ctx, AfterStep, script, vars))
+	})
+	t.Run("Fail", func(t *testing.T) {
+		err := Run(ctx, AfterStep, `fail("invalid result")`, vars)
+		require.ErrorContains(t, err, "invalid result")
+		require.NotErrorIs(t, err, ErrSkip)
+	})
+	t.Run("Skip", func(t *testing.T) {
+		err := Run(ctx, BeforeStep, `skip("not in prod")`, vars)
+		require.ErrorIs(t, err, ErrSkip)
+		require.ErrorContains(t, err, "not in prod")
+	})
+	t.Run("SkipNotAllowed", func(t *testing.T) {
+		err := Run(ctx, AfterStep, `skip()`, vars)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrSkip)
+	})
+	t.Run("NoStep", func(t *testing.T) {
+		require.NoError(t, Run(ctx, BeforeRun, `
+if step != None:
+    fail("step is set")
+`, Vars{}))
+	})
+	t.Run("ReadOnly", func(t *testing.T) {
+		require.Error(t, Run(ctx, BeforeRun, `params["ENV"] = "dev"`, vars))
+	})
+	t.Run("Cancel", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		err := Run(ctx, BeforeRun, `
+for i in range(1000000000):
+    pass
+`, vars)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,102 @@
+package digraph
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// baseHostEnv is the variables of the host always passed to the processes
+// of the steps unless they're denied. They're needed by most commands and
+// hold no secret.
+var baseHostEnv = []string{
+	"PATH", "HOME", "USER", "LOGNAME", "SHELL", "PWD", "HOSTNAME",
+	"LANG", "LANGUAGE", "LC_*", "TZ", "TERM", "TMPDIR", "TEMP", "TMP",
+	// Windows
+	"SYSTEMROOT", "SYSTEMDRIVE", "WINDIR", "COMSPEC", "PATHEXT", "USERPROFILE",
+}
+
+// hostEnv is the names of the variables inherited by the process. The ones
+// set later, e.g. by the env and the params of the DAG, aren't from the host.
+var hostEnv = envNames(os.Environ())
+
+var (
+	hostEnvPolicyMu sync.RWMutex
+	hostEnvAllow    = baseHostEnv
+	hostEnvDeny     []string
+)
+
+// SetHostEnvPolicy sets which variables of the host are passed to the
+// processes of the steps. A variable is passed if its name matches one of
+// the allowed patterns, in addition to the base variables like PATH and
+// HOME, and none of the denied ones. The patterns are matched with
+// path.Match, e.g. "AWS_*". The variables set by the DAG are always passed.
+func SetHostEnvPolicy(allow, deny []string) error {
+	for _, patterns := range [][]string{allow, deny} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("invalid pattern %q of the host environment: %w", pattern, err)
+			}
+		}
+	}
+	hostEnvPolicyMu.Lock()
+	defer hostEnvPolicyMu.Unlock()
+	hostEnvAllow = append(append([]string{}, baseHostEnv...), allow...)
+	hostEnvDeny = deny
+	return nil
+}
+
+// hostEnvAllowed returns whether the variable of the host is passed to the
+// processes of the steps.
+func hostEnvAllowed(name string) bool {
+	hostEnvPolicyMu.RLock()
+	defer hostEnvPolicyMu.RUnlock()
+	return matchEnvName(hostEnvAllow, name) && !matchEnvName(hostEnvDeny, name)
+}
+
+func matchEnvName(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// filterHostEnv removes the variables of the host not allowed by the policy
+// from the environment of the process. The variables set by the DAG are
+// kept even if the host has them too.
+func filterHostEnv(envs []string, dag *DAG) []string {
+	declared := envNames(dag.Env)
+	for i := range dag.Params {
+		// The positional params $1, $2, ...
+		declared[strconv.Itoa(i+1)] = struct{}{}
+	}
+	for _, key := range dag.dotenvKeys {
+		declared[key] = struct{}{}
+	}
+
+	ret := make([]string, 0, len(envs))
+	for _, env := range envs {
+		name, _, _ := strings.Cut(env, "=")
+		_, fromHost := hostEnv[name]
+		_, fromDAG := declared[name]
+		if fromHost && !fromDAG && !hostEnvAllowed(name) {
+			continue
+		}
+		ret = append(ret, env)
+	}
+	return ret
+}
+
+func envNames(envs []string) map[string]struct{} {
+	ret := make(map[string]struct{}, len(envs))
+	for _, env := range envs {
+		name, _, _ := strings.Cut(env, "=")
+		ret[name] = struct{}{}
+	}
+	return ret
+}
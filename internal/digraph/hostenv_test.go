@@ -0,0 +1,45 @@
+package digraph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterHostEnv(t *testing.T) {
+	for _, name := range []string{"PATH", "LC_ALL", "SECRET_TOKEN", "AWS_REGION", "DECLARED", "1"} {
+		if _, ok := hostEnv[name]; !ok {
+			hostEnv[name] = struct{}{}
+			t.Cleanup(func() { delete(hostEnv, name) })
+		}
+	}
+	t.Cleanup(func() {
+		require.NoError(t, SetHostEnvPolicy(nil, nil))
+	})
+
+	dag := &DAG{Env: []string{"DECLARED=dag"}, Params: []string{"param"}}
+	envs := []string{
+		"PATH=/bin", "LC_ALL=C", "SECRET_TOKEN=xxx", "AWS_REGION=eu",
+		"DECLARED=dag", "1=param", "FROM_DAGU=yes",
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		require.Equal(t, []string{
+			"PATH=/bin", "LC_ALL=C", "DECLARED=dag", "1=param", "FROM_DAGU=yes",
+		}, filterHostEnv(envs, dag))
+	})
+	t.Run("AllowAndDeny", func(t *testing.T) {
+		require.NoError(t, SetHostEnvPolicy([]string{"AWS_*"}, []string{"LC_*", "DECLARED"}))
+		// The variables of the DAG are never denied.
+		require.Equal(t, []string{
+			"PATH=/bin", "AWS_REGION=eu", "DECLARED=dag", "1=param", "FROM_DAGU=yes",
+		}, filterHostEnv(envs, dag))
+	})
+	t.Run("AllowAll", func(t *testing.T) {
+		require.NoError(t, SetHostEnvPolicy([]string{"*"}, nil))
+		require.Equal(t, envs, filterHostEnv(envs, dag))
+	})
+	t.Run("InvalidPattern", func(t *testing.T) {
+		require.Error(t, SetHostEnvPolicy([]string{"AWS_["}, nil))
+	})
+}
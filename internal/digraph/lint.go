@@ -0,0 +1,285 @@
+package digraph
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// LintWarning is a problem in the DAG definition that does not prevent
+// the DAG from being loaded but is likely to be a mistake.
+type LintWarning struct {
+	// Step is the name of the step the warning is about. It's empty when
+	// the warning is about the DAG itself.
+	Step string `json:"Step,omitempty"`
+	// Message describes the problem.
+	Message string `json:"Message"`
+}
+
+func (w LintWarning) String() string {
+	if w.Step == "" {
+		return w.Message
+	}
+	return fmt.Sprintf("step %s: %s", w.Step, w.Message)
+}
+
+// Lint loads the DAG definition with the full builder and checks the
+// dependencies of the steps. It returns an error if the DAG cannot run,
+// e.g. a step depends on a step that does not exist or the dependencies
+// have a cycle. Other problems are returned as warnings.
+func Lint(ctx context.Context, data []byte) ([]LintWarning, error) {
+	raw, err := unmarshalData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	def, err := decode(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	dag, err := build(BuildContext{ctx: ctx, opts: buildOpts{noEval: true}}, def)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkDependencies(dag.Steps); err != nil {
+		return nil, err
+	}
+
+	var warnings []LintWarning
+	warnings = append(warnings, lintDeprecatedKeys(raw)...)
+	warnings = append(warnings, lintUnreachableSteps(dag.Steps)...)
+	warnings = append(warnings, lintExecutables(dag.Steps)...)
+	return warnings, nil
+}
+
+// checkDependencies checks that the dependencies of the steps exist and
+// don't have a cycle.
+func checkDependencies(steps []Step) error {
+	deps := make(map[string][]string, len(steps))
+	for _, step := range steps {
+		deps[step.Name] = step.Depends
+	}
+	for _, step := range steps {
+		for _, dep := range step.Depends {
+			if _, ok := deps[dep]; !ok {
+				return wrapError("depends", dep, fmt.Errorf("%w: %s", errDependsStepNotFound, step.Name))
+			}
+		}
+	}
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int, len(steps))
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return wrapError("depends", name, errDependsCycle)
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		for _, dep := range deps[name] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+	for _, step := range steps {
+		if err := visit(step.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lintDeprecatedKeys returns the warnings for the deprecated keys in the
+// definition.
+func lintDeprecatedKeys(raw map[string]any) []LintWarning {
+	var warnings []LintWarning
+	if _, ok := raw["functions"]; ok {
+		warnings = append(warnings, LintWarning{
+			Message: "functions is deprecated, use a sub workflow (run) instead",
+		})
+	}
+
+	var stepDefs []map[string]any
+	switch v := raw["steps"].(type) {
+	case []any:
+		for _, s := range v {
+			if m, ok := toStringMap(s); ok {
+				stepDefs = append(stepDefs, m)
+			}
+		}
+	case map[any]any:
+		var names []string
+		for k := range v {
+			if name, ok := k.(string); ok {
+				names = append(names, name)
+			}
+		}
+		slices.Sort(names)
+		for _, name := range names {
+			if m, ok := toStringMap(v[name]); ok {
+				if _, ok := m["name"]; !ok {
+					m["name"] = name
+				}
+				stepDefs = append(stepDefs, m)
+			}
+		}
+	}
+	for _, m := range stepDefs {
+		name, _ := m["name"].(string)
+		if m["call"] != nil {
+			warnings = append(warnings, LintWarning{
+				Step:    name,
+				Message: "call is deprecated, use a sub workflow (run) instead",
+			})
+		}
+		for _, key := range []string{"command", "script"} {
+			if s, ok := m[key].(string); ok && strings.Contains(s, EnvKeySchedulerLogPath) {
+				warnings = append(warnings, LintWarning{
+					Step:    name,
+					Message: fmt.Sprintf("%s is deprecated, use %s instead", EnvKeySchedulerLogPath, EnvKeyDAGStepLogPath),
+				})
+			}
+		}
+	}
+	return warnings
+}
+
+// toStringMap converts the map decoded from YAML to a map with string keys.
+func toStringMap(v any) (map[string]any, bool) {
+	m, ok := v.(map[any]any)
+	if !ok {
+		return nil, false
+	}
+	ret := make(map[string]any, len(m))
+	for k, v := range m {
+		if key, ok := k.(string); ok {
+			ret[key] = v
+		}
+	}
+	return ret, true
+}
+
+// lintUnreachableSteps returns the warnings for the steps that can never
+// run because they require the same upstream step to both succeed and
+// fail.
+func lintUnreachableSteps(steps []Step) []LintWarning {
+	byName := make(map[string]Step, len(steps))
+	for _, step := range steps {
+		byName[step.Name] = step
+	}
+
+	// requirements is the outcome of the upstream steps required to run
+	// the step. The dependencies are checked to have no cycle.
+	requirements := make(map[string]map[string]DependencyCondition, len(steps))
+	unreachable := make(map[string]string)
+	var requirementsOf func(name string) map[string]DependencyCondition
+	requirementsOf = func(name string) map[string]DependencyCondition {
+		if req, ok := requirements[name]; ok {
+			return req
+		}
+		step := byName[name]
+		req := make(map[string]DependencyCondition)
+		add := func(upstream string, cond DependencyCondition) {
+			if prev, ok := req[upstream]; ok && prev != cond {
+				if _, ok := unreachable[name]; !ok {
+					unreachable[name] = upstream
+				}
+				return
+			}
+			req[upstream] = cond
+		}
+		for _, dep := range step.Depends {
+			if step.IsAnyOfDependency(dep) {
+				// Any one of the group is enough.
+				continue
+			}
+			for upstream, cond := range requirementsOf(dep) {
+				add(upstream, cond)
+			}
+			switch cond := step.DependencyConditionOf(dep); cond {
+			case DependencyOnSuccess:
+				upstream := byName[dep]
+				if upstream.ContinueOn.Failure || len(upstream.ContinueOn.ExitCode) > 0 ||
+					len(upstream.ContinueOn.Output) > 0 {
+					// The step also runs when the upstream step fails.
+					continue
+				}
+				add(dep, cond)
+			case DependencyOnFailure:
+				add(dep, cond)
+			}
+		}
+		requirements[name] = req
+		return req
+	}
+
+	var warnings []LintWarning
+	for _, step := range steps {
+		requirementsOf(step.Name)
+		if upstream, ok := unreachable[step.Name]; ok {
+			warnings = append(warnings, LintWarning{
+				Step:    step.Name,
+				Message: fmt.Sprintf("the step is unreachable because it requires %s to both succeed and fail", upstream),
+			})
+		}
+	}
+	return warnings
+}
+
+// shellBuiltins is the commands that are run by the shell and not found
+// in the PATH.
+var shellBuiltins = []string{
+	".", ":", "[", "alias", "break", "cd", "continue", "eval", "exec", "exit",
+	"export", "read", "return", "set", "shift", "source", "test", "trap",
+	"ulimit", "umask", "unset", "wait",
+}
+
+// lintExecutables returns the warnings for the commands that are not found
+// on this host. The commands with variables are not checked because they
+// are evaluated at run time.
+func lintExecutables(steps []Step) []LintWarning {
+	var warnings []LintWarning
+	for _, step := range steps {
+		if !step.ExecutorConfig.IsCommand() || step.Command == "" {
+			continue
+		}
+		cmd := step.Command
+		if strings.ContainsAny(cmd, "$`") || slices.Contains(shellBuiltins, cmd) {
+			continue
+		}
+		if strings.Contains(cmd, string(filepath.Separator)) {
+			if !filepath.IsAbs(cmd) {
+				// Relative to the working directory of the step.
+				continue
+			}
+			if _, err := os.Stat(cmd); err != nil {
+				warnings = append(warnings, LintWarning{
+					Step:    step.Name,
+					Message: fmt.Sprintf("executable %s is not found", cmd),
+				})
+			}
+			continue
+		}
+		if _, err := exec.LookPath(cmd); err != nil {
+			warnings = append(warnings, LintWarning{
+				Step:    step.Name,
+				Message: fmt.Sprintf("executable %s is not found in PATH", cmd),
+			})
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,86 @@
+package digraph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLint(t *testing.T) {
+	t.Run("NoWarnings", func(t *testing.T) {
+		warnings, err := Lint(context.Background(), []byte(`
+steps:
+  - name: "1"
+    command: "true"
+  - name: "2"
+    command: echo hello
+    depends: "1"
+`))
+		require.NoError(t, err)
+		require.Empty(t, warnings)
+	})
+	t.Run("DependencyNotFound", func(t *testing.T) {
+		_, err := Lint(context.Background(), []byte(`
+steps:
+  - name: "1"
+    command: "true"
+    depends: "2"
+`))
+		require.ErrorIs(t, err, errDependsStepNotFound)
+	})
+	t.Run("Cycle", func(t *testing.T) {
+		_, err := Lint(context.Background(), []byte(`
+steps:
+  - name: "1"
+    command: "true"
+    depends: "2"
+  - name: "2"
+    command: "true"
+    depends: "1"
+`))
+		require.ErrorIs(t, err, errDependsCycle)
+	})
+	t.Run("DeprecatedKeys", func(t *testing.T) {
+		warnings, err := Lint(context.Background(), []byte(`
+steps:
+  - name: "1"
+    command: echo $DAG_SCHEDULER_LOG_PATH
+`))
+		require.NoError(t, err)
+		require.Len(t, warnings, 1)
+		require.Equal(t, "1", warnings[0].Step)
+		require.Contains(t, warnings[0].Message, EnvKeySchedulerLogPath)
+	})
+	t.Run("UnreachableStep", func(t *testing.T) {
+		warnings, err := Lint(context.Background(), []byte(`
+steps:
+  - name: "1"
+    command: "true"
+  - name: "2"
+    command: "true"
+    depends: "1"
+  - name: "3"
+    command: "true"
+    depends:
+      - "2"
+      - step: "1"
+        on: failure
+`))
+		require.NoError(t, err)
+		require.Len(t, warnings, 1)
+		require.Equal(t, "3", warnings[0].Step)
+	})
+	t.Run("MissingExecutable", func(t *testing.T) {
+		warnings, err := Lint(context.Background(), []byte(`
+steps:
+  - name: "1"
+    command: dagu-missing-executable arg
+  - name: "2"
+    command: $CMD arg
+`))
+		require.NoError(t, err)
+		require.Len(t, warnings, 1)
+		require.Equal(t, "1", warnings[0].Step)
+	})
+}
@@ -20,20 +20,25 @@ import (
 
 // LoadOptions contains options for loading a DAG.
 type LoadOptions struct {
-	baseConfig   string   // Path to the base DAG configuration file.
+	baseConfigs  []string // Paths to the base DAG configuration files in the order they are applied.
 	params       string   // Parameters to override default parameters in the DAG.
 	paramsList   []string // List of parameters to override default parameters in the DAG.
 	noEval       bool     // Flag to disable evaluation of dynamic fields.
 	onlyMetadata bool     // Flag to load only metadata without full DAG details.
+	namespace    string   // Namespace of the DAG.
 }
 
 // LoadOption is a function type for setting LoadOptions.
 type LoadOption func(*LoadOptions)
 
-// WithBaseConfig sets the base DAG configuration file.
+// WithBaseConfig adds a base DAG configuration file. The base configs are
+// applied in the order they are given, e.g. the global one and then the one
+// of the namespace, and the DAG overrides them all.
 func WithBaseConfig(baseDAG string) LoadOption {
 	return func(o *LoadOptions) {
-		o.baseConfig = baseDAG
+		if baseDAG != "" {
+			o.baseConfigs = append(o.baseConfigs, baseDAG)
+		}
 	}
 }
 
@@ -65,6 +70,13 @@ func OnlyMetadata() LoadOption {
 	}
 }
 
+// WithNamespace sets the namespace of the DAG.
+func WithNamespace(ns string) LoadOption {
+	return func(o *LoadOptions) {
+		o.namespace = ns
+	}
+}
+
 // Load loads the DAG from the given file with the specified options.
 func Load(ctx context.Context, dag string, opts ...LoadOption) (*DAG, error) {
 	var options LoadOptions
@@ -74,14 +86,19 @@ func Load(ctx context.Context, dag string, opts ...LoadOption) (*DAG, error) {
 	buildContext := BuildContext{
 		ctx: ctx,
 		opts: buildOpts{
-			base:           options.baseConfig,
+			bases:          options.baseConfigs,
 			parameters:     options.params,
 			parametersList: options.paramsList,
 			onlyMetadata:   options.onlyMetadata,
 			noEval:         options.noEval,
 		},
 	}
-	return loadDAG(buildContext, dag)
+	loaded, err := loadDAG(buildContext, dag)
+	if err != nil {
+		return nil, err
+	}
+	loaded.Namespace = options.namespace
+	return loaded, nil
 }
 
 // LoadYAML loads the DAG from the given YAML data with the specified options.
@@ -91,7 +108,7 @@ func LoadYAML(ctx context.Context, data []byte, opts ...LoadOption) (*DAG, error
 		opt(&options)
 	}
 	return loadYAML(ctx, data, buildOpts{
-		base:           options.baseConfig,
+		bases:          options.baseConfigs,
 		parameters:     options.params,
 		parametersList: options.paramsList,
 		onlyMetadata:   options.onlyMetadata,
@@ -147,7 +164,7 @@ func loadDAG(ctx BuildContext, dag string) (*DAG, error) {
 
 	ctx = ctx.WithFile(filePath)
 
-	dest, err := loadBaseConfigIfRequired(ctx, ctx.opts.base)
+	dest, err := loadBaseConfigsIfRequired(ctx, ctx.opts.bases)
 	if err != nil {
 		return nil, err
 	}
@@ -205,22 +222,29 @@ func resolveYamlFilePath(file string) (string, error) {
 	return filepath.Abs(file)
 }
 
-// loadBaseConfigIfRequired loads the base config if needed, based on the given options.
-func loadBaseConfigIfRequired(ctx BuildContext, baseConfig string) (*DAG, error) {
-	if !ctx.opts.onlyMetadata && baseConfig != "" {
+// loadBaseConfigsIfRequired loads the base configs if needed, based on the
+// given options, and merges them in order.
+func loadBaseConfigsIfRequired(ctx BuildContext, baseConfigs []string) (*DAG, error) {
+	dest := new(DAG)
+	if ctx.opts.onlyMetadata {
+		return dest, nil
+	}
+
+	for _, baseConfig := range baseConfigs {
 		dag, err := loadBaseConfig(ctx, baseConfig)
 		if err != nil {
 			// Failed to load the base config.
 			return nil, err
 		}
-		if dag != nil {
-			// Found the base config.
-			return dag, nil
+		if dag == nil {
+			// The base config doesn't exist.
+			continue
+		}
+		if err := merge(dest, dag); err != nil {
+			return nil, err
 		}
 	}
-
-	// No base config.
-	return new(DAG), nil
+	return dest, nil
 }
 
 type mergeTransformer struct{}
@@ -243,6 +267,19 @@ func (*mergeTransformer) Transformer(
 		}
 	}
 
+	// A DAG with its own SMTP settings replaces the whole SMTP config of the
+	// base config so that the credentials of the base server are not sent to
+	// another server.
+	if typ == reflect.TypeOf(&SMTPConfig{}) {
+		return func(dst, src reflect.Value) error {
+			if dst.CanSet() && !src.IsNil() && !src.Elem().IsZero() {
+				dst.Set(src)
+			}
+
+			return nil
+		}
+	}
+
 	return nil
 }
 
@@ -261,10 +298,13 @@ func unmarshalData(data []byte) (map[string]any, error) {
 	var cm map[string]any
 	err := yaml.NewDecoder(bytes.NewReader(data)).Decode(&cm)
 	if errors.Is(err, io.EOF) {
-		err = nil
+		return cm, nil
+	}
+	if err != nil {
+		return nil, InvalidSpec(err)
 	}
 
-	return cm, err
+	return cm, nil
 }
 
 // decode decodes the configuration map into a configDefinition.
@@ -275,9 +315,11 @@ func decode(cm map[string]any) (*definition, error) {
 		Result:      c,
 		TagName:     "",
 	})
-	err := md.Decode(cm)
+	if err := md.Decode(cm); err != nil {
+		return c, InvalidSpec(err)
+	}
 
-	return c, err
+	return c, nil
 }
 
 // merge merges the source DAG into the destination DAG.
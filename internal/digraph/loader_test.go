@@ -2,6 +2,7 @@ package digraph
 
 import (
 	"context"
+	"errors"
 	"path/filepath"
 	"testing"
 	"time"
@@ -16,6 +17,7 @@ func Test_Load(t *testing.T) {
 		file             string
 		expectedError    string
 		expectedLocation string
+		invalidSpec      bool
 	}{
 		{
 			name:             "WithExt",
@@ -36,11 +38,13 @@ func Test_Load(t *testing.T) {
 			name:          "InvalidDAG",
 			file:          filepath.Join(testdataDir, "err_decode.yaml"),
 			expectedError: "has invalid keys: invalidkey",
+			invalidSpec:   true,
 		},
 		{
 			name:          "InvalidYAML",
 			file:          filepath.Join(testdataDir, "err_parse.yaml"),
 			expectedError: "cannot unmarshal",
+			invalidSpec:   true,
 		},
 	}
 	for _, tt := range tests {
@@ -49,6 +53,7 @@ func Test_Load(t *testing.T) {
 			if tt.expectedError != "" {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), tt.expectedError)
+				require.Equal(t, tt.invalidSpec, errors.Is(err, ErrInvalidSpec))
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, tt.expectedLocation, dag.Location)
@@ -108,6 +113,33 @@ steps:
 `
 )
 
+func TestSources(t *testing.T) {
+	base := filepath.Join(testdataDir, "base.yaml")
+	namespaceBase := filepath.Join(testdataDir, "namespace_base.yaml")
+	file := filepath.Join(testdataDir, "overwrite.yaml")
+
+	values, files, err := Sources(file,
+		WithBaseConfig(base),
+		WithBaseConfig(namespaceBase),
+		WithBaseConfig(filepath.Join(testdataDir, "not_exist.yaml")))
+	require.NoError(t, err)
+	require.Equal(t, []string{base, namespaceBase, file}, files)
+
+	sources := make(map[string]string)
+	for _, v := range values {
+		sources[v.Key] = filepath.Base(v.File)
+	}
+	require.Equal(t, "overwrite.yaml", sources["histRetentionDays"])
+	require.Equal(t, "overwrite.yaml", sources["mailOn"])
+	// The SMTP config is replaced as a whole.
+	require.Equal(t, "namespace_base.yaml", sources["smtp"])
+	require.NotContains(t, sources, "smtp.username")
+	// The mail configs are merged key by key.
+	require.Equal(t, "namespace_base.yaml", sources["infoMail.to"])
+	require.Equal(t, "base.yaml", sources["infoMail.prefix"])
+	require.Equal(t, "base.yaml", sources["env.LOG_DIR"])
+}
+
 func Test_LoadYAML(t *testing.T) {
 	t.Run("ValidYAMLData", func(t *testing.T) {
 		ret, err := loadYAML(context.Background(), []byte(testDAG), buildOpts{})
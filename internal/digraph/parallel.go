@@ -0,0 +1,56 @@
+package digraph
+
+import "fmt"
+
+// buildParallel parses the parallel field in the step definition. Unlike
+// foreach, the items are not evaluated when the DAG is built because they
+// are usually the output of an upstream step.
+// Case 1: parallel is a string, e.g. "${ITEMS}"
+// Case 2: parallel is a map with "items" (a string) and "maxParallel"
+func buildParallel(_ BuildContext, def stepDef, step *Step) error {
+	if def.Parallel == nil {
+		return nil
+	}
+	if def.Foreach != nil || def.Expand != nil {
+		return wrapError("parallel", def.Parallel, errParallelWithForeach)
+	}
+
+	parallel := &Parallel{}
+	switch v := def.Parallel.(type) {
+	case string:
+		parallel.Items = v
+
+	case map[any]any:
+		for key, value := range v {
+			switch key {
+			case "items":
+				items, ok := value.(string)
+				if !ok {
+					return wrapError("parallel.items", value, errInvalidParallel)
+				}
+				parallel.Items = items
+
+			case "maxParallel":
+				n, ok := value.(int)
+				if !ok || n < 0 {
+					return wrapError("parallel.maxParallel", value, errInvalidParallel)
+				}
+				parallel.MaxParallel = n
+
+			default:
+				return wrapError("parallel", key, fmt.Errorf("%w: unknown key %v", errInvalidParallel, key))
+
+			}
+		}
+
+	default:
+		return wrapError("parallel", v, errInvalidParallel)
+
+	}
+	if parallel.Items == "" {
+		return wrapError("parallel", def.Parallel, fmt.Errorf("%w: items is required", errInvalidParallel))
+	}
+
+	step.Parallel = parallel
+	return nil
+}
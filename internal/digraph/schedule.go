@@ -2,6 +2,8 @@ package digraph
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/robfig/cron/v3"
 )
@@ -26,6 +28,85 @@ func buildScheduler(values []string) ([]Schedule, error) {
 	return ret, nil
 }
 
+// prevSearchWindows are the windows before the time to search for the
+// previous fire time, the shortest first. robfig/cron returns the zero time
+// for the schedules that don't fire in five years.
+var prevSearchWindows = []time.Duration{
+	time.Hour,
+	24 * time.Hour,
+	7 * 24 * time.Hour,
+	32 * 24 * time.Hour,
+	366 * 24 * time.Hour,
+	5 * 366 * 24 * time.Hour,
+}
+
+// Cron returns the cron expression without the CRON_TZ or TZ prefix.
+func (s Schedule) Cron() string {
+	expr := strings.TrimSpace(s.Expression)
+	if strings.HasPrefix(expr, "CRON_TZ=") || strings.HasPrefix(expr, "TZ=") {
+		if i := strings.IndexAny(expr, " \t"); i >= 0 {
+			return strings.TrimSpace(expr[i:])
+		}
+	}
+	return expr
+}
+
+// Timezone returns the time zone specified with the CRON_TZ or TZ prefix.
+// It's empty if the schedule runs in the time zone of the scheduler.
+func (s Schedule) Timezone() string {
+	spec, ok := s.Parsed.(*cron.SpecSchedule)
+	if !ok || spec.Location == time.Local {
+		return ""
+	}
+	return spec.Location.String()
+}
+
+// TimeLocation returns the time zone of the DAG, i.e. the time zone of its first
+// schedule with the CRON_TZ or TZ prefix. It's nil if none of them has one,
+// i.e. the DAG runs in the time zone of the server.
+func (d *DAG) TimeLocation() *time.Location {
+	for _, schedules := range [][]Schedule{d.Schedule, d.StopSchedule, d.RestartSchedule} {
+		for _, s := range schedules {
+			if spec, ok := s.Parsed.(*cron.SpecSchedule); ok && spec.Location != time.Local {
+				return spec.Location
+			}
+		}
+	}
+	return nil
+}
+
+// Next returns the next fire time after t. The schedules without a time
+// zone are evaluated in the time zone of t. It returns the zero time if the
+// schedule is not parsed.
+func (s Schedule) Next(t time.Time) time.Time {
+	if s.Parsed == nil {
+		return time.Time{}
+	}
+	return s.Parsed.Next(t)
+}
+
+// Prev returns the last fire time at or before t. It returns the zero time
+// if the schedule didn't fire in five years.
+func (s Schedule) Prev(t time.Time) time.Time {
+	if s.Parsed == nil {
+		return time.Time{}
+	}
+	for _, window := range prevSearchWindows {
+		prev := s.Parsed.Next(t.Add(-window))
+		if prev.IsZero() || prev.After(t) {
+			continue
+		}
+		for {
+			next := s.Parsed.Next(prev)
+			if next.IsZero() || next.After(t) {
+				return prev
+			}
+			prev = next
+		}
+	}
+	return time.Time{}
+}
+
 // parseScheduleMap parses the schedule map and populates the starts, stops,
 // and restarts slices. Each key in the map must be either "start", "stop", or
 // "restart". The value can be Case 1 or Case 2.
@@ -0,0 +1,172 @@
+package scheduler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dagu-org/dagu/internal/cmdutil"
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/fileutil"
+)
+
+// cacheEntry is the record of the last successful execution of a cached step.
+type cacheEntry struct {
+	// Key is the cache key of the execution.
+	Key string `json:"Key"`
+	// Output is the value of the output variable of the step.
+	Output string `json:"Output,omitempty"`
+}
+
+// cacheKey computes the cache key of the step. The key is a hash of the
+// command of the step and the contents of the declared input files.
+// Variables in the command are expanded but command substitution is not
+// performed so that computing the key has no side effects.
+func (n *Node) cacheKey(ctx context.Context) (string, error) {
+	n.mu.RLock()
+	step := n.data.Step
+	n.mu.RUnlock()
+
+	stepContext := digraph.GetStepContext(ctx)
+	eval := func(s string) (string, error) {
+		return stepContext.EvalString(s, cmdutil.WithoutSubstitute())
+	}
+
+	h := sha256.New()
+	fields := []string{
+		step.Name,
+		step.Dir,
+		step.ExecutorConfig.Type,
+		step.CmdWithArgs,
+		step.CmdArgsSys,
+		step.Command,
+		strings.Join(step.Args, " "),
+		step.Script,
+	}
+	for _, field := range fields {
+		value, err := eval(field)
+		if err != nil {
+			return "", fmt.Errorf("failed to evaluate %q: %w", field, err)
+		}
+		_, _ = fmt.Fprintf(h, "%d:%s\n", len(value), value)
+	}
+
+	var files []string
+	for _, input := range step.Cache.Inputs {
+		pattern, err := eval(input)
+		if err != nil {
+			return "", fmt.Errorf("failed to evaluate cache input %q: %w", input, err)
+		}
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(step.Dir, pattern)
+		}
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return "", fmt.Errorf("invalid cache input %q: %w", input, err)
+		}
+		if len(matches) == 0 {
+			return "", fmt.Errorf("cache input %q does not match any file", input)
+		}
+		files = append(files, matches...)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		if err := hashFile(h, file); err != nil {
+			return "", err
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func hashFile(w io.Writer, file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return fmt.Errorf("failed to open cache input %q: %w", file, err)
+	}
+	defer f.Close()
+
+	_, _ = fmt.Fprintf(w, "%s\n", file)
+	if _, err := io.Copy(w, f); err != nil {
+		return fmt.Errorf("failed to read cache input %q: %w", file, err)
+	}
+	return nil
+}
+
+// RestoreFromCache checks if the cache entry in the directory matches the
+// key. If it does, it restores the output variable of the step and
+// returns true.
+func (n *Node) RestoreFromCache(dir, key string) (bool, error) {
+	entry, err := readCacheEntry(n.cacheFile(dir))
+	if err != nil || entry == nil || entry.Key != key {
+		return false, err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	// The produced files must still exist to reuse the result.
+	for _, p := range n.data.Step.Artifacts.Produces {
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(n.data.Step.Dir, p)
+		}
+		if !fileutil.FileExists(p) {
+			return false, nil
+		}
+	}
+
+	if n.data.Step.Output != "" {
+		n.setVariable(n.data.Step.Output, entry.Output)
+	}
+	return true, nil
+}
+
+// SaveCache records the successful execution of the step with the key.
+func (n *Node) SaveCache(dir, key string) error {
+	n.mu.RLock()
+	entry := cacheEntry{Key: key}
+	if n.data.Step.Output != "" {
+		if v, ok := n.getVariable(n.data.Step.Output); ok {
+			entry.Output = v.Value()
+		}
+	}
+	n.mu.RUnlock()
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache entry: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	return os.WriteFile(n.cacheFile(dir), data, 0600)
+}
+
+func (n *Node) cacheFile(dir string) string {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return filepath.Join(dir, fileutil.SafeName(n.data.Step.Name)+".json")
+}
+
+func readCacheEntry(file string) (*cacheEntry, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read cache entry: %w", err)
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cache entry: %w", err)
+	}
+	return &entry, nil
+}
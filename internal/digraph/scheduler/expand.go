@@ -0,0 +1,128 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/logger"
+)
+
+// isExpansionPending returns true if the node has finished successfully
+// and the steps generated from its output are not added to the graph yet.
+func (n *Node) isExpansionPending() bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	if n.data.Step.Expand == nil || n.expanded {
+		return false
+	}
+	status := n.data.State.Status
+	return status == NodeStatusSuccess || status == NodeStatusCached
+}
+
+func (n *Node) setExpanded() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.expanded = true
+}
+
+// expandItems parses the JSON list written to the output variable of the
+// node. Items that are not strings are encoded in JSON.
+func (n *Node) expandItems() ([]string, error) {
+	n.mu.RLock()
+	v, ok := n.getVariable(n.data.Step.Output)
+	n.mu.RUnlock()
+
+	if !ok {
+		return nil, nil
+	}
+	items, err := parseJSONItems(v.Value())
+	if err != nil {
+		return nil, fmt.Errorf("output of the step must be a JSON list: %w", err)
+	}
+	return items, nil
+}
+
+// parseJSONItems parses the JSON list of the items. Items that are not
+// strings are encoded in JSON. An empty string is an empty list.
+func parseJSONItems(s string) ([]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	var list []json.RawMessage
+	if err := json.Unmarshal([]byte(s), &list); err != nil {
+		return nil, err
+	}
+
+	items := make([]string, 0, len(list))
+	for _, raw := range list {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			items = append(items, s)
+			continue
+		}
+		items = append(items, string(raw))
+	}
+	return items, nil
+}
+
+// expand adds a step for each item in the output of the node to the graph.
+// The generated steps depend on the node, and the steps depending on the
+// node also depend on the generated steps so that they run after all of
+// them finish.
+func (g *ExecutionGraph) expand(ctx context.Context, node *Node) error {
+	defer node.setExpanded()
+
+	source := node.data.Step.Name
+	for _, n := range g.Nodes() {
+		if item := n.data.Step.ExpandItem; item != nil && item.Source == source {
+			// The steps were generated in the previous execution (e.g. retry).
+			return nil
+		}
+	}
+
+	items, err := node.expandItems()
+	if err != nil {
+		return err
+	}
+
+	tmpl := node.data.Step.Expand
+	var nodes []*Node
+	for i, item := range items {
+		step := *tmpl
+		step.Name = fmt.Sprintf("%s[%d]", tmpl.Name, i)
+		step.Depends = []string{source}
+		step.ExpandItem = &digraph.ExpandItem{Source: source, Index: i, Value: item}
+
+		n := &Node{data: NodeData{Step: step}}
+		n.Init()
+		nodes = append(nodes, n)
+	}
+
+	logger.Info(ctx, "Step expanded", "step", source, "count", len(nodes))
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	downstreams := g.from[node.id]
+	for _, n := range nodes {
+		if _, ok := g.dict[n.id]; ok {
+			continue
+		}
+		g.dict[n.id] = n
+		g.nodes = append(g.nodes, n)
+		g.addEdge(node, n)
+		for _, id := range downstreams {
+			down := g.dict[id]
+			g.addEdge(n, down)
+			down.mu.Lock()
+			down.data.Step.Depends = append(down.data.Step.Depends, n.data.Step.Name)
+			down.mu.Unlock()
+		}
+	}
+
+	return nil
+}
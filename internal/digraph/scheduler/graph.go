@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"sync"
 	"time"
 
@@ -65,6 +66,32 @@ func CreateRetryExecutionGraph(ctx context.Context, nodes ...*Node) (*ExecutionG
 	return graph, nil
 }
 
+// CreateStepRetryExecutionGraph creates a new execution graph to retry only
+// the step with the given name. The other nodes keep their state.
+func CreateStepRetryExecutionGraph(ctx context.Context, stepName string, nodes ...*Node) (*ExecutionGraph, error) {
+	graph := &ExecutionGraph{
+		dict:  make(map[int]*Node),
+		from:  make(map[int][]int),
+		to:    make(map[int][]int),
+		nodes: []*Node{},
+	}
+	for _, node := range nodes {
+		node.Init()
+		graph.dict[node.id] = node
+		graph.nodes = append(graph.nodes, node)
+	}
+	if err := graph.setup(); err != nil {
+		return nil, err
+	}
+	node, err := graph.findStep(stepName)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info(ctx, "clear node state", "step", stepName)
+	node.ClearState()
+	return graph, nil
+}
+
 // Duration returns the duration of the execution.
 func (g *ExecutionGraph) Duration() time.Duration {
 	g.mu.RLock()
@@ -96,7 +123,7 @@ func (g *ExecutionGraph) StartAt() time.Time {
 func (g *ExecutionGraph) IsRunning() bool {
 	g.mu.RLock()
 	defer g.mu.RUnlock()
-	for _, node := range g.Nodes() {
+	for _, node := range g.nodes {
 		if node.State().Status == NodeStatusRunning {
 			return true
 		}
@@ -124,6 +151,8 @@ func (g *ExecutionGraph) Start() {
 
 // Nodes returns the nodes of the execution graph.
 func (g *ExecutionGraph) Nodes() []*Node {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
 	return g.nodes
 }
 
@@ -141,10 +170,108 @@ func (g *ExecutionGraph) NodeData() []NodeData {
 	return ret
 }
 
+// RequestSkip marks the step with the given name to be skipped in the run.
+// The step must not have started yet.
+func (g *ExecutionGraph) RequestSkip(name string) error {
+	g.mu.RLock()
+	node, err := g.findStep(name)
+	g.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+	return node.RequestSkip()
+}
+
+// SignalStep sends the signal to the process of the running step with the
+// given name. Unlike stopping the run, the status of the step is kept.
+func (g *ExecutionGraph) SignalStep(ctx context.Context, name string, sig os.Signal) error {
+	g.mu.RLock()
+	node, err := g.findStep(name)
+	g.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+	return node.SendSignal(ctx, sig)
+}
+
+// StartFrom marks the steps the step with the given name depends on,
+// directly or indirectly, to be skipped so that the run starts from the
+// step. The other steps run as usual.
+func (g *ExecutionGraph) StartFrom(name string) error {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	node, err := g.findStep(name)
+	if err != nil {
+		return err
+	}
+	for id := range g.ancestors(node.id) {
+		if err := g.dict[id].RequestSkip(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Target marks the steps other than the step with the given name and the
+// steps it depends on, directly or indirectly, to be skipped so that only
+// the step and its dependencies run.
+func (g *ExecutionGraph) Target(name string) error {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	node, err := g.findStep(name)
+	if err != nil {
+		return err
+	}
+	closure := g.ancestors(node.id)
+	closure[node.id] = true
+	for _, n := range g.nodes {
+		if closure[n.id] {
+			continue
+		}
+		if err := n.RequestSkip(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ancestors returns the IDs of the nodes the node depends on, directly or
+// indirectly.
+func (g *ExecutionGraph) ancestors(id int) map[int]bool {
+	visited := make(map[int]bool)
+	queue := append([]int{}, g.to[id]...)
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+		queue = append(queue, g.to[id]...)
+	}
+	return visited
+}
+
 func (g *ExecutionGraph) node(id int) *Node {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
 	return g.dict[id]
 }
 
+// upstreams returns the IDs of the nodes the node depends on.
+func (g *ExecutionGraph) upstreams(id int) []int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.to[id]
+}
+
+// downstreams returns the IDs of the nodes depending on the node.
+func (g *ExecutionGraph) downstreams(id int) []int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.from[id]
+}
+
 func (g *ExecutionGraph) setupRetry(ctx context.Context) error {
 	dict := map[int]NodeStatus{}
 	retry := map[int]bool{}
@@ -244,10 +371,15 @@ func (g *ExecutionGraph) findStep(name string) (*Node, error) {
 			return n, nil
 		}
 	}
-	return nil, fmt.Errorf("%w: %s", errStepNotFound, name)
+	return nil, fmt.Errorf("%w: %s", ErrStepNotFound, name)
 }
 
 var (
 	errCycleDetected = errors.New("cycle detected")
-	errStepNotFound  = errors.New("step not found")
+	// ErrStepNotFound is returned if the graph has no step with the name.
+	ErrStepNotFound = errors.New("step not found")
+	// ErrStepAlreadyStarted is returned if the step to skip has started.
+	ErrStepAlreadyStarted = errors.New("step already started")
+	// ErrStepNotRunning is returned if the step to signal is not running.
+	ErrStepNotRunning = errors.New("step not running")
 )
@@ -103,3 +103,82 @@ func TestRetryExecution(t *testing.T) {
 	require.Equal(t, scheduler.NodeStatusNone, nodes[6].State().Status)
 	require.Equal(t, scheduler.NodeStatusSkipped, nodes[7].State().Status)
 }
+
+func TestStepRetryExecution(t *testing.T) {
+	newNodes := func() []*scheduler.Node {
+		return []*scheduler.Node{
+			scheduler.NodeWithData(
+				scheduler.NodeData{
+					Step:  digraph.Step{Name: "1", Command: "true"},
+					State: scheduler.NodeState{Status: scheduler.NodeStatusSuccess},
+				}),
+			scheduler.NodeWithData(
+				scheduler.NodeData{
+					Step:  digraph.Step{Name: "2", Command: "true", Depends: []string{"1"}},
+					State: scheduler.NodeState{Status: scheduler.NodeStatusError},
+				}),
+			scheduler.NodeWithData(
+				scheduler.NodeData{
+					Step:  digraph.Step{Name: "3", Command: "true", Depends: []string{"2"}},
+					State: scheduler.NodeState{Status: scheduler.NodeStatusCancel},
+				}),
+		}
+	}
+
+	t.Run("ClearOnlyTheStep", func(t *testing.T) {
+		nodes := newNodes()
+		_, err := scheduler.CreateStepRetryExecutionGraph(context.Background(), "2", nodes...)
+		require.NoError(t, err)
+		require.Equal(t, scheduler.NodeStatusSuccess, nodes[0].State().Status)
+		require.Equal(t, scheduler.NodeStatusNone, nodes[1].State().Status)
+		require.Equal(t, scheduler.NodeStatusCancel, nodes[2].State().Status)
+	})
+	t.Run("StepNotFound", func(t *testing.T) {
+		_, err := scheduler.CreateStepRetryExecutionGraph(context.Background(), "unknown", newNodes()...)
+		require.Error(t, err)
+	})
+}
+
+func TestStartFrom(t *testing.T) {
+	// 1 -> 2 -> 3 -> 5
+	//      4 -----^
+	graph, err := scheduler.NewExecutionGraph(
+		digraph.Step{Name: "1"},
+		digraph.Step{Name: "2", Depends: []string{"1"}},
+		digraph.Step{Name: "3", Depends: []string{"2"}},
+		digraph.Step{Name: "4"},
+		digraph.Step{Name: "5", Depends: []string{"3", "4"}},
+	)
+	require.NoError(t, err)
+	require.NoError(t, graph.StartFrom("3"))
+
+	skipped := make(map[string]bool)
+	for _, node := range graph.Nodes() {
+		skipped[node.Data().Step.Name] = node.State().SkipRequested
+	}
+	require.Equal(t, map[string]bool{"1": true, "2": true, "3": false, "4": false, "5": false}, skipped)
+
+	require.ErrorIs(t, graph.StartFrom("unknown"), scheduler.ErrStepNotFound)
+}
+
+func TestTarget(t *testing.T) {
+	// 1 -> 2 -> 3 -> 5
+	//      4 -----^
+	graph, err := scheduler.NewExecutionGraph(
+		digraph.Step{Name: "1"},
+		digraph.Step{Name: "2", Depends: []string{"1"}},
+		digraph.Step{Name: "3", Depends: []string{"2"}},
+		digraph.Step{Name: "4"},
+		digraph.Step{Name: "5", Depends: []string{"3", "4"}},
+	)
+	require.NoError(t, err)
+	require.NoError(t, graph.Target("3"))
+
+	skipped := make(map[string]bool)
+	for _, node := range graph.Nodes() {
+		skipped[node.Data().Step.Name] = node.State().SkipRequested
+	}
+	require.Equal(t, map[string]bool{"1": false, "2": false, "3": false, "4": true, "5": true}, skipped)
+
+	require.ErrorIs(t, graph.Target("unknown"), scheduler.ErrStepNotFound)
+}
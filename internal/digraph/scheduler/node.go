@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -16,6 +17,7 @@ import (
 	"golang.org/x/sys/unix"
 
 	"github.com/dagu-org/dagu/internal/cmdutil"
+	"github.com/dagu-org/dagu/internal/crypt"
 	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/dagu-org/dagu/internal/digraph/executor"
 	"github.com/dagu-org/dagu/internal/fileutil"
@@ -41,9 +43,19 @@ type Node struct {
 	outputWriter *os.File
 	outputReader *os.File
 	scriptFile   *os.File
+	stdinFile    *os.File
 	done         bool
 	retryPolicy  retryPolicy
 	cmdEvaluated bool
+	// expanded is true if the steps generated from the output of the node
+	// are added to the graph.
+	expanded bool
+	// serviceReady is true once the readiness probe of the service passed.
+	serviceReady bool
+	// serviceStopping is true when the scheduler stopped the service, and
+	// serviceErr is the reason if it failed.
+	serviceStopping bool
+	serviceErr      error
 }
 
 type NodeData struct {
@@ -62,6 +74,17 @@ type NodeState struct {
 	DoneCount  int
 	Error      error
 	ExitCode   int
+	// Artifacts is the list of artifact names staged by the node.
+	Artifacts []string
+	// ResourceUsage is the resource usage of the last run of the command.
+	ResourceUsage *executor.ResourceUsage
+	// SkipRequested is set when the operator asked to skip the step in the
+	// run. The step is skipped when it becomes ready, and the downstream
+	// steps continue as if continueOn.skipped is set.
+	SkipRequested bool
+	// SubRequestID is the request ID of the run of the sub DAG started by
+	// the node.
+	SubRequestID string
 }
 
 // NodeStatus represents the status of a node.
@@ -74,6 +97,7 @@ const (
 	NodeStatusCancel
 	NodeStatusSuccess
 	NodeStatusSkipped
+	NodeStatusCached
 )
 
 func (s NodeStatus) String() string {
@@ -88,6 +112,8 @@ func (s NodeStatus) String() string {
 		return "finished"
 	case NodeStatusSkipped:
 		return "skipped"
+	case NodeStatusCached:
+		return "cached"
 	case NodeStatusNone:
 		fallthrough
 	default:
@@ -156,7 +182,7 @@ func (n *Node) shouldContinue(ctx context.Context) bool {
 	continueOn := n.data.Step.ContinueOn
 
 	switch n.data.State.Status {
-	case NodeStatusSuccess:
+	case NodeStatusSuccess, NodeStatusCached:
 		return true
 	case NodeStatusError:
 		if continueOn.Failure {
@@ -165,7 +191,7 @@ func (n *Node) shouldContinue(ctx context.Context) bool {
 	case NodeStatusCancel:
 		return false
 	case NodeStatusSkipped:
-		if continueOn.Skipped {
+		if continueOn.Skipped || n.data.State.SkipRequested {
 			return true
 		}
 	case NodeStatusNone:
@@ -229,13 +255,23 @@ func (n *Node) State() NodeState {
 
 // Execute runs the command synchronously and returns error if any.
 func (n *Node) Execute(ctx context.Context) error {
-	cmd, err := n.setupExec(ctx)
+	if timeout := n.data.Step.Timeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, timeout, ErrStepTimeout)
+		defer cancel()
+	}
+
+	ctx, cmd, err := n.setupExec(ctx)
 	if err != nil {
 		return err
 	}
 
 	var exitCode int
+	startedAt := time.Now()
 	if err := cmd.Run(ctx); err != nil {
+		if errors.Is(context.Cause(ctx), ErrStepTimeout) {
+			err = fmt.Errorf("%w after %s", ErrStepTimeout, n.data.Step.Timeout)
+		}
 		n.setError(err)
 
 		// Set the exit code if the command implements ExitCoder
@@ -247,6 +283,7 @@ func (n *Node) Execute(ctx context.Context) error {
 	}
 
 	n.SetExitCode(exitCode)
+	n.setResourceUsage(cmd, time.Since(startedAt))
 
 	if n.outputReader != nil && n.data.Step.Output != "" {
 		if err := n.outputWriter.Close(); err != nil {
@@ -262,6 +299,47 @@ func (n *Node) Execute(ctx context.Context) error {
 	return n.data.State.Error
 }
 
+// setResourceUsage sets the resource usage of the command. Only the wall
+// time is set if the executor doesn't measure the usage.
+func (n *Node) setResourceUsage(cmd executor.Executor, wallTime time.Duration) {
+	usage := &executor.ResourceUsage{}
+	if r, ok := cmd.(executor.ResourceUsageReporter); ok {
+		if u := r.ResourceUsage(); u != nil {
+			usage = u
+		}
+	}
+	usage.WallTime = wallTime
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.data.State.ResourceUsage = usage
+}
+
+// checkPostconditions evaluates the postconditions of the step after the
+// command finished. The output variable of the step is available to the
+// conditions. If any condition is not met, the node is marked as error.
+func (n *Node) checkPostconditions(ctx context.Context) error {
+	conditions := n.data.Step.Postconditions
+	if len(conditions) == 0 {
+		return nil
+	}
+
+	logger.Info(ctx, "Checking post conditions", "step", n.data.Step.Name)
+	stepContext := digraph.GetStepContext(ctx)
+	if output := n.data.Step.Output; output != "" {
+		if v, ok := n.getVariable(output); ok {
+			stepContext = stepContext.WithEnv(output, v.Value())
+		}
+	}
+
+	if err := digraph.EvalConditions(digraph.WithStepContext(ctx, stepContext), conditions); err != nil {
+		logger.Info(ctx, "Post conditions failed", "step", n.data.Step.Name, "error", err)
+		n.setError(err)
+		return err
+	}
+	return nil
+}
+
 func (n *Node) clearVariable(key string) {
 	_ = os.Unsetenv(key)
 
@@ -310,7 +388,9 @@ func (n *Node) Finish() {
 	n.data.State.FinishedAt = time.Now()
 }
 
-func (n *Node) setupExec(ctx context.Context) (executor.Executor, error) {
+// setupExec creates the executor of the step. It returns the context of
+// the run which is cancelled by Cancel.
+func (n *Node) setupExec(ctx context.Context) (context.Context, executor.Executor, error) {
 	n.mu.Lock()
 	defer n.mu.Unlock()
 
@@ -327,7 +407,7 @@ func (n *Node) setupExec(ctx context.Context) (executor.Executor, error) {
 
 	// Evaluate the command and args if not already evaluated
 	if err := n.evaluateCommandArgs(ctx); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if n.scriptFile != nil {
@@ -338,9 +418,16 @@ func (n *Node) setupExec(ctx context.Context) (executor.Executor, error) {
 
 	cmd, err := executor.NewExecutor(ctx, n.data.Step)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	n.cmd = cmd
+	if r, ok := cmd.(executor.SubRunner); ok {
+		n.data.State.SubRequestID = r.SubRequestID()
+	}
+
+	if err := n.setupStdin(cmd); err != nil {
+		return nil, nil, err
+	}
 
 	var stdout io.Writer
 
@@ -356,7 +443,7 @@ func (n *Node) setupExec(ctx context.Context) (executor.Executor, error) {
 	if n.data.Step.Output != "" {
 		var err error
 		if n.outputReader, n.outputWriter, err = os.Pipe(); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		stdout = io.MultiWriter(stdout, n.outputWriter)
 	}
@@ -368,7 +455,7 @@ func (n *Node) setupExec(ctx context.Context) (executor.Executor, error) {
 		cmd.SetStderr(stdout)
 	}
 
-	return cmd, nil
+	return ctx, cmd, nil
 }
 
 func (n *Node) evaluateCommandArgs(ctx context.Context) error {
@@ -378,12 +465,28 @@ func (n *Node) evaluateCommandArgs(ctx context.Context) error {
 
 	stepContext := digraph.GetStepContext(ctx)
 	switch {
+	case len(n.data.Step.CommandList) > 0:
+		// In case of the command and args are defined with commandList. The
+		// items are evaluated but not split, and the command runs without a
+		// shell.
+		argv := make([]string, len(n.data.Step.CommandList))
+		for i, arg := range n.data.Step.CommandList {
+			value, err := stepContext.EvalString(arg, cmdutil.WithoutMemoize())
+			if err != nil {
+				return fmt.Errorf("failed to eval command list: %w", err)
+			}
+			argv[i] = value
+		}
+		n.data.Step.Command = argv[0]
+		n.data.Step.Args = argv[1:]
+		n.data.Step.ShellCmdArgs = ""
+
 	case n.data.Step.CmdArgsSys != "":
 		// In case of the command and args are defined as a list. In this case,
 		// CmdArgsSys is a string with the command and args separated by special markers.
 		cmd, args := cmdutil.SplitCommandArgs(n.data.Step.CmdArgsSys)
 		for i, arg := range args {
-			value, err := stepContext.EvalString(arg, cmdutil.WithoutExpandEnv())
+			value, err := stepContext.EvalString(arg, cmdutil.WithoutExpandEnv(), cmdutil.WithoutMemoize())
 			if err != nil {
 				return fmt.Errorf("failed to eval command with args: %w", err)
 			}
@@ -399,15 +502,14 @@ func (n *Node) evaluateCommandArgs(ctx context.Context) error {
 	case n.data.Step.CmdWithArgs != "":
 		// In case of the command and args are defined as a string.
 		stepContext := digraph.GetStepContext(ctx)
-		cmdWithArgs, err := stepContext.EvalString(n.data.Step.CmdWithArgs, cmdutil.WithoutExpandEnv())
+		cmdWithArgs, err := stepContext.EvalString(n.data.Step.CmdWithArgs, cmdutil.WithoutExpandEnv(), cmdutil.WithoutMemoize())
 		if err != nil {
 			return err
 		}
 
 		// Use user defined command as the shell command args that should be already a valid command.
-		if n.data.Step.ExecutorConfig.IsCommand() {
-			n.data.Step.ShellCmdArgs = cmdWithArgs
-		}
+		// It's also the command run by the shell on the remote host of the ssh executor.
+		n.data.Step.ShellCmdArgs = cmdWithArgs
 
 		// Split the command and args in case shell is not available in the system.
 		// In this case, the command and args need to be split to run the command directly.
@@ -431,7 +533,7 @@ func (n *Node) evaluateCommandArgs(ctx context.Context) error {
 			return fmt.Errorf("failed to split command: %w", err)
 		}
 		for i, arg := range args {
-			value, err := stepContext.EvalString(arg, cmdutil.WithoutExpandEnv())
+			value, err := stepContext.EvalString(arg, cmdutil.WithoutExpandEnv(), cmdutil.WithoutMemoize())
 			if err != nil {
 				return fmt.Errorf("failed to eval command args: %w", err)
 			}
@@ -446,7 +548,7 @@ func (n *Node) evaluateCommandArgs(ctx context.Context) error {
 		// Shouldn't reach here except for testing.
 
 		if n.data.Step.Command != "" {
-			value, err := stepContext.EvalString(n.data.Step.Command, cmdutil.WithoutExpandEnv())
+			value, err := stepContext.EvalString(n.data.Step.Command, cmdutil.WithoutExpandEnv(), cmdutil.WithoutMemoize())
 			if err != nil {
 				return fmt.Errorf("failed to eval command: %w", err)
 			}
@@ -454,7 +556,7 @@ func (n *Node) evaluateCommandArgs(ctx context.Context) error {
 		}
 
 		for i, arg := range n.data.Step.Args {
-			value, err := stepContext.EvalString(arg, cmdutil.WithoutExpandEnv())
+			value, err := stepContext.EvalString(arg, cmdutil.WithoutExpandEnv(), cmdutil.WithoutMemoize())
 			if err != nil {
 				return fmt.Errorf("failed to eval command args: %w", err)
 			}
@@ -500,6 +602,18 @@ func (n *Node) ClearState() {
 	n.data.State = NodeState{}
 }
 
+// RequestSkip marks the node to be skipped in the run. It returns
+// ErrStepAlreadyStarted if the node has started.
+func (n *Node) RequestSkip() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.data.State.Status != NodeStatusNone {
+		return fmt.Errorf("%w: %s", ErrStepAlreadyStarted, n.data.Step.Name)
+	}
+	n.data.State.SkipRequested = true
+	return nil
+}
+
 func (n *Node) SetStatus(status NodeStatus) {
 	n.mu.Lock()
 	defer n.mu.Unlock()
@@ -532,6 +646,19 @@ func (n *Node) Signal(ctx context.Context, sig os.Signal, allowOverride bool) {
 	}
 }
 
+// SendSignal sends the signal to the process of the running node, e.g. to
+// reload the config or to reopen the log files. It returns
+// ErrStepNotRunning if the node has no running process.
+func (n *Node) SendSignal(ctx context.Context, sig os.Signal) error {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	if n.data.State.Status != NodeStatusRunning || n.cmd == nil {
+		return fmt.Errorf("%w: %s", ErrStepNotRunning, n.data.Step.Name)
+	}
+	logger.Info(ctx, "Sending signal", "signal", sig, "step", n.data.Step.Name)
+	return n.cmd.Kill(sig)
+}
+
 func (n *Node) Cancel(ctx context.Context) {
 	n.mu.Lock()
 	defer n.mu.Unlock()
@@ -597,6 +724,33 @@ func (n *Node) Setup(ctx context.Context, logDir string, requestID string) error
 	}
 	n.data.Step.Dir = dir
 
+	// The text is passed as it is, so the command substitution is not run
+	// on the output of the upstream steps.
+	stdin, err := stepContext.EvalString(n.data.Step.Stdin, cmdutil.WithoutSubstitute())
+	if err != nil {
+		return fmt.Errorf("failed to evaluate stdin field: %w", err)
+	}
+	n.data.Step.Stdin = stdin
+
+	stdinFile, err := stepContext.EvalString(n.data.Step.StdinFile)
+	if err != nil {
+		return fmt.Errorf("failed to evaluate stdin file: %w", err)
+	}
+	if stdinFile != "" && !filepath.IsAbs(stdinFile) {
+		stdinFile = filepath.Join(n.data.Step.Dir, stdinFile)
+	}
+	n.data.Step.StdinFile = stdinFile
+
+	produces := make([]string, 0, len(n.data.Step.Artifacts.Produces))
+	for _, p := range n.data.Step.Artifacts.Produces {
+		value, err := stepContext.EvalString(p)
+		if err != nil {
+			return fmt.Errorf("failed to evaluate artifact %q: %w", p, err)
+		}
+		produces = append(produces, value)
+	}
+	n.data.Step.Artifacts.Produces = produces
+
 	if err := n.setupLog(); err != nil {
 		return fmt.Errorf("failed to setup log: %w", err)
 	}
@@ -639,6 +793,9 @@ func (n *Node) Teardown() error {
 	}
 	n.logLock.Unlock()
 
+	if n.stdinFile != nil {
+		_ = n.stdinFile.Close()
+	}
 	if n.scriptFile != nil {
 		_ = os.Remove(n.scriptFile.Name())
 	}
@@ -663,8 +820,51 @@ func (n *Node) IncDoneCount() {
 
 var (
 	ErrWorkingDirNotExist = fmt.Errorf("working directory does not exist")
+	ErrArtifactNotFound   = fmt.Errorf("artifact not found")
+	ErrStdinNotSupported  = fmt.Errorf("stdin is not supported by the executor")
+	ErrStepTimeout        = fmt.Errorf("step timed out")
 )
 
+// StageArtifacts copies the files produced by the step into the artifact
+// directory of the run. The files are stored by their base name.
+func (n *Node) StageArtifacts(dir string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var staged []string
+	for _, p := range n.data.Step.Artifacts.Produces {
+		src := p
+		if !filepath.IsAbs(src) {
+			src = filepath.Join(n.data.Step.Dir, src)
+		}
+		name := filepath.Base(p)
+		if err := fileutil.CopyFile(src, filepath.Join(dir, name)); err != nil {
+			return fmt.Errorf("failed to stage artifact %q: %w", p, err)
+		}
+		staged = append(staged, name)
+	}
+	n.data.State.Artifacts = staged
+	return nil
+}
+
+// ConsumeArtifacts copies the artifacts consumed by the step from the
+// artifact directory of the run into the working directory of the step.
+func (n *Node) ConsumeArtifacts(dir string) error {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	for _, name := range n.data.Step.Artifacts.Consumes {
+		src := filepath.Join(dir, name)
+		if !fileutil.FileExists(src) {
+			return fmt.Errorf("%w: %s", ErrArtifactNotFound, name)
+		}
+		if err := fileutil.CopyFile(src, filepath.Join(n.data.Step.Dir, name)); err != nil {
+			return fmt.Errorf("failed to consume artifact %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
 func (n *Node) setupScript() (err error) {
 	if n.data.Step.Script != "" {
 		if len(n.data.Step.Dir) > 0 && !fileutil.FileExists(n.data.Step.Dir) {
@@ -682,6 +882,34 @@ func (n *Node) setupScript() (err error) {
 	return err
 }
 
+// setupStdin passes the stdin of the step to the command. The file is
+// opened again for each retry so that the command reads it from the start.
+func (n *Node) setupStdin(cmd executor.Executor) error {
+	step := n.data.Step
+	if step.Stdin == "" && step.StdinFile == "" {
+		return nil
+	}
+	setter, ok := cmd.(executor.StdinSetter)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrStdinNotSupported, step.ExecutorConfig.Type)
+	}
+	if step.StdinFile == "" {
+		setter.SetStdin(strings.NewReader(step.Stdin))
+		return nil
+	}
+
+	if n.stdinFile != nil {
+		_ = n.stdinFile.Close()
+	}
+	f, err := os.Open(step.StdinFile)
+	if err != nil {
+		return fmt.Errorf("failed to open stdin file: %w", err)
+	}
+	n.stdinFile = f
+	setter.SetStdin(f)
+	return nil
+}
+
 func (n *Node) setupStdout() error {
 	if n.data.Step.Stdout != "" {
 		f := n.data.Step.Stdout
@@ -728,7 +956,9 @@ func (n *Node) setupLog() error {
 		n.data.State.Error = err
 		return err
 	}
-	n.logWriter = bufio.NewWriter(n.logFile)
+	// The step's own stdout and stderr files are left in plaintext for the
+	// user, while the log kept by Dagu is encrypted if enabled.
+	n.logWriter = bufio.NewWriter(crypt.NewWriter(n.logFile))
 	return nil
 }
 
@@ -758,7 +988,7 @@ func (n *Node) LogContainsPattern(ctx context.Context, patterns []string) (bool,
 	defer file.Close()
 
 	// Create a buffered reader with optimal buffer size
-	reader := bufio.NewReaderSize(file, 64*1024)
+	reader := bufio.NewReaderSize(crypt.NewReader(file), 64*1024)
 
 	// Use scanner for more efficient line reading
 	scanner := bufio.NewScanner(reader)
@@ -60,6 +60,18 @@ func withNodeScript(script string) nodeOption {
 	}
 }
 
+func withNodeStdin(stdin string) nodeOption {
+	return func(data *scheduler.NodeData) {
+		data.Step.Stdin = stdin
+	}
+}
+
+func withNodeStdinFile(file string) nodeOption {
+	return func(data *scheduler.NodeData) {
+		data.Step.StdinFile = file
+	}
+}
+
 func withNodeOutput(output string) nodeOption {
 	return func(data *scheduler.NodeData) {
 		data.Step.Output = output
@@ -135,6 +147,15 @@ func TestNode(t *testing.T) {
 		node := setupNode(t, withNodeCommand("false"))
 		node.ExecuteFail(t, "exit status 1")
 	})
+	t.Run("ResourceUsage", func(t *testing.T) {
+		node := setupNode(t, withNodeCommand("sleep 0.1"))
+		node.Execute(t)
+
+		usage := node.State().ResourceUsage
+		require.NotNil(t, usage)
+		require.Greater(t, usage.MaxRSS, int64(0))
+		require.GreaterOrEqual(t, usage.WallTime, 100*time.Millisecond)
+	})
 	t.Run("Signal", func(t *testing.T) {
 		node := setupNode(t, withNodeCommand("sleep 3"))
 		go func() {
@@ -190,6 +211,20 @@ func TestNode(t *testing.T) {
 		dat, _ := os.ReadFile(file)
 		require.Equalf(t, "hello\n", string(dat), "unexpected stderr content: %s", string(dat))
 	})
+	t.Run("Stdin", func(t *testing.T) {
+		node := setupNode(t, withNodeCommand("cat"), withNodeStdin("hello `echo world`"), withNodeOutput("OUTPUT_STDIN"))
+		node.Execute(t)
+		node.AssertOutput(t, "OUTPUT_STDIN", "hello `echo world`")
+	})
+	t.Run("StdinFile", func(t *testing.T) {
+		random := path.Join(os.TempDir(), uuid.Must(uuid.NewRandom()).String())
+		require.NoError(t, os.WriteFile(random, []byte("b\na\n"), 0600))
+		defer os.Remove(random)
+
+		node := setupNode(t, withNodeCommand("sort"), withNodeStdinFile(random), withNodeOutput("OUTPUT_STDIN"))
+		node.Execute(t)
+		node.AssertOutput(t, "OUTPUT_STDIN", "a\nb")
+	})
 	t.Run("Output", func(t *testing.T) {
 		node := setupNode(t, withNodeCmdArgs("echo hello"), withNodeOutput("OUTPUT_TEST"))
 		node.Execute(t)
@@ -0,0 +1,130 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/logger"
+)
+
+// errParallelItemsFailed is set to the step with the parallel field when
+// any of its items failed.
+var errParallelItemsFailed = errors.New("parallel items failed")
+
+// runParallel runs the step with the parallel field when it's ready. The
+// first time, a step is generated for each item and the step waits for
+// them. The next time, i.e. when all of them finished, the status of the
+// step is set from their statuses.
+func (sc *Scheduler) runParallel(ctx context.Context, graph *ExecutionGraph, node *Node) error {
+	if items := graph.parallelItems(node); len(items) > 0 {
+		aggregateParallel(node, items)
+		return nil
+	}
+
+	node.mu.Lock()
+	node.data.State.StartedAt = time.Now()
+	node.mu.Unlock()
+
+	stepCtx := digraph.GetStepContext(sc.setupContext(ctx, graph, node))
+	value, err := stepCtx.EvalString(node.data.Step.Parallel.Items)
+	if err != nil {
+		return fmt.Errorf("failed to evaluate the parallel items: %w", err)
+	}
+	items, err := parseJSONItems(value)
+	if err != nil {
+		return fmt.Errorf("parallel items must be a JSON list: %w", err)
+	}
+	if len(items) == 0 {
+		logger.Info(ctx, "Step has no parallel items", "step", node.data.Step.Name)
+		node.SetStatus(NodeStatusSuccess)
+		node.Finish()
+		return nil
+	}
+
+	graph.addParallel(node, items)
+	logger.Info(ctx, "Step fanned out", "step", node.data.Step.Name, "count", len(items))
+	return nil
+}
+
+// aggregateParallel sets the status of the step from the statuses of its
+// items. Any cancelled item cancels the step, and any failed item fails it.
+func aggregateParallel(node *Node, items []*Node) {
+	var failed, cancelled int
+	for _, item := range items {
+		switch item.State().Status {
+		case NodeStatusError:
+			failed++
+		case NodeStatusCancel:
+			cancelled++
+		}
+	}
+
+	switch {
+	case cancelled > 0:
+		node.SetStatus(NodeStatusCancel)
+	case failed > 0:
+		node.MarkError(fmt.Errorf("%w: %d of %d", errParallelItemsFailed, failed, len(items)))
+	default:
+		node.SetStatus(NodeStatusSuccess)
+	}
+	node.Finish()
+}
+
+// parallelItems returns the steps generated for the items of the step.
+func (g *ExecutionGraph) parallelItems(node *Node) []*Node {
+	var ret []*Node
+	for _, n := range g.Nodes() {
+		if item := n.data.Step.ExpandItem; item != nil && item.Source == node.data.Step.Name {
+			ret = append(ret, n)
+		}
+	}
+	return ret
+}
+
+// addParallel adds a step for each item to the graph. The generated steps
+// depend on the upstream steps of the node, and the node depends on them
+// on any condition so that it aggregates their statuses.
+func (g *ExecutionGraph) addParallel(node *Node, items []string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	source := node.data.Step
+	upstreams := g.to[node.id]
+	dependsOn := maps.Clone(source.DependsOn)
+	if dependsOn == nil {
+		dependsOn = make(map[string]digraph.DependencyCondition)
+	}
+	for i, item := range items {
+		step := source
+		step.Name = fmt.Sprintf("%s[%d]", source.Name, i)
+		step.Parallel = nil
+		step.Depends = slices.Clone(source.Depends)
+		step.DependsOn = maps.Clone(source.DependsOn)
+		step.ExpandItem = &digraph.ExpandItem{Source: source.Name, Index: i, Value: item}
+		if source.Parallel.MaxParallel > 0 {
+			step.ParallelGroup = source.Name
+			step.MaxParallel = source.Parallel.MaxParallel
+		}
+
+		n := &Node{data: NodeData{Step: step}}
+		n.Init()
+		g.dict[n.id] = n
+		g.nodes = append(g.nodes, n)
+		for _, id := range upstreams {
+			g.addEdge(g.dict[id], n)
+		}
+		g.addEdge(n, node)
+
+		node.data.Step.Depends = append(node.data.Step.Depends, step.Name)
+		dependsOn[step.Name] = digraph.DependencyOnAlways
+	}
+	node.data.Step.DependsOn = dependsOn
+}
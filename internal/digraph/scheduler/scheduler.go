@@ -2,14 +2,22 @@ package scheduler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"runtime/debug"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/hook"
 	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/sentry"
+	"github.com/dagu-org/dagu/internal/steplock"
 )
 
 type Status int
@@ -50,13 +58,31 @@ type Scheduler struct {
 	onSuccess     *digraph.Step
 	onFailure     *digraph.Step
 	onCancel      *digraph.Step
+	onTimeout     *digraph.Step
+	stageDefs     []digraph.Stage
 	requestID     string
-
-	canceled  int32
+	artifactDir   string
+	cacheDir      string
+	lockDir       string
+	hooks         *digraph.Hooks
+	dagName       string
+	params        map[string]string
+	publicURL     string
+
+	maxFailedSteps        int
+	maxFailedStepsPercent int
+
+	canceled int32
+	// cancelCh is closed when the scheduler is canceled.
+	cancelCh  chan struct{}
 	mu        sync.RWMutex
 	pause     time.Duration
 	lastError error
+	timedOut  bool
 	handlers  map[digraph.HandlerType]*Node
+	stages    []*stage
+	// abortErr is the error the run fails with before its steps run.
+	abortErr error
 }
 
 func New(cfg *Config) *Scheduler {
@@ -70,8 +96,21 @@ func New(cfg *Config) *Scheduler {
 		onSuccess:     cfg.OnSuccess,
 		onFailure:     cfg.OnFailure,
 		onCancel:      cfg.OnCancel,
+		onTimeout:     cfg.OnTimeout,
+		stageDefs:     cfg.Stages,
 		requestID:     cfg.ReqID,
+		artifactDir:   cfg.ArtifactDir,
+		cacheDir:      cfg.CacheDir,
+		lockDir:       cfg.LockDir,
+		hooks:         cfg.Hooks,
+		dagName:       cfg.DAGName,
+		params:        paramsMap(cfg.Params),
+		publicURL:     strings.TrimSuffix(cfg.PublicURL, "/"),
 		pause:         time.Millisecond * 100,
+		cancelCh:      make(chan struct{}),
+
+		maxFailedSteps:        cfg.MaxFailedSteps,
+		maxFailedStepsPercent: cfg.MaxFailedStepsPercent,
 	}
 }
 
@@ -85,7 +124,35 @@ type Config struct {
 	OnSuccess     *digraph.Step
 	OnFailure     *digraph.Step
 	OnCancel      *digraph.Step
+	OnTimeout     *digraph.Step
 	ReqID         string
+	// ArtifactDir is the run-scoped directory where the artifacts produced
+	// by the steps are staged. Artifacts are disabled if it's empty.
+	ArtifactDir string
+	// CacheDir is the directory where the cache entries of the steps are
+	// stored. Caching is disabled if it's empty.
+	CacheDir string
+	// LockDir is the directory of the locks of the steps shared by the DAG
+	// runs. The locks of the steps are ignored if it's empty.
+	LockDir string
+	// MaxFailedSteps is the number of steps allowed to fail without
+	// failing the DAG.
+	MaxFailedSteps int
+	// MaxFailedStepsPercent is the percentage of steps allowed to fail
+	// without failing the DAG.
+	MaxFailedStepsPercent int
+	// Stages is the list of stages whose handlers are executed when all
+	// the steps in the stage finish.
+	Stages []digraph.Stage
+	// Hooks is the scripts run at the hook points of the run.
+	Hooks *digraph.Hooks
+	// DAGName is the name of the DAG passed to the hooks.
+	DAGName string
+	// Params is the parameters of the DAG passed to the hooks.
+	Params []string
+	// PublicURL is the URL of the Web UI used for the links to the run in
+	// the lifecycle handlers.
+	PublicURL string
 }
 
 // Schedule runs the graph of steps.
@@ -96,8 +163,25 @@ func (sc *Scheduler) Schedule(ctx context.Context, graph *ExecutionGraph, done c
 	graph.Start()
 	defer graph.Finish()
 
+	err := sc.abortError()
+	if err == nil {
+		if err = sc.runHook(ctx, hook.BeforeRun, graph, nil); err != nil {
+			logger.Error(ctx, "Hook failed", "hook", hook.BeforeRun, "err", err)
+		}
+	}
+	if err != nil {
+		sc.setLastError(err)
+		for _, node := range graph.Nodes() {
+			node.SetStatus(NodeStatusSkipped)
+		}
+	}
+
 	var wg = sync.WaitGroup{}
 
+	// The handlers run after the timeout, so they need the context without
+	// the deadline of the run.
+	handlerCtx := ctx
+
 	var cancel context.CancelFunc
 	if sc.timeout > 0 {
 		ctx, cancel = context.WithTimeout(ctx, sc.timeout)
@@ -109,6 +193,21 @@ func (sc *Scheduler) Schedule(ctx context.Context, graph *ExecutionGraph, done c
 			break
 		}
 
+		// Add the steps generated from the output of finished steps.
+		for _, node := range graph.Nodes() {
+			if !node.isExpansionPending() {
+				continue
+			}
+			if err := graph.expand(ctx, node); err != nil {
+				logger.Error(ctx, "Failed to expand step", "step", node.data.Step.Name, "err", err)
+				node.MarkError(err)
+				sc.setLastError(err)
+			}
+		}
+
+		// Stop the services the remaining steps don't depend on.
+		sc.stopServices(ctx, graph)
+
 	NodesIteration:
 		for _, node := range graph.Nodes() {
 			if node.State().Status != NodeStatusNone || !isReady(ctx, graph, node) {
@@ -120,6 +219,28 @@ func (sc *Scheduler) Schedule(ctx context.Context, graph *ExecutionGraph, done c
 			if sc.maxActiveRuns > 0 && sc.runningCount(graph) >= sc.maxActiveRuns {
 				continue NodesIteration
 			}
+			if step := node.data.Step; step.MaxParallel > 0 &&
+				sc.runningCountInGroup(graph, step.ParallelGroup) >= step.MaxParallel {
+				continue NodesIteration
+			}
+
+			if node.State().SkipRequested {
+				logger.Info(ctx, "Step skipped by request", "step", node.data.Step.Name)
+				node.SetStatus(NodeStatusSkipped)
+				node.setError(errSkipRequested)
+				continue NodesIteration
+			}
+
+			// The step with the parallel field runs as the steps generated
+			// for its items.
+			if node.data.Step.Parallel != nil {
+				if err := sc.runParallel(ctx, graph, node); err != nil {
+					logger.Error(ctx, "Failed to run parallel items", "step", node.data.Step.Name, "err", err)
+					node.MarkError(err)
+					sc.setLastError(err)
+				}
+				continue NodesIteration
+			}
 
 			// Check preconditions
 			if len(node.data.Step.Preconditions) > 0 {
@@ -132,6 +253,19 @@ func (sc *Scheduler) Schedule(ctx context.Context, graph *ExecutionGraph, done c
 				}
 			}
 
+			if err := sc.runHook(ctx, hook.BeforeStep, graph, node); err != nil {
+				if errors.Is(err, hook.ErrSkip) {
+					logger.Info(ctx, "Step skipped by hook", "step", node.data.Step.Name, "reason", err)
+					node.SetStatus(NodeStatusSkipped)
+					node.setError(err)
+				} else {
+					logger.Error(ctx, "Hook failed", "hook", hook.BeforeStep, "step", node.data.Step.Name, "err", err)
+					node.MarkError(err)
+					sc.setLastError(err)
+				}
+				continue NodesIteration
+			}
+
 			wg.Add(1)
 
 			logger.Info(ctx, "Step execution started", "step", node.data.Step.Name)
@@ -142,6 +276,7 @@ func (sc *Scheduler) Schedule(ctx context.Context, graph *ExecutionGraph, done c
 						stack := string(debug.Stack())
 						err := fmt.Errorf("panic recovered: %v\n%s", panicObj, stack)
 						logger.Error(ctx, "Panic occurred", "error", err, "step", node.data.Step.Name, "stack", stack)
+						sentry.CapturePanic(ctx, panicObj, []byte(stack), map[string]string{sentry.TagStep: node.data.Step.Name})
 						node.MarkError(err)
 						sc.setLastError(err)
 					}
@@ -154,11 +289,40 @@ func (sc *Scheduler) Schedule(ctx context.Context, graph *ExecutionGraph, done c
 
 				ctx = sc.setupContext(ctx, graph, node)
 
+				// The lock is acquired before the setup so that the time
+				// waiting for it is not counted in the run of the step.
 				setupSucceed := true
-				if err := sc.setupNode(ctx, node); err != nil {
+				lock, err := sc.acquireLock(ctx, node)
+				switch {
+				case errors.Is(err, errLockCanceled):
+					setupSucceed = false
+					node.SetStatus(NodeStatusCancel)
+				case err != nil:
 					setupSucceed = false
 					sc.setLastError(err)
 					node.MarkError(err)
+				case lock != nil:
+					defer func() {
+						if err := lock.Unlock(); err != nil {
+							logger.Warn(ctx, "Failed to release the lock", "step", node.data.Step.Name, "lock", lock.Key(), "err", err)
+						}
+					}()
+				}
+
+				if setupSucceed {
+					if err := sc.setupNode(ctx, node); err != nil {
+						setupSucceed = false
+						sc.setLastError(err)
+						node.MarkError(err)
+					}
+				}
+
+				if setupSucceed {
+					if err := sc.consumeArtifacts(node); err != nil {
+						setupSucceed = false
+						sc.setLastError(err)
+						node.MarkError(err)
+					}
 				}
 
 				ctx = node.SetupContextBeforeExec(ctx)
@@ -167,9 +331,23 @@ func (sc *Scheduler) Schedule(ctx context.Context, graph *ExecutionGraph, done c
 					_ = sc.teardownNode(node)
 				}()
 
+				var cacheKey string
+				if setupSucceed {
+					var cached bool
+					if cacheKey, cached = sc.lookupCache(ctx, node); cached {
+						logger.Info(ctx, "Step result restored from cache", "step", node.data.Step.Name)
+						node.SetStatus(NodeStatusCached)
+					}
+				}
+
 			ExecRepeat: // repeat execution
-				for setupSucceed && !sc.isCanceled() {
-					execErr := sc.execNode(ctx, node)
+				for setupSucceed && node.State().Status != NodeStatusCached && !sc.isCanceled() {
+					var execErr error
+					if node.data.Step.Service != nil {
+						execErr = sc.runService(ctx, node)
+					} else {
+						execErr = sc.execNode(ctx, node)
+					}
 					if execErr != nil {
 						status := node.State().Status
 						switch {
@@ -179,11 +357,17 @@ func (sc *Scheduler) Schedule(ctx context.Context, graph *ExecutionGraph, done c
 						case sc.isTimeout(graph.startedAt):
 							logger.Info(ctx, "Step execution deadline exceeded", "step", node.data.Step.Name, "error", execErr)
 							node.SetStatus(NodeStatusCancel)
+							sc.setTimedOut()
 							sc.setLastError(execErr)
 
 						case sc.isCanceled():
 							sc.setLastError(execErr)
 
+						case errors.Is(execErr, ErrStepTimeout) && node.data.Step.CancelOnTimeout:
+							logger.Info(ctx, "Step timed out", "step", node.data.Step.Name, "error", execErr)
+							node.SetStatus(NodeStatusCancel)
+							sc.setLastError(execErr)
+
 						case node.retryPolicy.Limit > node.GetRetryCount():
 							// retry
 							node.IncRetryCount()
@@ -201,7 +385,11 @@ func (sc *Scheduler) Schedule(ctx context.Context, graph *ExecutionGraph, done c
 								node.SetStatus(NodeStatusSuccess)
 							} else {
 								node.MarkError(execErr)
-								sc.setLastError(execErr)
+								if sc.isFailureTolerated(graph) {
+									logger.Warn(ctx, "Step failed within the failure threshold", "step", node.data.Step.Name, "error", execErr)
+								} else {
+									sc.setLastError(execErr)
+								}
 							}
 						}
 					}
@@ -235,6 +423,27 @@ func (sc *Scheduler) Schedule(ctx context.Context, graph *ExecutionGraph, done c
 					node.SetStatus(NodeStatusSuccess)
 				}
 
+				if node.State().Status != NodeStatusCancel {
+					if err := sc.runHook(ctx, hook.AfterStep, graph, node); err != nil {
+						logger.Error(ctx, "Hook failed", "hook", hook.AfterStep, "step", node.data.Step.Name, "err", err)
+						sc.setLastError(err)
+						node.MarkError(err)
+					}
+				}
+
+				if status := node.State().Status; status == NodeStatusSuccess || status == NodeStatusCached {
+					if err := sc.stageArtifacts(node); err != nil {
+						sc.setLastError(err)
+						node.MarkError(err)
+					}
+				}
+
+				if node.State().Status == NodeStatusSuccess && cacheKey != "" {
+					if err := node.SaveCache(sc.cacheDir, cacheKey); err != nil {
+						logger.Warn(ctx, "Failed to save cache", "step", node.data.Step.Name, "err", err)
+					}
+				}
+
 				if err := sc.teardownNode(node); err != nil {
 					sc.setLastError(err)
 					node.SetStatus(NodeStatusError)
@@ -248,18 +457,28 @@ func (sc *Scheduler) Schedule(ctx context.Context, graph *ExecutionGraph, done c
 			time.Sleep(sc.delay) // TODO: check if this is necessary
 		}
 
+		sc.runStageHandlers(ctx, graph, &wg, done, false)
+
 		time.Sleep(sc.pause) // avoid busy loop
 	}
 
 	wg.Wait()
 
+	sc.runStageHandlers(ctx, graph, &wg, done, true)
+	wg.Wait()
+
 	var handlers []digraph.HandlerType
 	switch sc.Status(graph) {
 	case StatusSuccess:
 		handlers = append(handlers, digraph.HandlerOnSuccess)
 
 	case StatusError:
-		handlers = append(handlers, digraph.HandlerOnFailure)
+		// The timeout handler replaces the failure handler if it's defined.
+		if sc.isTimedOut() && sc.handlers[digraph.HandlerOnTimeout] != nil {
+			handlers = append(handlers, digraph.HandlerOnTimeout)
+		} else {
+			handlers = append(handlers, digraph.HandlerOnFailure)
+		}
 
 	case StatusCancel:
 		handlers = append(handlers, digraph.HandlerOnCancel)
@@ -276,7 +495,7 @@ func (sc *Scheduler) Schedule(ctx context.Context, graph *ExecutionGraph, done c
 	for _, handler := range handlers {
 		if handlerNode := sc.handlers[handler]; handlerNode != nil {
 			logger.Info(ctx, "Handler execution started", "handler", handlerNode.data.Step.Name)
-			if err := sc.runHandlerNode(ctx, graph, handlerNode); err != nil {
+			if err := sc.runHandlerNode(handlerCtx, graph, handlerNode); err != nil {
 				sc.setLastError(err)
 			}
 
@@ -289,6 +508,34 @@ func (sc *Scheduler) Schedule(ctx context.Context, graph *ExecutionGraph, done c
 	return sc.lastError
 }
 
+// Abort makes Schedule fail the run with the error without running the
+// steps, e.g. when the containers of the run failed to start. The failure
+// and the exit handlers still run.
+func (sc *Scheduler) Abort(err error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.abortErr = err
+}
+
+func (sc *Scheduler) abortError() error {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+	return sc.abortErr
+}
+
+func (sc *Scheduler) setTimedOut() {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.timedOut = true
+}
+
+// isTimedOut returns true if a step was canceled by the timeout of the run.
+func (sc *Scheduler) isTimedOut() bool {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+	return sc.timedOut
+}
+
 func (sc *Scheduler) setLastError(err error) {
 	sc.mu.Lock()
 	defer sc.mu.Unlock()
@@ -302,6 +549,41 @@ func (sc *Scheduler) setupNode(ctx context.Context, node *Node) error {
 	return nil
 }
 
+// lockPollInterval is the interval to try to acquire the lock of a step
+// held by another step.
+const lockPollInterval = time.Second
+
+var errLockCanceled = errors.New("canceled while waiting for the lock")
+
+// acquireLock waits until the step holds its lock. It returns nil if the
+// step has no lock.
+func (sc *Scheduler) acquireLock(ctx context.Context, node *Node) (*steplock.Lock, error) {
+	key := node.data.Step.Lock
+	if key == "" || sc.dry || sc.lockDir == "" {
+		return nil, nil
+	}
+	locker := steplock.New(sc.lockDir)
+	for waiting := false; ; waiting = true {
+		lock, ok, err := locker.TryLock(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to acquire the lock %q: %w", key, err)
+		}
+		if ok {
+			if waiting {
+				logger.Info(ctx, "Acquired the lock", "step", node.data.Step.Name, "lock", key)
+			}
+			return lock, nil
+		}
+		if !waiting {
+			logger.Info(ctx, "Waiting for the lock held by another step", "step", node.data.Step.Name, "lock", key)
+		}
+		if sc.isCanceled() || ctx.Err() != nil {
+			return nil, errLockCanceled
+		}
+		time.Sleep(lockPollInterval)
+	}
+}
+
 func (sc *Scheduler) teardownNode(node *Node) error {
 	if !sc.dry {
 		return node.Teardown()
@@ -309,11 +591,65 @@ func (sc *Scheduler) teardownNode(node *Node) error {
 	return nil
 }
 
+// lookupCache computes the cache key of the node and checks if the result
+// of the last successful execution can be reused.
+func (sc *Scheduler) lookupCache(ctx context.Context, node *Node) (string, bool) {
+	if sc.dry || sc.cacheDir == "" || node.data.Step.Cache == nil {
+		return "", false
+	}
+	key, err := node.cacheKey(ctx)
+	if err != nil {
+		logger.Warn(ctx, "Failed to compute cache key", "step", node.data.Step.Name, "err", err)
+		return "", false
+	}
+	cached, err := node.RestoreFromCache(sc.cacheDir, key)
+	if err != nil {
+		logger.Warn(ctx, "Failed to read cache", "step", node.data.Step.Name, "err", err)
+	}
+	return key, cached
+}
+
+// stageArtifacts copies the artifacts produced by the node into the
+// artifact directory.
+func (sc *Scheduler) stageArtifacts(node *Node) error {
+	if sc.dry || sc.artifactDir == "" || len(node.data.Step.Artifacts.Produces) == 0 {
+		return nil
+	}
+	return node.StageArtifacts(sc.artifactDir)
+}
+
+// consumeArtifacts copies the artifacts consumed by the node into its
+// working directory.
+func (sc *Scheduler) consumeArtifacts(node *Node) error {
+	if sc.dry || len(node.data.Step.Artifacts.Consumes) == 0 {
+		return nil
+	}
+	if sc.artifactDir == "" {
+		return fmt.Errorf("%w: artifact directory is not configured", ErrArtifactNotFound)
+	}
+	return node.ConsumeArtifacts(sc.artifactDir)
+}
+
 // setupContext builds the context for a step.
 func (sc *Scheduler) setupContext(ctx context.Context, graph *ExecutionGraph, node *Node) context.Context {
 	stepCtx := digraph.NewStepContext(ctx, node.data.Step)
+	if sc.artifactDir != "" {
+		stepCtx = stepCtx.WithEnv(digraph.EnvKeyArtifactsDir, sc.artifactDir)
+	}
+	if item := node.data.Step.ExpandItem; item != nil {
+		stepCtx = stepCtx.WithEnv(digraph.ExpandItemVar, item.Value)
+		stepCtx = stepCtx.WithEnv(digraph.ExpandItemIndexVar, strconv.Itoa(item.Index))
+	}
+
+	stepCtx.LoadOutputVariables(sc.outputVariables(graph, node))
 
-	// get output variables that are available to the next steps
+	return digraph.WithStepContext(ctx, stepCtx)
+}
+
+// outputVariables returns the output variables of the node and its
+// upstream nodes. The variables of the nearer nodes take precedence.
+func (sc *Scheduler) outputVariables(graph *ExecutionGraph, node *Node) *digraph.SyncMap {
+	vars := &digraph.SyncMap{}
 	curr := node.id
 	visited := make(map[int]struct{})
 	queue := []int{curr}
@@ -323,22 +659,79 @@ func (sc *Scheduler) setupContext(ctx context.Context, graph *ExecutionGraph, no
 			continue
 		}
 		visited[curr] = struct{}{}
-		queue = append(queue, graph.to[curr]...)
+		queue = append(queue, graph.upstreams(curr)...)
 
 		node := graph.node(curr)
 		if node.data.Step.OutputVariables == nil {
 			continue
 		}
 
-		stepCtx.LoadOutputVariables(node.data.Step.OutputVariables)
+		node.data.Step.OutputVariables.Range(func(key, value any) bool {
+			// Skip if the key already exists
+			if _, ok := vars.Load(key); !ok {
+				vars.Store(key, value)
+			}
+			return true
+		})
 	}
+	return vars
+}
 
-	return digraph.WithStepContext(ctx, stepCtx)
+// runHook runs the script of the hook point if it's defined. The node is
+// nil for the beforeRun hook.
+func (sc *Scheduler) runHook(ctx context.Context, point hook.Point, graph *ExecutionGraph, node *Node) error {
+	if sc.hooks == nil {
+		return nil
+	}
+	var script string
+	switch point {
+	case hook.BeforeRun:
+		script = sc.hooks.BeforeRun
+	case hook.BeforeStep:
+		script = sc.hooks.BeforeStep
+	case hook.AfterStep:
+		script = sc.hooks.AfterStep
+	}
+	if script == "" {
+		return nil
+	}
+
+	vars := hook.Vars{
+		DAGName: sc.dagName,
+		Params:  sc.params,
+	}
+	if node != nil {
+		state := node.State()
+		vars.Outputs = sc.outputVariables(graph, node).Variables()
+		vars.Step = &hook.Step{
+			Name:     node.data.Step.Name,
+			Status:   state.Status.String(),
+			ExitCode: state.ExitCode,
+		}
+		if state.Error != nil {
+			vars.Step.Error = state.Error.Error()
+		}
+	}
+	return hook.Run(ctx, point, script, vars)
+}
+
+// paramsMap converts the parameters in the form of "key=value" to a map.
+func paramsMap(params []string) map[string]string {
+	m := make(map[string]string, len(params))
+	for _, p := range params {
+		if k, v, ok := strings.Cut(p, "="); ok {
+			m[k] = v
+		}
+	}
+	return m
 }
 
 // buildStepContextForHandler builds the context for a handler.
 func (sc *Scheduler) buildStepContextForHandler(ctx context.Context, graph *ExecutionGraph, node *Node) context.Context {
 	stepCtx := digraph.NewStepContext(ctx, node.data.Step)
+	if sc.artifactDir != "" {
+		stepCtx = stepCtx.WithEnv(digraph.EnvKeyArtifactsDir, sc.artifactDir)
+	}
 
 	// get all output variables
 	for _, node := range graph.Nodes() {
@@ -349,14 +742,82 @@ func (sc *Scheduler) buildStepContextForHandler(ctx context.Context, graph *Exec
 		stepCtx.LoadOutputVariables(node.data.Step.OutputVariables)
 	}
 
+	// expose the results of the steps so that the handler can report them
+	result := &digraph.RunResult{DAG: sc.dagName, RequestID: sc.requestID}
+	if sc.publicURL != "" {
+		result.URL = sc.publicURL + "/dags/" + url.PathEscape(sc.dagName)
+		stepCtx = stepCtx.WithEnv(digraph.EnvKeyRunURL, result.URL)
+	}
+	var (
+		firstError   error
+		firstErrorAt time.Time
+	)
+	for _, node := range graph.Nodes() {
+		state := node.State()
+		name := node.data.Step.Name
+		if state.Status == NodeStatusError {
+			result.FailedSteps = append(result.FailedSteps, name)
+			if state.Error != nil && (firstError == nil || state.FinishedAt.Before(firstErrorAt)) {
+				firstError, firstErrorAt = state.Error, state.FinishedAt
+			}
+		}
+
+		stepResult := digraph.StepResult{
+			Name:     name,
+			Status:   state.Status.String(),
+			ExitCode: state.ExitCode,
+			Log:      state.Log,
+		}
+		if state.Error != nil {
+			stepResult.Error = state.Error.Error()
+		}
+
+		key := envKeySuffix(name)
+		if state.Status != NodeStatusNone {
+			stepCtx = stepCtx.WithEnv(digraph.EnvKeyStepExitCodePrefix+key, strconv.Itoa(state.ExitCode))
+		}
+		if state.Log != "" {
+			stepCtx = stepCtx.WithEnv(digraph.EnvKeyStepLogPathPrefix+key, state.Log)
+			if result.URL != "" {
+				stepResult.LogURL = result.URL + "/log?step=" + url.QueryEscape(name)
+				stepCtx = stepCtx.WithEnv(digraph.EnvKeyStepLogURLPrefix+key, stepResult.LogURL)
+			}
+		}
+		result.Steps = append(result.Steps, stepResult)
+	}
+	stepCtx = stepCtx.WithEnv(digraph.EnvKeyFailedSteps, strings.Join(result.FailedSteps, ","))
+	if firstError != nil {
+		result.FirstError = firstError.Error()
+		stepCtx = stepCtx.WithEnv(digraph.EnvKeyFirstError, result.FirstError)
+	}
+	stepCtx = stepCtx.WithRunResult(result)
+
 	return digraph.WithStepContext(ctx, stepCtx)
 }
 
+// envKeySuffix converts the step name to the suffix of an environment
+// variable name.
+func envKeySuffix(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
 func (sc *Scheduler) execNode(ctx context.Context, node *Node) error {
 	if !sc.dry {
 		if err := node.Execute(ctx); err != nil {
 			return fmt.Errorf("failed to execute step %q: %w", node.data.Step.Name, err)
 		}
+		if err := node.checkPostconditions(ctx); err != nil {
+			return fmt.Errorf("postconditions of step %q were not met: %w", node.data.Step.Name, err)
+		}
 	}
 
 	return nil
@@ -440,11 +901,77 @@ func (sc *Scheduler) isCanceled() bool {
 
 func isReady(ctx context.Context, g *ExecutionGraph, node *Node) bool {
 	ready := true
-	for _, dep := range g.to[node.id] {
+	for _, group := range node.data.Step.DependsAnyOf {
+		if !isAnyOfReady(g, node, group) {
+			ready = false
+		}
+	}
+
+	for _, dep := range g.upstreams(node.id) {
 		dep := g.node(dep)
 
+		if node.data.Step.IsAnyOfDependency(dep.data.Step.Name) {
+			// Handled above.
+			continue
+		}
+
+		if dep.isExpansionPending() {
+			// Wait for the generated steps to be added to the graph.
+			ready = false
+			continue
+		}
+
+		switch node.data.Step.DependencyConditionOf(dep.data.Step.Name) {
+		case digraph.DependencyOnFailure:
+			switch dep.State().Status {
+			case NodeStatusError:
+				continue
+
+			case NodeStatusSuccess, NodeStatusCached, NodeStatusSkipped:
+				ready = false
+				node.SetStatus(NodeStatusSkipped)
+				node.setError(errUpstreamNotFailed)
+
+			case NodeStatusCancel:
+				ready = false
+				node.SetStatus(NodeStatusCancel)
+
+			default:
+				ready = false
+
+			}
+			continue
+
+		case digraph.DependencyOnAlways:
+			if dep.isServiceRunning() {
+				continue
+			}
+			switch dep.State().Status {
+			case NodeStatusSuccess, NodeStatusCached, NodeStatusError, NodeStatusSkipped:
+				continue
+
+			case NodeStatusCancel:
+				ready = false
+				node.SetStatus(NodeStatusCancel)
+
+			default:
+				ready = false
+
+			}
+			continue
+
+		case digraph.DependencyOnSuccess:
+			// Handled below.
+
+		}
+
+		if dep.isServiceRunning() {
+			// The steps depending on the service run while it's running.
+			continue
+		}
+
 		switch dep.State().Status {
-		case NodeStatusSuccess:
+		case NodeStatusSuccess, NodeStatusCached:
 			continue
 
 		case NodeStatusError:
@@ -478,6 +1005,65 @@ func isReady(ctx context.Context, g *ExecutionGraph, node *Node) bool {
 	return ready
 }
 
+// isAnyOfReady returns true if any one of the upstream steps in the group
+// has succeeded. If all of them finished without success, the status of
+// the node is set according to the status of the upstream steps.
+func isAnyOfReady(g *ExecutionGraph, node *Node, group []string) bool {
+	var finished = true
+	var canceled, failed bool
+	for _, dep := range g.upstreams(node.id) {
+		dep := g.node(dep)
+		if !slices.Contains(group, dep.data.Step.Name) {
+			continue
+		}
+
+		if dep.isExpansionPending() {
+			finished = false
+			continue
+		}
+
+		switch dep.State().Status {
+		case NodeStatusSuccess, NodeStatusCached:
+			return true
+
+		case NodeStatusError:
+			// continueOn is not considered here so that the node waits
+			// for the other steps in the group to succeed.
+			failed = true
+
+		case NodeStatusSkipped:
+			// no-op
+
+		case NodeStatusCancel:
+			canceled = true
+
+		default:
+			finished = false
+
+		}
+	}
+
+	if !finished {
+		return false
+	}
+
+	// None of the upstream steps succeeded.
+	switch {
+	case canceled:
+		node.SetStatus(NodeStatusCancel)
+
+	case failed:
+		node.SetStatus(NodeStatusCancel)
+		node.setError(errUpstreamFailed)
+
+	default:
+		node.SetStatus(NodeStatusSkipped)
+		node.setError(errUpstreamSkipped)
+
+	}
+	return false
+}
+
 func (sc *Scheduler) runHandlerNode(ctx context.Context, graph *ExecutionGraph, node *Node) error {
 	defer func() {
 		node.data.State.FinishedAt = time.Now()
@@ -536,12 +1122,25 @@ func (sc *Scheduler) setup(ctx context.Context) (err error) {
 			&Node{data: NodeData{Step: *sc.onCancel}}
 	}
 
+	if sc.onTimeout != nil {
+		sc.handlers[digraph.HandlerOnTimeout] =
+			&Node{data: NodeData{Step: *sc.onTimeout}}
+	}
+
+	sc.stages = nil
+	for _, s := range sc.stageDefs {
+		sc.stages = append(sc.stages, newStage(s))
+	}
+
 	return err
 }
 
 func (sc *Scheduler) setCanceled() {
 	sc.mu.Lock()
 	defer sc.mu.Unlock()
+	if sc.canceled == 0 && sc.cancelCh != nil {
+		close(sc.cancelCh)
+	}
 	sc.canceled = 1
 }
 
@@ -555,6 +1154,17 @@ func (*Scheduler) runningCount(g *ExecutionGraph) int {
 	return count
 }
 
+// runningCountInGroup returns the number of running nodes in the parallel group.
+func (*Scheduler) runningCountInGroup(g *ExecutionGraph, group string) int {
+	count := 0
+	for _, node := range g.Nodes() {
+		if node.data.Step.ParallelGroup == group && node.State().Status == NodeStatusRunning {
+			count++
+		}
+	}
+	return count
+}
+
 func (*Scheduler) isFinished(g *ExecutionGraph) bool {
 	for _, node := range g.Nodes() {
 		if node.State().Status == NodeStatusRunning ||
@@ -570,7 +1180,8 @@ func (sc *Scheduler) isSucceed(g *ExecutionGraph) bool {
 	defer sc.mu.RUnlock()
 	for _, node := range g.Nodes() {
 		nodeStatus := node.State().Status
-		if nodeStatus == NodeStatusSuccess || nodeStatus == NodeStatusSkipped {
+		if nodeStatus == NodeStatusSuccess || nodeStatus == NodeStatusSkipped ||
+			nodeStatus == NodeStatusCached {
 			continue
 		}
 		return false
@@ -578,6 +1189,30 @@ func (sc *Scheduler) isSucceed(g *ExecutionGraph) bool {
 	return true
 }
 
+// isFailureTolerated returns true if the number of failed steps in the
+// graph does not exceed the failure threshold.
+func (sc *Scheduler) isFailureTolerated(g *ExecutionGraph) bool {
+	if sc.maxFailedSteps <= 0 && sc.maxFailedStepsPercent <= 0 {
+		return false
+	}
+
+	var failed int
+	nodes := g.Nodes()
+	for _, node := range nodes {
+		if node.State().Status == NodeStatusError {
+			failed++
+		}
+	}
+
+	if sc.maxFailedSteps > 0 && failed > sc.maxFailedSteps {
+		return false
+	}
+	if sc.maxFailedStepsPercent > 0 && failed*100 > sc.maxFailedStepsPercent*len(nodes) {
+		return false
+	}
+	return true
+}
+
 func (sc *Scheduler) isTimeout(startedAt time.Time) bool {
 	return sc.timeout > 0 && time.Since(startedAt) > sc.timeout
 }
@@ -585,4 +1220,10 @@ func (sc *Scheduler) isTimeout(startedAt time.Time) bool {
 var (
 	errUpstreamFailed  = fmt.Errorf("upstream failed")
 	errUpstreamSkipped = fmt.Errorf("upstream skipped")
+	// errUpstreamNotFailed is set when a step depending on the failure of
+	// the upstream step is skipped because the upstream did not fail.
+	errUpstreamNotFailed = fmt.Errorf("upstream did not fail")
+	// errSkipRequested is set when the step is skipped by the request of
+	// the operator.
+	errSkipRequested = fmt.Errorf("skipped by request")
 )
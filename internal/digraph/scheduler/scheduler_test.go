@@ -2,6 +2,9 @@ package scheduler_test
 
 import (
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
 	"path/filepath"
@@ -13,6 +16,7 @@ import (
 	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/dagu-org/dagu/internal/digraph/scheduler"
 	"github.com/dagu-org/dagu/internal/fileutil"
+	"github.com/dagu-org/dagu/internal/steplock"
 	"github.com/dagu-org/dagu/internal/test"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -269,6 +273,191 @@ func TestScheduler(t *testing.T) {
 		result.AssertNodeStatus(t, "1", scheduler.NodeStatusSuccess)
 		result.AssertNodeStatus(t, "2", scheduler.NodeStatusSuccess)
 	})
+	t.Run("ContinueOnExitCodeNotMatched", func(t *testing.T) {
+		sc := setup(t)
+
+		// 1 (exit code 2, benign) -> 3
+		// 2 (exit code 4, unknown) -> 4
+		continueOn := digraph.ContinueOn{
+			ExitCode:    []int{2, 3},
+			MarkSuccess: true,
+		}
+		graph := sc.newGraph(t,
+			newStep("1", withCommand("sh -c 'exit 2'"), withContinueOn(continueOn)),
+			newStep("2", withCommand("sh -c 'exit 4'"), withContinueOn(continueOn)),
+			successStep("3", "1"),
+			successStep("4", "2"),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+
+		// Only the known exit code is treated as success
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusError)
+		result.AssertNodeStatus(t, "3", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "4", scheduler.NodeStatusCancel)
+	})
+	t.Run("MaxFailedSteps", func(t *testing.T) {
+		sc := setup(t, withMaxFailedSteps(2))
+
+		// 1, 2 fail but the threshold is not exceeded
+		graph := sc.newGraph(t,
+			failStep("1"),
+			failStep("2"),
+			successStep("3"),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusSuccess)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusError)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusError)
+		result.AssertNodeStatus(t, "3", scheduler.NodeStatusSuccess)
+	})
+	t.Run("MaxFailedStepsExceeded", func(t *testing.T) {
+		sc := setup(t, withMaxFailedSteps(1))
+
+		graph := sc.newGraph(t,
+			failStep("1"),
+			failStep("2"),
+			successStep("3"),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+		result.AssertNodeStatus(t, "3", scheduler.NodeStatusSuccess)
+	})
+	t.Run("MaxFailedStepsPercent", func(t *testing.T) {
+		steps := []digraph.Step{failStep("1"), successStep("2"), successStep("3"), successStep("4")}
+
+		// 1 of 4 steps (25%) fails
+		sc := setup(t, withMaxFailedStepsPercent(25))
+		sc.newGraph(t, steps...).Schedule(t, scheduler.StatusSuccess)
+
+		sc = setup(t, withMaxFailedStepsPercent(20))
+		sc.newGraph(t, steps...).Schedule(t, scheduler.StatusError)
+	})
+	t.Run("HookBeforeRunFail", func(t *testing.T) {
+		sc := setup(t, withHooks(digraph.Hooks{
+			BeforeRun: `
+if params["ENV"] != "prod":
+    fail("ENV must be prod")
+`,
+		}), withParams("ENV=dev"))
+
+		graph := sc.newGraph(t, successStep("1"))
+
+		result := graph.Schedule(t, scheduler.StatusError)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusSkipped)
+	})
+	t.Run("HookBeforeStepSkip", func(t *testing.T) {
+		sc := setup(t, withHooks(digraph.Hooks{
+			BeforeStep: `
+if step.name == "2" and outputs["OUT"] == "skip":
+    skip("skipped by output")
+`,
+		}))
+
+		graph := sc.newGraph(t,
+			newStep("1", withCommand("echo skip"), withOutput("OUT")),
+			newStep("2", withCommand("true"), withDepends("1")),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusSuccess)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusSkipped)
+	})
+	t.Run("SkipRequested", func(t *testing.T) {
+		sc := setup(t)
+
+		// 1 -> 2 (skip requested) -> 3
+		graph := sc.newGraph(t,
+			successStep("1"),
+			failStep("2", "1"),
+			successStep("3", "2"),
+		)
+		require.NoError(t, graph.RequestSkip("2"))
+		require.ErrorIs(t, graph.RequestSkip("4"), scheduler.ErrStepNotFound)
+
+		result := graph.Schedule(t, scheduler.StatusSuccess)
+
+		result.AssertDoneCount(t, 2)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusSkipped)
+		result.AssertNodeStatus(t, "3", scheduler.NodeStatusSuccess)
+		require.ErrorIs(t, graph.RequestSkip("1"), scheduler.ErrStepAlreadyStarted)
+	})
+	t.Run("StepTimeout", func(t *testing.T) {
+		sc := setup(t)
+
+		// 1 (timeout) -> 2
+		graph := sc.newGraph(t,
+			newStep("1", withCommand("sleep 2"), withStepTimeout(100*time.Millisecond)),
+			successStep("2", "1"),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusError)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusCancel)
+
+		node := result.Node(t, "1")
+		require.ErrorIs(t, node.State().Error, scheduler.ErrStepTimeout)
+	})
+	t.Run("StepTimeoutContinueOnFailure", func(t *testing.T) {
+		sc := setup(t)
+
+		// 1 (timeout, continue on failure) -> 2
+		graph := sc.newGraph(t,
+			newStep("1",
+				withCommand("sleep 2"),
+				withStepTimeout(100*time.Millisecond),
+				withContinueOn(digraph.ContinueOn{Failure: true}),
+			),
+			successStep("2", "1"),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusError)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusSuccess)
+	})
+	t.Run("StepTimeoutCancel", func(t *testing.T) {
+		sc := setup(t)
+
+		// 1 (timeout, cancel on timeout) -> 2
+		graph := sc.newGraph(t,
+			newStep("1",
+				withCommand("sleep 2"),
+				withStepTimeout(100*time.Millisecond),
+				withCancelOnTimeout(),
+				withRetryPolicy(2, 0),
+			),
+			successStep("2", "1"),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusCancel)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusCancel)
+
+		node := result.Node(t, "1")
+		require.Equal(t, 0, node.State().RetryCount)
+	})
+	t.Run("HookAfterStepFail", func(t *testing.T) {
+		sc := setup(t, withHooks(digraph.Hooks{
+			AfterStep: `
+if step.name == "1" and outputs["OUT"] != "ok":
+    fail("invalid output")
+`,
+		}))
+
+		graph := sc.newGraph(t,
+			newStep("1", withCommand("echo ng"), withOutput("OUT")),
+			successStep("2", "1"),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusError)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusCancel)
+	})
 	t.Run("CancelSchedule", func(t *testing.T) {
 		sc := setup(t)
 
@@ -310,6 +499,24 @@ func TestScheduler(t *testing.T) {
 		result.AssertNodeStatus(t, "2", scheduler.NodeStatusCancel)
 		result.AssertNodeStatus(t, "3", scheduler.NodeStatusCancel)
 	})
+	t.Run("OnTimeoutHandler", func(t *testing.T) {
+		sc := setup(t,
+			withTimeout(time.Second),
+			withOnTimeout(successStep("onTimeout")),
+			withOnFailure(successStep("onFailure")),
+			withOnExit(successStep("onExit")),
+		)
+
+		graph := sc.newGraph(t, newStep("1", withCommand("sleep 10")))
+
+		result := graph.Schedule(t, scheduler.StatusError)
+
+		// onTimeout runs instead of onFailure
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusCancel)
+		result.AssertNodeStatus(t, "onTimeout", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "onFailure", scheduler.NodeStatusNone)
+		result.AssertNodeStatus(t, "onExit", scheduler.NodeStatusSuccess)
+	})
 	t.Run("RetryPolicyFail", func(t *testing.T) {
 		const file = "flag_test_retry_fail"
 
@@ -474,6 +681,91 @@ func TestScheduler(t *testing.T) {
 		result.AssertNodeStatus(t, "1", scheduler.NodeStatusSuccess)
 		result.AssertNodeStatus(t, "onExit", scheduler.NodeStatusError)
 	})
+	t.Run("StageHandlers", func(t *testing.T) {
+		sc := setup(t, withStages(
+			digraph.Stage{Name: "build", HandlerOn: digraph.HandlerOn{
+				Success: &digraph.Step{Name: "build.onSuccess", Command: "true"},
+				Exit:    &digraph.Step{Name: "build.onExit", Command: "true"},
+			}},
+			digraph.Stage{Name: "test", HandlerOn: digraph.HandlerOn{
+				Success: &digraph.Step{Name: "test.onSuccess", Command: "true"},
+				Failure: &digraph.Step{Name: "test.onFailure", Command: "true"},
+			}},
+		))
+
+		graph := sc.newGraph(t,
+			newStep("1", withCommand("true"), withStage("build")),
+			newStep("2", withCommand("true"), withStage("build")),
+			newStep("3", withCommand("false"), withStage("test"), withDepends("1", "2")),
+			newStep("4", withCommand("true"), withDepends("3")),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+
+		// 1, 2, 3 + build.onSuccess, build.onExit, test.onFailure
+		result.AssertDoneCount(t, 6)
+
+		stages := sc.Scheduler.Stages(graph.ExecutionGraph)
+		require.Len(t, stages, 2)
+
+		require.Equal(t, "build", stages[0].Name)
+		require.Equal(t, scheduler.NodeStatusSuccess, stages[0].Status)
+		require.False(t, stages[0].StartedAt.IsZero())
+		require.False(t, stages[0].FinishedAt.IsZero())
+		require.Equal(t, scheduler.NodeStatusSuccess, stages[0].Handlers[digraph.HandlerOnSuccess].State().Status)
+		require.Equal(t, scheduler.NodeStatusSuccess, stages[0].Handlers[digraph.HandlerOnExit].State().Status)
+
+		require.Equal(t, "test", stages[1].Name)
+		require.Equal(t, scheduler.NodeStatusError, stages[1].Status)
+		require.Equal(t, scheduler.NodeStatusNone, stages[1].Handlers[digraph.HandlerOnSuccess].State().Status)
+		require.Equal(t, scheduler.NodeStatusSuccess, stages[1].Handlers[digraph.HandlerOnFailure].State().Status)
+	})
+	t.Run("OnFailureHandlerFailureContext", func(t *testing.T) {
+		sc := setup(t, withOnFailure(newStep("onFailure",
+			withCommand(`echo "$DAG_FAILED_STEPS|$DAG_FIRST_ERROR|$DAG_STEP_EXIT_CODE_STEP_1|$DAG_STEP_EXIT_CODE_STEP_2"`),
+			withOutput("OUT"),
+		)))
+
+		graph := sc.newGraph(t,
+			successStep("step 1"),
+			newStep("step-2", withCommand("sh -c 'exit 3'")),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+		result.AssertNodeStatus(t, "onFailure", scheduler.NodeStatusSuccess)
+
+		require.NotEmpty(t, result.Node(t, "step-2").State().Log)
+
+		output, ok := result.Node(t, "onFailure").Data().Step.OutputVariables.Load("OUT")
+		require.True(t, ok, "output variable not found")
+		require.Regexp(t, `^OUT=step-2\|.*exit status 3\|0\|3$`, output)
+	})
+	t.Run("OnFailureHandlerHTTP", func(t *testing.T) {
+		var gotBody string
+		srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			gotBody = string(body)
+		}))
+		defer srv.Close()
+
+		onFailure := newStep("onFailure", withCommand("POST "+srv.URL))
+		onFailure.ExecutorConfig = digraph.ExecutorConfig{Type: digraph.ExecutorTypeHTTP, Config: map[string]any{
+			"body":     `{{ .DAG }}|{{ json .FailedSteps }}|{{ .FirstError }}|{{ range .Steps }}{{ .Name }}={{ .Status }} {{ .LogURL }};{{ end }}`,
+			"template": true,
+		}}
+		sc := setup(t, withOnFailure(onFailure), func(cfg *scheduler.Config) {
+			cfg.DAGName = "etl"
+			cfg.PublicURL = "https://example.com/dagu/"
+		})
+
+		graph := sc.newGraph(t,
+			newStep("step-1", withCommand("sh -c 'exit 3'")),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+		result.AssertNodeStatus(t, "onFailure", scheduler.NodeStatusSuccess)
+		require.Equal(t, `etl|["step-1"]|failed to execute step "step-1": exit status 3|step-1=failed https://example.com/dagu/dags/etl/log?step=step-1;`, gotBody)
+	})
 	t.Run("OnCancelHandler", func(t *testing.T) {
 		sc := setup(t, withOnCancel(successStep("onCancel")))
 
@@ -659,6 +951,47 @@ func TestScheduler(t *testing.T) {
 		require.True(t, ok, "output variable not found")
 		require.Equal(t, "RESULT=hello", output, "expected output %q, got %q", "hello", output)
 	})
+	t.Run("CommandList", func(t *testing.T) {
+		sc := setup(t)
+
+		// The arguments are passed to the command as they are.
+		graph := sc.newGraph(t,
+			newStep("1", withCommandList("printf", "%s|", `"a b"`, "$(c)", "$$5"), withOutput("OUT")),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusSuccess)
+
+		node := result.Node(t, "1")
+		output, ok := node.Data().Step.OutputVariables.Load("OUT")
+		require.True(t, ok, "output variable not found")
+		require.Equal(t, `OUT="a b"|$(c)|$5|`, output)
+	})
+	t.Run("Postconditions", func(t *testing.T) {
+		sc := setup(t)
+
+		graph := sc.newGraph(t,
+			newStep("1",
+				withCommand("echo 42"),
+				withOutput("OUT"),
+				withPostcondition(digraph.Condition{Condition: "${OUT}", Expected: "re:^[0-9]+$"}),
+			),
+			newStep("2",
+				withCommand("echo hello"),
+				withOutput("OUT2"),
+				withPostcondition(digraph.Condition{Condition: "${OUT2}", Expected: "re:^[0-9]+$"}),
+			),
+			newStep("3", withCommand("true"), withDepends("2")),
+		)
+
+		// 2 exits with 0 but its postcondition is not met
+		result := graph.Schedule(t, scheduler.StatusError)
+
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusError)
+		result.AssertNodeStatus(t, "3", scheduler.NodeStatusCancel)
+
+		require.ErrorIs(t, result.Node(t, "2").State().Error, digraph.ErrConditionNotMet)
+	})
 	t.Run("OutputInheritance", func(t *testing.T) {
 		sc := setup(t)
 
@@ -809,6 +1142,421 @@ func TestScheduler(t *testing.T) {
 		require.True(t, ok, "output variable not found")
 		require.Equal(t, "RESULT=step_test", output, "unexpected output %q", output)
 	})
+	t.Run("Artifacts", func(t *testing.T) {
+		artifactDir := t.TempDir()
+		sc := setup(t, withArtifactDir(artifactDir))
+
+		producerDir, consumerDir := t.TempDir(), t.TempDir()
+		graph := sc.newGraph(t,
+			newStep("1", withWorkingDir(producerDir), withCommand("sh -c 'echo hello > out.txt'"),
+				withArtifacts(digraph.Artifacts{Produces: []string{"out.txt"}})),
+			newStep("2", withWorkingDir(consumerDir), withDepends("1"), withCommand("cat out.txt"),
+				withArtifacts(digraph.Artifacts{Consumes: []string{"out.txt"}}), withOutput("RESULT")),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusSuccess)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusSuccess)
+
+		require.Equal(t, []string{"out.txt"}, result.Node(t, "1").State().Artifacts)
+		require.FileExists(t, filepath.Join(artifactDir, "out.txt"))
+
+		output, ok := result.Node(t, "2").Data().Step.OutputVariables.Load("RESULT")
+		require.True(t, ok, "output variable not found")
+		require.Equal(t, "RESULT=hello", output, "unexpected output %q", output)
+	})
+	t.Run("Cache", func(t *testing.T) {
+		cacheDir := t.TempDir()
+		workDir := t.TempDir()
+		input := filepath.Join(workDir, "input.txt")
+		require.NoError(t, os.WriteFile(input, []byte("v1"), 0600))
+
+		step := newStep("1", withWorkingDir(workDir), withCommand("cat input.txt"),
+			withOutput("RESULT"), withCache(digraph.Cache{Inputs: []string{"input.txt"}}))
+
+		// The first run executes the step and records the result.
+		sc := setup(t, withCacheDir(cacheDir))
+		result := sc.newGraph(t, step).Schedule(t, scheduler.StatusSuccess)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusSuccess)
+
+		// The second run restores the result from the cache.
+		sc = setup(t, withCacheDir(cacheDir))
+		result = sc.newGraph(t, step, newStep("2", withDepends("1"), withCommand("echo $RESULT"))).
+			Schedule(t, scheduler.StatusSuccess)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusCached)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusSuccess)
+
+		output, ok := result.Node(t, "1").Data().Step.OutputVariables.Load("RESULT")
+		require.True(t, ok, "output variable not found")
+		require.Equal(t, "RESULT=v1", output, "unexpected output %q", output)
+
+		// Changing the input invalidates the cache.
+		require.NoError(t, os.WriteFile(input, []byte("v2"), 0600))
+		sc = setup(t, withCacheDir(cacheDir))
+		result = sc.newGraph(t, step).Schedule(t, scheduler.StatusSuccess)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusSuccess)
+	})
+	t.Run("DependsOnFailure", func(t *testing.T) {
+		sc := setup(t)
+
+		// 1 -> 2 (on failure) -> 3
+		//   -> 4 (always)
+		graph := sc.newGraph(t,
+			failStep("1"),
+			newStep("2", withDependsOn("1", digraph.DependencyOnFailure), withCommand("true")),
+			successStep("3", "2"),
+			newStep("4", withDependsOn("1", digraph.DependencyOnAlways), withCommand("true")),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusError)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "3", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "4", scheduler.NodeStatusSuccess)
+	})
+	t.Run("DependsOnFailureUpstreamSucceeded", func(t *testing.T) {
+		sc := setup(t)
+
+		// 1 -> 2 (on failure) -> 3
+		graph := sc.newGraph(t,
+			successStep("1"),
+			newStep("2", withDependsOn("1", digraph.DependencyOnFailure), withCommand("true")),
+			successStep("3", "2"),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusSuccess)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusSkipped)
+		result.AssertNodeStatus(t, "3", scheduler.NodeStatusSkipped)
+	})
+	t.Run("DependsAnyOf", func(t *testing.T) {
+		sc := setup(t)
+
+		// 1 (fail), 2 -> 3 (any of 1, 2)
+		graph := sc.newGraph(t,
+			failStep("1"),
+			successStep("2"),
+			newStep("3", withDependsAnyOf("1", "2"), withCommand("true")),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusError)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "3", scheduler.NodeStatusSuccess)
+	})
+	t.Run("DependsAnyOfAllFailed", func(t *testing.T) {
+		sc := setup(t)
+
+		// 1 (fail), 2 (fail) -> 3 (any of 1, 2)
+		graph := sc.newGraph(t,
+			failStep("1"),
+			failStep("2"),
+			newStep("3", withDependsAnyOf("1", "2"), withCommand("true")),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+		result.AssertNodeStatus(t, "3", scheduler.NodeStatusCancel)
+	})
+	t.Run("DependsAnyOfWithOtherDependency", func(t *testing.T) {
+		sc := setup(t)
+
+		// 1, 2 (fail), 3 -> 4 (any of 1, 2 and 3)
+		graph := sc.newGraph(t,
+			successStep("1"),
+			failStep("2"),
+			successStep("3"),
+			newStep("4", withDepends("3"), withDependsAnyOf("1", "2"), withCommand("true")),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+		result.AssertNodeStatus(t, "4", scheduler.NodeStatusSuccess)
+	})
+	t.Run("MaxParallelInGroup", func(t *testing.T) {
+		sc := setup(t)
+
+		// 1, 2, 3, 4 in the group run 2 at a time, 5 is not limited
+		var steps []digraph.Step
+		for _, name := range []string{"1", "2", "3", "4"} {
+			steps = append(steps, newStep(name, withCommand("sleep 0.3"), withParallelGroup("group", 2)))
+		}
+		steps = append(steps, newStep("5", withCommand("sleep 0.3")))
+		graph := sc.newGraph(t, steps...)
+
+		result := graph.Schedule(t, scheduler.StatusSuccess)
+		result.AssertDoneCount(t, 5)
+
+		// Check the number of steps in the group running at the start of each step
+		var states []scheduler.NodeState
+		for _, node := range graph.Nodes() {
+			if node.Data().Step.ParallelGroup == "group" {
+				states = append(states, node.Data().State)
+			}
+		}
+		for _, s := range states {
+			var running int
+			for _, other := range states {
+				if !other.StartedAt.After(s.StartedAt) && other.FinishedAt.After(s.StartedAt) {
+					running++
+				}
+			}
+			require.LessOrEqual(t, running, 2, "too many steps running in the group")
+		}
+	})
+	t.Run("StepLock", func(t *testing.T) {
+		lockDir := t.TempDir()
+		sc := setup(t, withLockDir(lockDir))
+
+		// The lock is held by a step of another DAG at first.
+		held, ok, err := steplock.New(lockDir).TryLock("resource")
+		require.NoError(t, err)
+		require.True(t, ok)
+		releasedAt := time.Now().Add(300 * time.Millisecond)
+		time.AfterFunc(time.Until(releasedAt), func() {
+			_ = held.Unlock()
+		})
+
+		graph := sc.newGraph(t,
+			newStep("1", withCommand("sleep 0.2"), withLock("resource")),
+			newStep("2", withCommand("sleep 0.2"), withLock("resource")),
+			newStep("3", withCommand("true")),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusSuccess)
+		result.AssertDoneCount(t, 3)
+
+		var states []scheduler.NodeState
+		for _, node := range graph.Nodes() {
+			if node.Data().Step.Lock == "resource" {
+				states = append(states, node.Data().State)
+			}
+		}
+		require.Len(t, states, 2)
+		first, second := states[0], states[1]
+		if second.StartedAt.Before(first.StartedAt) {
+			first, second = second, first
+		}
+		require.False(t, second.StartedAt.Before(first.FinishedAt), "the steps sharing the lock overlapped")
+		require.False(t, first.StartedAt.Before(releasedAt), "the step ran while another DAG held the lock")
+	})
+	t.Run("Expand", func(t *testing.T) {
+		sc := setup(t)
+
+		// 1 -> 2[0], 2[1] -> 3
+		graph := sc.newGraph(t,
+			newStep("1", withCommand(`echo '["a", "b"]'`), withOutput("ITEMS"),
+				withExpand(newStep("2", withCommand("echo ${ITEM}-${ITEM_INDEX}"), withOutput("RESULT")))),
+			successStep("3", "1"),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusSuccess)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "2[0]", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "2[1]", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "3", scheduler.NodeStatusSuccess)
+		require.Len(t, graph.Nodes(), 4)
+
+		output, ok := result.Node(t, "2[1]").Data().Step.OutputVariables.Load("RESULT")
+		require.True(t, ok, "output variable not found")
+		require.Equal(t, "RESULT=b-1", output, "unexpected output %q", output)
+
+		// The downstream step runs after the generated steps
+		node3 := result.Node(t, "3").Data()
+		require.Equal(t, []string{"1", "2[0]", "2[1]"}, node3.Step.Depends)
+		for _, name := range []string{"2[0]", "2[1]"} {
+			finishedAt := result.Node(t, name).Data().State.FinishedAt
+			require.False(t, node3.State.StartedAt.Before(finishedAt), "step 3 started before %s finished", name)
+		}
+	})
+	t.Run("ExpandInvalidOutput", func(t *testing.T) {
+		sc := setup(t)
+
+		graph := sc.newGraph(t,
+			newStep("1", withCommand("echo not-a-list"), withOutput("ITEMS"),
+				withExpand(newStep("2", withCommand("true")))),
+			successStep("3", "1"),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusError)
+		result.AssertNodeStatus(t, "3", scheduler.NodeStatusCancel)
+	})
+	t.Run("Parallel", func(t *testing.T) {
+		sc := setup(t)
+
+		// 1 -> 2[0], 2[1], 2[2] -> 2 -> 3
+		graph := sc.newGraph(t,
+			newStep("1", withCommand(`echo '["a", "b", {"c": 1}]'`), withOutput("ITEMS")),
+			newStep("2", withDepends("1"), withCommand("echo ${ITEM}-${ITEM_INDEX}"), withOutput("RESULT"),
+				withParallel("${ITEMS}", 2)),
+			successStep("3", "2"),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusSuccess)
+		for _, name := range []string{"1", "2[0]", "2[1]", "2[2]", "2", "3"} {
+			result.AssertNodeStatus(t, name, scheduler.NodeStatusSuccess)
+		}
+		require.Len(t, graph.Nodes(), 6)
+
+		output, ok := result.Node(t, "2[1]").Data().Step.OutputVariables.Load("RESULT")
+		require.True(t, ok, "output variable not found")
+		require.Equal(t, "RESULT=b-1", output, "unexpected output %q", output)
+		require.Equal(t, `{"c": 1}`, result.Node(t, "2[2]").Data().Step.ExpandItem.Value)
+		require.Equal(t, 2, result.Node(t, "2[0]").Data().Step.MaxParallel)
+
+		// The step finishes after its items, and the downstream step runs
+		// after the step.
+		node2 := result.Node(t, "2").Data()
+		for _, name := range []string{"2[0]", "2[1]", "2[2]"} {
+			finishedAt := result.Node(t, name).Data().State.FinishedAt
+			require.False(t, node2.State.FinishedAt.Before(finishedAt), "step 2 finished before %s", name)
+		}
+		node3 := result.Node(t, "3").Data()
+		require.False(t, node3.State.StartedAt.Before(node2.State.FinishedAt), "step 3 started before step 2 finished")
+	})
+	t.Run("ParallelItemFailed", func(t *testing.T) {
+		sc := setup(t)
+
+		graph := sc.newGraph(t,
+			newStep("1", withCommand(`echo '["0", "1"]'`), withOutput("ITEMS")),
+			newStep("2", withDepends("1"), withCommand("test ${ITEM} = 0"), withParallel("${ITEMS}", 0)),
+			successStep("3", "2"),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+		result.AssertNodeStatus(t, "2[0]", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "2[1]", scheduler.NodeStatusError)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusError)
+		result.AssertNodeStatus(t, "3", scheduler.NodeStatusCancel)
+		require.ErrorContains(t, result.Node(t, "2").State().Error, "1 of 2")
+	})
+	t.Run("ParallelNoItems", func(t *testing.T) {
+		sc := setup(t)
+
+		graph := sc.newGraph(t,
+			newStep("1", withCommand(`echo '[]'`), withOutput("ITEMS")),
+			newStep("2", withDepends("1"), withCommand("false"), withParallel("${ITEMS}", 0)),
+			successStep("3", "2"),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusSuccess)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "3", scheduler.NodeStatusSuccess)
+		require.Len(t, graph.Nodes(), 3)
+	})
+	t.Run("Service", func(t *testing.T) {
+		sc := setup(t)
+
+		ready := filepath.Join(t.TempDir(), "ready")
+		// The service is stopped when the steps depending on it finish.
+		graph := sc.newGraph(t,
+			newStep("1", withCommand("sh -c 'sleep 0.5 && touch "+ready+" && sleep 10'"),
+				withService(digraph.StepService{
+					Readiness:         []digraph.Condition{{Command: "test -f " + ready}},
+					ReadinessInterval: 100 * time.Millisecond,
+					ReadinessTimeout:  5 * time.Second,
+				})),
+			newStep("2", withDepends("1"), withCommand("test -f "+ready)),
+			successStep("3", "2"),
+		)
+
+		start := time.Now()
+		result := graph.Schedule(t, scheduler.StatusSuccess)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "3", scheduler.NodeStatusSuccess)
+		require.Less(t, time.Since(start), 5*time.Second)
+
+		node1 := result.Node(t, "1").Data()
+		require.NoError(t, node1.State.Error)
+		node2 := result.Node(t, "2").Data()
+		require.False(t, node1.State.FinishedAt.Before(node2.State.FinishedAt), "service stopped before step 2 finished")
+	})
+	t.Run("ServiceRestart", func(t *testing.T) {
+		sc := setup(t)
+
+		graph := sc.newGraph(t,
+			newStep("1", withCommand("sleep 0.2"), withService(digraph.StepService{RestartLimit: 2})),
+			newStep("2", withDepends("1"), withCommand("sleep 2")),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusError)
+		require.Equal(t, 2, result.Node(t, "1").State().RetryCount)
+	})
+	t.Run("ServiceRestartNotReady", func(t *testing.T) {
+		sc := setup(t)
+
+		dir := t.TempDir()
+		ready := filepath.Join(dir, "ready")
+		marker := filepath.Join(dir, "restarted")
+		// The first run is ready and crashes. The restarted service is ready
+		// after a while, and step 2 must wait for it.
+		script := fmt.Sprintf(`if [ -f %[2]s ]; then sleep 1.5; touch %[1]s; sleep 10; else touch %[2]s %[1]s; sleep 0.3; rm %[1]s; exit 1; fi`, ready, marker)
+		graph := sc.newGraph(t,
+			newStep("1", withCommandList("sh", "-c", script),
+				withService(digraph.StepService{
+					Readiness:         []digraph.Condition{{Command: "test -f " + ready}},
+					ReadinessInterval: 100 * time.Millisecond,
+					ReadinessTimeout:  5 * time.Second,
+					RestartLimit:      1,
+				})),
+			newStep("0", withCommand("sleep 0.8")),
+			newStep("2", withDepends("0", "1"), withCommand("test -f "+ready)),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusSuccess)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusSuccess)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusSuccess)
+		require.Equal(t, 1, result.Node(t, "1").State().RetryCount)
+	})
+	t.Run("ServiceRestartCanceled", func(t *testing.T) {
+		sc := setup(t)
+
+		graph := sc.newGraph(t,
+			newStep("1", withCommand("false"),
+				withService(digraph.StepService{RestartLimit: 1, RestartInterval: time.Minute})),
+			successStep("2", "1"),
+		)
+
+		go func() {
+			time.Sleep(500 * time.Millisecond)
+			graph.Cancel(t)
+		}()
+
+		start := time.Now()
+		result := graph.Schedule(t, scheduler.StatusCancel)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusCancel)
+		require.Less(t, time.Since(start), 10*time.Second)
+	})
+	t.Run("ServiceNotReady", func(t *testing.T) {
+		sc := setup(t)
+
+		graph := sc.newGraph(t,
+			newStep("1", withCommand("sleep 10"),
+				withService(digraph.StepService{
+					Readiness:         []digraph.Condition{{Command: "false"}},
+					ReadinessInterval: 100 * time.Millisecond,
+					ReadinessTimeout:  500 * time.Millisecond,
+				})),
+			successStep("2", "1"),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusError)
+		result.AssertNodeStatus(t, "2", scheduler.NodeStatusCancel)
+		require.ErrorContains(t, result.Node(t, "1").State().Error, "service not ready")
+	})
+	t.Run("ArtifactNotFound", func(t *testing.T) {
+		sc := setup(t, withArtifactDir(t.TempDir()))
+
+		graph := sc.newGraph(t,
+			newStep("1", withWorkingDir(t.TempDir()), withCommand("true"),
+				withArtifacts(digraph.Artifacts{Consumes: []string{"missing.txt"}})),
+		)
+
+		result := graph.Schedule(t, scheduler.StatusError)
+		result.AssertNodeStatus(t, "1", scheduler.NodeStatusError)
+	})
 }
 
 func successStep(name string, depends ...string) digraph.Step {
@@ -827,6 +1575,23 @@ func withDepends(depends ...string) stepOption {
 	}
 }
 
+func withDependsOn(upstream string, cond digraph.DependencyCondition) stepOption {
+	return func(step *digraph.Step) {
+		step.Depends = append(step.Depends, upstream)
+		if step.DependsOn == nil {
+			step.DependsOn = make(map[string]digraph.DependencyCondition)
+		}
+		step.DependsOn[upstream] = cond
+	}
+}
+
+func withDependsAnyOf(upstreams ...string) stepOption {
+	return func(step *digraph.Step) {
+		step.Depends = append(step.Depends, upstreams...)
+		step.DependsAnyOf = append(step.DependsAnyOf, upstreams)
+	}
+}
+
 func withContinueOn(c digraph.ContinueOn) stepOption {
 	return func(step *digraph.Step) {
 		step.ContinueOn = c
@@ -840,6 +1605,18 @@ func withRetryPolicy(limit int, interval time.Duration) stepOption {
 	}
 }
 
+func withStepTimeout(timeout time.Duration) stepOption {
+	return func(step *digraph.Step) {
+		step.Timeout = timeout
+	}
+}
+
+func withCancelOnTimeout() stepOption {
+	return func(step *digraph.Step) {
+		step.CancelOnTimeout = true
+	}
+}
+
 func withRepeatPolicy(repeat bool, interval time.Duration) stepOption {
 	return func(step *digraph.Step) {
 		step.RepeatPolicy.Repeat = repeat
@@ -853,6 +1630,12 @@ func withPrecondition(condition digraph.Condition) stepOption {
 	}
 }
 
+func withPostcondition(condition digraph.Condition) stepOption {
+	return func(step *digraph.Step) {
+		step.Postconditions = []digraph.Condition{condition}
+	}
+}
+
 func withScript(script string) stepOption {
 	return func(step *digraph.Step) {
 		step.Script = script
@@ -865,12 +1648,61 @@ func withWorkingDir(dir string) stepOption {
 	}
 }
 
+func withArtifacts(artifacts digraph.Artifacts) stepOption {
+	return func(step *digraph.Step) {
+		step.Artifacts = artifacts
+	}
+}
+
+func withCache(cache digraph.Cache) stepOption {
+	return func(step *digraph.Step) {
+		step.Cache = &cache
+	}
+}
+
 func withOutput(output string) stepOption {
 	return func(step *digraph.Step) {
 		step.Output = output
 	}
 }
 
+func withParallelGroup(group string, maxParallel int) stepOption {
+	return func(step *digraph.Step) {
+		step.ParallelGroup = group
+		step.MaxParallel = maxParallel
+	}
+}
+
+func withLock(key string) stepOption {
+	return func(step *digraph.Step) {
+		step.Lock = key
+	}
+}
+
+func withStage(stage string) stepOption {
+	return func(step *digraph.Step) {
+		step.Stage = stage
+	}
+}
+
+func withExpand(tmpl digraph.Step) stepOption {
+	return func(step *digraph.Step) {
+		step.Expand = &tmpl
+	}
+}
+
+func withParallel(items string, maxParallel int) stepOption {
+	return func(step *digraph.Step) {
+		step.Parallel = &digraph.Parallel{Items: items, MaxParallel: maxParallel}
+	}
+}
+
+func withService(svc digraph.StepService) stepOption {
+	return func(step *digraph.Step) {
+		step.Service = &svc
+	}
+}
+
 func withCommand(command string) stepOption {
 	return func(step *digraph.Step) {
 		cmd, args, err := cmdutil.SplitCommand(command)
@@ -883,6 +1715,14 @@ func withCommand(command string) stepOption {
 	}
 }
 
+func withCommandList(argv ...string) stepOption {
+	return func(step *digraph.Step) {
+		step.CommandList = argv
+		step.Command = argv[0]
+		step.Args = argv[1:]
+	}
+}
+
 func newStep(name string, opts ...stepOption) digraph.Step {
 	step := digraph.Step{Name: name}
 	for _, opt := range opts {
@@ -931,12 +1771,66 @@ func withOnSuccess(step digraph.Step) schedulerOption {
 	}
 }
 
+func withOnTimeout(step digraph.Step) schedulerOption {
+	return func(cfg *scheduler.Config) {
+		cfg.OnTimeout = &step
+	}
+}
+
 func withOnFailure(step digraph.Step) schedulerOption {
 	return func(cfg *scheduler.Config) {
 		cfg.OnFailure = &step
 	}
 }
 
+func withArtifactDir(dir string) schedulerOption {
+	return func(cfg *scheduler.Config) {
+		cfg.ArtifactDir = dir
+	}
+}
+
+func withLockDir(dir string) schedulerOption {
+	return func(cfg *scheduler.Config) {
+		cfg.LockDir = dir
+	}
+}
+
+func withCacheDir(dir string) schedulerOption {
+	return func(cfg *scheduler.Config) {
+		cfg.CacheDir = dir
+	}
+}
+
+func withMaxFailedSteps(n int) schedulerOption {
+	return func(cfg *scheduler.Config) {
+		cfg.MaxFailedSteps = n
+	}
+}
+
+func withStages(stages ...digraph.Stage) schedulerOption {
+	return func(cfg *scheduler.Config) {
+		cfg.Stages = stages
+	}
+}
+
+func withMaxFailedStepsPercent(percent int) schedulerOption {
+	return func(cfg *scheduler.Config) {
+		cfg.MaxFailedStepsPercent = percent
+	}
+}
+
+func withHooks(hooks digraph.Hooks) schedulerOption {
+	return func(cfg *scheduler.Config) {
+		cfg.Hooks = &hooks
+	}
+}
+
+func withParams(params ...string) schedulerOption {
+	return func(cfg *scheduler.Config) {
+		cfg.Params = params
+	}
+}
+
 func setup(t *testing.T, opts ...schedulerOption) testHelper {
 	t.Helper()
 
@@ -1071,6 +1965,9 @@ func (sr scheduleResult) AssertNodeStatus(t *testing.T, stepName string, expecte
 	if sr.Config.OnCancel != nil && sr.Config.OnCancel.Name == stepName {
 		target = sr.Scheduler.HandlerNode(digraph.HandlerOnCancel)
 	}
+	if sr.Config.OnTimeout != nil && sr.Config.OnTimeout.Name == stepName {
+		target = sr.Scheduler.HandlerNode(digraph.HandlerOnTimeout)
+	}
 
 	if target == nil {
 		t.Fatalf("step %s not found", stepName)
@@ -0,0 +1,181 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"syscall"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/logger"
+	"golang.org/x/sys/unix"
+)
+
+var (
+	// errServiceNotReady is set to the service step when the readiness
+	// probe did not pass in time.
+	errServiceNotReady = errors.New("service not ready")
+	// errServiceExited is set to the service step when its command exited
+	// before it was stopped and the restarts are exhausted.
+	errServiceExited = errors.New("service exited")
+)
+
+// runService runs the command of the service step until the scheduler
+// stops it. The command is restarted up to the restart limit if it exits
+// before, and the readiness probe runs again after each restart.
+func (sc *Scheduler) runService(ctx context.Context, node *Node) error {
+	svc := node.data.Step.Service
+	for {
+		probeCtx, cancelProbe := context.WithCancel(ctx)
+		go probeReadiness(probeCtx, node)
+		execErr := sc.execNode(ctx, node)
+		cancelProbe()
+
+		if stopping, err := node.serviceStopped(); stopping {
+			// The command exits with the signal sent to stop it.
+			node.setError(err)
+			return err
+		}
+		if sc.isCanceled() {
+			return execErr
+		}
+		if execErr == nil {
+			execErr = fmt.Errorf("%w: %s", errServiceExited, node.data.Step.Name)
+		}
+		if node.GetRetryCount() >= svc.RestartLimit {
+			return execErr
+		}
+
+		node.IncRetryCount()
+		// The dependent steps wait until the restarted service is ready.
+		node.resetServiceReady()
+		logger.Warn(ctx, "Service exited. Restarting...", "step", node.data.Step.Name, "error", execErr, "restart", node.GetRetryCount())
+		timer := time.NewTimer(svc.RestartInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return execErr
+		case <-sc.cancelCh:
+			timer.Stop()
+			return execErr
+		case <-timer.C:
+		}
+		node.SetRetriedAt(time.Now())
+	}
+}
+
+// probeReadiness evaluates the readiness conditions of the service until
+// they are met. The service is stopped if they are not met in time.
+func probeReadiness(ctx context.Context, node *Node) {
+	svc := node.data.Step.Service
+	deadline := time.Now().Add(svc.ReadinessTimeout)
+	for {
+		if err := digraph.EvalConditions(ctx, svc.Readiness); err == nil {
+			logger.Info(ctx, "Service is ready", "step", node.data.Step.Name)
+			node.setServiceReady()
+			return
+		}
+		if time.Now().After(deadline) {
+			logger.Error(ctx, "Service is not ready in time", "step", node.data.Step.Name, "timeout", svc.ReadinessTimeout)
+			node.stopService(ctx, fmt.Errorf("%w after %s", errServiceNotReady, svc.ReadinessTimeout))
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(svc.ReadinessInterval):
+		}
+	}
+}
+
+// stopServices stops the running service steps that no step needs anymore.
+func (sc *Scheduler) stopServices(ctx context.Context, graph *ExecutionGraph) {
+	for _, node := range graph.Nodes() {
+		if node.data.Step.Service == nil || node.State().Status != NodeStatusRunning {
+			continue
+		}
+		if stopping, _ := node.serviceStopped(); stopping || graph.isServiceNeeded(node) {
+			continue
+		}
+		logger.Info(ctx, "Stopping service", "step", node.data.Step.Name)
+		node.stopService(ctx, nil)
+	}
+}
+
+// isServiceNeeded returns true if any step depending on the service has
+// not finished. The steps depending on its failure don't count. If no step
+// depends on the service, it's needed until the other steps finish.
+func (g *ExecutionGraph) isServiceNeeded(node *Node) bool {
+	downstreams := g.downstreams(node.id)
+	if len(downstreams) == 0 {
+		for _, n := range g.Nodes() {
+			if n.data.Step.Service != nil {
+				continue
+			}
+			if status := n.State().Status; status == NodeStatusNone || status == NodeStatusRunning {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, id := range downstreams {
+		down := g.node(id)
+		if down.data.Step.DependencyConditionOf(node.data.Step.Name) == digraph.DependencyOnFailure {
+			continue
+		}
+		if status := down.State().Status; status == NodeStatusNone || status == NodeStatusRunning {
+			return true
+		}
+	}
+	return false
+}
+
+// isServiceRunning returns true if the node is a service that is running
+// and ready.
+func (n *Node) isServiceRunning() bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.data.Step.Service != nil && n.serviceReady && !n.serviceStopping &&
+		n.data.State.Status == NodeStatusRunning
+}
+
+func (n *Node) setServiceReady() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.serviceReady = true
+}
+
+func (n *Node) resetServiceReady() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.serviceReady = false
+}
+
+// serviceStopped returns true if the service was stopped by the scheduler,
+// and the error if it was stopped because of a failure.
+func (n *Node) serviceStopped() (bool, error) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.serviceStopping, n.serviceErr
+}
+
+// stopService stops the command of the service with the signal on stop of
+// the step, or SIGTERM.
+func (n *Node) stopService(ctx context.Context, err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.serviceStopping = true
+	n.serviceErr = err
+	if n.cmd == nil {
+		return
+	}
+	var sig = syscall.SIGTERM
+	if n.data.Step.SignalOnStop != "" {
+		sig = unix.SignalNum(n.data.Step.SignalOnStop)
+	}
+	if err := n.cmd.Kill(sig); err != nil {
+		logger.Error(ctx, "Failed to stop service", "step", n.data.Step.Name, "err", err)
+	}
+}
@@ -0,0 +1,190 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/logger"
+)
+
+// StageState is the state of a stage collapsed from the states of the
+// steps in the stage.
+type StageState struct {
+	Name       string
+	Status     NodeStatus
+	StartedAt  time.Time
+	FinishedAt time.Time
+	// Handlers contains the handler nodes of the stage.
+	Handlers map[digraph.HandlerType]*Node
+}
+
+// stage keeps track of the execution of the handlers of a stage.
+type stage struct {
+	name     string
+	handled  bool
+	handlers map[digraph.HandlerType]*Node
+}
+
+func newStage(s digraph.Stage) *stage {
+	handlers := map[digraph.HandlerType]*Node{}
+	for typ, step := range map[digraph.HandlerType]*digraph.Step{
+		digraph.HandlerOnExit:    s.HandlerOn.Exit,
+		digraph.HandlerOnSuccess: s.HandlerOn.Success,
+		digraph.HandlerOnFailure: s.HandlerOn.Failure,
+		digraph.HandlerOnCancel:  s.HandlerOn.Cancel,
+	} {
+		if step != nil {
+			handlers[typ] = &Node{data: NodeData{Step: *step}}
+		}
+	}
+	return &stage{name: s.Name, handlers: handlers}
+}
+
+// stageStateOf collapses the states of the nodes in the stage. The status
+// is none until a node starts and running until all nodes finish. After
+// that, it's error if any node failed, canceled if any node was canceled,
+// skipped if all nodes were skipped, and success otherwise.
+func stageStateOf(g *ExecutionGraph, name string) StageState {
+	state := StageState{Name: name}
+
+	var total, started, finished, failed, canceled, skipped int
+	for _, node := range g.Nodes() {
+		if node.data.Step.Stage != name {
+			continue
+		}
+		total++
+
+		s := node.State()
+		if s.Status != NodeStatusNone {
+			started++
+			if !s.StartedAt.IsZero() && (state.StartedAt.IsZero() || s.StartedAt.Before(state.StartedAt)) {
+				state.StartedAt = s.StartedAt
+			}
+		}
+		switch s.Status {
+		case NodeStatusNone, NodeStatusRunning:
+			continue
+
+		case NodeStatusError:
+			failed++
+
+		case NodeStatusCancel:
+			canceled++
+
+		case NodeStatusSkipped:
+			skipped++
+
+		case NodeStatusSuccess, NodeStatusCached:
+			// no-op
+
+		}
+		finished++
+		if s.FinishedAt.After(state.FinishedAt) {
+			state.FinishedAt = s.FinishedAt
+		}
+	}
+
+	switch {
+	case started == 0:
+		state.Status = NodeStatusNone
+		state.FinishedAt = time.Time{}
+
+	case finished < total:
+		state.Status = NodeStatusRunning
+		state.FinishedAt = time.Time{}
+
+	case failed > 0:
+		state.Status = NodeStatusError
+
+	case canceled > 0:
+		state.Status = NodeStatusCancel
+
+	case skipped == total:
+		state.Status = NodeStatusSkipped
+
+	default:
+		state.Status = NodeStatusSuccess
+
+	}
+	return state
+}
+
+// Stages returns the states of the stages in the order of the definition.
+func (sc *Scheduler) Stages(g *ExecutionGraph) []StageState {
+	var ret []StageState
+	for _, s := range sc.stages {
+		state := stageStateOf(g, s.name)
+		state.Handlers = s.handlers
+		ret = append(ret, state)
+	}
+	return ret
+}
+
+// runStageHandlers runs the handlers of the stages whose steps have all
+// finished. If final is true, the stages that started but did not finish
+// (e.g. the DAG was canceled) are handled as canceled.
+func (sc *Scheduler) runStageHandlers(
+	ctx context.Context, graph *ExecutionGraph, wg *sync.WaitGroup, done chan *Node, final bool,
+) {
+	for _, s := range sc.stages {
+		if s.handled {
+			continue
+		}
+
+		status := stageStateOf(graph, s.name).Status
+		switch status {
+		case NodeStatusNone:
+			continue
+
+		case NodeStatusRunning:
+			if !final {
+				continue
+			}
+			status = NodeStatusCancel
+
+		case NodeStatusSuccess, NodeStatusError, NodeStatusCancel, NodeStatusSkipped, NodeStatusCached:
+			// finished
+
+		}
+		s.handled = true
+
+		var handlers []digraph.HandlerType
+		switch status {
+		case NodeStatusSuccess:
+			handlers = append(handlers, digraph.HandlerOnSuccess)
+
+		case NodeStatusError:
+			handlers = append(handlers, digraph.HandlerOnFailure)
+
+		case NodeStatusCancel:
+			handlers = append(handlers, digraph.HandlerOnCancel)
+
+		default:
+			// no-op
+
+		}
+		handlers = append(handlers, digraph.HandlerOnExit)
+
+		logger.Info(ctx, "Stage finished", "stage", s.name, "status", status.String())
+
+		wg.Add(1)
+		go func(s *stage) {
+			defer wg.Done()
+			for _, handler := range handlers {
+				handlerNode := s.handlers[handler]
+				if handlerNode == nil {
+					continue
+				}
+				logger.Info(ctx, "Stage handler execution started", "stage", s.name, "handler", handlerNode.data.Step.Name)
+				if err := sc.runHandlerNode(ctx, graph, handlerNode); err != nil {
+					sc.setLastError(err)
+				}
+				if done != nil {
+					done <- handlerNode
+				}
+			}
+		}(s)
+	}
+}
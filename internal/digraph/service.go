@@ -0,0 +1,82 @@
+package digraph
+
+import (
+	"fmt"
+	"time"
+)
+
+// Default values of the readiness probes of the service steps.
+const (
+	defaultReadinessInterval = time.Second
+	defaultReadinessTimeout  = time.Minute
+)
+
+// buildService parses the service field in the step definition.
+// Case 1: service is a boolean
+// Case 2: service is a map with readiness (same as precondition),
+// readinessIntervalSec, readinessTimeoutSec, restartLimit and
+// restartIntervalSec
+func buildService(ctx BuildContext, def stepDef, step *Step) error {
+	if def.Service == nil {
+		return nil
+	}
+	if def.RetryPolicy != nil || def.RepeatPolicy != nil || def.Parallel != nil {
+		return wrapError("service", def.Service, errServiceWithPolicy)
+	}
+
+	svc := &StepService{
+		ReadinessInterval: defaultReadinessInterval,
+		ReadinessTimeout:  defaultReadinessTimeout,
+	}
+	switch v := def.Service.(type) {
+	case bool:
+		if !v {
+			return nil
+		}
+
+	case map[any]any:
+		for key, value := range v {
+			if key == "readiness" {
+				conditions, err := parsePrecondition(ctx, value)
+				if err != nil {
+					return wrapError("service.readiness", value, err)
+				}
+				svc.Readiness = conditions
+				continue
+			}
+
+			n, ok := value.(int)
+			if !ok || n < 0 {
+				return wrapError(fmt.Sprintf("service.%v", key), value, errInvalidService)
+			}
+			switch key {
+			case "readinessIntervalSec":
+				if n > 0 {
+					svc.ReadinessInterval = time.Duration(n) * time.Second
+				}
+
+			case "readinessTimeoutSec":
+				if n > 0 {
+					svc.ReadinessTimeout = time.Duration(n) * time.Second
+				}
+
+			case "restartLimit":
+				svc.RestartLimit = n
+
+			case "restartIntervalSec":
+				svc.RestartInterval = time.Duration(n) * time.Second
+
+			default:
+				return wrapError("service", key, fmt.Errorf("%w: unknown key %v", errInvalidService, key))
+
+			}
+		}
+
+	default:
+		return wrapError("service", v, errInvalidService)
+
+	}
+
+	step.Service = svc
+	return nil
+}
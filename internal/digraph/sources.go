@@ -0,0 +1,123 @@
+package digraph
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dagu-org/dagu/internal/fileutil"
+)
+
+// ValueSource is an effective value of a DAG and the file it comes from.
+type ValueSource struct {
+	// Key is the path of the value, e.g. "smtp" or "handlerOn.failure".
+	Key   string
+	Value any
+	// File is the base config or the DAG file setting the value.
+	File string
+}
+
+// wholeKeys are the keys whose values replace the ones of the base configs
+// as a whole in the merge instead of being merged key by key.
+var wholeKeys = map[string]bool{
+	"smtp":   true,
+	"mailOn": true,
+}
+
+// Sources returns the effective values of the DAG file and the files they
+// come from, sorted by key. The base configs given by WithBaseConfig are
+// applied in order before the DAG like in Load, so the last file setting a
+// value wins. It also returns the files applied, skipping the base configs
+// that don't exist.
+func Sources(dag string, opts ...LoadOption) ([]ValueSource, []string, error) {
+	var options LoadOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	filePath, err := resolveYamlFilePath(dag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var files []string
+	for _, base := range options.baseConfigs {
+		if fileutil.FileExists(base) {
+			files = append(files, base)
+		}
+	}
+	files = append(files, filePath)
+
+	values := make(map[string]ValueSource)
+	for _, file := range files {
+		raw, err := readFile(file)
+		if err != nil {
+			return nil, nil, err
+		}
+		flattenValues(values, "", raw, file)
+	}
+
+	ret := make([]ValueSource, 0, len(values))
+	for _, v := range values {
+		ret = append(ret, v)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Key < ret[j].Key
+	})
+	return ret, files, nil
+}
+
+// flattenValues sets the values in the map of the file, descending into the
+// nested maps so that a value set by a base config and not overridden keeps
+// its source.
+func flattenValues(values map[string]ValueSource, prefix string, raw map[string]any, file string) {
+	for k, v := range raw {
+		key := prefix + k
+		nested, ok := v.(map[string]any)
+		if !ok {
+			nested, ok = toStringMap(v)
+		}
+		if key == "env" {
+			// The variables of the base configs are kept unless the DAG
+			// sets them again, so each one has its source.
+			nested, ok = envMap(v), true
+		}
+		if !ok || wholeKeys[key] {
+			// The value replaces the nested values set by the previous files.
+			for existing := range values {
+				if strings.HasPrefix(existing, key+".") {
+					delete(values, existing)
+				}
+			}
+			values[key] = ValueSource{Key: key, Value: v, File: file}
+			continue
+		}
+		delete(values, key)
+		flattenValues(values, key+".", nested, file)
+	}
+}
+
+// envMap converts the env, either a map or a list of maps and "KEY=VALUE"
+// strings, to a map of the variables.
+func envMap(v any) map[string]any {
+	ret := make(map[string]any)
+	if m, ok := toStringMap(v); ok {
+		return m
+	}
+	list, ok := v.([]any)
+	if !ok {
+		return ret
+	}
+	for _, item := range list {
+		if m, ok := toStringMap(item); ok {
+			for k, v := range m {
+				ret[k] = v
+			}
+			continue
+		}
+		if s, ok := item.(string); ok {
+			if k, v, found := strings.Cut(s, "="); found {
+				ret[k] = v
+			}
+		}
+	}
+	return ret
+}
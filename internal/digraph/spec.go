@@ -27,6 +27,10 @@ type definition struct {
 	Functions []*funcDef // deprecated
 	// Steps is the list of steps to run.
 	Steps any // []stepDef or map[string]stepDef
+	// Stages is the list of stages grouping the steps.
+	Stages []stageDef
+	// StepGroups is the configuration of the groups of steps.
+	StepGroups map[string]stepGroupDef
 	// SMTP is the SMTP configuration.
 	SMTP smtpConfigDef
 	// MailOn is the mail configuration.
@@ -35,12 +39,26 @@ type definition struct {
 	ErrorMail mailConfigDef
 	// InfoMail is the mail configuration for information.
 	InfoMail mailConfigDef
+	// Notify is the list of the notifiers to send the result of the run.
+	// Each item has the type, on and config fields.
+	Notify []map[any]any
+	// Callbacks is the list of the webhooks called when the run completes.
+	Callbacks []callbackDef
+	// Hooks is the scripts to run at the hook points of the run.
+	Hooks *hooksDef
+	// Container is the container shared by the docker steps of the run.
+	Container *containerDef
+	// Services is the list of the auxiliary containers run during the run.
+	Services []serviceDef
 	// TimeoutSec is the timeout in seconds to finish the DAG.
 	TimeoutSec int
 	// DelaySec is the delay in seconds to start the first node.
 	DelaySec int
 	// RestartWaitSec is the wait in seconds to when the DAG is restarted.
 	RestartWaitSec int
+	// SubstitutionTimeoutSec is the timeout in seconds of each command in
+	// backticks.
+	SubstitutionTimeoutSec int
 	// HistRetentionDays is the retention days of the history.
 	HistRetentionDays *int
 	// Precondition is the condition to run the DAG.
@@ -49,6 +67,12 @@ type definition struct {
 	Preconditions any
 	// MaxActiveRuns is the maximum number of concurrent steps.
 	MaxActiveRuns int
+	// MaxFailedSteps is the number (e.g. 3) or the percentage (e.g. "10%")
+	// of steps allowed to fail without failing the DAG.
+	MaxFailedSteps any
+	// MaxRunDuration is the hard deadline of the run enforced by the agent.
+	// It can be a duration (e.g. "2h") or a number of seconds.
+	MaxRunDuration any
 	// Params is the default parameters for the steps.
 	Params any
 	// MaxCleanUpTimeSec is the maximum time in seconds to clean up the DAG.
@@ -65,6 +89,7 @@ type handlerOnDef struct {
 	Success *stepDef // Step to execute on success
 	Cancel  *stepDef // Step to execute on cancel
 	Exit    *stepDef // Step to execute on exit
+	Timeout *stepDef // Step to execute on timeout
 }
 
 // stepDef defines a step in the DAG.
@@ -79,6 +104,9 @@ type stepDef struct {
 	Executor any
 	// Command is the command to run (on shell).
 	Command any
+	// CommandList is the command and its arguments to run directly without
+	// a shell. The items are not split or unquoted.
+	CommandList []any
 	// Shell is the shell to run the command. Default is `$SHELL` or `sh`.
 	Shell string
 	// Script is the script to run.
@@ -87,6 +115,9 @@ type stepDef struct {
 	Stdout string
 	// Stderr is the file to write the stderr.
 	Stderr string
+	// Stdin is the standard input of the command. It can be a string
+	// (e.g. "${UPSTREAM_OUTPUT}") or a map with the file to read it from.
+	Stdin any
 	// Output is the variable name to store the output.
 	Output string
 	// Depends is the list of steps to depend on.
@@ -99,10 +130,17 @@ type stepDef struct {
 	RepeatPolicy *repeatPolicyDef
 	// MailOnError is the flag to send mail on error.
 	MailOnError bool
+	// TimeoutSec is the timeout in seconds to finish the step.
+	TimeoutSec int
+	// CancelOnTimeout is the flag to mark the step as canceled instead of
+	// failed when it times out.
+	CancelOnTimeout bool
 	// Precondition is the condition to run the step.
 	Precondition any
 	// Preconditions is the condition to run the step.
 	Preconditions any
+	// Postconditions is the condition to be met after the step finishes.
+	Postconditions any
 	// SignalOnStop is the signal when the step is requested to stop.
 	// When it is empty, the same signal as the parent process is sent.
 	// It can be KILL when the process does not stop over the timeout.
@@ -111,8 +149,48 @@ type stepDef struct {
 	Call *callFuncDef // deprecated
 	// Run is a sub workflow to run
 	Run string
-	// Params is the parameters for the sub workflow
-	Params string
+	// Params is the parameters for the sub workflow.
+	// It can be a string (e.g. "KEY=value") or a map of parameter names
+	// to values (e.g. {DATE: ${DATE}}) that are evaluated at call time.
+	Params any
+	// Artifacts is the files produced and consumed by the step.
+	Artifacts *artifactsDef
+	// Stage is the name of the stage the step belongs to.
+	Stage string
+	// Group is the name of the step group defined in stepGroups.
+	Group string
+	// Lock is the key of the lock shared with the steps of any DAG that
+	// must not run at the same time as the step.
+	Lock string
+	// Foreach is the list of items to run the step for. It can be a list,
+	// a range (e.g. "1..10"), a variable holding a JSON list or
+	// space-separated values, or a map with items and maxParallel.
+	Foreach any
+	// Expand is the template of the steps generated at runtime from the
+	// JSON list written to the output variable of the step.
+	Expand *stepDef
+	// Parallel is the JSON list of items the step runs for in parallel,
+	// usually the output variable of an upstream step (e.g. ${ITEMS}), or
+	// a map with items and maxParallel.
+	Parallel any
+	// Service runs the command of the step for the whole run, e.g. a
+	// server. It can be true or a map with readiness, readinessIntervalSec,
+	// readinessTimeoutSec, restartLimit and restartIntervalSec.
+	Service any
+	// Cache is the configuration for caching the result of the step.
+	Cache *cacheDef
+	// HTTP is the HTTP request the step sends instead of a command.
+	HTTP *httpDef
+}
+
+// httpDef defines the HTTP request of a step.
+type httpDef struct {
+	Method  string            // HTTP method (POST by default)
+	URL     string            // URL of the request
+	Headers map[string]string // HTTP headers
+	Query   map[string]string // Query parameters
+	Body    string            // Template of the request body
+	Timeout int               // Timeout in seconds
 }
 
 // funcDef defines a function in the DAG.
@@ -128,6 +206,21 @@ type callFuncDef struct {
 	Args     map[string]any // Arguments for the function call
 }
 
+// stageDef defines a stage of the DAG.
+type stageDef struct {
+	// Name is the name of the stage.
+	Name string
+	// HandlerOn is the steps to be executed when the stage finishes.
+	HandlerOn handlerOnDef
+}
+
+// stepGroupDef defines the configuration of a group of steps.
+type stepGroupDef struct {
+	// MaxParallel is the maximum number of steps in the group running
+	// at the same time.
+	MaxParallel int
+}
+
 // continueOnDef defines the conditions to continue on failure or skipped.
 type continueOnDef struct {
 	Failure     bool // Continue on failure
@@ -137,6 +230,17 @@ type continueOnDef struct {
 	MarkSuccess bool // Mark the step as success when the condition is met
 }
 
+// cacheDef defines the inputs of a step that is cached.
+type cacheDef struct {
+	Inputs any // string or []string
+}
+
+// artifactsDef defines the files a step produces and consumes.
+type artifactsDef struct {
+	Produces any // string or []string
+	Consumes any // string or []string
+}
+
 // repeatPolicyDef defines the repeat policy for a step.
 type repeatPolicyDef struct {
 	Repeat      bool // Flag to indicate if the step should be repeated
@@ -151,10 +255,23 @@ type retryPolicyDef struct {
 
 // smtpConfigDef defines the SMTP configuration.
 type smtpConfigDef struct {
-	Host     string // SMTP host
-	Port     string // SMTP port
-	Username string // SMTP username
-	Password string // SMTP password
+	Host       string         // SMTP host
+	Port       string         // SMTP port
+	Username   string         // SMTP username
+	Password   string         // SMTP password
+	TLS        string         // TLS mode: auto, starttls, tls or none
+	Auth       string         // Authentication mechanism: plain, login or xoauth2
+	OAuth2     *smtpOAuth2Def // Token of the XOAUTH2 authentication
+	TimeoutSec int            // Timeout to connect and send an email
+}
+
+// smtpOAuth2Def defines the token of the XOAUTH2 authentication.
+type smtpOAuth2Def struct {
+	AccessToken  string
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
 }
 
 // mailConfigDef defines the mail configuration.
@@ -165,6 +282,44 @@ type mailConfigDef struct {
 	AttachLogs bool   // Flag to attach logs to the email
 }
 
+// containerDef defines the container shared by the docker steps.
+type containerDef struct {
+	Image      string   // Image of the container
+	Pull       *bool    // Flag to pull the image before starting
+	Env        []string // Environment variables (KEY=value)
+	Volumes    []string // Volumes to mount (host:container[:mode])
+	WorkingDir string   // Working directory of the commands
+	User       string   // User to run the commands
+}
+
+// serviceDef defines an auxiliary container run during the run.
+type serviceDef struct {
+	Name    string   // Name of the service
+	Image   string   // Image of the container
+	Pull    *bool    // Flag to pull the image before starting
+	Env     []string // Environment variables (KEY=value)
+	Command []string // Command of the container
+	Ports   []any    // Ports of the container to publish (e.g. 5432)
+}
+
+// callbackDef defines a webhook called when the run completes.
+type callbackDef struct {
+	URL              string            // URL of the webhook
+	Method           string            // HTTP method (POST by default)
+	Headers          map[string]string // HTTP headers
+	Payload          string            // Template of the request body
+	Events           []string          // Statuses of the run to call on
+	Retries          *int              // Number of retries on failure
+	RetryIntervalSec int               // Interval in seconds before the first retry
+}
+
+// hooksDef defines the scripts run at the hook points of the run.
+type hooksDef struct {
+	BeforeRun  string // Script to run before the first step
+	BeforeStep string // Script to run before each step
+	AfterStep  string // Script to run after each step
+}
+
 // mailOnDef defines the conditions to send mail.
 type mailOnDef struct {
 	Failure bool // Send mail on failure
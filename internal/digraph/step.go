@@ -2,6 +2,7 @@ package digraph
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 )
@@ -27,6 +28,9 @@ type Step struct {
 	CmdWithArgs string `json:"CmdWithArgs,omitempty"`
 	// CmdArgsSys is the command with arguments for the system.
 	CmdArgsSys string `json:"CmdArgsSys,omitempty"`
+	// CommandList is the command and the arguments run directly without a
+	// shell. Each item is evaluated but not split.
+	CommandList []string `json:"CommandList,omitempty"`
 	// Command specifies only the command without arguments.
 	Command string `json:"Command,omitempty"`
 	// ShellCmdArgs is the shell command with arguments.
@@ -39,10 +43,23 @@ type Step struct {
 	Stdout string `json:"Stdout,omitempty"`
 	// Stderr is the file to store the standard error.
 	Stderr string `json:"Stderr,omitempty"`
+	// Stdin is the text passed to the standard input of the command.
+	Stdin string `json:"Stdin,omitempty"`
+	// StdinFile is the file passed to the standard input of the command.
+	// Only one of Stdin and StdinFile is set.
+	StdinFile string `json:"StdinFile,omitempty"`
 	// Output is the variable name to store the output.
 	Output string `json:"Output,omitempty"`
 	// Depends contains the list of step names to depend on.
 	Depends []string `json:"Depends,omitempty"`
+	// DependsOn contains the conditions of the dependencies that are not
+	// satisfied by the success of the upstream step. The key is the name
+	// of the upstream step.
+	DependsOn map[string]DependencyCondition `json:"DependsOn,omitempty"`
+	// DependsAnyOf contains the groups of upstream steps where the success
+	// of any one step in the group satisfies the dependency. The steps are
+	// also included in Depends.
+	DependsAnyOf [][]string `json:"DependsAnyOf,omitempty"`
 	// ContinueOn contains the conditions to continue on failure or skipped.
 	ContinueOn ContinueOn `json:"ContinueOn,omitempty"`
 	// RetryPolicy contains the retry policy for the step.
@@ -51,20 +68,98 @@ type Step struct {
 	RepeatPolicy RepeatPolicy `json:"RepeatPolicy,omitempty"`
 	// MailOnError is the flag to send mail on error.
 	MailOnError bool `json:"MailOnError,omitempty"`
+	// Timeout is the time limit of the step. No limit is applied if it's 0.
+	Timeout time.Duration `json:"Timeout,omitempty"`
+	// CancelOnTimeout is the flag to mark the step as canceled instead of
+	// failed when it times out.
+	CancelOnTimeout bool `json:"CancelOnTimeout,omitempty"`
 	// Preconditions contains the conditions to be met before running the step.
 	Preconditions []Condition `json:"Preconditions,omitempty"`
+	// Postconditions contains the conditions to be met after the step
+	// finishes. The step fails if any of them is not met.
+	Postconditions []Condition `json:"Postconditions,omitempty"`
 	// SignalOnStop is the signal to send on stop.
 	SignalOnStop string `json:"SignalOnStop,omitempty"`
 	// SubWorkflow contains the information about a sub DAG to be executed.
 	SubWorkflow *SubWorkflow `json:"SubWorkflow,omitempty"`
+	// Artifacts contains the files produced and consumed by the step.
+	Artifacts Artifacts `json:"Artifacts,omitempty"`
+	// Cache contains the configuration for caching the result of the step.
+	// The result is not cached if it's nil.
+	Cache *Cache `json:"Cache,omitempty"`
+	// Expand is the template of the steps generated at runtime from the JSON
+	// list written to the output variable of the step. A step is generated
+	// for each item in the list and runs after this step.
+	Expand *Step `json:"Expand,omitempty"`
+	// ExpandItem contains the item of the list for a step generated from the
+	// Expand template of another step or from the foreach list of the step.
+	ExpandItem *ExpandItem `json:"ExpandItem,omitempty"`
+	// Service is set if the command of the step is a long-running process,
+	// e.g. a server or a consumer. The steps depending on it start when it's
+	// ready, and it's stopped when they finish.
+	Service *StepService `json:"Service,omitempty"`
+	// Parallel contains the items the step runs for in parallel. The items
+	// are evaluated when the upstream steps finish, and a step is generated
+	// for each item. The status of the step is the aggregate of them.
+	Parallel *Parallel `json:"Parallel,omitempty"`
+	// Stage is the name of the stage the step belongs to.
+	Stage string `json:"Stage,omitempty"`
+	// ParallelGroup is the name of the group of steps that share the
+	// MaxParallel limit.
+	ParallelGroup string `json:"ParallelGroup,omitempty"`
+	// MaxParallel is the maximum number of steps in the ParallelGroup that
+	// run at the same time. There is no limit if it's zero.
+	MaxParallel int `json:"MaxParallel,omitempty"`
+	// Lock is the key of the lock the step holds while it runs. The steps
+	// with the same key never run at the same time, even in different DAGs.
+	Lock string `json:"Lock,omitempty"`
+
+	// foreach contains the items parsed from the foreach field. The step is
+	// replaced by a step for each item when the DAG is built.
+	foreach *foreach
+}
+
+// foreach contains the items to run a step for.
+type foreach struct {
+	items       []string
+	maxParallel int
+}
+
+// Parallel contains the items a step runs for in parallel.
+type Parallel struct {
+	// Items is evaluated to the JSON list of the items at runtime.
+	Items string `json:"Items"`
+	// MaxParallel is the maximum number of the items that run at the same
+	// time. There is no limit if it's zero.
+	MaxParallel int `json:"MaxParallel,omitempty"`
+}
+
+// ExpandItem is the item of the list a generated step is created for.
+// The value is available to the step as ${ITEM} and the index as ${ITEM_INDEX}.
+type ExpandItem struct {
+	// Source is the name of the step that generated the step.
+	Source string `json:"Source"`
+	// Index is the index of the item in the list.
+	Index int `json:"Index"`
+	// Value is the item. Items that are not strings are encoded in JSON.
+	Value string `json:"Value"`
 }
 
+// Variables available to the steps generated by the Expand template.
+const (
+	ExpandItemVar      = "ITEM"
+	ExpandItemIndexVar = "ITEM_INDEX"
+)
+
 // setup sets the default values for the step.
 func (s *Step) setup(workDir string) {
 	// If the working directory is not set, use the directory of the DAG file.
 	if s.Dir == "" {
 		s.Dir = workDir
 	}
+	if s.Expand != nil {
+		s.Expand.setup(s.Dir)
+	}
 }
 
 // String returns a formatted string representation of the step
@@ -88,16 +183,67 @@ func (s *Step) String() string {
 	return strings.Join(parts, "\t")
 }
 
+// DependencyConditionOf returns the condition of the dependency on the
+// upstream step.
+func (s *Step) DependencyConditionOf(upstream string) DependencyCondition {
+	if c, ok := s.DependsOn[upstream]; ok {
+		return c
+	}
+	return DependencyOnSuccess
+}
+
+// IsAnyOfDependency returns true if the upstream step is in one of the
+// anyOf groups of the step.
+func (s *Step) IsAnyOfDependency(upstream string) bool {
+	for _, group := range s.DependsAnyOf {
+		if slices.Contains(group, upstream) {
+			return true
+		}
+	}
+	return false
+}
+
+// DependencyCondition is the status of an upstream step required to run
+// the dependent step.
+type DependencyCondition string
+
+const (
+	// DependencyOnSuccess runs the step when the upstream step succeeds.
+	DependencyOnSuccess DependencyCondition = "success"
+	// DependencyOnFailure runs the step only when the upstream step fails.
+	// It can be used for compensation or cleanup paths in the graph.
+	DependencyOnFailure DependencyCondition = "failure"
+	// DependencyOnAlways runs the step when the upstream step finishes
+	// regardless of its status.
+	DependencyOnAlways DependencyCondition = "always"
+)
+
 // SubWorkflow contains information about a sub DAG to be executed.
 type SubWorkflow struct {
 	Name   string `json:"Name,omitempty"`
 	Params string `json:"Params,omitempty"`
 }
 
+// Artifacts contains the files a step exchanges with other steps in the run.
+// Produced files are copied into the run-scoped artifact directory after the
+// step succeeds. Consumed files are copied from the artifact directory into
+// the working directory of the step before it runs.
+type Artifacts struct {
+	// Produces is the list of files (relative to the working directory) the
+	// step produces.
+	Produces []string `json:"Produces,omitempty"`
+	// Consumes is the list of artifact names the step consumes.
+	Consumes []string `json:"Consumes,omitempty"`
+}
+
 // ExecutorTypeSubWorkflow is defined here in order to parse
 // the `run` field in the DAG file.
 const ExecutorTypeSubWorkflow = "subworkflow"
 
+// ExecutorTypeHTTP is defined here in order to parse the `http` field in
+// the DAG file.
+const ExecutorTypeHTTP = "http"
+
 // ExecutorConfig contains the configuration for the executor.
 type ExecutorConfig struct {
 	// Type represents one of the registered executors.
@@ -123,6 +269,22 @@ type RetryPolicy struct {
 	IntervalSecStr string `json:"IntervalSecStr,omitempty"`
 }
 
+// StepService contains the configuration of a service step.
+type StepService struct {
+	// Readiness is the conditions the service is ready when met. The service
+	// is ready when it starts if it's empty.
+	Readiness []Condition `json:"Readiness,omitempty"`
+	// ReadinessInterval is the interval between the readiness probes.
+	ReadinessInterval time.Duration `json:"ReadinessInterval,omitempty"`
+	// ReadinessTimeout is the time to wait for the service to be ready.
+	ReadinessTimeout time.Duration `json:"ReadinessTimeout,omitempty"`
+	// RestartLimit is the number of restarts allowed when the service exits
+	// before it's stopped.
+	RestartLimit int `json:"RestartLimit,omitempty"`
+	// RestartInterval is the time to wait before restarting the service.
+	RestartInterval time.Duration `json:"RestartInterval,omitempty"`
+}
+
 // RepeatPolicy contains the repeat policy for a step.
 type RepeatPolicy struct {
 	// Repeat determines if the step should be repeated.
@@ -131,6 +293,15 @@ type RepeatPolicy struct {
 	Interval time.Duration `json:"Interval,omitempty"`
 }
 
+// Cache contains the configuration for caching the result of a step.
+// The cache key is computed from the command of the step and the contents
+// of the input files. If the key matches the one of the last successful
+// execution, the step is not executed and marked as cached.
+type Cache struct {
+	// Inputs is the list of files (or glob patterns) that affect the result.
+	Inputs []string `json:"Inputs,omitempty"`
+}
+
 // ContinueOn contains the conditions to continue on failure or skipped.
 // Failure is the flag to continue to the next step on failure.
 // Skipped is the flag to continue to the next step on skipped.
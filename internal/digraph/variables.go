@@ -46,7 +46,7 @@ func loadVariables(ctx BuildContext, strVariables any) (
 
 			value, err = cmdutil.EvalString(ctx.ctx, value)
 			if err != nil {
-				return nil, wrapError("env", pair.val, fmt.Errorf("%w: %s", errInvalidEnvValue, pair.val))
+				return nil, wrapError("env", pair.val, fmt.Errorf("%w: %s: %w", errInvalidEnvValue, pair.val, err))
 			}
 
 			if err := os.Setenv(pair.key, value); err != nil {
@@ -2,6 +2,8 @@ package fileutil
 
 import (
 	"errors"
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"slices"
@@ -67,6 +69,38 @@ func createFile(file string) (*os.File, error) {
 	return outfile, nil
 }
 
+// CopyFile copies the content of src to dst. The parent directory of dst is
+// created if it doesn't exist and dst is overwritten if it already exists.
+func CopyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", src, err)
+	}
+	defer in.Close()
+
+	info, err := in.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", src, err)
+	}
+	if info.IsDir() {
+		return fmt.Errorf("%s is a directory", src)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", dst, err)
+	}
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", dst, err)
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return fmt.Errorf("failed to copy %s to %s: %w", src, dst, err)
+	}
+	return out.Close()
+}
+
 // MustTempDir returns temporary directory.
 // This function is used only for testing.
 func MustTempDir(pattern string) string {
@@ -85,6 +85,26 @@ func Test_OpenOrCreateFile(t *testing.T) {
 	})
 }
 
+func TestCopyFile(t *testing.T) {
+	t.Run("Copy", func(t *testing.T) {
+		tmp := t.TempDir()
+		src := filepath.Join(tmp, "src.txt")
+		require.NoError(t, os.WriteFile(src, []byte("hello"), 0600))
+
+		dst := filepath.Join(tmp, "nested", "dst.txt")
+		require.NoError(t, CopyFile(src, dst))
+
+		dat, err := os.ReadFile(dst)
+		require.NoError(t, err)
+		require.Equal(t, "hello", string(dat))
+	})
+	t.Run("SourceNotFound", func(t *testing.T) {
+		tmp := t.TempDir()
+		err := CopyFile(filepath.Join(tmp, "missing"), filepath.Join(tmp, "dst"))
+		require.Error(t, err)
+	})
+}
+
 func Test_MustTempDir(t *testing.T) {
 	t.Run("Valid", func(t *testing.T) {
 		dir := MustTempDir("tempdir")
@@ -1,33 +1,106 @@
 package dag
 
 import (
+	"time"
+
+	"github.com/dagu-org/dagu/internal/client"
 	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/executor"
 	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+	"github.com/dagu-org/dagu/internal/persistence"
 	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/dagu-org/dagu/internal/stringutil"
 	"github.com/go-openapi/swag"
 )
 
-func convertToDAG(dag *digraph.DAG) *models.Dag {
-	var schedules []*models.Schedule
-	for _, s := range dag.Schedule {
-		schedules = append(schedules, &models.Schedule{
-			Expression: swag.String(s.Expression),
-		})
-	}
-
+func convertToDAG(dag *digraph.DAG, now time.Time, timezone string) *models.Dag {
 	return &models.Dag{
 		Name:          swag.String(dag.Name),
 		Group:         swag.String(dag.Group),
+		Namespace:     dag.Namespace,
 		Description:   swag.String(dag.Description),
 		Params:        dag.Params,
 		DefaultParams: swag.String(dag.DefaultParams),
 		Tags:          dag.Tags,
-		Schedule:      schedules,
+		Schedule:      convertToSchedules(dag.Schedule, now, timezone),
+	}
+}
+
+// convertToSchedules converts the schedules with their fire times around
+// now. The schedules without a time zone are evaluated in the time zone of
+// now, whose name is timezone.
+func convertToSchedules(schedules []digraph.Schedule, now time.Time, timezone string) []*models.Schedule {
+	var ret []*models.Schedule
+	for _, s := range schedules {
+		tz := s.Timezone()
+		if tz == "" {
+			tz = timezone
+		}
+		ret = append(ret, &models.Schedule{
+			Expression: swag.String(s.Expression),
+			Cron:       s.Cron(),
+			Timezone:   tz,
+			NextRun:    formatOptionalTime(s.Next(now)),
+			PrevRun:    formatOptionalTime(s.Prev(now)),
+		})
+	}
+	return ret
+}
+
+// convertToSuspension converts the suspension. It returns nil if the DAG is
+// not suspended.
+func convertToSuspension(s *persistence.Suspension) *models.Suspension {
+	if s == nil {
+		return nil
+	}
+	return &models.Suspension{
+		Reason:      s.Reason,
+		SuspendedAt: formatOptionalTime(s.SuspendedAt),
+		ExpiresAt:   formatOptionalTime(s.ExpiresAt),
 	}
 }
 
+func convertToDelayedStart(s persistence.DelayedStart) *models.DelayedStart {
+	return &models.DelayedStart{
+		RequestID:    swag.String(s.RequestID),
+		StartAt:      swag.String(s.StartAt.Format(time.RFC3339)),
+		RegisteredAt: swag.String(s.RegisteredAt.Format(time.RFC3339)),
+		Params:       s.Params,
+		Labels:       s.Labels,
+	}
+}
+
+func convertToQueuedRun(run client.QueuedRun) *models.QueuedRun {
+	dag := run.DelayedStart.DAG
+	if run.DAG != nil {
+		dag = run.DAG.ID()
+	}
+	return &models.QueuedRun{
+		DAG:        swag.String(dag),
+		RequestID:  swag.String(run.RequestID),
+		Position:   swag.Int64(int64(run.Position)),
+		Reason:     swag.String(string(run.Reason)),
+		EnqueuedAt: swag.String(run.RegisteredAt.Format(time.RFC3339)),
+		StartAt:    swag.String(run.StartAt.Format(time.RFC3339)),
+		Priority:   swag.Int64(int64(run.Priority)),
+		Params:     run.Params,
+		Labels:     run.Labels,
+		SkipSteps:  run.SkipSteps,
+	}
+}
+
+// formatOptionalTime formats the time. It returns an empty string for the
+// zero time.
+func formatOptionalTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
 func convertToStatusDetail(s model.Status) *models.DagStatusDetail {
 	status := &models.DagStatusDetail{
+		Labels:     s.Labels,
 		Log:        swag.String(s.Log),
 		Name:       swag.String(s.Name),
 		Params:     swag.String(s.Params),
@@ -35,12 +108,16 @@ func convertToStatusDetail(s model.Status) *models.DagStatusDetail {
 		RequestID:  swag.String(s.RequestID),
 		StartedAt:  swag.String(s.StartedAt),
 		FinishedAt: swag.String(s.FinishedAt),
+		Heartbeat:  s.Heartbeat,
 		Status:     swag.Int64(int64(s.Status)),
 		StatusText: swag.String(s.StatusText),
 	}
 	for _, n := range s.Nodes {
 		status.Nodes = append(status.Nodes, convertToNode(n))
 	}
+	for _, n := range s.Notes {
+		status.Notes = append(status.Notes, convertToNote(n))
+	}
 	if s.OnSuccess != nil {
 		status.OnSuccess = convertToNode(s.OnSuccess)
 	}
@@ -50,24 +127,127 @@ func convertToStatusDetail(s model.Status) *models.DagStatusDetail {
 	if s.OnCancel != nil {
 		status.OnCancel = convertToNode(s.OnCancel)
 	}
+	if s.OnTimeout != nil {
+		status.OnTimeout = convertToNode(s.OnTimeout)
+	}
 	if s.OnExit != nil {
 		status.OnExit = convertToNode(s.OnExit)
 	}
 	return status
 }
 
+func convertToNote(note model.Note) *models.RunNote {
+	return &models.RunNote{
+		CreatedAt: swag.String(note.CreatedAt),
+		Text:      swag.String(note.Text),
+	}
+}
+
+func convertToLineageRun(run model.LineageRun) *models.LineageRun {
+	ret := &models.LineageRun{
+		DAG:             swag.String(run.DAG),
+		RequestID:       swag.String(run.Status.RequestID),
+		Status:          swag.Int64(int64(run.Status.Status)),
+		StatusText:      swag.String(run.Status.StatusText),
+		StartedAt:       swag.String(run.Status.StartedAt),
+		Trigger:         swag.String(""),
+		ParentRequestID: run.Status.ParentRequestID,
+	}
+	if lineage := run.Status.Lineage; lineage != nil {
+		ret.Trigger = swag.String(string(lineage.Trigger))
+		ret.ParentDAG = lineage.ParentDAG
+		ret.RetryOf = lineage.RetryOf
+		ret.Schedule = lineage.Schedule
+	}
+	return ret
+}
+
+func convertToLintWarning(w digraph.LintWarning) *models.LintWarning {
+	return &models.LintWarning{
+		Step:    w.Step,
+		Message: swag.String(w.Message),
+	}
+}
+
 func convertToNode(node *model.Node) *models.StatusNode {
 	return &models.StatusNode{
-		DoneCount:  swag.Int64(int64(node.DoneCount)),
-		Error:      swag.String(node.Error),
-		FinishedAt: swag.String(node.FinishedAt),
-		Log:        swag.String(node.Log),
-		RetryCount: swag.Int64(int64(node.RetryCount)),
-		StartedAt:  swag.String(node.StartedAt),
-		Status:     swag.Int64(int64(node.Status)),
-		StatusText: swag.String(node.StatusText),
-		Step:       convertToStepObject(node.Step),
+		Artifacts:     node.Artifacts,
+		DoneCount:     swag.Int64(int64(node.DoneCount)),
+		Error:         swag.String(node.Error),
+		FinishedAt:    swag.String(node.FinishedAt),
+		Log:           swag.String(node.Log),
+		ResourceUsage: convertToResourceUsage(node.ResourceUsage),
+		RetryCount:    swag.Int64(int64(node.RetryCount)),
+		SkipRequested: node.SkipRequested,
+		StartedAt:     swag.String(node.StartedAt),
+		Status:        swag.Int64(int64(node.Status)),
+		StatusText:    swag.String(node.StatusText),
+		Step:          convertToStepObject(node.Step),
+		SubRequestID:  node.SubRequestID,
+	}
+}
+
+func convertToResourceUsage(usage *executor.ResourceUsage) *models.ResourceUsage {
+	if usage == nil {
+		return nil
+	}
+	return &models.ResourceUsage{
+		UserCPUTimeMs:   swag.Int64(usage.UserCPUTime.Milliseconds()),
+		SystemCPUTimeMs: swag.Int64(usage.SystemCPUTime.Milliseconds()),
+		MaxRSSBytes:     swag.Int64(usage.MaxRSS),
+		WallTimeMs:      swag.Int64(usage.WallTime.Milliseconds()),
+	}
+}
+
+func convertToRunTrend(r model.RunTrend) *models.RunTrend {
+	var startedAt string
+	if !r.StartedAt.IsZero() {
+		startedAt = stringutil.FormatTime(r.StartedAt)
+	}
+	return &models.RunTrend{
+		RequestID:  swag.String(r.RequestID),
+		Status:     swag.Int64(int64(r.Status)),
+		StatusText: swag.String(r.Status.String()),
+		StartedAt:  swag.String(startedAt),
+		DurationMs: swag.Int64(r.Duration.Milliseconds()),
+	}
+}
+
+func convertToStepTrend(s model.StepTrend) *models.StepTrend {
+	return &models.StepTrend{
+		Name:               swag.String(s.Name),
+		Runs:               swag.Int64(int64(s.Runs)),
+		LatestDurationMs:   swag.Int64(s.Latest.Milliseconds()),
+		BaselineDurationMs: swag.Int64(s.Baseline.Milliseconds()),
+		AverageDurationMs:  swag.Int64(s.Average.Milliseconds()),
+		AverageCPUTimeMs:   swag.Int64(s.AverageCPUTime.Milliseconds()),
+		MaxRSSBytes:        swag.Int64(s.MaxRSS),
+		LatestMaxRSSBytes:  swag.Int64(s.LatestMaxRSS),
+		Ratio:              swag.Float64(s.Ratio),
+		Regressed:          swag.Bool(s.Regressed),
+	}
+}
+
+func convertToStepFailures(s model.StepFailures) *models.StepFailures {
+	var lastFailedAt string
+	if !s.LastFailedAt.IsZero() {
+		lastFailedAt = stringutil.FormatTime(s.LastFailedAt)
+	}
+	ret := &models.StepFailures{
+		Name:         swag.String(s.Name),
+		Runs:         swag.Int64(int64(s.Runs)),
+		Failures:     swag.Int64(int64(s.Failures)),
+		FailureRate:  swag.Float64(s.FailureRate()),
+		LastFailedAt: swag.String(lastFailedAt),
+		Errors:       []*models.ErrorCount{},
+	}
+	for _, e := range s.Errors {
+		ret.Errors = append(ret.Errors, &models.ErrorCount{
+			Error: swag.String(e.Error),
+			Count: swag.Int64(int64(e.Count)),
+		})
 	}
+	return ret
 }
 
 func convertToStepObject(step digraph.Step) *models.StepObject {
@@ -1,7 +1,13 @@
 package dag
 
 import (
+	"errors"
+	"io/fs"
+	"os"
+
+	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+	"github.com/dagu-org/dagu/internal/persistence"
 	"github.com/go-openapi/swag"
 )
 
@@ -12,6 +18,7 @@ type codedError struct {
 
 func newInternalError(err error) *codedError {
 	return &codedError{Code: 500, APIError: &models.APIError{
+		Code:            errorCode(err, models.ErrorCodeINTERNALERROR),
 		Message:         swag.String("Internal Server Error"),
 		DetailedMessage: swag.String(err.Error()),
 	}}
@@ -19,14 +26,68 @@ func newInternalError(err error) *codedError {
 
 func newNotFoundError(err error) *codedError {
 	return &codedError{Code: 404, APIError: &models.APIError{
+		Code:            errorCode(err, models.ErrorCodeNOTFOUND),
 		Message:         swag.String("Not Found"),
 		DetailedMessage: swag.String(err.Error()),
 	}}
 }
 
+func newForbiddenError(err error) *codedError {
+	return &codedError{Code: 403, APIError: &models.APIError{
+		Code:            models.ErrorCodeFORBIDDEN,
+		Message:         swag.String("Forbidden"),
+		DetailedMessage: swag.String(err.Error()),
+	}}
+}
+
 func newBadRequestError(err error) *codedError {
 	return &codedError{Code: 400, APIError: &models.APIError{
+		Code:            errorCode(err, models.ErrorCodeBADREQUEST),
 		Message:         swag.String("Bad Request"),
 		DetailedMessage: swag.String(err.Error()),
 	}}
 }
+
+// errorCode returns the stable code of the error for the clients. The
+// fallback is returned when the error has no specific code.
+func errorCode(err error, fallback models.ErrorCode) models.ErrorCode {
+	switch {
+	case errors.Is(err, persistence.ErrDAGNotFound):
+		return models.ErrorCodeDAGNOTFOUND
+	case errors.Is(err, errDAGRunning):
+		return models.ErrorCodeDAGRUNNING
+	case errors.Is(err, digraph.ErrInvalidSpec):
+		return models.ErrorCodeSPECINVALID
+	case isStoreError(err):
+		return models.ErrorCodeSTOREUNAVAILABLE
+	default:
+		return fallback
+	}
+}
+
+// isStoreError reports whether the error is caused by the file system
+// the stores are built on, e.g. a permission error or a full disk.
+func isStoreError(err error) bool {
+	if errors.Is(err, fs.ErrNotExist) {
+		return false
+	}
+	var pathErr *fs.PathError
+	var linkErr *os.LinkError
+	var syscallErr *os.SyscallError
+	return errors.As(err, &pathErr) || errors.As(err, &linkErr) || errors.As(err, &syscallErr)
+}
+
+// remoteErrorCode returns the code of the generic error for the status code
+// responded by the remote node.
+func remoteErrorCode(statusCode int) models.ErrorCode {
+	switch statusCode {
+	case 400:
+		return models.ErrorCodeBADREQUEST
+	case 403:
+		return models.ErrorCodeFORBIDDEN
+	case 404:
+		return models.ErrorCodeNOTFOUND
+	default:
+		return models.ErrorCodeREMOTENODEUNAVAILABLE
+	}
+}
@@ -1,6 +1,7 @@
 package dag
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"encoding/json"
@@ -9,27 +10,37 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/dagu-org/dagu/internal/calendar"
 	"github.com/dagu-org/dagu/internal/client"
 	"github.com/dagu-org/dagu/internal/config"
+	"github.com/dagu-org/dagu/internal/crypt"
 	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/dagu-org/dagu/internal/digraph/scheduler"
 	"github.com/dagu-org/dagu/internal/frontend/gen/models"
 	"github.com/dagu-org/dagu/internal/frontend/gen/restapi/operations"
 	"github.com/dagu-org/dagu/internal/frontend/gen/restapi/operations/dags"
 	"github.com/dagu-org/dagu/internal/frontend/server"
-	"github.com/dagu-org/dagu/internal/persistence/jsondb"
+	"github.com/dagu-org/dagu/internal/namespace"
+	"github.com/dagu-org/dagu/internal/persistence"
 	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/dagu-org/dagu/internal/stringutil"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
 	"github.com/samber/lo"
+	"golang.org/x/sys/unix"
 	"golang.org/x/text/encoding"
 	"golang.org/x/text/encoding/japanese"
 	"golang.org/x/text/transform"
+	"gopkg.in/yaml.v2"
 )
 
 const (
@@ -42,9 +53,11 @@ const (
 
 var (
 	errInvalidArgs        = errors.New("invalid argument")
+	errDAGRunning         = errors.New("the DAG is still running")
 	ErrFailedToReadStatus = errors.New("failed to read status")
 	ErrStepNotFound       = errors.New("step was not found")
 	ErrReadingLastStatus  = errors.New("error reading the last status")
+	ErrArtifactNotFound   = errors.New("artifact was not found")
 )
 
 // Handler is a handler for the DAG API.
@@ -53,6 +66,9 @@ type Handler struct {
 	logEncodingCharset string
 	remoteNodes        map[string]config.RemoteNode
 	apiBasePath        string
+	location           *time.Location
+	timezone           string
+	paths              config.PathsConfig
 }
 
 type NewHandlerArgs struct {
@@ -60,6 +76,12 @@ type NewHandlerArgs struct {
 	LogEncodingCharset string
 	RemoteNodes        []config.RemoteNode
 	ApiBasePath        string
+	// Location is the time zone the scheduler evaluates the schedules in,
+	// and Timezone is its name.
+	Location *time.Location
+	Timezone string
+	// Paths is used to find the base configs of the DAGs.
+	Paths config.PathsConfig
 }
 
 func NewHandler(args *NewHandlerArgs) server.Handler {
@@ -67,21 +89,41 @@ func NewHandler(args *NewHandlerArgs) server.Handler {
 	for _, node := range args.RemoteNodes {
 		remoteNodes[node.Name] = node
 	}
+	location := args.Location
+	if location == nil {
+		location = time.Local
+	}
 	return &Handler{
 		client:             args.Client,
 		logEncodingCharset: args.LogEncodingCharset,
 		remoteNodes:        remoteNodes,
 		apiBasePath:        args.ApiBasePath,
+		location:           location,
+		timezone:           args.Timezone,
+		paths:              args.Paths,
 	}
 }
 
+// now returns the current time in the time zone of the scheduler.
+func (h *Handler) now() time.Time {
+	return time.Now().In(h.location)
+}
+
 func (h *Handler) Configure(api *operations.DaguAPI) {
 	api.DagsListDagsHandler = dags.ListDagsHandlerFunc(
 		func(params dags.ListDagsParams) middleware.Responder {
+			ctx := params.HTTPRequest.Context()
+			if params.HTTPRequest.URL.Query().Get("remoteNode") == remoteNodeAll {
+				resp, err := h.getAggregatedList(ctx, params)
+				if err != nil {
+					return dags.NewListDagsDefault(err.Code).
+						WithPayload(err.APIError)
+				}
+				return dags.NewListDagsOK().WithPayload(resp)
+			}
 			if resp := h.handleRemoteNodeProxy(nil, params.HTTPRequest); resp != nil {
 				return resp
 			}
-			ctx := params.HTTPRequest.Context()
 			resp, err := h.getList(ctx, params)
 			if err != nil {
 				return dags.NewListDagsDefault(err.Code).
@@ -118,6 +160,20 @@ func (h *Handler) Configure(api *operations.DaguAPI) {
 			return dags.NewPostDagActionOK().WithPayload(resp)
 		})
 
+	api.DagsStartDagHandler = dags.StartDagHandlerFunc(
+		func(params dags.StartDagParams) middleware.Responder {
+			if resp := h.handleRemoteNodeProxy(params.Body, params.HTTPRequest); resp != nil {
+				return resp
+			}
+			ctx := params.HTTPRequest.Context()
+			resp, err := h.startDAG(ctx, params)
+			if err != nil {
+				return dags.NewStartDagDefault(err.Code).
+					WithPayload(err.APIError)
+			}
+			return dags.NewStartDagOK().WithPayload(resp)
+		})
+
 	api.DagsCreateDagHandler = dags.CreateDagHandlerFunc(
 		func(params dags.CreateDagParams) middleware.Responder {
 			if resp := h.handleRemoteNodeProxy(params.Body, params.HTTPRequest); resp != nil {
@@ -173,8 +229,225 @@ func (h *Handler) Configure(api *operations.DaguAPI) {
 			}
 			return dags.NewListTagsOK().WithPayload(tags)
 		})
+
+	api.DagsPostRunNoteHandler = dags.PostRunNoteHandlerFunc(
+		func(params dags.PostRunNoteParams) middleware.Responder {
+			if resp := h.handleRemoteNodeProxy(params.Body, params.HTTPRequest); resp != nil {
+				return resp
+			}
+			ctx := params.HTTPRequest.Context()
+			resp, err := h.postRunNote(ctx, params)
+			if err != nil {
+				return dags.NewPostRunNoteDefault(err.Code).
+					WithPayload(err.APIError)
+			}
+			return dags.NewPostRunNoteOK().WithPayload(resp)
+		})
+
+	api.DagsGetRunLineageHandler = dags.GetRunLineageHandlerFunc(
+		func(params dags.GetRunLineageParams) middleware.Responder {
+			if resp := h.handleRemoteNodeProxy(nil, params.HTTPRequest); resp != nil {
+				return resp
+			}
+			ctx := params.HTTPRequest.Context()
+			resp, err := h.getRunLineage(ctx, params)
+			if err != nil {
+				return dags.NewGetRunLineageDefault(err.Code).
+					WithPayload(err.APIError)
+			}
+			return dags.NewGetRunLineageOK().WithPayload(resp)
+		})
+
+	api.DagsRetryDagStepHandler = dags.RetryDagStepHandlerFunc(
+		func(params dags.RetryDagStepParams) middleware.Responder {
+			if resp := h.handleRemoteNodeProxy(nil, params.HTTPRequest); resp != nil {
+				return resp
+			}
+			ctx := params.HTTPRequest.Context()
+			resp, err := h.retryDagStep(ctx, params)
+			if err != nil {
+				return dags.NewRetryDagStepDefault(err.Code).
+					WithPayload(err.APIError)
+			}
+			return dags.NewRetryDagStepOK().WithPayload(resp)
+		})
+
+	api.DagsSkipDagStepHandler = dags.SkipDagStepHandlerFunc(
+		func(params dags.SkipDagStepParams) middleware.Responder {
+			if resp := h.handleRemoteNodeProxy(nil, params.HTTPRequest); resp != nil {
+				return resp
+			}
+			ctx := params.HTTPRequest.Context()
+			resp, err := h.skipDagStep(ctx, params)
+			if err != nil {
+				return dags.NewSkipDagStepDefault(err.Code).
+					WithPayload(err.APIError)
+			}
+			return dags.NewSkipDagStepOK().WithPayload(resp)
+		})
+
+	api.DagsSignalDagStepHandler = dags.SignalDagStepHandlerFunc(
+		func(params dags.SignalDagStepParams) middleware.Responder {
+			if resp := h.handleRemoteNodeProxy(params.Body, params.HTTPRequest); resp != nil {
+				return resp
+			}
+			ctx := params.HTTPRequest.Context()
+			resp, err := h.signalDagStep(ctx, params)
+			if err != nil {
+				return dags.NewSignalDagStepDefault(err.Code).
+					WithPayload(err.APIError)
+			}
+			return dags.NewSignalDagStepOK().WithPayload(resp)
+		})
+
+	api.DagsGetArtifactHandler = dags.GetArtifactHandlerFunc(
+		func(params dags.GetArtifactParams) middleware.Responder {
+			if resp := h.handleRemoteNodeProxy(nil, params.HTTPRequest); resp != nil {
+				return resp
+			}
+			ctx := params.HTTPRequest.Context()
+			file, err := h.getArtifact(ctx, params)
+			if err != nil {
+				return dags.NewGetArtifactDefault(err.Code).
+					WithPayload(err.APIError)
+			}
+			return dags.NewGetArtifactOK().WithPayload(file)
+		})
+
+	api.DagsGetDagAnalyticsHandler = dags.GetDagAnalyticsHandlerFunc(
+		func(params dags.GetDagAnalyticsParams) middleware.Responder {
+			if resp := h.handleRemoteNodeProxy(nil, params.HTTPRequest); resp != nil {
+				return resp
+			}
+			ctx := params.HTTPRequest.Context()
+			resp, err := h.getAnalytics(ctx, params)
+			if err != nil {
+				return dags.NewGetDagAnalyticsDefault(err.Code).
+					WithPayload(err.APIError)
+			}
+			return dags.NewGetDagAnalyticsOK().WithPayload(resp)
+		})
+
+	api.DagsGetDagFailuresHandler = dags.GetDagFailuresHandlerFunc(
+		func(params dags.GetDagFailuresParams) middleware.Responder {
+			if resp := h.handleRemoteNodeProxy(nil, params.HTTPRequest); resp != nil {
+				return resp
+			}
+			ctx := params.HTTPRequest.Context()
+			resp, err := h.getFailures(ctx, params)
+			if err != nil {
+				return dags.NewGetDagFailuresDefault(err.Code).
+					WithPayload(err.APIError)
+			}
+			return dags.NewGetDagFailuresOK().WithPayload(resp)
+		})
+
+	api.DagsGetDagConfigSourcesHandler = dags.GetDagConfigSourcesHandlerFunc(
+		func(params dags.GetDagConfigSourcesParams) middleware.Responder {
+			if resp := h.handleRemoteNodeProxy(nil, params.HTTPRequest); resp != nil {
+				return resp
+			}
+			ctx := params.HTTPRequest.Context()
+			resp, err := h.getConfigSources(ctx, params)
+			if err != nil {
+				return dags.NewGetDagConfigSourcesDefault(err.Code).
+					WithPayload(err.APIError)
+			}
+			return dags.NewGetDagConfigSourcesOK().WithPayload(resp)
+		})
+
+	api.DagsGetDagGraphHandler = dags.GetDagGraphHandlerFunc(
+		func(params dags.GetDagGraphParams) middleware.Responder {
+			if resp := h.handleRemoteNodeProxy(nil, params.HTTPRequest); resp != nil {
+				return resp
+			}
+			ctx := params.HTTPRequest.Context()
+			graph, err := h.getGraph(ctx, params)
+			if err != nil {
+				return dags.NewGetDagGraphDefault(err.Code).
+					WithPayload(err.APIError)
+			}
+			return dags.NewGetDagGraphOK().WithPayload(graph)
+		})
+
+	api.DagsGetScheduleCalendarHandler = dags.GetScheduleCalendarHandlerFunc(
+		func(params dags.GetScheduleCalendarParams) middleware.Responder {
+			if resp := h.handleRemoteNodeProxy(nil, params.HTTPRequest); resp != nil {
+				return resp
+			}
+			ctx := params.HTTPRequest.Context()
+			cal, err := h.getCalendar(ctx, params)
+			if err != nil {
+				return dags.NewGetScheduleCalendarDefault(err.Code).
+					WithPayload(err.APIError)
+			}
+			return dags.NewGetScheduleCalendarOK().WithPayload(cal)
+		})
+
+	api.DagsGetTimelineHandler = dags.GetTimelineHandlerFunc(
+		func(params dags.GetTimelineParams) middleware.Responder {
+			if resp := h.handleRemoteNodeProxy(nil, params.HTTPRequest); resp != nil {
+				return resp
+			}
+			ctx := params.HTTPRequest.Context()
+			timeline, err := h.getTimeline(ctx, params)
+			if err != nil {
+				return dags.NewGetTimelineDefault(err.Code).
+					WithPayload(err.APIError)
+			}
+			return dags.NewGetTimelineOK().WithPayload(timeline)
+		})
+
+	api.DagsListQueuedRunsHandler = dags.ListQueuedRunsHandlerFunc(
+		func(params dags.ListQueuedRunsParams) middleware.Responder {
+			if resp := h.handleRemoteNodeProxy(nil, params.HTTPRequest); resp != nil {
+				return resp
+			}
+			ctx := params.HTTPRequest.Context()
+			resp, err := h.listQueuedRuns(ctx, params)
+			if err != nil {
+				return dags.NewListQueuedRunsDefault(err.Code).
+					WithPayload(err.APIError)
+			}
+			return dags.NewListQueuedRunsOK().WithPayload(resp)
+		})
+
+	api.DagsUpdateQueuedRunHandler = dags.UpdateQueuedRunHandlerFunc(
+		func(params dags.UpdateQueuedRunParams) middleware.Responder {
+			if resp := h.handleRemoteNodeProxy(params.Body, params.HTTPRequest); resp != nil {
+				return resp
+			}
+			ctx := params.HTTPRequest.Context()
+			resp, err := h.updateQueuedRun(ctx, params)
+			if err != nil {
+				return dags.NewUpdateQueuedRunDefault(err.Code).
+					WithPayload(err.APIError)
+			}
+			return dags.NewUpdateQueuedRunOK().WithPayload(resp)
+		})
+
+	api.DagsCancelQueuedRunHandler = dags.CancelQueuedRunHandlerFunc(
+		func(params dags.CancelQueuedRunParams) middleware.Responder {
+			if resp := h.handleRemoteNodeProxy(nil, params.HTTPRequest); resp != nil {
+				return resp
+			}
+			ctx := params.HTTPRequest.Context()
+			if err := h.cancelQueuedRun(ctx, params); err != nil {
+				return dags.NewCancelQueuedRunDefault(err.Code).
+					WithPayload(err.APIError)
+			}
+			return dags.NewCancelQueuedRunOK()
+		})
 }
 
+const (
+	// remoteNodeLocal is the remoteNode query value of this node.
+	remoteNodeLocal = "local"
+	// remoteNodeAll is the remoteNode query value to list the DAGs of this
+	// node and all the remote nodes.
+	remoteNodeAll = "all"
+)
+
 // handleRemoteNodeProxy checks if 'remoteNode' is present in the query parameters.
 // If yes, it proxies the request to the remote node and returns the remote response.
 // If not, it returns nil, indicating to proceed locally.
@@ -184,14 +457,22 @@ func (h *Handler) handleRemoteNodeProxy(body any, r *http.Request) middleware.Re
 	}
 
 	remoteNodeName := r.URL.Query().Get("remoteNode")
-	if remoteNodeName == "" || remoteNodeName == "local" {
+	if remoteNodeName == "" || remoteNodeName == remoteNodeLocal {
 		return nil // No remote node specified, handle locally
 	}
+	if remoteNodeName == remoteNodeAll {
+		// Only the list of DAGs can be aggregated.
+		return h.responderWithCodedError(newBadRequestError(
+			fmt.Errorf("remote node %s is only supported for listing DAGs: %w", remoteNodeAll, errInvalidArgs),
+		))
+	}
 
 	node, ok := h.remoteNodes[remoteNodeName]
 	if !ok {
 		// remote node not found, return bad request
-		return dags.NewListDagsDefault(400)
+		return h.responderWithCodedError(newBadRequestError(
+			fmt.Errorf("remote node %s not found: %w", remoteNodeName, errInvalidArgs),
+		))
 	}
 
 	// forward the request to the remote node
@@ -200,6 +481,20 @@ func (h *Handler) handleRemoteNodeProxy(body any, r *http.Request) middleware.Re
 
 // doRemoteProxy performs the actual proxying of the request to the remote node.
 func (h *Handler) doRemoteProxy(body any, originalReq *http.Request, node config.RemoteNode) middleware.Responder {
+	code, respData, err := h.callRemote(body, originalReq, node)
+	if err != nil {
+		return h.responderWithCodedError(err)
+	}
+
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		w.WriteHeader(code)
+		_, _ = w.Write(respData)
+	})
+}
+
+// callRemote sends the request to the remote node and returns the status
+// code and the body of the successful response.
+func (h *Handler) callRemote(body any, originalReq *http.Request, node config.RemoteNode) (int, []byte, *codedError) {
 	// Copy original query parameters except remoteNode
 	q := originalReq.URL.Query()
 	q.Del("remoteNode")
@@ -207,11 +502,12 @@ func (h *Handler) doRemoteProxy(body any, originalReq *http.Request, node config
 	// Build the new remote URL
 	urlComponents := strings.Split(originalReq.URL.Path, h.apiBasePath)
 	if len(urlComponents) < 2 {
-		return h.responderWithCodedError(&codedError{
+		return 0, nil, &codedError{
 			Code: 400,
 			APIError: &models.APIError{
+				Code:    models.ErrorCodeBADREQUEST,
 				Message: swag.String("invalid API path"),
-			}})
+			}}
 	}
 	remoteURL := fmt.Sprintf("%s%s?%s", strings.TrimSuffix(node.APIBaseURL, "/"), urlComponents[1], q.Encode())
 
@@ -220,22 +516,24 @@ func (h *Handler) doRemoteProxy(body any, originalReq *http.Request, node config
 	if body != nil {
 		data, err := json.Marshal(body)
 		if err != nil {
-			return h.responderWithCodedError(&codedError{
+			return 0, nil, &codedError{
 				Code: 502,
 				APIError: &models.APIError{
+					Code:    models.ErrorCodeREMOTENODEUNAVAILABLE,
 					Message: swag.String(fmt.Sprintf("failed to read request body: %v", err)),
-				}})
+				}}
 		}
 		bodyJSON = strings.NewReader(string(data))
 	}
 
 	req, err := http.NewRequest(method, remoteURL, bodyJSON)
 	if err != nil {
-		return h.responderWithCodedError(&codedError{
+		return 0, nil, &codedError{
 			Code: 502,
 			APIError: &models.APIError{
+				Code:    models.ErrorCodeREMOTENODEUNAVAILABLE,
 				Message: swag.String(fmt.Sprintf("failed to create request to remote node: %v", err)),
-			}})
+			}}
 	}
 
 	// Copy headers from the original request if needed
@@ -270,19 +568,21 @@ func (h *Handler) doRemoteProxy(body any, originalReq *http.Request, node config
 
 	resp, err := client.Do(req)
 	if err != nil {
-		return h.responderWithCodedError(&codedError{
+		return 0, nil, &codedError{
 			Code: 502,
 			APIError: &models.APIError{
+				Code:    models.ErrorCodeREMOTENODEUNAVAILABLE,
 				Message: swag.String(fmt.Sprintf("failed to send request to remote node: %v", err)),
-			}})
+			}}
 	}
 
 	if resp == nil {
-		return h.responderWithCodedError(&codedError{
+		return 0, nil, &codedError{
 			Code: 502,
 			APIError: &models.APIError{
+				Code:    models.ErrorCodeREMOTENODEUNAVAILABLE,
 				Message: swag.String("received nil response from remote node"),
-			}})
+			}}
 	}
 
 	defer func() {
@@ -293,11 +593,12 @@ func (h *Handler) doRemoteProxy(body any, originalReq *http.Request, node config
 
 	respData, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return h.responderWithCodedError(&codedError{
+		return 0, nil, &codedError{
 			Code: 502,
 			APIError: &models.APIError{
+				Code:    models.ErrorCodeREMOTENODEUNAVAILABLE,
 				Message: swag.String(fmt.Sprintf("failed to read response from remote node: %v", err)),
-			}})
+			}}
 	}
 
 	// If not status 200, try to parse the error response
@@ -306,26 +607,98 @@ func (h *Handler) doRemoteProxy(body any, originalReq *http.Request, node config
 		if len(respData) > 0 {
 			var remoteErr models.APIError
 			if err := json.Unmarshal(respData, &remoteErr); err == nil && remoteErr.Message != nil {
-				return h.responderWithCodedError(&codedError{
+				return 0, nil, &codedError{
 					Code:     resp.StatusCode,
 					APIError: &remoteErr,
-				})
+				}
 			}
 		}
 		// If we can't decode a proper error or have no data, return a generic one
 		payload := &models.APIError{
+			Code:    remoteErrorCode(resp.StatusCode),
 			Message: swag.String(fmt.Sprintf("remote node responded with status %d", resp.StatusCode)),
 		}
-		return h.responderWithCodedError(&codedError{
+		return 0, nil, &codedError{
 			Code:     resp.StatusCode,
 			APIError: payload,
-		})
+		}
 	}
 
-	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
-		w.WriteHeader(resp.StatusCode)
-		_, _ = w.Write(respData)
-	})
+	return resp.StatusCode, respData, nil
+}
+
+// listRemote fetches the list of DAGs from the remote node.
+func (h *Handler) listRemote(originalReq *http.Request, node config.RemoteNode) (*models.ListDagsResponse, error) {
+	_, respData, cerr := h.callRemote(nil, originalReq, node)
+	if cerr != nil {
+		msg := swag.StringValue(cerr.APIError.Message)
+		if detail := swag.StringValue(cerr.APIError.DetailedMessage); detail != "" {
+			msg += ": " + detail
+		}
+		return nil, errors.New(msg)
+	}
+	var resp models.ListDagsResponse
+	if err := json.Unmarshal(respData, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse the response: %w", err)
+	}
+	return &resp, nil
+}
+
+// getAggregatedList lists the DAGs of this node and all the remote nodes.
+// The items are labeled with the name of the node. The remote nodes that
+// fail to respond are reported in the errors.
+func (h *Handler) getAggregatedList(ctx context.Context, params dags.ListDagsParams) (*models.ListDagsResponse, *codedError) {
+	resp, cerr := h.getList(ctx, params)
+	if cerr != nil {
+		return nil, cerr
+	}
+	for _, item := range resp.DAGs {
+		item.RemoteNode = remoteNodeLocal
+	}
+
+	names := make([]string, 0, len(h.remoteNodes))
+	for name := range h.remoteNodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var (
+		wg      sync.WaitGroup
+		results = make([]*models.ListDagsResponse, len(names))
+		errs    = make([]error, len(names))
+	)
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, node config.RemoteNode) {
+			defer wg.Done()
+			results[i], errs[i] = h.listRemote(params.HTTPRequest, node)
+		}(i, h.remoteNodes[name])
+	}
+	wg.Wait()
+
+	for i, name := range names {
+		if errs[i] != nil {
+			resp.Errors = append(resp.Errors, fmt.Sprintf("remote node %s: %v", name, errs[i]))
+			resp.HasError = swag.Bool(true)
+			continue
+		}
+		r := results[i]
+		for _, item := range r.DAGs {
+			item.RemoteNode = name
+			resp.DAGs = append(resp.DAGs, item)
+		}
+		for _, e := range r.Errors {
+			resp.Errors = append(resp.Errors, fmt.Sprintf("remote node %s: %s", name, e))
+		}
+		if swag.BoolValue(r.HasError) {
+			resp.HasError = swag.Bool(true)
+		}
+		if swag.Int64Value(r.PageCount) > swag.Int64Value(resp.PageCount) {
+			resp.PageCount = r.PageCount
+		}
+	}
+
+	return resp, nil
 }
 
 func (h *Handler) responderWithCodedError(err *codedError) middleware.Responder {
@@ -343,6 +716,9 @@ func (h *Handler) createDAG(ctx context.Context, params dags.CreateDagParams) (
 	switch *params.Body.Action {
 	case "new":
 		name := *params.Body.Value
+		if cerr := checkNamespace(ctx, name); cerr != nil {
+			return nil, cerr
+		}
 		id, err := h.client.CreateDAG(ctx, name)
 		if err != nil {
 			return nil, newInternalError(err)
@@ -352,18 +728,337 @@ func (h *Handler) createDAG(ctx context.Context, params dags.CreateDagParams) (
 		return nil, newBadRequestError(errInvalidArgs)
 	}
 }
-func (h *Handler) deleteDAG(ctx context.Context, params dags.DeleteDagParams) *codedError {
+
+// checkNamespace checks that the namespace of the DAG ID is valid and the
+// user is allowed to access it.
+func checkNamespace(ctx context.Context, id string) *codedError {
+	ns, name := namespace.Split(id)
+	if strings.Contains(name, namespace.Separator) {
+		return newBadRequestError(
+			fmt.Errorf("invalid namespace in %q: %w", id, errInvalidArgs),
+		)
+	}
+	if namespace.IsBaseConfig(name) {
+		return newBadRequestError(
+			fmt.Errorf("%q is reserved for the base config of the namespace: %w", name, errInvalidArgs),
+		)
+	}
+	if !namespace.Allowed(ctx, ns) {
+		return newForbiddenError(fmt.Errorf("access to the namespace %q is forbidden", ns))
+	}
+	return nil
+}
+
+func (h *Handler) deleteDAG(ctx context.Context, params dags.DeleteDagParams) *codedError {
+	dagStatus, err := h.client.GetStatus(ctx, params.DagID)
+	if err != nil {
+		return newNotFoundError(err)
+	}
+	if err := h.client.DeleteDAG(ctx, params.DagID, dagStatus.DAG.Location); err != nil {
+		return newInternalError(err)
+	}
+	return nil
+}
+
+func (h *Handler) getArtifact(ctx context.Context, params dags.GetArtifactParams) (io.ReadCloser, *codedError) {
+	dagStatus, err := h.client.GetStatus(ctx, params.DagID)
+	if err != nil {
+		return nil, newNotFoundError(err)
+	}
+
+	status, err := h.client.GetStatusByRequestID(ctx, dagStatus.DAG, params.RequestID)
+	if err != nil {
+		return nil, newNotFoundError(err)
+	}
+
+	// Only the artifacts recorded in the status can be downloaded.
+	var found bool
+	for _, node := range status.Nodes {
+		if lo.Contains(node.Artifacts, params.ArtifactName) {
+			found = true
+			break
+		}
+	}
+	if !found || status.ArtifactDir == "" {
+		return nil, newNotFoundError(
+			fmt.Errorf("%w: %s", ErrArtifactNotFound, params.ArtifactName),
+		)
+	}
+
+	file, err := os.Open(filepath.Join(status.ArtifactDir, params.ArtifactName))
+	if err != nil {
+		return nil, newNotFoundError(err)
+	}
+	return file, nil
+}
+
+func (h *Handler) postRunNote(ctx context.Context, params dags.PostRunNoteParams) (*models.PostRunNoteResponse, *codedError) {
+	text := strings.TrimSpace(swag.StringValue(params.Body.Text))
+	if text == "" {
+		return nil, newBadRequestError(fmt.Errorf("note text is required: %w", errInvalidArgs))
+	}
+
+	dagStatus, err := h.client.GetStatus(ctx, params.DagID)
+	if err != nil {
+		return nil, newNotFoundError(err)
+	}
+
+	status, err := h.client.GetStatusByRequestID(ctx, dagStatus.DAG, params.RequestID)
+	if err != nil {
+		return nil, newNotFoundError(err)
+	}
+
+	// The status of a running DAG is overwritten by the agent, so notes can
+	// only be attached to finished runs.
+	if status.Status == scheduler.StatusRunning {
+		return nil, newBadRequestError(
+			fmt.Errorf("%w: %w", errDAGRunning, errInvalidArgs),
+		)
+	}
+
+	status.Notes = append(status.Notes, model.NewNote(text, time.Now()))
+	if err := h.client.UpdateStatus(ctx, dagStatus.DAG, *status); err != nil {
+		return nil, newInternalError(err)
+	}
+
+	resp := &models.PostRunNoteResponse{Notes: []*models.RunNote{}}
+	for _, n := range status.Notes {
+		resp.Notes = append(resp.Notes, convertToNote(n))
+	}
+	return resp, nil
+}
+
+func (h *Handler) getRunLineage(ctx context.Context, params dags.GetRunLineageParams) (*models.RunLineageResponse, *codedError) {
+	dagStatus, err := h.client.GetStatus(ctx, params.DagID)
+	if err != nil {
+		return nil, newNotFoundError(err)
+	}
+	status, err := h.client.GetStatusByRequestID(ctx, dagStatus.DAG, params.RequestID)
+	if err != nil {
+		return nil, newNotFoundError(err)
+	}
+	run := model.LineageRun{DAG: dagStatus.DAG.ID(), Status: status}
+
+	ancestors, originFound := model.Ancestors(run, func(dag, requestID string) (*model.Status, error) {
+		parent, err := h.client.GetStatus(ctx, dag)
+		if err != nil {
+			return nil, err
+		}
+		return h.client.GetStatusByRequestID(ctx, parent.DAG, requestID)
+	})
+
+	// The descendants are searched in the recent runs of all the DAGs as
+	// the runs only record where they come from.
+	statuses, err := h.listAllStatuses(ctx, nil, nil)
+	if err != nil {
+		return nil, newInternalError(err)
+	}
+	var runs []model.LineageRun
+	for _, s := range statuses {
+		if s.DAG == nil {
+			continue
+		}
+		for _, f := range h.client.GetRecentHistory(ctx, s.DAG, lineageHistoryLimit) {
+			runs = append(runs, model.LineageRun{DAG: s.DAG.ID(), Status: &f.Status})
+		}
+	}
+	descendants := model.Descendants(run, runs)
+
+	resp := &models.RunLineageResponse{
+		Run:         convertToLineageRun(run),
+		Ancestors:   []*models.LineageRun{},
+		OriginFound: swag.Bool(originFound),
+		Descendants: []*models.LineageRun{},
+	}
+	for _, r := range ancestors {
+		resp.Ancestors = append(resp.Ancestors, convertToLineageRun(r))
+	}
+	for _, r := range descendants {
+		resp.Descendants = append(resp.Descendants, convertToLineageRun(r))
+	}
+	return resp, nil
+}
+
+func (h *Handler) retryDagStep(ctx context.Context, params dags.RetryDagStepParams) (*models.PostDagActionResponse, *codedError) {
+	dagStatus, err := h.client.GetStatus(ctx, params.DagID)
+	if err != nil {
+		return nil, newNotFoundError(err)
+	}
+
+	status, err := h.client.GetStatusByRequestID(ctx, dagStatus.DAG, params.RequestID)
+	if err != nil {
+		return nil, newNotFoundError(err)
+	}
+
+	if status.Status == scheduler.StatusRunning {
+		return nil, newBadRequestError(
+			fmt.Errorf("%w: %w", errDAGRunning, errInvalidArgs),
+		)
+	}
+
+	var found bool
+	for _, n := range status.Nodes {
+		if n.Step.Name == params.StepName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, newNotFoundError(
+			fmt.Errorf("step %s not found", params.StepName),
+		)
+	}
+
+	if err := h.client.RetryStep(ctx, dagStatus.DAG, params.RequestID, params.StepName); err != nil {
+		return nil, newInternalError(
+			fmt.Errorf("error trying to retry the step: %w", err),
+		)
+	}
+	return &models.PostDagActionResponse{RequestID: params.RequestID}, nil
+}
+
+func (h *Handler) skipDagStep(ctx context.Context, params dags.SkipDagStepParams) (*models.PostDagActionResponse, *codedError) {
+	dagStatus, err := h.client.GetStatus(ctx, params.DagID)
+	if err != nil {
+		return nil, newNotFoundError(err)
+	}
+
+	if !lo.ContainsBy(dagStatus.DAG.Steps, func(s digraph.Step) bool {
+		return s.Name == params.StepName
+	}) {
+		return nil, newNotFoundError(
+			fmt.Errorf("step %s not found", params.StepName),
+		)
+	}
+
+	err = h.client.SkipStep(ctx, dagStatus.DAG, params.RequestID, params.StepName)
+	switch {
+	case errors.Is(err, client.ErrRunNotActive), errors.Is(err, scheduler.ErrStepAlreadyStarted):
+		return nil, newBadRequestError(err)
+	case err != nil:
+		return nil, newInternalError(
+			fmt.Errorf("error trying to skip the step: %w", err),
+		)
+	}
+	return &models.PostDagActionResponse{RequestID: params.RequestID}, nil
+}
+
+func (h *Handler) signalDagStep(ctx context.Context, params dags.SignalDagStepParams) (*models.PostDagActionResponse, *codedError) {
+	signal := swag.StringValue(params.Body.Signal)
+	if unix.SignalNum(signal) == 0 {
+		return nil, newBadRequestError(
+			fmt.Errorf("invalid signal %q: %w", signal, errInvalidArgs),
+		)
+	}
+
+	dagStatus, err := h.client.GetStatus(ctx, params.DagID)
+	if err != nil {
+		return nil, newNotFoundError(err)
+	}
+
+	if !lo.ContainsBy(dagStatus.DAG.Steps, func(s digraph.Step) bool {
+		return s.Name == params.StepName
+	}) {
+		return nil, newNotFoundError(
+			fmt.Errorf("step %s not found", params.StepName),
+		)
+	}
+
+	err = h.client.SignalStep(ctx, dagStatus.DAG, params.RequestID, params.StepName, signal)
+	switch {
+	case errors.Is(err, client.ErrRunNotActive), errors.Is(err, scheduler.ErrStepNotRunning):
+		return nil, newBadRequestError(err)
+	case err != nil:
+		return nil, newInternalError(
+			fmt.Errorf("error trying to send the signal to the step: %w", err),
+		)
+	}
+	return &models.PostDagActionResponse{RequestID: params.RequestID}, nil
+}
+
+func (h *Handler) getAnalytics(ctx context.Context, params dags.GetDagAnalyticsParams) (*models.DagAnalyticsResponse, *codedError) {
+	limit := defaultHistoryLimit
+	if params.Limit != nil {
+		if *params.Limit <= 0 {
+			return nil, newBadRequestError(fmt.Errorf("limit must be positive: %w", errInvalidArgs))
+		}
+		limit = int(*params.Limit)
+	}
+
+	dagStatus, err := h.client.GetStatus(ctx, params.DagID)
+	if err != nil {
+		return nil, newNotFoundError(err)
+	}
+
+	history := h.client.GetRecentHistory(ctx, dagStatus.DAG, limit)
+	statuses := make([]model.Status, 0, len(history))
+	for _, file := range history {
+		statuses = append(statuses, file.Status)
+	}
+	trend := model.AnalyzeTrend(statuses, swag.Float64Value(params.Threshold))
+
+	resp := &models.DagAnalyticsResponse{
+		Runs:  []*models.RunTrend{},
+		Steps: []*models.StepTrend{},
+	}
+	for _, r := range trend.Runs {
+		resp.Runs = append(resp.Runs, convertToRunTrend(r))
+	}
+	for _, s := range trend.Steps {
+		resp.Steps = append(resp.Steps, convertToStepTrend(s))
+	}
+	return resp, nil
+}
+
+func (h *Handler) getFailures(ctx context.Context, params dags.GetDagFailuresParams) (*models.DagFailuresResponse, *codedError) {
+	limit := defaultHistoryLimit
+	if params.Limit != nil {
+		if *params.Limit <= 0 {
+			return nil, newBadRequestError(fmt.Errorf("limit must be positive: %w", errInvalidArgs))
+		}
+		limit = int(*params.Limit)
+	}
+	var since time.Time
+	if params.Days != nil {
+		if *params.Days <= 0 {
+			return nil, newBadRequestError(fmt.Errorf("days must be positive: %w", errInvalidArgs))
+		}
+		since = time.Now().AddDate(0, 0, -int(*params.Days))
+	}
+
 	dagStatus, err := h.client.GetStatus(ctx, params.DagID)
 	if err != nil {
-		return newNotFoundError(err)
+		return nil, newNotFoundError(err)
 	}
-	if err := h.client.DeleteDAG(ctx, params.DagID, dagStatus.DAG.Location); err != nil {
-		return newInternalError(err)
+
+	history := h.client.GetRecentHistory(ctx, dagStatus.DAG, limit)
+	statuses := make([]model.Status, 0, len(history))
+	for _, file := range history {
+		statuses = append(statuses, file.Status)
 	}
-	return nil
+	hotspots := model.AnalyzeFailures(statuses, since)
+
+	resp := &models.DagFailuresResponse{
+		Runs:       swag.Int64(int64(hotspots.Runs)),
+		FailedRuns: swag.Int64(int64(hotspots.FailedRuns)),
+		Steps:      []*models.StepFailures{},
+	}
+	for _, s := range hotspots.Steps {
+		resp.Steps = append(resp.Steps, convertToStepFailures(s))
+	}
+	return resp, nil
 }
 
 func (h *Handler) getList(ctx context.Context, params dags.ListDagsParams) (*models.ListDagsResponse, *codedError) {
+	if params.Since != nil && *params.Since != "" {
+		if _, err := time.Parse(time.RFC3339, *params.Since); err != nil {
+			return nil, newBadRequestError(errInvalidArgs)
+		}
+	}
+
+	// The timestamp is taken before reading the statuses so that the changes
+	// made while reading are reported again on the next poll.
+	timestamp := h.now()
 	dgs, result, err := h.client.GetAllStatusPagination(ctx, params)
 	if err != nil {
 		return nil, newInternalError(err)
@@ -384,12 +1079,14 @@ func (h *Handler) getList(ctx context.Context, params dags.ListDagsParams) (*mod
 		Errors:    result.ErrorList,
 		PageCount: swag.Int64(int64(result.PageCount)),
 		HasError:  swag.Bool(hasErr),
+		Timestamp: timestamp.Format(time.RFC3339Nano),
 	}
 
 	for _, dagStatus := range dgs {
 		s := dagStatus.Status
 
 		status := &models.DagStatus{
+			Labels:     s.Labels,
 			Log:        swag.String(s.Log),
 			Name:       swag.String(s.Name),
 			Params:     swag.String(s.Params),
@@ -402,12 +1099,13 @@ func (h *Handler) getList(ctx context.Context, params dags.ListDagsParams) (*mod
 		}
 
 		item := &models.DagListItem{
-			Dir:       swag.String(dagStatus.Dir),
-			ErrorT:    dagStatus.ErrorT,
-			File:      swag.String(dagStatus.File),
-			Status:    status,
-			Suspended: swag.Bool(dagStatus.Suspended),
-			DAG:       convertToDAG(dagStatus.DAG),
+			Dir:        swag.String(dagStatus.Dir),
+			ErrorT:     dagStatus.ErrorT,
+			File:       swag.String(dagStatus.File),
+			Status:     status,
+			Suspended:  swag.Bool(dagStatus.Suspended),
+			Suspension: convertToSuspension(dagStatus.Suspension),
+			DAG:        convertToDAG(dagStatus.DAG, h.now(), h.timezone),
 		}
 
 		if dagStatus.Error != nil {
@@ -453,12 +1151,7 @@ func (h *Handler) getDetail(
 		handlerOn.Exit = convertToStepObject(*hdlrs.Exit)
 	}
 
-	var schedules []*models.Schedule
-	for _, s := range dagStatus.DAG.Schedule {
-		schedules = append(schedules, &models.Schedule{
-			Expression: swag.String(s.Expression),
-		})
-	}
+	schedules := convertToSchedules(dagStatus.DAG.Schedule, h.now(), h.timezone)
 
 	var preconditions []*models.Condition
 	for _, p := range dagStatus.DAG.Preconditions {
@@ -475,6 +1168,7 @@ func (h *Handler) getDetail(
 		Description:       swag.String(dag.Description),
 		Env:               dag.Env,
 		Group:             swag.String(dag.Group),
+		Namespace:         dag.Namespace,
 		HandlerOn:         handlerOn,
 		HistRetentionDays: swag.Int64(int64(dag.HistRetentionDays)),
 		Location:          swag.String(dag.Location),
@@ -489,12 +1183,13 @@ func (h *Handler) getDetail(
 	}
 
 	statusWithDetails := &models.DagStatusWithDetails{
-		DAG:       dagDetail,
-		Dir:       swag.String(dagStatus.Dir),
-		ErrorT:    dagStatus.ErrorT,
-		File:      swag.String(dagStatus.File),
-		Status:    convertToStatusDetail(dagStatus.Status),
-		Suspended: swag.Bool(dagStatus.Suspended),
+		DAG:        dagDetail,
+		Dir:        swag.String(dagStatus.Dir),
+		ErrorT:     dagStatus.ErrorT,
+		File:       swag.String(dagStatus.File),
+		Status:     convertToStatusDetail(dagStatus.Status),
+		Suspended:  swag.Bool(dagStatus.Suspended),
+		Suspension: convertToSuspension(dagStatus.Suspension),
 	}
 
 	if dagStatus.Error != nil {
@@ -516,13 +1211,20 @@ func (h *Handler) getDetail(
 
 	switch tab {
 	case dagTabTypeStatus:
+		starts, err := h.client.GetDelayedStarts(ctx, dag)
+		if err != nil {
+			resp.Errors = append(resp.Errors, err.Error())
+		}
+		for _, start := range starts {
+			resp.DelayedStarts = append(resp.DelayedStarts, convertToDelayedStart(start))
+		}
 		return resp, nil
 
 	case dagTabTypeSpec:
 		return h.processSpecRequest(ctx, dagID, resp)
 
 	case dagTabTypeHistory:
-		return h.processLogRequest(ctx, resp, dag)
+		return h.processLogRequest(ctx, resp, dag, params)
 
 	case dagTabTypeStepLog:
 		return h.processStepLogRequest(ctx, dag, params, resp)
@@ -544,7 +1246,7 @@ func (h *Handler) processSchedulerLogRequest(
 	var logFile string
 
 	if params.File != nil {
-		status, err := jsondb.ParseStatusFile(*params.File)
+		status, err := h.client.ReadStatusFile(ctx, *params.File)
 		if err != nil {
 			return nil, newBadRequestError(err)
 		}
@@ -585,7 +1287,7 @@ func (h *Handler) processStepLogRequest(
 	}
 
 	if params.File != nil {
-		parsedStatus, err := jsondb.ParseStatusFile(*params.File)
+		parsedStatus, err := h.client.ReadStatusFile(ctx, *params.File)
 		if err != nil {
 			return nil, newBadRequestError(err)
 		}
@@ -619,6 +1321,9 @@ func (h *Handler) processStepLogRequest(
 		if status.OnCancel != nil && status.OnCancel.Step.Name == *params.Step {
 			node = status.OnCancel
 		}
+		if status.OnTimeout != nil && status.OnTimeout.Step.Name == *params.Step {
+			node = status.OnTimeout
+		}
 		if status.OnExit != nil && status.OnExit.Step.Name == *params.Step {
 			node = status.OnExit
 		}
@@ -665,13 +1370,46 @@ var (
 	defaultHistoryLimit = 30
 )
 
+const (
+	// defaultCalendarDays is the number of the days exported to the
+	// calendar by default.
+	defaultCalendarDays = 7
+	// maxCalendarDays is the maximum number of the days exported to the
+	// calendar.
+	maxCalendarDays = 90
+	// maxCalendarEvents is the maximum number of the events of a schedule,
+	// so that a schedule that fires every minute doesn't flood the calendar.
+	maxCalendarEvents = 500
+	// minCalendarEventDuration is the duration of the events of the DAGs
+	// that have never finished a run.
+	minCalendarEventDuration = time.Minute
+	// defaultTimelineHours is the number of the hours simulated by default.
+	defaultTimelineHours = 24
+	// maxTimelineHours is the maximum number of the hours simulated.
+	maxTimelineHours = 7 * 24
+	// lineageHistoryLimit is the number of the recent runs of each DAG
+	// searched for the descendants of a run.
+	lineageHistoryLimit = 100
+)
+
 func (h *Handler) processLogRequest(
 	ctx context.Context,
 	resp *models.GetDagDetailsResponse,
 	dag *digraph.DAG,
+	params dags.GetDagDetailsParams,
 ) (*models.GetDagDetailsResponse, *codedError) {
 	logs := h.client.GetRecentHistory(ctx, dag, defaultHistoryLimit)
 
+	if params.Labels != nil && *params.Labels != "" {
+		labels, err := model.ParseLabels(*params.Labels)
+		if err != nil {
+			return nil, newBadRequestError(err)
+		}
+		logs = lo.Filter(logs, func(log model.StatusFile, _ int) bool {
+			return log.Status.MatchLabels(labels)
+		})
+	}
+
 	nodeNameToStatusList := map[string][]scheduler.NodeStatus{}
 	for idx, log := range logs {
 		for _, node := range log.Status.Nodes {
@@ -709,6 +1447,9 @@ func (h *Handler) processLogRequest(
 			n := log.Status.OnCancel
 			addNodeStatus(ctx, handlerToStatusList, len(logs), idx, n.Step.Name, n.Status)
 		}
+		if n := log.Status.OnTimeout; n != nil {
+			addNodeStatus(ctx, handlerToStatusList, len(logs), idx, n.Step.Name, n.Status)
+		}
 		if n := log.Status.OnExit; n != nil {
 			addNodeStatus(ctx, handlerToStatusList, len(logs), idx, n.Step.Name, n.Status)
 		}
@@ -718,6 +1459,7 @@ func (h *Handler) processLogRequest(
 		digraph.HandlerOnSuccess,
 		digraph.HandlerOnFailure,
 		digraph.HandlerOnCancel,
+		digraph.HandlerOnTimeout,
 		digraph.HandlerOnExit,
 	} {
 		if statusList, ok := handlerToStatusList[handlerType.String()]; ok {
@@ -782,30 +1524,80 @@ func (h *Handler) postAction(
 
 	switch *params.Body.Action {
 	case "start":
-		if dagStatus.Status.Status == scheduler.StatusRunning {
-			return nil, newBadRequestError(errInvalidArgs)
+		startAt, cErr := parseStartTime(params.Body.StartAt, params.Body.Delay, h.now())
+		if cErr != nil {
+			return nil, cErr
 		}
-		h.client.StartAsync(ctx, dagStatus.DAG, client.StartOptions{
-			Params: params.Body.Params,
-		})
-		return &models.PostDagActionResponse{}, nil
+		if startAt.IsZero() && dagStatus.Status.Status == scheduler.StatusRunning {
+			return nil, newBadRequestError(fmt.Errorf("%w: %w", errDAGRunning, errInvalidArgs))
+		}
+		requestID, err := uuid.NewRandom()
+		if err != nil {
+			return nil, newInternalError(err)
+		}
+		labels, err := model.ParseLabels(params.Body.Labels)
+		if err != nil {
+			return nil, newBadRequestError(err)
+		}
+		for _, step := range []string{params.Body.From, params.Body.Target} {
+			if step != "" && !lo.ContainsBy(dagStatus.DAG.Steps, func(s digraph.Step) bool {
+				return s.Name == step
+			}) {
+				return nil, newBadRequestError(
+					fmt.Errorf("step %s not found: %w", step, errInvalidArgs),
+				)
+			}
+		}
+		opts := client.StartOptions{
+			Labels:         labels,
+			Params:         params.Body.Params,
+			RequestID:      requestID.String(),
+			IdempotencyKey: params.Body.IdempotencyKey,
+			Trigger:        model.TriggerAPI,
+			From:           params.Body.From,
+			Target:         params.Body.Target,
+		}
+		if key := opts.IdempotencyKey; key != "" {
+			// Return the existing run instead of starting a duplicate.
+			reserved, err := h.client.ReserveIdempotencyKey(ctx, dagStatus.DAG, key, opts.RequestID)
+			if err != nil {
+				return nil, newInternalError(err)
+			}
+			if reserved != opts.RequestID {
+				return &models.PostDagActionResponse{RequestID: reserved}, nil
+			}
+		}
+		if !startAt.IsZero() {
+			// The scheduler starts the run at the time.
+			if err := h.client.StartLater(ctx, dagStatus.DAG, opts, startAt); err != nil {
+				if key := opts.IdempotencyKey; key != "" {
+					_ = h.client.ReleaseIdempotencyKey(ctx, dagStatus.DAG, key, opts.RequestID)
+				}
+				return nil, newInternalError(err)
+			}
+			return &models.PostDagActionResponse{RequestID: requestID.String()}, nil
+		}
+		h.client.StartAsync(ctx, dagStatus.DAG, opts)
+		return &models.PostDagActionResponse{RequestID: requestID.String()}, nil
 
 	case "suspend":
-		_ = h.client.ToggleSuspend(ctx, params.DagID, params.Body.Value == "true")
+		if err := h.suspend(ctx, params); err != nil {
+			return nil, err
+		}
 		return &models.PostDagActionResponse{}, nil
 
 	case "stop":
-		if dagStatus.Status.Status != scheduler.StatusRunning {
-			return nil, newBadRequestError(
-				fmt.Errorf("the DAG is not running: %w", errInvalidArgs),
-			)
+		// Stopping the run again is not an error.
+		result, err := h.client.Stop(ctx, dagStatus.DAG, params.Body.RequestID)
+		if errors.Is(err, persistence.ErrRequestIDNotFound) {
+			return nil, newNotFoundError(err)
 		}
-		if err := h.client.Stop(ctx, dagStatus.DAG); err != nil {
-			return nil, newBadRequestError(
+		if err != nil {
+			return nil, newInternalError(
 				fmt.Errorf("error trying to stop the DAG: %w", err),
 			)
 		}
-		return &models.PostDagActionResponse{}, nil
+		return &models.PostDagActionResponse{StopResult: string(result)}, nil
 
 	case "retry":
 		if params.Body.RequestID == "" {
@@ -826,11 +1618,19 @@ func (h *Handler) postAction(
 	case "mark-failed":
 		return h.processUpdateStatus(ctx, params, dagStatus, scheduler.NodeStatusError)
 
+	case "mark-skipped":
+		return h.processUpdateStatus(ctx, params, dagStatus, scheduler.NodeStatusSkipped)
+
 	case "save":
-		if err := h.client.UpdateDAG(ctx, params.DagID, params.Body.Value); err != nil {
+		warnings, err := h.client.UpdateDAG(ctx, params.DagID, params.Body.Value)
+		if err != nil {
 			return nil, newInternalError(err)
 		}
-		return &models.PostDagActionResponse{}, nil
+		resp := &models.PostDagActionResponse{}
+		for _, w := range warnings {
+			resp.Warnings = append(resp.Warnings, convertToLintWarning(w))
+		}
+		return resp, nil
 
 	case "rename":
 		newName := params.Body.Value
@@ -839,6 +1639,9 @@ func (h *Handler) postAction(
 				fmt.Errorf("new name is required: %w", errInvalidArgs),
 			)
 		}
+		if cerr := checkNamespace(ctx, newName); cerr != nil {
+			return nil, cerr
+		}
 		if err := h.client.Rename(ctx, params.DagID, newName); err != nil {
 			return nil, newInternalError(err)
 		}
@@ -851,6 +1654,78 @@ func (h *Handler) postAction(
 	}
 }
 
+// requestIDRe is the pattern of the request IDs given by the clients. The
+// request ID is a part of the name of the status file.
+var requestIDRe = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+func (h *Handler) startDAG(ctx context.Context, params dags.StartDagParams) (*models.PostDagActionResponse, *codedError) {
+	var body models.StartDagBody
+	if params.Body != nil {
+		body = *params.Body
+	}
+	if body.RequestID != "" && !requestIDRe.MatchString(body.RequestID) {
+		return nil, newBadRequestError(
+			fmt.Errorf("invalid request ID %q: %w", body.RequestID, errInvalidArgs),
+		)
+	}
+	for name := range body.Params {
+		if !paramNameRe.MatchString(name) {
+			return nil, newBadRequestError(
+				fmt.Errorf("invalid parameter name %q: %w", name, errInvalidArgs),
+			)
+		}
+	}
+
+	dagStatus, err := h.client.GetStatus(ctx, params.DagID)
+	if err != nil {
+		return nil, newNotFoundError(err)
+	}
+
+	requestID := body.RequestID
+	if requestID != "" {
+		// The webhooks may be delivered more than once.
+		if _, err := h.client.GetStatusByRequestID(ctx, dagStatus.DAG, requestID); err == nil {
+			return &models.PostDagActionResponse{RequestID: requestID}, nil
+		}
+	} else {
+		id, err := uuid.NewRandom()
+		if err != nil {
+			return nil, newInternalError(err)
+		}
+		requestID = id.String()
+	}
+
+	if dagStatus.Status.Status == scheduler.StatusRunning {
+		return nil, newBadRequestError(fmt.Errorf("%w: %w", errDAGRunning, errInvalidArgs))
+	}
+
+	h.client.StartAsync(ctx, dagStatus.DAG, client.StartOptions{
+		Params:    formatParams(body.Params),
+		RequestID: requestID,
+		Trigger:   model.TriggerAPI,
+	})
+	return &models.PostDagActionResponse{RequestID: requestID}, nil
+}
+
+var paramNameRe = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// formatParams formats the named parameters as the parameters of the
+// command line, sorted by the names.
+func formatParams(params map[string]string) string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		value := strings.ReplaceAll(params[name], `"`, `\"`)
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, name, value))
+	}
+	return strings.Join(pairs, " ")
+}
+
 func (h *Handler) processUpdateStatus(
 	ctx context.Context,
 	params dags.PostDagActionParams,
@@ -867,7 +1742,7 @@ func (h *Handler) processUpdateStatus(
 	// Do not allow updating the status if the DAG is still running.
 	if dagStatus.Status.Status == scheduler.StatusRunning {
 		return nil, newBadRequestError(
-			fmt.Errorf("the DAG is still running: %w", errInvalidArgs),
+			fmt.Errorf("%w: %w", errDAGRunning, errInvalidArgs),
 		)
 	}
 
@@ -891,9 +1766,17 @@ func (h *Handler) processUpdateStatus(
 		return nil, newBadRequestError(fmt.Errorf("step not found: %w", errInvalidArgs))
 	}
 
+	from := status.Nodes[idxToUpdate].Status
 	status.Nodes[idxToUpdate].Status = to
 	status.Nodes[idxToUpdate].StatusText = to.String()
 
+	// Record the manual override in the notes of the run for auditing.
+	note := fmt.Sprintf("Step %q was manually changed from %s to %s", params.Body.Step, from, to)
+	if reason := strings.TrimSpace(params.Body.Value); reason != "" {
+		note += ": " + reason
+	}
+	status.Notes = append(status.Notes, model.NewNote(note, time.Now()))
+
 	if err := h.client.UpdateStatus(ctx, dagStatus.DAG, *status); err != nil {
 		return nil, newInternalError(err)
 	}
@@ -909,13 +1792,26 @@ func (h *Handler) searchDAGs(ctx context.Context, params dags.SearchDagsParams)
 		return nil, newBadRequestError(errInvalidArgs)
 	}
 
-	ret, errs, err := h.client.Grep(ctx, query)
+	var labels map[string]string
+	if params.Labels != nil && *params.Labels != "" {
+		parsed, err := model.ParseLabels(*params.Labels)
+		if err != nil {
+			return nil, newBadRequestError(err)
+		}
+		labels = parsed
+	}
+
+	ret, errs, err := h.client.Search(ctx, query)
 	if err != nil {
 		return nil, newInternalError(err)
 	}
 
 	var results []*models.SearchDagsResultItem
 	for _, item := range ret {
+		if labels != nil && !h.hasRunWithLabels(ctx, item.DAG, labels) {
+			continue
+		}
+
 		var matches []*models.SearchDagsMatchItem
 		for _, match := range item.Matches {
 			matches = append(matches, &models.SearchDagsMatchItem{
@@ -927,7 +1823,7 @@ func (h *Handler) searchDAGs(ctx context.Context, params dags.SearchDagsParams)
 
 		results = append(results, &models.SearchDagsResultItem{
 			Name:    item.Name,
-			DAG:     convertToDAG(item.DAG),
+			DAG:     convertToDAG(item.DAG, h.now(), h.timezone),
 			Matches: matches,
 		})
 	}
@@ -938,9 +1834,19 @@ func (h *Handler) searchDAGs(ctx context.Context, params dags.SearchDagsParams)
 	}, nil
 }
 
+// hasRunWithLabels returns true if any of the recent runs of the DAG has
+// the labels.
+func (h *Handler) hasRunWithLabels(ctx context.Context, dag *digraph.DAG, labels map[string]string) bool {
+	logs := h.client.GetRecentHistory(ctx, dag, defaultHistoryLimit)
+	return lo.SomeBy(logs, func(log model.StatusFile) bool {
+		return log.Status.MatchLabels(labels)
+	})
+}
+
+// readFileContent reads the log file, decrypting it if it's encrypted.
 func readFileContent(f string, decoder *encoding.Decoder) ([]byte, error) {
 	if decoder == nil {
-		return os.ReadFile(f)
+		return crypt.ReadFile(f)
 	}
 
 	r, err := os.Open(f)
@@ -950,7 +1856,7 @@ func readFileContent(f string, decoder *encoding.Decoder) ([]byte, error) {
 	defer func() {
 		_ = r.Close()
 	}()
-	tr := transform.NewReader(r, decoder)
+	tr := transform.NewReader(crypt.NewReader(r), decoder)
 	ret, err := io.ReadAll(tr)
 	return ret, err
 }
@@ -965,3 +1871,310 @@ func (h *Handler) getTagList(ctx context.Context, _ dags.ListTagsParams) (*model
 		Tags:   tags,
 	}, nil
 }
+
+func (h *Handler) getGraph(ctx context.Context, params dags.GetDagGraphParams) (io.ReadCloser, *codedError) {
+	dagStatus, err := h.client.GetStatus(ctx, params.DagID)
+	if err != nil {
+		return nil, newNotFoundError(err)
+	}
+
+	// The latest run, or the steps of the DAG if it has never run.
+	status := dagStatus.Status
+	if params.RequestID != nil {
+		found, err := h.client.GetStatusByRequestID(ctx, dagStatus.DAG, *params.RequestID)
+		if err != nil {
+			return nil, newNotFoundError(err)
+		}
+		status = *found
+	}
+	return io.NopCloser(strings.NewReader(model.RenderGraph(status.Nodes))), nil
+}
+
+func (h *Handler) getConfigSources(ctx context.Context, params dags.GetDagConfigSourcesParams) (*models.DagConfigSourcesResponse, *codedError) {
+	dagStatus, err := h.client.GetStatus(ctx, params.DagID)
+	if err != nil {
+		return nil, newNotFoundError(err)
+	}
+
+	var opts []digraph.LoadOption
+	for _, base := range h.paths.BaseConfigsFor(dagStatus.DAG.Location) {
+		opts = append(opts, digraph.WithBaseConfig(base))
+	}
+	values, files, err := digraph.Sources(dagStatus.DAG.Location, opts...)
+	if err != nil {
+		return nil, newInternalError(err)
+	}
+
+	resp := &models.DagConfigSourcesResponse{Files: files, Values: []*models.ConfigValueSource{}}
+	for _, v := range values {
+		value, err := yaml.Marshal(v.Value)
+		if err != nil {
+			return nil, newInternalError(err)
+		}
+		resp.Values = append(resp.Values, &models.ConfigValueSource{
+			Key:   swag.String(v.Key),
+			Value: swag.String(strings.TrimSuffix(string(value), "\n")),
+			File:  swag.String(v.File),
+		})
+	}
+	return resp, nil
+}
+
+func (h *Handler) getCalendar(ctx context.Context, params dags.GetScheduleCalendarParams) (io.ReadCloser, *codedError) {
+	days := defaultCalendarDays
+	if params.Days != nil {
+		if *params.Days <= 0 || *params.Days > maxCalendarDays {
+			return nil, newBadRequestError(
+				fmt.Errorf("days must be between 1 and %d: %w", maxCalendarDays, errInvalidArgs),
+			)
+		}
+		days = int(*params.Days)
+	}
+
+	statuses, err := h.listAllStatuses(ctx, params.SearchName, params.SearchTag)
+	if err != nil {
+		return nil, newInternalError(err)
+	}
+
+	now := h.now()
+	until := now.AddDate(0, 0, days)
+	cal := &calendar.Calendar{Name: "Dagu"}
+	for _, dagStatus := range statuses {
+		if dagStatus.Suspended || dagStatus.Error != nil || dagStatus.DAG == nil {
+			continue
+		}
+		dag := dagStatus.DAG
+		id := dag.ID()
+		duration := calendarEventDuration(dagStatus.Status)
+
+		for _, schedule := range dag.Schedule {
+			description := "Schedule: " + schedule.Expression
+			if dag.Description != "" {
+				description = dag.Description + "\n" + description
+			}
+			for _, t := range calendar.Occurrences(schedule.Parsed, now, until, maxCalendarEvents) {
+				cal.Events = append(cal.Events, calendar.Event{
+					UID:         fmt.Sprintf("%s-%s@dagu", id, t.UTC().Format("20060102T150405Z")),
+					Summary:     dag.Name,
+					Description: description,
+					Start:       t,
+					End:         t.Add(duration),
+				})
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := cal.Write(&buf, now); err != nil {
+		return nil, newInternalError(err)
+	}
+	return io.NopCloser(&buf), nil
+}
+
+// listAllStatuses returns the statuses of all the DAGs matching the name
+// and the tag.
+func (h *Handler) listAllStatuses(ctx context.Context, searchName, searchTag *string) ([]client.DAGStatus, error) {
+	var statuses []client.DAGStatus
+	for page := int64(1); ; page++ {
+		list, result, err := h.client.GetAllStatusPagination(ctx, dags.ListDagsParams{
+			Page:       swag.Int64(page),
+			SearchName: searchName,
+			SearchTag:  searchTag,
+		})
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, list...)
+		if page >= int64(result.PageCount) {
+			return statuses, nil
+		}
+	}
+}
+
+func (h *Handler) getTimeline(ctx context.Context, params dags.GetTimelineParams) (*models.TimelineResponse, *codedError) {
+	hours := defaultTimelineHours
+	if params.Hours != nil {
+		if *params.Hours <= 0 || *params.Hours > maxTimelineHours {
+			return nil, newBadRequestError(
+				fmt.Errorf("hours must be between 1 and %d: %w", maxTimelineHours, errInvalidArgs),
+			)
+		}
+		hours = int(*params.Hours)
+	}
+
+	statuses, err := h.listAllStatuses(ctx, params.SearchName, params.SearchTag)
+	if err != nil {
+		return nil, newInternalError(err)
+	}
+	var scheduled []calendar.ScheduledDAG
+	for _, dagStatus := range statuses {
+		if dagStatus.Error != nil || dagStatus.DAG == nil {
+			continue
+		}
+		scheduled = append(scheduled, calendar.ScheduledDAG{
+			DAG:        dagStatus.DAG,
+			Suspension: dagStatus.Suspension,
+		})
+	}
+
+	from := h.now()
+	to := from.Add(time.Duration(hours) * time.Hour)
+	resp := &models.TimelineResponse{
+		From: swag.String(from.Format(time.RFC3339)),
+		To:   swag.String(to.Format(time.RFC3339)),
+		Runs: []*models.TimelineRun{},
+	}
+	for _, run := range calendar.Timeline(scheduled, from, to, maxCalendarEvents) {
+		resp.Runs = append(resp.Runs, &models.TimelineRun{
+			DAG:       swag.String(run.DAG),
+			Time:      swag.String(run.Time.Format(time.RFC3339)),
+			Operation: swag.String(string(run.Operation)),
+			Schedule:  swag.String(run.Schedule),
+			Skipped:   swag.Bool(run.Skipped),
+			Reason:    run.Reason,
+		})
+	}
+	return resp, nil
+}
+
+func (h *Handler) listQueuedRuns(ctx context.Context, params dags.ListQueuedRunsParams) (*models.QueueResponse, *codedError) {
+	var dag *digraph.DAG
+	if params.DagID != nil && *params.DagID != "" {
+		dagStatus, err := h.client.GetStatus(ctx, *params.DagID)
+		if err != nil {
+			return nil, newNotFoundError(err)
+		}
+		dag = dagStatus.DAG
+	}
+
+	runs, err := h.client.GetQueue(ctx, h.now())
+	if err != nil {
+		return nil, newInternalError(err)
+	}
+	resp := &models.QueueResponse{Runs: []*models.QueuedRun{}}
+	for _, run := range runs {
+		if dag != nil && run.DelayedStart.DAG != dag.Location {
+			continue
+		}
+		resp.Runs = append(resp.Runs, convertToQueuedRun(run))
+	}
+	return resp, nil
+}
+
+func (h *Handler) updateQueuedRun(ctx context.Context, params dags.UpdateQueuedRunParams) (*models.QueuedRun, *codedError) {
+	if _, cErr := h.findQueuedRun(ctx, params.RequestID); cErr != nil {
+		return nil, cErr
+	}
+	priority := int(swag.Int64Value(params.Body.Priority))
+	if err := h.client.SetQueuedRunPriority(ctx, params.RequestID, priority); err != nil {
+		if errors.Is(err, persistence.ErrRequestIDNotFound) {
+			// The run was started meanwhile.
+			return nil, newNotFoundError(err)
+		}
+		return nil, newInternalError(err)
+	}
+	run, cErr := h.findQueuedRun(ctx, params.RequestID)
+	if cErr != nil {
+		return nil, cErr
+	}
+	return convertToQueuedRun(*run), nil
+}
+
+func (h *Handler) cancelQueuedRun(ctx context.Context, params dags.CancelQueuedRunParams) *codedError {
+	if _, cErr := h.findQueuedRun(ctx, params.RequestID); cErr != nil {
+		return cErr
+	}
+	if err := h.client.CancelQueuedRun(ctx, params.RequestID); err != nil {
+		if errors.Is(err, persistence.ErrRequestIDNotFound) {
+			return newNotFoundError(err)
+		}
+		return newInternalError(err)
+	}
+	return nil
+}
+
+// findQueuedRun returns the queued run of the request ID. The runs of the
+// DAGs in the namespaces not allowed are not found.
+func (h *Handler) findQueuedRun(ctx context.Context, requestID string) (*client.QueuedRun, *codedError) {
+	runs, err := h.client.GetQueue(ctx, h.now())
+	if err != nil {
+		return nil, newInternalError(err)
+	}
+	for _, run := range runs {
+		if run.RequestID == requestID {
+			return &run, nil
+		}
+	}
+	return nil, newNotFoundError(
+		fmt.Errorf("%w: %s", persistence.ErrRequestIDNotFound, requestID),
+	)
+}
+
+// calendarEventDuration returns the duration of the latest run, which is
+// used as the duration of the events of the DAG.
+func calendarEventDuration(status model.Status) time.Duration {
+	startedAt, err := stringutil.ParseTime(status.StartedAt)
+	if err != nil || startedAt.IsZero() {
+		return minCalendarEventDuration
+	}
+	finishedAt, err := stringutil.ParseTime(status.FinishedAt)
+	if err != nil {
+		return minCalendarEventDuration
+	}
+	return max(finishedAt.Sub(startedAt), minCalendarEventDuration)
+}
+
+// suspend suspends or resumes the DAG. The DAG is suspended with the reason
+// and until the expiry if they are given.
+func (h *Handler) suspend(ctx context.Context, params dags.PostDagActionParams) *codedError {
+	if params.Body.Value != "true" {
+		if err := h.client.ToggleSuspend(ctx, params.DagID, false); err != nil {
+			return newInternalError(err)
+		}
+		return nil
+	}
+
+	suspension := persistence.Suspension{
+		Reason:      strings.TrimSpace(params.Body.Reason),
+		SuspendedAt: time.Now(),
+	}
+	if params.Body.ExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339, params.Body.ExpiresAt)
+		if err != nil {
+			return newBadRequestError(fmt.Errorf("invalid expiry %q: %w", params.Body.ExpiresAt, errInvalidArgs))
+		}
+		if !expiresAt.After(suspension.SuspendedAt) {
+			return newBadRequestError(fmt.Errorf("the expiry must be in the future: %w", errInvalidArgs))
+		}
+		suspension.ExpiresAt = expiresAt
+	}
+	if err := h.client.Suspend(ctx, params.DagID, suspension); err != nil {
+		return newInternalError(err)
+	}
+	return nil
+}
+
+// parseStartTime returns the time to start the run given by the start time
+// or the delay, or the zero time to start it immediately.
+func parseStartTime(startAt, delay string, now time.Time) (time.Time, *codedError) {
+	switch {
+	case startAt != "" && delay != "":
+		return time.Time{}, newBadRequestError(fmt.Errorf("only one of startAt and delay can be set: %w", errInvalidArgs))
+	case delay != "":
+		d, err := time.ParseDuration(delay)
+		if err != nil || d <= 0 {
+			return time.Time{}, newBadRequestError(fmt.Errorf("invalid delay %q: %w", delay, errInvalidArgs))
+		}
+		return now.Add(d), nil
+	case startAt != "":
+		t, err := time.Parse(time.RFC3339, startAt)
+		if err != nil {
+			return time.Time{}, newBadRequestError(fmt.Errorf("invalid start time %q: %w", startAt, errInvalidArgs))
+		}
+		if !t.After(now) {
+			return time.Time{}, newBadRequestError(fmt.Errorf("the start time must be in the future: %w", errInvalidArgs))
+		}
+		return t, nil
+	}
+	return time.Time{}, nil
+}
@@ -0,0 +1,147 @@
+package dag
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/frontend/gen/restapi/operations/dags"
+	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/dagu-org/dagu/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_MarkSkipped(t *testing.T) {
+	th := test.Setup(t)
+	ctx := th.Context
+
+	dagsDir := th.Config.Paths.DAGsDir
+	require.NoError(t, os.MkdirAll(dagsDir, 0755))
+	dagFile := filepath.Join(dagsDir, "mark_skipped.yaml")
+	require.NoError(t, os.WriteFile(dagFile, []byte("steps:\n  - name: step1\n    command: \"false\"\n"), 0600))
+	dag, err := digraph.Load(ctx, dagFile)
+	require.NoError(t, err)
+
+	// Write a failed run to change the status of its step.
+	requestID := "test-mark-skipped"
+	require.NoError(t, th.HistoryStore.Open(ctx, dag.Location, time.Now(), requestID))
+	nodes := []scheduler.NodeData{{Step: dag.Steps[0], State: scheduler.NodeState{Status: scheduler.NodeStatusError}}}
+	status := model.NewStatusFactory(dag).Create(requestID, scheduler.StatusError, 0, time.Now(), model.WithNodes(nodes))
+	require.NoError(t, th.HistoryStore.Write(ctx, status))
+	require.NoError(t, th.HistoryStore.Close(ctx))
+
+	h := NewHandler(&NewHandlerArgs{Client: th.Client}).(*Handler)
+	action := "mark-skipped"
+	params := dags.PostDagActionParams{
+		DagID: "mark_skipped",
+		Body: dags.PostDagActionBody{
+			Action:    &action,
+			RequestID: requestID,
+			Step:      "step1",
+			Value:     "the input is optional",
+		},
+	}
+
+	t.Run("StepNotFound", func(t *testing.T) {
+		params := params
+		params.Body.Step = "missing"
+		_, cErr := h.postAction(ctx, params)
+		require.NotNil(t, cErr)
+		require.Equal(t, http.StatusBadRequest, cErr.Code)
+	})
+	t.Run("MarkSkipped", func(t *testing.T) {
+		_, cErr := h.postAction(ctx, params)
+		require.Nil(t, cErr)
+
+		updated, err := th.Client.GetStatusByRequestID(ctx, dag, requestID)
+		require.NoError(t, err)
+		require.Equal(t, scheduler.NodeStatusSkipped, updated.Nodes[0].Status)
+		require.Equal(t, scheduler.NodeStatusSkipped.String(), updated.Nodes[0].StatusText)
+		require.Len(t, updated.Notes, 1)
+		require.Equal(t, `Step "step1" was manually changed from failed to skipped: the input is optional`, updated.Notes[0].Text)
+		require.NotEmpty(t, updated.Notes[0].CreatedAt)
+	})
+}
+
+func TestHandler_StartIdempotencyKey(t *testing.T) {
+	th := test.Setup(t)
+	ctx := th.Context
+
+	dagsDir := th.Config.Paths.DAGsDir
+	require.NoError(t, os.MkdirAll(dagsDir, 0755))
+	dagFile := filepath.Join(dagsDir, "idempotency_key.yaml")
+	require.NoError(t, os.WriteFile(dagFile, []byte("steps:\n  - name: step1\n    command: echo 1\n"), 0600))
+
+	h := NewHandler(&NewHandlerArgs{Client: th.Client}).(*Handler)
+	action := "start"
+	start := func() string {
+		resp, cErr := h.postAction(ctx, dags.PostDagActionParams{
+			DagID: "idempotency_key",
+			Body: dags.PostDagActionBody{
+				Action:         &action,
+				Delay:          "1h",
+				IdempotencyKey: "webhook-delivery-1",
+			},
+		})
+		require.Nil(t, cErr)
+		return resp.RequestID
+	}
+
+	// The second start with the same key returns the first run.
+	requestID := start()
+	require.NotEmpty(t, requestID)
+	require.Equal(t, requestID, start())
+
+	queue, err := th.Client.GetQueue(ctx, time.Now())
+	require.NoError(t, err)
+	require.Len(t, queue, 1)
+	require.Equal(t, requestID, queue[0].RequestID)
+}
+
+func TestHandler_SearchLabels(t *testing.T) {
+	th := test.Setup(t)
+	ctx := th.Context
+
+	dagsDir := th.Config.Paths.DAGsDir
+	require.NoError(t, os.MkdirAll(dagsDir, 0755))
+	for _, name := range []string{"acme_report", "other_report"} {
+		dagFile := filepath.Join(dagsDir, name+".yaml")
+		require.NoError(t, os.WriteFile(dagFile, []byte("steps:\n  - name: report\n    command: echo report\n"), 0600))
+		dag, err := digraph.Load(ctx, dagFile)
+		require.NoError(t, err)
+
+		requestID := "test-search-" + name
+		require.NoError(t, th.HistoryStore.Open(ctx, dag.Location, time.Now(), requestID))
+		status := model.NewStatusFactory(dag).Create(
+			requestID, scheduler.StatusSuccess, 0, time.Now(),
+			model.WithLabels(map[string]string{"customer": strings.TrimSuffix(name, "_report")}),
+		)
+		require.NoError(t, th.HistoryStore.Write(ctx, status))
+		require.NoError(t, th.HistoryStore.Close(ctx))
+	}
+
+	h := NewHandler(&NewHandlerArgs{Client: th.Client}).(*Handler)
+	search := func(labels string) []string {
+		resp, cErr := h.searchDAGs(ctx, dags.SearchDagsParams{Q: "report", Labels: &labels})
+		require.Nil(t, cErr)
+		var names []string
+		for _, item := range resp.Results {
+			names = append(names, item.Name)
+		}
+		return names
+	}
+
+	require.ElementsMatch(t, []string{"acme_report", "other_report"}, search(""))
+	require.Equal(t, []string{"acme_report"}, search("customer=acme"))
+	require.Empty(t, search("customer=acme,backfill=true"))
+
+	invalid := "customer"
+	_, cErr := h.searchDAGs(ctx, dags.SearchDagsParams{Q: "report", Labels: &invalid})
+	require.NotNil(t, cErr)
+	require.Equal(t, http.StatusBadRequest, cErr.Code)
+}
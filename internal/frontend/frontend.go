@@ -1,13 +1,22 @@
 package frontend
 
 import (
+	"path/filepath"
+
 	"github.com/dagu-org/dagu/internal/client"
 	"github.com/dagu-org/dagu/internal/config"
 	"github.com/dagu-org/dagu/internal/frontend/dag"
 	"github.com/dagu-org/dagu/internal/frontend/server"
+	"github.com/dagu-org/dagu/internal/metrics"
 )
 
-func New(cfg *config.Config, cli client.Client) *server.Server {
+// ShutdownMarkerFile is the name of the shutdown marker of the server in the
+// data directory.
+const ShutdownMarkerFile = "server.shutdown"
+
+// New creates the server. The metrics of the collectors are served at
+// /metrics.
+func New(cfg *config.Config, cli client.Client, collectors ...metrics.Collector) *server.Server {
 	var hs []server.Handler
 
 	hs = append(hs, dag.NewHandler(
@@ -16,6 +25,9 @@ func New(cfg *config.Config, cli client.Client) *server.Server {
 			LogEncodingCharset: cfg.UI.LogEncodingCharset,
 			RemoteNodes:        cfg.RemoteNodes,
 			ApiBasePath:        cfg.APIBaseURL,
+			Location:           cfg.Location,
+			Timezone:           cfg.TZ,
+			Paths:              cfg.Paths,
 		},
 	))
 
@@ -33,15 +45,26 @@ func New(cfg *config.Config, cli client.Client) *server.Server {
 		NavbarColor:           cfg.UI.NavbarColor,
 		NavbarTitle:           cfg.UI.NavbarTitle,
 		MaxDashboardPageLimit: cfg.UI.MaxDashboardPageLimit,
+		BasePath:              cfg.BasePath,
 		APIBaseURL:            cfg.APIBaseURL,
 		TimeZone:              cfg.TZ,
 		RemoteNodes:           remoteNodes,
+		Client:                cli,
+		GracePeriod:           cfg.ShutdownGracePeriod,
+		ShutdownMarker:        filepath.Join(cfg.Paths.DataDir, ShutdownMarkerFile),
+		Metrics:               metrics.Handler(collectors...),
 	}
 
 	if cfg.Auth.Token.Enabled {
 		serverParams.AuthToken = &server.AuthToken{
 			Token: cfg.Auth.Token.Value,
 		}
+		for _, t := range cfg.Auth.Token.Tokens {
+			serverParams.AuthToken.Tokens = append(serverParams.AuthToken.Tokens, server.ScopedToken{
+				Token:      t.Value,
+				Namespaces: t.Namespaces,
+			})
+		}
 	}
 
 	if cfg.Auth.Basic.Enabled {
@@ -49,6 +72,13 @@ func New(cfg *config.Config, cli client.Client) *server.Server {
 			Username: cfg.Auth.Basic.Username,
 			Password: cfg.Auth.Basic.Password,
 		}
+		for _, u := range cfg.Auth.Basic.Users {
+			serverParams.BasicAuth.Users = append(serverParams.BasicAuth.Users, server.BasicAuthUser{
+				Username:   u.Username,
+				Password:   u.Password,
+				Namespaces: u.Namespaces,
+			})
+		}
 	}
 
 	return server.New(serverParams)
@@ -19,6 +19,9 @@ import (
 // swagger:model ApiError
 type APIError struct {
 
+	// code
+	Code ErrorCode `json:"code,omitempty"`
+
 	// detailed message
 	// Required: true
 	DetailedMessage *string `json:"detailedMessage"`
@@ -32,6 +35,10 @@ type APIError struct {
 func (m *APIError) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateCode(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDetailedMessage(formats); err != nil {
 		res = append(res, err)
 	}
@@ -46,6 +53,23 @@ func (m *APIError) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *APIError) validateCode(formats strfmt.Registry) error {
+	if swag.IsZero(m.Code) { // not required
+		return nil
+	}
+
+	if err := m.Code.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("code")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("code")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *APIError) validateDetailedMessage(formats strfmt.Registry) error {
 
 	if err := validate.Required("detailedMessage", "body", m.DetailedMessage); err != nil {
@@ -64,8 +88,35 @@ func (m *APIError) validateMessage(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this Api error based on context it is used
+// ContextValidate validate this Api error based on the context it is used
 func (m *APIError) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCode(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIError) contextValidateCode(ctx context.Context, formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Code) { // not required
+		return nil
+	}
+
+	if err := m.Code.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("code")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("code")
+		}
+		return err
+	}
+
 	return nil
 }
 
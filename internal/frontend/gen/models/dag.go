@@ -36,6 +36,9 @@ type Dag struct {
 	// Required: true
 	Name *string `json:"Name"`
 
+	// The namespace of the DAG, which is empty for the DAGs not in any namespace.
+	Namespace string `json:"Namespace,omitempty"`
+
 	// params
 	// Required: true
 	Params []string `json:"Params"`
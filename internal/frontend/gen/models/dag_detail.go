@@ -64,6 +64,9 @@ type DagDetail struct {
 	// Required: true
 	Name *string `json:"Name"`
 
+	// namespace
+	Namespace string `json:"Namespace,omitempty"`
+
 	// params
 	// Required: true
 	Params []string `json:"Params"`
@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DagFailuresResponse dag failures response
+//
+// swagger:model dagFailuresResponse
+type DagFailuresResponse struct {
+
+	// The number of the analyzed runs that failed.
+	// Required: true
+	FailedRuns *int64 `json:"FailedRuns"`
+
+	// The number of the analyzed runs.
+	// Required: true
+	Runs *int64 `json:"Runs"`
+
+	// The failed steps, the most failed first.
+	// Required: true
+	Steps []*StepFailures `json:"Steps"`
+}
+
+// Validate validates this dag failures response
+func (m *DagFailuresResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFailedRuns(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRuns(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSteps(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DagFailuresResponse) validateFailedRuns(formats strfmt.Registry) error {
+
+	if err := validate.Required("FailedRuns", "body", m.FailedRuns); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagFailuresResponse) validateRuns(formats strfmt.Registry) error {
+
+	if err := validate.Required("Runs", "body", m.Runs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagFailuresResponse) validateSteps(formats strfmt.Registry) error {
+
+	if err := validate.Required("Steps", "body", m.Steps); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Steps); i++ {
+		if swag.IsZero(m.Steps[i]) { // not required
+			continue
+		}
+
+		if m.Steps[i] != nil {
+			if err := m.Steps[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Steps" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Steps" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this dag failures response based on the context it is used
+func (m *DagFailuresResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateSteps(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DagFailuresResponse) contextValidateSteps(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Steps); i++ {
+
+		if m.Steps[i] != nil {
+
+			if swag.IsZero(m.Steps[i]) { // not required
+				return nil
+			}
+
+			if err := m.Steps[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Steps" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Steps" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DagFailuresResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DagFailuresResponse) UnmarshalBinary(b []byte) error {
+	var res DagFailuresResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -39,6 +39,9 @@ type DagListItem struct {
 	// Required: true
 	File *string `json:"File"`
 
+	// remote node
+	RemoteNode string `json:"RemoteNode,omitempty"`
+
 	// status
 	// Required: true
 	Status *DagStatus `json:"Status"`
@@ -46,6 +49,9 @@ type DagListItem struct {
 	// suspended
 	// Required: true
 	Suspended *bool `json:"Suspended"`
+
+	// suspension
+	Suspension *Suspension `json:"Suspension,omitempty"`
 }
 
 // Validate validates this dag list item
@@ -80,6 +86,10 @@ func (m *DagListItem) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSuspension(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -171,6 +181,25 @@ func (m *DagListItem) validateSuspended(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *DagListItem) validateSuspension(formats strfmt.Registry) error {
+	if swag.IsZero(m.Suspension) { // not required
+		return nil
+	}
+
+	if m.Suspension != nil {
+		if err := m.Suspension.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Suspension")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("Suspension")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this dag list item based on the context it is used
 func (m *DagListItem) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -183,6 +212,10 @@ func (m *DagListItem) ContextValidate(ctx context.Context, formats strfmt.Regist
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateSuspension(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -223,6 +256,27 @@ func (m *DagListItem) contextValidateStatus(ctx context.Context, formats strfmt.
 	return nil
 }
 
+func (m *DagListItem) contextValidateSuspension(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Suspension != nil {
+
+		if swag.IsZero(m.Suspension) { // not required
+			return nil
+		}
+
+		if err := m.Suspension.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Suspension")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("Suspension")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *DagListItem) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -23,6 +23,9 @@ type DagStatus struct {
 	// Required: true
 	FinishedAt *string `json:"FinishedAt"`
 
+	// labels
+	Labels map[string]string `json:"Labels,omitempty"`
+
 	// log
 	// Required: true
 	Log *string `json:"Log"`
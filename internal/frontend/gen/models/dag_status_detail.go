@@ -24,6 +24,12 @@ type DagStatusDetail struct {
 	// Required: true
 	FinishedAt *string `json:"FinishedAt"`
 
+	// Time the agent running the DAG was last alive
+	Heartbeat string `json:"Heartbeat,omitempty"`
+
+	// labels
+	Labels map[string]string `json:"Labels,omitempty"`
+
 	// log
 	// Required: true
 	Log *string `json:"Log"`
@@ -36,6 +42,9 @@ type DagStatusDetail struct {
 	// Required: true
 	Nodes []*StatusNode `json:"Nodes"`
 
+	// notes
+	Notes []*RunNote `json:"Notes"`
+
 	// on cancel
 	// Required: true
 	OnCancel *StatusNode `json:"OnCancel"`
@@ -52,6 +61,9 @@ type DagStatusDetail struct {
 	// Required: true
 	OnSuccess *StatusNode `json:"OnSuccess"`
 
+	// on timeout
+	OnTimeout *StatusNode `json:"OnTimeout,omitempty"`
+
 	// params
 	// Required: true
 	Params *string `json:"Params"`
@@ -97,6 +109,10 @@ func (m *DagStatusDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateNotes(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateOnCancel(formats); err != nil {
 		res = append(res, err)
 	}
@@ -113,6 +129,10 @@ func (m *DagStatusDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateOnTimeout(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateParams(formats); err != nil {
 		res = append(res, err)
 	}
@@ -197,6 +217,32 @@ func (m *DagStatusDetail) validateNodes(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *DagStatusDetail) validateNotes(formats strfmt.Registry) error {
+	if swag.IsZero(m.Notes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Notes); i++ {
+		if swag.IsZero(m.Notes[i]) { // not required
+			continue
+		}
+
+		if m.Notes[i] != nil {
+			if err := m.Notes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Notes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Notes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *DagStatusDetail) validateOnCancel(formats strfmt.Registry) error {
 
 	if err := validate.Required("OnCancel", "body", m.OnCancel); err != nil {
@@ -277,6 +323,25 @@ func (m *DagStatusDetail) validateOnSuccess(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *DagStatusDetail) validateOnTimeout(formats strfmt.Registry) error {
+	if swag.IsZero(m.OnTimeout) { // not required
+		return nil
+	}
+
+	if m.OnTimeout != nil {
+		if err := m.OnTimeout.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("OnTimeout")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("OnTimeout")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *DagStatusDetail) validateParams(formats strfmt.Registry) error {
 
 	if err := validate.Required("Params", "body", m.Params); err != nil {
@@ -339,6 +404,10 @@ func (m *DagStatusDetail) ContextValidate(ctx context.Context, formats strfmt.Re
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateNotes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateOnCancel(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -355,6 +424,10 @@ func (m *DagStatusDetail) ContextValidate(ctx context.Context, formats strfmt.Re
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateOnTimeout(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -386,6 +459,31 @@ func (m *DagStatusDetail) contextValidateNodes(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *DagStatusDetail) contextValidateNotes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Notes); i++ {
+
+		if m.Notes[i] != nil {
+
+			if swag.IsZero(m.Notes[i]) { // not required
+				return nil
+			}
+
+			if err := m.Notes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Notes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Notes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *DagStatusDetail) contextValidateOnCancel(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.OnCancel != nil {
@@ -454,6 +552,27 @@ func (m *DagStatusDetail) contextValidateOnSuccess(ctx context.Context, formats
 	return nil
 }
 
+func (m *DagStatusDetail) contextValidateOnTimeout(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.OnTimeout != nil {
+
+		if swag.IsZero(m.OnTimeout) { // not required
+			return nil
+		}
+
+		if err := m.OnTimeout.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("OnTimeout")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("OnTimeout")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *DagStatusDetail) MarshalBinary() ([]byte, error) {
 	if m == nil {
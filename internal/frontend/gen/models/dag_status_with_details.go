@@ -46,6 +46,9 @@ type DagStatusWithDetails struct {
 	// suspended
 	// Required: true
 	Suspended *bool `json:"Suspended"`
+
+	// suspension
+	Suspension *Suspension `json:"Suspension,omitempty"`
 }
 
 // Validate validates this dag status with details
@@ -80,6 +83,10 @@ func (m *DagStatusWithDetails) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSuspension(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -171,6 +178,25 @@ func (m *DagStatusWithDetails) validateSuspended(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *DagStatusWithDetails) validateSuspension(formats strfmt.Registry) error {
+	if swag.IsZero(m.Suspension) { // not required
+		return nil
+	}
+
+	if m.Suspension != nil {
+		if err := m.Suspension.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Suspension")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("Suspension")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this dag status with details based on the context it is used
 func (m *DagStatusWithDetails) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -183,6 +209,10 @@ func (m *DagStatusWithDetails) ContextValidate(ctx context.Context, formats strf
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateSuspension(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -223,6 +253,27 @@ func (m *DagStatusWithDetails) contextValidateStatus(ctx context.Context, format
 	return nil
 }
 
+func (m *DagStatusWithDetails) contextValidateSuspension(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Suspension != nil {
+
+		if swag.IsZero(m.Suspension) { // not required
+			return nil
+		}
+
+		if err := m.Suspension.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Suspension")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("Suspension")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *DagStatusWithDetails) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -0,0 +1,111 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DelayedStart delayed start
+//
+// swagger:model delayedStart
+type DelayedStart struct {
+
+	// labels
+	Labels map[string]string `json:"Labels,omitempty"`
+
+	// params
+	Params string `json:"Params,omitempty"`
+
+	// registered at
+	// Required: true
+	RegisteredAt *string `json:"RegisteredAt"`
+
+	// request Id
+	// Required: true
+	RequestID *string `json:"RequestId"`
+
+	// start at
+	// Required: true
+	StartAt *string `json:"StartAt"`
+}
+
+// Validate validates this delayed start
+func (m *DelayedStart) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRegisteredAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRequestID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DelayedStart) validateRegisteredAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("RegisteredAt", "body", m.RegisteredAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DelayedStart) validateRequestID(formats strfmt.Registry) error {
+
+	if err := validate.Required("RequestId", "body", m.RequestID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DelayedStart) validateStartAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("StartAt", "body", m.StartAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this delayed start based on context it is used
+func (m *DelayedStart) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DelayedStart) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DelayedStart) UnmarshalBinary(b []byte) error {
+	var res DelayedStart
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
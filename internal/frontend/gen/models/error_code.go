@@ -0,0 +1,99 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// ErrorCode The stable code of the error to tell the errors apart without the messages.
+//
+// swagger:model ErrorCode
+type ErrorCode string
+
+func NewErrorCode(value ErrorCode) *ErrorCode {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated ErrorCode.
+func (m ErrorCode) Pointer() *ErrorCode {
+	return &m
+}
+
+const (
+
+	// ErrorCodeBADREQUEST captures enum value "BAD_REQUEST"
+	ErrorCodeBADREQUEST ErrorCode = "BAD_REQUEST"
+
+	// ErrorCodeFORBIDDEN captures enum value "FORBIDDEN"
+	ErrorCodeFORBIDDEN ErrorCode = "FORBIDDEN"
+
+	// ErrorCodeNOTFOUND captures enum value "NOT_FOUND"
+	ErrorCodeNOTFOUND ErrorCode = "NOT_FOUND"
+
+	// ErrorCodeDAGNOTFOUND captures enum value "DAG_NOT_FOUND"
+	ErrorCodeDAGNOTFOUND ErrorCode = "DAG_NOT_FOUND"
+
+	// ErrorCodeDAGRUNNING captures enum value "DAG_RUNNING"
+	ErrorCodeDAGRUNNING ErrorCode = "DAG_RUNNING"
+
+	// ErrorCodeSPECINVALID captures enum value "SPEC_INVALID"
+	ErrorCodeSPECINVALID ErrorCode = "SPEC_INVALID"
+
+	// ErrorCodeSTOREUNAVAILABLE captures enum value "STORE_UNAVAILABLE"
+	ErrorCodeSTOREUNAVAILABLE ErrorCode = "STORE_UNAVAILABLE"
+
+	// ErrorCodeREMOTENODEUNAVAILABLE captures enum value "REMOTE_NODE_UNAVAILABLE"
+	ErrorCodeREMOTENODEUNAVAILABLE ErrorCode = "REMOTE_NODE_UNAVAILABLE"
+
+	// ErrorCodeINTERNALERROR captures enum value "INTERNAL_ERROR"
+	ErrorCodeINTERNALERROR ErrorCode = "INTERNAL_ERROR"
+)
+
+// for schema
+var errorCodeEnum []interface{}
+
+func init() {
+	var res []ErrorCode
+	if err := json.Unmarshal([]byte(`["BAD_REQUEST","FORBIDDEN","NOT_FOUND","DAG_NOT_FOUND","DAG_RUNNING","SPEC_INVALID","STORE_UNAVAILABLE","REMOTE_NODE_UNAVAILABLE","INTERNAL_ERROR"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		errorCodeEnum = append(errorCodeEnum, v)
+	}
+}
+
+func (m ErrorCode) validateErrorCodeEnum(path, location string, value ErrorCode) error {
+	if err := validate.EnumCase(path, location, value, errorCodeEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this error code
+func (m ErrorCode) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateErrorCodeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this error code based on context it is used
+func (m ErrorCode) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,88 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ErrorCount error count
+//
+// swagger:model errorCount
+type ErrorCount struct {
+
+	// count
+	// Required: true
+	Count *int64 `json:"Count"`
+
+	// error
+	// Required: true
+	Error *string `json:"Error"`
+}
+
+// Validate validates this error count
+func (m *ErrorCount) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateError(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ErrorCount) validateCount(formats strfmt.Registry) error {
+
+	if err := validate.Required("Count", "body", m.Count); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ErrorCount) validateError(formats strfmt.Registry) error {
+
+	if err := validate.Required("Error", "body", m.Error); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this error count based on context it is used
+func (m *ErrorCount) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ErrorCount) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ErrorCount) UnmarshalBinary(b []byte) error {
+	var res ErrorCount
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -27,6 +28,9 @@ type GetDagDetailsResponse struct {
 	// Required: true
 	Definition *string `json:"Definition"`
 
+	// The runs registered to be started later, the earliest first. Only set in the status tab.
+	DelayedStarts []*DelayedStart `json:"DelayedStarts"`
+
 	// errors
 	// Required: true
 	Errors []string `json:"Errors"`
@@ -72,6 +76,10 @@ func (m *GetDagDetailsResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDelayedStarts(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateErrors(formats); err != nil {
 		res = append(res, err)
 	}
@@ -139,6 +147,32 @@ func (m *GetDagDetailsResponse) validateDefinition(formats strfmt.Registry) erro
 	return nil
 }
 
+func (m *GetDagDetailsResponse) validateDelayedStarts(formats strfmt.Registry) error {
+	if swag.IsZero(m.DelayedStarts) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.DelayedStarts); i++ {
+		if swag.IsZero(m.DelayedStarts[i]) { // not required
+			continue
+		}
+
+		if m.DelayedStarts[i] != nil {
+			if err := m.DelayedStarts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("DelayedStarts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("DelayedStarts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *GetDagDetailsResponse) validateErrors(formats strfmt.Registry) error {
 
 	if err := validate.Required("Errors", "body", m.Errors); err != nil {
@@ -252,6 +286,10 @@ func (m *GetDagDetailsResponse) ContextValidate(ctx context.Context, formats str
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateDelayedStarts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateLogData(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -287,6 +325,31 @@ func (m *GetDagDetailsResponse) contextValidateDAG(ctx context.Context, formats
 	return nil
 }
 
+func (m *GetDagDetailsResponse) contextValidateDelayedStarts(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.DelayedStarts); i++ {
+
+		if m.DelayedStarts[i] != nil {
+
+			if swag.IsZero(m.DelayedStarts[i]) { // not required
+				return nil
+			}
+
+			if err := m.DelayedStarts[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("DelayedStarts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("DelayedStarts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *GetDagDetailsResponse) contextValidateLogData(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.LogData != nil {
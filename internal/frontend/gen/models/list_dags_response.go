@@ -35,6 +35,9 @@ type ListDagsResponse struct {
 	// page count
 	// Required: true
 	PageCount *int64 `json:"PageCount"`
+
+	// The time the list was read. Pass it as since to get only the DAGs that changed afterwards.
+	Timestamp string `json:"Timestamp,omitempty"`
 }
 
 // Validate validates this list dags response
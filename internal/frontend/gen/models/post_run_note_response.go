@@ -0,0 +1,124 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostRunNoteResponse post run note response
+//
+// swagger:model postRunNoteResponse
+type PostRunNoteResponse struct {
+
+	// notes
+	// Required: true
+	Notes []*RunNote `json:"Notes"`
+}
+
+// Validate validates this post run note response
+func (m *PostRunNoteResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateNotes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostRunNoteResponse) validateNotes(formats strfmt.Registry) error {
+
+	if err := validate.Required("Notes", "body", m.Notes); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Notes); i++ {
+		if swag.IsZero(m.Notes[i]) { // not required
+			continue
+		}
+
+		if m.Notes[i] != nil {
+			if err := m.Notes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Notes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Notes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post run note response based on the context it is used
+func (m *PostRunNoteResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateNotes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostRunNoteResponse) contextValidateNotes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Notes); i++ {
+
+		if m.Notes[i] != nil {
+
+			if swag.IsZero(m.Notes[i]) { // not required
+				return nil
+			}
+
+			if err := m.Notes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Notes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Notes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostRunNoteResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostRunNoteResponse) UnmarshalBinary(b []byte) error {
+	var res PostRunNoteResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,122 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ResourceUsage The resource usage of the command of the step.
+//
+// swagger:model resourceUsage
+type ResourceUsage struct {
+
+	// The maximum resident set size in bytes.
+	// Required: true
+	MaxRSSBytes *int64 `json:"MaxRSSBytes"`
+
+	// The CPU time spent in the kernel mode in milliseconds.
+	// Required: true
+	SystemCPUTimeMs *int64 `json:"SystemCPUTimeMs"`
+
+	// The CPU time spent in the user mode in milliseconds.
+	// Required: true
+	UserCPUTimeMs *int64 `json:"UserCPUTimeMs"`
+
+	// The elapsed time of the command in milliseconds.
+	// Required: true
+	WallTimeMs *int64 `json:"WallTimeMs"`
+}
+
+// Validate validates this resource usage
+func (m *ResourceUsage) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMaxRSSBytes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSystemCPUTimeMs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUserCPUTimeMs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateWallTimeMs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ResourceUsage) validateMaxRSSBytes(formats strfmt.Registry) error {
+
+	if err := validate.Required("MaxRSSBytes", "body", m.MaxRSSBytes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ResourceUsage) validateSystemCPUTimeMs(formats strfmt.Registry) error {
+
+	if err := validate.Required("SystemCPUTimeMs", "body", m.SystemCPUTimeMs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ResourceUsage) validateUserCPUTimeMs(formats strfmt.Registry) error {
+
+	if err := validate.Required("UserCPUTimeMs", "body", m.UserCPUTimeMs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ResourceUsage) validateWallTimeMs(formats strfmt.Registry) error {
+
+	if err := validate.Required("WallTimeMs", "body", m.WallTimeMs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this resource usage based on context it is used
+func (m *ResourceUsage) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ResourceUsage) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ResourceUsage) UnmarshalBinary(b []byte) error {
+	var res ResourceUsage
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,254 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// RunLineageResponse run lineage response
+//
+// swagger:model runLineageResponse
+type RunLineageResponse struct {
+
+	// The runs the run comes from, the origin first.
+	// Required: true
+	Ancestors []*LineageRun `json:"Ancestors"`
+
+	// The runs triggered by the run and by its descendants, in the order they started.
+	// Required: true
+	Descendants []*LineageRun `json:"Descendants"`
+
+	// Whether the first ancestor is the origin. It's false if an ancestor is no longer in the history.
+	// Required: true
+	OriginFound *bool `json:"OriginFound"`
+
+	// run
+	// Required: true
+	Run *LineageRun `json:"Run"`
+}
+
+// Validate validates this run lineage response
+func (m *RunLineageResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAncestors(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDescendants(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOriginFound(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRun(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RunLineageResponse) validateAncestors(formats strfmt.Registry) error {
+
+	if err := validate.Required("Ancestors", "body", m.Ancestors); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Ancestors); i++ {
+		if swag.IsZero(m.Ancestors[i]) { // not required
+			continue
+		}
+
+		if m.Ancestors[i] != nil {
+			if err := m.Ancestors[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Ancestors" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Ancestors" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *RunLineageResponse) validateDescendants(formats strfmt.Registry) error {
+
+	if err := validate.Required("Descendants", "body", m.Descendants); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Descendants); i++ {
+		if swag.IsZero(m.Descendants[i]) { // not required
+			continue
+		}
+
+		if m.Descendants[i] != nil {
+			if err := m.Descendants[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Descendants" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Descendants" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *RunLineageResponse) validateOriginFound(formats strfmt.Registry) error {
+
+	if err := validate.Required("OriginFound", "body", m.OriginFound); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RunLineageResponse) validateRun(formats strfmt.Registry) error {
+
+	if err := validate.Required("Run", "body", m.Run); err != nil {
+		return err
+	}
+
+	if m.Run != nil {
+		if err := m.Run.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Run")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("Run")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this run lineage response based on the context it is used
+func (m *RunLineageResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAncestors(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDescendants(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRun(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RunLineageResponse) contextValidateAncestors(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Ancestors); i++ {
+
+		if m.Ancestors[i] != nil {
+
+			if swag.IsZero(m.Ancestors[i]) { // not required
+				return nil
+			}
+
+			if err := m.Ancestors[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Ancestors" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Ancestors" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *RunLineageResponse) contextValidateDescendants(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Descendants); i++ {
+
+		if m.Descendants[i] != nil {
+
+			if swag.IsZero(m.Descendants[i]) { // not required
+				return nil
+			}
+
+			if err := m.Descendants[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Descendants" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Descendants" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *RunLineageResponse) contextValidateRun(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Run != nil {
+
+		if err := m.Run.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Run")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("Run")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RunLineageResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RunLineageResponse) UnmarshalBinary(b []byte) error {
+	var res RunLineageResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -19,9 +19,21 @@ import (
 // swagger:model schedule
 type Schedule struct {
 
+	// The cron expression without the time zone prefix.
+	Cron string `json:"Cron,omitempty"`
+
 	// expression
 	// Required: true
 	Expression *string `json:"Expression"`
+
+	// The next fire time in RFC 3339.
+	NextRun string `json:"NextRun,omitempty"`
+
+	// The last fire time in RFC 3339.
+	PrevRun string `json:"PrevRun,omitempty"`
+
+	// The time zone the schedule is evaluated in.
+	Timezone string `json:"Timezone,omitempty"`
 }
 
 // Validate validates this schedule
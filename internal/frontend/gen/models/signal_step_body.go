@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SignalStepBody signal step body
+//
+// swagger:model signalStepBody
+type SignalStepBody struct {
+
+	// The name of the signal, e.g. SIGHUP or SIGUSR1.
+	// Required: true
+	Signal *string `json:"signal"`
+}
+
+// Validate validates this signal step body
+func (m *SignalStepBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSignal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SignalStepBody) validateSignal(formats strfmt.Registry) error {
+
+	if err := validate.Required("signal", "body", m.Signal); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this signal step body based on context it is used
+func (m *SignalStepBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SignalStepBody) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SignalStepBody) UnmarshalBinary(b []byte) error {
+	var res SignalStepBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
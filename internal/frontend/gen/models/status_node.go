@@ -19,6 +19,9 @@ import (
 // swagger:model statusNode
 type StatusNode struct {
 
+	// artifacts
+	Artifacts []string `json:"Artifacts"`
+
 	// done count
 	// Required: true
 	DoneCount *int64 `json:"DoneCount"`
@@ -35,10 +38,16 @@ type StatusNode struct {
 	// Required: true
 	Log *string `json:"Log"`
 
+	// resource usage
+	ResourceUsage *ResourceUsage `json:"ResourceUsage,omitempty"`
+
 	// retry count
 	// Required: true
 	RetryCount *int64 `json:"RetryCount"`
 
+	// Whether the step was requested to be skipped in the run.
+	SkipRequested bool `json:"SkipRequested,omitempty"`
+
 	// started at
 	// Required: true
 	StartedAt *string `json:"StartedAt"`
@@ -54,6 +63,9 @@ type StatusNode struct {
 	// step
 	// Required: true
 	Step *StepObject `json:"Step"`
+
+	// Request ID of the run of the sub DAG started by the step.
+	SubRequestID string `json:"SubRequestId,omitempty"`
 }
 
 // Validate validates this status node
@@ -76,6 +88,10 @@ func (m *StatusNode) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateResourceUsage(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRetryCount(formats); err != nil {
 		res = append(res, err)
 	}
@@ -138,6 +154,25 @@ func (m *StatusNode) validateLog(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *StatusNode) validateResourceUsage(formats strfmt.Registry) error {
+	if swag.IsZero(m.ResourceUsage) { // not required
+		return nil
+	}
+
+	if m.ResourceUsage != nil {
+		if err := m.ResourceUsage.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("ResourceUsage")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("ResourceUsage")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *StatusNode) validateRetryCount(formats strfmt.Registry) error {
 
 	if err := validate.Required("RetryCount", "body", m.RetryCount); err != nil {
@@ -198,6 +233,10 @@ func (m *StatusNode) validateStep(formats strfmt.Registry) error {
 func (m *StatusNode) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateResourceUsage(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateStep(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -208,6 +247,27 @@ func (m *StatusNode) ContextValidate(ctx context.Context, formats strfmt.Registr
 	return nil
 }
 
+func (m *StatusNode) contextValidateResourceUsage(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ResourceUsage != nil {
+
+		if swag.IsZero(m.ResourceUsage) { // not required
+			return nil
+		}
+
+		if err := m.ResourceUsage.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("ResourceUsage")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("ResourceUsage")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *StatusNode) contextValidateStep(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Step != nil {
@@ -0,0 +1,224 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// StepTrend The durations and the resource usage of a step across its successful runs.
+//
+// swagger:model stepTrend
+type StepTrend struct {
+
+	// average CPU time ms
+	// Required: true
+	AverageCPUTimeMs *int64 `json:"AverageCPUTimeMs"`
+
+	// average duration ms
+	// Required: true
+	AverageDurationMs *int64 `json:"AverageDurationMs"`
+
+	// The median duration of the successful runs before the latest one.
+	// Required: true
+	BaselineDurationMs *int64 `json:"BaselineDurationMs"`
+
+	// latest duration ms
+	// Required: true
+	LatestDurationMs *int64 `json:"LatestDurationMs"`
+
+	// latest max r s s bytes
+	// Required: true
+	LatestMaxRSSBytes *int64 `json:"LatestMaxRSSBytes"`
+
+	// max r s s bytes
+	// Required: true
+	MaxRSSBytes *int64 `json:"MaxRSSBytes"`
+
+	// name
+	// Required: true
+	Name *string `json:"Name"`
+
+	// The latest duration divided by the baseline.
+	// Required: true
+	Ratio *float64 `json:"Ratio"`
+
+	// regressed
+	// Required: true
+	Regressed *bool `json:"Regressed"`
+
+	// The number of the successful runs of the step.
+	// Required: true
+	Runs *int64 `json:"Runs"`
+}
+
+// Validate validates this step trend
+func (m *StepTrend) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAverageCPUTimeMs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAverageDurationMs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateBaselineDurationMs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLatestDurationMs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLatestMaxRSSBytes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxRSSBytes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRatio(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRegressed(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRuns(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StepTrend) validateAverageCPUTimeMs(formats strfmt.Registry) error {
+
+	if err := validate.Required("AverageCPUTimeMs", "body", m.AverageCPUTimeMs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StepTrend) validateAverageDurationMs(formats strfmt.Registry) error {
+
+	if err := validate.Required("AverageDurationMs", "body", m.AverageDurationMs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StepTrend) validateBaselineDurationMs(formats strfmt.Registry) error {
+
+	if err := validate.Required("BaselineDurationMs", "body", m.BaselineDurationMs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StepTrend) validateLatestDurationMs(formats strfmt.Registry) error {
+
+	if err := validate.Required("LatestDurationMs", "body", m.LatestDurationMs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StepTrend) validateLatestMaxRSSBytes(formats strfmt.Registry) error {
+
+	if err := validate.Required("LatestMaxRSSBytes", "body", m.LatestMaxRSSBytes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StepTrend) validateMaxRSSBytes(formats strfmt.Registry) error {
+
+	if err := validate.Required("MaxRSSBytes", "body", m.MaxRSSBytes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StepTrend) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("Name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StepTrend) validateRatio(formats strfmt.Registry) error {
+
+	if err := validate.Required("Ratio", "body", m.Ratio); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StepTrend) validateRegressed(formats strfmt.Registry) error {
+
+	if err := validate.Required("Regressed", "body", m.Regressed); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StepTrend) validateRuns(formats strfmt.Registry) error {
+
+	if err := validate.Required("Runs", "body", m.Runs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this step trend based on context it is used
+func (m *StepTrend) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StepTrend) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StepTrend) UnmarshalBinary(b []byte) error {
+	var res StepTrend
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
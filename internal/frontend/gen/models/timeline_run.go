@@ -0,0 +1,142 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TimelineRun timeline run
+//
+// swagger:model timelineRun
+type TimelineRun struct {
+
+	// The ID of the DAG.
+	// Required: true
+	DAG *string `json:"DAG"`
+
+	// What the scheduler does, "start", "stop" or "restart".
+	// Required: true
+	Operation *string `json:"Operation"`
+
+	// Why the run is expected to be skipped.
+	Reason string `json:"Reason,omitempty"`
+
+	// The cron expression of the schedule.
+	// Required: true
+	Schedule *string `json:"Schedule"`
+
+	// Whether the run is expected to be skipped.
+	// Required: true
+	Skipped *bool `json:"Skipped"`
+
+	// The scheduled time in RFC 3339.
+	// Required: true
+	Time *string `json:"Time"`
+}
+
+// Validate validates this timeline run
+func (m *TimelineRun) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDAG(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOperation(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSchedule(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSkipped(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TimelineRun) validateDAG(formats strfmt.Registry) error {
+
+	if err := validate.Required("DAG", "body", m.DAG); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TimelineRun) validateOperation(formats strfmt.Registry) error {
+
+	if err := validate.Required("Operation", "body", m.Operation); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TimelineRun) validateSchedule(formats strfmt.Registry) error {
+
+	if err := validate.Required("Schedule", "body", m.Schedule); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TimelineRun) validateSkipped(formats strfmt.Registry) error {
+
+	if err := validate.Required("Skipped", "body", m.Skipped); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TimelineRun) validateTime(formats strfmt.Registry) error {
+
+	if err := validate.Required("Time", "body", m.Time); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this timeline run based on context it is used
+func (m *TimelineRun) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TimelineRun) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TimelineRun) UnmarshalBinary(b []byte) error {
+	var res TimelineRun
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -40,6 +40,8 @@ func configureAPI(api *operations.DaguAPI) http.Handler {
 
 	api.JSONProducer = runtime.JSONProducer()
 
+	api.TextCalendarProducer = runtime.ByteStreamProducer()
+
 	if api.DagsListDagsHandler == nil {
 		api.DagsListDagsHandler = dags.ListDagsHandlerFunc(
 			func(params dags.ListDagsParams) middleware.Responder {
@@ -16,7 +16,10 @@
 //	  - application/json
 //
 //	Produces:
+//	  - application/octet-stream
+//	  - image/svg+xml
 //	  - application/json
+//	  - text/calendar
 //
 // swagger:meta
 package restapi
@@ -39,6 +39,51 @@ func init() {
   "host": "localhost:8080",
   "basePath": "/api/v1",
   "paths": {
+    "/calendar.ics": {
+      "get": {
+        "description": "Exports the scheduled runs of the DAGs as an iCalendar feed.",
+        "produces": [
+          "text/calendar",
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "getScheduleCalendar",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "searchName",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "searchTag",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "The number of the days from now to export the scheduled runs.",
+            "name": "days",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/dags": {
       "get": {
         "description": "Returns a list of DAGs.",
@@ -69,6 +114,18 @@ func init() {
             "type": "string",
             "name": "searchTag",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Returns only the DAGs that changed since the time (RFC3339), usually the Timestamp of the previous response.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Returns only the DAGs in the namespace.",
+            "name": "namespace",
+            "in": "query"
           }
         ],
         "responses": {
@@ -163,6 +220,12 @@ func init() {
             "type": "string",
             "name": "step",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Filters the history by labels (e.g. customer=acme,backfill=true).",
+            "name": "labels",
+            "in": "query"
           }
         ],
         "responses": {
@@ -214,19 +277,50 @@ func init() {
                     "retry",
                     "mark-success",
                     "mark-failed",
+                    "mark-skipped",
                     "save",
                     "rename"
                   ]
                 },
+                "delay": {
+                  "description": "The delay after which the scheduler starts the run, e.g. \"30m\".",
+                  "type": "string"
+                },
+                "expiresAt": {
+                  "description": "The time in RFC 3339 the suspension is lifted.",
+                  "type": "string"
+                },
+                "from": {
+                  "description": "The name of the step to start the run from. The steps it depends on are skipped.",
+                  "type": "string"
+                },
+                "idempotencyKey": {
+                  "type": "string"
+                },
+                "labels": {
+                  "type": "string"
+                },
                 "params": {
                   "type": "string"
                 },
+                "reason": {
+                  "description": "The reason of the suspension.",
+                  "type": "string"
+                },
                 "requestId": {
                   "type": "string"
                 },
+                "startAt": {
+                  "description": "The time in RFC 3339 the scheduler starts the run. The run is started immediately if neither startAt nor delay is set.",
+                  "type": "string"
+                },
                 "step": {
                   "type": "string"
                 },
+                "target": {
+                  "description": "The name of the step to run with the steps it depends on. The other steps are skipped.",
+                  "type": "string"
+                },
                 "value": {
                   "type": "string"
                 }
@@ -279,29 +373,41 @@ func init() {
         }
       }
     },
-    "/search": {
+    "/dags/{dagId}/analytics": {
       "get": {
-        "description": "Searches for DAGs.",
+        "description": "Returns the duration and resource usage trends of the recent runs of a DAG.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "dags"
         ],
-        "operationId": "searchDags",
+        "operationId": "getDagAnalytics",
         "parameters": [
           {
             "type": "string",
-            "name": "q",
-            "in": "query",
+            "name": "dagId",
+            "in": "path",
             "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The number of the recent runs to analyze.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "description": "The ratio of the latest duration of a step to its baseline above which the step is regarded as regressed.",
+            "name": "threshold",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/searchDagsResponse"
+              "$ref": "#/definitions/dagAnalyticsResponse"
             }
           },
           "default": {
@@ -313,21 +419,29 @@ func init() {
         }
       }
     },
-    "/tags": {
+    "/dags/{dagId}/config-sources": {
       "get": {
-        "description": "Returns a list of tags.",
+        "description": "Returns the effective configuration values of a DAG and the base config or the DAG file each value comes from, for debugging the base config chain.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "dags"
         ],
-        "operationId": "listTags",
+        "operationId": "getDagConfigSources",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listTagResponse"
+              "$ref": "#/definitions/dagConfigSourcesResponse"
             }
           },
           "default": {
@@ -338,839 +452,2927 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "ApiError": {
-      "type": "object",
-      "required": [
-        "message",
-        "detailedMessage"
-      ],
-      "properties": {
-        "detailedMessage": {
-          "type": "string"
-        },
-        "message": {
-          "type": "string"
-        }
-      }
-    },
-    "condition": {
-      "type": "object",
-      "properties": {
-        "Condition": {
-          "type": "string"
-        },
-        "Expected": {
-          "type": "string"
-        }
-      }
     },
-    "createDagResponse": {
-      "type": "object",
-      "required": [
-        "DagID"
-      ],
-      "properties": {
-        "DagID": {
-          "type": "string"
+    "/dags/{dagId}/failures": {
+      "get": {
+        "description": "Returns the steps of a DAG that failed most often in the recent runs with their most common errors.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "getDagFailures",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The number of the recent runs to analyze.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Analyzes only the runs started in the last days.",
+            "name": "days",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dagFailuresResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "dag": {
-      "type": "object",
-      "required": [
-        "Group",
-        "Name",
-        "Schedule",
-        "Description",
-        "Params",
-        "DefaultParams",
-        "Tags"
-      ],
-      "properties": {
-        "DefaultParams": {
-          "type": "string"
-        },
-        "Description": {
-          "type": "string"
-        },
-        "Group": {
-          "type": "string"
-        },
-        "Name": {
-          "type": "string"
-        },
-        "Params": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "Schedule": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/schedule"
+    "/dags/{dagId}/graph.svg": {
+      "get": {
+        "description": "Renders the graph of the steps of a DAG with the statuses of a run as an SVG image.",
+        "produces": [
+          "image/svg+xml",
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "getDagGraph",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The request ID of the run. The latest run is used if not specified.",
+            "name": "requestId",
+            "in": "query"
           }
-        },
-        "Tags": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
         }
       }
     },
-    "dagDetail": {
-      "type": "object",
-      "required": [
-        "Location",
-        "Group",
-        "Name",
-        "Schedule",
-        "Description",
-        "Env",
-        "LogDir",
-        "HandlerOn",
-        "Steps",
-        "Delay",
-        "HistRetentionDays",
-        "Preconditions",
-        "MaxActiveRuns",
-        "Params",
-        "DefaultParams",
-        "Tags"
-      ],
-      "properties": {
-        "DefaultParams": {
-          "type": "string"
-        },
-        "Delay": {
-          "type": "integer"
-        },
-        "Description": {
-          "type": "string"
-        },
-        "Env": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "Group": {
-          "type": "string"
-        },
-        "HandlerOn": {
-          "$ref": "#/definitions/handlerOn"
-        },
-        "HistRetentionDays": {
-          "type": "integer"
-        },
-        "Location": {
-          "type": "string"
-        },
-        "LogDir": {
-          "type": "string"
-        },
-        "MaxActiveRuns": {
-          "type": "integer"
-        },
-        "Name": {
-          "type": "string"
-        },
-        "Params": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "Preconditions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/condition"
-          }
-        },
-        "Schedule": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/schedule"
-          }
-        },
-        "Steps": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/stepObject"
+    "/dags/{dagId}/requests/{requestId}/artifacts/{artifactName}": {
+      "get": {
+        "description": "Downloads an artifact produced by a DAG run.",
+        "produces": [
+          "application/octet-stream",
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "getArtifact",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "requestId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "artifactName",
+            "in": "path",
+            "required": true
           }
-        },
-        "Tags": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
         }
       }
     },
-    "dagListItem": {
-      "type": "object",
-      "required": [
-        "File",
-        "Dir",
-        "DAG",
-        "Status",
-        "Suspended",
-        "Error",
-        "ErrorT"
-      ],
-      "properties": {
-        "DAG": {
-          "$ref": "#/definitions/dag"
-        },
-        "Dir": {
-          "type": "string"
-        },
-        "Error": {
-          "type": "string"
-        },
-        "ErrorT": {
-          "type": "string"
-        },
-        "File": {
-          "type": "string"
-        },
-        "Status": {
-          "$ref": "#/definitions/dagStatus"
-        },
-        "Suspended": {
-          "type": "boolean"
-        }
-      }
-    },
-    "dagLogGridItem": {
-      "type": "object",
-      "required": [
-        "Name",
-        "Vals"
-      ],
-      "properties": {
-        "Name": {
-          "type": "string"
-        },
-        "Vals": {
-          "type": "array",
-          "items": {
-            "type": "integer"
+    "/dags/{dagId}/requests/{requestId}/lineage": {
+      "get": {
+        "description": "Returns the lineage of a DAG run, the runs it comes from back to its origin and the runs it triggered.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "getRunLineage",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "requestId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/runLineageResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
         }
       }
     },
-    "dagLogResponse": {
-      "type": "object",
-      "required": [
-        "GridData",
-        "Logs"
-      ],
-      "properties": {
-        "GridData": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/dagLogGridItem"
+    "/dags/{dagId}/requests/{requestId}/notes": {
+      "post": {
+        "description": "Attaches a note to a DAG run.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "postRunNote",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "requestId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "text"
+              ],
+              "properties": {
+                "text": {
+                  "type": "string"
+                }
+              }
+            }
           }
-        },
-        "Logs": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/dagStatusFile"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/postRunNoteResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
         }
       }
     },
-    "dagSchedulerLogResponse": {
-      "type": "object",
-      "required": [
-        "LogFile",
-        "Content"
-      ],
-      "properties": {
-        "Content": {
-          "type": "string"
-        },
-        "LogFile": {
-          "type": "string"
-        }
-      }
-    },
-    "dagStatus": {
-      "type": "object",
-      "required": [
-        "RequestId",
-        "Name",
-        "Status",
-        "StatusText",
-        "Pid",
-        "StartedAt",
-        "FinishedAt",
-        "Log",
-        "Params"
-      ],
-      "properties": {
-        "FinishedAt": {
-          "type": "string"
-        },
-        "Log": {
-          "type": "string"
-        },
-        "Name": {
-          "type": "string"
-        },
-        "Params": {
-          "type": "string"
-        },
-        "Pid": {
-          "type": "integer"
-        },
-        "RequestId": {
-          "type": "string"
-        },
-        "StartedAt": {
-          "type": "string"
-        },
-        "Status": {
-          "type": "integer"
-        },
-        "StatusText": {
-          "type": "string"
+    "/dags/{dagId}/requests/{requestId}/steps/{stepName}/retry": {
+      "post": {
+        "description": "Retries a single step of a DAG run.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "retryDagStep",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "requestId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "stepName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/postDagActionResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "dagStatusDetail": {
-      "type": "object",
-      "required": [
-        "RequestId",
-        "Name",
-        "Status",
-        "StatusText",
-        "Pid",
-        "Nodes",
-        "OnExit",
-        "OnSuccess",
-        "OnFailure",
-        "OnCancel",
-        "StartedAt",
-        "FinishedAt",
-        "Log",
-        "Params"
-      ],
-      "properties": {
-        "FinishedAt": {
-          "type": "string"
-        },
-        "Log": {
-          "type": "string"
-        },
-        "Name": {
-          "type": "string"
-        },
-        "Nodes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/statusNode"
+    "/dags/{dagId}/requests/{requestId}/steps/{stepName}/signal": {
+      "post": {
+        "description": "Sends a signal to the process group of a running step without stopping the DAG run, e.g. SIGHUP to make a long-running step reload its config or reopen its log files.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "signalDagStep",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "requestId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "stepName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/signalStepBody"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/postDagActionResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        },
-        "OnCancel": {
-          "$ref": "#/definitions/statusNode"
-        },
-        "OnExit": {
-          "$ref": "#/definitions/statusNode"
-        },
-        "OnFailure": {
-          "$ref": "#/definitions/statusNode"
-        },
-        "OnSuccess": {
-          "$ref": "#/definitions/statusNode"
-        },
-        "Params": {
-          "type": "string"
-        },
-        "Pid": {
-          "type": "integer"
-        },
-        "RequestId": {
-          "type": "string"
-        },
-        "StartedAt": {
-          "type": "string"
-        },
-        "Status": {
-          "type": "integer"
-        },
-        "StatusText": {
-          "type": "string"
         }
       }
     },
-    "dagStatusFile": {
-      "type": "object",
-      "required": [
-        "File"
-      ],
-      "properties": {
-        "File": {
-          "type": "string"
-        },
-        "Status": {
-          "$ref": "#/definitions/dagStatusDetail"
+    "/dags/{dagId}/requests/{requestId}/steps/{stepName}/skip": {
+      "post": {
+        "description": "Marks a step of a queued or running DAG run which has not started yet to be skipped. The steps after it run as if it succeeded.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "skipDagStep",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "requestId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "stepName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/postDagActionResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "dagStatusWithDetails": {
-      "type": "object",
-      "required": [
-        "File",
-        "Dir",
-        "DAG",
-        "Status",
-        "Suspended",
-        "Error",
-        "ErrorT"
-      ],
-      "properties": {
-        "DAG": {
-          "$ref": "#/definitions/dagDetail"
-        },
-        "Dir": {
-          "type": "string"
-        },
-        "Error": {
-          "type": "string"
-        },
-        "ErrorT": {
-          "type": "string"
-        },
-        "File": {
-          "type": "string"
-        },
-        "Status": {
-          "$ref": "#/definitions/dagStatusDetail"
-        },
-        "Suspended": {
-          "type": "boolean"
+    "/dags/{dagId}/start": {
+      "post": {
+        "description": "Starts a DAG with the parameters, e.g. from a webhook of GitHub Actions or Airflow. The DAG runs asynchronously and the request ID of the run is returned. When the request ID is given and the run already exists, the run is returned without starting the DAG again.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "startDag",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/startDagBody"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/postDagActionResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "dagStepLogResponse": {
-      "type": "object",
-      "required": [
-        "Step",
-        "LogFile",
-        "Content"
-      ],
-      "properties": {
-        "Content": {
-          "type": "string"
-        },
-        "LogFile": {
-          "type": "string"
-        },
-        "Step": {
-          "$ref": "#/definitions/statusNode"
+    "/queue": {
+      "get": {
+        "description": "Returns the runs waiting to be started by the scheduler in the order they are started, with their position in the queue of their DAG and the reason they are waiting.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "listQueuedRuns",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only the runs of the DAG are returned if it's set.",
+            "name": "dagId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/queueResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "getDagDetailsResponse": {
-      "type": "object",
-      "required": [
-        "Title",
-        "DAG",
-        "Tab",
-        "Graph",
-        "Definition",
-        "LogData",
-        "LogUrl",
-        "StepLog",
-        "ScLog",
-        "Errors"
-      ],
-      "properties": {
-        "DAG": {
-          "$ref": "#/definitions/dagStatusWithDetails"
-        },
-        "Definition": {
-          "type": "string"
-        },
-        "Errors": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/queue/{requestId}": {
+      "delete": {
+        "description": "Cancels a queued run. The run is removed from the queue and never started.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "cancelQueuedRun",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "requestId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Changes the priority of a queued run. The due runs of a DAG are started in the order of the priority, the highest first.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "updateQueuedRun",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "requestId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "Priority"
+              ],
+              "properties": {
+                "Priority": {
+                  "type": "integer"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/queuedRun"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        },
-        "Graph": {
-          "type": "string"
-        },
-        "LogData": {
-          "$ref": "#/definitions/dagLogResponse"
-        },
-        "LogUrl": {
-          "type": "string"
-        },
-        "ScLog": {
-          "$ref": "#/definitions/dagSchedulerLogResponse"
-        },
-        "StepLog": {
-          "$ref": "#/definitions/dagStepLogResponse"
-        },
-        "Tab": {
-          "type": "string"
-        },
-        "Title": {
-          "type": "string"
         }
       }
     },
-    "handlerOn": {
-      "type": "object",
-      "properties": {
-        "Cancel": {
-          "$ref": "#/definitions/stepObject"
-        },
-        "Exit": {
-          "$ref": "#/definitions/stepObject"
-        },
-        "Failure": {
-          "$ref": "#/definitions/stepObject"
-        },
-        "Success": {
-          "$ref": "#/definitions/stepObject"
+    "/search": {
+      "get": {
+        "description": "Searches for DAGs.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "searchDags",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "q",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Returns only the DAGs with a recent run having the labels (e.g. customer=acme,backfill=true).",
+            "name": "labels",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/searchDagsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "listDagsResponse": {
-      "type": "object",
-      "required": [
-        "DAGs",
-        "Errors",
-        "HasError",
-        "PageCount"
-      ],
-      "properties": {
-        "DAGs": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/dagListItem"
-          }
-        },
-        "Errors": {
+    "/tags": {
+      "get": {
+        "description": "Returns a list of tags.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "listTags",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listTagResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/timeline": {
+      "get": {
+        "description": "Simulates the scheduler to list the runs expected in the next hours, including the ones skipped because the DAG is suspended or out of its time window.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "getTimeline",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "The number of the hours from now to simulate.",
+            "name": "hours",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "searchName",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "searchTag",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/timelineResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "ApiError": {
+      "type": "object",
+      "required": [
+        "message",
+        "detailedMessage"
+      ],
+      "properties": {
+        "code": {
+          "$ref": "#/definitions/ErrorCode"
+        },
+        "detailedMessage": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "ErrorCode": {
+      "description": "The stable code of the error to tell the errors apart without the messages.\n",
+      "type": "string",
+      "enum": [
+        "BAD_REQUEST",
+        "FORBIDDEN",
+        "NOT_FOUND",
+        "DAG_NOT_FOUND",
+        "DAG_RUNNING",
+        "SPEC_INVALID",
+        "STORE_UNAVAILABLE",
+        "REMOTE_NODE_UNAVAILABLE",
+        "INTERNAL_ERROR"
+      ]
+    },
+    "condition": {
+      "type": "object",
+      "properties": {
+        "Condition": {
+          "type": "string"
+        },
+        "Expected": {
+          "type": "string"
+        }
+      }
+    },
+    "configValueSource": {
+      "type": "object",
+      "required": [
+        "Key",
+        "Value",
+        "File"
+      ],
+      "properties": {
+        "File": {
+          "description": "The file the value comes from.",
+          "type": "string"
+        },
+        "Key": {
+          "description": "The path of the value, e.g. \"smtp\" or \"handlerOn.failure.command\".",
+          "type": "string"
+        },
+        "Value": {
+          "description": "The value in YAML.",
+          "type": "string"
+        }
+      }
+    },
+    "createDagResponse": {
+      "type": "object",
+      "required": [
+        "DagID"
+      ],
+      "properties": {
+        "DagID": {
+          "type": "string"
+        }
+      }
+    },
+    "dag": {
+      "type": "object",
+      "required": [
+        "Group",
+        "Name",
+        "Schedule",
+        "Description",
+        "Params",
+        "DefaultParams",
+        "Tags"
+      ],
+      "properties": {
+        "DefaultParams": {
+          "type": "string"
+        },
+        "Description": {
+          "type": "string"
+        },
+        "Group": {
+          "type": "string"
+        },
+        "Name": {
+          "type": "string"
+        },
+        "Namespace": {
+          "description": "The namespace of the DAG, which is empty for the DAGs not in any namespace.",
+          "type": "string"
+        },
+        "Params": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "Schedule": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/schedule"
+          }
+        },
+        "Tags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "dagAnalyticsResponse": {
+      "type": "object",
+      "required": [
+        "Runs",
+        "Steps"
+      ],
+      "properties": {
+        "Runs": {
+          "description": "The recent runs, the newest first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/runTrend"
+          }
+        },
+        "Steps": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/stepTrend"
+          }
+        }
+      }
+    },
+    "dagConfigSourcesResponse": {
+      "type": "object",
+      "required": [
+        "Files",
+        "Values"
+      ],
+      "properties": {
+        "Files": {
+          "description": "The base configs and the DAG file in the order they are applied.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "Values": {
+          "description": "The effective values sorted by key.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configValueSource"
+          }
+        }
+      }
+    },
+    "dagDetail": {
+      "type": "object",
+      "required": [
+        "Location",
+        "Group",
+        "Name",
+        "Schedule",
+        "Description",
+        "Env",
+        "LogDir",
+        "HandlerOn",
+        "Steps",
+        "Delay",
+        "HistRetentionDays",
+        "Preconditions",
+        "MaxActiveRuns",
+        "Params",
+        "DefaultParams",
+        "Tags"
+      ],
+      "properties": {
+        "DefaultParams": {
+          "type": "string"
+        },
+        "Delay": {
+          "type": "integer"
+        },
+        "Description": {
+          "type": "string"
+        },
+        "Env": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "Group": {
+          "type": "string"
+        },
+        "HandlerOn": {
+          "$ref": "#/definitions/handlerOn"
+        },
+        "HistRetentionDays": {
+          "type": "integer"
+        },
+        "Location": {
+          "type": "string"
+        },
+        "LogDir": {
+          "type": "string"
+        },
+        "MaxActiveRuns": {
+          "type": "integer"
+        },
+        "Name": {
+          "type": "string"
+        },
+        "Namespace": {
+          "type": "string"
+        },
+        "Params": {
           "type": "array",
           "items": {
             "type": "string"
           }
-        },
-        "HasError": {
-          "type": "boolean"
-        },
-        "PageCount": {
-          "type": "integer"
+        },
+        "Preconditions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/condition"
+          }
+        },
+        "Schedule": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/schedule"
+          }
+        },
+        "Steps": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/stepObject"
+          }
+        },
+        "Tags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "dagFailuresResponse": {
+      "type": "object",
+      "required": [
+        "Runs",
+        "FailedRuns",
+        "Steps"
+      ],
+      "properties": {
+        "FailedRuns": {
+          "description": "The number of the analyzed runs that failed.",
+          "type": "integer"
+        },
+        "Runs": {
+          "description": "The number of the analyzed runs.",
+          "type": "integer"
+        },
+        "Steps": {
+          "description": "The failed steps, the most failed first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/stepFailures"
+          }
+        }
+      }
+    },
+    "dagListItem": {
+      "type": "object",
+      "required": [
+        "File",
+        "Dir",
+        "DAG",
+        "Status",
+        "Suspended",
+        "Error",
+        "ErrorT"
+      ],
+      "properties": {
+        "DAG": {
+          "$ref": "#/definitions/dag"
+        },
+        "Dir": {
+          "type": "string"
+        },
+        "Error": {
+          "type": "string"
+        },
+        "ErrorT": {
+          "type": "string"
+        },
+        "File": {
+          "type": "string"
+        },
+        "RemoteNode": {
+          "type": "string"
+        },
+        "Status": {
+          "$ref": "#/definitions/dagStatus"
+        },
+        "Suspended": {
+          "type": "boolean"
+        },
+        "Suspension": {
+          "$ref": "#/definitions/suspension"
+        }
+      }
+    },
+    "dagLogGridItem": {
+      "type": "object",
+      "required": [
+        "Name",
+        "Vals"
+      ],
+      "properties": {
+        "Name": {
+          "type": "string"
+        },
+        "Vals": {
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        }
+      }
+    },
+    "dagLogResponse": {
+      "type": "object",
+      "required": [
+        "GridData",
+        "Logs"
+      ],
+      "properties": {
+        "GridData": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dagLogGridItem"
+          }
+        },
+        "Logs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dagStatusFile"
+          }
+        }
+      }
+    },
+    "dagSchedulerLogResponse": {
+      "type": "object",
+      "required": [
+        "LogFile",
+        "Content"
+      ],
+      "properties": {
+        "Content": {
+          "type": "string"
+        },
+        "LogFile": {
+          "type": "string"
+        }
+      }
+    },
+    "dagStatus": {
+      "type": "object",
+      "required": [
+        "RequestId",
+        "Name",
+        "Status",
+        "StatusText",
+        "Pid",
+        "StartedAt",
+        "FinishedAt",
+        "Log",
+        "Params"
+      ],
+      "properties": {
+        "FinishedAt": {
+          "type": "string"
+        },
+        "Labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "Log": {
+          "type": "string"
+        },
+        "Name": {
+          "type": "string"
+        },
+        "Params": {
+          "type": "string"
+        },
+        "Pid": {
+          "type": "integer"
+        },
+        "RequestId": {
+          "type": "string"
+        },
+        "StartedAt": {
+          "type": "string"
+        },
+        "Status": {
+          "type": "integer"
+        },
+        "StatusText": {
+          "type": "string"
+        }
+      }
+    },
+    "dagStatusDetail": {
+      "type": "object",
+      "required": [
+        "RequestId",
+        "Name",
+        "Status",
+        "StatusText",
+        "Pid",
+        "Nodes",
+        "OnExit",
+        "OnSuccess",
+        "OnFailure",
+        "OnCancel",
+        "StartedAt",
+        "FinishedAt",
+        "Log",
+        "Params"
+      ],
+      "properties": {
+        "FinishedAt": {
+          "type": "string"
+        },
+        "Heartbeat": {
+          "description": "Time the agent running the DAG was last alive",
+          "type": "string"
+        },
+        "Labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "Log": {
+          "type": "string"
+        },
+        "Name": {
+          "type": "string"
+        },
+        "Nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/statusNode"
+          }
+        },
+        "Notes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/runNote"
+          }
+        },
+        "OnCancel": {
+          "$ref": "#/definitions/statusNode"
+        },
+        "OnExit": {
+          "$ref": "#/definitions/statusNode"
+        },
+        "OnFailure": {
+          "$ref": "#/definitions/statusNode"
+        },
+        "OnSuccess": {
+          "$ref": "#/definitions/statusNode"
+        },
+        "OnTimeout": {
+          "$ref": "#/definitions/statusNode"
+        },
+        "Params": {
+          "type": "string"
+        },
+        "Pid": {
+          "type": "integer"
+        },
+        "RequestId": {
+          "type": "string"
+        },
+        "StartedAt": {
+          "type": "string"
+        },
+        "Status": {
+          "type": "integer"
+        },
+        "StatusText": {
+          "type": "string"
+        }
+      }
+    },
+    "dagStatusFile": {
+      "type": "object",
+      "required": [
+        "File"
+      ],
+      "properties": {
+        "File": {
+          "type": "string"
+        },
+        "Status": {
+          "$ref": "#/definitions/dagStatusDetail"
+        }
+      }
+    },
+    "dagStatusWithDetails": {
+      "type": "object",
+      "required": [
+        "File",
+        "Dir",
+        "DAG",
+        "Status",
+        "Suspended",
+        "Error",
+        "ErrorT"
+      ],
+      "properties": {
+        "DAG": {
+          "$ref": "#/definitions/dagDetail"
+        },
+        "Dir": {
+          "type": "string"
+        },
+        "Error": {
+          "type": "string"
+        },
+        "ErrorT": {
+          "type": "string"
+        },
+        "File": {
+          "type": "string"
+        },
+        "Status": {
+          "$ref": "#/definitions/dagStatusDetail"
+        },
+        "Suspended": {
+          "type": "boolean"
+        },
+        "Suspension": {
+          "$ref": "#/definitions/suspension"
+        }
+      }
+    },
+    "dagStepLogResponse": {
+      "type": "object",
+      "required": [
+        "Step",
+        "LogFile",
+        "Content"
+      ],
+      "properties": {
+        "Content": {
+          "type": "string"
+        },
+        "LogFile": {
+          "type": "string"
+        },
+        "Step": {
+          "$ref": "#/definitions/statusNode"
+        }
+      }
+    },
+    "delayedStart": {
+      "type": "object",
+      "required": [
+        "RequestId",
+        "StartAt",
+        "RegisteredAt"
+      ],
+      "properties": {
+        "Labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "Params": {
+          "type": "string"
+        },
+        "RegisteredAt": {
+          "type": "string"
+        },
+        "RequestId": {
+          "type": "string"
+        },
+        "StartAt": {
+          "type": "string"
+        }
+      }
+    },
+    "errorCount": {
+      "type": "object",
+      "required": [
+        "Error",
+        "Count"
+      ],
+      "properties": {
+        "Count": {
+          "type": "integer"
+        },
+        "Error": {
+          "type": "string"
+        }
+      }
+    },
+    "getDagDetailsResponse": {
+      "type": "object",
+      "required": [
+        "Title",
+        "DAG",
+        "Tab",
+        "Graph",
+        "Definition",
+        "LogData",
+        "LogUrl",
+        "StepLog",
+        "ScLog",
+        "Errors"
+      ],
+      "properties": {
+        "DAG": {
+          "$ref": "#/definitions/dagStatusWithDetails"
+        },
+        "Definition": {
+          "type": "string"
+        },
+        "DelayedStarts": {
+          "description": "The runs registered to be started later, the earliest first. Only set in the status tab.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/delayedStart"
+          }
+        },
+        "Errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "Graph": {
+          "type": "string"
+        },
+        "LogData": {
+          "$ref": "#/definitions/dagLogResponse"
+        },
+        "LogUrl": {
+          "type": "string"
+        },
+        "ScLog": {
+          "$ref": "#/definitions/dagSchedulerLogResponse"
+        },
+        "StepLog": {
+          "$ref": "#/definitions/dagStepLogResponse"
+        },
+        "Tab": {
+          "type": "string"
+        },
+        "Title": {
+          "type": "string"
+        }
+      }
+    },
+    "handlerOn": {
+      "type": "object",
+      "properties": {
+        "Cancel": {
+          "$ref": "#/definitions/stepObject"
+        },
+        "Exit": {
+          "$ref": "#/definitions/stepObject"
+        },
+        "Failure": {
+          "$ref": "#/definitions/stepObject"
+        },
+        "Success": {
+          "$ref": "#/definitions/stepObject"
+        }
+      }
+    },
+    "lineageRun": {
+      "type": "object",
+      "required": [
+        "DAG",
+        "RequestId",
+        "Status",
+        "StatusText",
+        "StartedAt",
+        "Trigger"
+      ],
+      "properties": {
+        "DAG": {
+          "description": "The ID of the DAG.",
+          "type": "string"
+        },
+        "ParentDAG": {
+          "description": "The ID of the DAG of the parent run.",
+          "type": "string"
+        },
+        "ParentRequestId": {
+          "description": "The request ID of the run that started the run as a sub workflow.",
+          "type": "string"
+        },
+        "RequestId": {
+          "type": "string"
+        },
+        "RetryOf": {
+          "description": "The request ID of the run of the same DAG retried by the run.",
+          "type": "string"
+        },
+        "Schedule": {
+          "description": "The cron expression of the schedule that started the run.",
+          "type": "string"
+        },
+        "StartedAt": {
+          "type": "string"
+        },
+        "Status": {
+          "type": "integer"
+        },
+        "StatusText": {
+          "type": "string"
+        },
+        "Trigger": {
+          "description": "What started the run, \"manual\", \"api\", \"schedule\", \"restart\", \"retry\" or \"parent\". It's empty for the runs recorded before the lineage.",
+          "type": "string"
+        }
+      }
+    },
+    "lintWarning": {
+      "type": "object",
+      "required": [
+        "Message"
+      ],
+      "properties": {
+        "Message": {
+          "type": "string"
+        },
+        "Step": {
+          "type": "string"
+        }
+      }
+    },
+    "listDagsResponse": {
+      "type": "object",
+      "required": [
+        "DAGs",
+        "Errors",
+        "HasError",
+        "PageCount"
+      ],
+      "properties": {
+        "DAGs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dagListItem"
+          }
+        },
+        "Errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "HasError": {
+          "type": "boolean"
+        },
+        "PageCount": {
+          "type": "integer"
+        },
+        "Timestamp": {
+          "description": "The time the list was read. Pass it as since to get only the DAGs that changed afterwards.",
+          "type": "string"
+        }
+      }
+    },
+    "listTagResponse": {
+      "type": "object",
+      "required": [
+        "Tags",
+        "Errors"
+      ],
+      "properties": {
+        "Errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "Tags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "postDagActionResponse": {
+      "type": "object",
+      "properties": {
+        "NewDagID": {
+          "type": "string"
+        },
+        "RequestId": {
+          "type": "string"
+        },
+        "StopResult": {
+          "description": "The result of the stop action.",
+          "type": "string",
+          "enum": [
+            "signalled",
+            "already-stopped",
+            "not-running"
+          ]
+        },
+        "Warnings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lintWarning"
+          }
+        }
+      }
+    },
+    "postRunNoteResponse": {
+      "type": "object",
+      "required": [
+        "Notes"
+      ],
+      "properties": {
+        "Notes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/runNote"
+          }
+        }
+      }
+    },
+    "queueResponse": {
+      "type": "object",
+      "required": [
+        "Runs"
+      ],
+      "properties": {
+        "Runs": {
+          "description": "The queued runs in the order they are started.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/queuedRun"
+          }
+        }
+      }
+    },
+    "queuedRun": {
+      "type": "object",
+      "required": [
+        "DAG",
+        "RequestId",
+        "Position",
+        "Reason",
+        "EnqueuedAt",
+        "StartAt",
+        "Priority"
+      ],
+      "properties": {
+        "DAG": {
+          "description": "The ID of the DAG. It's the location of the DAG file if the DAG can't be loaded.",
+          "type": "string"
+        },
+        "EnqueuedAt": {
+          "description": "The time the run was queued in RFC 3339.",
+          "type": "string"
+        },
+        "Labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "Params": {
+          "type": "string"
+        },
+        "Position": {
+          "description": "The 1-based position of the run in the queue of its DAG.",
+          "type": "integer"
+        },
+        "Priority": {
+          "type": "integer"
+        },
+        "Reason": {
+          "description": "Why the run is waiting, \"delayed\" (for its start time), \"concurrency\" (for the current run of the DAG to finish) or \"due\" (to be started in the next round of the scheduler).",
+          "type": "string"
+        },
+        "RequestId": {
+          "type": "string"
+        },
+        "SkipSteps": {
+          "description": "The names of the steps to skip in the run.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "StartAt": {
+          "description": "The earliest time the run is started in RFC 3339.",
+          "type": "string"
+        }
+      }
+    },
+    "repeatPolicy": {
+      "type": "object",
+      "properties": {
+        "Interval": {
+          "type": "integer"
+        },
+        "Repeat": {
+          "type": "boolean"
+        }
+      }
+    },
+    "resourceUsage": {
+      "description": "The resource usage of the command of the step.",
+      "type": "object",
+      "required": [
+        "UserCPUTimeMs",
+        "SystemCPUTimeMs",
+        "MaxRSSBytes",
+        "WallTimeMs"
+      ],
+      "properties": {
+        "MaxRSSBytes": {
+          "description": "The maximum resident set size in bytes.",
+          "type": "integer"
+        },
+        "SystemCPUTimeMs": {
+          "description": "The CPU time spent in the kernel mode in milliseconds.",
+          "type": "integer"
+        },
+        "UserCPUTimeMs": {
+          "description": "The CPU time spent in the user mode in milliseconds.",
+          "type": "integer"
+        },
+        "WallTimeMs": {
+          "description": "The elapsed time of the command in milliseconds.",
+          "type": "integer"
+        }
+      }
+    },
+    "runLineageResponse": {
+      "type": "object",
+      "required": [
+        "Run",
+        "Ancestors",
+        "OriginFound",
+        "Descendants"
+      ],
+      "properties": {
+        "Ancestors": {
+          "description": "The runs the run comes from, the origin first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lineageRun"
+          }
+        },
+        "Descendants": {
+          "description": "The runs triggered by the run and by its descendants, in the order they started.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lineageRun"
+          }
+        },
+        "OriginFound": {
+          "description": "Whether the first ancestor is the origin. It's false if an ancestor is no longer in the history.",
+          "type": "boolean"
+        },
+        "Run": {
+          "$ref": "#/definitions/lineageRun"
+        }
+      }
+    },
+    "runNote": {
+      "type": "object",
+      "required": [
+        "Text",
+        "CreatedAt"
+      ],
+      "properties": {
+        "CreatedAt": {
+          "type": "string"
+        },
+        "Text": {
+          "type": "string"
+        }
+      }
+    },
+    "runTrend": {
+      "type": "object",
+      "required": [
+        "RequestId",
+        "Status",
+        "StatusText",
+        "StartedAt",
+        "DurationMs"
+      ],
+      "properties": {
+        "DurationMs": {
+          "type": "integer"
+        },
+        "RequestId": {
+          "type": "string"
+        },
+        "StartedAt": {
+          "type": "string"
+        },
+        "Status": {
+          "type": "integer"
+        },
+        "StatusText": {
+          "type": "string"
+        }
+      }
+    },
+    "schedule": {
+      "type": "object",
+      "required": [
+        "Expression"
+      ],
+      "properties": {
+        "Cron": {
+          "description": "The cron expression without the time zone prefix.",
+          "type": "string"
+        },
+        "Expression": {
+          "type": "string"
+        },
+        "NextRun": {
+          "description": "The next fire time in RFC 3339.",
+          "type": "string"
+        },
+        "PrevRun": {
+          "description": "The last fire time in RFC 3339.",
+          "type": "string"
+        },
+        "Timezone": {
+          "description": "The time zone the schedule is evaluated in.",
+          "type": "string"
+        }
+      }
+    },
+    "searchDagsMatchItem": {
+      "type": "object",
+      "properties": {
+        "Line": {
+          "type": "string"
+        },
+        "LineNumber": {
+          "type": "integer"
+        },
+        "StartLine": {
+          "type": "integer"
+        }
+      }
+    },
+    "searchDagsResponse": {
+      "type": "object",
+      "required": [
+        "Results",
+        "Errors"
+      ],
+      "properties": {
+        "Errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "Results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/searchDagsResultItem"
+          }
+        }
+      }
+    },
+    "searchDagsResultItem": {
+      "type": "object",
+      "properties": {
+        "DAG": {
+          "$ref": "#/definitions/dag"
+        },
+        "Matches": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/searchDagsMatchItem"
+          }
+        },
+        "Name": {
+          "type": "string"
+        }
+      }
+    },
+    "signalStepBody": {
+      "type": "object",
+      "required": [
+        "signal"
+      ],
+      "properties": {
+        "signal": {
+          "description": "The name of the signal, e.g. SIGHUP or SIGUSR1.",
+          "type": "string"
+        }
+      }
+    },
+    "startDagBody": {
+      "type": "object",
+      "properties": {
+        "params": {
+          "description": "The named parameters of the run.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "requestId": {
+          "description": "The request ID of the run, up to 64 letters, digits, hyphens and underscores. A random ID is used when it's empty.",
+          "type": "string"
+        }
+      }
+    },
+    "statusNode": {
+      "type": "object",
+      "required": [
+        "Step",
+        "Log",
+        "StartedAt",
+        "FinishedAt",
+        "Status",
+        "RetryCount",
+        "DoneCount",
+        "Error",
+        "StatusText"
+      ],
+      "properties": {
+        "Artifacts": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "DoneCount": {
+          "type": "integer"
+        },
+        "Error": {
+          "type": "string"
+        },
+        "FinishedAt": {
+          "type": "string"
+        },
+        "Log": {
+          "type": "string"
+        },
+        "ResourceUsage": {
+          "$ref": "#/definitions/resourceUsage"
+        },
+        "RetryCount": {
+          "type": "integer"
+        },
+        "SkipRequested": {
+          "description": "Whether the step was requested to be skipped in the run.",
+          "type": "boolean"
+        },
+        "StartedAt": {
+          "type": "string"
+        },
+        "Status": {
+          "type": "integer"
+        },
+        "StatusText": {
+          "type": "string"
+        },
+        "Step": {
+          "$ref": "#/definitions/stepObject"
+        },
+        "SubRequestId": {
+          "description": "Request ID of the run of the sub DAG started by the step.",
+          "type": "string"
+        }
+      }
+    },
+    "stepFailures": {
+      "type": "object",
+      "required": [
+        "Name",
+        "Runs",
+        "Failures",
+        "FailureRate",
+        "LastFailedAt",
+        "Errors"
+      ],
+      "properties": {
+        "Errors": {
+          "description": "The most common errors of the step, the most common first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/errorCount"
+          }
+        },
+        "FailureRate": {
+          "type": "number"
+        },
+        "Failures": {
+          "type": "integer"
+        },
+        "LastFailedAt": {
+          "type": "string"
+        },
+        "Name": {
+          "type": "string"
+        },
+        "Runs": {
+          "description": "The number of the runs in which the step was executed.",
+          "type": "integer"
+        }
+      }
+    },
+    "stepObject": {
+      "type": "object",
+      "required": [
+        "Name",
+        "Description",
+        "Variables",
+        "Dir",
+        "CmdWithArgs",
+        "Command",
+        "Script",
+        "Stdout",
+        "Stderr",
+        "Output",
+        "Args",
+        "Depends",
+        "RepeatPolicy",
+        "MailOnError",
+        "Preconditions"
+      ],
+      "properties": {
+        "Args": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "CmdWithArgs": {
+          "type": "string"
+        },
+        "Command": {
+          "type": "string"
+        },
+        "Depends": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "Description": {
+          "type": "string"
+        },
+        "Dir": {
+          "type": "string"
+        },
+        "MailOnError": {
+          "type": "boolean"
+        },
+        "Name": {
+          "type": "string"
+        },
+        "Output": {
+          "type": "string"
+        },
+        "Params": {
+          "type": "string"
+        },
+        "Preconditions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/condition"
+          }
+        },
+        "RepeatPolicy": {
+          "$ref": "#/definitions/repeatPolicy"
+        },
+        "Run": {
+          "type": "string"
+        },
+        "Script": {
+          "type": "string"
+        },
+        "Stderr": {
+          "type": "string"
+        },
+        "Stdout": {
+          "type": "string"
+        },
+        "Variables": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "stepTrend": {
+      "description": "The durations and the resource usage of a step across its successful runs.",
+      "type": "object",
+      "required": [
+        "Name",
+        "Runs",
+        "LatestDurationMs",
+        "BaselineDurationMs",
+        "AverageDurationMs",
+        "AverageCPUTimeMs",
+        "MaxRSSBytes",
+        "LatestMaxRSSBytes",
+        "Ratio",
+        "Regressed"
+      ],
+      "properties": {
+        "AverageCPUTimeMs": {
+          "type": "integer"
+        },
+        "AverageDurationMs": {
+          "type": "integer"
+        },
+        "BaselineDurationMs": {
+          "description": "The median duration of the successful runs before the latest one.",
+          "type": "integer"
+        },
+        "LatestDurationMs": {
+          "type": "integer"
+        },
+        "LatestMaxRSSBytes": {
+          "type": "integer"
+        },
+        "MaxRSSBytes": {
+          "type": "integer"
+        },
+        "Name": {
+          "type": "string"
+        },
+        "Ratio": {
+          "description": "The latest duration divided by the baseline.",
+          "type": "number"
+        },
+        "Regressed": {
+          "type": "boolean"
+        },
+        "Runs": {
+          "description": "The number of the successful runs of the step.",
+          "type": "integer"
+        }
+      }
+    },
+    "suspension": {
+      "type": "object",
+      "properties": {
+        "ExpiresAt": {
+          "description": "The time the suspension is lifted. It's empty if the DAG is suspended until it's resumed.",
+          "type": "string"
+        },
+        "Reason": {
+          "type": "string"
+        },
+        "SuspendedAt": {
+          "type": "string"
+        }
+      }
+    },
+    "timelineResponse": {
+      "type": "object",
+      "required": [
+        "From",
+        "To",
+        "Runs"
+      ],
+      "properties": {
+        "From": {
+          "description": "The start of the simulated period in RFC 3339.",
+          "type": "string"
+        },
+        "Runs": {
+          "description": "The runs sorted by time.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/timelineRun"
+          }
+        },
+        "To": {
+          "description": "The end of the simulated period in RFC 3339.",
+          "type": "string"
+        }
+      }
+    },
+    "timelineRun": {
+      "type": "object",
+      "required": [
+        "DAG",
+        "Time",
+        "Operation",
+        "Schedule",
+        "Skipped"
+      ],
+      "properties": {
+        "DAG": {
+          "description": "The ID of the DAG.",
+          "type": "string"
+        },
+        "Operation": {
+          "description": "What the scheduler does, \"start\", \"stop\" or \"restart\".",
+          "type": "string"
+        },
+        "Reason": {
+          "description": "Why the run is expected to be skipped.",
+          "type": "string"
+        },
+        "Schedule": {
+          "description": "The cron expression of the schedule.",
+          "type": "string"
+        },
+        "Skipped": {
+          "description": "Whether the run is expected to be skipped.",
+          "type": "boolean"
+        },
+        "Time": {
+          "description": "The scheduled time in RFC 3339.",
+          "type": "string"
+        }
+      }
+    }
+  },
+  "tags": [
+    {
+      "description": "Operations about DAGs",
+      "name": "dags"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Dagu is a simple DAG (Directed Acyclic Graph) runner.\nIt is a simple tool to run a series of tasks in a specific order\n",
+    "title": "Dagu",
+    "contact": {
+      "name": "Dagu"
+    },
+    "version": "0.0.1"
+  },
+  "host": "localhost:8080",
+  "basePath": "/api/v1",
+  "paths": {
+    "/calendar.ics": {
+      "get": {
+        "description": "Exports the scheduled runs of the DAGs as an iCalendar feed.",
+        "produces": [
+          "application/json",
+          "text/calendar"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "getScheduleCalendar",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "searchName",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "searchTag",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "The number of the days from now to export the scheduled runs.",
+            "name": "days",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/dags": {
+      "get": {
+        "description": "Returns a list of DAGs.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "listDags",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "page",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "searchName",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "searchTag",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Returns only the DAGs that changed since the time (RFC3339), usually the Timestamp of the previous response.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Returns only the DAGs in the namespace.",
+            "name": "namespace",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listDagsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Creates a new DAG.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "createDag",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "required": [
+                "action",
+                "value"
+              ],
+              "properties": {
+                "action": {
+                  "type": "string"
+                },
+                "value": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/createDagResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/dags/{dagId}": {
+      "get": {
+        "description": "Returns details of a DAG.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "getDagDetails",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tab",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "file",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "step",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Filters the history by labels (e.g. customer=acme,backfill=true).",
+            "name": "labels",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/getDagDetailsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Performs an action on a DAG.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "postDagAction",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "required": [
+                "action"
+              ],
+              "properties": {
+                "action": {
+                  "type": "string",
+                  "enum": [
+                    "start",
+                    "suspend",
+                    "stop",
+                    "retry",
+                    "mark-success",
+                    "mark-failed",
+                    "mark-skipped",
+                    "save",
+                    "rename"
+                  ]
+                },
+                "delay": {
+                  "description": "The delay after which the scheduler starts the run, e.g. \"30m\".",
+                  "type": "string"
+                },
+                "expiresAt": {
+                  "description": "The time in RFC 3339 the suspension is lifted.",
+                  "type": "string"
+                },
+                "from": {
+                  "description": "The name of the step to start the run from. The steps it depends on are skipped.",
+                  "type": "string"
+                },
+                "idempotencyKey": {
+                  "type": "string"
+                },
+                "labels": {
+                  "type": "string"
+                },
+                "params": {
+                  "type": "string"
+                },
+                "reason": {
+                  "description": "The reason of the suspension.",
+                  "type": "string"
+                },
+                "requestId": {
+                  "type": "string"
+                },
+                "startAt": {
+                  "description": "The time in RFC 3339 the scheduler starts the run. The run is started immediately if neither startAt nor delay is set.",
+                  "type": "string"
+                },
+                "step": {
+                  "type": "string"
+                },
+                "target": {
+                  "description": "The name of the step to run with the steps it depends on. The other steps are skipped.",
+                  "type": "string"
+                },
+                "value": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/postDagActionResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes a DAG.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "deleteDag",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/dags/{dagId}/analytics": {
+      "get": {
+        "description": "Returns the duration and resource usage trends of the recent runs of a DAG.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "getDagAnalytics",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The number of the recent runs to analyze.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "description": "The ratio of the latest duration of a step to its baseline above which the step is regarded as regressed.",
+            "name": "threshold",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dagAnalyticsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/dags/{dagId}/config-sources": {
+      "get": {
+        "description": "Returns the effective configuration values of a DAG and the base config or the DAG file each value comes from, for debugging the base config chain.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "getDagConfigSources",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dagConfigSourcesResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "listTagResponse": {
-      "type": "object",
-      "required": [
-        "Tags",
-        "Errors"
-      ],
-      "properties": {
-        "Errors": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/dags/{dagId}/failures": {
+      "get": {
+        "description": "Returns the steps of a DAG that failed most often in the recent runs with their most common errors.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "getDagFailures",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The number of the recent runs to analyze.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Analyzes only the runs started in the last days.",
+            "name": "days",
+            "in": "query"
           }
-        },
-        "Tags": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dagFailuresResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
         }
       }
     },
-    "postDagActionResponse": {
-      "type": "object",
-      "properties": {
-        "NewDagID": {
-          "type": "string"
-        }
-      }
-    },
-    "repeatPolicy": {
-      "type": "object",
-      "properties": {
-        "Interval": {
-          "type": "integer"
-        },
-        "Repeat": {
-          "type": "boolean"
+    "/dags/{dagId}/graph.svg": {
+      "get": {
+        "description": "Renders the graph of the steps of a DAG with the statuses of a run as an SVG image.",
+        "produces": [
+          "application/json",
+          "image/svg+xml"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "getDagGraph",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The request ID of the run. The latest run is used if not specified.",
+            "name": "requestId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "schedule": {
-      "type": "object",
-      "required": [
-        "Expression"
-      ],
-      "properties": {
-        "Expression": {
-          "type": "string"
+    "/dags/{dagId}/requests/{requestId}/artifacts/{artifactName}": {
+      "get": {
+        "description": "Downloads an artifact produced by a DAG run.",
+        "produces": [
+          "application/json",
+          "application/octet-stream"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "getArtifact",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "requestId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "artifactName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "searchDagsMatchItem": {
-      "type": "object",
-      "properties": {
-        "Line": {
-          "type": "string"
-        },
-        "LineNumber": {
-          "type": "integer"
-        },
-        "StartLine": {
-          "type": "integer"
+    "/dags/{dagId}/requests/{requestId}/lineage": {
+      "get": {
+        "description": "Returns the lineage of a DAG run, the runs it comes from back to its origin and the runs it triggered.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "getRunLineage",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "requestId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/runLineageResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "searchDagsResponse": {
-      "type": "object",
-      "required": [
-        "Results",
-        "Errors"
-      ],
-      "properties": {
-        "Errors": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/dags/{dagId}/requests/{requestId}/notes": {
+      "post": {
+        "description": "Attaches a note to a DAG run.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "postRunNote",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "requestId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "text"
+              ],
+              "properties": {
+                "text": {
+                  "type": "string"
+                }
+              }
+            }
           }
-        },
-        "Results": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/searchDagsResultItem"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/postRunNoteResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
         }
       }
     },
-    "searchDagsResultItem": {
-      "type": "object",
-      "properties": {
-        "DAG": {
-          "$ref": "#/definitions/dag"
-        },
-        "Matches": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/searchDagsMatchItem"
+    "/dags/{dagId}/requests/{requestId}/steps/{stepName}/retry": {
+      "post": {
+        "description": "Retries a single step of a DAG run.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "retryDagStep",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "requestId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "stepName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/postDagActionResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        },
-        "Name": {
-          "type": "string"
-        }
-      }
-    },
-    "statusNode": {
-      "type": "object",
-      "required": [
-        "Step",
-        "Log",
-        "StartedAt",
-        "FinishedAt",
-        "Status",
-        "RetryCount",
-        "DoneCount",
-        "Error",
-        "StatusText"
-      ],
-      "properties": {
-        "DoneCount": {
-          "type": "integer"
-        },
-        "Error": {
-          "type": "string"
-        },
-        "FinishedAt": {
-          "type": "string"
-        },
-        "Log": {
-          "type": "string"
-        },
-        "RetryCount": {
-          "type": "integer"
-        },
-        "StartedAt": {
-          "type": "string"
-        },
-        "Status": {
-          "type": "integer"
-        },
-        "StatusText": {
-          "type": "string"
-        },
-        "Step": {
-          "$ref": "#/definitions/stepObject"
         }
       }
     },
-    "stepObject": {
-      "type": "object",
-      "required": [
-        "Name",
-        "Description",
-        "Variables",
-        "Dir",
-        "CmdWithArgs",
-        "Command",
-        "Script",
-        "Stdout",
-        "Stderr",
-        "Output",
-        "Args",
-        "Depends",
-        "RepeatPolicy",
-        "MailOnError",
-        "Preconditions"
-      ],
-      "properties": {
-        "Args": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "CmdWithArgs": {
-          "type": "string"
-        },
-        "Command": {
-          "type": "string"
-        },
-        "Depends": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "Description": {
-          "type": "string"
-        },
-        "Dir": {
-          "type": "string"
-        },
-        "MailOnError": {
-          "type": "boolean"
-        },
-        "Name": {
-          "type": "string"
-        },
-        "Output": {
-          "type": "string"
-        },
-        "Params": {
-          "type": "string"
-        },
-        "Preconditions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/condition"
+    "/dags/{dagId}/requests/{requestId}/steps/{stepName}/signal": {
+      "post": {
+        "description": "Sends a signal to the process group of a running step without stopping the DAG run, e.g. SIGHUP to make a long-running step reload its config or reopen its log files.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "signalDagStep",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "requestId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "stepName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/signalStepBody"
+            }
           }
-        },
-        "RepeatPolicy": {
-          "$ref": "#/definitions/repeatPolicy"
-        },
-        "Run": {
-          "type": "string"
-        },
-        "Script": {
-          "type": "string"
-        },
-        "Stderr": {
-          "type": "string"
-        },
-        "Stdout": {
-          "type": "string"
-        },
-        "Variables": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/postDagActionResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
         }
       }
-    }
-  },
-  "tags": [
-    {
-      "description": "Operations about DAGs",
-      "name": "dags"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Dagu is a simple DAG (Directed Acyclic Graph) runner.\nIt is a simple tool to run a series of tasks in a specific order\n",
-    "title": "Dagu",
-    "contact": {
-      "name": "Dagu"
-    },
-    "version": "0.0.1"
-  },
-  "host": "localhost:8080",
-  "basePath": "/api/v1",
-  "paths": {
-    "/dags": {
-      "get": {
-        "description": "Returns a list of DAGs.",
+    },
+    "/dags/{dagId}/requests/{requestId}/steps/{stepName}/skip": {
+      "post": {
+        "description": "Marks a step of a queued or running DAG run which has not started yet to be skipped. The steps after it run as if it succeeded.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "dags"
         ],
-        "operationId": "listDags",
+        "operationId": "skipDagStep",
         "parameters": [
           {
-            "type": "integer",
-            "name": "page",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "limit",
-            "in": "query"
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "name": "searchName",
-            "in": "query"
+            "name": "requestId",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "name": "searchTag",
-            "in": "query"
+            "name": "stepName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listDagsResponse"
+              "$ref": "#/definitions/postDagActionResponse"
             }
           },
           "default": {
@@ -1180,34 +3382,33 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/dags/{dagId}/start": {
       "post": {
-        "description": "Creates a new DAG.",
+        "description": "Starts a DAG with the parameters, e.g. from a webhook of GitHub Actions or Airflow. The DAG runs asynchronously and the request ID of the run is returned. When the request ID is given and the run already exists, the run is returned without starting the DAG again.",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
           "dags"
         ],
-        "operationId": "createDag",
+        "operationId": "startDag",
         "parameters": [
+          {
+            "type": "string",
+            "name": "dagId",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "schema": {
-              "type": "object",
-              "required": [
-                "action",
-                "value"
-              ],
-              "properties": {
-                "action": {
-                  "type": "string"
-                },
-                "value": {
-                  "type": "string"
-                }
-              }
+              "$ref": "#/definitions/startDagBody"
             }
           }
         ],
@@ -1215,7 +3416,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/createDagResponse"
+              "$ref": "#/definitions/postDagActionResponse"
             }
           },
           "default": {
@@ -1227,36 +3428,21 @@ func init() {
         }
       }
     },
-    "/dags/{dagId}": {
+    "/queue": {
       "get": {
-        "description": "Returns details of a DAG.",
+        "description": "Returns the runs waiting to be started by the scheduler in the order they are started, with their position in the queue of their DAG and the reason they are waiting.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "dags"
         ],
-        "operationId": "getDagDetails",
+        "operationId": "listQueuedRuns",
         "parameters": [
           {
             "type": "string",
+            "description": "Only the runs of the DAG are returned if it's set.",
             "name": "dagId",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "tab",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "file",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "step",
             "in": "query"
           }
         ],
@@ -1264,7 +3450,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/getDagDetailsResponse"
+              "$ref": "#/definitions/queueResponse"
             }
           },
           "default": {
@@ -1274,67 +3460,29 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "description": "Performs an action on a DAG.",
+      }
+    },
+    "/queue/{requestId}": {
+      "delete": {
+        "description": "Cancels a queued run. The run is removed from the queue and never started.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "dags"
         ],
-        "operationId": "postDagAction",
+        "operationId": "cancelQueuedRun",
         "parameters": [
           {
             "type": "string",
-            "name": "dagId",
+            "name": "requestId",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "type": "object",
-              "required": [
-                "action"
-              ],
-              "properties": {
-                "action": {
-                  "type": "string",
-                  "enum": [
-                    "start",
-                    "suspend",
-                    "stop",
-                    "retry",
-                    "mark-success",
-                    "mark-failed",
-                    "save",
-                    "rename"
-                  ]
-                },
-                "params": {
-                  "type": "string"
-                },
-                "requestId": {
-                  "type": "string"
-                },
-                "step": {
-                  "type": "string"
-                },
-                "value": {
-                  "type": "string"
-                }
-              }
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/postDagActionResponse"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -1344,26 +3492,45 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Deletes a DAG.",
+      "patch": {
+        "description": "Changes the priority of a queued run. The due runs of a DAG are started in the order of the priority, the highest first.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "dags"
         ],
-        "operationId": "deleteDag",
+        "operationId": "updateQueuedRun",
         "parameters": [
           {
             "type": "string",
-            "name": "dagId",
+            "name": "requestId",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "Priority"
+              ],
+              "properties": {
+                "Priority": {
+                  "type": "integer"
+                }
+              }
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/queuedRun"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1390,6 +3557,12 @@ func init() {
             "name": "q",
             "in": "query",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "Returns only the DAGs with a recent run having the labels (e.g. customer=acme,backfill=true).",
+            "name": "labels",
+            "in": "query"
           }
         ],
         "responses": {
@@ -1433,6 +3606,50 @@ func init() {
           }
         }
       }
+    },
+    "/timeline": {
+      "get": {
+        "description": "Simulates the scheduler to list the runs expected in the next hours, including the ones skipped because the DAG is suspended or out of its time window.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "dags"
+        ],
+        "operationId": "getTimeline",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "The number of the hours from now to simulate.",
+            "name": "hours",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "searchName",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "searchTag",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/timelineResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
     }
   },
   "definitions": {
@@ -1443,6 +3660,9 @@ func init() {
         "detailedMessage"
       ],
       "properties": {
+        "code": {
+          "$ref": "#/definitions/ErrorCode"
+        },
         "detailedMessage": {
           "type": "string"
         },
@@ -1451,6 +3671,21 @@ func init() {
         }
       }
     },
+    "ErrorCode": {
+      "description": "The stable code of the error to tell the errors apart without the messages.\n",
+      "type": "string",
+      "enum": [
+        "BAD_REQUEST",
+        "FORBIDDEN",
+        "NOT_FOUND",
+        "DAG_NOT_FOUND",
+        "DAG_RUNNING",
+        "SPEC_INVALID",
+        "STORE_UNAVAILABLE",
+        "REMOTE_NODE_UNAVAILABLE",
+        "INTERNAL_ERROR"
+      ]
+    },
     "condition": {
       "type": "object",
       "properties": {
@@ -1462,6 +3697,28 @@ func init() {
         }
       }
     },
+    "configValueSource": {
+      "type": "object",
+      "required": [
+        "Key",
+        "Value",
+        "File"
+      ],
+      "properties": {
+        "File": {
+          "description": "The file the value comes from.",
+          "type": "string"
+        },
+        "Key": {
+          "description": "The path of the value, e.g. \"smtp\" or \"handlerOn.failure.command\".",
+          "type": "string"
+        },
+        "Value": {
+          "description": "The value in YAML.",
+          "type": "string"
+        }
+      }
+    },
     "createDagResponse": {
       "type": "object",
       "required": [
@@ -1497,6 +3754,10 @@ func init() {
         "Name": {
           "type": "string"
         },
+        "Namespace": {
+          "description": "The namespace of the DAG, which is empty for the DAGs not in any namespace.",
+          "type": "string"
+        },
         "Params": {
           "type": "array",
           "items": {
@@ -1517,6 +3778,51 @@ func init() {
         }
       }
     },
+    "dagAnalyticsResponse": {
+      "type": "object",
+      "required": [
+        "Runs",
+        "Steps"
+      ],
+      "properties": {
+        "Runs": {
+          "description": "The recent runs, the newest first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/runTrend"
+          }
+        },
+        "Steps": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/stepTrend"
+          }
+        }
+      }
+    },
+    "dagConfigSourcesResponse": {
+      "type": "object",
+      "required": [
+        "Files",
+        "Values"
+      ],
+      "properties": {
+        "Files": {
+          "description": "The base configs and the DAG file in the order they are applied.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "Values": {
+          "description": "The effective values sorted by key.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configValueSource"
+          }
+        }
+      }
+    },
     "dagDetail": {
       "type": "object",
       "required": [
@@ -1574,6 +3880,9 @@ func init() {
         "Name": {
           "type": "string"
         },
+        "Namespace": {
+          "type": "string"
+        },
         "Params": {
           "type": "array",
           "items": {
@@ -1606,6 +3915,31 @@ func init() {
         }
       }
     },
+    "dagFailuresResponse": {
+      "type": "object",
+      "required": [
+        "Runs",
+        "FailedRuns",
+        "Steps"
+      ],
+      "properties": {
+        "FailedRuns": {
+          "description": "The number of the analyzed runs that failed.",
+          "type": "integer"
+        },
+        "Runs": {
+          "description": "The number of the analyzed runs.",
+          "type": "integer"
+        },
+        "Steps": {
+          "description": "The failed steps, the most failed first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/stepFailures"
+          }
+        }
+      }
+    },
     "dagListItem": {
       "type": "object",
       "required": [
@@ -1633,11 +3967,17 @@ func init() {
         "File": {
           "type": "string"
         },
+        "RemoteNode": {
+          "type": "string"
+        },
         "Status": {
           "$ref": "#/definitions/dagStatus"
         },
         "Suspended": {
           "type": "boolean"
+        },
+        "Suspension": {
+          "$ref": "#/definitions/suspension"
         }
       }
     },
@@ -1712,6 +4052,12 @@ func init() {
         "FinishedAt": {
           "type": "string"
         },
+        "Labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
         "Log": {
           "type": "string"
         },
@@ -1760,6 +4106,16 @@ func init() {
         "FinishedAt": {
           "type": "string"
         },
+        "Heartbeat": {
+          "description": "Time the agent running the DAG was last alive",
+          "type": "string"
+        },
+        "Labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
         "Log": {
           "type": "string"
         },
@@ -1772,6 +4128,12 @@ func init() {
             "$ref": "#/definitions/statusNode"
           }
         },
+        "Notes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/runNote"
+          }
+        },
         "OnCancel": {
           "$ref": "#/definitions/statusNode"
         },
@@ -1784,6 +4146,9 @@ func init() {
         "OnSuccess": {
           "$ref": "#/definitions/statusNode"
         },
+        "OnTimeout": {
+          "$ref": "#/definitions/statusNode"
+        },
         "Params": {
           "type": "string"
         },
@@ -1850,6 +4215,9 @@ func init() {
         },
         "Suspended": {
           "type": "boolean"
+        },
+        "Suspension": {
+          "$ref": "#/definitions/suspension"
         }
       }
     },
@@ -1861,150 +4229,472 @@ func init() {
         "Content"
       ],
       "properties": {
-        "Content": {
-          "type": "string"
-        },
-        "LogFile": {
-          "type": "string"
-        },
-        "Step": {
-          "$ref": "#/definitions/statusNode"
+        "Content": {
+          "type": "string"
+        },
+        "LogFile": {
+          "type": "string"
+        },
+        "Step": {
+          "$ref": "#/definitions/statusNode"
+        }
+      }
+    },
+    "delayedStart": {
+      "type": "object",
+      "required": [
+        "RequestId",
+        "StartAt",
+        "RegisteredAt"
+      ],
+      "properties": {
+        "Labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "Params": {
+          "type": "string"
+        },
+        "RegisteredAt": {
+          "type": "string"
+        },
+        "RequestId": {
+          "type": "string"
+        },
+        "StartAt": {
+          "type": "string"
+        }
+      }
+    },
+    "errorCount": {
+      "type": "object",
+      "required": [
+        "Error",
+        "Count"
+      ],
+      "properties": {
+        "Count": {
+          "type": "integer"
+        },
+        "Error": {
+          "type": "string"
+        }
+      }
+    },
+    "getDagDetailsResponse": {
+      "type": "object",
+      "required": [
+        "Title",
+        "DAG",
+        "Tab",
+        "Graph",
+        "Definition",
+        "LogData",
+        "LogUrl",
+        "StepLog",
+        "ScLog",
+        "Errors"
+      ],
+      "properties": {
+        "DAG": {
+          "$ref": "#/definitions/dagStatusWithDetails"
+        },
+        "Definition": {
+          "type": "string"
+        },
+        "DelayedStarts": {
+          "description": "The runs registered to be started later, the earliest first. Only set in the status tab.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/delayedStart"
+          }
+        },
+        "Errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "Graph": {
+          "type": "string"
+        },
+        "LogData": {
+          "$ref": "#/definitions/dagLogResponse"
+        },
+        "LogUrl": {
+          "type": "string"
+        },
+        "ScLog": {
+          "$ref": "#/definitions/dagSchedulerLogResponse"
+        },
+        "StepLog": {
+          "$ref": "#/definitions/dagStepLogResponse"
+        },
+        "Tab": {
+          "type": "string"
+        },
+        "Title": {
+          "type": "string"
+        }
+      }
+    },
+    "handlerOn": {
+      "type": "object",
+      "properties": {
+        "Cancel": {
+          "$ref": "#/definitions/stepObject"
+        },
+        "Exit": {
+          "$ref": "#/definitions/stepObject"
+        },
+        "Failure": {
+          "$ref": "#/definitions/stepObject"
+        },
+        "Success": {
+          "$ref": "#/definitions/stepObject"
+        }
+      }
+    },
+    "lineageRun": {
+      "type": "object",
+      "required": [
+        "DAG",
+        "RequestId",
+        "Status",
+        "StatusText",
+        "StartedAt",
+        "Trigger"
+      ],
+      "properties": {
+        "DAG": {
+          "description": "The ID of the DAG.",
+          "type": "string"
+        },
+        "ParentDAG": {
+          "description": "The ID of the DAG of the parent run.",
+          "type": "string"
+        },
+        "ParentRequestId": {
+          "description": "The request ID of the run that started the run as a sub workflow.",
+          "type": "string"
+        },
+        "RequestId": {
+          "type": "string"
+        },
+        "RetryOf": {
+          "description": "The request ID of the run of the same DAG retried by the run.",
+          "type": "string"
+        },
+        "Schedule": {
+          "description": "The cron expression of the schedule that started the run.",
+          "type": "string"
+        },
+        "StartedAt": {
+          "type": "string"
+        },
+        "Status": {
+          "type": "integer"
+        },
+        "StatusText": {
+          "type": "string"
+        },
+        "Trigger": {
+          "description": "What started the run, \"manual\", \"api\", \"schedule\", \"restart\", \"retry\" or \"parent\". It's empty for the runs recorded before the lineage.",
+          "type": "string"
+        }
+      }
+    },
+    "lintWarning": {
+      "type": "object",
+      "required": [
+        "Message"
+      ],
+      "properties": {
+        "Message": {
+          "type": "string"
+        },
+        "Step": {
+          "type": "string"
+        }
+      }
+    },
+    "listDagsResponse": {
+      "type": "object",
+      "required": [
+        "DAGs",
+        "Errors",
+        "HasError",
+        "PageCount"
+      ],
+      "properties": {
+        "DAGs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dagListItem"
+          }
+        },
+        "Errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "HasError": {
+          "type": "boolean"
+        },
+        "PageCount": {
+          "type": "integer"
+        },
+        "Timestamp": {
+          "description": "The time the list was read. Pass it as since to get only the DAGs that changed afterwards.",
+          "type": "string"
+        }
+      }
+    },
+    "listTagResponse": {
+      "type": "object",
+      "required": [
+        "Tags",
+        "Errors"
+      ],
+      "properties": {
+        "Errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "Tags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "postDagActionResponse": {
+      "type": "object",
+      "properties": {
+        "NewDagID": {
+          "type": "string"
+        },
+        "RequestId": {
+          "type": "string"
+        },
+        "StopResult": {
+          "description": "The result of the stop action.",
+          "type": "string",
+          "enum": [
+            "signalled",
+            "already-stopped",
+            "not-running"
+          ]
+        },
+        "Warnings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lintWarning"
+          }
+        }
+      }
+    },
+    "postRunNoteResponse": {
+      "type": "object",
+      "required": [
+        "Notes"
+      ],
+      "properties": {
+        "Notes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/runNote"
+          }
+        }
+      }
+    },
+    "queueResponse": {
+      "type": "object",
+      "required": [
+        "Runs"
+      ],
+      "properties": {
+        "Runs": {
+          "description": "The queued runs in the order they are started.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/queuedRun"
+          }
         }
       }
     },
-    "getDagDetailsResponse": {
+    "queuedRun": {
       "type": "object",
       "required": [
-        "Title",
         "DAG",
-        "Tab",
-        "Graph",
-        "Definition",
-        "LogData",
-        "LogUrl",
-        "StepLog",
-        "ScLog",
-        "Errors"
+        "RequestId",
+        "Position",
+        "Reason",
+        "EnqueuedAt",
+        "StartAt",
+        "Priority"
       ],
       "properties": {
         "DAG": {
-          "$ref": "#/definitions/dagStatusWithDetails"
+          "description": "The ID of the DAG. It's the location of the DAG file if the DAG can't be loaded.",
+          "type": "string"
         },
-        "Definition": {
+        "EnqueuedAt": {
+          "description": "The time the run was queued in RFC 3339.",
           "type": "string"
         },
-        "Errors": {
-          "type": "array",
-          "items": {
+        "Labels": {
+          "type": "object",
+          "additionalProperties": {
             "type": "string"
           }
         },
-        "Graph": {
+        "Params": {
           "type": "string"
         },
-        "LogData": {
-          "$ref": "#/definitions/dagLogResponse"
-        },
-        "LogUrl": {
-          "type": "string"
+        "Position": {
+          "description": "The 1-based position of the run in the queue of its DAG.",
+          "type": "integer"
         },
-        "ScLog": {
-          "$ref": "#/definitions/dagSchedulerLogResponse"
+        "Priority": {
+          "type": "integer"
         },
-        "StepLog": {
-          "$ref": "#/definitions/dagStepLogResponse"
+        "Reason": {
+          "description": "Why the run is waiting, \"delayed\" (for its start time), \"concurrency\" (for the current run of the DAG to finish) or \"due\" (to be started in the next round of the scheduler).",
+          "type": "string"
         },
-        "Tab": {
+        "RequestId": {
           "type": "string"
         },
-        "Title": {
+        "SkipSteps": {
+          "description": "The names of the steps to skip in the run.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "StartAt": {
+          "description": "The earliest time the run is started in RFC 3339.",
           "type": "string"
         }
       }
     },
-    "handlerOn": {
+    "repeatPolicy": {
       "type": "object",
       "properties": {
-        "Cancel": {
-          "$ref": "#/definitions/stepObject"
-        },
-        "Exit": {
-          "$ref": "#/definitions/stepObject"
-        },
-        "Failure": {
-          "$ref": "#/definitions/stepObject"
+        "Interval": {
+          "type": "integer"
         },
-        "Success": {
-          "$ref": "#/definitions/stepObject"
+        "Repeat": {
+          "type": "boolean"
         }
       }
     },
-    "listDagsResponse": {
+    "resourceUsage": {
+      "description": "The resource usage of the command of the step.",
       "type": "object",
       "required": [
-        "DAGs",
-        "Errors",
-        "HasError",
-        "PageCount"
+        "UserCPUTimeMs",
+        "SystemCPUTimeMs",
+        "MaxRSSBytes",
+        "WallTimeMs"
       ],
       "properties": {
-        "DAGs": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/dagListItem"
-          }
+        "MaxRSSBytes": {
+          "description": "The maximum resident set size in bytes.",
+          "type": "integer"
         },
-        "Errors": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "SystemCPUTimeMs": {
+          "description": "The CPU time spent in the kernel mode in milliseconds.",
+          "type": "integer"
         },
-        "HasError": {
-          "type": "boolean"
+        "UserCPUTimeMs": {
+          "description": "The CPU time spent in the user mode in milliseconds.",
+          "type": "integer"
         },
-        "PageCount": {
+        "WallTimeMs": {
+          "description": "The elapsed time of the command in milliseconds.",
           "type": "integer"
         }
       }
     },
-    "listTagResponse": {
+    "runLineageResponse": {
       "type": "object",
       "required": [
-        "Tags",
-        "Errors"
+        "Run",
+        "Ancestors",
+        "OriginFound",
+        "Descendants"
       ],
       "properties": {
-        "Errors": {
+        "Ancestors": {
+          "description": "The runs the run comes from, the origin first.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/lineageRun"
           }
         },
-        "Tags": {
+        "Descendants": {
+          "description": "The runs triggered by the run and by its descendants, in the order they started.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/lineageRun"
           }
+        },
+        "OriginFound": {
+          "description": "Whether the first ancestor is the origin. It's false if an ancestor is no longer in the history.",
+          "type": "boolean"
+        },
+        "Run": {
+          "$ref": "#/definitions/lineageRun"
         }
       }
     },
-    "postDagActionResponse": {
+    "runNote": {
       "type": "object",
+      "required": [
+        "Text",
+        "CreatedAt"
+      ],
       "properties": {
-        "NewDagID": {
+        "CreatedAt": {
+          "type": "string"
+        },
+        "Text": {
           "type": "string"
         }
       }
     },
-    "repeatPolicy": {
+    "runTrend": {
       "type": "object",
+      "required": [
+        "RequestId",
+        "Status",
+        "StatusText",
+        "StartedAt",
+        "DurationMs"
+      ],
       "properties": {
-        "Interval": {
+        "DurationMs": {
           "type": "integer"
         },
-        "Repeat": {
-          "type": "boolean"
+        "RequestId": {
+          "type": "string"
+        },
+        "StartedAt": {
+          "type": "string"
+        },
+        "Status": {
+          "type": "integer"
+        },
+        "StatusText": {
+          "type": "string"
         }
       }
     },
@@ -2014,8 +4704,24 @@ func init() {
         "Expression"
       ],
       "properties": {
+        "Cron": {
+          "description": "The cron expression without the time zone prefix.",
+          "type": "string"
+        },
         "Expression": {
           "type": "string"
+        },
+        "NextRun": {
+          "description": "The next fire time in RFC 3339.",
+          "type": "string"
+        },
+        "PrevRun": {
+          "description": "The last fire time in RFC 3339.",
+          "type": "string"
+        },
+        "Timezone": {
+          "description": "The time zone the schedule is evaluated in.",
+          "type": "string"
         }
       }
     },
@@ -2071,6 +4777,34 @@ func init() {
         }
       }
     },
+    "signalStepBody": {
+      "type": "object",
+      "required": [
+        "signal"
+      ],
+      "properties": {
+        "signal": {
+          "description": "The name of the signal, e.g. SIGHUP or SIGUSR1.",
+          "type": "string"
+        }
+      }
+    },
+    "startDagBody": {
+      "type": "object",
+      "properties": {
+        "params": {
+          "description": "The named parameters of the run.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "requestId": {
+          "description": "The request ID of the run, up to 64 letters, digits, hyphens and underscores. A random ID is used when it's empty.",
+          "type": "string"
+        }
+      }
+    },
     "statusNode": {
       "type": "object",
       "required": [
@@ -2085,6 +4819,12 @@ func init() {
         "StatusText"
       ],
       "properties": {
+        "Artifacts": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "DoneCount": {
           "type": "integer"
         },
@@ -2097,9 +4837,16 @@ func init() {
         "Log": {
           "type": "string"
         },
+        "ResourceUsage": {
+          "$ref": "#/definitions/resourceUsage"
+        },
         "RetryCount": {
           "type": "integer"
         },
+        "SkipRequested": {
+          "description": "Whether the step was requested to be skipped in the run.",
+          "type": "boolean"
+        },
         "StartedAt": {
           "type": "string"
         },
@@ -2111,6 +4858,46 @@ func init() {
         },
         "Step": {
           "$ref": "#/definitions/stepObject"
+        },
+        "SubRequestId": {
+          "description": "Request ID of the run of the sub DAG started by the step.",
+          "type": "string"
+        }
+      }
+    },
+    "stepFailures": {
+      "type": "object",
+      "required": [
+        "Name",
+        "Runs",
+        "Failures",
+        "FailureRate",
+        "LastFailedAt",
+        "Errors"
+      ],
+      "properties": {
+        "Errors": {
+          "description": "The most common errors of the step, the most common first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/errorCount"
+          }
+        },
+        "FailureRate": {
+          "type": "number"
+        },
+        "Failures": {
+          "type": "integer"
+        },
+        "LastFailedAt": {
+          "type": "string"
+        },
+        "Name": {
+          "type": "string"
+        },
+        "Runs": {
+          "description": "The number of the runs in which the step was executed.",
+          "type": "integer"
         }
       }
     },
@@ -2198,6 +4985,133 @@ func init() {
           }
         }
       }
+    },
+    "stepTrend": {
+      "description": "The durations and the resource usage of a step across its successful runs.",
+      "type": "object",
+      "required": [
+        "Name",
+        "Runs",
+        "LatestDurationMs",
+        "BaselineDurationMs",
+        "AverageDurationMs",
+        "AverageCPUTimeMs",
+        "MaxRSSBytes",
+        "LatestMaxRSSBytes",
+        "Ratio",
+        "Regressed"
+      ],
+      "properties": {
+        "AverageCPUTimeMs": {
+          "type": "integer"
+        },
+        "AverageDurationMs": {
+          "type": "integer"
+        },
+        "BaselineDurationMs": {
+          "description": "The median duration of the successful runs before the latest one.",
+          "type": "integer"
+        },
+        "LatestDurationMs": {
+          "type": "integer"
+        },
+        "LatestMaxRSSBytes": {
+          "type": "integer"
+        },
+        "MaxRSSBytes": {
+          "type": "integer"
+        },
+        "Name": {
+          "type": "string"
+        },
+        "Ratio": {
+          "description": "The latest duration divided by the baseline.",
+          "type": "number"
+        },
+        "Regressed": {
+          "type": "boolean"
+        },
+        "Runs": {
+          "description": "The number of the successful runs of the step.",
+          "type": "integer"
+        }
+      }
+    },
+    "suspension": {
+      "type": "object",
+      "properties": {
+        "ExpiresAt": {
+          "description": "The time the suspension is lifted. It's empty if the DAG is suspended until it's resumed.",
+          "type": "string"
+        },
+        "Reason": {
+          "type": "string"
+        },
+        "SuspendedAt": {
+          "type": "string"
+        }
+      }
+    },
+    "timelineResponse": {
+      "type": "object",
+      "required": [
+        "From",
+        "To",
+        "Runs"
+      ],
+      "properties": {
+        "From": {
+          "description": "The start of the simulated period in RFC 3339.",
+          "type": "string"
+        },
+        "Runs": {
+          "description": "The runs sorted by time.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/timelineRun"
+          }
+        },
+        "To": {
+          "description": "The end of the simulated period in RFC 3339.",
+          "type": "string"
+        }
+      }
+    },
+    "timelineRun": {
+      "type": "object",
+      "required": [
+        "DAG",
+        "Time",
+        "Operation",
+        "Schedule",
+        "Skipped"
+      ],
+      "properties": {
+        "DAG": {
+          "description": "The ID of the DAG.",
+          "type": "string"
+        },
+        "Operation": {
+          "description": "What the scheduler does, \"start\", \"stop\" or \"restart\".",
+          "type": "string"
+        },
+        "Reason": {
+          "description": "Why the run is expected to be skipped.",
+          "type": "string"
+        },
+        "Schedule": {
+          "description": "The cron expression of the schedule.",
+          "type": "string"
+        },
+        "Skipped": {
+          "description": "Whether the run is expected to be skipped.",
+          "type": "boolean"
+        },
+        "Time": {
+          "description": "The scheduled time in RFC 3339.",
+          "type": "string"
+        }
+      }
     }
   },
   "tags": [
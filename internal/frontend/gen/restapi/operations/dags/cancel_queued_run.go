@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CancelQueuedRunHandlerFunc turns a function with the right signature into a cancel queued run handler
+type CancelQueuedRunHandlerFunc func(CancelQueuedRunParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CancelQueuedRunHandlerFunc) Handle(params CancelQueuedRunParams) middleware.Responder {
+	return fn(params)
+}
+
+// CancelQueuedRunHandler interface for that can handle valid cancel queued run params
+type CancelQueuedRunHandler interface {
+	Handle(CancelQueuedRunParams) middleware.Responder
+}
+
+// NewCancelQueuedRun creates a new http.Handler for the cancel queued run operation
+func NewCancelQueuedRun(ctx *middleware.Context, handler CancelQueuedRunHandler) *CancelQueuedRun {
+	return &CancelQueuedRun{Context: ctx, Handler: handler}
+}
+
+/*
+	CancelQueuedRun swagger:route DELETE /queue/{requestId} dags cancelQueuedRun
+
+Cancels a queued run. The run is removed from the queue and never started.
+*/
+type CancelQueuedRun struct {
+	Context *middleware.Context
+	Handler CancelQueuedRunHandler
+}
+
+func (o *CancelQueuedRun) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCancelQueuedRunParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
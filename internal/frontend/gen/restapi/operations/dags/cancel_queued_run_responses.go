@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+)
+
+// CancelQueuedRunOKCode is the HTTP code returned for type CancelQueuedRunOK
+const CancelQueuedRunOKCode int = 200
+
+/*
+CancelQueuedRunOK A successful response.
+
+swagger:response cancelQueuedRunOK
+*/
+type CancelQueuedRunOK struct {
+}
+
+// NewCancelQueuedRunOK creates CancelQueuedRunOK with default headers values
+func NewCancelQueuedRunOK() *CancelQueuedRunOK {
+
+	return &CancelQueuedRunOK{}
+}
+
+// WriteResponse to the client
+func (o *CancelQueuedRunOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+/*
+CancelQueuedRunDefault Generic error response.
+
+swagger:response cancelQueuedRunDefault
+*/
+type CancelQueuedRunDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewCancelQueuedRunDefault creates CancelQueuedRunDefault with default headers values
+func NewCancelQueuedRunDefault(code int) *CancelQueuedRunDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CancelQueuedRunDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the cancel queued run default response
+func (o *CancelQueuedRunDefault) WithStatusCode(code int) *CancelQueuedRunDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the cancel queued run default response
+func (o *CancelQueuedRunDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the cancel queued run default response
+func (o *CancelQueuedRunDefault) WithPayload(payload *models.APIError) *CancelQueuedRunDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel queued run default response
+func (o *CancelQueuedRunDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelQueuedRunDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
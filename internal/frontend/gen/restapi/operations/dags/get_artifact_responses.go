@@ -0,0 +1,117 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+)
+
+// GetArtifactOKCode is the HTTP code returned for type GetArtifactOK
+const GetArtifactOKCode int = 200
+
+/*
+GetArtifactOK A successful response.
+
+swagger:response getArtifactOK
+*/
+type GetArtifactOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewGetArtifactOK creates GetArtifactOK with default headers values
+func NewGetArtifactOK() *GetArtifactOK {
+
+	return &GetArtifactOK{}
+}
+
+// WithPayload adds the payload to the get artifact o k response
+func (o *GetArtifactOK) WithPayload(payload io.ReadCloser) *GetArtifactOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get artifact o k response
+func (o *GetArtifactOK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetArtifactOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+GetArtifactDefault Generic error response.
+
+swagger:response getArtifactDefault
+*/
+type GetArtifactDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewGetArtifactDefault creates GetArtifactDefault with default headers values
+func NewGetArtifactDefault(code int) *GetArtifactDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetArtifactDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get artifact default response
+func (o *GetArtifactDefault) WithStatusCode(code int) *GetArtifactDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get artifact default response
+func (o *GetArtifactDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get artifact default response
+func (o *GetArtifactDefault) WithPayload(payload *models.APIError) *GetArtifactDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get artifact default response
+func (o *GetArtifactDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetArtifactDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
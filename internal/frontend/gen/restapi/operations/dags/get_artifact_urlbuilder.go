@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+	"strings"
+)
+
+// GetArtifactURL generates an URL for the get artifact operation
+type GetArtifactURL struct {
+	ArtifactName string
+	DagID        string
+	RequestID    string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetArtifactURL) WithBasePath(bp string) *GetArtifactURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetArtifactURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *GetArtifactURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/dags/{dagId}/requests/{requestId}/artifacts/{artifactName}"
+
+	artifactName := o.ArtifactName
+	if artifactName != "" {
+		_path = strings.Replace(_path, "{artifactName}", artifactName, -1)
+	} else {
+		return nil, errors.New("artifactName is required on GetArtifactURL")
+	}
+
+	dagID := o.DagID
+	if dagID != "" {
+		_path = strings.Replace(_path, "{dagId}", dagID, -1)
+	} else {
+		return nil, errors.New("dagId is required on GetArtifactURL")
+	}
+
+	requestID := o.RequestID
+	if requestID != "" {
+		_path = strings.Replace(_path, "{requestId}", requestID, -1)
+	} else {
+		return nil, errors.New("requestId is required on GetArtifactURL")
+	}
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/api/v1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *GetArtifactURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *GetArtifactURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *GetArtifactURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on GetArtifactURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on GetArtifactURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *GetArtifactURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
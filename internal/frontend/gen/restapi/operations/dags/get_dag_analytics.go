@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetDagAnalyticsHandlerFunc turns a function with the right signature into a get dag analytics handler
+type GetDagAnalyticsHandlerFunc func(GetDagAnalyticsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetDagAnalyticsHandlerFunc) Handle(params GetDagAnalyticsParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetDagAnalyticsHandler interface for that can handle valid get dag analytics params
+type GetDagAnalyticsHandler interface {
+	Handle(GetDagAnalyticsParams) middleware.Responder
+}
+
+// NewGetDagAnalytics creates a new http.Handler for the get dag analytics operation
+func NewGetDagAnalytics(ctx *middleware.Context, handler GetDagAnalyticsHandler) *GetDagAnalytics {
+	return &GetDagAnalytics{Context: ctx, Handler: handler}
+}
+
+/*
+	GetDagAnalytics swagger:route GET /dags/{dagId}/analytics dags getDagAnalytics
+
+Returns the duration and resource usage trends of the recent runs of a DAG.
+*/
+type GetDagAnalytics struct {
+	Context *middleware.Context
+	Handler GetDagAnalyticsHandler
+}
+
+func (o *GetDagAnalytics) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetDagAnalyticsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetDagAnalyticsParams creates a new GetDagAnalyticsParams object
+//
+// There are no default values defined in the spec.
+func NewGetDagAnalyticsParams() GetDagAnalyticsParams {
+
+	return GetDagAnalyticsParams{}
+}
+
+// GetDagAnalyticsParams contains all the bound params for the get dag analytics operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters getDagAnalytics
+type GetDagAnalyticsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: path
+	*/
+	DagID string
+	/*The number of the recent runs to analyze.
+	  In: query
+	*/
+	Limit *int64
+	/*The ratio of the latest duration of a step to its baseline above which the step is regarded as regressed.
+	  In: query
+	*/
+	Threshold *float64
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetDagAnalyticsParams() beforehand.
+func (o *GetDagAnalyticsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	rDagID, rhkDagID, _ := route.Params.GetOK("dagId")
+	if err := o.bindDagID(rDagID, rhkDagID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qThreshold, qhkThreshold, _ := qs.GetOK("threshold")
+	if err := o.bindThreshold(qThreshold, qhkThreshold, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindDagID binds and validates parameter DagID from path.
+func (o *GetDagAnalyticsParams) bindDagID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.DagID = raw
+
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *GetDagAnalyticsParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	return nil
+}
+
+// bindThreshold binds and validates parameter Threshold from query.
+func (o *GetDagAnalyticsParams) bindThreshold(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertFloat64(raw)
+	if err != nil {
+		return errors.InvalidType("threshold", "query", "float64", raw)
+	}
+	o.Threshold = &value
+
+	return nil
+}
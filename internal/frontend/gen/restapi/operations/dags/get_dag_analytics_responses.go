@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+)
+
+// GetDagAnalyticsOKCode is the HTTP code returned for type GetDagAnalyticsOK
+const GetDagAnalyticsOKCode int = 200
+
+/*
+GetDagAnalyticsOK A successful response.
+
+swagger:response getDagAnalyticsOK
+*/
+type GetDagAnalyticsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.DagAnalyticsResponse `json:"body,omitempty"`
+}
+
+// NewGetDagAnalyticsOK creates GetDagAnalyticsOK with default headers values
+func NewGetDagAnalyticsOK() *GetDagAnalyticsOK {
+
+	return &GetDagAnalyticsOK{}
+}
+
+// WithPayload adds the payload to the get dag analytics o k response
+func (o *GetDagAnalyticsOK) WithPayload(payload *models.DagAnalyticsResponse) *GetDagAnalyticsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get dag analytics o k response
+func (o *GetDagAnalyticsOK) SetPayload(payload *models.DagAnalyticsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetDagAnalyticsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetDagAnalyticsDefault Generic error response.
+
+swagger:response getDagAnalyticsDefault
+*/
+type GetDagAnalyticsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewGetDagAnalyticsDefault creates GetDagAnalyticsDefault with default headers values
+func NewGetDagAnalyticsDefault(code int) *GetDagAnalyticsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetDagAnalyticsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get dag analytics default response
+func (o *GetDagAnalyticsDefault) WithStatusCode(code int) *GetDagAnalyticsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get dag analytics default response
+func (o *GetDagAnalyticsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get dag analytics default response
+func (o *GetDagAnalyticsDefault) WithPayload(payload *models.APIError) *GetDagAnalyticsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get dag analytics default response
+func (o *GetDagAnalyticsDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetDagAnalyticsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetDagConfigSourcesHandlerFunc turns a function with the right signature into a get dag config sources handler
+type GetDagConfigSourcesHandlerFunc func(GetDagConfigSourcesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetDagConfigSourcesHandlerFunc) Handle(params GetDagConfigSourcesParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetDagConfigSourcesHandler interface for that can handle valid get dag config sources params
+type GetDagConfigSourcesHandler interface {
+	Handle(GetDagConfigSourcesParams) middleware.Responder
+}
+
+// NewGetDagConfigSources creates a new http.Handler for the get dag config sources operation
+func NewGetDagConfigSources(ctx *middleware.Context, handler GetDagConfigSourcesHandler) *GetDagConfigSources {
+	return &GetDagConfigSources{Context: ctx, Handler: handler}
+}
+
+/*
+	GetDagConfigSources swagger:route GET /dags/{dagId}/config-sources dags getDagConfigSources
+
+Returns the effective configuration values of a DAG and the base config or the DAG file each value comes from, for debugging the base config chain.
+*/
+type GetDagConfigSources struct {
+	Context *middleware.Context
+	Handler GetDagConfigSourcesHandler
+}
+
+func (o *GetDagConfigSources) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetDagConfigSourcesParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
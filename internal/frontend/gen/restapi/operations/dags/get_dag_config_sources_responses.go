@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+)
+
+// GetDagConfigSourcesOKCode is the HTTP code returned for type GetDagConfigSourcesOK
+const GetDagConfigSourcesOKCode int = 200
+
+/*
+GetDagConfigSourcesOK A successful response.
+
+swagger:response getDagConfigSourcesOK
+*/
+type GetDagConfigSourcesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.DagConfigSourcesResponse `json:"body,omitempty"`
+}
+
+// NewGetDagConfigSourcesOK creates GetDagConfigSourcesOK with default headers values
+func NewGetDagConfigSourcesOK() *GetDagConfigSourcesOK {
+
+	return &GetDagConfigSourcesOK{}
+}
+
+// WithPayload adds the payload to the get dag config sources o k response
+func (o *GetDagConfigSourcesOK) WithPayload(payload *models.DagConfigSourcesResponse) *GetDagConfigSourcesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get dag config sources o k response
+func (o *GetDagConfigSourcesOK) SetPayload(payload *models.DagConfigSourcesResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetDagConfigSourcesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetDagConfigSourcesDefault Generic error response.
+
+swagger:response getDagConfigSourcesDefault
+*/
+type GetDagConfigSourcesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewGetDagConfigSourcesDefault creates GetDagConfigSourcesDefault with default headers values
+func NewGetDagConfigSourcesDefault(code int) *GetDagConfigSourcesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetDagConfigSourcesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get dag config sources default response
+func (o *GetDagConfigSourcesDefault) WithStatusCode(code int) *GetDagConfigSourcesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get dag config sources default response
+func (o *GetDagConfigSourcesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get dag config sources default response
+func (o *GetDagConfigSourcesDefault) WithPayload(payload *models.APIError) *GetDagConfigSourcesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get dag config sources default response
+func (o *GetDagConfigSourcesDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetDagConfigSourcesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -40,6 +40,10 @@ type GetDagDetailsParams struct {
 	  In: query
 	*/
 	File *string
+	/*Filters the history by labels (e.g. customer=acme,backfill=true).
+	  In: query
+	*/
+	Labels *string
 	/*
 	  In: query
 	*/
@@ -71,6 +75,11 @@ func (o *GetDagDetailsParams) BindRequest(r *http.Request, route *middleware.Mat
 		res = append(res, err)
 	}
 
+	qLabels, qhkLabels, _ := qs.GetOK("labels")
+	if err := o.bindLabels(qLabels, qhkLabels, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qStep, qhkStep, _ := qs.GetOK("step")
 	if err := o.bindStep(qStep, qhkStep, route.Formats); err != nil {
 		res = append(res, err)
@@ -118,6 +127,24 @@ func (o *GetDagDetailsParams) bindFile(rawData []string, hasKey bool, formats st
 	return nil
 }
 
+// bindLabels binds and validates parameter Labels from query.
+func (o *GetDagDetailsParams) bindLabels(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Labels = &raw
+
+	return nil
+}
+
 // bindStep binds and validates parameter Step from query.
 func (o *GetDagDetailsParams) bindStep(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
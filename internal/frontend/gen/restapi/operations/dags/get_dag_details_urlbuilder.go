@@ -16,9 +16,10 @@ import (
 type GetDagDetailsURL struct {
 	DagID string
 
-	File *string
-	Step *string
-	Tab  *string
+	File   *string
+	Labels *string
+	Step   *string
+	Tab    *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -69,6 +70,14 @@ func (o *GetDagDetailsURL) Build() (*url.URL, error) {
 		qs.Set("file", fileQ)
 	}
 
+	var labelsQ string
+	if o.Labels != nil {
+		labelsQ = *o.Labels
+	}
+	if labelsQ != "" {
+		qs.Set("labels", labelsQ)
+	}
+
 	var stepQ string
 	if o.Step != nil {
 		stepQ = *o.Step
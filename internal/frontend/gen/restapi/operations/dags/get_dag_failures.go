@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetDagFailuresHandlerFunc turns a function with the right signature into a get dag failures handler
+type GetDagFailuresHandlerFunc func(GetDagFailuresParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetDagFailuresHandlerFunc) Handle(params GetDagFailuresParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetDagFailuresHandler interface for that can handle valid get dag failures params
+type GetDagFailuresHandler interface {
+	Handle(GetDagFailuresParams) middleware.Responder
+}
+
+// NewGetDagFailures creates a new http.Handler for the get dag failures operation
+func NewGetDagFailures(ctx *middleware.Context, handler GetDagFailuresHandler) *GetDagFailures {
+	return &GetDagFailures{Context: ctx, Handler: handler}
+}
+
+/*
+	GetDagFailures swagger:route GET /dags/{dagId}/failures dags getDagFailures
+
+Returns the steps of a DAG that failed most often in the recent runs with their most common errors.
+*/
+type GetDagFailures struct {
+	Context *middleware.Context
+	Handler GetDagFailuresHandler
+}
+
+func (o *GetDagFailures) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetDagFailuresParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
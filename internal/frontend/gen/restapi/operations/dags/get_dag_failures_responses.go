@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+)
+
+// GetDagFailuresOKCode is the HTTP code returned for type GetDagFailuresOK
+const GetDagFailuresOKCode int = 200
+
+/*
+GetDagFailuresOK A successful response.
+
+swagger:response getDagFailuresOK
+*/
+type GetDagFailuresOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.DagFailuresResponse `json:"body,omitempty"`
+}
+
+// NewGetDagFailuresOK creates GetDagFailuresOK with default headers values
+func NewGetDagFailuresOK() *GetDagFailuresOK {
+
+	return &GetDagFailuresOK{}
+}
+
+// WithPayload adds the payload to the get dag failures o k response
+func (o *GetDagFailuresOK) WithPayload(payload *models.DagFailuresResponse) *GetDagFailuresOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get dag failures o k response
+func (o *GetDagFailuresOK) SetPayload(payload *models.DagFailuresResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetDagFailuresOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetDagFailuresDefault Generic error response.
+
+swagger:response getDagFailuresDefault
+*/
+type GetDagFailuresDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewGetDagFailuresDefault creates GetDagFailuresDefault with default headers values
+func NewGetDagFailuresDefault(code int) *GetDagFailuresDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetDagFailuresDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get dag failures default response
+func (o *GetDagFailuresDefault) WithStatusCode(code int) *GetDagFailuresDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get dag failures default response
+func (o *GetDagFailuresDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get dag failures default response
+func (o *GetDagFailuresDefault) WithPayload(payload *models.APIError) *GetDagFailuresDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get dag failures default response
+func (o *GetDagFailuresDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetDagFailuresDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetDagGraphHandlerFunc turns a function with the right signature into a get dag graph handler
+type GetDagGraphHandlerFunc func(GetDagGraphParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetDagGraphHandlerFunc) Handle(params GetDagGraphParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetDagGraphHandler interface for that can handle valid get dag graph params
+type GetDagGraphHandler interface {
+	Handle(GetDagGraphParams) middleware.Responder
+}
+
+// NewGetDagGraph creates a new http.Handler for the get dag graph operation
+func NewGetDagGraph(ctx *middleware.Context, handler GetDagGraphHandler) *GetDagGraph {
+	return &GetDagGraph{Context: ctx, Handler: handler}
+}
+
+/*
+	GetDagGraph swagger:route GET /dags/{dagId}/graph.svg dags getDagGraph
+
+Renders the graph of the steps of a DAG with the statuses of a run as an SVG image.
+*/
+type GetDagGraph struct {
+	Context *middleware.Context
+	Handler GetDagGraphHandler
+}
+
+func (o *GetDagGraph) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetDagGraphParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,101 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetDagGraphParams creates a new GetDagGraphParams object
+//
+// There are no default values defined in the spec.
+func NewGetDagGraphParams() GetDagGraphParams {
+
+	return GetDagGraphParams{}
+}
+
+// GetDagGraphParams contains all the bound params for the get dag graph operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters getDagGraph
+type GetDagGraphParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: path
+	*/
+	DagID string
+	/*The request ID of the run. The latest run is used if not specified.
+	  In: query
+	*/
+	RequestID *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetDagGraphParams() beforehand.
+func (o *GetDagGraphParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	rDagID, rhkDagID, _ := route.Params.GetOK("dagId")
+	if err := o.bindDagID(rDagID, rhkDagID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qRequestID, qhkRequestID, _ := qs.GetOK("requestId")
+	if err := o.bindRequestID(qRequestID, qhkRequestID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindDagID binds and validates parameter DagID from path.
+func (o *GetDagGraphParams) bindDagID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.DagID = raw
+
+	return nil
+}
+
+// bindRequestID binds and validates parameter RequestID from query.
+func (o *GetDagGraphParams) bindRequestID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.RequestID = &raw
+
+	return nil
+}
@@ -0,0 +1,117 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+)
+
+// GetDagGraphOKCode is the HTTP code returned for type GetDagGraphOK
+const GetDagGraphOKCode int = 200
+
+/*
+GetDagGraphOK A successful response.
+
+swagger:response getDagGraphOK
+*/
+type GetDagGraphOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewGetDagGraphOK creates GetDagGraphOK with default headers values
+func NewGetDagGraphOK() *GetDagGraphOK {
+
+	return &GetDagGraphOK{}
+}
+
+// WithPayload adds the payload to the get dag graph o k response
+func (o *GetDagGraphOK) WithPayload(payload io.ReadCloser) *GetDagGraphOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get dag graph o k response
+func (o *GetDagGraphOK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetDagGraphOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+GetDagGraphDefault Generic error response.
+
+swagger:response getDagGraphDefault
+*/
+type GetDagGraphDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewGetDagGraphDefault creates GetDagGraphDefault with default headers values
+func NewGetDagGraphDefault(code int) *GetDagGraphDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetDagGraphDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get dag graph default response
+func (o *GetDagGraphDefault) WithStatusCode(code int) *GetDagGraphDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get dag graph default response
+func (o *GetDagGraphDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get dag graph default response
+func (o *GetDagGraphDefault) WithPayload(payload *models.APIError) *GetDagGraphDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get dag graph default response
+func (o *GetDagGraphDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetDagGraphDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetRunLineageHandlerFunc turns a function with the right signature into a get run lineage handler
+type GetRunLineageHandlerFunc func(GetRunLineageParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetRunLineageHandlerFunc) Handle(params GetRunLineageParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetRunLineageHandler interface for that can handle valid get run lineage params
+type GetRunLineageHandler interface {
+	Handle(GetRunLineageParams) middleware.Responder
+}
+
+// NewGetRunLineage creates a new http.Handler for the get run lineage operation
+func NewGetRunLineage(ctx *middleware.Context, handler GetRunLineageHandler) *GetRunLineage {
+	return &GetRunLineage{Context: ctx, Handler: handler}
+}
+
+/*
+	GetRunLineage swagger:route GET /dags/{dagId}/requests/{requestId}/lineage dags getRunLineage
+
+Returns the lineage of a DAG run, the runs it comes from back to its origin and the runs it triggered.
+*/
+type GetRunLineage struct {
+	Context *middleware.Context
+	Handler GetRunLineageHandler
+}
+
+func (o *GetRunLineage) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetRunLineageParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
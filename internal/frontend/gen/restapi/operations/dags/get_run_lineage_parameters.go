@@ -0,0 +1,95 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetRunLineageParams creates a new GetRunLineageParams object
+//
+// There are no default values defined in the spec.
+func NewGetRunLineageParams() GetRunLineageParams {
+
+	return GetRunLineageParams{}
+}
+
+// GetRunLineageParams contains all the bound params for the get run lineage operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters getRunLineage
+type GetRunLineageParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: path
+	*/
+	DagID string
+	/*
+	  Required: true
+	  In: path
+	*/
+	RequestID string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetRunLineageParams() beforehand.
+func (o *GetRunLineageParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rDagID, rhkDagID, _ := route.Params.GetOK("dagId")
+	if err := o.bindDagID(rDagID, rhkDagID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rRequestID, rhkRequestID, _ := route.Params.GetOK("requestId")
+	if err := o.bindRequestID(rRequestID, rhkRequestID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindDagID binds and validates parameter DagID from path.
+func (o *GetRunLineageParams) bindDagID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.DagID = raw
+
+	return nil
+}
+
+// bindRequestID binds and validates parameter RequestID from path.
+func (o *GetRunLineageParams) bindRequestID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.RequestID = raw
+
+	return nil
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+)
+
+// GetRunLineageOKCode is the HTTP code returned for type GetRunLineageOK
+const GetRunLineageOKCode int = 200
+
+/*
+GetRunLineageOK A successful response.
+
+swagger:response getRunLineageOK
+*/
+type GetRunLineageOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.RunLineageResponse `json:"body,omitempty"`
+}
+
+// NewGetRunLineageOK creates GetRunLineageOK with default headers values
+func NewGetRunLineageOK() *GetRunLineageOK {
+
+	return &GetRunLineageOK{}
+}
+
+// WithPayload adds the payload to the get run lineage o k response
+func (o *GetRunLineageOK) WithPayload(payload *models.RunLineageResponse) *GetRunLineageOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get run lineage o k response
+func (o *GetRunLineageOK) SetPayload(payload *models.RunLineageResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetRunLineageOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetRunLineageDefault Generic error response.
+
+swagger:response getRunLineageDefault
+*/
+type GetRunLineageDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewGetRunLineageDefault creates GetRunLineageDefault with default headers values
+func NewGetRunLineageDefault(code int) *GetRunLineageDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetRunLineageDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get run lineage default response
+func (o *GetRunLineageDefault) WithStatusCode(code int) *GetRunLineageDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get run lineage default response
+func (o *GetRunLineageDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get run lineage default response
+func (o *GetRunLineageDefault) WithPayload(payload *models.APIError) *GetRunLineageDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get run lineage default response
+func (o *GetRunLineageDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetRunLineageDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetScheduleCalendarHandlerFunc turns a function with the right signature into a get schedule calendar handler
+type GetScheduleCalendarHandlerFunc func(GetScheduleCalendarParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetScheduleCalendarHandlerFunc) Handle(params GetScheduleCalendarParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetScheduleCalendarHandler interface for that can handle valid get schedule calendar params
+type GetScheduleCalendarHandler interface {
+	Handle(GetScheduleCalendarParams) middleware.Responder
+}
+
+// NewGetScheduleCalendar creates a new http.Handler for the get schedule calendar operation
+func NewGetScheduleCalendar(ctx *middleware.Context, handler GetScheduleCalendarHandler) *GetScheduleCalendar {
+	return &GetScheduleCalendar{Context: ctx, Handler: handler}
+}
+
+/*
+	GetScheduleCalendar swagger:route GET /calendar.ics dags getScheduleCalendar
+
+Exports the scheduled runs of the DAGs as an iCalendar feed.
+*/
+type GetScheduleCalendar struct {
+	Context *middleware.Context
+	Handler GetScheduleCalendarHandler
+}
+
+func (o *GetScheduleCalendar) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetScheduleCalendarParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,137 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetScheduleCalendarParams creates a new GetScheduleCalendarParams object
+//
+// There are no default values defined in the spec.
+func NewGetScheduleCalendarParams() GetScheduleCalendarParams {
+
+	return GetScheduleCalendarParams{}
+}
+
+// GetScheduleCalendarParams contains all the bound params for the get schedule calendar operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters getScheduleCalendar
+type GetScheduleCalendarParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The number of the days from now to export the scheduled runs.
+	  In: query
+	*/
+	Days *int64
+	/*
+	  In: query
+	*/
+	SearchName *string
+	/*
+	  In: query
+	*/
+	SearchTag *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetScheduleCalendarParams() beforehand.
+func (o *GetScheduleCalendarParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qDays, qhkDays, _ := qs.GetOK("days")
+	if err := o.bindDays(qDays, qhkDays, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSearchName, qhkSearchName, _ := qs.GetOK("searchName")
+	if err := o.bindSearchName(qSearchName, qhkSearchName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSearchTag, qhkSearchTag, _ := qs.GetOK("searchTag")
+	if err := o.bindSearchTag(qSearchTag, qhkSearchTag, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindDays binds and validates parameter Days from query.
+func (o *GetScheduleCalendarParams) bindDays(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("days", "query", "int64", raw)
+	}
+	o.Days = &value
+
+	return nil
+}
+
+// bindSearchName binds and validates parameter SearchName from query.
+func (o *GetScheduleCalendarParams) bindSearchName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.SearchName = &raw
+
+	return nil
+}
+
+// bindSearchTag binds and validates parameter SearchTag from query.
+func (o *GetScheduleCalendarParams) bindSearchTag(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.SearchTag = &raw
+
+	return nil
+}
@@ -0,0 +1,117 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+)
+
+// GetScheduleCalendarOKCode is the HTTP code returned for type GetScheduleCalendarOK
+const GetScheduleCalendarOKCode int = 200
+
+/*
+GetScheduleCalendarOK A successful response.
+
+swagger:response getScheduleCalendarOK
+*/
+type GetScheduleCalendarOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewGetScheduleCalendarOK creates GetScheduleCalendarOK with default headers values
+func NewGetScheduleCalendarOK() *GetScheduleCalendarOK {
+
+	return &GetScheduleCalendarOK{}
+}
+
+// WithPayload adds the payload to the get schedule calendar o k response
+func (o *GetScheduleCalendarOK) WithPayload(payload io.ReadCloser) *GetScheduleCalendarOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get schedule calendar o k response
+func (o *GetScheduleCalendarOK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetScheduleCalendarOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+GetScheduleCalendarDefault Generic error response.
+
+swagger:response getScheduleCalendarDefault
+*/
+type GetScheduleCalendarDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewGetScheduleCalendarDefault creates GetScheduleCalendarDefault with default headers values
+func NewGetScheduleCalendarDefault(code int) *GetScheduleCalendarDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetScheduleCalendarDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get schedule calendar default response
+func (o *GetScheduleCalendarDefault) WithStatusCode(code int) *GetScheduleCalendarDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get schedule calendar default response
+func (o *GetScheduleCalendarDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get schedule calendar default response
+func (o *GetScheduleCalendarDefault) WithPayload(payload *models.APIError) *GetScheduleCalendarDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get schedule calendar default response
+func (o *GetScheduleCalendarDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetScheduleCalendarDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
+)
+
+// GetScheduleCalendarURL generates an URL for the get schedule calendar operation
+type GetScheduleCalendarURL struct {
+	Days       *int64
+	SearchName *string
+	SearchTag  *string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetScheduleCalendarURL) WithBasePath(bp string) *GetScheduleCalendarURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetScheduleCalendarURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *GetScheduleCalendarURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/calendar.ics"
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/api/v1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var daysQ string
+	if o.Days != nil {
+		daysQ = swag.FormatInt64(*o.Days)
+	}
+	if daysQ != "" {
+		qs.Set("days", daysQ)
+	}
+
+	var searchNameQ string
+	if o.SearchName != nil {
+		searchNameQ = *o.SearchName
+	}
+	if searchNameQ != "" {
+		qs.Set("searchName", searchNameQ)
+	}
+
+	var searchTagQ string
+	if o.SearchTag != nil {
+		searchTagQ = *o.SearchTag
+	}
+	if searchTagQ != "" {
+		qs.Set("searchTag", searchTagQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *GetScheduleCalendarURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *GetScheduleCalendarURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *GetScheduleCalendarURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on GetScheduleCalendarURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on GetScheduleCalendarURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *GetScheduleCalendarURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetTimelineHandlerFunc turns a function with the right signature into a get timeline handler
+type GetTimelineHandlerFunc func(GetTimelineParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetTimelineHandlerFunc) Handle(params GetTimelineParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetTimelineHandler interface for that can handle valid get timeline params
+type GetTimelineHandler interface {
+	Handle(GetTimelineParams) middleware.Responder
+}
+
+// NewGetTimeline creates a new http.Handler for the get timeline operation
+func NewGetTimeline(ctx *middleware.Context, handler GetTimelineHandler) *GetTimeline {
+	return &GetTimeline{Context: ctx, Handler: handler}
+}
+
+/*
+	GetTimeline swagger:route GET /timeline dags getTimeline
+
+Simulates the scheduler to list the runs expected in the next hours, including the ones skipped because the DAG is suspended or out of its time window.
+*/
+type GetTimeline struct {
+	Context *middleware.Context
+	Handler GetTimelineHandler
+}
+
+func (o *GetTimeline) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetTimelineParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
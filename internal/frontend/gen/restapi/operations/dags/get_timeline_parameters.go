@@ -0,0 +1,137 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetTimelineParams creates a new GetTimelineParams object
+//
+// There are no default values defined in the spec.
+func NewGetTimelineParams() GetTimelineParams {
+
+	return GetTimelineParams{}
+}
+
+// GetTimelineParams contains all the bound params for the get timeline operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters getTimeline
+type GetTimelineParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The number of the hours from now to simulate.
+	  In: query
+	*/
+	Hours *int64
+	/*
+	  In: query
+	*/
+	SearchName *string
+	/*
+	  In: query
+	*/
+	SearchTag *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetTimelineParams() beforehand.
+func (o *GetTimelineParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qHours, qhkHours, _ := qs.GetOK("hours")
+	if err := o.bindHours(qHours, qhkHours, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSearchName, qhkSearchName, _ := qs.GetOK("searchName")
+	if err := o.bindSearchName(qSearchName, qhkSearchName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSearchTag, qhkSearchTag, _ := qs.GetOK("searchTag")
+	if err := o.bindSearchTag(qSearchTag, qhkSearchTag, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindHours binds and validates parameter Hours from query.
+func (o *GetTimelineParams) bindHours(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("hours", "query", "int64", raw)
+	}
+	o.Hours = &value
+
+	return nil
+}
+
+// bindSearchName binds and validates parameter SearchName from query.
+func (o *GetTimelineParams) bindSearchName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.SearchName = &raw
+
+	return nil
+}
+
+// bindSearchTag binds and validates parameter SearchTag from query.
+func (o *GetTimelineParams) bindSearchTag(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.SearchTag = &raw
+
+	return nil
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+)
+
+// GetTimelineOKCode is the HTTP code returned for type GetTimelineOK
+const GetTimelineOKCode int = 200
+
+/*
+GetTimelineOK A successful response.
+
+swagger:response getTimelineOK
+*/
+type GetTimelineOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.TimelineResponse `json:"body,omitempty"`
+}
+
+// NewGetTimelineOK creates GetTimelineOK with default headers values
+func NewGetTimelineOK() *GetTimelineOK {
+
+	return &GetTimelineOK{}
+}
+
+// WithPayload adds the payload to the get timeline o k response
+func (o *GetTimelineOK) WithPayload(payload *models.TimelineResponse) *GetTimelineOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get timeline o k response
+func (o *GetTimelineOK) SetPayload(payload *models.TimelineResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTimelineOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetTimelineDefault Generic error response.
+
+swagger:response getTimelineDefault
+*/
+type GetTimelineDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewGetTimelineDefault creates GetTimelineDefault with default headers values
+func NewGetTimelineDefault(code int) *GetTimelineDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetTimelineDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get timeline default response
+func (o *GetTimelineDefault) WithStatusCode(code int) *GetTimelineDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get timeline default response
+func (o *GetTimelineDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get timeline default response
+func (o *GetTimelineDefault) WithPayload(payload *models.APIError) *GetTimelineDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get timeline default response
+func (o *GetTimelineDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTimelineDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
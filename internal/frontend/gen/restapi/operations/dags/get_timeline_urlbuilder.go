@@ -0,0 +1,123 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
+)
+
+// GetTimelineURL generates an URL for the get timeline operation
+type GetTimelineURL struct {
+	Hours      *int64
+	SearchName *string
+	SearchTag  *string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetTimelineURL) WithBasePath(bp string) *GetTimelineURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetTimelineURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *GetTimelineURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/timeline"
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/api/v1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var hoursQ string
+	if o.Hours != nil {
+		hoursQ = swag.FormatInt64(*o.Hours)
+	}
+	if hoursQ != "" {
+		qs.Set("hours", hoursQ)
+	}
+
+	var searchNameQ string
+	if o.SearchName != nil {
+		searchNameQ = *o.SearchName
+	}
+	if searchNameQ != "" {
+		qs.Set("searchName", searchNameQ)
+	}
+
+	var searchTagQ string
+	if o.SearchTag != nil {
+		searchTagQ = *o.SearchTag
+	}
+	if searchTagQ != "" {
+		qs.Set("searchTag", searchTagQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *GetTimelineURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *GetTimelineURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *GetTimelineURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on GetTimelineURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on GetTimelineURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *GetTimelineURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
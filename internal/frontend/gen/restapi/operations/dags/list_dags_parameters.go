@@ -36,6 +36,10 @@ type ListDagsParams struct {
 	  In: query
 	*/
 	Limit *int64
+	/*Returns only the DAGs in the namespace.
+	  In: query
+	*/
+	Namespace *string
 	/*
 	  In: query
 	*/
@@ -48,6 +52,10 @@ type ListDagsParams struct {
 	  In: query
 	*/
 	SearchTag *string
+	/*Returns only the DAGs that changed since the time (RFC3339), usually the Timestamp of the previous response.
+	  In: query
+	*/
+	Since *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -66,6 +74,11 @@ func (o *ListDagsParams) BindRequest(r *http.Request, route *middleware.MatchedR
 		res = append(res, err)
 	}
 
+	qNamespace, qhkNamespace, _ := qs.GetOK("namespace")
+	if err := o.bindNamespace(qNamespace, qhkNamespace, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qPage, qhkPage, _ := qs.GetOK("page")
 	if err := o.bindPage(qPage, qhkPage, route.Formats); err != nil {
 		res = append(res, err)
@@ -80,6 +93,11 @@ func (o *ListDagsParams) BindRequest(r *http.Request, route *middleware.MatchedR
 	if err := o.bindSearchTag(qSearchTag, qhkSearchTag, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qSince, qhkSince, _ := qs.GetOK("since")
+	if err := o.bindSince(qSince, qhkSince, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -109,6 +127,24 @@ func (o *ListDagsParams) bindLimit(rawData []string, hasKey bool, formats strfmt
 	return nil
 }
 
+// bindNamespace binds and validates parameter Namespace from query.
+func (o *ListDagsParams) bindNamespace(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Namespace = &raw
+
+	return nil
+}
+
 // bindPage binds and validates parameter Page from query.
 func (o *ListDagsParams) bindPage(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -167,3 +203,21 @@ func (o *ListDagsParams) bindSearchTag(rawData []string, hasKey bool, formats st
 
 	return nil
 }
+
+// bindSince binds and validates parameter Since from query.
+func (o *ListDagsParams) bindSince(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Since = &raw
+
+	return nil
+}
@@ -16,9 +16,11 @@ import (
 // ListDagsURL generates an URL for the list dags operation
 type ListDagsURL struct {
 	Limit      *int64
+	Namespace  *string
 	Page       *int64
 	SearchName *string
 	SearchTag  *string
+	Since      *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -62,6 +64,14 @@ func (o *ListDagsURL) Build() (*url.URL, error) {
 		qs.Set("limit", limitQ)
 	}
 
+	var namespaceQ string
+	if o.Namespace != nil {
+		namespaceQ = *o.Namespace
+	}
+	if namespaceQ != "" {
+		qs.Set("namespace", namespaceQ)
+	}
+
 	var pageQ string
 	if o.Page != nil {
 		pageQ = swag.FormatInt64(*o.Page)
@@ -86,6 +96,14 @@ func (o *ListDagsURL) Build() (*url.URL, error) {
 		qs.Set("searchTag", searchTagQ)
 	}
 
+	var sinceQ string
+	if o.Since != nil {
+		sinceQ = *o.Since
+	}
+	if sinceQ != "" {
+		qs.Set("since", sinceQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
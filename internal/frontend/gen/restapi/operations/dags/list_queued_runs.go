@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListQueuedRunsHandlerFunc turns a function with the right signature into a list queued runs handler
+type ListQueuedRunsHandlerFunc func(ListQueuedRunsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListQueuedRunsHandlerFunc) Handle(params ListQueuedRunsParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListQueuedRunsHandler interface for that can handle valid list queued runs params
+type ListQueuedRunsHandler interface {
+	Handle(ListQueuedRunsParams) middleware.Responder
+}
+
+// NewListQueuedRuns creates a new http.Handler for the list queued runs operation
+func NewListQueuedRuns(ctx *middleware.Context, handler ListQueuedRunsHandler) *ListQueuedRuns {
+	return &ListQueuedRuns{Context: ctx, Handler: handler}
+}
+
+/*
+	ListQueuedRuns swagger:route GET /queue dags listQueuedRuns
+
+Returns the runs waiting to be started by the scheduler in the order they are started, with their position in the queue of their DAG and the reason they are waiting.
+*/
+type ListQueuedRuns struct {
+	Context *middleware.Context
+	Handler ListQueuedRunsHandler
+}
+
+func (o *ListQueuedRuns) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListQueuedRunsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
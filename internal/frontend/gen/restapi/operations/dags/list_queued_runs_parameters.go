@@ -0,0 +1,77 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListQueuedRunsParams creates a new ListQueuedRunsParams object
+//
+// There are no default values defined in the spec.
+func NewListQueuedRunsParams() ListQueuedRunsParams {
+
+	return ListQueuedRunsParams{}
+}
+
+// ListQueuedRunsParams contains all the bound params for the list queued runs operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters listQueuedRuns
+type ListQueuedRunsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Only the runs of the DAG are returned if it's set.
+	  In: query
+	*/
+	DagID *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListQueuedRunsParams() beforehand.
+func (o *ListQueuedRunsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qDagID, qhkDagID, _ := qs.GetOK("dagId")
+	if err := o.bindDagID(qDagID, qhkDagID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindDagID binds and validates parameter DagID from query.
+func (o *ListQueuedRunsParams) bindDagID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.DagID = &raw
+
+	return nil
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+)
+
+// ListQueuedRunsOKCode is the HTTP code returned for type ListQueuedRunsOK
+const ListQueuedRunsOKCode int = 200
+
+/*
+ListQueuedRunsOK A successful response.
+
+swagger:response listQueuedRunsOK
+*/
+type ListQueuedRunsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.QueueResponse `json:"body,omitempty"`
+}
+
+// NewListQueuedRunsOK creates ListQueuedRunsOK with default headers values
+func NewListQueuedRunsOK() *ListQueuedRunsOK {
+
+	return &ListQueuedRunsOK{}
+}
+
+// WithPayload adds the payload to the list queued runs o k response
+func (o *ListQueuedRunsOK) WithPayload(payload *models.QueueResponse) *ListQueuedRunsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list queued runs o k response
+func (o *ListQueuedRunsOK) SetPayload(payload *models.QueueResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListQueuedRunsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+ListQueuedRunsDefault Generic error response.
+
+swagger:response listQueuedRunsDefault
+*/
+type ListQueuedRunsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewListQueuedRunsDefault creates ListQueuedRunsDefault with default headers values
+func NewListQueuedRunsDefault(code int) *ListQueuedRunsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListQueuedRunsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list queued runs default response
+func (o *ListQueuedRunsDefault) WithStatusCode(code int) *ListQueuedRunsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list queued runs default response
+func (o *ListQueuedRunsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list queued runs default response
+func (o *ListQueuedRunsDefault) WithPayload(payload *models.APIError) *ListQueuedRunsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list queued runs default response
+func (o *ListQueuedRunsDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListQueuedRunsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
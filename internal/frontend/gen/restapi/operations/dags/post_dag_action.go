@@ -68,18 +68,42 @@ type PostDagActionBody struct {
 
 	// action
 	// Required: true
-	// Enum: [start suspend stop retry mark-success mark-failed save rename]
+	// Enum: [start suspend stop retry mark-success mark-failed mark-skipped save rename]
 	Action *string `json:"action"`
 
+	// The delay after which the scheduler starts the run, e.g. "30m".
+	Delay string `json:"delay,omitempty"`
+
+	// The time in RFC 3339 the suspension is lifted.
+	ExpiresAt string `json:"expiresAt,omitempty"`
+
+	// The name of the step to start the run from. The steps it depends on are skipped.
+	From string `json:"from,omitempty"`
+
+	// idempotency key
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
+
+	// labels
+	Labels string `json:"labels,omitempty"`
+
 	// params
 	Params string `json:"params,omitempty"`
 
+	// The reason of the suspension.
+	Reason string `json:"reason,omitempty"`
+
 	// request Id
 	RequestID string `json:"requestId,omitempty"`
 
+	// The time in RFC 3339 the scheduler starts the run. The run is started immediately if neither startAt nor delay is set.
+	StartAt string `json:"startAt,omitempty"`
+
 	// step
 	Step string `json:"step,omitempty"`
 
+	// The name of the step to run with the steps it depends on. The other steps are skipped.
+	Target string `json:"target,omitempty"`
+
 	// value
 	Value string `json:"value,omitempty"`
 }
@@ -102,7 +126,7 @@ var postDagActionBodyTypeActionPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["start","suspend","stop","retry","mark-success","mark-failed","save","rename"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["start","suspend","stop","retry","mark-success","mark-failed","mark-skipped","save","rename"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -130,6 +154,9 @@ const (
 	// PostDagActionBodyActionMarkDashFailed captures enum value "mark-failed"
 	PostDagActionBodyActionMarkDashFailed string = "mark-failed"
 
+	// PostDagActionBodyActionMarkDashSkipped captures enum value "mark-skipped"
+	PostDagActionBodyActionMarkDashSkipped string = "mark-skipped"
+
 	// PostDagActionBodyActionSave captures enum value "save"
 	PostDagActionBodyActionSave string = "save"
 
@@ -0,0 +1,117 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostRunNoteHandlerFunc turns a function with the right signature into a post run note handler
+type PostRunNoteHandlerFunc func(PostRunNoteParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostRunNoteHandlerFunc) Handle(params PostRunNoteParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostRunNoteHandler interface for that can handle valid post run note params
+type PostRunNoteHandler interface {
+	Handle(PostRunNoteParams) middleware.Responder
+}
+
+// NewPostRunNote creates a new http.Handler for the post run note operation
+func NewPostRunNote(ctx *middleware.Context, handler PostRunNoteHandler) *PostRunNote {
+	return &PostRunNote{Context: ctx, Handler: handler}
+}
+
+/*
+	PostRunNote swagger:route POST /dags/{dagId}/requests/{requestId}/notes dags postRunNote
+
+Attaches a note to a DAG run.
+*/
+type PostRunNote struct {
+	Context *middleware.Context
+	Handler PostRunNoteHandler
+}
+
+func (o *PostRunNote) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostRunNoteParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// PostRunNoteBody post run note body
+//
+// swagger:model PostRunNoteBody
+type PostRunNoteBody struct {
+
+	// text
+	// Required: true
+	Text *string `json:"text"`
+}
+
+// Validate validates this post run note body
+func (o *PostRunNoteBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateText(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostRunNoteBody) validateText(formats strfmt.Registry) error {
+
+	if err := validate.Required("body"+"."+"text", "body", o.Text); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post run note body based on context it is used
+func (o *PostRunNoteBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostRunNoteBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostRunNoteBody) UnmarshalBinary(b []byte) error {
+	var res PostRunNoteBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
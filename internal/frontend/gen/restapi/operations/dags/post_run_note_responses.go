@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+)
+
+// PostRunNoteOKCode is the HTTP code returned for type PostRunNoteOK
+const PostRunNoteOKCode int = 200
+
+/*
+PostRunNoteOK A successful response.
+
+swagger:response postRunNoteOK
+*/
+type PostRunNoteOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.PostRunNoteResponse `json:"body,omitempty"`
+}
+
+// NewPostRunNoteOK creates PostRunNoteOK with default headers values
+func NewPostRunNoteOK() *PostRunNoteOK {
+
+	return &PostRunNoteOK{}
+}
+
+// WithPayload adds the payload to the post run note o k response
+func (o *PostRunNoteOK) WithPayload(payload *models.PostRunNoteResponse) *PostRunNoteOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post run note o k response
+func (o *PostRunNoteOK) SetPayload(payload *models.PostRunNoteResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostRunNoteOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+PostRunNoteDefault Generic error response.
+
+swagger:response postRunNoteDefault
+*/
+type PostRunNoteDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewPostRunNoteDefault creates PostRunNoteDefault with default headers values
+func NewPostRunNoteDefault(code int) *PostRunNoteDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostRunNoteDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post run note default response
+func (o *PostRunNoteDefault) WithStatusCode(code int) *PostRunNoteDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post run note default response
+func (o *PostRunNoteDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post run note default response
+func (o *PostRunNoteDefault) WithPayload(payload *models.APIError) *PostRunNoteDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post run note default response
+func (o *PostRunNoteDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostRunNoteDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// RetryDagStepHandlerFunc turns a function with the right signature into a retry dag step handler
+type RetryDagStepHandlerFunc func(RetryDagStepParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RetryDagStepHandlerFunc) Handle(params RetryDagStepParams) middleware.Responder {
+	return fn(params)
+}
+
+// RetryDagStepHandler interface for that can handle valid retry dag step params
+type RetryDagStepHandler interface {
+	Handle(RetryDagStepParams) middleware.Responder
+}
+
+// NewRetryDagStep creates a new http.Handler for the retry dag step operation
+func NewRetryDagStep(ctx *middleware.Context, handler RetryDagStepHandler) *RetryDagStep {
+	return &RetryDagStep{Context: ctx, Handler: handler}
+}
+
+/*
+	RetryDagStep swagger:route POST /dags/{dagId}/requests/{requestId}/steps/{stepName}/retry dags retryDagStep
+
+Retries a single step of a DAG run.
+*/
+type RetryDagStep struct {
+	Context *middleware.Context
+	Handler RetryDagStepHandler
+}
+
+func (o *RetryDagStep) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRetryDagStepParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
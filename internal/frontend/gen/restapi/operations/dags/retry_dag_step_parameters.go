@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewRetryDagStepParams creates a new RetryDagStepParams object
+//
+// There are no default values defined in the spec.
+func NewRetryDagStepParams() RetryDagStepParams {
+
+	return RetryDagStepParams{}
+}
+
+// RetryDagStepParams contains all the bound params for the retry dag step operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters retryDagStep
+type RetryDagStepParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: path
+	*/
+	DagID string
+	/*
+	  Required: true
+	  In: path
+	*/
+	RequestID string
+	/*
+	  Required: true
+	  In: path
+	*/
+	StepName string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewRetryDagStepParams() beforehand.
+func (o *RetryDagStepParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rDagID, rhkDagID, _ := route.Params.GetOK("dagId")
+	if err := o.bindDagID(rDagID, rhkDagID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rRequestID, rhkRequestID, _ := route.Params.GetOK("requestId")
+	if err := o.bindRequestID(rRequestID, rhkRequestID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rStepName, rhkStepName, _ := route.Params.GetOK("stepName")
+	if err := o.bindStepName(rStepName, rhkStepName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindDagID binds and validates parameter DagID from path.
+func (o *RetryDagStepParams) bindDagID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.DagID = raw
+
+	return nil
+}
+
+// bindRequestID binds and validates parameter RequestID from path.
+func (o *RetryDagStepParams) bindRequestID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.RequestID = raw
+
+	return nil
+}
+
+// bindStepName binds and validates parameter StepName from path.
+func (o *RetryDagStepParams) bindStepName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.StepName = raw
+
+	return nil
+}
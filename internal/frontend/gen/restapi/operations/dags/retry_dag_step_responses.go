@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+)
+
+// RetryDagStepOKCode is the HTTP code returned for type RetryDagStepOK
+const RetryDagStepOKCode int = 200
+
+/*
+RetryDagStepOK A successful response.
+
+swagger:response retryDagStepOK
+*/
+type RetryDagStepOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.PostDagActionResponse `json:"body,omitempty"`
+}
+
+// NewRetryDagStepOK creates RetryDagStepOK with default headers values
+func NewRetryDagStepOK() *RetryDagStepOK {
+
+	return &RetryDagStepOK{}
+}
+
+// WithPayload adds the payload to the retry dag step o k response
+func (o *RetryDagStepOK) WithPayload(payload *models.PostDagActionResponse) *RetryDagStepOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the retry dag step o k response
+func (o *RetryDagStepOK) SetPayload(payload *models.PostDagActionResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RetryDagStepOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+RetryDagStepDefault Generic error response.
+
+swagger:response retryDagStepDefault
+*/
+type RetryDagStepDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewRetryDagStepDefault creates RetryDagStepDefault with default headers values
+func NewRetryDagStepDefault(code int) *RetryDagStepDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RetryDagStepDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the retry dag step default response
+func (o *RetryDagStepDefault) WithStatusCode(code int) *RetryDagStepDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the retry dag step default response
+func (o *RetryDagStepDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the retry dag step default response
+func (o *RetryDagStepDefault) WithPayload(payload *models.APIError) *RetryDagStepDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the retry dag step default response
+func (o *RetryDagStepDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RetryDagStepDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
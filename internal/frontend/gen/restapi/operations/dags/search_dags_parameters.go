@@ -32,6 +32,10 @@ type SearchDagsParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Returns only the DAGs with a recent run having the labels (e.g. customer=acme,backfill=true).
+	  In: query
+	*/
+	Labels *string
 	/*
 	  Required: true
 	  In: query
@@ -50,6 +54,11 @@ func (o *SearchDagsParams) BindRequest(r *http.Request, route *middleware.Matche
 
 	qs := runtime.Values(r.URL.Query())
 
+	qLabels, qhkLabels, _ := qs.GetOK("labels")
+	if err := o.bindLabels(qLabels, qhkLabels, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qQ, qhkQ, _ := qs.GetOK("q")
 	if err := o.bindQ(qQ, qhkQ, route.Formats); err != nil {
 		res = append(res, err)
@@ -60,6 +69,24 @@ func (o *SearchDagsParams) BindRequest(r *http.Request, route *middleware.Matche
 	return nil
 }
 
+// bindLabels binds and validates parameter Labels from query.
+func (o *SearchDagsParams) bindLabels(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Labels = &raw
+
+	return nil
+}
+
 // bindQ binds and validates parameter Q from query.
 func (o *SearchDagsParams) bindQ(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	if !hasKey {
@@ -13,7 +13,8 @@ import (
 
 // SearchDagsURL generates an URL for the search dags operation
 type SearchDagsURL struct {
-	Q string
+	Labels *string
+	Q      string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -49,6 +50,14 @@ func (o *SearchDagsURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var labelsQ string
+	if o.Labels != nil {
+		labelsQ = *o.Labels
+	}
+	if labelsQ != "" {
+		qs.Set("labels", labelsQ)
+	}
+
 	qQ := o.Q
 	if qQ != "" {
 		qs.Set("q", qQ)
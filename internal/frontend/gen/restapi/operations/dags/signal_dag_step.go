@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// SignalDagStepHandlerFunc turns a function with the right signature into a signal dag step handler
+type SignalDagStepHandlerFunc func(SignalDagStepParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SignalDagStepHandlerFunc) Handle(params SignalDagStepParams) middleware.Responder {
+	return fn(params)
+}
+
+// SignalDagStepHandler interface for that can handle valid signal dag step params
+type SignalDagStepHandler interface {
+	Handle(SignalDagStepParams) middleware.Responder
+}
+
+// NewSignalDagStep creates a new http.Handler for the signal dag step operation
+func NewSignalDagStep(ctx *middleware.Context, handler SignalDagStepHandler) *SignalDagStep {
+	return &SignalDagStep{Context: ctx, Handler: handler}
+}
+
+/*
+	SignalDagStep swagger:route POST /dags/{dagId}/requests/{requestId}/steps/{stepName}/signal dags signalDagStep
+
+Sends a signal to the process group of a running step without stopping the DAG run, e.g. SIGHUP to make a long-running step reload its config or reopen its log files.
+*/
+type SignalDagStep struct {
+	Context *middleware.Context
+	Handler SignalDagStepHandler
+}
+
+func (o *SignalDagStep) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSignalDagStepParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+)
+
+// SignalDagStepOKCode is the HTTP code returned for type SignalDagStepOK
+const SignalDagStepOKCode int = 200
+
+/*
+SignalDagStepOK A successful response.
+
+swagger:response signalDagStepOK
+*/
+type SignalDagStepOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.PostDagActionResponse `json:"body,omitempty"`
+}
+
+// NewSignalDagStepOK creates SignalDagStepOK with default headers values
+func NewSignalDagStepOK() *SignalDagStepOK {
+
+	return &SignalDagStepOK{}
+}
+
+// WithPayload adds the payload to the signal dag step o k response
+func (o *SignalDagStepOK) WithPayload(payload *models.PostDagActionResponse) *SignalDagStepOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the signal dag step o k response
+func (o *SignalDagStepOK) SetPayload(payload *models.PostDagActionResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SignalDagStepOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+SignalDagStepDefault Generic error response.
+
+swagger:response signalDagStepDefault
+*/
+type SignalDagStepDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewSignalDagStepDefault creates SignalDagStepDefault with default headers values
+func NewSignalDagStepDefault(code int) *SignalDagStepDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SignalDagStepDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the signal dag step default response
+func (o *SignalDagStepDefault) WithStatusCode(code int) *SignalDagStepDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the signal dag step default response
+func (o *SignalDagStepDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the signal dag step default response
+func (o *SignalDagStepDefault) WithPayload(payload *models.APIError) *SignalDagStepDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the signal dag step default response
+func (o *SignalDagStepDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SignalDagStepDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
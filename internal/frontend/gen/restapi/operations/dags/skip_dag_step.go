@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// SkipDagStepHandlerFunc turns a function with the right signature into a skip dag step handler
+type SkipDagStepHandlerFunc func(SkipDagStepParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SkipDagStepHandlerFunc) Handle(params SkipDagStepParams) middleware.Responder {
+	return fn(params)
+}
+
+// SkipDagStepHandler interface for that can handle valid skip dag step params
+type SkipDagStepHandler interface {
+	Handle(SkipDagStepParams) middleware.Responder
+}
+
+// NewSkipDagStep creates a new http.Handler for the skip dag step operation
+func NewSkipDagStep(ctx *middleware.Context, handler SkipDagStepHandler) *SkipDagStep {
+	return &SkipDagStep{Context: ctx, Handler: handler}
+}
+
+/*
+	SkipDagStep swagger:route POST /dags/{dagId}/requests/{requestId}/steps/{stepName}/skip dags skipDagStep
+
+Marks a step of a queued or running DAG run which has not started yet to be skipped. The steps after it run as if it succeeded.
+*/
+type SkipDagStep struct {
+	Context *middleware.Context
+	Handler SkipDagStepHandler
+}
+
+func (o *SkipDagStep) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSkipDagStepParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
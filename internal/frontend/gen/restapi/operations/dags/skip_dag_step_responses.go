@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+)
+
+// SkipDagStepOKCode is the HTTP code returned for type SkipDagStepOK
+const SkipDagStepOKCode int = 200
+
+/*
+SkipDagStepOK A successful response.
+
+swagger:response skipDagStepOK
+*/
+type SkipDagStepOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.PostDagActionResponse `json:"body,omitempty"`
+}
+
+// NewSkipDagStepOK creates SkipDagStepOK with default headers values
+func NewSkipDagStepOK() *SkipDagStepOK {
+
+	return &SkipDagStepOK{}
+}
+
+// WithPayload adds the payload to the skip dag step o k response
+func (o *SkipDagStepOK) WithPayload(payload *models.PostDagActionResponse) *SkipDagStepOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the skip dag step o k response
+func (o *SkipDagStepOK) SetPayload(payload *models.PostDagActionResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SkipDagStepOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+SkipDagStepDefault Generic error response.
+
+swagger:response skipDagStepDefault
+*/
+type SkipDagStepDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewSkipDagStepDefault creates SkipDagStepDefault with default headers values
+func NewSkipDagStepDefault(code int) *SkipDagStepDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SkipDagStepDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the skip dag step default response
+func (o *SkipDagStepDefault) WithStatusCode(code int) *SkipDagStepDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the skip dag step default response
+func (o *SkipDagStepDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the skip dag step default response
+func (o *SkipDagStepDefault) WithPayload(payload *models.APIError) *SkipDagStepDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the skip dag step default response
+func (o *SkipDagStepDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SkipDagStepDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
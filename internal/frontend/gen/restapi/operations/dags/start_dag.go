@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// StartDagHandlerFunc turns a function with the right signature into a start dag handler
+type StartDagHandlerFunc func(StartDagParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn StartDagHandlerFunc) Handle(params StartDagParams) middleware.Responder {
+	return fn(params)
+}
+
+// StartDagHandler interface for that can handle valid start dag params
+type StartDagHandler interface {
+	Handle(StartDagParams) middleware.Responder
+}
+
+// NewStartDag creates a new http.Handler for the start dag operation
+func NewStartDag(ctx *middleware.Context, handler StartDagHandler) *StartDag {
+	return &StartDag{Context: ctx, Handler: handler}
+}
+
+/*
+	StartDag swagger:route POST /dags/{dagId}/start dags startDag
+
+Starts a DAG with the parameters, e.g. from a webhook of GitHub Actions or Airflow. The DAG runs asynchronously and the request ID of the run is returned. When the request ID is given and the run already exists, the run is returned without starting the DAG again.
+*/
+type StartDag struct {
+	Context *middleware.Context
+	Handler StartDagHandler
+}
+
+func (o *StartDag) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewStartDagParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+)
+
+// StartDagOKCode is the HTTP code returned for type StartDagOK
+const StartDagOKCode int = 200
+
+/*
+StartDagOK A successful response.
+
+swagger:response startDagOK
+*/
+type StartDagOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.PostDagActionResponse `json:"body,omitempty"`
+}
+
+// NewStartDagOK creates StartDagOK with default headers values
+func NewStartDagOK() *StartDagOK {
+
+	return &StartDagOK{}
+}
+
+// WithPayload adds the payload to the start dag o k response
+func (o *StartDagOK) WithPayload(payload *models.PostDagActionResponse) *StartDagOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the start dag o k response
+func (o *StartDagOK) SetPayload(payload *models.PostDagActionResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StartDagOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+StartDagDefault Generic error response.
+
+swagger:response startDagDefault
+*/
+type StartDagDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewStartDagDefault creates StartDagDefault with default headers values
+func NewStartDagDefault(code int) *StartDagDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &StartDagDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the start dag default response
+func (o *StartDagDefault) WithStatusCode(code int) *StartDagDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the start dag default response
+func (o *StartDagDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the start dag default response
+func (o *StartDagDefault) WithPayload(payload *models.APIError) *StartDagDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the start dag default response
+func (o *StartDagDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StartDagDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// UpdateQueuedRunHandlerFunc turns a function with the right signature into a update queued run handler
+type UpdateQueuedRunHandlerFunc func(UpdateQueuedRunParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UpdateQueuedRunHandlerFunc) Handle(params UpdateQueuedRunParams) middleware.Responder {
+	return fn(params)
+}
+
+// UpdateQueuedRunHandler interface for that can handle valid update queued run params
+type UpdateQueuedRunHandler interface {
+	Handle(UpdateQueuedRunParams) middleware.Responder
+}
+
+// NewUpdateQueuedRun creates a new http.Handler for the update queued run operation
+func NewUpdateQueuedRun(ctx *middleware.Context, handler UpdateQueuedRunHandler) *UpdateQueuedRun {
+	return &UpdateQueuedRun{Context: ctx, Handler: handler}
+}
+
+/*
+	UpdateQueuedRun swagger:route PATCH /queue/{requestId} dags updateQueuedRun
+
+Changes the priority of a queued run. The due runs of a DAG are started in the order of the priority, the highest first.
+*/
+type UpdateQueuedRun struct {
+	Context *middleware.Context
+	Handler UpdateQueuedRunHandler
+}
+
+func (o *UpdateQueuedRun) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewUpdateQueuedRunParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// UpdateQueuedRunBody update queued run body
+//
+// swagger:model UpdateQueuedRunBody
+type UpdateQueuedRunBody struct {
+
+	// priority
+	// Required: true
+	Priority *int64 `json:"Priority"`
+}
+
+// Validate validates this update queued run body
+func (o *UpdateQueuedRunBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validatePriority(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *UpdateQueuedRunBody) validatePriority(formats strfmt.Registry) error {
+
+	if err := validate.Required("body"+"."+"Priority", "body", o.Priority); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this update queued run body based on context it is used
+func (o *UpdateQueuedRunBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *UpdateQueuedRunBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *UpdateQueuedRunBody) UnmarshalBinary(b []byte) error {
+	var res UpdateQueuedRunBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
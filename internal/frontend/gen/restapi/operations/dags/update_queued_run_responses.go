@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/dagu-org/dagu/internal/frontend/gen/models"
+)
+
+// UpdateQueuedRunOKCode is the HTTP code returned for type UpdateQueuedRunOK
+const UpdateQueuedRunOKCode int = 200
+
+/*
+UpdateQueuedRunOK A successful response.
+
+swagger:response updateQueuedRunOK
+*/
+type UpdateQueuedRunOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.QueuedRun `json:"body,omitempty"`
+}
+
+// NewUpdateQueuedRunOK creates UpdateQueuedRunOK with default headers values
+func NewUpdateQueuedRunOK() *UpdateQueuedRunOK {
+
+	return &UpdateQueuedRunOK{}
+}
+
+// WithPayload adds the payload to the update queued run o k response
+func (o *UpdateQueuedRunOK) WithPayload(payload *models.QueuedRun) *UpdateQueuedRunOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update queued run o k response
+func (o *UpdateQueuedRunOK) SetPayload(payload *models.QueuedRun) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateQueuedRunOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+UpdateQueuedRunDefault Generic error response.
+
+swagger:response updateQueuedRunDefault
+*/
+type UpdateQueuedRunDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewUpdateQueuedRunDefault creates UpdateQueuedRunDefault with default headers values
+func NewUpdateQueuedRunDefault(code int) *UpdateQueuedRunDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UpdateQueuedRunDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the update queued run default response
+func (o *UpdateQueuedRunDefault) WithStatusCode(code int) *UpdateQueuedRunDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the update queued run default response
+func (o *UpdateQueuedRunDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the update queued run default response
+func (o *UpdateQueuedRunDefault) WithPayload(payload *models.APIError) *UpdateQueuedRunDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update queued run default response
+func (o *UpdateQueuedRunDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateQueuedRunDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -7,6 +7,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -42,29 +43,81 @@ func NewDaguAPI(spec *loads.Document) *DaguAPI {
 
 		JSONConsumer: runtime.JSONConsumer(),
 
+		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
+		TextCalendarProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("textCalendar producer has not yet been implemented")
+		}),
 
+		DagsCancelQueuedRunHandler: dags.CancelQueuedRunHandlerFunc(func(params dags.CancelQueuedRunParams) middleware.Responder {
+			return middleware.NotImplemented("operation dags.CancelQueuedRun has not yet been implemented")
+		}),
 		DagsCreateDagHandler: dags.CreateDagHandlerFunc(func(params dags.CreateDagParams) middleware.Responder {
 			return middleware.NotImplemented("operation dags.CreateDag has not yet been implemented")
 		}),
 		DagsDeleteDagHandler: dags.DeleteDagHandlerFunc(func(params dags.DeleteDagParams) middleware.Responder {
 			return middleware.NotImplemented("operation dags.DeleteDag has not yet been implemented")
 		}),
+		DagsGetArtifactHandler: dags.GetArtifactHandlerFunc(func(params dags.GetArtifactParams) middleware.Responder {
+			return middleware.NotImplemented("operation dags.GetArtifact has not yet been implemented")
+		}),
+		DagsGetDagAnalyticsHandler: dags.GetDagAnalyticsHandlerFunc(func(params dags.GetDagAnalyticsParams) middleware.Responder {
+			return middleware.NotImplemented("operation dags.GetDagAnalytics has not yet been implemented")
+		}),
+		DagsGetDagConfigSourcesHandler: dags.GetDagConfigSourcesHandlerFunc(func(params dags.GetDagConfigSourcesParams) middleware.Responder {
+			return middleware.NotImplemented("operation dags.GetDagConfigSources has not yet been implemented")
+		}),
 		DagsGetDagDetailsHandler: dags.GetDagDetailsHandlerFunc(func(params dags.GetDagDetailsParams) middleware.Responder {
 			return middleware.NotImplemented("operation dags.GetDagDetails has not yet been implemented")
 		}),
+		DagsGetDagFailuresHandler: dags.GetDagFailuresHandlerFunc(func(params dags.GetDagFailuresParams) middleware.Responder {
+			return middleware.NotImplemented("operation dags.GetDagFailures has not yet been implemented")
+		}),
+		DagsGetDagGraphHandler: dags.GetDagGraphHandlerFunc(func(params dags.GetDagGraphParams) middleware.Responder {
+			return middleware.NotImplemented("operation dags.GetDagGraph has not yet been implemented")
+		}),
+		DagsGetRunLineageHandler: dags.GetRunLineageHandlerFunc(func(params dags.GetRunLineageParams) middleware.Responder {
+			return middleware.NotImplemented("operation dags.GetRunLineage has not yet been implemented")
+		}),
+		DagsGetScheduleCalendarHandler: dags.GetScheduleCalendarHandlerFunc(func(params dags.GetScheduleCalendarParams) middleware.Responder {
+			return middleware.NotImplemented("operation dags.GetScheduleCalendar has not yet been implemented")
+		}),
+		DagsGetTimelineHandler: dags.GetTimelineHandlerFunc(func(params dags.GetTimelineParams) middleware.Responder {
+			return middleware.NotImplemented("operation dags.GetTimeline has not yet been implemented")
+		}),
 		DagsListDagsHandler: dags.ListDagsHandlerFunc(func(params dags.ListDagsParams) middleware.Responder {
 			return middleware.NotImplemented("operation dags.ListDags has not yet been implemented")
 		}),
+		DagsListQueuedRunsHandler: dags.ListQueuedRunsHandlerFunc(func(params dags.ListQueuedRunsParams) middleware.Responder {
+			return middleware.NotImplemented("operation dags.ListQueuedRuns has not yet been implemented")
+		}),
 		DagsListTagsHandler: dags.ListTagsHandlerFunc(func(params dags.ListTagsParams) middleware.Responder {
 			return middleware.NotImplemented("operation dags.ListTags has not yet been implemented")
 		}),
 		DagsPostDagActionHandler: dags.PostDagActionHandlerFunc(func(params dags.PostDagActionParams) middleware.Responder {
 			return middleware.NotImplemented("operation dags.PostDagAction has not yet been implemented")
 		}),
+		DagsPostRunNoteHandler: dags.PostRunNoteHandlerFunc(func(params dags.PostRunNoteParams) middleware.Responder {
+			return middleware.NotImplemented("operation dags.PostRunNote has not yet been implemented")
+		}),
+		DagsRetryDagStepHandler: dags.RetryDagStepHandlerFunc(func(params dags.RetryDagStepParams) middleware.Responder {
+			return middleware.NotImplemented("operation dags.RetryDagStep has not yet been implemented")
+		}),
 		DagsSearchDagsHandler: dags.SearchDagsHandlerFunc(func(params dags.SearchDagsParams) middleware.Responder {
 			return middleware.NotImplemented("operation dags.SearchDags has not yet been implemented")
 		}),
+		DagsSignalDagStepHandler: dags.SignalDagStepHandlerFunc(func(params dags.SignalDagStepParams) middleware.Responder {
+			return middleware.NotImplemented("operation dags.SignalDagStep has not yet been implemented")
+		}),
+		DagsSkipDagStepHandler: dags.SkipDagStepHandlerFunc(func(params dags.SkipDagStepParams) middleware.Responder {
+			return middleware.NotImplemented("operation dags.SkipDagStep has not yet been implemented")
+		}),
+		DagsStartDagHandler: dags.StartDagHandlerFunc(func(params dags.StartDagParams) middleware.Responder {
+			return middleware.NotImplemented("operation dags.StartDag has not yet been implemented")
+		}),
+		DagsUpdateQueuedRunHandler: dags.UpdateQueuedRunHandlerFunc(func(params dags.UpdateQueuedRunParams) middleware.Responder {
+			return middleware.NotImplemented("operation dags.UpdateQueuedRun has not yet been implemented")
+		}),
 	}
 }
 
@@ -100,24 +153,63 @@ type DaguAPI struct {
 	//   - application/json
 	JSONConsumer runtime.Consumer
 
+	// BinProducer registers a producer for the following mime types:
+	//   - application/octet-stream
+	//   - image/svg+xml
+	BinProducer runtime.Producer
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
+	// TextCalendarProducer registers a producer for the following mime types:
+	//   - text/calendar
+	TextCalendarProducer runtime.Producer
 
+	// DagsCancelQueuedRunHandler sets the operation handler for the cancel queued run operation
+	DagsCancelQueuedRunHandler dags.CancelQueuedRunHandler
 	// DagsCreateDagHandler sets the operation handler for the create dag operation
 	DagsCreateDagHandler dags.CreateDagHandler
 	// DagsDeleteDagHandler sets the operation handler for the delete dag operation
 	DagsDeleteDagHandler dags.DeleteDagHandler
+	// DagsGetArtifactHandler sets the operation handler for the get artifact operation
+	DagsGetArtifactHandler dags.GetArtifactHandler
+	// DagsGetDagAnalyticsHandler sets the operation handler for the get dag analytics operation
+	DagsGetDagAnalyticsHandler dags.GetDagAnalyticsHandler
+	// DagsGetDagConfigSourcesHandler sets the operation handler for the get dag config sources operation
+	DagsGetDagConfigSourcesHandler dags.GetDagConfigSourcesHandler
 	// DagsGetDagDetailsHandler sets the operation handler for the get dag details operation
 	DagsGetDagDetailsHandler dags.GetDagDetailsHandler
+	// DagsGetDagFailuresHandler sets the operation handler for the get dag failures operation
+	DagsGetDagFailuresHandler dags.GetDagFailuresHandler
+	// DagsGetDagGraphHandler sets the operation handler for the get dag graph operation
+	DagsGetDagGraphHandler dags.GetDagGraphHandler
+	// DagsGetRunLineageHandler sets the operation handler for the get run lineage operation
+	DagsGetRunLineageHandler dags.GetRunLineageHandler
+	// DagsGetScheduleCalendarHandler sets the operation handler for the get schedule calendar operation
+	DagsGetScheduleCalendarHandler dags.GetScheduleCalendarHandler
+	// DagsGetTimelineHandler sets the operation handler for the get timeline operation
+	DagsGetTimelineHandler dags.GetTimelineHandler
 	// DagsListDagsHandler sets the operation handler for the list dags operation
 	DagsListDagsHandler dags.ListDagsHandler
+	// DagsListQueuedRunsHandler sets the operation handler for the list queued runs operation
+	DagsListQueuedRunsHandler dags.ListQueuedRunsHandler
 	// DagsListTagsHandler sets the operation handler for the list tags operation
 	DagsListTagsHandler dags.ListTagsHandler
 	// DagsPostDagActionHandler sets the operation handler for the post dag action operation
 	DagsPostDagActionHandler dags.PostDagActionHandler
+	// DagsPostRunNoteHandler sets the operation handler for the post run note operation
+	DagsPostRunNoteHandler dags.PostRunNoteHandler
+	// DagsRetryDagStepHandler sets the operation handler for the retry dag step operation
+	DagsRetryDagStepHandler dags.RetryDagStepHandler
 	// DagsSearchDagsHandler sets the operation handler for the search dags operation
 	DagsSearchDagsHandler dags.SearchDagsHandler
+	// DagsSignalDagStepHandler sets the operation handler for the signal dag step operation
+	DagsSignalDagStepHandler dags.SignalDagStepHandler
+	// DagsSkipDagStepHandler sets the operation handler for the skip dag step operation
+	DagsSkipDagStepHandler dags.SkipDagStepHandler
+	// DagsStartDagHandler sets the operation handler for the start dag operation
+	DagsStartDagHandler dags.StartDagHandler
+	// DagsUpdateQueuedRunHandler sets the operation handler for the update queued run operation
+	DagsUpdateQueuedRunHandler dags.UpdateQueuedRunHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -191,31 +283,85 @@ func (o *DaguAPI) Validate() error {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
 
+	if o.BinProducer == nil {
+		unregistered = append(unregistered, "BinProducer")
+	}
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.TextCalendarProducer == nil {
+		unregistered = append(unregistered, "TextCalendarProducer")
+	}
 
+	if o.DagsCancelQueuedRunHandler == nil {
+		unregistered = append(unregistered, "dags.CancelQueuedRunHandler")
+	}
 	if o.DagsCreateDagHandler == nil {
 		unregistered = append(unregistered, "dags.CreateDagHandler")
 	}
 	if o.DagsDeleteDagHandler == nil {
 		unregistered = append(unregistered, "dags.DeleteDagHandler")
 	}
+	if o.DagsGetArtifactHandler == nil {
+		unregistered = append(unregistered, "dags.GetArtifactHandler")
+	}
+	if o.DagsGetDagAnalyticsHandler == nil {
+		unregistered = append(unregistered, "dags.GetDagAnalyticsHandler")
+	}
+	if o.DagsGetDagConfigSourcesHandler == nil {
+		unregistered = append(unregistered, "dags.GetDagConfigSourcesHandler")
+	}
 	if o.DagsGetDagDetailsHandler == nil {
 		unregistered = append(unregistered, "dags.GetDagDetailsHandler")
 	}
+	if o.DagsGetDagFailuresHandler == nil {
+		unregistered = append(unregistered, "dags.GetDagFailuresHandler")
+	}
+	if o.DagsGetDagGraphHandler == nil {
+		unregistered = append(unregistered, "dags.GetDagGraphHandler")
+	}
+	if o.DagsGetRunLineageHandler == nil {
+		unregistered = append(unregistered, "dags.GetRunLineageHandler")
+	}
+	if o.DagsGetScheduleCalendarHandler == nil {
+		unregistered = append(unregistered, "dags.GetScheduleCalendarHandler")
+	}
+	if o.DagsGetTimelineHandler == nil {
+		unregistered = append(unregistered, "dags.GetTimelineHandler")
+	}
 	if o.DagsListDagsHandler == nil {
 		unregistered = append(unregistered, "dags.ListDagsHandler")
 	}
+	if o.DagsListQueuedRunsHandler == nil {
+		unregistered = append(unregistered, "dags.ListQueuedRunsHandler")
+	}
 	if o.DagsListTagsHandler == nil {
 		unregistered = append(unregistered, "dags.ListTagsHandler")
 	}
 	if o.DagsPostDagActionHandler == nil {
 		unregistered = append(unregistered, "dags.PostDagActionHandler")
 	}
+	if o.DagsPostRunNoteHandler == nil {
+		unregistered = append(unregistered, "dags.PostRunNoteHandler")
+	}
+	if o.DagsRetryDagStepHandler == nil {
+		unregistered = append(unregistered, "dags.RetryDagStepHandler")
+	}
 	if o.DagsSearchDagsHandler == nil {
 		unregistered = append(unregistered, "dags.SearchDagsHandler")
 	}
+	if o.DagsSignalDagStepHandler == nil {
+		unregistered = append(unregistered, "dags.SignalDagStepHandler")
+	}
+	if o.DagsSkipDagStepHandler == nil {
+		unregistered = append(unregistered, "dags.SkipDagStepHandler")
+	}
+	if o.DagsStartDagHandler == nil {
+		unregistered = append(unregistered, "dags.StartDagHandler")
+	}
+	if o.DagsUpdateQueuedRunHandler == nil {
+		unregistered = append(unregistered, "dags.UpdateQueuedRunHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -262,8 +408,14 @@ func (o *DaguAPI) ProducersFor(mediaTypes []string) map[string]runtime.Producer
 	result := make(map[string]runtime.Producer, len(mediaTypes))
 	for _, mt := range mediaTypes {
 		switch mt {
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinProducer
+		case "image/svg+xml":
+			result["image/svg+xml"] = o.BinProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "text/calendar":
+			result["text/calendar"] = o.TextCalendarProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -304,6 +456,10 @@ func (o *DaguAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/queue/{requestId}"] = dags.NewCancelQueuedRun(o.context, o.DagsCancelQueuedRunHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -315,23 +471,83 @@ func (o *DaguAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/dags/{dagId}/requests/{requestId}/artifacts/{artifactName}"] = dags.NewGetArtifact(o.context, o.DagsGetArtifactHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/dags/{dagId}/analytics"] = dags.NewGetDagAnalytics(o.context, o.DagsGetDagAnalyticsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/dags/{dagId}/config-sources"] = dags.NewGetDagConfigSources(o.context, o.DagsGetDagConfigSourcesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/dags/{dagId}"] = dags.NewGetDagDetails(o.context, o.DagsGetDagDetailsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/dags/{dagId}/failures"] = dags.NewGetDagFailures(o.context, o.DagsGetDagFailuresHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/dags/{dagId}/graph.svg"] = dags.NewGetDagGraph(o.context, o.DagsGetDagGraphHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/dags/{dagId}/requests/{requestId}/lineage"] = dags.NewGetRunLineage(o.context, o.DagsGetRunLineageHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/calendar.ics"] = dags.NewGetScheduleCalendar(o.context, o.DagsGetScheduleCalendarHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/timeline"] = dags.NewGetTimeline(o.context, o.DagsGetTimelineHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/dags"] = dags.NewListDags(o.context, o.DagsListDagsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/queue"] = dags.NewListQueuedRuns(o.context, o.DagsListQueuedRunsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/tags"] = dags.NewListTags(o.context, o.DagsListTagsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/dags/{dagId}"] = dags.NewPostDagAction(o.context, o.DagsPostDagActionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/dags/{dagId}/requests/{requestId}/notes"] = dags.NewPostRunNote(o.context, o.DagsPostRunNoteHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/dags/{dagId}/requests/{requestId}/steps/{stepName}/retry"] = dags.NewRetryDagStep(o.context, o.DagsRetryDagStepHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/search"] = dags.NewSearchDags(o.context, o.DagsSearchDagsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/dags/{dagId}/requests/{requestId}/steps/{stepName}/signal"] = dags.NewSignalDagStep(o.context, o.DagsSignalDagStepHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/dags/{dagId}/requests/{requestId}/steps/{stepName}/skip"] = dags.NewSkipDagStep(o.context, o.DagsSkipDagStepHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/dags/{dagId}/start"] = dags.NewStartDag(o.context, o.DagsStartDagHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/queue/{requestId}"] = dags.NewUpdateQueuedRun(o.context, o.DagsUpdateQueuedRunHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
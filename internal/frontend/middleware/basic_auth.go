@@ -5,13 +5,17 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+
+	"github.com/dagu-org/dagu/internal/namespace"
 )
 
 const (
 	authHeaderKey = "Authorization"
 )
 
-func BasicAuth(realm string, creds map[string]string) func(
+// BasicAuth authenticates the users. The users with namespaces are allowed
+// to access only the DAGs in them.
+func BasicAuth(realm string, users []AuthUser) func(
 	next http.Handler,
 ) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -21,26 +25,39 @@ func BasicAuth(realm string, creds map[string]string) func(
 				next.ServeHTTP(w, r)
 				return
 			}
-			user, pass, ok := r.BasicAuth()
+			username, pass, ok := r.BasicAuth()
 			if !ok {
 				basicAuthFailed(w, realm)
 				return
 			}
 
-			credPass, credUserOk := creds[user]
-			if !credUserOk || subtle.ConstantTimeCompare(
+			user, found := findUser(users, username)
+			if !found || subtle.ConstantTimeCompare(
 				[]byte(pass),
-				[]byte(credPass),
+				[]byte(user.Password),
 			) != 1 {
 				basicAuthFailed(w, realm)
 				return
 			}
 
-			next.ServeHTTP(w, r.WithContext(withAuthenticated(r.Context())))
+			ctx := withAuthenticated(r.Context())
+			if len(user.Namespaces) > 0 {
+				ctx = namespace.WithAccess(ctx, user.Namespaces)
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
+func findUser(users []AuthUser, username string) (AuthUser, bool) {
+	for _, user := range users {
+		if user.Username == username {
+			return user, true
+		}
+	}
+	return AuthUser{}, false
+}
+
 // skipBasicAuth skips basic auth middleware when the auth token is set
 func skipBasicAuth(authHeader []string) bool {
 	return authToken != nil &&
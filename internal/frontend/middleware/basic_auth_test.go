@@ -51,8 +51,8 @@ func TestBasicAuth(t *testing.T) {
 		},
 	}
 	// incorrectCreds triggers HTTP 401 Unauthorized upon basic auth
-	incorrectCreds := map[string]string{
-		"INCORRECT_USERNAME": "INCORRECT_PASSWORD",
+	incorrectCreds := []AuthUser{
+		{Username: "INCORRECT_USERNAME", Password: "INCORRECT_PASSWORD"},
 	}
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+func TestETag(t *testing.T) {
+	body := `{"DAGs":[]}`
+	handler := ETag(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/dags", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, body, rec.Body.String())
+	etag := rec.Header().Get("ETag")
+	require.True(t, strings.HasPrefix(etag, `W/"`))
+	require.Equal(t, "no-cache", rec.Header().Get("Cache-Control"))
+
+	t.Run("NotModified", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/dags", nil)
+		req.Header.Set("If-None-Match", `"other", `+etag)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusNotModified, rec.Code)
+		require.Empty(t, rec.Body.String())
+		require.Equal(t, etag, rec.Header().Get("ETag"))
+	})
+	t.Run("Modified", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/dags", nil)
+		req.Header.Set("If-None-Match", `W/"other"`)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, body, rec.Body.String())
+	})
+	t.Run("Error", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/missing", nil))
+		require.Equal(t, http.StatusNotFound, rec.Code)
+		require.Empty(t, rec.Header().Get("ETag"))
+	})
+	t.Run("Post", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/dags", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Empty(t, rec.Header().Get("ETag"))
+	})
+	t.Run("Gzip", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/dags", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		rec := httptest.NewRecorder()
+		middleware.Compress(compressionLevel)(handler).ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+		require.Equal(t, etag, rec.Header().Get("ETag"))
+
+		r, err := gzip.NewReader(rec.Body)
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, body, string(data))
+	})
+}
@@ -3,14 +3,22 @@ package middleware
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/namespace"
 	"github.com/go-chi/chi/v5/middleware"
 )
 
+// compressionLevel is the gzip level of the API responses.
+const compressionLevel = 5
+
 func SetupGlobalMiddleware(handler http.Handler) http.Handler {
-	next := cors(handler)
+	next := namespaceAccess(handler)
+	next = ETag(next)
+	next = middleware.Compress(compressionLevel)(next)
+	next = cors(next)
 	next = middleware.RequestID(next)
 	if appLogger != nil {
 		next = logging(next)
@@ -20,14 +28,19 @@ func SetupGlobalMiddleware(handler http.Handler) http.Handler {
 	next = middleware.Recoverer(next)
 
 	if authToken != nil {
-		next = TokenAuth("restricted", authToken.Token)(next)
+		var tokens []ScopedToken
+		if authToken.Token != "" {
+			tokens = append(tokens, ScopedToken{Token: authToken.Token})
+		}
+		next = TokenAuth("restricted", append(tokens, authToken.Tokens...))(next)
 	}
 
 	if authBasic != nil {
-		next = BasicAuth(
-			"restricted",
-			map[string]string{authBasic.Username: authBasic.Password},
-		)(next)
+		var users []AuthUser
+		if authBasic.Username != "" {
+			users = append(users, AuthUser{Username: authBasic.Username, Password: authBasic.Password})
+		}
+		next = BasicAuth("restricted", append(users, authBasic.Users...))(next)
 	}
 	next = prefixChecker(next)
 
@@ -71,10 +84,29 @@ type Options struct {
 type AuthBasic struct {
 	Username string
 	Password string
+	// Users is the users allowed to access only their namespaces.
+	Users []AuthUser
+}
+
+// AuthUser is a user of the basic authentication. The user without
+// namespaces is allowed to access all of them.
+type AuthUser struct {
+	Username   string
+	Password   string
+	Namespaces []string
 }
 
 type AuthToken struct {
 	Token string
+	// Tokens is the tokens allowed to access only their namespaces.
+	Tokens []ScopedToken
+}
+
+// ScopedToken is a bearer token. The token without namespaces is allowed to
+// access all of them.
+type ScopedToken struct {
+	Token      string
+	Namespaces []string
 }
 
 func Setup(opts *Options) {
@@ -94,6 +126,12 @@ func prefixChecker(next http.Handler) http.Handler {
 				http.Redirect(w, r, basePath, http.StatusSeeOther)
 				return
 			}
+			// Only the paths under the base path are served so that
+			// "/dagu-other" is not mistaken for "/dagu" + "-other".
+			if basePath != "" && r.URL.Path != basePath && !strings.HasPrefix(r.URL.Path, basePath+"/") {
+				http.NotFound(w, r)
+				return
+			}
 			http.StripPrefix(basePath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				if strings.HasPrefix(r.URL.Path, "/api") {
 					next.ServeHTTP(w, r)
@@ -104,6 +142,31 @@ func prefixChecker(next http.Handler) http.Handler {
 		})
 }
 
+// namespaceAccess forbids the requests to the DAGs in the namespaces the
+// user is not allowed to access. The namespace is in the DAG ID of the path,
+// e.g. /api/v1/dags/team-a%2Fetl.
+func namespaceAccess(next http.Handler) http.Handler {
+	const prefix = "/api/v1/dags/"
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.EscapedPath()
+		if !strings.HasPrefix(path, prefix) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		segment, _, _ := strings.Cut(strings.TrimPrefix(path, prefix), "/")
+		id, err := url.PathUnescape(segment)
+		if err != nil {
+			http.Error(w, "invalid DAG ID", http.StatusBadRequest)
+			return
+		}
+		if ns, _ := namespace.Split(id); !namespace.Allowed(r.Context(), ns) {
+			http.Error(w, "access to the namespace is forbidden", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 func cors(h http.Handler) http.Handler {
 	return http.HandlerFunc(
 		func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefixChecker(t *testing.T) {
+	echo := func(name string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(name + ":" + r.URL.Path))
+		})
+	}
+	defaultHandler = echo("ui")
+	basePath = "/dagu"
+	t.Cleanup(func() {
+		defaultHandler = nil
+		basePath = ""
+	})
+	handler := prefixChecker(echo("api"))
+
+	testCases := []struct {
+		path       string
+		httpStatus int
+		body       string
+		location   string
+	}{
+		{path: "/", httpStatus: http.StatusSeeOther, location: "/dagu"},
+		{path: "/dagu/", httpStatus: http.StatusOK, body: "ui:/"},
+		{path: "/dagu/assets/bundle.js", httpStatus: http.StatusOK, body: "ui:/assets/bundle.js"},
+		{path: "/dagu/api/v1/dags", httpStatus: http.StatusOK, body: "api:/api/v1/dags"},
+		{path: "/dagu-other/api/v1/dags", httpStatus: http.StatusNotFound},
+		{path: "/api/v1/dags", httpStatus: http.StatusNotFound},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.path, nil))
+			require.Equal(t, tc.httpStatus, rec.Code)
+			if tc.body != "" {
+				require.Equal(t, tc.body, rec.Body.String())
+			}
+			if tc.location != "" {
+				require.Equal(t, tc.location, rec.Header().Get("Location"))
+			}
+		})
+	}
+}
+
+func TestNamespaceAccess(t *testing.T) {
+	authBasic = &AuthBasic{
+		Username: "admin",
+		Password: "admin",
+		Users:    []AuthUser{{Username: "alice", Password: "alice", Namespaces: []string{"team-a"}}},
+	}
+	authToken = &AuthToken{Tokens: []ScopedToken{{Token: "token-b", Namespaces: []string{"team-b"}}}}
+	defer func() {
+		authBasic, authToken = nil, nil
+	}()
+	handler := namespaceAccess(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	users := []AuthUser{{Username: authBasic.Username, Password: authBasic.Password}}
+	handler = TokenAuth("restricted", authToken.Tokens)(handler)
+	handler = BasicAuth("restricted", append(users, authBasic.Users...))(handler)
+
+	for _, tc := range []struct {
+		name   string
+		path   string
+		auth   func(r *http.Request)
+		status int
+	}{
+		{"AdminInNamespace", "/api/v1/dags/team-b%2Fetl", func(r *http.Request) { r.SetBasicAuth("admin", "admin") }, http.StatusOK},
+		{"UserInNamespace", "/api/v1/dags/team-a%2Fetl/graph.svg", func(r *http.Request) { r.SetBasicAuth("alice", "alice") }, http.StatusOK},
+		{"UserInOtherNamespace", "/api/v1/dags/team-b%2Fetl", func(r *http.Request) { r.SetBasicAuth("alice", "alice") }, http.StatusForbidden},
+		{"UserInRootNamespace", "/api/v1/dags/etl", func(r *http.Request) { r.SetBasicAuth("alice", "alice") }, http.StatusForbidden},
+		{"UserList", "/api/v1/dags", func(r *http.Request) { r.SetBasicAuth("alice", "alice") }, http.StatusOK},
+		{"TokenInNamespace", "/api/v1/dags/team-b%2Fetl", func(r *http.Request) { r.Header.Set("Authorization", "Bearer token-b") }, http.StatusOK},
+		{"TokenInOtherNamespace", "/api/v1/dags/team-a%2Fetl", func(r *http.Request) { r.Header.Set("Authorization", "Bearer token-b") }, http.StatusForbidden},
+		{"WrongToken", "/api/v1/dags/team-b%2Fetl", func(r *http.Request) { r.Header.Set("Authorization", "Bearer token-a") }, http.StatusUnauthorized},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			tc.auth(r)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, r)
+			require.Equal(t, tc.status, rec.Code)
+		})
+	}
+}
@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+
+	"github.com/dagu-org/dagu/internal/namespace"
 )
 
 // TokenAuth implements a similar middleware handler like go-chi's BasicAuth
-// middleware but for bearer tokens
+// middleware but for bearer tokens. The tokens with namespaces are allowed to
+// access only the DAGs in them.
 func TokenAuth(
-	realm string, token string,
+	realm string, tokens []ScopedToken,
 ) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -31,16 +34,32 @@ func TokenAuth(
 				return
 			}
 
-			if subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+			token, found := findToken(tokens, bearer)
+			if !found {
 				tokenAuthFailed(w, realm)
 				return
 			}
 
+			if len(token.Namespaces) > 0 {
+				r = r.WithContext(namespace.WithAccess(r.Context(), token.Namespaces))
+			}
 			next.ServeHTTP(w, r)
 		})
 	}
 }
 
+// findToken compares the bearer with all the tokens in constant time.
+func findToken(tokens []ScopedToken, bearer string) (ScopedToken, bool) {
+	var ret ScopedToken
+	found := false
+	for _, token := range tokens {
+		if subtle.ConstantTimeCompare([]byte(bearer), []byte(token.Token)) == 1 && !found {
+			ret, found = token, true
+		}
+	}
+	return ret, found
+}
+
 func skipTokenAuth(r http.Request) bool {
 	return isAuthenticated(r.Context())
 }
@@ -9,6 +9,9 @@ import (
 
 func (svr *Server) defaultRoutes(ctx context.Context, r *chi.Mux) *chi.Mux {
 	r.Get("/assets/*", svr.handleGetAssets())
+	if svr.metrics != nil {
+		r.Handle("/metrics", svr.metrics)
+	}
 	r.Get("/*", svr.handleRequest(ctx))
 
 	return r
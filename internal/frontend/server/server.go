@@ -8,7 +8,9 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	"github.com/dagu-org/dagu/internal/client"
 	"github.com/dagu-org/dagu/internal/config"
 	"github.com/dagu-org/dagu/internal/frontend/gen/restapi"
 	"github.com/dagu-org/dagu/internal/logger"
@@ -31,6 +33,11 @@ type Server struct {
 	server      *restapi.Server
 	handlers    []Handler
 	assets      fs.FS
+
+	client         client.Client
+	gracePeriod    time.Duration
+	shutdownMarker string
+	metrics        http.Handler
 }
 
 type NewServerArgs struct {
@@ -42,6 +49,16 @@ type NewServerArgs struct {
 	Handlers  []Handler
 	AssetsFS  fs.FS
 
+	// Client is drained on shutdown to wait for the runs started from the
+	// server up to the GracePeriod. The ShutdownMarker is persisted after
+	// draining.
+	Client         client.Client
+	GracePeriod    time.Duration
+	ShutdownMarker string
+
+	// Metrics serves the metrics in the Prometheus text format at /metrics.
+	Metrics http.Handler
+
 	// Configuration for the frontend
 	NavbarColor           string
 	NavbarTitle           string
@@ -55,10 +72,25 @@ type NewServerArgs struct {
 type BasicAuth struct {
 	Username string
 	Password string
+	// Users is the users allowed to access only their namespaces.
+	Users []BasicAuthUser
+}
+
+type BasicAuthUser struct {
+	Username   string
+	Password   string
+	Namespaces []string
 }
 
 type AuthToken struct {
 	Token string
+	// Tokens is the tokens allowed to access only their namespaces.
+	Tokens []ScopedToken
+}
+
+type ScopedToken struct {
+	Token      string
+	Namespaces []string
 }
 
 type Handler interface {
@@ -74,6 +106,12 @@ func New(params NewServerArgs) *Server {
 		tls:       params.TLS,
 		handlers:  params.Handlers,
 		assets:    params.AssetsFS,
+
+		client:         params.Client,
+		gracePeriod:    params.GracePeriod,
+		shutdownMarker: params.ShutdownMarker,
+		metrics:        params.Metrics,
+
 		funcsConfig: funcsConfig{
 			NavbarColor:           params.NavbarColor,
 			NavbarTitle:           params.NavbarTitle,
@@ -107,12 +145,25 @@ func (svr *Server) Serve(ctx context.Context) (err error) {
 		middlewareOptions.AuthToken = &pkgmiddleware.AuthToken{
 			Token: svr.authToken.Token,
 		}
+		for _, t := range svr.authToken.Tokens {
+			middlewareOptions.AuthToken.Tokens = append(middlewareOptions.AuthToken.Tokens, pkgmiddleware.ScopedToken{
+				Token:      t.Token,
+				Namespaces: t.Namespaces,
+			})
+		}
 	}
 	if svr.basicAuth != nil {
 		middlewareOptions.AuthBasic = &pkgmiddleware.AuthBasic{
 			Username: svr.basicAuth.Username,
 			Password: svr.basicAuth.Password,
 		}
+		for _, u := range svr.basicAuth.Users {
+			middlewareOptions.AuthBasic.Users = append(middlewareOptions.AuthBasic.Users, pkgmiddleware.AuthUser{
+				Username:   u.Username,
+				Password:   u.Password,
+				Namespaces: u.Namespaces,
+			})
+		}
 	}
 	pkgmiddleware.Setup(middlewareOptions)
 
@@ -150,6 +201,7 @@ func (svr *Server) Serve(ctx context.Context) (err error) {
 		if err != nil {
 			logger.Error(ctx, "Server shutdown", "err", err)
 		}
+		svr.drain(ctx)
 		serverStopCtx()
 	}()
 
@@ -174,3 +226,24 @@ func (svr *Server) Serve(ctx context.Context) (err error) {
 
 	return nil
 }
+
+// drain waits for the DAGs started from the server to finish and persists
+// the shutdown marker.
+func (svr *Server) drain(ctx context.Context) {
+	if svr.client == nil {
+		return
+	}
+
+	logger.Info(ctx, "Waiting for the running DAGs to finish", "gracePeriod", svr.gracePeriod)
+	result := svr.client.Drain(ctx, svr.gracePeriod)
+	if !result.Clean {
+		logger.Warn(ctx, "Stopped the DAGs not finished within the grace period", "DAGs", result.Stopped)
+	}
+
+	if svr.shutdownMarker == "" {
+		return
+	}
+	if err := client.WriteShutdownMarker(svr.shutdownMarker, "server", result); err != nil {
+		logger.Error(ctx, "Failed to persist the shutdown marker", "err", err)
+	}
+}
@@ -40,10 +40,11 @@ type appLogger struct {
 }
 
 type Config struct {
-	debug  bool
-	format string
-	writer io.Writer
-	quiet  bool
+	debug    bool
+	format   string
+	writer   io.Writer
+	quiet    bool
+	handlers []slog.Handler
 }
 
 type Option func(*Config)
@@ -76,6 +77,14 @@ func WithQuiet() Option {
 	}
 }
 
+// WithHandler adds a handler to receive the logs in addition to the
+// standard error and the writer.
+func WithHandler(h slog.Handler) Option {
+	return func(o *Config) {
+		o.handlers = append(o.handlers, h)
+	}
+}
+
 var defaultLogger = NewLogger(WithFormat("text"))
 
 func NewLogger(opts ...Option) Logger {
@@ -114,6 +123,8 @@ func NewLogger(opts ...Option) Logger {
 		handlers = append(handlers, guardedHandler)
 	}
 
+	handlers = append(handlers, cfg.handlers...)
+
 	return &appLogger{
 		logger:         slog.New(slogmulti.Fanout(handlers...)),
 		guardedHandler: guardedHandler,
@@ -0,0 +1,131 @@
+package mailer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strings"
+)
+
+// loginAuth implements the LOGIN authentication mechanism, which some
+// servers such as Office 365 accept instead of PLAIN.
+type loginAuth struct {
+	username string
+	password string
+	host     string
+}
+
+var _ smtp.Auth = (*loginAuth)(nil)
+
+func (a *loginAuth) Start(server *smtp.ServerInfo) (string, []byte, error) {
+	if err := checkEncrypted(server, a.host); err != nil {
+		return "", nil, err
+	}
+	return "LOGIN", nil, nil
+}
+
+func (a *loginAuth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if !more {
+		return nil, nil
+	}
+	prompt := strings.ToLower(strings.TrimSpace(string(fromServer)))
+	switch {
+	case strings.HasPrefix(prompt, "username"):
+		return []byte(a.username), nil
+	case strings.HasPrefix(prompt, "password"):
+		return []byte(a.password), nil
+	default:
+		return nil, fmt.Errorf("smtp: unexpected LOGIN prompt: %s", fromServer)
+	}
+}
+
+// xoauth2Auth implements the XOAUTH2 authentication mechanism of Gmail and
+// Office 365.
+type xoauth2Auth struct {
+	username string
+	token    string
+	host     string
+}
+
+var _ smtp.Auth = (*xoauth2Auth)(nil)
+
+func (a *xoauth2Auth) Start(server *smtp.ServerInfo) (string, []byte, error) {
+	if err := checkEncrypted(server, a.host); err != nil {
+		return "", nil, err
+	}
+	resp := "user=" + a.username + "\x01auth=Bearer " + a.token + "\x01\x01"
+	return "XOAUTH2", []byte(resp), nil
+}
+
+func (a *xoauth2Auth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if more {
+		// The server sends the details of the error as a challenge, and
+		// expects an empty response before it replies with the failure.
+		return []byte{}, nil
+	}
+	return nil, nil
+}
+
+// checkEncrypted refuses to send the credentials over an unencrypted
+// connection except to localhost, like smtp.PlainAuth.
+func checkEncrypted(server *smtp.ServerInfo, host string) error {
+	if server.Name != host {
+		return errors.New("smtp: wrong host name")
+	}
+	if !server.TLS && host != "localhost" && host != "127.0.0.1" && host != "::1" {
+		return errUnencrypted
+	}
+	return nil
+}
+
+// oauth2Token returns the configured access token, or gets one from the
+// token endpoint with the refresh token.
+func (m *Mailer) oauth2Token(ctx context.Context) (string, error) {
+	if m.oauth2.AccessToken != "" {
+		return m.oauth2.AccessToken, nil
+	}
+	if m.oauth2.TokenURL == "" || m.oauth2.RefreshToken == "" {
+		return "", errors.New("smtp: either an access token or a token URL and a refresh token is required for XOAUTH2")
+	}
+
+	form := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {m.oauth2.RefreshToken},
+		"client_id":     {m.oauth2.ClientID},
+		"client_secret": {m.oauth2.ClientSecret},
+	}
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, m.oauth2.TokenURL, strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh the access token: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	var token struct {
+		AccessToken      string `json:"access_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to decode the token response (%s): %w", resp.Status, err)
+	}
+	if resp.StatusCode != http.StatusOK || token.AccessToken == "" {
+		return "", fmt.Errorf("failed to refresh the access token (%s): %s %s",
+			resp.Status, token.Error, token.ErrorDescription)
+	}
+	return token.AccessToken, nil
+}
@@ -0,0 +1,77 @@
+package mailer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXOAuth2Auth(t *testing.T) {
+	auth := &xoauth2Auth{username: "user@example.com", token: "token", host: "smtp.example.com"}
+
+	t.Run("Start", func(t *testing.T) {
+		proto, resp, err := auth.Start(&smtp.ServerInfo{Name: "smtp.example.com", TLS: true})
+		require.NoError(t, err)
+		require.Equal(t, "XOAUTH2", proto)
+		require.Equal(t, "user=user@example.com\x01auth=Bearer token\x01\x01", string(resp))
+	})
+	t.Run("Unencrypted", func(t *testing.T) {
+		_, _, err := auth.Start(&smtp.ServerInfo{Name: "smtp.example.com"})
+		require.ErrorIs(t, err, errUnencrypted)
+	})
+}
+
+func TestLoginAuth(t *testing.T) {
+	auth := &loginAuth{username: "user", password: "secret", host: "localhost"}
+
+	proto, _, err := auth.Start(&smtp.ServerInfo{Name: "localhost"})
+	require.NoError(t, err)
+	require.Equal(t, "LOGIN", proto)
+
+	resp, err := auth.Next([]byte("Username:"), true)
+	require.NoError(t, err)
+	require.Equal(t, "user", string(resp))
+	resp, err = auth.Next([]byte("Password:"), true)
+	require.NoError(t, err)
+	require.Equal(t, "secret", string(resp))
+}
+
+func TestOAuth2Token(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		if r.PostForm.Get("grant_type") != "refresh_token" || r.PostForm.Get("refresh_token") != "refresh" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"bad token"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token":"access","token_type":"Bearer"}`))
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+
+	t.Run("AccessToken", func(t *testing.T) {
+		m := New(Config{OAuth2: OAuth2Config{AccessToken: "static"}})
+		token, err := m.oauth2Token(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "static", token)
+	})
+	t.Run("RefreshToken", func(t *testing.T) {
+		m := New(Config{OAuth2: OAuth2Config{TokenURL: srv.URL, RefreshToken: "refresh"}})
+		token, err := m.oauth2Token(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "access", token)
+	})
+	t.Run("InvalidRefreshToken", func(t *testing.T) {
+		m := New(Config{OAuth2: OAuth2Config{TokenURL: srv.URL, RefreshToken: "expired"}})
+		_, err := m.oauth2Token(ctx)
+		require.ErrorContains(t, err, "invalid_grant")
+	})
+	t.Run("NoToken", func(t *testing.T) {
+		_, err := New(Config{}).oauth2Token(ctx)
+		require.Error(t, err)
+	})
+}
@@ -3,14 +3,18 @@ package mailer
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"mime"
+	"net"
 	"net/smtp"
-	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/dagu-org/dagu/internal/crypt"
 	"github.com/dagu-org/dagu/internal/logger"
 )
 
@@ -20,22 +24,76 @@ type Mailer struct {
 	port     string
 	username string
 	password string
+	tlsMode  string
+	auth     string
+	oauth2   OAuth2Config
+	timeout  time.Duration
 }
 
+// TLS modes of the connection to the SMTP server.
+const (
+	// TLSAuto upgrades the connection with STARTTLS if the server supports it.
+	TLSAuto = "auto"
+	// TLSStartTLS requires the server to support STARTTLS.
+	TLSStartTLS = "starttls"
+	// TLSImplicit connects with TLS from the start, usually on port 465.
+	TLSImplicit = "tls"
+	// TLSNone never encrypts the connection.
+	TLSNone = "none"
+)
+
+// Authentication mechanisms.
+const (
+	AuthPlain   = "plain"
+	AuthLogin   = "login"
+	AuthXOAuth2 = "xoauth2"
+)
+
+// defaultTimeout is the default timeout to connect to the SMTP server and
+// send an email.
+const defaultTimeout = 30 * time.Second
+
 // Config is a config for SMTP mailer.
 type Config struct {
 	Host     string
 	Port     string
 	Username string
 	Password string
+	// TLS is the TLS mode. TLSAuto is used if it's empty.
+	TLS string
+	// Auth is the authentication mechanism. AuthPlain is used if it's empty
+	// and the username or the password is set.
+	Auth string
+	// OAuth2 is the token of the XOAUTH2 authentication.
+	OAuth2 OAuth2Config
+	// Timeout is the timeout to connect to the server and send an email.
+	Timeout time.Duration
+}
+
+// OAuth2Config is the access token of the XOAUTH2 authentication, or the
+// client credentials and the refresh token to get one.
+type OAuth2Config struct {
+	AccessToken  string
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
 }
 
 func New(cfg Config) *Mailer {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
 	return &Mailer{
 		host:     cfg.Host,
 		port:     cfg.Port,
 		username: cfg.Username,
 		password: cfg.Password,
+		tlsMode:  cfg.TLS,
+		auth:     cfg.Auth,
+		oauth2:   cfg.OAuth2,
+		timeout:  timeout,
 	}
 }
 
@@ -43,8 +101,12 @@ var (
 	replacer = strings.NewReplacer(
 		"\r\n", "", "\r", "", "\n", "", "%0a", "", "%0d", "",
 	)
-	boundary     = "==simple-boundary-dagu-mailer"
-	errFileEmpty = errors.New("file is empty")
+	boundary          = "==simple-boundary-dagu-mailer"
+	errFileEmpty      = errors.New("file is empty")
+	errNoStartTLS     = errors.New("smtp: server doesn't support STARTTLS")
+	errUnencrypted    = errors.New("smtp: unencrypted connection")
+	errInvalidTLSMode = errors.New("smtp: invalid TLS mode")
+	errInvalidAuth    = errors.New("smtp: invalid auth mechanism")
 )
 
 // SendMail sends an email.
@@ -56,25 +118,19 @@ func (m *Mailer) Send(
 	attachments []string,
 ) error {
 	logger.Info(ctx, "Sending an email", "to", to, "subject", subject)
-	if m.username == "" && m.password == "" {
-		return m.sendWithNoAuth(from, to, subject, body, attachments)
-	}
-	return m.sendWithAuth(from, to, subject, body, attachments)
-}
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
 
-func (m *Mailer) sendWithNoAuth(
-	from string,
-	to []string,
-	subject, body string,
-	attachments []string,
-) error {
-	c, err := smtp.Dial(m.host + ":" + m.port)
+	c, err := m.dial(ctx)
 	if err != nil {
 		return err
 	}
 	defer func() {
 		_ = c.Close()
 	}()
+	if err := m.authenticate(ctx, c); err != nil {
+		return err
+	}
 	if err = c.Mail(replacer.Replace(from)); err != nil {
 		return err
 	}
@@ -101,18 +157,75 @@ func (m *Mailer) sendWithNoAuth(
 	return c.Quit()
 }
 
-func (m *Mailer) sendWithAuth(
-	from string,
-	to []string,
-	subject, body string,
-	attachments []string,
-) error {
-	auth := smtp.PlainAuth("", m.username, m.password, m.host)
-	body = newlineToBrTag(body)
-	return smtp.SendMail(
-		m.host+":"+m.port, auth, from, to,
-		m.composeMail(to, from, subject, body, attachments),
-	)
+// dial connects to the SMTP server and encrypts the connection according
+// to the TLS mode. The connection is closed when the context is done.
+func (m *Mailer) dial(ctx context.Context) (*smtp.Client, error) {
+	addr := net.JoinHostPort(m.host, m.port)
+	tlsConfig := &tls.Config{ServerName: m.host, MinVersion: tls.VersionTLS12}
+
+	var conn net.Conn
+	var err error
+	switch m.tlsMode {
+	case TLSImplicit:
+		dialer := &tls.Dialer{Config: tlsConfig}
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	case "", TLSAuto, TLSStartTLS, TLSNone:
+		var dialer net.Dialer
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	default:
+		return nil, fmt.Errorf("%w: %s", errInvalidTLSMode, m.tlsMode)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	c, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if m.tlsMode == TLSImplicit || m.tlsMode == TLSNone {
+		return c, nil
+	}
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		err = c.StartTLS(tlsConfig)
+	} else if m.tlsMode == TLSStartTLS {
+		err = errNoStartTLS
+	}
+	if err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// authenticate authenticates with the mechanism of the config. It does
+// nothing if no mechanism nor credentials are configured.
+func (m *Mailer) authenticate(ctx context.Context, c *smtp.Client) error {
+	var auth smtp.Auth
+	switch m.auth {
+	case "":
+		if m.username == "" && m.password == "" {
+			return nil
+		}
+		auth = smtp.PlainAuth("", m.username, m.password, m.host)
+	case AuthPlain:
+		auth = smtp.PlainAuth("", m.username, m.password, m.host)
+	case AuthLogin:
+		auth = &loginAuth{username: m.username, password: m.password, host: m.host}
+	case AuthXOAuth2:
+		token, err := m.oauth2Token(ctx)
+		if err != nil {
+			return err
+		}
+		auth = &xoauth2Auth{username: m.username, token: token, host: m.host}
+	default:
+		return fmt.Errorf("%w: %s", errInvalidAuth, m.auth)
+	}
+	return c.Auth(auth)
 }
 
 func (*Mailer) composeHeader(
@@ -167,7 +280,7 @@ func addAttachments(attachments []string) []byte {
 		data, err := readFile(fileName)
 		if err == nil {
 			_, _ = buf.WriteString(fmt.Sprintf("\r\n\n--%s\r\n", boundary))
-			_, _ = buf.WriteString("Content-Type: text/plain;" + "\r\n")
+			_, _ = buf.WriteString("Content-Type: " + contentType(fileName) + ";\r\n")
 			_, _ = buf.WriteString("Content-Transfer-Encoding: base64" + "\r\n")
 			_, _ = buf.WriteString(
 				"Content-Disposition: attachment; filename=" +
@@ -182,8 +295,18 @@ func addAttachments(attachments []string) []byte {
 	return buf.Bytes()
 }
 
+// contentType returns the MIME type of the attachment by the extension. The
+// log files are sent as text.
+func contentType(fileName string) string {
+	if typ := mime.TypeByExtension(filepath.Ext(fileName)); typ != "" {
+		return typ
+	}
+	return "text/plain"
+}
+
 func readFile(fileName string) (data []byte, err error) {
-	data, err = os.ReadFile(fileName)
+	// The logs encrypted at rest are sent in plaintext.
+	data, err = crypt.ReadFile(fileName)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,380 @@
+package mailer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/google/uuid"
+)
+
+// Default options of the queue.
+const (
+	defaultQueueSize     = 100
+	defaultMaxAttempts   = 5
+	defaultRetryInterval = 10 * time.Second
+	defaultFlushTimeout  = 10 * time.Second
+)
+
+// QueueDirName is the name of the queue directory under the data directory.
+const QueueDirName = "mail-queue"
+
+// Subdirectories of the queue directory.
+const (
+	pendingDir = "pending"
+	deadDir    = "dead"
+)
+
+var errQueueClosed = errors.New("mail queue is closed")
+
+// QueueOptions is the options of the mail queue.
+type QueueOptions struct {
+	// Dir is the directory to spool the mails.
+	Dir string
+	// Size is the number of the mails waiting in memory. The mails beyond
+	// the size are only spooled and delivered by Redeliver.
+	Size int
+	// MaxAttempts is the number of the delivery attempts of a mail before
+	// it's moved to the dead letters.
+	MaxAttempts int
+	// RetryInterval is the initial interval between the attempts. It's
+	// doubled after each attempt.
+	RetryInterval time.Duration
+	// FlushTimeout is the time Close waits for the queued mails.
+	FlushTimeout time.Duration
+}
+
+func (o QueueOptions) withDefaults() QueueOptions {
+	if o.Size <= 0 {
+		o.Size = defaultQueueSize
+	}
+	if o.MaxAttempts <= 0 {
+		o.MaxAttempts = defaultMaxAttempts
+	}
+	if o.RetryInterval <= 0 {
+		o.RetryInterval = defaultRetryInterval
+	}
+	if o.FlushTimeout <= 0 {
+		o.FlushTimeout = defaultFlushTimeout
+	}
+	return o
+}
+
+// spooledMail is a mail in the queue directory. The SMTP config is stored
+// with the mail so that any process can deliver it.
+type spooledMail struct {
+	Config      Config
+	From        string
+	To          []string
+	Subject     string
+	Body        string
+	Attachments []string
+	// PID is the process that owns the mail. Redeliver skips the mails
+	// owned by a live process.
+	PID       int
+	Attempts  int
+	LastError string `json:",omitempty"`
+	CreatedAt time.Time
+}
+
+// sendFunc sends a spooled mail.
+type sendFunc func(ctx context.Context, m *spooledMail) error
+
+func sendSpooledMail(ctx context.Context, m *spooledMail) error {
+	return New(m.Config).Send(ctx, m.From, m.To, m.Subject, m.Body, m.Attachments)
+}
+
+// Queue sends the mails asynchronously with a worker. The mails are spooled
+// to the directory before being queued, so the mails that the worker could
+// not deliver before the process exits are delivered by Redeliver later.
+type Queue struct {
+	cfg  Config
+	opts QueueOptions
+	send sendFunc
+
+	mails  chan string
+	done   chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// NewQueue creates a queue that sends the mails with the SMTP config and
+// starts the worker. The worker isn't stopped by the cancellation of the
+// context but by Close.
+func NewQueue(ctx context.Context, cfg Config, opts QueueOptions) *Queue {
+	opts = opts.withDefaults()
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	q := &Queue{
+		cfg:    cfg,
+		opts:   opts,
+		send:   sendSpooledMail,
+		mails:  make(chan string, opts.Size),
+		done:   make(chan struct{}),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	go q.run()
+	return q
+}
+
+// Send spools the mail and queues it. It doesn't wait for the delivery.
+func (q *Queue) Send(
+	ctx context.Context,
+	from string,
+	to []string,
+	subject, body string,
+	attachments []string,
+) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return errQueueClosed
+	}
+
+	m := &spooledMail{
+		Config:      q.cfg,
+		From:        from,
+		To:          to,
+		Subject:     subject,
+		Body:        body,
+		Attachments: attachments,
+		PID:         os.Getpid(),
+		CreatedAt:   time.Now(),
+	}
+	path, err := spool(q.opts.Dir, m)
+	if err != nil {
+		return fmt.Errorf("failed to spool the mail: %w", err)
+	}
+	logger.Info(ctx, "Queued an email", "to", to, "subject", subject)
+
+	select {
+	case q.mails <- path:
+	default:
+		logger.Warn(ctx, "Mail queue is full; the mail is left for redelivery", "file", path)
+	}
+	return nil
+}
+
+// Close stops accepting the mails and waits for the queued mails to be
+// delivered up to the flush timeout. The mails not delivered are left in
+// the directory for Redeliver.
+func (q *Queue) Close(ctx context.Context) {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return
+	}
+	q.closed = true
+	close(q.mails)
+	q.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, q.opts.FlushTimeout)
+	defer cancel()
+	select {
+	case <-q.done:
+	case <-ctx.Done():
+		logger.Warn(ctx, "Mail queue was not flushed; the remaining mails are left for redelivery", "dir", q.opts.Dir)
+		q.cancel()
+		<-q.done
+	}
+	q.cancel()
+}
+
+func (q *Queue) run() {
+	defer close(q.done)
+	for path := range q.mails {
+		if q.ctx.Err() != nil {
+			continue
+		}
+		q.deliver(path)
+	}
+}
+
+// deliver sends the mail until it's delivered, it runs out of the attempts
+// or the queue is cancelled.
+func (q *Queue) deliver(path string) {
+	interval := q.opts.RetryInterval
+	for {
+		result, err := attempt(q.ctx, path, q.opts, q.send)
+		if err != nil {
+			logger.Error(q.ctx, "Failed to deliver the mail", "file", path, "err", err)
+			return
+		}
+		if result != attemptFailed {
+			return
+		}
+		select {
+		case <-time.After(interval):
+			interval *= 2
+		case <-q.ctx.Done():
+			return
+		}
+	}
+}
+
+// attemptResult is the result of a delivery attempt.
+type attemptResult int
+
+const (
+	attemptDelivered attemptResult = iota
+	// attemptFailed means the mail is left pending for a retry.
+	attemptFailed
+	// attemptDead means the mail ran out of the attempts.
+	attemptDead
+	// attemptCancelled means the context was cancelled during the attempt,
+	// which isn't counted.
+	attemptCancelled
+)
+
+// attempt tries to send the spooled mail once.
+func attempt(ctx context.Context, path string, opts QueueOptions, send sendFunc) (attemptResult, error) {
+	m, err := readSpooledMail(path)
+	if err != nil {
+		return attemptFailed, err
+	}
+	sendErr := send(ctx, m)
+	if sendErr == nil {
+		return attemptDelivered, os.Remove(path)
+	}
+	if ctx.Err() != nil {
+		return attemptCancelled, nil
+	}
+
+	m.Attempts++
+	m.LastError = sendErr.Error()
+	if m.Attempts >= opts.MaxAttempts {
+		logger.Error(ctx, "Giving up the delivery of the mail", "subject", m.Subject, "attempts", m.Attempts, "err", sendErr)
+		return attemptDead, deadLetter(opts.Dir, path, m)
+	}
+	logger.Warn(ctx, "Failed to send the mail; it will be retried", "subject", m.Subject, "attempts", m.Attempts, "err", sendErr)
+	return attemptFailed, writeSpooledMail(path, m)
+}
+
+// RedeliverResult is the numbers of the mails by the result of Redeliver.
+type RedeliverResult struct {
+	Delivered int
+	Failed    int
+	Dead      int
+}
+
+// Redeliver tries to send each pending mail in the directory once. The mails
+// owned by a live process are skipped because its queue is delivering them.
+func Redeliver(ctx context.Context, opts QueueOptions) (RedeliverResult, error) {
+	return redeliver(ctx, opts.withDefaults(), sendSpooledMail)
+}
+
+func redeliver(ctx context.Context, opts QueueOptions, send sendFunc) (RedeliverResult, error) {
+	var ret RedeliverResult
+	entries, err := os.ReadDir(filepath.Join(opts.Dir, pendingDir))
+	if errors.Is(err, os.ErrNotExist) {
+		return ret, nil
+	}
+	if err != nil {
+		return ret, err
+	}
+
+	var errs []error
+	for _, e := range entries {
+		if ctx.Err() != nil {
+			break
+		}
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") {
+			continue
+		}
+		path := filepath.Join(opts.Dir, pendingDir, e.Name())
+		m, err := readSpooledMail(path)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if m.PID != 0 && m.PID != os.Getpid() && processAlive(m.PID) {
+			continue
+		}
+		// The mail is owned by this process from now on.
+		m.PID = os.Getpid()
+		if err := writeSpooledMail(path, m); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		result, err := attempt(ctx, path, opts, send)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		switch result {
+		case attemptDelivered:
+			ret.Delivered++
+		case attemptFailed:
+			ret.Failed++
+		case attemptDead:
+			ret.Dead++
+		case attemptCancelled:
+		}
+	}
+	return ret, errors.Join(errs...)
+}
+
+func spool(dir string, m *spooledMail) (string, error) {
+	pending := filepath.Join(dir, pendingDir)
+	if err := os.MkdirAll(pending, 0700); err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("%s-%s.json", m.CreatedAt.Format("20060102150405.000000"), uuid.NewString())
+	path := filepath.Join(pending, name)
+	return path, writeSpooledMail(path, m)
+}
+
+func readSpooledMail(path string) (*spooledMail, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var m spooledMail
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to decode the mail %s: %w", path, err)
+	}
+	return &m, nil
+}
+
+// writeSpooledMail writes the mail to a temporary file and renames it so
+// that a partially written mail is never read. The file may contain the
+// SMTP credentials, so only the owner can read it.
+func writeSpooledMail(path string, m *spooledMail) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func deadLetter(dir, path string, m *spooledMail) error {
+	dead := filepath.Join(dir, deadDir)
+	if err := os.MkdirAll(dead, 0700); err != nil {
+		return err
+	}
+	if err := writeSpooledMail(path, m); err != nil {
+		return err
+	}
+	return os.Rename(path, filepath.Join(dead, filepath.Base(path)))
+}
+
+func processAlive(pid int) bool {
+	err := syscall.Kill(pid, 0)
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
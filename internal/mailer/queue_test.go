@@ -0,0 +1,147 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSender records the mails. It fails the first failures attempts and
+// the mails with the subject failSubject.
+type fakeSender struct {
+	mu          sync.Mutex
+	failures    int
+	failSubject string
+	block       chan struct{}
+	sent        []string
+}
+
+func (s *fakeSender) send(ctx context.Context, m *spooledMail) error {
+	if s.block != nil {
+		select {
+		case <-s.block:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failures > 0 || m.Subject == s.failSubject {
+		s.failures--
+		return errors.New("connection refused")
+	}
+	s.sent = append(s.sent, m.Subject)
+	return nil
+}
+
+func (s *fakeSender) subjects() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.sent...)
+}
+
+func newTestQueue(t *testing.T, sender *fakeSender, opts QueueOptions) *Queue {
+	t.Helper()
+	opts.Dir = t.TempDir()
+	q := NewQueue(context.Background(), Config{Host: "localhost", Port: "25"}, opts)
+	q.send = sender.send
+	return q
+}
+
+func pendingMails(t *testing.T, dir, sub string) []string {
+	t.Helper()
+	matches, err := filepath.Glob(filepath.Join(dir, sub, "*.json"))
+	require.NoError(t, err)
+	return matches
+}
+
+func TestQueue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Deliver", func(t *testing.T) {
+		sender := &fakeSender{}
+		q := newTestQueue(t, sender, QueueOptions{})
+		require.NoError(t, q.Send(ctx, "from@example.com", []string{"to@example.com"}, "first", "body", nil))
+		require.NoError(t, q.Send(ctx, "from@example.com", []string{"to@example.com"}, "second", "body", nil))
+		q.Close(ctx)
+
+		require.Equal(t, []string{"first", "second"}, sender.subjects())
+		require.Empty(t, pendingMails(t, q.opts.Dir, pendingDir))
+		require.ErrorIs(t, q.Send(ctx, "from@example.com", nil, "late", "body", nil), errQueueClosed)
+	})
+	t.Run("Retry", func(t *testing.T) {
+		sender := &fakeSender{failures: 2}
+		q := newTestQueue(t, sender, QueueOptions{RetryInterval: time.Millisecond})
+		require.NoError(t, q.Send(ctx, "from@example.com", []string{"to@example.com"}, "retried", "body", nil))
+		q.Close(ctx)
+
+		require.Equal(t, []string{"retried"}, sender.subjects())
+		require.Empty(t, pendingMails(t, q.opts.Dir, pendingDir))
+	})
+	t.Run("DeadLetter", func(t *testing.T) {
+		sender := &fakeSender{failures: 10}
+		q := newTestQueue(t, sender, QueueOptions{MaxAttempts: 2, RetryInterval: time.Millisecond})
+		require.NoError(t, q.Send(ctx, "from@example.com", []string{"to@example.com"}, "dead", "body", nil))
+		q.Close(ctx)
+
+		require.Empty(t, pendingMails(t, q.opts.Dir, pendingDir))
+		dead := pendingMails(t, q.opts.Dir, deadDir)
+		require.Len(t, dead, 1)
+		m, err := readSpooledMail(dead[0])
+		require.NoError(t, err)
+		require.Equal(t, 2, m.Attempts)
+		require.Equal(t, "connection refused", m.LastError)
+	})
+	t.Run("FlushTimeout", func(t *testing.T) {
+		sender := &fakeSender{block: make(chan struct{})}
+		q := newTestQueue(t, sender, QueueOptions{FlushTimeout: 50 * time.Millisecond})
+		require.NoError(t, q.Send(ctx, "from@example.com", []string{"to@example.com"}, "slow", "body", nil))
+
+		start := time.Now()
+		q.Close(ctx)
+		require.Less(t, time.Since(start), 5*time.Second)
+
+		// The mail is left for the redelivery without counting the attempt.
+		pending := pendingMails(t, q.opts.Dir, pendingDir)
+		require.Len(t, pending, 1)
+		m, err := readSpooledMail(pending[0])
+		require.NoError(t, err)
+		require.Zero(t, m.Attempts)
+		require.Equal(t, "localhost", m.Config.Host)
+	})
+}
+
+func TestRedeliver(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	opts := QueueOptions{Dir: dir, MaxAttempts: 2}.withDefaults()
+
+	mail := func(subject string, pid, attempts int) {
+		_, err := spool(dir, &spooledMail{Subject: subject, PID: pid, Attempts: attempts, CreatedAt: time.Now()})
+		require.NoError(t, err)
+	}
+	// The mails of a dead agent, and of a live process which is skipped.
+	mail("orphan", 0, 0)
+	mail("last", 0, 1)
+	mail("owned", os.Getppid(), 0)
+
+	sender := &fakeSender{failSubject: "last"}
+	ret, err := redeliver(ctx, opts, sender.send)
+	require.NoError(t, err)
+	require.Equal(t, RedeliverResult{Delivered: 1, Dead: 1}, ret)
+	require.Equal(t, []string{"orphan"}, sender.subjects())
+	require.Len(t, pendingMails(t, dir, pendingDir), 1)
+	require.Len(t, pendingMails(t, dir, deadDir), 1)
+
+	t.Run("NoDir", func(t *testing.T) {
+		ret, err := redeliver(ctx, QueueOptions{Dir: filepath.Join(dir, "none")}, sender.send)
+		require.NoError(t, err)
+		require.Zero(t, ret)
+	})
+}
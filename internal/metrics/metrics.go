@@ -0,0 +1,130 @@
+// Package metrics exposes the metrics of the DAG runs in the Prometheus
+// text format.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// Collector writes its metrics in the Prometheus text format.
+type Collector interface {
+	WriteTo(w io.Writer) (int64, error)
+}
+
+// CollectorFunc is a function writing the metrics.
+type CollectorFunc func(w io.Writer) (int64, error)
+
+// WriteTo calls the function.
+func (f CollectorFunc) WriteTo(w io.Writer) (int64, error) {
+	return f(w)
+}
+
+// Handler returns the handler serving the metrics of the collectors.
+func Handler(collectors ...Collector) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		for _, c := range collectors {
+			if _, err := c.WriteTo(w); err != nil {
+				return
+			}
+		}
+	})
+}
+
+// Buckets of the histograms in seconds.
+var (
+	// LatencyBuckets is for the short delays, e.g. the latency of the
+	// ticks of the scheduler.
+	LatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+	// DurationBuckets is for the durations of the steps.
+	DurationBuckets = []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300, 600, 1800, 3600, 10800}
+)
+
+// Histogram counts the observed values in the buckets. It's not safe for
+// concurrent use; the collectors guard it with their locks.
+type Histogram struct {
+	buckets []float64
+	counts  []uint64
+	sum     float64
+	count   uint64
+}
+
+// NewHistogram creates a histogram with the upper bounds of the buckets in
+// ascending order.
+func NewHistogram(buckets []float64) *Histogram {
+	return &Histogram{
+		buckets: buckets,
+		counts:  make([]uint64, len(buckets)),
+	}
+}
+
+// Observe adds the value to the histogram.
+func (h *Histogram) Observe(v float64) {
+	for i, upper := range h.buckets {
+		if v <= upper {
+			h.counts[i]++
+		}
+	}
+	h.sum += v
+	h.count++
+}
+
+// Write writes the samples of the histogram with the labels, e.g.
+// `dag="example"`. The HELP and TYPE lines are written by the caller.
+func (h *Histogram) Write(w io.Writer, name, labels string) (int64, error) {
+	p := NewPrinter(w)
+	sep := ""
+	if labels != "" {
+		sep = ","
+	}
+	for i, upper := range h.buckets {
+		p.Printf("%s_bucket{%s%sle=%q} %d\n", name, labels, sep, strconv.FormatFloat(upper, 'g', -1, 64), h.counts[i])
+	}
+	p.Printf("%s_bucket{%s%sle=\"+Inf\"} %d\n", name, labels, sep, h.count)
+	if labels != "" {
+		labels = "{" + labels + "}"
+	}
+	p.Printf("%s_sum%s %g\n", name, labels, h.sum)
+	p.Printf("%s_count%s %d\n", name, labels, h.count)
+	return p.Result()
+}
+
+// Printer writes the lines of the metrics until an error occurs.
+type Printer struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+// NewPrinter creates a printer writing to w.
+func NewPrinter(w io.Writer) *Printer {
+	return &Printer{w: w}
+}
+
+// Printf writes the formatted line.
+func (p *Printer) Printf(format string, args ...any) {
+	if p.err != nil {
+		return
+	}
+	var n int
+	n, p.err = fmt.Fprintf(p.w, format, args...)
+	p.n += int64(n)
+}
+
+// Write writes the output of the function, e.g. a histogram.
+func (p *Printer) Write(fn func(w io.Writer) (int64, error)) {
+	if p.err != nil {
+		return
+	}
+	var n int64
+	n, p.err = fn(p.w)
+	p.n += n
+}
+
+// Result returns the number of bytes written and the first error.
+func (p *Printer) Result() (int64, error) {
+	return p.n, p.err
+}
@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/client"
+)
+
+// queueReasons is the reasons of the queued runs in the order of the
+// metrics.
+var queueReasons = []client.QueueReason{
+	client.QueueReasonDelayed, client.QueueReasonConcurrency, client.QueueReasonDue,
+}
+
+// Queue returns the collector of the number of the runs in the queue. The
+// queue is read each time the metrics are collected.
+func Queue(cli client.Client) Collector {
+	return CollectorFunc(func(w io.Writer) (int64, error) {
+		runs, err := cli.GetQueue(context.Background(), time.Now())
+		if err != nil {
+			// The queue is not reported rather than reported empty.
+			return 0, nil
+		}
+		depth := make(map[client.QueueReason]int, len(queueReasons))
+		for _, run := range runs {
+			depth[run.Reason]++
+		}
+
+		p := NewPrinter(w)
+		p.Printf("# HELP dagu_queue_depth Number of the runs waiting in the queue.\n")
+		p.Printf("# TYPE dagu_queue_depth gauge\n")
+		for _, reason := range queueReasons {
+			p.Printf("dagu_queue_depth{reason=%q} %d\n", reason, depth[reason])
+		}
+		return p.Result()
+	})
+}
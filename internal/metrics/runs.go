@@ -0,0 +1,163 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/dagu-org/dagu/internal/stringutil"
+)
+
+// Runs counts the DAG runs and the durations of their steps from the
+// statuses observed. A run is counted once however many times its status
+// is observed.
+type Runs struct {
+	mu sync.Mutex
+
+	// last is the last status observed for each DAG.
+	last map[string]observed
+
+	started   map[string]int64
+	succeeded map[string]int64
+	failed    map[string]int64
+	cancelled map[string]int64
+	// steps is the histograms of the durations of the steps by the DAG
+	// and the step.
+	steps map[stepKey]*Histogram
+}
+
+type observed struct {
+	requestID string
+	status    scheduler.Status
+}
+
+type stepKey struct {
+	dag  string
+	step string
+}
+
+// NewRuns creates a collector of the DAG runs.
+func NewRuns() *Runs {
+	return &Runs{
+		last:      make(map[string]observed),
+		started:   make(map[string]int64),
+		succeeded: make(map[string]int64),
+		failed:    make(map[string]int64),
+		cancelled: make(map[string]int64),
+		steps:     make(map[stepKey]*Histogram),
+	}
+}
+
+// Observe records the latest status of the DAG. The status of a run that
+// was not observed running yet also counts the run as started.
+func (r *Runs) Observe(key string, status *model.Status) {
+	if status == nil || status.RequestID == "" || status.Status == scheduler.StatusNone {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	prev, ok := r.last[key]
+	sameRun := ok && prev.requestID == status.RequestID
+	if sameRun && prev.status == status.Status {
+		return
+	}
+	r.last[key] = observed{requestID: status.RequestID, status: status.Status}
+
+	dag := status.Name
+	if !sameRun {
+		r.started[dag]++
+	}
+	switch status.Status {
+	case scheduler.StatusSuccess:
+		r.succeeded[dag]++
+	case scheduler.StatusError:
+		r.failed[dag]++
+	case scheduler.StatusCancel:
+		r.cancelled[dag]++
+	default:
+		return
+	}
+
+	for _, node := range status.Nodes {
+		switch node.Status {
+		case scheduler.NodeStatusSuccess, scheduler.NodeStatusError, scheduler.NodeStatusCancel:
+		default:
+			continue
+		}
+		started, err := stringutil.ParseTime(node.StartedAt)
+		if err != nil || started.IsZero() {
+			continue
+		}
+		finished, err := stringutil.ParseTime(node.FinishedAt)
+		if err != nil || finished.Before(started) {
+			continue
+		}
+		k := stepKey{dag: dag, step: node.Step.Name}
+		h, ok := r.steps[k]
+		if !ok {
+			h = NewHistogram(DurationBuckets)
+			r.steps[k] = h
+		}
+		h.Observe(finished.Sub(started).Seconds())
+	}
+}
+
+// WriteTo writes the metrics in the Prometheus text format.
+func (r *Runs) WriteTo(w io.Writer) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p := NewPrinter(w)
+	counters := []struct {
+		name   string
+		help   string
+		values map[string]int64
+	}{
+		{"dagu_runs_started_total", "Number of DAG runs started.", r.started},
+		{"dagu_runs_succeeded_total", "Number of DAG runs succeeded.", r.succeeded},
+		{"dagu_runs_failed_total", "Number of DAG runs failed.", r.failed},
+		{"dagu_runs_cancelled_total", "Number of DAG runs cancelled.", r.cancelled},
+	}
+	for _, c := range counters {
+		p.Printf("# HELP %s %s\n", c.name, c.help)
+		p.Printf("# TYPE %s counter\n", c.name)
+		for _, dag := range sortedKeys(c.values) {
+			p.Printf("%s{dag=%q} %d\n", c.name, dag, c.values[dag])
+		}
+	}
+
+	p.Printf("# HELP dagu_step_duration_seconds Duration of the executions of the steps.\n")
+	p.Printf("# TYPE dagu_step_duration_seconds histogram\n")
+	keys := make([]stepKey, 0, len(r.steps))
+	for k := range r.steps {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].dag != keys[j].dag {
+			return keys[i].dag < keys[j].dag
+		}
+		return keys[i].step < keys[j].step
+	})
+	for _, k := range keys {
+		labels := fmt.Sprintf("dag=%q,step=%q", k.dag, k.step)
+		p.Write(func(w io.Writer) (int64, error) {
+			return r.steps[k].Write(w, "dagu_step_duration_seconds", labels)
+		})
+	}
+
+	return p.Result()
+}
+
+func sortedKeys(m map[string]int64) []string {
+	ret := make([]string, 0, len(m))
+	for k := range m {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret
+}
@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"bytes"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/dagu-org/dagu/internal/stringutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newStatus(requestID string, status scheduler.Status, nodes ...*model.Node) *model.Status {
+	return &model.Status{
+		Name:      "etl",
+		RequestID: requestID,
+		Status:    status,
+		Nodes:     nodes,
+	}
+}
+
+func newNode(name string, status scheduler.NodeStatus, duration time.Duration) *model.Node {
+	started := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &model.Node{
+		Step:       digraph.Step{Name: name},
+		Status:     status,
+		StartedAt:  stringutil.FormatTime(started),
+		FinishedAt: stringutil.FormatTime(started.Add(duration)),
+	}
+}
+
+func TestRuns(t *testing.T) {
+	runs := NewRuns()
+
+	// The status is observed more than once.
+	runs.Observe("etl.yaml", newStatus("req-1", scheduler.StatusRunning))
+	runs.Observe("etl.yaml", newStatus("req-1", scheduler.StatusRunning))
+	finished := newStatus("req-1", scheduler.StatusSuccess,
+		newNode("extract", scheduler.NodeStatusSuccess, 3*time.Second),
+		newNode("load", scheduler.NodeStatusSkipped, 0),
+	)
+	runs.Observe("etl.yaml", finished)
+	runs.Observe("etl.yaml", finished)
+
+	// The run finished before it was observed running.
+	runs.Observe("etl.yaml", newStatus("req-2", scheduler.StatusError,
+		newNode("extract", scheduler.NodeStatusError, 20*time.Second),
+	))
+
+	var buf bytes.Buffer
+	_, err := runs.WriteTo(&buf)
+	require.NoError(t, err)
+	out := buf.String()
+
+	assert.Contains(t, out, "dagu_runs_started_total{dag=\"etl\"} 2\n")
+	assert.Contains(t, out, "dagu_runs_succeeded_total{dag=\"etl\"} 1\n")
+	assert.Contains(t, out, "dagu_runs_failed_total{dag=\"etl\"} 1\n")
+	assert.Contains(t, out, "# TYPE dagu_runs_cancelled_total counter\n")
+	assert.Contains(t, out, "# TYPE dagu_step_duration_seconds histogram\n")
+	assert.Contains(t, out, "dagu_step_duration_seconds_bucket{dag=\"etl\",step=\"extract\",le=\"5\"} 1\n")
+	assert.Contains(t, out, "dagu_step_duration_seconds_bucket{dag=\"etl\",step=\"extract\",le=\"30\"} 2\n")
+	assert.Contains(t, out, "dagu_step_duration_seconds_sum{dag=\"etl\",step=\"extract\"} 23\n")
+	assert.Contains(t, out, "dagu_step_duration_seconds_count{dag=\"etl\",step=\"extract\"} 2\n")
+	assert.NotContains(t, out, `step="load"`)
+}
+
+func TestHandler(t *testing.T) {
+	runs := NewRuns()
+	runs.Observe("etl.yaml", newStatus("req-1", scheduler.StatusRunning))
+	extra := CollectorFunc(func(w io.Writer) (int64, error) {
+		n, err := io.WriteString(w, "extra_metric 1\n")
+		return int64(n), err
+	})
+
+	rec := httptest.NewRecorder()
+	Handler(runs, extra).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), "dagu_runs_started_total{dag=\"etl\"} 1\n")
+	assert.Contains(t, rec.Body.String(), "extra_metric 1\n")
+}
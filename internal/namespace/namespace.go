@@ -0,0 +1,137 @@
+// Package namespace scopes the DAGs to namespaces such as "team-a" so that
+// the teams sharing a server only see and operate their own DAGs. The DAGs
+// of a namespace are in the subdirectory of the namespace in a DAG
+// directory, and their IDs are the namespace and the name, e.g.
+// "team-a/etl". The DAGs directly in a DAG directory are in the root
+// namespace, whose name is empty.
+package namespace
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/dagu-org/dagu/internal/fileutil"
+)
+
+// Separator separates the namespace from the name in the DAG IDs.
+const Separator = "/"
+
+// BaseConfigFile is the name of the base config of a namespace in its
+// directory. It's applied to the DAGs of the namespace after the base config
+// of the DAG directory.
+const BaseConfigFile = ".base.yaml"
+
+// IsDAGFile returns whether the file in a DAG directory or a namespace is a
+// DAG, i.e. a YAML file other than the base config of the namespace.
+func IsDAGFile(name string) bool {
+	return fileutil.IsYAMLFile(name) && !IsBaseConfig(filepath.Base(name))
+}
+
+// IsBaseConfig returns whether the file name, with or without the
+// extension, is the one of the base config of a namespace.
+func IsBaseConfig(name string) bool {
+	return fileutil.EnsureYAMLExtension(name) == BaseConfigFile
+}
+
+// All grants the access to all the namespaces including the root one.
+const All = "*"
+
+var validName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// Valid returns whether the name can be used as a namespace.
+func Valid(name string) bool {
+	return validName.MatchString(name)
+}
+
+// Split splits the DAG ID into the namespace and the name. The namespace is
+// empty if the ID has no namespace.
+func Split(id string) (ns, name string) {
+	ns, name, ok := strings.Cut(id, Separator)
+	if !ok || !Valid(ns) || strings.Contains(name, Separator) {
+		return "", id
+	}
+	return ns, name
+}
+
+// Join returns the DAG ID of the name in the namespace.
+func Join(ns, name string) string {
+	if ns == "" {
+		return name
+	}
+	return ns + Separator + name
+}
+
+// Of returns the namespace of the DAG file, i.e. the name of its directory
+// if the directory is in one of the DAG directories. A directory that is a
+// DAG directory itself is not a namespace.
+func Of(dagsDirs []string, file string) string {
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return ""
+	}
+	dir := filepath.Dir(abs)
+	ns := filepath.Base(dir)
+	if !Valid(ns) {
+		return ""
+	}
+	parent := filepath.Dir(dir)
+	found := false
+	for _, d := range dagsDirs {
+		d, err := filepath.Abs(d)
+		if err != nil {
+			continue
+		}
+		if d == dir {
+			return ""
+		}
+		found = found || d == parent
+	}
+	if found {
+		return ns
+	}
+	return ""
+}
+
+// List returns the namespaces in the DAG directory, i.e. its
+// subdirectories with valid names.
+func List(dagsDir string) ([]string, error) {
+	entries, err := os.ReadDir(dagsDir)
+	if err != nil {
+		return nil, err
+	}
+	var ret []string
+	for _, entry := range entries {
+		if entry.IsDir() && Valid(entry.Name()) {
+			ret = append(ret, entry.Name())
+		}
+	}
+	return ret, nil
+}
+
+type accessKey struct{}
+
+// WithAccess returns a context allowed to access only the namespaces. The
+// context without the access is allowed to access all the namespaces.
+func WithAccess(ctx context.Context, namespaces []string) context.Context {
+	return context.WithValue(ctx, accessKey{}, namespaces)
+}
+
+// Allowed returns whether the context is allowed to access the namespace.
+func Allowed(ctx context.Context, ns string) bool {
+	namespaces, restricted := AllowedList(ctx)
+	return !restricted || slices.Contains(namespaces, ns)
+}
+
+// AllowedList returns the namespaces the context is allowed to access, and
+// false if it's allowed to access all of them.
+func AllowedList(ctx context.Context) ([]string, bool) {
+	namespaces, ok := ctx.Value(accessKey{}).([]string)
+	if !ok || slices.Contains(namespaces, All) {
+		return nil, false
+	}
+	return namespaces, true
+}
@@ -0,0 +1,62 @@
+package namespace
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplit(t *testing.T) {
+	for id, want := range map[string][2]string{
+		"etl":            {"", "etl"},
+		"team-a/etl":     {"team-a", "etl"},
+		"team-a/sub/etl": {"", "team-a/sub/etl"},
+		"../etl":         {"", "../etl"},
+		"/tmp/etl.yaml":  {"", "/tmp/etl.yaml"},
+	} {
+		ns, name := Split(id)
+		require.Equal(t, want, [2]string{ns, name}, id)
+		if ns != "" {
+			require.Equal(t, id, Join(ns, name))
+		}
+	}
+}
+
+func TestOf(t *testing.T) {
+	dagsDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dagsDir, "team-a"), 0755))
+
+	require.Equal(t, "team-a", Of([]string{dagsDir}, filepath.Join(dagsDir, "team-a", "etl.yaml")))
+	require.Equal(t, "", Of([]string{dagsDir}, filepath.Join(dagsDir, "etl.yaml")))
+	require.Equal(t, "", Of([]string{dagsDir}, filepath.Join(dagsDir, "team-a", "sub", "etl.yaml")))
+
+	namespaces, err := List(dagsDir)
+	require.NoError(t, err)
+	require.Equal(t, []string{"team-a"}, namespaces)
+}
+
+func TestAccess(t *testing.T) {
+	ctx := context.Background()
+	require.True(t, Allowed(ctx, "team-a"))
+
+	scoped := WithAccess(ctx, []string{"team-a"})
+	require.True(t, Allowed(scoped, "team-a"))
+	require.False(t, Allowed(scoped, "team-b"))
+	require.False(t, Allowed(scoped, ""))
+	namespaces, restricted := AllowedList(scoped)
+	require.True(t, restricted)
+	require.Equal(t, []string{"team-a"}, namespaces)
+
+	require.True(t, Allowed(WithAccess(ctx, []string{All}), "team-b"))
+}
+
+func TestIsDAGFile(t *testing.T) {
+	require.True(t, IsDAGFile("etl.yaml"))
+	require.True(t, IsDAGFile("/dags/team-a/etl.yml"))
+	require.False(t, IsDAGFile("/dags/team-a/.base.yaml"))
+	require.False(t, IsDAGFile("README.md"))
+	require.True(t, IsBaseConfig(".base"))
+}
@@ -0,0 +1,39 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+var _ Notifier = (*commandNotifier)(nil)
+
+// commandNotifier runs the executable plugin with the notification as JSON
+// on the standard input.
+type commandNotifier struct {
+	path string
+}
+
+// Notify implements Notifier.
+func (c *commandNotifier) Notify(ctx context.Context, n Notification) error {
+	data, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("failed to encode the notification: %w", err)
+	}
+
+	var stderr bytes.Buffer
+	// nolint: gosec
+	cmd := exec.CommandContext(ctx, c.path)
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("plugin %s failed: %w: %s", c.path, err, msg)
+		}
+		return fmt.Errorf("plugin %s failed: %w", c.path, err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Package notifier sends the result of the runs to the notification
+// backends provided as plugins.
+//
+// The plugins are discovered from the plugins directory:
+//
+//   - An executable named "dagu-notifier-<type>" is run with the
+//     notification as JSON on the standard input.
+//   - A YAML file named "<type>.yaml" describes a webhook. The URL, the
+//     headers and the body are templates of the notification.
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/fileutil"
+)
+
+// pluginPrefix is the prefix of the name of the executable plugins.
+const pluginPrefix = "dagu-notifier-"
+
+// timeout is the maximum time to send a notification.
+const timeout = time.Minute
+
+var errNotifierNotFound = errors.New("notifier not found")
+
+// Notification is the result of a run sent to the notifiers.
+type Notification struct {
+	DAG        string `json:"dag"`
+	RequestID  string `json:"requestId"`
+	Status     string `json:"status"`
+	StartedAt  string `json:"startedAt,omitempty"`
+	FinishedAt string `json:"finishedAt,omitempty"`
+	Params     string `json:"params,omitempty"`
+	Error      string `json:"error,omitempty"`
+	// Graph is the SVG image of the graph of the steps with their statuses.
+	Graph string `json:"graph,omitempty"`
+	// Config is the configuration of the notifier in the DAG.
+	Config map[string]any `json:"config,omitempty"`
+}
+
+// Notifier sends the notification to a backend.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// Registry is the set of the notifiers by type.
+type Registry struct {
+	notifiers map[string]Notifier
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{notifiers: make(map[string]Notifier)}
+}
+
+// Register registers the notifier of the type.
+func (r *Registry) Register(notifierType string, n Notifier) {
+	r.notifiers[notifierType] = n
+}
+
+// Notify sends the notification with the notifier of the type.
+func (r *Registry) Notify(ctx context.Context, notifierType string, n Notification) error {
+	notifier, ok := r.notifiers[notifierType]
+	if !ok {
+		return fmt.Errorf("%w: %s", errNotifierNotFound, notifierType)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return notifier.Notify(ctx, n)
+}
+
+// NotifyWithRetry sends the notification with the notifier, retrying up to
+// retries times on failure. The interval doubles after each retry.
+func NotifyWithRetry(ctx context.Context, notifier Notifier, n Notification, retries int, interval time.Duration) error {
+	for attempt := 0; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := notifier.Notify(attemptCtx, n)
+		cancel()
+		if err == nil || attempt >= retries {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(interval << attempt):
+		}
+	}
+}
+
+// Load discovers the plugins in the directory. It returns an empty
+// registry if the directory does not exist.
+func Load(dir string) (*Registry, error) {
+	r := NewRegistry()
+	if dir == "" {
+		return r, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plugins directory %s: %w", dir, err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		path := filepath.Join(dir, name)
+		switch {
+		case strings.HasPrefix(name, pluginPrefix):
+			info, err := entry.Info()
+			if err != nil || info.Mode()&0111 == 0 {
+				// Not executable.
+				continue
+			}
+			r.Register(strings.TrimPrefix(name, pluginPrefix), &commandNotifier{path: path})
+
+		case fileutil.IsYAMLFile(name):
+			webhook, err := loadWebhook(path)
+			if err != nil {
+				return nil, err
+			}
+			r.Register(strings.TrimSuffix(name, filepath.Ext(name)), webhook)
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,125 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("NotExist", func(t *testing.T) {
+		r, err := Load(filepath.Join(t.TempDir(), "missing"))
+		require.NoError(t, err)
+		require.Empty(t, r.notifiers)
+	})
+	t.Run("Command", func(t *testing.T) {
+		dir := t.TempDir()
+		out := filepath.Join(dir, "out.json")
+		script := "#!/bin/sh\ncat > " + out + "\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, pluginPrefix+"file"), []byte(script), 0755)) // nolint: gosec
+		// Not executable.
+		require.NoError(t, os.WriteFile(filepath.Join(dir, pluginPrefix+"other"), []byte(script), 0600))
+
+		r, err := Load(dir)
+		require.NoError(t, err)
+		require.Len(t, r.notifiers, 1)
+
+		n := Notification{DAG: "test", RequestID: "request-id", Status: "failure", Error: "failed"}
+		require.NoError(t, r.Notify(context.Background(), "file", n))
+
+		data, err := os.ReadFile(out)
+		require.NoError(t, err)
+		var got Notification
+		require.NoError(t, json.Unmarshal(data, &got))
+		require.Equal(t, n, got)
+	})
+	t.Run("Webhook", func(t *testing.T) {
+		var (
+			gotPath   string
+			gotHeader string
+			gotBody   string
+		)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotPath = r.URL.Path
+			gotHeader = r.Header.Get("X-Channel")
+			body, _ := io.ReadAll(r.Body)
+			gotBody = string(body)
+		}))
+		defer srv.Close()
+
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "chat.yaml"), []byte(`
+url: "`+srv.URL+`/hooks/{{ .DAG }}"
+headers:
+  X-Channel: "{{ .Config.channel }}"
+body: '{"text": {{ json .Error }}}'
+`), 0600))
+
+		r, err := Load(dir)
+		require.NoError(t, err)
+		require.NoError(t, r.Notify(context.Background(), "chat", Notification{
+			DAG:    "backup",
+			Status: "failure",
+			Error:  `exit "1"`,
+			Config: map[string]any{"channel": "alerts"},
+		}))
+		require.Equal(t, "/hooks/backup", gotPath)
+		require.Equal(t, "alerts", gotHeader)
+		require.Equal(t, `{"text": "exit \"1\""}`, gotBody)
+	})
+	t.Run("WebhookError", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer srv.Close()
+
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "chat.yaml"), []byte("url: "+srv.URL+"\n"), 0600))
+
+		r, err := Load(dir)
+		require.NoError(t, err)
+		require.Error(t, r.Notify(context.Background(), "chat", Notification{DAG: "backup"}))
+	})
+	t.Run("InvalidWebhook", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "chat.yaml"), []byte("method: POST\n"), 0600))
+
+		_, err := Load(dir)
+		require.ErrorIs(t, err, errWebhookURLRequired)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		err := NewRegistry().Notify(context.Background(), "missing", Notification{})
+		require.ErrorIs(t, err, errNotifierNotFound)
+	})
+}
+
+func TestNotifyWithRetry(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	webhook, err := NewWebhook(WebhookDef{URL: srv.URL})
+	require.NoError(t, err)
+	require.NoError(t, NotifyWithRetry(context.Background(), webhook, Notification{DAG: "backup"}, 2, time.Millisecond))
+	require.Equal(t, 3, calls)
+
+	t.Run("Exhausted", func(t *testing.T) {
+		calls = 0
+		err := NotifyWithRetry(context.Background(), webhook, Notification{DAG: "backup"}, 1, time.Millisecond)
+		require.Error(t, err)
+		require.Equal(t, 2, calls)
+	})
+}
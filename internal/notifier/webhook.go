@@ -0,0 +1,161 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+)
+
+var _ Notifier = (*webhookNotifier)(nil)
+
+var errWebhookURLRequired = errors.New("webhook url is required")
+
+// WebhookDef is the definition of a webhook.
+type WebhookDef struct {
+	// URL is the template of the URL of the webhook.
+	URL string `yaml:"url"`
+	// Method is the HTTP method. It's POST by default.
+	Method string `yaml:"method"`
+	// Headers is the templates of the HTTP headers.
+	Headers map[string]string `yaml:"headers"`
+	// Body is the template of the request body. The notification is sent
+	// as JSON if it's empty.
+	Body string `yaml:"body"`
+}
+
+// webhookNotifier sends the notification to the webhook.
+type webhookNotifier struct {
+	method  string
+	url     *template.Template
+	headers map[string]*template.Template
+	body    *template.Template
+}
+
+var templateFuncs = template.FuncMap{
+	// json encodes the value as JSON, e.g. to quote a string in the body.
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+func loadWebhook(file string) (*webhookNotifier, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook %s: %w", file, err)
+	}
+	var def WebhookDef
+	if err := yaml.UnmarshalStrict(data, &def); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook %s: %w", file, err)
+	}
+	w, err := newWebhook(def)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, file)
+	}
+	return w, nil
+}
+
+// NewWebhook creates a notifier that sends the notification to the webhook.
+func NewWebhook(def WebhookDef) (Notifier, error) {
+	return newWebhook(def)
+}
+
+func newWebhook(def WebhookDef) (*webhookNotifier, error) {
+	if def.URL == "" {
+		return nil, errWebhookURLRequired
+	}
+
+	parse := func(name, text string) (*template.Template, error) {
+		tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=zero").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s of webhook: %w", name, err)
+		}
+		return tmpl, nil
+	}
+
+	var err error
+
+	w := &webhookNotifier{
+		method:  http.MethodPost,
+		headers: make(map[string]*template.Template),
+	}
+	if def.Method != "" {
+		w.method = strings.ToUpper(def.Method)
+	}
+	if w.url, err = parse("url", def.URL); err != nil {
+		return nil, err
+	}
+	for k, v := range def.Headers {
+		if w.headers[k], err = parse(k, v); err != nil {
+			return nil, err
+		}
+	}
+	if def.Body != "" {
+		if w.body, err = parse("body", def.Body); err != nil {
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+// Notify implements Notifier.
+func (w *webhookNotifier) Notify(ctx context.Context, n Notification) error {
+	url, err := execute(w.url, n)
+	if err != nil {
+		return err
+	}
+
+	var body []byte
+	if w.body != nil {
+		s, err := execute(w.body, n)
+		if err != nil {
+			return err
+		}
+		body = []byte(s)
+	} else if body, err = json.Marshal(n); err != nil {
+		return fmt.Errorf("failed to encode the notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, w.method, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create the request: %w", err)
+	}
+	if w.body == nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, tmpl := range w.headers {
+		v, err := execute(tmpl, n)
+		if err != nil {
+			return err
+		}
+		req.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send the request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("webhook responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+func execute(tmpl *template.Template, n Notification) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, n); err != nil {
+		return "", fmt.Errorf("failed to render %s: %w", tmpl.Name(), err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,41 @@
+package persistence
+
+import (
+	"context"
+	"sync"
+)
+
+// batchWorkers is the number of the workers reading the files of a batch in
+// parallel.
+const batchWorkers = 16
+
+// ReadBatch calls read for each index from 0 to n-1 with up to 16 workers in
+// parallel. It stops handing out the indexes once the context is done and
+// returns the error of the context; read is not called for the rest.
+func ReadBatch(ctx context.Context, n int, read func(i int)) error {
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(batchWorkers, n) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				read(i)
+			}
+		}()
+	}
+
+	var err error
+loop:
+	for i := range n {
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			break loop
+		case indexes <- i:
+		}
+	}
+	close(indexes)
+	wg.Wait()
+	return err
+}
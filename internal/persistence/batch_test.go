@@ -0,0 +1,34 @@
+package persistence_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/dagu-org/dagu/internal/persistence"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadBatch(t *testing.T) {
+	t.Run("ReadAll", func(t *testing.T) {
+		read := make([]int, 100)
+		err := persistence.ReadBatch(context.Background(), len(read), func(i int) {
+			read[i]++
+		})
+		require.NoError(t, err)
+		for i := range read {
+			require.Equal(t, 1, read[i], "index %d", i)
+		}
+	})
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var count atomic.Int32
+		err := persistence.ReadBatch(ctx, 100, func(_ int) {
+			if count.Add(1) == 10 {
+				cancel()
+			}
+		})
+		require.ErrorIs(t, err, context.Canceled)
+		require.Less(t, count.Load(), int32(100))
+	})
+}
@@ -14,6 +14,7 @@ var (
 	ErrRequestIDNotFound = fmt.Errorf("request id not found")
 	ErrNoStatusDataToday = fmt.Errorf("no status data today")
 	ErrNoStatusData      = fmt.Errorf("no status data")
+	ErrDAGNotFound       = fmt.Errorf("DAG not found")
 )
 
 type HistoryStore interface {
@@ -23,10 +24,43 @@ type HistoryStore interface {
 	Update(ctx context.Context, key, requestID string, status model.Status) error
 	ReadStatusRecent(ctx context.Context, key string, itemLimit int) []model.StatusFile
 	ReadStatusToday(ctx context.Context, key string) (*model.Status, error)
+	// BatchReadLatest reads the latest statuses of the DAGs in the same way
+	// as ReadStatusToday. The results are in the order of the keys.
+	BatchReadLatest(ctx context.Context, keys []string) []LatestStatus
 	FindByRequestID(ctx context.Context, key string, requestID string) (*model.StatusFile, error)
+	// ReadStatusFile reads the status in the File of a model.StatusFile
+	// returned by the store.
+	ReadStatusFile(ctx context.Context, file string) (*model.Status, error)
 	RemoveAll(ctx context.Context, key string) error
 	RemoveOld(ctx context.Context, key string, retentionDays int) error
 	Rename(ctx context.Context, oldKey, newKey string) error
+	// ReserveIdempotencyKey reserves the idempotency key of the DAG for the
+	// run with the request ID atomically. It returns the request ID of the
+	// run the key is reserved for, which is requestID if the key was not
+	// reserved yet.
+	ReserveIdempotencyKey(ctx context.Context, key, idempotencyKey, requestID string) (string, error)
+	// ReleaseIdempotencyKey releases the idempotency key reserved for the
+	// run with the request ID.
+	ReleaseIdempotencyKey(ctx context.Context, key, idempotencyKey, requestID string) error
+}
+
+// StatusChangeTracker is implemented by the history stores tracking when
+// the latest statuses of the DAGs change, so that the clients polling the
+// DAGs can list only the changed ones.
+type StatusChangeTracker interface {
+	// ChangedSince returns true if the latest status of the DAG changed at
+	// or after the time, or if it's unknown.
+	ChangedSince(key string, since time.Time) bool
+	// MarkChanged records that the DAG changed now.
+	MarkChanged(key string)
+}
+
+// LatestStatus is the result of reading the latest status of a DAG.
+type LatestStatus struct {
+	Status *model.Status
+	// Err is ErrNoStatusDataToday or ErrNoStatusData if the DAG has no
+	// status to read.
+	Err error
 }
 
 type DAGStore interface {
@@ -36,7 +70,7 @@ type DAGStore interface {
 	ListPagination(ctx context.Context, params DAGListPaginationArgs) (*DagListPaginationResult, error)
 	GetMetadata(ctx context.Context, name string) (*digraph.DAG, error)
 	GetDetails(ctx context.Context, name string) (*digraph.DAG, error)
-	Grep(ctx context.Context, pattern string) (ret []*GrepResult, errs []string, err error)
+	Search(ctx context.Context, query string) (ret []*SearchResult, errs []string, err error)
 	Rename(ctx context.Context, oldID, newID string) error
 	GetSpec(ctx context.Context, name string) (string, error)
 	UpdateSpec(ctx context.Context, name string, spec []byte) error
@@ -48,6 +82,12 @@ type DAGListPaginationArgs struct {
 	Limit int
 	Name  string
 	Tag   string
+	// Namespaces limits the DAGs to the namespaces. All the DAGs are listed
+	// if it's nil.
+	Namespaces []string
+	// Filter lists only the DAGs it returns true for if it's set. The DAGs
+	// are filtered before they are paginated.
+	Filter func(dag *digraph.DAG) bool
 }
 
 type DagListPaginationResult struct {
@@ -56,13 +96,70 @@ type DagListPaginationResult struct {
 	ErrorList []string
 }
 
-type GrepResult struct {
+type SearchResult struct {
 	Name    string
 	DAG     *digraph.DAG
+	Score   float64
 	Matches []*grep.Match
 }
 
 type FlagStore interface {
 	ToggleSuspend(id string, suspend bool) error
+	// IsSuspended returns true if the DAG is suspended and the suspension
+	// has not expired.
 	IsSuspended(id string) bool
+	// Suspend suspends the DAG with the reason and the expiry.
+	Suspend(id string, suspension Suspension) error
+	// GetSuspension returns the suspension of the DAG including an expired
+	// one. It returns nil if the DAG is not suspended.
+	GetSuspension(id string) (*Suspension, error)
+}
+
+// Suspension is the reason and the expiry of the suspension of a DAG.
+type Suspension struct {
+	Reason      string    `json:"reason,omitempty"`
+	SuspendedAt time.Time `json:"suspendedAt"`
+	// ExpiresAt is the time the DAG is resumed. It's zero if the DAG is
+	// suspended until it's resumed manually.
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// Expired returns true if the suspension has expired at the time.
+func (s *Suspension) Expired(now time.Time) bool {
+	return !s.ExpiresAt.IsZero() && !now.Before(s.ExpiresAt)
+}
+
+// DelayedStartStore holds the runs registered to be started later by the
+// scheduler.
+type DelayedStartStore interface {
+	Add(start DelayedStart) error
+	// List returns the registered runs, the earliest start first.
+	List() ([]DelayedStart, error)
+	// Remove unregisters the run. It returns ErrRequestIDNotFound if the
+	// run isn't registered, so that only one of the processes removing the
+	// run starts it.
+	Remove(requestID string) error
+}
+
+// DelayedStart is a run registered to be started later.
+type DelayedStart struct {
+	// DAG is the location of the DAG file.
+	DAG            string            `json:"dag"`
+	RequestID      string            `json:"requestId"`
+	Params         string            `json:"params,omitempty"`
+	IdempotencyKey string            `json:"idempotencyKey,omitempty"`
+	Labels         map[string]string `json:"labels,omitempty"`
+	Trigger        model.Trigger     `json:"trigger,omitempty"`
+	StartAt        time.Time         `json:"startAt"`
+	RegisteredAt   time.Time         `json:"registeredAt"`
+	// ExecutionDate is the logical date of the run. It defaults to StartAt.
+	ExecutionDate time.Time `json:"executionDate"`
+	// Priority orders the due runs of the same DAG, the highest first.
+	Priority int `json:"priority,omitempty"`
+	// SkipSteps is the names of the steps to skip in the run.
+	SkipSteps []string `json:"skipSteps,omitempty"`
+	// From is the name of the step to start the run from.
+	From string `json:"from,omitempty"`
+	// Target is the name of the step to run with its dependencies only.
+	Target string `json:"target,omitempty"`
 }
@@ -0,0 +1,93 @@
+package jsondb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// extIdempotencyKey is the extension of the files reserving the idempotency
+// keys of the runs.
+const extIdempotencyKey = ".key"
+
+// ReserveIdempotencyKey reserves the idempotency key of the DAG for the run
+// with the request ID. The file of the key is created atomically, so only one
+// of the concurrent starts with the same key reserves it. It returns the
+// request ID of the run the key is reserved for, which is requestID if the
+// key was not reserved yet.
+func (db *JSONDB) ReserveIdempotencyKey(_ context.Context, key, idempotencyKey, requestID string) (string, error) {
+	if key == "" {
+		return "", errKeyEmpty
+	}
+	file := db.idempotencyKeyFile(key, idempotencyKey)
+	dir := filepath.Dir(file)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("%w: %s : %s", errCreateNewDirectory, dir, err)
+	}
+
+	// The request ID is written to a temporary file linked to the file of the
+	// key, so that the file is never read before the request ID is written.
+	tmp, err := os.CreateTemp(dir, ".reserve-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to reserve the idempotency key: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.WriteString(requestID)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to reserve the idempotency key: %w", err)
+	}
+
+	err = os.Link(tmp.Name(), file)
+	if err == nil {
+		return requestID, nil
+	}
+	if !os.IsExist(err) {
+		return "", fmt.Errorf("failed to reserve the idempotency key: %w", err)
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the idempotency key: %w", err)
+	}
+	return string(data), nil
+}
+
+// ReleaseIdempotencyKey releases the idempotency key reserved for the run
+// with the request ID, e.g. when the run failed to be registered. The key
+// reserved for another run is kept.
+func (db *JSONDB) ReleaseIdempotencyKey(_ context.Context, key, idempotencyKey, requestID string) error {
+	if key == "" {
+		return errKeyEmpty
+	}
+	file := db.idempotencyKeyFile(key, idempotencyKey)
+	data, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if string(data) != requestID {
+		return nil
+	}
+	return os.Remove(file)
+}
+
+// idempotencyKeyFile returns the file reserving the idempotency key. The
+// file is named by the hash of the key because the key is given by the
+// clients.
+func (db *JSONDB) idempotencyKeyFile(key, idempotencyKey string) string {
+	h := sha256.Sum256([]byte(idempotencyKey))
+	return db.createPrefix(key) + "." + hex.EncodeToString(h[:]) + extIdempotencyKey
+}
+
+// idempotencyKeyFiles returns the files reserving the idempotency keys of
+// the DAG.
+func (db *JSONDB) idempotencyKeyFiles(key string) ([]string, error) {
+	return filepath.Glob(db.createPrefix(key) + ".*" + extIdempotencyKey)
+}
@@ -18,8 +18,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dagu-org/dagu/internal/crypt"
 	"github.com/dagu-org/dagu/internal/fileutil"
 	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/namespace"
 	"github.com/dagu-org/dagu/internal/persistence"
 	"github.com/dagu-org/dagu/internal/persistence/filecache"
 	"github.com/dagu-org/dagu/internal/persistence/model"
@@ -53,7 +55,9 @@ var _ persistence.HistoryStore = (*JSONDB)(nil)
 
 // JSONDB manages DAGs status files in local storage.
 type JSONDB struct {
-	baseDir           string
+	baseDir string
+	// dagsDirs is the DAG directories to find the namespaces of the DAGs.
+	dagsDirs          []string
 	latestStatusToday bool
 	fileCache         *filecache.Cache[*model.Status]
 	writer            *writer
@@ -64,6 +68,7 @@ type Option func(*Options)
 type Options struct {
 	FileCache         *filecache.Cache[*model.Status]
 	LatestStatusToday bool
+	DAGsDirs          []string
 }
 
 func WithFileCache(cache *filecache.Cache[*model.Status]) Option {
@@ -72,6 +77,14 @@ func WithFileCache(cache *filecache.Cache[*model.Status]) Option {
 	}
 }
 
+// WithDAGsDirs sets the DAG directories. The history of the DAGs in a
+// namespace is stored in the directory of the namespace.
+func WithDAGsDirs(dirs ...string) Option {
+	return func(o *Options) {
+		o.DAGsDirs = dirs
+	}
+}
+
 func WithLatestStatusToday(latestStatusToday bool) Option {
 	return func(o *Options) {
 		o.LatestStatusToday = latestStatusToday
@@ -88,6 +101,7 @@ func New(baseDir string, opts ...Option) *JSONDB {
 	}
 	return &JSONDB{
 		baseDir:           baseDir,
+		dagsDirs:          options.DAGsDirs,
 		latestStatusToday: options.LatestStatusToday,
 		fileCache:         options.FileCache,
 	}
@@ -160,17 +174,43 @@ func (db *JSONDB) Close(ctx context.Context) error {
 func (db *JSONDB) ReadStatusRecent(_ context.Context, key string, itemLimit int) []model.StatusFile {
 	var ret []model.StatusFile
 
+	seen := make(map[string]bool)
 	files := db.getLatestMatches(db.globPattern(key), itemLimit)
 	for _, file := range files {
 		status, err := db.parseStatusFile(file)
 		if err != nil {
 			continue
 		}
+		seen[status.RequestID] = true
 		ret = append(ret, model.StatusFile{
 			File:   file,
 			Status: *status,
 		})
 	}
+	if len(ret) >= itemLimit {
+		return ret
+	}
+
+	// The older runs are in the summaries.
+	summaries, _ := db.summaries(key)
+	for _, summary := range summaries {
+		statuses, err := readSummary(summary)
+		if err != nil {
+			continue
+		}
+		for i := len(statuses) - 1; i >= 0; i-- {
+			if seen[statuses[i].RequestID] {
+				continue
+			}
+			ret = append(ret, model.StatusFile{
+				File:   summary,
+				Status: *statuses[i],
+			})
+			if len(ret) >= itemLimit {
+				return ret
+			}
+		}
+	}
 
 	return ret
 }
@@ -183,6 +223,23 @@ func (db *JSONDB) ReadStatusToday(_ context.Context, key string) (*model.Status,
 	return db.parseStatusFile(file)
 }
 
+func (db *JSONDB) BatchReadLatest(ctx context.Context, keys []string) []persistence.LatestStatus {
+	ret := make([]persistence.LatestStatus, len(keys))
+	read := make([]bool, len(keys))
+	if err := persistence.ReadBatch(ctx, len(keys), func(i int) {
+		status, err := db.ReadStatusToday(ctx, keys[i])
+		ret[i] = persistence.LatestStatus{Status: status, Err: err}
+		read[i] = true
+	}); err != nil {
+		for i := range ret {
+			if !read[i] {
+				ret[i].Err = err
+			}
+		}
+	}
+	return ret
+}
+
 func (db *JSONDB) FindByRequestID(_ context.Context, key string, requestID string) (*model.StatusFile, error) {
 	if requestID == "" {
 		return nil, errRequestIDNotFound
@@ -208,9 +265,33 @@ func (db *JSONDB) FindByRequestID(_ context.Context, key string, requestID strin
 		}
 	}
 
+	summaries, err := db.summaries(key)
+	if err != nil {
+		return nil, err
+	}
+	for _, summary := range summaries {
+		statuses, err := readSummary(summary)
+		if err != nil {
+			log.Printf("parsing failed %s : %s", summary, err)
+			continue
+		}
+		for _, status := range statuses {
+			if status.RequestID == requestID {
+				return &model.StatusFile{
+					File:   summary,
+					Status: *status,
+				}, nil
+			}
+		}
+	}
+
 	return nil, fmt.Errorf("%w : %s", persistence.ErrRequestIDNotFound, requestID)
 }
 
+func (db *JSONDB) ReadStatusFile(_ context.Context, file string) (*model.Status, error) {
+	return ParseStatusFile(file)
+}
+
 func (db *JSONDB) RemoveAll(ctx context.Context, key string) error {
 	return db.RemoveOld(ctx, key, 0)
 }
@@ -239,6 +320,42 @@ func (db *JSONDB) RemoveOld(_ context.Context, key string, retentionDays int) er
 		}
 	}
 
+	// The idempotency keys are kept as long as the runs they were reserved
+	// for.
+	keys, err := db.idempotencyKeyFiles(key)
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		info, err := os.Stat(k)
+		if err != nil {
+			continue
+		}
+		if info.ModTime().Before(oldDate) {
+			if err := os.Remove(k); err != nil {
+				lastErr = err
+			}
+		}
+	}
+
+	// The summaries are removed once their whole month is out of the
+	// retention.
+	summaries, err := db.summaries(key)
+	if err != nil {
+		return err
+	}
+	for _, summary := range summaries {
+		end, err := summaryMonthEnd(summary)
+		if err != nil {
+			continue
+		}
+		if retentionDays == 0 || !end.After(oldDate) {
+			if err := os.Remove(summary); err != nil {
+				lastErr = err
+			}
+		}
+	}
+
 	return lastErr
 }
 
@@ -300,6 +417,16 @@ func (db *JSONDB) Rename(_ context.Context, oldKey, newKey string) error {
 	if err != nil {
 		return err
 	}
+	summaries, err := db.summaries(oldKey)
+	if err != nil {
+		return err
+	}
+	matches = append(matches, summaries...)
+	keys, err := db.idempotencyKeyFiles(oldKey)
+	if err != nil {
+		return err
+	}
+	matches = append(matches, keys...)
 
 	oldPrefix := filepath.Base(db.createPrefix(oldKey))
 	newPrefix := filepath.Base(db.createPrefix(newKey))
@@ -327,12 +454,16 @@ func (db *JSONDB) parseStatusFile(file string) (*model.Status, error) {
 
 func (db *JSONDB) getDirectory(key string, prefix string) string {
 	if key != prefix {
+		baseDir := db.baseDir
+		if filepath.IsAbs(key) {
+			baseDir = filepath.Join(baseDir, namespace.Of(db.dagsDirs, key))
+		}
 		// Add a hash postfix to the directory name to avoid conflicts.
 		// nolint: gosec
 		h := md5.New()
 		_, _ = h.Write([]byte(key))
 		v := hex.EncodeToString(h.Sum(nil))
-		return filepath.Join(db.baseDir, fmt.Sprintf("%s-%s", prefix, v))
+		return filepath.Join(baseDir, fmt.Sprintf("%s-%s", prefix, v))
 	}
 
 	return filepath.Join(db.baseDir, key)
@@ -409,13 +540,17 @@ func ParseStatusFile(filePath string) (*model.Status, error) {
 	defer f.Close()
 
 	var (
-		offset int64
-		result *model.Status
+		offset     int64
+		result     *model.Status
+		decryptErr error
 	)
 	for {
 		line, err := readLineFrom(f, offset)
 		if err == io.EOF {
 			if result == nil {
+				if decryptErr != nil {
+					return nil, fmt.Errorf("%s: %w", filePath, decryptErr)
+				}
 				return nil, err
 			}
 			return result, nil
@@ -423,6 +558,18 @@ func ParseStatusFile(filePath string) (*model.Status, error) {
 			return nil, err
 		}
 		offset += int64(len(line)) + 1 // +1 for newline
+		line, ok := splitChecksum(line)
+		if !ok {
+			// The line is corrupt or still being written.
+			continue
+		}
+		// The encrypted lines are skipped like the broken ones if they can't
+		// be decrypted, e.g. the last one being written.
+		line, err = crypt.OpenLine(line)
+		if err != nil {
+			decryptErr = err
+			continue
+		}
 		if len(line) > 0 {
 			status, err := model.StatusFromJSON(string(line))
 			if err == nil {
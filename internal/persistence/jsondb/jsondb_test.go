@@ -1,12 +1,14 @@
 package jsondb
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/dagu-org/dagu/internal/digraph/scheduler"
 	"github.com/dagu-org/dagu/internal/persistence"
 	"github.com/dagu-org/dagu/internal/persistence/model"
@@ -187,6 +189,80 @@ func TestJSONDB_ReadStatusToday_EdgeCases(t *testing.T) {
 	})
 }
 
+func TestJSONDB_BatchReadLatest(t *testing.T) {
+	th := testSetup(t)
+
+	var keys []string
+	for i := 0; i < 20; i++ {
+		dag := th.DAG(fmt.Sprintf("test_batch_read_%d", i))
+		keys = append(keys, dag.Location)
+		if i%2 == 1 {
+			// The DAGs with an odd number have never run.
+			continue
+		}
+		requestID := fmt.Sprintf("request-id-%d", i)
+		err := th.DB.Open(th.Context, dag.Location, time.Now(), requestID)
+		require.NoError(t, err)
+		status := model.NewStatusFactory(dag.DAG).Create(
+			requestID, scheduler.StatusSuccess, testPID, time.Now(),
+		)
+		status.RequestID = requestID
+		require.NoError(t, th.DB.Write(th.Context, status))
+		require.NoError(t, th.DB.Close(th.Context))
+	}
+
+	results := th.DB.BatchReadLatest(th.Context, keys)
+	require.Len(t, results, len(keys))
+	for i, result := range results {
+		if i%2 == 1 {
+			assert.ErrorIs(t, result.Err, persistence.ErrNoStatusDataToday)
+			continue
+		}
+		require.NoError(t, result.Err)
+		assert.Equal(t, fmt.Sprintf("request-id-%d", i), result.Status.RequestID)
+	}
+
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(th.Context)
+		cancel()
+		for _, result := range th.DB.BatchReadLatest(ctx, keys) {
+			assert.ErrorIs(t, result.Err, context.Canceled)
+		}
+	})
+}
+
+func TestJSONDB_IdempotencyKey(t *testing.T) {
+	th := testSetup(t)
+	dag := th.DAG("test_idempotency_key")
+
+	reserved, err := th.DB.ReserveIdempotencyKey(th.Context, dag.Location, "key-1", "request-id-1")
+	require.NoError(t, err)
+	require.Equal(t, "request-id-1", reserved)
+
+	reserved, err = th.DB.ReserveIdempotencyKey(th.Context, dag.Location, "key-1", "request-id-2")
+	require.NoError(t, err)
+	require.Equal(t, "request-id-1", reserved)
+
+	t.Run("Rename", func(t *testing.T) {
+		renamed := th.DAG("test_idempotency_key_renamed")
+		require.NoError(t, th.DB.Rename(th.Context, dag.Location, renamed.Location))
+		t.Cleanup(func() {
+			require.NoError(t, th.DB.Rename(th.Context, renamed.Location, dag.Location))
+		})
+
+		reserved, err := th.DB.ReserveIdempotencyKey(th.Context, renamed.Location, "key-1", "request-id-2")
+		require.NoError(t, err)
+		require.Equal(t, "request-id-1", reserved)
+	})
+	t.Run("RemoveAll", func(t *testing.T) {
+		require.NoError(t, th.DB.RemoveAll(th.Context, dag.Location))
+
+		reserved, err := th.DB.ReserveIdempotencyKey(th.Context, dag.Location, "key-1", "request-id-2")
+		require.NoError(t, err)
+		require.Equal(t, "request-id-2", reserved)
+	})
+}
+
 func TestJSONDB_RemoveAll(t *testing.T) {
 	th := testSetup(t)
 
@@ -354,3 +430,25 @@ func TestJSONDB_FileManagement(t *testing.T) {
 		assert.Less(t, info.Size(), sizeBeforeCompact)
 	})
 }
+
+func TestJSONDB_Namespace(t *testing.T) {
+	th := testSetup(t)
+	dagsDir := filepath.Join(th.tmpDir, "dags")
+	db := New(filepath.Join(th.tmpDir, "data"), WithDAGsDirs(dagsDir))
+
+	dag := &digraph.DAG{Name: "etl", Location: filepath.Join(dagsDir, "team-a", "etl.yaml")}
+	requestID := "request-id-namespace"
+	require.NoError(t, db.Open(th.Context, dag.Location, time.Now(), requestID))
+	status := model.NewStatusFactory(dag).Create(requestID, scheduler.StatusSuccess, testPID, time.Now())
+	require.NoError(t, db.Write(th.Context, status))
+	require.NoError(t, db.Close(th.Context))
+
+	// The history of the DAG is in the directory of the namespace.
+	matches, err := filepath.Glob(filepath.Join(th.tmpDir, "data", "team-a", "etl-*", "*.dat"))
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+
+	found, err := db.FindByRequestID(th.Context, dag.Location, requestID)
+	require.NoError(t, err)
+	require.Equal(t, requestID, found.Status.RequestID)
+}
@@ -0,0 +1,241 @@
+package jsondb
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/crypt"
+	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/persistence/model"
+)
+
+// The old status files of a DAG are rolled up into a summary file per month
+// named "<prefix>.<YYYYMM>.summary" in the directory of the DAG, so that the
+// number of the files in the directory and the time to glob them stay
+// bounded over the years. A summary holds the records of the runs in the
+// same format as the status files; the last record of a run wins.
+const (
+	extSummary         = ".summary"
+	summaryMonthFormat = "200601"
+)
+
+// statusFilePrefix returns the path of the status file without the
+// timestamp, the request ID and the extension.
+func statusFilePrefix(file string) (string, error) {
+	base := filepath.Base(file)
+	loc := rTimestamp.FindStringIndex(base)
+	if loc == nil || loc[0] == 0 {
+		return "", fmt.Errorf("no timestamp in the file name: %s", file)
+	}
+	return filepath.Join(filepath.Dir(file), base[:loc[0]-1]), nil
+}
+
+// summaryPath returns the summary file of the month of the status file.
+func summaryPath(file string, month time.Time) (string, error) {
+	prefix, err := statusFilePrefix(file)
+	if err != nil {
+		return "", err
+	}
+	return prefix + "." + month.UTC().Format(summaryMonthFormat) + extSummary, nil
+}
+
+// summaryMonthEnd returns the end of the month of the summary file.
+func summaryMonthEnd(file string) (time.Time, error) {
+	name := strings.TrimSuffix(filepath.Base(file), extSummary)
+	month, err := time.Parse(summaryMonthFormat, name[strings.LastIndexByte(name, '.')+1:])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("no month in the file name: %s", file)
+	}
+	return month.AddDate(0, 1, 0), nil
+}
+
+// summaries returns the summary files of the DAG, the latest month first.
+func (db *JSONDB) summaries(key string) ([]string, error) {
+	matches, err := filepath.Glob(db.createPrefix(key) + ".*" + extSummary)
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(matches)))
+	return matches, nil
+}
+
+// readSummary returns the statuses of the runs in the summary file in the
+// order they were rolled up.
+func readSummary(file string) ([]*model.Status, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		ret   []*model.Status
+		index = make(map[string]int)
+	)
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 && err == nil {
+			status, parseErr := parseRecord(line[:len(line)-1])
+			switch {
+			case errors.Is(parseErr, crypt.ErrNoKey):
+				return nil, fmt.Errorf("%s: %w", file, parseErr)
+			case parseErr != nil:
+				// The corrupt records are reported by the verification.
+			case status.RequestID == "":
+				ret = append(ret, status)
+			default:
+				if i, ok := index[status.RequestID]; ok {
+					ret[i] = status
+				} else {
+					index[status.RequestID] = len(ret)
+					ret = append(ret, status)
+				}
+			}
+		}
+		if err == io.EOF {
+			return ret, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// RollUp moves the status files of the runs started before the time into
+// the monthly summary files of their DAGs. The latest status file of each
+// DAG and the files of the runs still running are kept. It returns the
+// number of the status files rolled up.
+func (db *JSONDB) RollUp(ctx context.Context, before time.Time) (int, error) {
+	dirs := make(map[string][]string)
+	err := filepath.WalkDir(db.baseDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == quarantineDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) == extDat {
+			dir := filepath.Dir(path)
+			dirs[dir] = append(dirs[dir], path)
+		}
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	var total int
+	for _, files := range dirs {
+		if ctx.Err() != nil {
+			return total, ctx.Err()
+		}
+		n, err := db.rollUpFiles(ctx, files, before)
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// rollUpFiles rolls up the status files of a directory.
+func (db *JSONDB) rollUpFiles(ctx context.Context, files []string, before time.Time) (int, error) {
+	// The files are sorted by the time so that the runs are appended to the
+	// summaries in order, and the latest file of each DAG is kept so that
+	// the latest status of the DAG is still found without the summaries.
+	files = filterLatest(files, len(files))
+	latest := make(map[string]bool)
+	groups := make(map[string][]string)
+	var order []string
+	for _, file := range files {
+		ts, err := findTimestamp(file)
+		if err != nil || ts.IsZero() {
+			continue
+		}
+		prefix, err := statusFilePrefix(file)
+		if err != nil {
+			continue
+		}
+		if !latest[prefix] {
+			latest[prefix] = true
+			continue
+		}
+		if !ts.Before(before) {
+			continue
+		}
+		summary, _ := summaryPath(file, ts)
+		if _, ok := groups[summary]; !ok {
+			order = append(order, summary)
+		}
+		groups[summary] = append(groups[summary], file)
+	}
+
+	var total int
+	for _, summary := range order {
+		group := groups[summary]
+		// The oldest run first.
+		sort.Strings(group)
+		n, err := db.appendToSummary(ctx, summary, group)
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// appendToSummary appends the final statuses of the files to the summary
+// and removes the files.
+func (db *JSONDB) appendToSummary(ctx context.Context, summary string, files []string) (int, error) {
+	w := newWriter(summary)
+	if err := w.open(); err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = w.close()
+	}()
+
+	var n int
+	for _, file := range files {
+		status, err := ParseStatusFile(file)
+		if errors.Is(err, crypt.ErrNoKey) {
+			return n, err
+		}
+		if err != nil {
+			// The corrupt files are left to the verification.
+			logger.Warn(ctx, "Skipped the status file that can't be read", "file", file, "err", err)
+			continue
+		}
+		if status.Status == scheduler.StatusRunning {
+			continue
+		}
+		if err := w.write(*status); err != nil {
+			return n, fmt.Errorf("failed to write the summary %s: %w", summary, err)
+		}
+		if err := os.Remove(file); err != nil {
+			return n, fmt.Errorf("failed to remove the rolled up file %s: %w", file, err)
+		}
+		if db.fileCache != nil {
+			db.fileCache.Invalidate(file)
+		}
+		n++
+	}
+	return n, w.close()
+}
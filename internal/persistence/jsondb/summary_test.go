@@ -0,0 +1,100 @@
+package jsondb
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollUp(t *testing.T) {
+	th := testSetup(t)
+
+	dag := th.DAG("test_rollup")
+	now := time.Now()
+	runs := []struct {
+		requestID string
+		startedAt time.Time
+		status    scheduler.Status
+	}{
+		{"request-id-1", now.AddDate(0, 0, -70), scheduler.StatusSuccess},
+		{"request-id-2", now.AddDate(0, 0, -40), scheduler.StatusError},
+		{"request-id-3", now.AddDate(0, 0, -35), scheduler.StatusRunning},
+		{"request-id-4", now.AddDate(0, 0, -1), scheduler.StatusSuccess},
+		{"request-id-5", now.AddDate(0, 0, -60), scheduler.StatusSuccess},
+	}
+	for _, run := range runs {
+		writer := dag.Writer(t, run.requestID, run.startedAt)
+		writer.Write(t, model.NewStatusFactory(dag.DAG).Create(
+			run.requestID, run.status, testPID, run.startedAt,
+		))
+		writer.Close(t)
+	}
+
+	n, err := th.DB.RollUp(th.Context, now.AddDate(0, 0, -30))
+	require.NoError(t, err)
+	// The running run and the latest run are kept.
+	require.Equal(t, 3, n)
+
+	matches, err := filepath.Glob(th.DB.globPattern(dag.Location))
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	summaries, err := th.DB.summaries(dag.Location)
+	require.NoError(t, err)
+	require.NotEmpty(t, summaries)
+
+	t.Run("ReadStatusRecent", func(t *testing.T) {
+		statuses := th.DB.ReadStatusRecent(th.Context, dag.Location, 10)
+		require.Len(t, statuses, 5)
+		require.Equal(t, "request-id-4", statuses[0].Status.RequestID)
+
+		statuses = th.DB.ReadStatusRecent(th.Context, dag.Location, 3)
+		require.Len(t, statuses, 3)
+	})
+	t.Run("FindAndUpdate", func(t *testing.T) {
+		found, err := th.DB.FindByRequestID(th.Context, dag.Location, "request-id-2")
+		require.NoError(t, err)
+		require.Equal(t, scheduler.StatusError, found.Status.Status)
+
+		found.Status.Status = scheduler.StatusSuccess
+		require.NoError(t, th.DB.Update(th.Context, dag.Location, "request-id-2", found.Status))
+
+		found, err = th.DB.FindByRequestID(th.Context, dag.Location, "request-id-2")
+		require.NoError(t, err)
+		require.Equal(t, scheduler.StatusSuccess, found.Status.Status)
+		require.Len(t, th.DB.ReadStatusRecent(th.Context, dag.Location, 10), 5)
+	})
+	t.Run("Verify", func(t *testing.T) {
+		reports, err := th.DB.Verify(th.Context, false)
+		require.NoError(t, err)
+		require.Len(t, reports, len(matches)+len(summaries))
+		for _, r := range reports {
+			require.True(t, r.OK(), r.File)
+		}
+	})
+	t.Run("Rename", func(t *testing.T) {
+		newLocation := filepath.Join(th.tmpDir, "test_rollup_renamed.yaml")
+		require.NoError(t, th.DB.Rename(th.Context, dag.Location, newLocation))
+		require.Len(t, th.DB.ReadStatusRecent(th.Context, newLocation, 10), 5)
+		dag.Location = newLocation
+	})
+	t.Run("RemoveOld", func(t *testing.T) {
+		// The summaries of the months out of the retention are removed.
+		require.NoError(t, th.DB.RemoveOld(th.Context, dag.Location, 1))
+		summaries, err := th.DB.summaries(dag.Location)
+		require.NoError(t, err)
+		for _, summary := range summaries {
+			end, err := summaryMonthEnd(summary)
+			require.NoError(t, err)
+			require.True(t, end.After(now.AddDate(0, 0, -1)))
+		}
+
+		require.NoError(t, th.DB.RemoveAll(th.Context, dag.Location))
+		summaries, err = th.DB.summaries(dag.Location)
+		require.NoError(t, err)
+		require.Empty(t, summaries)
+	})
+}
@@ -0,0 +1,224 @@
+package jsondb
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/crypt"
+	"github.com/dagu-org/dagu/internal/persistence/model"
+)
+
+// quarantineDir is the directory in the data directory where the corrupt
+// status files are moved by the repair.
+const quarantineDir = ".quarantine"
+
+// checksumSep separates the status from its checksum in a line. It never
+// appears in the JSON or the encrypted records.
+const checksumSep = '\t'
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// checksum returns the CRC-32C of the line in hex.
+func checksum(line []byte) []byte {
+	return fmt.Appendf(nil, "%08x", crc32.Checksum(line, crcTable))
+}
+
+// appendChecksum returns the line followed by its checksum.
+func appendChecksum(line []byte) []byte {
+	ret := make([]byte, 0, len(line)+9)
+	ret = append(ret, line...)
+	ret = append(ret, checksumSep)
+	return append(ret, checksum(line)...)
+}
+
+// splitChecksum returns the line without its checksum and whether the
+// checksum matches. The lines written before the checksums were added have
+// none and are returned as they are.
+func splitChecksum(line []byte) ([]byte, bool) {
+	i := bytes.LastIndexByte(line, checksumSep)
+	if i < 0 {
+		return line, true
+	}
+	payload := line[:i]
+	return payload, bytes.Equal(line[i+1:], checksum(payload))
+}
+
+// FileReport is the result of the verification of a status file.
+type FileReport struct {
+	File string
+	// Records is the number of the valid records.
+	Records int
+	// Corrupt is the line numbers of the records that are corrupt.
+	Corrupt []int
+	// Truncated is whether the last record was cut off, e.g. by a crash.
+	Truncated bool
+	// Quarantined is where the original file was moved by the repair.
+	Quarantined string
+	// Salvaged is whether the repair kept the valid records in the file:
+	// the last one for a status file and all of them for a summary. The file
+	// is removed if it has no valid record.
+	Salvaged bool
+}
+
+// OK returns whether the file has no problem.
+func (r FileReport) OK() bool {
+	return len(r.Corrupt) == 0 && !r.Truncated
+}
+
+// VerifyFile checks the checksums and the contents of the records of the
+// status file. It returns the last valid status, or nil if there is none.
+func VerifyFile(file string) (FileReport, *model.Status, error) {
+	report, statuses, err := verifyRecords(file)
+	if err != nil || len(statuses) == 0 {
+		return report, nil, err
+	}
+	return report, statuses[len(statuses)-1], nil
+}
+
+// verifyRecords checks the records of the status or summary file and
+// returns the valid ones.
+func verifyRecords(file string) (FileReport, []*model.Status, error) {
+	report := FileReport{File: file}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return report, nil, err
+	}
+
+	var valid []*model.Status
+	lines := bytes.Split(data, []byte("\n"))
+	for i, line := range lines {
+		if len(line) == 0 {
+			continue
+		}
+		status, err := parseRecord(line)
+		switch {
+		case errors.Is(err, crypt.ErrNoKey):
+			return report, nil, fmt.Errorf("%s: %w", file, err)
+		case err == nil:
+			report.Records++
+			valid = append(valid, status)
+		case i == len(lines)-1:
+			// The file doesn't end with a newline.
+			report.Truncated = true
+		default:
+			report.Corrupt = append(report.Corrupt, i+1)
+		}
+	}
+	return report, valid, nil
+}
+
+// parseRecord returns the status of the line of a status file.
+func parseRecord(line []byte) (*model.Status, error) {
+	payload, ok := splitChecksum(line)
+	if !ok {
+		return nil, errors.New("checksum mismatch")
+	}
+	payload, err := crypt.OpenLine(payload)
+	if err != nil {
+		return nil, err
+	}
+	return model.StatusFromJSON(string(payload))
+}
+
+// Verify checks all the status and summary files in the data directory.
+// With repair, the corrupt files are moved to the quarantine directory and
+// replaced by their valid records. It returns the reports of all the files checked.
+func (db *JSONDB) Verify(ctx context.Context, repair bool) ([]FileReport, error) {
+	quarantine := filepath.Join(db.baseDir, quarantineDir, time.Now().Format("20060102.150405"))
+	var reports []FileReport
+	err := filepath.WalkDir(db.baseDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if d.IsDir() {
+			if d.Name() == quarantineDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		ext := filepath.Ext(path)
+		if ext != extDat && ext != extSummary {
+			return nil
+		}
+		report, statuses, err := verifyRecords(path)
+		if err != nil {
+			return err
+		}
+		if repair && !report.OK() {
+			if ext == extDat && len(statuses) > 0 {
+				// Only the last status of a run matters.
+				statuses = statuses[len(statuses)-1:]
+			}
+			if err := db.repair(&report, statuses, quarantine); err != nil {
+				return err
+			}
+		}
+		reports = append(reports, report)
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return reports, err
+}
+
+// repair moves the file to the quarantine directory and writes the valid
+// statuses back to it.
+func (db *JSONDB) repair(report *FileReport, statuses []*model.Status, quarantine string) error {
+	rel, err := filepath.Rel(db.baseDir, report.File)
+	if err != nil {
+		return err
+	}
+	target := filepath.Join(quarantine, rel)
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	tempFile := report.File + ".repair"
+	if len(statuses) > 0 {
+		w := newWriter(tempFile)
+		if err := w.open(); err != nil {
+			return err
+		}
+		var err error
+		for _, status := range statuses {
+			if err = w.write(*status); err != nil {
+				break
+			}
+		}
+		if closeErr := w.close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			_ = os.Remove(tempFile)
+			return fmt.Errorf("failed to salvage %s: %w", report.File, err)
+		}
+	}
+
+	if err := os.Rename(report.File, target); err != nil {
+		_ = os.Remove(tempFile)
+		return fmt.Errorf("failed to quarantine %s: %w", report.File, err)
+	}
+	report.Quarantined = target
+	if len(statuses) == 0 {
+		return nil
+	}
+	if err := os.Rename(tempFile, report.File); err != nil {
+		return fmt.Errorf("failed to salvage %s: %w", report.File, err)
+	}
+	report.Salvaged = true
+	if db.fileCache != nil {
+		db.fileCache.Invalidate(report.File)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package jsondb
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	th := testSetup(t)
+
+	dag := th.DAG("test_verify")
+	requestID := "request-id-test-verify"
+	writer := dag.Writer(t, requestID, time.Now())
+	status := model.NewStatusFactory(dag.DAG).Create(
+		requestID, scheduler.StatusRunning, testPID, time.Now(),
+	)
+	writer.Write(t, status)
+	status.Status = scheduler.StatusSuccess
+	writer.Write(t, status)
+
+	t.Run("Valid", func(t *testing.T) {
+		reports, err := th.DB.Verify(th.Context, false)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		require.True(t, reports[0].OK())
+		require.Equal(t, 2, reports[0].Records)
+	})
+	t.Run("Corrupt", func(t *testing.T) {
+		data, err := os.ReadFile(writer.FilePath)
+		require.NoError(t, err)
+		// A flipped byte in the first record and a record cut off by a
+		// crash after the second one.
+		data[10] ^= 0x01
+		data = append(data, data[:20]...)
+		require.NoError(t, os.WriteFile(writer.FilePath, data, 0600))
+
+		report, _, err := VerifyFile(writer.FilePath)
+		require.NoError(t, err)
+		require.Equal(t, []int{1}, report.Corrupt)
+		require.True(t, report.Truncated)
+		require.Equal(t, 1, report.Records)
+
+		// The last valid record is still read.
+		writer.AssertContent(t, "test_verify", requestID, scheduler.StatusSuccess)
+	})
+	t.Run("Repair", func(t *testing.T) {
+		reports, err := th.DB.Verify(th.Context, true)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		require.True(t, reports[0].Salvaged)
+		require.FileExists(t, reports[0].Quarantined)
+
+		report, _, err := VerifyFile(writer.FilePath)
+		require.NoError(t, err)
+		require.True(t, report.OK())
+		require.Equal(t, 1, report.Records)
+		writer.AssertContent(t, "test_verify", requestID, scheduler.StatusSuccess)
+
+		// The quarantined files are not verified again.
+		reports, err = th.DB.Verify(th.Context, false)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+	})
+	t.Run("Legacy", func(t *testing.T) {
+		// The records written without the checksums are valid.
+		legacy, err := json.Marshal(status)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(writer.FilePath, append(legacy, '\n'), 0600))
+
+		report, _, err := VerifyFile(writer.FilePath)
+		require.NoError(t, err)
+		require.True(t, report.OK())
+		require.Equal(t, 1, report.Records)
+	})
+}
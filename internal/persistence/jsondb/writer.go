@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"sync"
 
+	"github.com/dagu-org/dagu/internal/crypt"
 	"github.com/dagu-org/dagu/internal/fileutil"
 	"github.com/dagu-org/dagu/internal/persistence/model"
 )
@@ -71,7 +72,7 @@ func (w *writer) write(st model.Status) error {
 		return err
 	}
 
-	if _, err := w.writer.Write(jsonb); err != nil {
+	if _, err := w.writer.Write(appendChecksum(crypt.SealLine(jsonb))); err != nil {
 		return err
 	}
 
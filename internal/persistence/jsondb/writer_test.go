@@ -1,12 +1,15 @@
 package jsondb
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/dagu-org/dagu/internal/crypt"
 	"github.com/dagu-org/dagu/internal/digraph/scheduler"
 	"github.com/dagu-org/dagu/internal/persistence/model"
 	"github.com/stretchr/testify/assert"
@@ -53,6 +56,33 @@ func TestWriter(t *testing.T) {
 		// Verify appended data
 		writer.AssertContent(t, "test_append_to_existing", requestID, scheduler.StatusSuccess)
 	})
+
+	t.Run("Encrypted", func(t *testing.T) {
+		c, err := crypt.New(bytes.Repeat([]byte{1}, crypt.KeySize))
+		require.NoError(t, err)
+		crypt.SetDefault(c)
+		defer crypt.SetDefault(nil)
+
+		dag := th.DAG("test_write_encrypted")
+		requestID := "request-id-test-write-encrypted"
+		writer := dag.Writer(t, requestID, time.Now())
+		status := model.NewStatusFactory(dag.DAG).Create(
+			requestID, scheduler.StatusSuccess, testPID, time.Now(),
+		)
+		writer.Write(t, status)
+		writer.Close(t)
+
+		data, err := os.ReadFile(writer.FilePath)
+		require.NoError(t, err)
+		require.True(t, crypt.IsEncrypted(data))
+		require.NotContains(t, string(data), requestID)
+		writer.AssertContent(t, "test_write_encrypted", requestID, scheduler.StatusSuccess)
+
+		// The file can't be read without the key.
+		crypt.SetDefault(nil)
+		_, err = ParseStatusFile(writer.FilePath)
+		require.ErrorIs(t, err, crypt.ErrNoKey)
+	})
 }
 
 func TestWriterErrorHandling(t *testing.T) {
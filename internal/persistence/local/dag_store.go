@@ -4,18 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io/fs"
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/dagu-org/dagu/internal/fileutil"
 	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/namespace"
 	"github.com/dagu-org/dagu/internal/persistence"
 	"github.com/dagu-org/dagu/internal/persistence/filecache"
-	"github.com/dagu-org/dagu/internal/persistence/grep"
+	"github.com/dagu-org/dagu/internal/persistence/search"
 )
 
 var _ persistence.DAGStore = (*dagStoreImpl)(nil)
@@ -24,6 +26,9 @@ type DAGStoreOption func(*DAGStoreOptions)
 
 type DAGStoreOptions struct {
 	FileCache *filecache.Cache[*digraph.DAG]
+	// SearchPaths is the directories searched for the DAGs after the base
+	// directory.
+	SearchPaths []string
 }
 
 func WithFileCache(cache *filecache.Cache[*digraph.DAG]) DAGStoreOption {
@@ -32,9 +37,24 @@ func WithFileCache(cache *filecache.Cache[*digraph.DAG]) DAGStoreOption {
 	}
 }
 
+// WithSearchPaths sets the additional directories of the DAGs.
+func WithSearchPaths(dirs ...string) DAGStoreOption {
+	return func(o *DAGStoreOptions) {
+		o.SearchPaths = dirs
+	}
+}
+
+// ErrAmbiguousDAG is returned when the DAGs with the same name are in more
+// than one directory.
+var ErrAmbiguousDAG = errors.New("the DAG name is ambiguous")
+
 type dagStoreImpl struct {
-	baseDir   string
-	fileCache *filecache.Cache[*digraph.DAG]
+	// baseDir is the directory of the new DAGs.
+	baseDir string
+	// dirs is the base directory followed by the search paths.
+	dirs        []string
+	fileCache   *filecache.Cache[*digraph.DAG]
+	searchIndex *search.Index
 }
 
 func NewDAGStore(dir string, opts ...DAGStoreOption) persistence.DAGStore {
@@ -43,23 +63,52 @@ func NewDAGStore(dir string, opts ...DAGStoreOption) persistence.DAGStore {
 		opt(options)
 	}
 
+	dirs := uniqueDirs(append([]string{dir}, options.SearchPaths...))
 	return &dagStoreImpl{
-		baseDir:   dir,
-		fileCache: options.FileCache,
+		baseDir:     dir,
+		dirs:        dirs,
+		fileCache:   options.FileCache,
+		searchIndex: search.New(dirs...),
 	}
 }
 
+// uniqueDirs removes the directories listed more than once.
+func uniqueDirs(dirs []string) []string {
+	var ret []string
+	seen := make(map[string]bool)
+	for _, dir := range dirs {
+		key := filepath.Clean(dir)
+		if abs, err := filepath.Abs(dir); err == nil {
+			key = abs
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		ret = append(ret, dir)
+	}
+	return ret
+}
+
 // GetMetadata retrieves the metadata of a DAG by its name.
 func (d *dagStoreImpl) GetMetadata(ctx context.Context, name string) (*digraph.DAG, error) {
 	filePath, err := d.locateDAG(name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to locate DAG %s: %w", name, err)
 	}
+	return d.loadMetadata(ctx, filePath)
+}
+
+// loadMetadata loads the metadata of the DAG file.
+func (d *dagStoreImpl) loadMetadata(ctx context.Context, filePath string) (*digraph.DAG, error) {
+	opts := []digraph.LoadOption{
+		digraph.OnlyMetadata(), digraph.WithoutEval(), digraph.WithNamespace(namespace.Of(d.dirs, filePath)),
+	}
 	if d.fileCache == nil {
-		return digraph.Load(ctx, filePath, digraph.OnlyMetadata(), digraph.WithoutEval())
+		return digraph.Load(ctx, filePath, opts...)
 	}
 	return d.fileCache.LoadLatest(filePath, func() (*digraph.DAG, error) {
-		return digraph.Load(ctx, filePath, digraph.OnlyMetadata(), digraph.WithoutEval())
+		return digraph.Load(ctx, filePath, opts...)
 	})
 }
 
@@ -69,7 +118,7 @@ func (d *dagStoreImpl) GetDetails(ctx context.Context, name string) (*digraph.DA
 	if err != nil {
 		return nil, fmt.Errorf("failed to locate DAG %s: %w", name, err)
 	}
-	dat, err := digraph.Load(ctx, filePath, digraph.WithoutEval())
+	dat, err := digraph.Load(ctx, filePath, digraph.WithoutEval(), digraph.WithNamespace(namespace.Of(d.dirs, filePath)))
 	if err != nil {
 		return nil, fmt.Errorf("failed to load DAG %s: %w", name, err)
 	}
@@ -123,6 +172,12 @@ func (d *dagStoreImpl) Create(_ context.Context, name string, spec []byte) (stri
 	if fileExists(filePath) {
 		return "", fmt.Errorf("%w: %s", errDAGFileAlreadyExists, filePath)
 	}
+	if found := findInDirs(d.dirs, name); len(found) > 0 {
+		return "", fmt.Errorf("%w: %s", errDAGFileAlreadyExists, found[0])
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return "", fmt.Errorf("failed to create the directory of DAG %s: %w", name, err)
+	}
 	if err := os.WriteFile(filePath, spec, defaultPerm); err != nil {
 		return "", fmt.Errorf("failed to write DAG %s: %w", name, err)
 	}
@@ -165,53 +220,61 @@ func (d *dagStoreImpl) ListPagination(ctx context.Context, params persistence.DA
 		count   int
 	)
 
-	if err := filepath.WalkDir(d.baseDir, func(_ string, entry fs.DirEntry, err error) error {
-		if err != nil {
-			return err
-		}
+	files, errList, err := d.listDAGFiles()
+	if err != nil {
+		return &persistence.DagListPaginationResult{
+			ErrorList: append(errList, err.Error()),
+		}, err
+	}
 
-		if entry.IsDir() || !fileutil.IsYAMLFile(entry.Name()) {
-			return nil
+	var targets []DAGFile
+	for _, file := range files {
+		if params.Namespaces != nil {
+			if ns, _ := namespace.Split(file.Name); !slices.Contains(params.Namespaces, ns) {
+				continue
+			}
 		}
-
-		baseName := path.Base(entry.Name())
-		dagName := strings.TrimSuffix(baseName, path.Ext(baseName))
 		if params.Name != "" && params.Tag == "" {
 			// If tag is not provided, check before reading the file to avoid
 			// unnecessary file read and parsing.
-			if !containsSearchText(dagName, params.Name) {
-				// Return early if the name does not match the search text.
-				return nil
+			if !containsSearchText(file.Name, params.Name) {
+				// Skip early if the name does not match the search text.
+				continue
 			}
 		}
+		targets = append(targets, file)
+	}
 
-		// Read the file and parse the DAG.
-		parsedDAG, err := d.GetMetadata(ctx, dagName)
-		if err != nil {
-			errList = append(errList, fmt.Sprintf("reading %s failed: %s", dagName, err))
-			return nil
+	// Read the files and parse the DAGs.
+	results, err := d.loadMetadataBatch(ctx, targets)
+	if err != nil {
+		return &persistence.DagListPaginationResult{
+			ErrorList: append(errList, err.Error()),
+		}, err
+	}
+	for i, result := range results {
+		file, parsedDAG := targets[i], result.dag
+		if result.err != nil {
+			errList = append(errList, fmt.Sprintf("reading %s failed: %s", file.Name, result.err))
+			continue
 		}
 
-		if params.Name != "" && !containsSearchText(dagName, params.Name) {
-			return nil
+		if params.Name != "" && !containsSearchText(file.Name, params.Name) {
+			continue
 		}
 
 		if params.Tag != "" && !containsTag(parsedDAG.Tags, params.Tag) {
-			return nil
+			continue
+		}
+
+		if params.Filter != nil && !params.Filter(parsedDAG) {
+			continue
 		}
 
 		count++
 		if count > (params.Page-1)*params.Limit && len(dagList) < params.Limit {
 			dagList = append(dagList, parsedDAG)
 		}
-
-		return nil
-	}); err != nil {
-		return &persistence.DagListPaginationResult{
-			DagList:   dagList,
-			Count:     count,
-			ErrorList: append(errList, err.Error()),
-		}, err
 	}
 
 	return &persistence.DagListPaginationResult{
@@ -227,29 +290,105 @@ func (d *dagStoreImpl) List(ctx context.Context) (ret []*digraph.DAG, errs []str
 		errs = append(errs, err.Error())
 		return
 	}
-	entries, err := os.ReadDir(d.baseDir)
+	files, errs, err := d.listDAGFiles()
 	if err != nil {
 		errs = append(errs, err.Error())
 		return
 	}
-	for _, entry := range entries {
-		if fileutil.IsYAMLFile(entry.Name()) {
-			dat, err := d.GetMetadata(ctx, entry.Name())
-			if err == nil {
-				ret = append(ret, dat)
-			} else {
-				errs = append(errs, fmt.Sprintf(
-					"reading %s failed: %s", entry.Name(), err),
-				)
-			}
+	results, err := d.loadMetadataBatch(ctx, files)
+	if err != nil {
+		errs = append(errs, err.Error())
+		return nil, errs, err
+	}
+	for i, result := range results {
+		if result.err == nil {
+			ret = append(ret, result.dag)
+		} else {
+			errs = append(errs, fmt.Sprintf(
+				"reading %s failed: %s", filepath.Base(files[i].Path), result.err),
+			)
 		}
 	}
 	return ret, errs, nil
 }
 
-// Grep searches for a pattern in all DAGs.
-func (d *dagStoreImpl) Grep(ctx context.Context, pattern string) (
-	ret []*persistence.GrepResult, errs []string, err error,
+// loadResult is the result of loading the metadata of a DAG file.
+type loadResult struct {
+	dag *digraph.DAG
+	err error
+}
+
+// loadMetadataBatch loads the metadata of the DAG files in parallel. The
+// results are in the order of the files. It returns the error of the
+// context if it's done before all of them are loaded.
+func (d *dagStoreImpl) loadMetadataBatch(ctx context.Context, files []DAGFile) ([]loadResult, error) {
+	ret := make([]loadResult, len(files))
+	if err := persistence.ReadBatch(ctx, len(files), func(i int) {
+		dag, err := d.loadMetadata(ctx, files[i].Path)
+		ret[i] = loadResult{dag: dag, err: err}
+	}); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// DAGFile is a DAG file in one of the directories.
+type DAGFile struct {
+	// Name is the name of the DAG with its namespace.
+	Name string
+	Path string
+}
+
+// listDAGFiles returns the DAG files in the directories of the store.
+func (d *dagStoreImpl) listDAGFiles() ([]DAGFile, []string, error) {
+	return ListDAGFiles(d.dirs)
+}
+
+// ListDAGFiles returns the DAG files in the directories and their
+// namespaces sorted by name. The names found in more than one directory are
+// left out and reported in errs because they can't be told apart.
+func ListDAGFiles(dirs []string) (files []DAGFile, errs []string, err error) {
+	paths := make(map[string][]string)
+	for _, dir := range uniqueDirs(dirs) {
+		namespaces, err := namespace.List(dir)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, ns := range append([]string{""}, namespaces...) {
+			entries, err := os.ReadDir(filepath.Join(dir, ns))
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, entry := range entries {
+				if entry.IsDir() || !namespace.IsDAGFile(entry.Name()) {
+					continue
+				}
+				name := namespace.Join(ns, strings.TrimSuffix(entry.Name(), path.Ext(entry.Name())))
+				paths[name] = append(paths[name], filepath.Join(dir, ns, entry.Name()))
+			}
+		}
+	}
+
+	names := make([]string, 0, len(paths))
+	for name := range paths {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if len(paths[name]) > 1 {
+			errs = append(errs, fmt.Sprintf("%s: %s is found in %s",
+				ErrAmbiguousDAG, name, strings.Join(paths[name], ", ")))
+			continue
+		}
+		files = append(files, DAGFile{Name: name, Path: paths[name][0]})
+	}
+	return files, errs, nil
+}
+
+// Search returns the DAGs matching the query ranked by the matches in
+// the name, description, tags and step commands.
+func (d *dagStoreImpl) Search(ctx context.Context, query string) (
+	ret []*persistence.SearchResult, errs []string, err error,
 ) {
 	if err = d.ensureDirExist(); err != nil {
 		errs = append(
@@ -258,35 +397,18 @@ func (d *dagStoreImpl) Grep(ctx context.Context, pattern string) (
 		return
 	}
 
-	entries, err := os.ReadDir(d.baseDir)
+	results, errs, err := d.searchIndex.Search(ctx, query)
 	if err != nil {
-		logger.Error(ctx, "Failed to read directory", "dir", d.baseDir, "err", err)
+		logger.Error(ctx, "Failed to search DAGs", "dir", d.baseDir, "err", err)
+		return nil, errs, err
 	}
-
-	for _, entry := range entries {
-		if fileutil.IsYAMLFile(entry.Name()) {
-			filePath := filepath.Join(d.baseDir, entry.Name())
-			dat, err := os.ReadFile(filePath)
-			if err != nil {
-				logger.Error(ctx, "Failed to read DAG file", "file", entry.Name(), "err", err)
-				continue
-			}
-			matches, err := grep.Grep(dat, fmt.Sprintf("(?i)%s", pattern), grep.DefaultOptions)
-			if err != nil {
-				errs = append(errs, fmt.Sprintf("grep %s failed: %s", entry.Name(), err))
-				continue
-			}
-			dag, err := digraph.Load(ctx, filePath, digraph.OnlyMetadata(), digraph.WithoutEval())
-			if err != nil {
-				errs = append(errs, fmt.Sprintf("check %s failed: %s", entry.Name(), err))
-				continue
-			}
-			ret = append(ret, &persistence.GrepResult{
-				Name:    strings.TrimSuffix(entry.Name(), path.Ext(entry.Name())),
-				DAG:     dag,
-				Matches: matches,
-			})
-		}
+	for _, r := range results {
+		ret = append(ret, &persistence.SearchResult{
+			Name:    r.Name,
+			DAG:     r.DAG,
+			Score:   r.Score,
+			Matches: r.Matches,
+		})
 	}
 	return ret, errs, nil
 }
@@ -297,15 +419,32 @@ func (d *dagStoreImpl) Rename(_ context.Context, oldID, newID string) error {
 	if err != nil {
 		return fmt.Errorf("failed to locate DAG %s: %w", oldID, err)
 	}
+	// Keep the DAG in the DAG directory it's in.
 	newFilePath := d.generateFilePath(newID)
+	if ns, name := namespace.Split(newID); ns != "" || !strings.Contains(newID, string(filepath.Separator)) {
+		dir := filepath.Dir(oldFilePath)
+		if namespace.Of(d.dirs, oldFilePath) != "" {
+			dir = filepath.Dir(dir)
+		}
+		newFilePath = fileutil.EnsureYAMLExtension(filepath.Join(dir, ns, name))
+	}
 	if fileExists(newFilePath) {
 		return fmt.Errorf("%w: %s", errDAGFileAlreadyExists, newFilePath)
 	}
+	if found := findInDirs(d.dirs, newID); len(found) > 0 {
+		return fmt.Errorf("%w: %s", errDAGFileAlreadyExists, found[0])
+	}
+	if err := os.MkdirAll(filepath.Dir(newFilePath), 0755); err != nil {
+		return err
+	}
 	return os.Rename(oldFilePath, newFilePath)
 }
 
 // generateFilePath generates the file path for a DAG by its name.
 func (d *dagStoreImpl) generateFilePath(name string) string {
+	if ns, name := namespace.Split(name); ns != "" {
+		return filepath.Clean(fileutil.EnsureYAMLExtension(filepath.Join(d.baseDir, ns, name)))
+	}
 	if strings.Contains(name, string(filepath.Separator)) {
 		filePath, err := filepath.Abs(name)
 		if err == nil {
@@ -318,6 +457,13 @@ func (d *dagStoreImpl) generateFilePath(name string) string {
 
 // locateDAG locates the DAG file by its name or path.
 func (d *dagStoreImpl) locateDAG(nameOrPath string) (string, error) {
+	return LocateDAG(d.dirs, nameOrPath)
+}
+
+// LocateDAG locates the DAG file by its name or path. The name is looked up
+// in the current directory and then in the directories. It's an error if
+// the name is found in more than one directory.
+func LocateDAG(dirs []string, nameOrPath string) (string, error) {
 	if strings.Contains(nameOrPath, string(filepath.Separator)) {
 		foundPath, err := findDAGFile(nameOrPath)
 		if err == nil {
@@ -325,17 +471,31 @@ func (d *dagStoreImpl) locateDAG(nameOrPath string) (string, error) {
 		}
 	}
 
-	searchPaths := []string{".", d.baseDir}
-	for _, dir := range searchPaths {
-		candidatePath := filepath.Join(dir, nameOrPath)
-		foundPath, err := findDAGFile(candidatePath)
-		if err == nil {
-			return foundPath, nil
-		}
+	if foundPath, err := findDAGFile(filepath.Join(".", nameOrPath)); err == nil {
+		return foundPath, nil
 	}
 
-	// DAG not found
-	return "", fmt.Errorf("workflow %s not found: %w", nameOrPath, os.ErrNotExist)
+	found := findInDirs(dirs, nameOrPath)
+	switch len(found) {
+	case 0:
+		// DAG not found
+		return "", fmt.Errorf("%w: %s: %w", persistence.ErrDAGNotFound, nameOrPath, os.ErrNotExist)
+	case 1:
+		return found[0], nil
+	default:
+		return "", fmt.Errorf("%w: %s is found in %s", ErrAmbiguousDAG, nameOrPath, strings.Join(found, ", "))
+	}
+}
+
+// findInDirs returns the DAG files with the name in the directories.
+func findInDirs(dirs []string, name string) []string {
+	var found []string
+	for _, dir := range uniqueDirs(dirs) {
+		if foundPath, err := findDAGFile(filepath.Join(dir, name)); err == nil {
+			found = append(found, foundPath)
+		}
+	}
+	return found
 }
 
 // findDAGFile finds the sub workflow file with the given name.
@@ -364,27 +524,33 @@ func (d *dagStoreImpl) TagList(ctx context.Context) ([]string, []string, error)
 		tagSet  = make(map[string]struct{})
 	)
 
-	if err := filepath.WalkDir(d.baseDir, func(_ string, entry fs.DirEntry, err error) error {
-		if err != nil {
-			return err
-		}
+	files, errList, err := d.listDAGFiles()
+	if err != nil {
+		return nil, append(errList, err.Error()), err
+	}
 
-		if entry.IsDir() || !fileutil.IsYAMLFile(entry.Name()) {
-			return nil
+	var targets []DAGFile
+	for _, file := range files {
+		// Only the tags of the namespaces the user can access are listed.
+		if ns, _ := namespace.Split(file.Name); !namespace.Allowed(ctx, ns) {
+			continue
 		}
+		targets = append(targets, file)
+	}
 
-		parsedDAG, err := d.GetMetadata(ctx, entry.Name())
-		if err != nil {
-			errList = append(errList, fmt.Sprintf("reading %s failed: %s", entry.Name(), err))
+	results, err := d.loadMetadataBatch(ctx, targets)
+	if err != nil {
+		return nil, append(errList, err.Error()), err
+	}
+	for i, result := range results {
+		if result.err != nil {
+			errList = append(errList, fmt.Sprintf("reading %s failed: %s", filepath.Base(targets[i].Path), result.err))
+			continue
 		}
 
-		for _, tag := range parsedDAG.Tags {
+		for _, tag := range result.dag.Tags {
 			tagSet[tag] = struct{}{}
 		}
-
-		return nil
-	}); err != nil {
-		return nil, append(errList, err.Error()), err
 	}
 
 	tagList := make([]string, 0, len(tagSet))
@@ -0,0 +1,154 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/persistence"
+	"github.com/dagu-org/dagu/internal/persistence/filecache"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDAGStore_SearchPaths(t *testing.T) {
+	dagsDir := t.TempDir()
+	teamDir := t.TempDir()
+	writeDAG := func(dir, name string) string {
+		t.Helper()
+		file := filepath.Join(dir, name+".yaml")
+		require.NoError(t, os.WriteFile(file, []byte("steps:\n  - name: step\n    command: \"true\"\n"), 0600))
+		return file
+	}
+	writeDAG(dagsDir, "backup")
+	teamFile := writeDAG(teamDir, "report")
+	writeDAG(dagsDir, "cleanup")
+	writeDAG(teamDir, "cleanup")
+
+	ctx := context.Background()
+	store := NewDAGStore(dagsDir, WithSearchPaths(teamDir, dagsDir))
+
+	t.Run("Locate", func(t *testing.T) {
+		dag, err := store.GetMetadata(ctx, "report")
+		require.NoError(t, err)
+		require.Equal(t, teamFile, dag.Location)
+
+		file, err := LocateDAG([]string{dagsDir, teamDir}, "report")
+		require.NoError(t, err)
+		require.Equal(t, teamFile, file)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := store.GetMetadata(ctx, "missing")
+		require.ErrorIs(t, err, persistence.ErrDAGNotFound)
+	})
+	t.Run("Ambiguous", func(t *testing.T) {
+		_, err := store.GetMetadata(ctx, "cleanup")
+		require.ErrorIs(t, err, ErrAmbiguousDAG)
+	})
+	t.Run("List", func(t *testing.T) {
+		dags, errs, err := store.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, dags, 2)
+		require.Len(t, errs, 1)
+		require.Contains(t, errs[0], "cleanup")
+
+		ret, err := store.ListPagination(ctx, persistence.DAGListPaginationArgs{Page: 1, Limit: 10})
+		require.NoError(t, err)
+		require.Equal(t, 2, ret.Count)
+		require.Equal(t, "backup", ret.DagList[0].Name)
+		require.Equal(t, "report", ret.DagList[1].Name)
+	})
+	t.Run("CreateAndRename", func(t *testing.T) {
+		// The names in the search paths are taken.
+		_, err := store.Create(ctx, "report", []byte("steps: []"))
+		require.ErrorIs(t, err, errDAGFileAlreadyExists)
+		require.ErrorIs(t, store.Rename(ctx, "backup", "report"), errDAGFileAlreadyExists)
+
+		// The renamed DAG stays in its directory.
+		require.NoError(t, store.Rename(ctx, "report", "monthly_report"))
+		require.FileExists(t, filepath.Join(teamDir, "monthly_report.yaml"))
+	})
+}
+
+func TestDAGStore_Namespace(t *testing.T) {
+	dagsDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dagsDir, "team-a"), 0755))
+	spec := []byte("tags: [daily]\nsteps:\n  - name: step\n    command: \"true\"\n")
+	require.NoError(t, os.WriteFile(filepath.Join(dagsDir, "etl.yaml"), spec, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dagsDir, "team-a", "etl.yaml"), spec, 0600))
+
+	ctx := context.Background()
+	store := NewDAGStore(dagsDir)
+
+	t.Run("GetMetadata", func(t *testing.T) {
+		dag, err := store.GetMetadata(ctx, "team-a/etl")
+		require.NoError(t, err)
+		require.Equal(t, "team-a", dag.Namespace)
+		require.Equal(t, "team-a/etl", dag.ID())
+	})
+	t.Run("ListPagination", func(t *testing.T) {
+		ret, err := store.ListPagination(ctx, persistence.DAGListPaginationArgs{Page: 1, Limit: 10})
+		require.NoError(t, err)
+		require.Equal(t, 2, ret.Count)
+
+		ret, err = store.ListPagination(ctx, persistence.DAGListPaginationArgs{Page: 1, Limit: 10, Namespaces: []string{"team-a"}})
+		require.NoError(t, err)
+		require.Equal(t, 1, ret.Count)
+		require.Equal(t, "team-a/etl", ret.DagList[0].ID())
+	})
+	t.Run("CreateAndRename", func(t *testing.T) {
+		id, err := store.Create(ctx, "team-b/report", []byte("steps: []"))
+		require.NoError(t, err)
+		require.Equal(t, "team-b/report", id)
+		require.FileExists(t, filepath.Join(dagsDir, "team-b", "report.yaml"))
+
+		require.NoError(t, store.Rename(ctx, "team-b/report", "team-a/report"))
+		require.FileExists(t, filepath.Join(dagsDir, "team-a", "report.yaml"))
+	})
+}
+
+func TestDAGStore_ListParallel(t *testing.T) {
+	dagsDir := t.TempDir()
+	for i := range 50 {
+		spec := fmt.Sprintf("tags: tag%d\nsteps:\n  - name: step\n    command: \"true\"\n", i%3)
+		if i == 25 {
+			spec = "steps: [invalid"
+		}
+		file := filepath.Join(dagsDir, fmt.Sprintf("dag%02d.yaml", i))
+		require.NoError(t, os.WriteFile(file, []byte(spec), 0600))
+	}
+
+	ctx := context.Background()
+	cache := filecache.New[*digraph.DAG](0, time.Hour)
+	store := NewDAGStore(dagsDir, WithFileCache(cache))
+
+	dags, errs, err := store.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, dags, 49)
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0], "dag25.yaml")
+	// The DAGs are in the order of the files.
+	require.Equal(t, "dag00", dags[0].Name)
+	require.Equal(t, "dag26", dags[25].Name)
+	require.Equal(t, "dag49", dags[48].Name)
+
+	// The parse results are reused while the files are unchanged.
+	cached, ok := cache.Load(dags[0].Location)
+	require.True(t, ok)
+	again, _, err := store.List(ctx)
+	require.NoError(t, err)
+	require.Same(t, cached, again[0])
+
+	ret, err := store.ListPagination(ctx, persistence.DAGListPaginationArgs{Page: 2, Limit: 5, Tag: "tag1"})
+	require.NoError(t, err)
+	require.Equal(t, 16, ret.Count)
+	require.Len(t, ret.DagList, 5)
+	require.Equal(t, "dag16", ret.DagList[0].Name)
+
+	tags, _, err := store.TagList(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"tag0", "tag1", "tag2"}, tags)
+}
@@ -0,0 +1,90 @@
+package local
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dagu-org/dagu/internal/persistence"
+	"github.com/dagu-org/dagu/internal/persistence/local/storage"
+)
+
+// DelayedStartDirName is the name of the directory of the delayed starts
+// under the data directory.
+const DelayedStartDirName = "delayed"
+
+const extDelayedStart = ".json"
+
+type delayedStartStoreImpl struct {
+	storage *storage.Storage
+}
+
+func NewDelayedStartStore(s *storage.Storage) persistence.DelayedStartStore {
+	return &delayedStartStoreImpl{
+		storage: s,
+	}
+}
+
+func (d delayedStartStoreImpl) Add(start persistence.DelayedStart) error {
+	data, err := json.Marshal(start)
+	if err != nil {
+		return err
+	}
+	// The file is renamed into place so that the readers never see a
+	// partial one.
+	file := delayedStartFile(start.RequestID)
+	if err := d.storage.Write(file+".tmp", data); err != nil {
+		return err
+	}
+	return os.Rename(filepath.Join(d.storage.Dir, file+".tmp"), filepath.Join(d.storage.Dir, file))
+}
+
+func (d delayedStartStoreImpl) List() ([]persistence.DelayedStart, error) {
+	entries, err := os.ReadDir(d.storage.Dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var ret []persistence.DelayedStart
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), extDelayedStart) {
+			continue
+		}
+		data, err := d.storage.Read(entry.Name())
+		if errors.Is(err, os.ErrNotExist) {
+			// The run was started or removed meanwhile.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		var start persistence.DelayedStart
+		if err := json.Unmarshal(data, &start); err != nil {
+			return nil, fmt.Errorf("failed to read the delayed start %s: %w", entry.Name(), err)
+		}
+		ret = append(ret, start)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].StartAt.Before(ret[j].StartAt)
+	})
+	return ret, nil
+}
+
+func (d delayedStartStoreImpl) Remove(requestID string) error {
+	err := d.storage.Delete(delayedStartFile(requestID))
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w : %s", persistence.ErrRequestIDNotFound, requestID)
+	}
+	return err
+}
+
+// delayedStartFile returns the file of the delayed start of the request ID.
+func delayedStartFile(requestID string) string {
+	return normalizeFilename(requestID, "-") + extDelayedStart
+}
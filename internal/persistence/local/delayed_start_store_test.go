@@ -0,0 +1,49 @@
+package local
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/persistence"
+	"github.com/dagu-org/dagu/internal/persistence/local/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelayedStartStore(t *testing.T) {
+	store := NewDelayedStartStore(storage.NewStorage(t.TempDir()))
+
+	starts, err := store.List()
+	require.NoError(t, err)
+	require.Empty(t, starts)
+
+	now := time.Now().Truncate(time.Second)
+	require.NoError(t, store.Add(persistence.DelayedStart{
+		DAG: "/dags/late.yaml", RequestID: "late", StartAt: now.Add(time.Hour),
+	}))
+	require.NoError(t, store.Add(persistence.DelayedStart{
+		DAG: "/dags/early.yaml", RequestID: "early", StartAt: now.Add(time.Minute),
+		Params: "x=1", Labels: map[string]string{"customer": "acme"},
+	}))
+
+	// The earliest start first.
+	starts, err = store.List()
+	require.NoError(t, err)
+	require.Len(t, starts, 2)
+	require.Equal(t, "early", starts[0].RequestID)
+	require.Equal(t, "x=1", starts[0].Params)
+	require.Equal(t, map[string]string{"customer": "acme"}, starts[0].Labels)
+	require.True(t, now.Add(time.Minute).Equal(starts[0].StartAt))
+	require.Equal(t, "late", starts[1].RequestID)
+
+	// The run is removed only once.
+	require.NoError(t, store.Remove("early"))
+	err = store.Remove("early")
+	require.True(t, errors.Is(err, persistence.ErrRequestIDNotFound))
+
+	starts, err = store.List()
+	require.NoError(t, err)
+	require.Len(t, starts, 1)
+	require.Equal(t, "late", starts[0].RequestID)
+}
@@ -1,10 +1,16 @@
 package local
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"path"
 	"regexp"
 	"strings"
+	"time"
 
+	"github.com/dagu-org/dagu/internal/namespace"
 	"github.com/dagu-org/dagu/internal/persistence"
 	"github.com/dagu-org/dagu/internal/persistence/local/storage"
 )
@@ -21,19 +27,53 @@ func NewFlagStore(s *storage.Storage) persistence.FlagStore {
 
 func (f flagStoreImpl) ToggleSuspend(id string, suspend bool) error {
 	if suspend {
-		return f.storage.Create(fileName(id))
-	} else if f.IsSuspended(id) {
+		return f.Suspend(id, persistence.Suspension{SuspendedAt: time.Now()})
+	} else if f.storage.Exists(fileName(id)) {
 		return f.storage.Delete(fileName(id))
 	}
 	return nil
 }
 
 func (f flagStoreImpl) IsSuspended(id string) bool {
-	return f.storage.Exists(fileName(id))
+	suspension, err := f.GetSuspension(id)
+	if err != nil {
+		// A broken flag still suspends the DAG.
+		return f.storage.Exists(fileName(id))
+	}
+	return suspension != nil && !suspension.Expired(time.Now())
+}
+
+func (f flagStoreImpl) Suspend(id string, suspension persistence.Suspension) error {
+	data, err := json.Marshal(suspension)
+	if err != nil {
+		return err
+	}
+	return f.storage.Write(fileName(id), data)
+}
+
+func (f flagStoreImpl) GetSuspension(id string) (*persistence.Suspension, error) {
+	data, err := f.storage.Read(fileName(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var suspension persistence.Suspension
+	// The flags written before the reasons were supported are empty.
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &suspension); err != nil {
+			return nil, fmt.Errorf("failed to read the suspension of %s: %w", id, err)
+		}
+	}
+	return &suspension, nil
 }
 
+// fileName returns the file of the flag. The flags of the DAGs in a
+// namespace are in the directory of the namespace.
 func fileName(id string) string {
-	return fmt.Sprintf("%s.suspend", normalizeFilename(id, "-"))
+	ns, name := namespace.Split(id)
+	return path.Join(ns, fmt.Sprintf("%s.suspend", normalizeFilename(name, "-")))
 }
 
 // https://github.com/sindresorhus/filename-reserved-regex/blob/master/index.js
@@ -2,9 +2,12 @@ package local
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/dagu-org/dagu/internal/fileutil"
+	"github.com/dagu-org/dagu/internal/persistence"
 	"github.com/dagu-org/dagu/internal/persistence/local/storage"
 
 	"github.com/stretchr/testify/require"
@@ -24,4 +27,52 @@ func TestFlagStore(t *testing.T) {
 	require.NoError(t, err)
 
 	require.True(t, flagStore.IsSuspended("test"))
+
+	t.Run("Reason", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+		err := flagStore.Suspend("reason", persistence.Suspension{
+			Reason:    "database migration",
+			ExpiresAt: expiresAt,
+		})
+		require.NoError(t, err)
+		require.True(t, flagStore.IsSuspended("reason"))
+
+		suspension, err := flagStore.GetSuspension("reason")
+		require.NoError(t, err)
+		require.Equal(t, "database migration", suspension.Reason)
+		require.True(t, expiresAt.Equal(suspension.ExpiresAt))
+
+		require.NoError(t, flagStore.ToggleSuspend("reason", false))
+		suspension, err = flagStore.GetSuspension("reason")
+		require.NoError(t, err)
+		require.Nil(t, suspension)
+	})
+	t.Run("Expired", func(t *testing.T) {
+		err := flagStore.Suspend("expired", persistence.Suspension{
+			ExpiresAt: time.Now().Add(-time.Minute),
+		})
+		require.NoError(t, err)
+		require.False(t, flagStore.IsSuspended("expired"))
+
+		suspension, err := flagStore.GetSuspension("expired")
+		require.NoError(t, err)
+		require.True(t, suspension.Expired(time.Now()))
+	})
+	t.Run("EmptyFlag", func(t *testing.T) {
+		// The flags written by the older versions are empty.
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "legacy.suspend"), nil, 0600))
+		require.True(t, flagStore.IsSuspended("legacy"))
+
+		suspension, err := flagStore.GetSuspension("legacy")
+		require.NoError(t, err)
+		require.Empty(t, suspension.Reason)
+	})
+	t.Run("Namespace", func(t *testing.T) {
+		require.NoError(t, flagStore.ToggleSuspend("team-a/etl", true))
+		require.FileExists(t, filepath.Join(tmpDir, "team-a", "etl.suspend"))
+		require.True(t, flagStore.IsSuspended("team-a/etl"))
+		// The DAG with the same name in another namespace is not suspended.
+		require.False(t, flagStore.IsSuspended("team-b/etl"))
+		require.False(t, flagStore.IsSuspended("etl"))
+	})
 }
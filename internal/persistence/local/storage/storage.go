@@ -26,7 +26,22 @@ func NewStorage(dir string) *Storage {
 
 // Create creates the given file.
 func (s *Storage) Create(file string) error {
-	return os.WriteFile(path.Join(s.Dir, file), []byte{}, defaultPermission)
+	return s.Write(file, []byte{})
+}
+
+// Write writes the data to the given file. The file can be in a
+// subdirectory, which is created if needed.
+func (s *Storage) Write(file string, data []byte) error {
+	filePath := path.Join(s.Dir, file)
+	if err := os.MkdirAll(path.Dir(filePath), defaultPermission); err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, data, defaultPermission)
+}
+
+// Read reads the given file.
+func (s *Storage) Read(file string) ([]byte, error) {
+	return os.ReadFile(path.Join(s.Dir, file))
 }
 
 // Exists returns true if the given file exists.
@@ -0,0 +1,124 @@
+package model
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/stringutil"
+)
+
+// maxHotspotErrors is the maximum number of the errors reported per step.
+const maxHotspotErrors = 5
+
+// FailureHotspots is the steps of a DAG that failed in the recent runs.
+type FailureHotspots struct {
+	// Runs is the number of the analyzed runs.
+	Runs int
+	// FailedRuns is the number of the analyzed runs that failed.
+	FailedRuns int
+	// Steps is the failed steps, the most failed first.
+	Steps []StepFailures
+}
+
+// StepFailures is the failures of a step.
+type StepFailures struct {
+	Name string
+	// Runs is the number of the runs in which the step was executed.
+	Runs int
+	// Failures is the number of the runs in which the step failed.
+	Failures int
+	// LastFailedAt is the start time of the latest run in which the step
+	// failed.
+	LastFailedAt time.Time
+	// Errors is the most common errors of the step, the most common first.
+	Errors []ErrorCount
+}
+
+// FailureRate returns the ratio of the failures to the executions.
+func (s StepFailures) FailureRate() float64 {
+	if s.Runs == 0 {
+		return 0
+	}
+	return float64(s.Failures) / float64(s.Runs)
+}
+
+// ErrorCount is the number of the occurrences of an error.
+type ErrorCount struct {
+	Error string
+	Count int
+}
+
+// AnalyzeFailures aggregates the failed steps of the runs started at or
+// after since. All the runs are analyzed if since is zero.
+func AnalyzeFailures(statuses []Status, since time.Time) FailureHotspots {
+	var hotspots FailureHotspots
+	steps := make(map[string]*StepFailures)
+	errorCounts := make(map[string]map[string]int)
+	for _, s := range statuses {
+		startedAt, _ := stringutil.ParseTime(s.StartedAt)
+		if !since.IsZero() && startedAt.Before(since) {
+			continue
+		}
+		hotspots.Runs++
+		if s.Status == scheduler.StatusError {
+			hotspots.FailedRuns++
+		}
+		for _, n := range s.Nodes {
+			if n.Status != scheduler.NodeStatusSuccess && n.Status != scheduler.NodeStatusError {
+				continue
+			}
+			step, ok := steps[n.Step.Name]
+			if !ok {
+				step = &StepFailures{Name: n.Step.Name}
+				steps[n.Step.Name] = step
+				errorCounts[n.Step.Name] = make(map[string]int)
+			}
+			step.Runs++
+			if n.Status != scheduler.NodeStatusError {
+				continue
+			}
+			step.Failures++
+			if startedAt.After(step.LastFailedAt) {
+				step.LastFailedAt = startedAt
+			}
+			if msg := strings.TrimSpace(n.Error); msg != "" {
+				errorCounts[n.Step.Name][msg]++
+			}
+		}
+	}
+
+	for name, step := range steps {
+		if step.Failures == 0 {
+			continue
+		}
+		step.Errors = topErrors(errorCounts[name])
+		hotspots.Steps = append(hotspots.Steps, *step)
+	}
+	sort.Slice(hotspots.Steps, func(i, j int) bool {
+		a, b := hotspots.Steps[i], hotspots.Steps[j]
+		if a.Failures != b.Failures {
+			return a.Failures > b.Failures
+		}
+		return a.Name < b.Name
+	})
+	return hotspots
+}
+
+func topErrors(counts map[string]int) []ErrorCount {
+	ret := make([]ErrorCount, 0, len(counts))
+	for msg, count := range counts {
+		ret = append(ret, ErrorCount{Error: msg, Count: count})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Count != ret[j].Count {
+			return ret[i].Count > ret[j].Count
+		}
+		return ret[i].Error < ret[j].Error
+	})
+	if len(ret) > maxHotspotErrors {
+		ret = ret[:maxHotspotErrors]
+	}
+	return ret
+}
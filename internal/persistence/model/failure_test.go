@@ -0,0 +1,87 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/stringutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeFailures(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	node := func(name string, status scheduler.NodeStatus, err string) *Node {
+		return &Node{
+			Step:   digraph.Step{Name: name},
+			Status: status,
+			Error:  err,
+		}
+	}
+	run := func(day int, status scheduler.Status, nodes ...*Node) Status {
+		return Status{
+			RequestID: fmt.Sprintf("run-%d", day),
+			Status:    status,
+			StartedAt: stringutil.FormatTime(base.AddDate(0, 0, day)),
+			Nodes:     nodes,
+		}
+	}
+	statuses := []Status{
+		run(3, scheduler.StatusError,
+			node("extract", scheduler.NodeStatusSuccess, ""),
+			node("load", scheduler.NodeStatusError, "connection refused"),
+		),
+		run(2, scheduler.StatusError,
+			node("extract", scheduler.NodeStatusError, "timeout"),
+			node("load", scheduler.NodeStatusSkipped, ""),
+		),
+		run(1, scheduler.StatusError,
+			node("extract", scheduler.NodeStatusSuccess, ""),
+			node("load", scheduler.NodeStatusError, "connection refused "),
+		),
+		run(0, scheduler.StatusError,
+			node("extract", scheduler.NodeStatusSuccess, ""),
+			node("load", scheduler.NodeStatusError, "disk full"),
+		),
+	}
+
+	t.Run("AllRuns", func(t *testing.T) {
+		hotspots := AnalyzeFailures(statuses, time.Time{})
+		require.Equal(t, 4, hotspots.Runs)
+		require.Equal(t, 4, hotspots.FailedRuns)
+		require.Len(t, hotspots.Steps, 2)
+
+		load := hotspots.Steps[0]
+		require.Equal(t, "load", load.Name)
+		require.Equal(t, 3, load.Runs)
+		require.Equal(t, 3, load.Failures)
+		require.Equal(t, 1.0, load.FailureRate())
+		require.True(t, base.AddDate(0, 0, 3).Equal(load.LastFailedAt))
+		require.Equal(t, []ErrorCount{
+			{Error: "connection refused", Count: 2},
+			{Error: "disk full", Count: 1},
+		}, load.Errors)
+
+		extract := hotspots.Steps[1]
+		require.Equal(t, "extract", extract.Name)
+		require.Equal(t, 4, extract.Runs)
+		require.Equal(t, 1, extract.Failures)
+		require.Equal(t, 0.25, extract.FailureRate())
+	})
+	t.Run("Since", func(t *testing.T) {
+		hotspots := AnalyzeFailures(statuses, base.AddDate(0, 0, 2))
+		require.Equal(t, 2, hotspots.Runs)
+		require.Len(t, hotspots.Steps, 2)
+		require.Equal(t, "extract", hotspots.Steps[0].Name)
+		require.Equal(t, "load", hotspots.Steps[1].Name)
+	})
+	t.Run("NoFailures", func(t *testing.T) {
+		hotspots := AnalyzeFailures([]Status{
+			run(0, scheduler.StatusSuccess, node("extract", scheduler.NodeStatusSuccess, "")),
+		}, time.Time{})
+		require.Equal(t, 1, hotspots.Runs)
+		require.Empty(t, hotspots.Steps)
+	})
+}
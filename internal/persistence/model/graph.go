@@ -0,0 +1,144 @@
+package model
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+)
+
+// Layout of the graph image.
+const (
+	graphNodeWidth  = 180
+	graphNodeHeight = 44
+	graphGapX       = 60
+	graphGapY       = 20
+	graphMargin     = 20
+	graphNameLength = 24
+)
+
+// graphStyle is the colors of a node, the same as the graph of the web UI.
+type graphStyle struct {
+	fill, stroke, text string
+}
+
+func graphStyleOf(status scheduler.NodeStatus) graphStyle {
+	switch status {
+	case scheduler.NodeStatusRunning:
+		return graphStyle{fill: "#f0fdf4", stroke: "#86efac", text: "#166534"}
+	case scheduler.NodeStatusSuccess, scheduler.NodeStatusCached:
+		// The cached steps are rendered like the succeeded steps.
+		return graphStyle{fill: "#f0fdf4", stroke: "#86efac", text: "#166534"}
+	case scheduler.NodeStatusError:
+		return graphStyle{fill: "#fef2f2", stroke: "#fca5a5", text: "#aa1010"}
+	case scheduler.NodeStatusCancel:
+		return graphStyle{fill: "#fdf2f8", stroke: "#f9a8d4", text: "#9d174d"}
+	case scheduler.NodeStatusSkipped:
+		return graphStyle{fill: "#f8fafc", stroke: "#cbd5e1", text: "#475569"}
+	default:
+		return graphStyle{fill: "#f0f9ff", stroke: "#93c5fd", text: "#1e40af"}
+	}
+}
+
+func graphEdgeColor(status scheduler.NodeStatus) string {
+	switch status {
+	case scheduler.NodeStatusError:
+		return "#ef4444"
+	case scheduler.NodeStatusSuccess, scheduler.NodeStatusCached:
+		return "#16a34a"
+	default:
+		return "#64748b"
+	}
+}
+
+// RenderGraph renders the graph of the steps with their statuses as an SVG
+// image. The steps are placed from left to right by the depth of their
+// dependencies.
+func RenderGraph(nodes []*Node) string {
+	index := make(map[string]int, len(nodes))
+	for i, n := range nodes {
+		index[n.Step.Name] = i
+	}
+
+	// depth is the length of the longest path from a step without
+	// dependencies.
+	depths := make([]int, len(nodes))
+	visited := make([]bool, len(nodes))
+	var depth func(i int) int
+	depth = func(i int) int {
+		if visited[i] {
+			return depths[i]
+		}
+		visited[i] = true
+		for _, dep := range nodes[i].Step.Depends {
+			if j, ok := index[dep]; ok {
+				depths[i] = max(depths[i], depth(j)+1)
+			}
+		}
+		return depths[i]
+	}
+
+	type point struct{ x, y int }
+	positions := make([]point, len(nodes))
+	rows := map[int]int{}
+	var columns, maxRows int
+	for i := range nodes {
+		d := depth(i)
+		positions[i] = point{
+			x: graphMargin + d*(graphNodeWidth+graphGapX),
+			y: graphMargin + rows[d]*(graphNodeHeight+graphGapY),
+		}
+		rows[d]++
+		columns = max(columns, d+1)
+		maxRows = max(maxRows, rows[d])
+	}
+
+	width := 2*graphMargin + columns*graphNodeWidth + max(columns-1, 0)*graphGapX
+	height := 2*graphMargin + maxRows*graphNodeHeight + max(maxRows-1, 0)*graphGapY
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif">`,
+		width, height, width, height)
+	b.WriteString(`<rect width="100%" height="100%" fill="#ffffff"/>`)
+
+	for i, n := range nodes {
+		to := positions[i]
+		for _, dep := range n.Step.Depends {
+			j, ok := index[dep]
+			if !ok {
+				continue
+			}
+			from := positions[j]
+			x1, y1 := from.x+graphNodeWidth, from.y+graphNodeHeight/2
+			x2, y2 := to.x, to.y+graphNodeHeight/2
+			mid := (x1 + x2) / 2
+			fmt.Fprintf(&b, `<path d="M%d %d C%d %d %d %d %d %d" fill="none" stroke="%s" stroke-width="1.5"/>`,
+				x1, y1, mid, y1, mid, y2, x2, y2, graphEdgeColor(n.Status))
+			fmt.Fprintf(&b, `<polygon points="%d,%d %d,%d %d,%d" fill="%s"/>`,
+				x2, y2, x2-6, y2-4, x2-6, y2+4, graphEdgeColor(n.Status))
+		}
+	}
+
+	for i, n := range nodes {
+		p := positions[i]
+		style := graphStyleOf(n.Status)
+		fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%d" height="%d" rx="6" fill="%s" stroke="%s" stroke-width="1.2"/>`,
+			p.x, p.y, graphNodeWidth, graphNodeHeight, style.fill, style.stroke)
+		fmt.Fprintf(&b, `<text x="%d" y="%d" text-anchor="middle" font-size="13" fill="%s">%s</text>`,
+			p.x+graphNodeWidth/2, p.y+19, style.text, html.EscapeString(truncateName(n.Step.Name)))
+		fmt.Fprintf(&b, `<text x="%d" y="%d" text-anchor="middle" font-size="10" fill="%s">%s</text>`,
+			p.x+graphNodeWidth/2, p.y+35, style.text, html.EscapeString(n.Status.String()))
+	}
+
+	b.WriteString(`</svg>`)
+	return b.String()
+}
+
+func truncateName(name string) string {
+	runes := []rune(name)
+	if len(runes) <= graphNameLength {
+		return name
+	}
+	return string(runes[:graphNameLength-1]) + "…"
+}
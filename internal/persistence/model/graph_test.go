@@ -0,0 +1,52 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderGraph(t *testing.T) {
+	nodes := []*Node{
+		{Step: digraph.Step{Name: "build"}, Status: scheduler.NodeStatusSuccess},
+		{Step: digraph.Step{Name: "lint"}, Status: scheduler.NodeStatusSuccess},
+		{Step: digraph.Step{Name: "deploy <prod>", Depends: []string{"build", "lint"}}, Status: scheduler.NodeStatusError},
+	}
+
+	svg := RenderGraph(nodes)
+	require.True(t, strings.HasPrefix(svg, "<svg"))
+	require.True(t, strings.HasSuffix(svg, "</svg>"))
+	require.Contains(t, svg, "build")
+	require.Contains(t, svg, "deploy &lt;prod&gt;")
+	require.Contains(t, svg, "failed")
+	require.Contains(t, svg, "#fef2f2")
+	// The edges from the dependencies to the failed step.
+	require.Equal(t, 2, strings.Count(svg, `stroke="#ef4444"`))
+	// The steps without dependencies are stacked in the first column, and
+	// the failed step is placed in the second column.
+	require.Contains(t, svg, `<rect x="20" y="84"`)
+	require.Contains(t, svg, `<rect x="260" y="20"`)
+	require.Contains(t, svg, `width="460" height="148"`)
+
+	t.Run("LongName", func(t *testing.T) {
+		svg := RenderGraph([]*Node{{Step: digraph.Step{Name: strings.Repeat("x", 40)}}})
+		require.Contains(t, svg, strings.Repeat("x", graphNameLength-1)+"…")
+		require.Contains(t, svg, "not started")
+	})
+	t.Run("Cached", func(t *testing.T) {
+		svg := RenderGraph([]*Node{
+			{Step: digraph.Step{Name: "build"}, Status: scheduler.NodeStatusCached},
+			{Step: digraph.Step{Name: "test", Depends: []string{"build"}}, Status: scheduler.NodeStatusCached},
+		})
+		// The cached steps are rendered like the succeeded steps.
+		require.Contains(t, svg, "#f0fdf4")
+		require.NotContains(t, svg, "#f0f9ff")
+		require.Equal(t, 1, strings.Count(svg, `stroke="#16a34a"`))
+	})
+	t.Run("Empty", func(t *testing.T) {
+		require.Contains(t, RenderGraph(nil), `width="40" height="40"`)
+	})
+}
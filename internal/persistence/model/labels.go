@@ -0,0 +1,79 @@
+package model
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+var ErrInvalidLabel = errors.New("invalid label")
+
+// ParseLabels parses a comma separated list of labels in the form of
+// "key=value" (e.g. "customer=acme,backfill=true").
+func ParseLabels(s string) (map[string]string, error) {
+	labels := make(map[string]string)
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		key, value, err := parseLabel(item)
+		if err != nil {
+			return nil, err
+		}
+		labels[key] = value
+	}
+	return labels, nil
+}
+
+// ReadLabels reads labels written one per line in the form of "key=value".
+// Empty lines are ignored.
+func ReadLabels(r io.Reader) (map[string]string, error) {
+	labels := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		key, value, err := parseLabel(line)
+		if err != nil {
+			return nil, err
+		}
+		labels[key] = value
+	}
+	return labels, scanner.Err()
+}
+
+// FormatLabels formats the labels in the form accepted by ParseLabels.
+// The labels are sorted by key.
+func FormatLabels(labels map[string]string) string {
+	items := make([]string, 0, len(labels))
+	for k, v := range labels {
+		items = append(items, k+"="+v)
+	}
+	sort.Strings(items)
+	return strings.Join(items, ",")
+}
+
+func parseLabel(s string) (string, string, error) {
+	key, value, found := strings.Cut(s, "=")
+	key = strings.TrimSpace(key)
+	if !found || key == "" {
+		return "", "", fmt.Errorf("%w: %q", ErrInvalidLabel, s)
+	}
+	return key, strings.TrimSpace(value), nil
+}
+
+// MatchLabels returns true if the status has all the given labels.
+func (st *Status) MatchLabels(labels map[string]string) bool {
+	for k, v := range labels {
+		if value, ok := st.Labels[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLabels(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		labels, err := ParseLabels("customer=acme, backfill=true,,empty=")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{
+			"customer": "acme",
+			"backfill": "true",
+			"empty":    "",
+		}, labels)
+		require.Equal(t, "backfill=true,customer=acme,empty=", FormatLabels(labels))
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := ParseLabels("customer")
+		require.ErrorIs(t, err, ErrInvalidLabel)
+	})
+	t.Run("ReadLabels", func(t *testing.T) {
+		labels, err := ReadLabels(strings.NewReader("customer=acme\n\nregion = us\n"))
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"customer": "acme", "region": "us"}, labels)
+	})
+}
+
+func TestMatchLabels(t *testing.T) {
+	status := Status{Labels: map[string]string{"customer": "acme", "backfill": "true"}}
+	require.True(t, status.MatchLabels(nil))
+	require.True(t, status.MatchLabels(map[string]string{"customer": "acme"}))
+	require.False(t, status.MatchLabels(map[string]string{"customer": "other"}))
+	require.False(t, status.MatchLabels(map[string]string{"region": "us"}))
+}
@@ -0,0 +1,157 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/dagu-org/dagu/internal/stringutil"
+)
+
+var ErrInvalidTrigger = errors.New("invalid trigger")
+
+// Trigger is what started a run.
+type Trigger string
+
+const (
+	// TriggerManual is a run started with the command line.
+	TriggerManual Trigger = "manual"
+	// TriggerAPI is a run started with the Web UI or the REST API.
+	TriggerAPI Trigger = "api"
+	// TriggerSchedule is a run started by the scheduler at a scheduled time.
+	TriggerSchedule Trigger = "schedule"
+	// TriggerRestart is a run started by restarting the DAG, either with
+	// the command line or at a scheduled time.
+	TriggerRestart Trigger = "restart"
+	// TriggerRetry is a run retrying a previous run of the DAG.
+	TriggerRetry Trigger = "retry"
+	// TriggerParent is a run started as a sub workflow by a step of another
+	// DAG run.
+	TriggerParent Trigger = "parent"
+)
+
+var triggers = []Trigger{
+	TriggerManual, TriggerAPI, TriggerSchedule, TriggerRestart, TriggerRetry, TriggerParent,
+}
+
+// ParseTrigger returns the trigger of the name.
+func ParseTrigger(s string) (Trigger, error) {
+	for _, t := range triggers {
+		if string(t) == s {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidTrigger, s)
+}
+
+// Lineage records where a run comes from. The runs recorded before the
+// lineage was added have none.
+type Lineage struct {
+	Trigger Trigger `json:"Trigger"`
+	// ParentDAG is the ID of the DAG whose run started this run as a sub
+	// workflow. The request ID of the parent run is in ParentRequestID of
+	// the status.
+	ParentDAG string `json:"ParentDAG,omitempty"`
+	// RetryOf is the request ID of the run of the same DAG retried by this
+	// run.
+	RetryOf string `json:"RetryOf,omitempty"`
+	// Schedule is the cron expression of the schedule that started the
+	// run. A DAG may have more than one schedule.
+	Schedule string `json:"Schedule,omitempty"`
+}
+
+func WithLineage(lineage *Lineage) StatusOption {
+	return func(s *Status) {
+		s.Lineage = lineage
+	}
+}
+
+// LineageRun is a run in the lineage of a run.
+type LineageRun struct {
+	// DAG is the ID of the DAG of the run.
+	DAG    string
+	Status *Status
+}
+
+// maxLineageDepth bounds the ancestors followed in case of a cycle in the
+// records.
+const maxLineageDepth = 100
+
+// Ancestors returns the runs the run comes from, the origin first, with
+// find returning the run of a DAG by its request ID. It also returns false
+// if an ancestor can't be found, e.g. because it was removed from the
+// history, in which case the first run returned is not the origin.
+func Ancestors(run LineageRun, find func(dag, requestID string) (*Status, error)) ([]LineageRun, bool) {
+	var ret []LineageRun
+	seen := map[string]bool{run.DAG + "/" + run.Status.RequestID: true}
+	for range maxLineageDepth {
+		dag, requestID := previous(run)
+		if requestID == "" {
+			slices.Reverse(ret)
+			return ret, true
+		}
+		if dag == "" || seen[dag+"/"+requestID] {
+			break
+		}
+		seen[dag+"/"+requestID] = true
+		status, err := find(dag, requestID)
+		if err != nil {
+			break
+		}
+		run = LineageRun{DAG: dag, Status: status}
+		ret = append(ret, run)
+	}
+	slices.Reverse(ret)
+	return ret, false
+}
+
+// previous returns the run the run comes from. The DAG is empty if the run
+// has a parent whose DAG wasn't recorded.
+func previous(run LineageRun) (dag, requestID string) {
+	lineage := run.Status.Lineage
+	switch {
+	case lineage != nil && lineage.RetryOf != "":
+		return run.DAG, lineage.RetryOf
+	case run.Status.ParentRequestID != "":
+		if lineage != nil {
+			dag = lineage.ParentDAG
+		}
+		return dag, run.Status.ParentRequestID
+	}
+	return "", ""
+}
+
+// Descendants returns the runs triggered by the run and by its descendants
+// among the runs, in the order they started.
+func Descendants(run LineageRun, runs []LineageRun) []LineageRun {
+	children := make(map[string][]LineageRun)
+	for _, r := range runs {
+		if dag, requestID := previous(r); requestID != "" {
+			children[dag+"/"+requestID] = append(children[dag+"/"+requestID], r)
+		}
+	}
+
+	var ret []LineageRun
+	seen := map[string]bool{run.DAG + "/" + run.Status.RequestID: true}
+	queue := []LineageRun{run}
+	for len(queue) > 0 {
+		key := queue[0].DAG + "/" + queue[0].Status.RequestID
+		queue = queue[1:]
+		for _, child := range children[key] {
+			childKey := child.DAG + "/" + child.Status.RequestID
+			if seen[childKey] {
+				continue
+			}
+			seen[childKey] = true
+			ret = append(ret, child)
+			queue = append(queue, child)
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		a, _ := stringutil.ParseTime(ret[i].Status.StartedAt)
+		b, _ := stringutil.ParseTime(ret[j].Status.StartedAt)
+		return a.Before(b)
+	})
+	return ret
+}
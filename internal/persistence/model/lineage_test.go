@@ -0,0 +1,70 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineage(t *testing.T) {
+	// etl (schedule) -> load (parent) -> load retry; etl -> report (parent)
+	etl := LineageRun{DAG: "etl", Status: &Status{
+		RequestID: "1", StartedAt: "2024-01-01 00:00:00",
+		Lineage: &Lineage{Trigger: TriggerSchedule},
+	}}
+	load := LineageRun{DAG: "team-a/load", Status: &Status{
+		RequestID: "2", StartedAt: "2024-01-01 00:01:00", ParentRequestID: "1",
+		Lineage: &Lineage{Trigger: TriggerParent, ParentDAG: "etl"},
+	}}
+	retry := LineageRun{DAG: "team-a/load", Status: &Status{
+		RequestID: "3", StartedAt: "2024-01-01 00:05:00", ParentRequestID: "1",
+		Lineage: &Lineage{Trigger: TriggerRetry, ParentDAG: "etl", RetryOf: "2"},
+	}}
+	report := LineageRun{DAG: "report", Status: &Status{
+		RequestID: "4", StartedAt: "2024-01-01 00:02:00", ParentRequestID: "1",
+		Lineage: &Lineage{Trigger: TriggerParent, ParentDAG: "etl"},
+	}}
+	other := LineageRun{DAG: "etl", Status: &Status{RequestID: "5"}}
+	runs := []LineageRun{etl, load, retry, report, other}
+
+	find := func(dag, requestID string) (*Status, error) {
+		for _, r := range runs {
+			if r.DAG == dag && r.Status.RequestID == requestID {
+				return r.Status, nil
+			}
+		}
+		return nil, errors.New("not found")
+	}
+
+	t.Run("Ancestors", func(t *testing.T) {
+		ancestors, originFound := Ancestors(retry, find)
+		require.True(t, originFound)
+		require.Equal(t, []LineageRun{etl, load}, ancestors)
+
+		ancestors, originFound = Ancestors(etl, find)
+		require.True(t, originFound)
+		require.Empty(t, ancestors)
+
+		// The parent is no longer in the history.
+		orphan := LineageRun{DAG: "x", Status: &Status{
+			RequestID: "6", ParentRequestID: "9",
+			Lineage: &Lineage{Trigger: TriggerParent, ParentDAG: "etl"},
+		}}
+		ancestors, originFound = Ancestors(orphan, find)
+		require.False(t, originFound)
+		require.Empty(t, ancestors)
+	})
+	t.Run("Descendants", func(t *testing.T) {
+		require.Equal(t, []LineageRun{load, report, retry}, Descendants(etl, runs))
+		require.Equal(t, []LineageRun{retry}, Descendants(load, runs))
+		require.Empty(t, Descendants(other, runs))
+	})
+	t.Run("ParseTrigger", func(t *testing.T) {
+		trigger, err := ParseTrigger("schedule")
+		require.NoError(t, err)
+		require.Equal(t, TriggerSchedule, trigger)
+		_, err = ParseTrigger("webhook")
+		require.ErrorIs(t, err, ErrInvalidTrigger)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/executor"
 	"github.com/dagu-org/dagu/internal/digraph/scheduler"
 	"github.com/dagu-org/dagu/internal/stringutil"
 )
@@ -37,6 +38,11 @@ func FromNode(node scheduler.NodeData) *Node {
 		RetryCount: node.State.RetryCount,
 		DoneCount:  node.State.DoneCount,
 		Error:      errText(node.State.Error),
+		Artifacts:  node.State.Artifacts,
+
+		ResourceUsage: node.State.ResourceUsage,
+		SkipRequested: node.State.SkipRequested,
+		SubRequestID:  node.State.SubRequestID,
 	}
 }
 
@@ -51,6 +57,15 @@ type Node struct {
 	DoneCount  int                  `json:"DoneCount,omitempty"`
 	Error      string               `json:"Error,omitempty"`
 	StatusText string               `json:"StatusText"`
+	Artifacts  []string             `json:"Artifacts,omitempty"`
+	// ResourceUsage is the CPU time, the memory and the wall time of the
+	// command of the step.
+	ResourceUsage *executor.ResourceUsage `json:"ResourceUsage,omitempty"`
+	// SkipRequested is true if the operator asked to skip the step.
+	SkipRequested bool `json:"SkipRequested,omitempty"`
+	// SubRequestID is the request ID of the run of the sub DAG started by
+	// the step.
+	SubRequestID string `json:"SubRequestID,omitempty"`
 }
 
 func (n *Node) ToNode() *scheduler.Node {
@@ -66,6 +81,11 @@ func (n *Node) ToNode() *scheduler.Node {
 		RetryCount: n.RetryCount,
 		DoneCount:  n.DoneCount,
 		Error:      errFromText(n.Error),
+		Artifacts:  n.Artifacts,
+
+		ResourceUsage: n.ResourceUsage,
+		SkipRequested: n.SkipRequested,
+		SubRequestID:  n.SubRequestID,
 	})
 }
 
@@ -30,6 +30,8 @@ func (f *StatusFactory) CreateDefault() Status {
 		OnSuccess:  nodeOrNil(f.dag.HandlerOn.Success),
 		OnFailure:  nodeOrNil(f.dag.HandlerOn.Failure),
 		OnCancel:   nodeOrNil(f.dag.HandlerOn.Cancel),
+		OnTimeout:  nodeOrNil(f.dag.HandlerOn.Timeout),
+		Stages:     stagesFromDAG(f.dag.Stages),
 		Params:     strings.Join(f.dag.Params, " "),
 		ParamsList: f.dag.Params,
 		StartedAt:  stringutil.FormatTime(time.Time{}),
@@ -83,12 +85,81 @@ func WithOnCancelNode(node *scheduler.Node) StatusOption {
 	}
 }
 
+func WithOnTimeoutNode(node *scheduler.Node) StatusOption {
+	return func(s *Status) {
+		if node != nil {
+			s.OnTimeout = FromNode(node.Data())
+		}
+	}
+}
+
 func WithLogFilePath(logFilePath string) StatusOption {
 	return func(s *Status) {
 		s.Log = logFilePath
 	}
 }
 
+func WithArtifactDir(dir string) StatusOption {
+	return func(s *Status) {
+		s.ArtifactDir = dir
+	}
+}
+
+func WithIdempotencyKey(key string) StatusOption {
+	return func(s *Status) {
+		s.IdempotencyKey = key
+	}
+}
+
+func WithLabels(labels map[string]string) StatusOption {
+	return func(s *Status) {
+		s.Labels = labels
+	}
+}
+
+func WithParentRequestID(requestID string) StatusOption {
+	return func(s *Status) {
+		s.ParentRequestID = requestID
+	}
+}
+
+func WithScheduledTime(t time.Time) StatusOption {
+	return func(s *Status) {
+		if !t.IsZero() {
+			s.ScheduledTime = stringutil.FormatTime(t)
+		}
+	}
+}
+
+func WithExecutionDate(t time.Time) StatusOption {
+	return func(s *Status) {
+		if !t.IsZero() {
+			s.ExecutionDate = stringutil.FormatTime(t)
+		}
+	}
+}
+
+func WithStages(stages []scheduler.StageState) StatusOption {
+	return func(s *Status) {
+		s.Stages = FromStages(stages)
+	}
+}
+
+// WithHeartbeat sets the time the agent running the DAG was last alive.
+func WithHeartbeat(t time.Time) StatusOption {
+	return func(s *Status) {
+		if !t.IsZero() {
+			s.Heartbeat = FormatTime(t)
+		}
+	}
+}
+
+func WithNotes(notes []Note) StatusOption {
+	return func(s *Status) {
+		s.Notes = notes
+	}
+}
+
 func (f *StatusFactory) Create(
 	requestID string,
 	status scheduler.Status,
@@ -139,11 +210,140 @@ type Status struct {
 	OnSuccess  *Node            `json:"OnSuccess"`
 	OnFailure  *Node            `json:"OnFailure"`
 	OnCancel   *Node            `json:"OnCancel"`
+	OnTimeout  *Node            `json:"OnTimeout,omitempty"`
 	StartedAt  string           `json:"StartedAt"`
 	FinishedAt string           `json:"FinishedAt"`
 	Log        string           `json:"Log"`
 	Params     string           `json:"Params,omitempty"`
 	ParamsList []string         `json:"ParamsList,omitempty"`
+	// ArtifactDir is the directory where the artifacts of the run are staged.
+	ArtifactDir string `json:"ArtifactDir,omitempty"`
+	// IdempotencyKey is the key given by the submitter of the run to
+	// prevent duplicate runs.
+	IdempotencyKey string `json:"IdempotencyKey,omitempty"`
+	// Labels is the arbitrary key/value metadata of the run.
+	Labels map[string]string `json:"Labels,omitempty"`
+	// Stages is the list of the stages of the DAG with the status collapsed
+	// from the status of the steps in each stage.
+	Stages []*Stage `json:"Stages,omitempty"`
+	// Notes is the list of notes attached to the run by operators.
+	Notes []Note `json:"Notes,omitempty"`
+	// ParentRequestID is the request ID of the DAG run that started this
+	// run as a sub workflow.
+	ParentRequestID string `json:"ParentRequestID,omitempty"`
+	// Lineage is what started the run.
+	Lineage *Lineage `json:"Lineage,omitempty"`
+	// ScheduledTime is the time the run was scheduled to start at.
+	ScheduledTime string `json:"ScheduledTime,omitempty"`
+	// ExecutionDate is the logical date of the data the run processes.
+	ExecutionDate string `json:"ExecutionDate,omitempty"`
+	// Heartbeat is the time the agent running the DAG was last alive. The
+	// agent updates it periodically while the DAG is running.
+	Heartbeat string `json:"Heartbeat,omitempty"`
+}
+
+// Stage is the status of a stage of the DAG.
+type Stage struct {
+	Name       string               `json:"Name"`
+	Status     scheduler.NodeStatus `json:"Status"`
+	StatusText string               `json:"StatusText"`
+	StartedAt  string               `json:"StartedAt"`
+	FinishedAt string               `json:"FinishedAt"`
+	OnExit     *Node                `json:"OnExit,omitempty"`
+	OnSuccess  *Node                `json:"OnSuccess,omitempty"`
+	OnFailure  *Node                `json:"OnFailure,omitempty"`
+	OnCancel   *Node                `json:"OnCancel,omitempty"`
+}
+
+// Duration returns the duration of the stage. It returns zero if the stage
+// has not finished yet.
+func (s *Stage) Duration() time.Duration {
+	startedAt, err := stringutil.ParseTime(s.StartedAt)
+	if err != nil || startedAt.IsZero() {
+		return 0
+	}
+	finishedAt, err := stringutil.ParseTime(s.FinishedAt)
+	if err != nil || finishedAt.IsZero() {
+		return 0
+	}
+	return finishedAt.Sub(startedAt)
+}
+
+func FromStages(stages []scheduler.StageState) []*Stage {
+	var ret []*Stage
+	for _, s := range stages {
+		stage := &Stage{
+			Name:       s.Name,
+			Status:     s.Status,
+			StatusText: s.Status.String(),
+			StartedAt:  FormatTime(s.StartedAt),
+			FinishedAt: FormatTime(s.FinishedAt),
+		}
+		if node := s.Handlers[digraph.HandlerOnExit]; node != nil {
+			stage.OnExit = FromNode(node.Data())
+		}
+		if node := s.Handlers[digraph.HandlerOnSuccess]; node != nil {
+			stage.OnSuccess = FromNode(node.Data())
+		}
+		if node := s.Handlers[digraph.HandlerOnFailure]; node != nil {
+			stage.OnFailure = FromNode(node.Data())
+		}
+		if node := s.Handlers[digraph.HandlerOnCancel]; node != nil {
+			stage.OnCancel = FromNode(node.Data())
+		}
+		ret = append(ret, stage)
+	}
+	return ret
+}
+
+func stagesFromDAG(stages []digraph.Stage) []*Stage {
+	var ret []*Stage
+	for _, s := range stages {
+		ret = append(ret, &Stage{
+			Name:       s.Name,
+			Status:     scheduler.NodeStatusNone,
+			StatusText: scheduler.NodeStatusNone.String(),
+			OnExit:     nodeOrNil(s.HandlerOn.Exit),
+			OnSuccess:  nodeOrNil(s.HandlerOn.Success),
+			OnFailure:  nodeOrNil(s.HandlerOn.Failure),
+			OnCancel:   nodeOrNil(s.HandlerOn.Cancel),
+		})
+	}
+	return ret
+}
+
+// Note is a free-text note attached to a run.
+type Note struct {
+	Text      string `json:"Text"`
+	CreatedAt string `json:"CreatedAt"`
+}
+
+func NewNote(text string, createdAt time.Time) Note {
+	return Note{Text: text, CreatedAt: FormatTime(createdAt)}
+}
+
+// MarkInterrupted marks the run and the running nodes as failed because the
+// process running the DAG is gone. The reason is recorded in the error of the
+// nodes and in a note of the run.
+func (st *Status) MarkInterrupted(reason string, at time.Time) {
+	finishedAt := FormatTime(at)
+	nodes := append([]*Node{}, st.Nodes...)
+	nodes = append(nodes, st.OnExit, st.OnSuccess, st.OnFailure, st.OnCancel, st.OnTimeout)
+	for _, node := range nodes {
+		if node == nil || node.Status != scheduler.NodeStatusRunning {
+			continue
+		}
+		node.Status = scheduler.NodeStatusError
+		node.StatusText = node.Status.String()
+		node.Error = reason
+		node.FinishedAt = finishedAt
+	}
+	st.Status = scheduler.StatusError
+	st.StatusText = st.Status.String()
+	if t, _ := stringutil.ParseTime(st.FinishedAt); t.IsZero() {
+		st.FinishedAt = finishedAt
+	}
+	st.Notes = append(st.Notes, NewNote("Run interrupted: "+reason, at))
 }
 
 func (st *Status) CorrectRunningStatus() {
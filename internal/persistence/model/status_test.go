@@ -43,6 +43,7 @@ func TestStatusSerialization(t *testing.T) {
 	requestID := "request-id-testI"
 	statusToPersist := NewStatusFactory(dag).Create(
 		requestID, scheduler.StatusSuccess, 0, startedAt, WithFinishedAt(finishedAt),
+		WithNotes([]Note{NewNote("manually reconciled", finishedAt)}),
 	)
 
 	rawJSON, err := json.Marshal(statusToPersist)
@@ -54,6 +55,7 @@ func TestStatusSerialization(t *testing.T) {
 	require.Equal(t, statusToPersist.Name, statusObject.Name)
 	require.Equal(t, 1, len(statusObject.Nodes))
 	require.Equal(t, dag.Steps[0].Name, statusObject.Nodes[0].Step.Name)
+	require.Equal(t, statusToPersist.Notes, statusObject.Notes)
 }
 
 func TestCorrectRunningStatus(t *testing.T) {
@@ -64,6 +66,24 @@ func TestCorrectRunningStatus(t *testing.T) {
 	require.Equal(t, scheduler.StatusError, status.Status)
 }
 
+func TestMarkInterrupted(t *testing.T) {
+	dag := &digraph.DAG{Name: "test"}
+	nodes := []scheduler.NodeData{
+		{Step: digraph.Step{Name: "1"}, State: scheduler.NodeState{Status: scheduler.NodeStatusSuccess}},
+		{Step: digraph.Step{Name: "2"}, State: scheduler.NodeState{Status: scheduler.NodeStatusRunning}},
+	}
+	status := NewStatusFactory(dag).Create("request-id", scheduler.StatusRunning, 0, time.Now(), WithNodes(nodes))
+
+	status.MarkInterrupted("process not found", time.Now())
+	require.Equal(t, scheduler.StatusError, status.Status)
+	require.Equal(t, scheduler.NodeStatusSuccess, status.Nodes[0].Status)
+	require.Equal(t, scheduler.NodeStatusError, status.Nodes[1].Status)
+	require.Equal(t, "process not found", status.Nodes[1].Error)
+	require.NotEqual(t, "-", status.FinishedAt)
+	require.Len(t, status.Notes, 1)
+	require.Equal(t, "Run interrupted: process not found", status.Notes[0].Text)
+}
+
 func TestJsonMarshal(t *testing.T) {
 	step := digraph.Step{
 		OutputVariables: &digraph.SyncMap{},
@@ -0,0 +1,193 @@
+package model
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph/executor"
+	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/stringutil"
+)
+
+// DefaultRegressionThreshold is the ratio of the duration of the latest run
+// of a step to its baseline above which the step is regarded as regressed.
+const DefaultRegressionThreshold = 1.5
+
+// minRegressionBaseline is the minimum baseline to detect the regressions.
+// The durations of the shorter steps are too noisy to compare.
+const minRegressionBaseline = time.Second
+
+// Trend is the durations and the resource usage of the recent runs of a DAG.
+type Trend struct {
+	// Runs is the runs, the newest first.
+	Runs []RunTrend
+	// Steps is the steps in the order of the latest run.
+	Steps []StepTrend
+}
+
+// RunTrend is the duration of a run.
+type RunTrend struct {
+	RequestID string
+	Status    scheduler.Status
+	StartedAt time.Time
+	Duration  time.Duration
+}
+
+// StepTrend is the durations and the resource usage of a step across the
+// successful runs of the step.
+type StepTrend struct {
+	Name string
+	// Runs is the number of the successful runs of the step.
+	Runs int
+	// Latest is the duration of the latest successful run.
+	Latest time.Duration
+	// Baseline is the median duration of the successful runs before the
+	// latest one. It's zero if there is no previous run.
+	Baseline time.Duration
+	// Average is the average duration of the successful runs.
+	Average time.Duration
+	// AverageCPUTime is the average CPU time of the runs with the measured
+	// resource usage.
+	AverageCPUTime time.Duration
+	// MaxRSS is the maximum resident set size across the runs in bytes.
+	MaxRSS int64
+	// LatestMaxRSS is the maximum resident set size of the latest run.
+	LatestMaxRSS int64
+	// Ratio is the latest duration divided by the baseline, or zero if
+	// there is no baseline.
+	Ratio float64
+	// Regressed is true if the ratio exceeds the threshold.
+	Regressed bool
+}
+
+// stepSample is the duration and the resource usage of a run of a step.
+type stepSample struct {
+	duration time.Duration
+	usage    *executor.ResourceUsage
+}
+
+// AnalyzeTrend computes the trend of the statuses of the runs of a DAG. A
+// step is regressed if its latest duration is more than threshold times the
+// baseline. DefaultRegressionThreshold is used if threshold isn't greater
+// than 1.
+func AnalyzeTrend(statuses []Status, threshold float64) Trend {
+	if threshold <= 1 {
+		threshold = DefaultRegressionThreshold
+	}
+
+	runs := make([]RunTrend, 0, len(statuses))
+	for _, s := range statuses {
+		startedAt, _ := stringutil.ParseTime(s.StartedAt)
+		finishedAt, _ := stringutil.ParseTime(s.FinishedAt)
+		runs = append(runs, RunTrend{
+			RequestID: s.RequestID,
+			Status:    s.Status,
+			StartedAt: startedAt,
+			Duration:  elapsed(startedAt, finishedAt),
+		})
+	}
+	order := make([]int, len(statuses))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return runs[order[i]].StartedAt.After(runs[order[j]].StartedAt)
+	})
+
+	trend := Trend{Runs: make([]RunTrend, 0, len(runs))}
+	var names []string
+	samples := make(map[string][]stepSample)
+	for _, i := range order {
+		trend.Runs = append(trend.Runs, runs[i])
+		for _, n := range statuses[i].Nodes {
+			if n.Status != scheduler.NodeStatusSuccess {
+				continue
+			}
+			name := n.Step.Name
+			if _, ok := samples[name]; !ok {
+				names = append(names, name)
+			}
+			samples[name] = append(samples[name], stepSample{
+				duration: nodeDuration(n),
+				usage:    n.ResourceUsage,
+			})
+		}
+	}
+
+	for _, name := range names {
+		trend.Steps = append(trend.Steps, stepTrend(name, samples[name], threshold))
+	}
+	return trend
+}
+
+// stepTrend computes the trend of a step from the samples, the newest first.
+func stepTrend(name string, samples []stepSample, threshold float64) StepTrend {
+	st := StepTrend{
+		Name:   name,
+		Runs:   len(samples),
+		Latest: samples[0].duration,
+	}
+
+	var total, cpuTotal time.Duration
+	var measured int
+	for _, s := range samples {
+		total += s.duration
+		if s.usage == nil {
+			continue
+		}
+		if s.usage.MaxRSS > 0 {
+			cpuTotal += s.usage.UserCPUTime + s.usage.SystemCPUTime
+			measured++
+		}
+		st.MaxRSS = max(st.MaxRSS, s.usage.MaxRSS)
+	}
+	st.Average = total / time.Duration(len(samples))
+	if measured > 0 {
+		st.AverageCPUTime = cpuTotal / time.Duration(measured)
+	}
+	if u := samples[0].usage; u != nil {
+		st.LatestMaxRSS = u.MaxRSS
+	}
+
+	if len(samples) > 1 {
+		previous := make([]time.Duration, 0, len(samples)-1)
+		for _, s := range samples[1:] {
+			previous = append(previous, s.duration)
+		}
+		st.Baseline = median(previous)
+	}
+	if st.Baseline > 0 {
+		st.Ratio = float64(st.Latest) / float64(st.Baseline)
+		st.Regressed = st.Baseline >= minRegressionBaseline && st.Ratio > threshold
+	}
+	return st
+}
+
+// nodeDuration returns the wall time of the command of the node, or the
+// time between the start and the finish of the node if it's not measured.
+func nodeDuration(n *Node) time.Duration {
+	if n.ResourceUsage != nil && n.ResourceUsage.WallTime > 0 {
+		return n.ResourceUsage.WallTime
+	}
+	startedAt, _ := stringutil.ParseTime(n.StartedAt)
+	finishedAt, _ := stringutil.ParseTime(n.FinishedAt)
+	return elapsed(startedAt, finishedAt)
+}
+
+func elapsed(startedAt, finishedAt time.Time) time.Duration {
+	if startedAt.IsZero() || finishedAt.Before(startedAt) {
+		return 0
+	}
+	return finishedAt.Sub(startedAt)
+}
+
+func median(durations []time.Duration) time.Duration {
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
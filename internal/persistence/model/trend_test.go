@@ -0,0 +1,93 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/executor"
+	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/stringutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeTrend(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	run := func(requestID string, day int, durations map[string]time.Duration) Status {
+		startedAt := base.AddDate(0, 0, day)
+		status := Status{
+			RequestID: requestID,
+			Status:    scheduler.StatusSuccess,
+			StartedAt: stringutil.FormatTime(startedAt),
+		}
+		finishedAt := startedAt
+		for _, name := range []string{"extract", "load"} {
+			d, ok := durations[name]
+			if !ok {
+				continue
+			}
+			status.Nodes = append(status.Nodes, &Node{
+				Step:   digraph.Step{Name: name},
+				Status: scheduler.NodeStatusSuccess,
+				ResourceUsage: &executor.ResourceUsage{
+					UserCPUTime: d / 2,
+					MaxRSS:      int64(day+1) << 20,
+					WallTime:    d,
+				},
+			})
+			finishedAt = finishedAt.Add(d)
+		}
+		status.FinishedAt = stringutil.FormatTime(finishedAt)
+		return status
+	}
+
+	t.Run("Regression", func(t *testing.T) {
+		statuses := []Status{
+			run("1", 0, map[string]time.Duration{"extract": 10 * time.Second, "load": 100 * time.Millisecond}),
+			run("3", 2, map[string]time.Duration{"extract": 30 * time.Second, "load": time.Second}),
+			run("2", 1, map[string]time.Duration{"extract": 12 * time.Second, "load": 100 * time.Millisecond}),
+		}
+		trend := AnalyzeTrend(statuses, 0)
+
+		require.Len(t, trend.Runs, 3)
+		require.Equal(t, "3", trend.Runs[0].RequestID)
+		require.Equal(t, 31*time.Second, trend.Runs[0].Duration)
+
+		require.Len(t, trend.Steps, 2)
+		extract := trend.Steps[0]
+		require.Equal(t, "extract", extract.Name)
+		require.Equal(t, 3, extract.Runs)
+		require.Equal(t, 30*time.Second, extract.Latest)
+		require.Equal(t, 11*time.Second, extract.Baseline)
+		require.Equal(t, 52*time.Second/3, extract.Average)
+		require.Equal(t, int64(3<<20), extract.MaxRSS)
+		require.Equal(t, int64(3<<20), extract.LatestMaxRSS)
+		require.True(t, extract.Regressed)
+
+		// The baseline of load is too short to detect the regression.
+		load := trend.Steps[1]
+		require.Equal(t, "load", load.Name)
+		require.InDelta(t, 10, load.Ratio, 0.001)
+		require.False(t, load.Regressed)
+	})
+	t.Run("Threshold", func(t *testing.T) {
+		statuses := []Status{
+			run("2", 1, map[string]time.Duration{"extract": 15 * time.Second}),
+			run("1", 0, map[string]time.Duration{"extract": 10 * time.Second}),
+		}
+		require.False(t, AnalyzeTrend(statuses, 2).Steps[0].Regressed)
+		require.True(t, AnalyzeTrend(statuses, 1.2).Steps[0].Regressed)
+	})
+	t.Run("FailedStepsIgnored", func(t *testing.T) {
+		latest := run("2", 1, map[string]time.Duration{"extract": time.Minute})
+		latest.Nodes[0].Status = scheduler.NodeStatusError
+		statuses := []Status{
+			latest,
+			run("1", 0, map[string]time.Duration{"extract": 10 * time.Second}),
+		}
+		step := AnalyzeTrend(statuses, 0).Steps[0]
+		require.Equal(t, 1, step.Runs)
+		require.Zero(t, step.Baseline)
+		require.False(t, step.Regressed)
+	})
+}
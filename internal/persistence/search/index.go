@@ -0,0 +1,282 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/namespace"
+	"github.com/dagu-org/dagu/internal/persistence/grep"
+)
+
+// Weights of the terms by the field they appear in. A match in the name
+// ranks higher than a match in the commands of the steps.
+const (
+	weightName        = 4.0
+	weightTag         = 3.0
+	weightDescription = 2.0
+	weightStep        = 1.0
+)
+
+// prefixMatchRatio is the ratio of the weight given to the term that only
+// starts with the query term.
+const prefixMatchRatio = 0.5
+
+// Result is a DAG matching the query.
+type Result struct {
+	Name    string
+	DAG     *digraph.DAG
+	Score   float64
+	Matches []*grep.Match
+}
+
+// Index is an inverted index of the DAG files in the directories. It's kept in
+// memory and the changed files are indexed again before each search.
+type Index struct {
+	dirs []string
+
+	mu   sync.Mutex
+	docs map[string]*document
+	// postings maps a term to the weight of the term in each DAG file.
+	postings map[string]map[string]float64
+}
+
+type document struct {
+	name    string
+	modTime time.Time
+	data    []byte
+	dag     *digraph.DAG
+	err     error
+	terms   map[string]float64
+}
+
+// New creates an index of the DAG files in the directories.
+func New(dirs ...string) *Index {
+	return &Index{
+		dirs:     dirs,
+		docs:     make(map[string]*document),
+		postings: make(map[string]map[string]float64),
+	}
+}
+
+// Refresh indexes the files that were added or changed since the last
+// refresh and removes the deleted files from the index.
+func (idx *Index) Refresh(ctx context.Context) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	return idx.refresh(ctx)
+}
+
+func (idx *Index) refresh(ctx context.Context) error {
+	seen := make(map[string]bool)
+	for _, dir := range idx.dirs {
+		namespaces, err := namespace.List(dir)
+		if err != nil {
+			return err
+		}
+		for _, ns := range append([]string{""}, namespaces...) {
+			entries, err := os.ReadDir(filepath.Join(dir, ns))
+			if err != nil {
+				return err
+			}
+			for _, entry := range entries {
+				if entry.IsDir() || !namespace.IsDAGFile(entry.Name()) {
+					continue
+				}
+				file := filepath.Join(dir, ns, entry.Name())
+				seen[file] = true
+
+				info, err := entry.Info()
+				if err != nil {
+					continue
+				}
+				if doc, ok := idx.docs[file]; ok && doc.modTime.Equal(info.ModTime()) {
+					continue
+				}
+				idx.add(ctx, file, ns, info.ModTime())
+			}
+		}
+	}
+
+	for file := range idx.docs {
+		if !seen[file] {
+			idx.remove(file)
+		}
+	}
+	return nil
+}
+
+// add indexes the file, replacing the previous version if any.
+func (idx *Index) add(ctx context.Context, file, ns string, modTime time.Time) {
+	idx.remove(file)
+
+	doc := &document{
+		name:    namespace.Join(ns, strings.TrimSuffix(filepath.Base(file), path.Ext(file))),
+		modTime: modTime,
+		terms:   make(map[string]float64),
+	}
+	idx.docs[file] = doc
+
+	doc.data, doc.err = os.ReadFile(file)
+	if doc.err != nil {
+		return
+	}
+	doc.dag, doc.err = digraph.LoadYAML(ctx, doc.data, digraph.WithoutEval())
+	if doc.err != nil {
+		return
+	}
+	if doc.dag.Name == "" {
+		doc.dag.Name = strings.TrimSuffix(filepath.Base(file), path.Ext(file))
+	}
+	doc.dag.Location = file
+	doc.dag.Namespace = ns
+
+	addTerms(doc.terms, doc.name, weightName)
+	if doc.dag.Name != doc.name {
+		addTerms(doc.terms, doc.dag.Name, weightName)
+	}
+	for _, tag := range doc.dag.Tags {
+		addTerms(doc.terms, tag, weightTag)
+	}
+	addTerms(doc.terms, doc.dag.Description, weightDescription)
+	for _, step := range doc.dag.Steps {
+		addTerms(doc.terms, step.Name, weightStep)
+		addTerms(doc.terms, step.CmdWithArgs, weightStep)
+		addTerms(doc.terms, step.Script, weightStep)
+	}
+
+	for term, weight := range doc.terms {
+		if idx.postings[term] == nil {
+			idx.postings[term] = make(map[string]float64)
+		}
+		idx.postings[term][file] = weight
+	}
+}
+
+// remove removes the file from the index.
+func (idx *Index) remove(file string) {
+	doc, ok := idx.docs[file]
+	if !ok {
+		return
+	}
+	for term := range doc.terms {
+		delete(idx.postings[term], file)
+		if len(idx.postings[term]) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+	delete(idx.docs, file)
+}
+
+// Search returns the DAGs matching all the terms in the query ordered by
+// the score. A term in the query matches the terms starting with it. The
+// errors of the files failed to load are returned as errs.
+func (idx *Index) Search(ctx context.Context, query string) (ret []*Result, errs []string, err error) {
+	queryTerms := tokenize(query)
+	if len(queryTerms) == 0 {
+		return nil, nil, nil
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if err := idx.refresh(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	var scores map[string]float64
+	for i, q := range queryTerms {
+		matched := idx.match(q)
+		if i == 0 {
+			scores = matched
+			continue
+		}
+		for file, score := range scores {
+			if s, ok := matched[file]; ok {
+				scores[file] = score + s
+			} else {
+				delete(scores, file)
+			}
+		}
+	}
+
+	pattern := make([]string, 0, len(queryTerms))
+	for _, q := range queryTerms {
+		pattern = append(pattern, regexp.QuoteMeta(q))
+	}
+	grepPattern := fmt.Sprintf("(?i)(%s)", strings.Join(pattern, "|"))
+
+	for file, score := range scores {
+		doc := idx.docs[file]
+		matches, err := grep.Grep(doc.data, grepPattern, grep.DefaultOptions)
+		if err != nil {
+			// The terms may only appear in the name of the file.
+			matches = nil
+		}
+		ret = append(ret, &Result{
+			Name:    doc.name,
+			DAG:     doc.dag,
+			Score:   score,
+			Matches: matches,
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Score != ret[j].Score {
+			return ret[i].Score > ret[j].Score
+		}
+		return ret[i].Name < ret[j].Name
+	})
+
+	for _, doc := range idx.docs {
+		if doc.err != nil {
+			errs = append(errs, fmt.Sprintf("check %s failed: %s", doc.name, doc.err))
+		}
+	}
+	sort.Strings(errs)
+
+	return ret, errs, nil
+}
+
+// match returns the score of the files having the terms that match the
+// query term.
+func (idx *Index) match(q string) map[string]float64 {
+	scores := make(map[string]float64)
+	for term, files := range idx.postings {
+		ratio := 1.0
+		if term != q {
+			if !strings.HasPrefix(term, q) {
+				continue
+			}
+			ratio = prefixMatchRatio
+		}
+		for file, weight := range files {
+			if s := weight * ratio; s > scores[file] {
+				scores[file] = s
+			}
+		}
+	}
+	return scores
+}
+
+// addTerms adds the terms in the text with the weight.
+func addTerms(terms map[string]float64, text string, weight float64) {
+	for _, term := range tokenize(text) {
+		terms[term] += weight
+	}
+}
+
+// tokenize splits the text into lower case terms of letters and digits.
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
@@ -0,0 +1,108 @@
+package search
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndex_Search(t *testing.T) {
+	dir := t.TempDir()
+	writeDAG := func(name, spec string) {
+		t.Helper()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name+".yaml"), []byte(spec), 0600))
+	}
+
+	writeDAG("backup", `description: copy the database to the bucket
+tags: daily
+steps:
+  - name: dump
+    command: pg_dump mydb
+`)
+	writeDAG("report", `description: build the daily report from the backup
+steps:
+  - name: render
+    command: python report.py
+`)
+	writeDAG("cleanup", `steps:
+  - name: remove
+    command: rm -rf /tmp/backup
+`)
+
+	ctx := context.Background()
+	idx := New(dir)
+
+	t.Run("RankByField", func(t *testing.T) {
+		ret, errs, err := idx.Search(ctx, "backup")
+		require.NoError(t, err)
+		require.Empty(t, errs)
+		require.Len(t, ret, 3)
+		// The name ranks higher than the description and the commands.
+		require.Equal(t, "backup", ret[0].Name)
+		require.Equal(t, "report", ret[1].Name)
+		require.Equal(t, "cleanup", ret[2].Name)
+		require.NotEmpty(t, ret[1].Matches)
+	})
+	t.Run("AllTermsMustMatch", func(t *testing.T) {
+		ret, _, err := idx.Search(ctx, "daily backup")
+		require.NoError(t, err)
+		require.Len(t, ret, 2)
+		require.Equal(t, "backup", ret[0].Name)
+		require.Equal(t, "report", ret[1].Name)
+	})
+	t.Run("PrefixMatch", func(t *testing.T) {
+		ret, _, err := idx.Search(ctx, "pg_du")
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, "backup", ret[0].Name)
+	})
+	t.Run("RefreshOnChanges", func(t *testing.T) {
+		writeDAG("archive", `description: archive the old backup files
+steps:
+  - name: archive
+    command: tar czf archive.tgz data
+`)
+		writeDAG("cleanup", `steps:
+  - name: remove
+    command: rm -rf /tmp/cache
+`)
+		// Make the change visible regardless of the resolution of the
+		// modification time.
+		future := time.Now().Add(time.Minute)
+		require.NoError(t, os.Chtimes(filepath.Join(dir, "cleanup.yaml"), future, future))
+		require.NoError(t, os.Remove(filepath.Join(dir, "report.yaml")))
+
+		ret, _, err := idx.Search(ctx, "backup")
+		require.NoError(t, err)
+		var names []string
+		for _, r := range ret {
+			names = append(names, r.Name)
+		}
+		require.Equal(t, []string{"backup", "archive"}, names)
+	})
+	t.Run("InvalidDAG", func(t *testing.T) {
+		writeDAG("invalid", `steps: 1`)
+
+		_, errs, err := idx.Search(ctx, "backup")
+		require.NoError(t, err)
+		require.Len(t, errs, 1)
+	})
+	t.Run("MultipleDirs", func(t *testing.T) {
+		teamDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(teamDir, "restore.yaml"), []byte(`description: restore the backup
+steps:
+  - name: restore
+    command: pg_restore mydb
+`), 0600))
+
+		ret, _, err := New(dir, teamDir).Search(ctx, "restore")
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, "restore", ret[0].Name)
+		require.Equal(t, filepath.Join(teamDir, "restore.yaml"), ret[0].DAG.Location)
+	})
+}
@@ -0,0 +1,330 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/namespace"
+	"github.com/dagu-org/dagu/internal/persistence"
+	"github.com/dagu-org/dagu/internal/persistence/local"
+)
+
+var _ persistence.DAGStore = (*DAGStore)(nil)
+
+// DAGStore keeps the names and the tags of the DAG files in the database,
+// so that the DAGs are listed and filtered without parsing all the files.
+// The files are still the specs of the DAGs the agents run, so the other
+// operations are done on the files. The files changed since they were read
+// are read again when the DAGs are listed.
+type DAGStore struct {
+	persistence.DAGStore
+
+	db *sql.DB
+	// dirs is the directories of the DAG files.
+	dirs []string
+	// mu serializes the syncs of the process.
+	mu sync.Mutex
+}
+
+// NewDAGStore creates a DAG store of the DAG files in the directory and the
+// search paths of the options, keeping their metadata in the database
+// opened by Open.
+func NewDAGStore(db *sql.DB, dir string, opts ...local.DAGStoreOption) *DAGStore {
+	options := &local.DAGStoreOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return &DAGStore{
+		DAGStore: local.NewDAGStore(dir, opts...),
+		db:       db,
+		dirs:     append([]string{dir}, options.SearchPaths...),
+	}
+}
+
+// indexedDAG is a DAG file in the database.
+type indexedDAG struct {
+	name string
+	path string
+	// err is the error of reading the file, or empty.
+	err string
+}
+
+// ListPagination lists the DAGs matching the name and the tag in the
+// database, and reads only the DAGs of the page unless they're filtered.
+func (s *DAGStore) ListPagination(ctx context.Context, params persistence.DAGListPaginationArgs) (*persistence.DagListPaginationResult, error) {
+	errList, err := s.sync(ctx)
+	if err != nil {
+		return &persistence.DagListPaginationResult{
+			ErrorList: append(errList, err.Error()),
+		}, err
+	}
+
+	query := "SELECT name, path, error FROM dags WHERE instr(lower(name), lower(?)) > 0"
+	args := []any{params.Name}
+	if params.Tag != "" {
+		// The files not read have no tags, but their errors are listed.
+		query += " AND (error != '' OR EXISTS (SELECT 1 FROM dag_tags t WHERE t.path = dags.path AND lower(t.tag) = lower(?)))"
+		args = append(args, params.Tag)
+	}
+	dags, err := s.query(ctx, query+" ORDER BY name", args...)
+	if err != nil {
+		return &persistence.DagListPaginationResult{
+			ErrorList: append(errList, err.Error()),
+		}, err
+	}
+
+	var targets []indexedDAG
+	for _, dag := range dags {
+		if params.Namespaces != nil {
+			if ns, _ := namespace.Split(dag.name); !slices.Contains(params.Namespaces, ns) {
+				continue
+			}
+		}
+		if dag.err != "" {
+			errList = append(errList, fmt.Sprintf("reading %s failed: %s", dag.name, dag.err))
+			continue
+		}
+		targets = append(targets, dag)
+	}
+
+	start := min((params.Page-1)*params.Limit, len(targets))
+	if params.Filter == nil {
+		// Only the DAGs of the page are read.
+		page := targets[start:min(start+params.Limit, len(targets))]
+		dagList, errs, err := s.loadMetadata(ctx, page)
+		if err != nil {
+			return &persistence.DagListPaginationResult{
+				ErrorList: append(errList, err.Error()),
+			}, err
+		}
+		return &persistence.DagListPaginationResult{
+			DagList:   dagList,
+			Count:     len(targets),
+			ErrorList: append(errList, errs...),
+		}, nil
+	}
+
+	loaded, errs, err := s.loadMetadata(ctx, targets)
+	if err != nil {
+		return &persistence.DagListPaginationResult{
+			ErrorList: append(errList, err.Error()),
+		}, err
+	}
+	errList = append(errList, errs...)
+
+	var (
+		dagList []*digraph.DAG
+		count   int
+	)
+	for _, dag := range loaded {
+		if !params.Filter(dag) {
+			continue
+		}
+		count++
+		if count > (params.Page-1)*params.Limit && len(dagList) < params.Limit {
+			dagList = append(dagList, dag)
+		}
+	}
+	return &persistence.DagListPaginationResult{
+		DagList:   dagList,
+		Count:     count,
+		ErrorList: errList,
+	}, nil
+}
+
+// TagList lists the tags of the DAGs in the namespaces the user can access.
+func (s *DAGStore) TagList(ctx context.Context) ([]string, []string, error) {
+	errList, err := s.sync(ctx)
+	if err != nil {
+		return nil, append(errList, err.Error()), err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+SELECT d.name, d.error, coalesce(t.tag, '') FROM dags d
+LEFT JOIN dag_tags t ON t.path = d.path ORDER BY d.name`)
+	if err != nil {
+		return nil, append(errList, err.Error()), err
+	}
+	defer rows.Close()
+
+	tagList := []string{}
+	seen := make(map[string]bool)
+	for rows.Next() {
+		var name, readErr, tag string
+		if err := rows.Scan(&name, &readErr, &tag); err != nil {
+			return nil, append(errList, err.Error()), err
+		}
+		// Only the tags of the namespaces the user can access are listed.
+		if ns, _ := namespace.Split(name); !namespace.Allowed(ctx, ns) {
+			continue
+		}
+		if readErr != "" {
+			errList = append(errList, fmt.Sprintf("reading %s failed: %s", name, readErr))
+			continue
+		}
+		if tag != "" && !seen[tag] {
+			seen[tag] = true
+			tagList = append(tagList, tag)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, append(errList, err.Error()), err
+	}
+	return tagList, errList, nil
+}
+
+// sync updates the database with the DAG files. The files added or changed
+// since they were read are read, and the files removed are deleted. It
+// returns the errors of listing the files, e.g. the ambiguous names.
+func (s *DAGStore) sync(ctx context.Context) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	files, errList, err := local.ListDAGFiles(s.dirs)
+	if err != nil {
+		return errList, err
+	}
+
+	type fileState struct {
+		modTime int64
+		size    int64
+	}
+	indexed := make(map[string]fileState)
+	rows, err := s.db.QueryContext(ctx, "SELECT path, mod_time, size FROM dags")
+	if err != nil {
+		return errList, err
+	}
+	for rows.Next() {
+		var (
+			path  string
+			state fileState
+		)
+		if err := rows.Scan(&path, &state.modTime, &state.size); err != nil {
+			_ = rows.Close()
+			return errList, err
+		}
+		indexed[path] = state
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return errList, err
+	}
+
+	type changedFile struct {
+		local.DAGFile
+		state fileState
+		dag   *digraph.DAG
+		err   error
+	}
+	var changed []*changedFile
+	exists := make(map[string]bool, len(files))
+	for _, file := range files {
+		exists[file.Path] = true
+		info, err := os.Stat(file.Path)
+		if err != nil {
+			continue
+		}
+		state := fileState{modTime: info.ModTime().UnixNano(), size: info.Size()}
+		if prev, ok := indexed[file.Path]; ok && prev == state {
+			continue
+		}
+		changed = append(changed, &changedFile{DAGFile: file, state: state})
+	}
+
+	if err := persistence.ReadBatch(ctx, len(changed), func(i int) {
+		changed[i].dag, changed[i].err = s.GetMetadata(ctx, changed[i].Path)
+	}); err != nil {
+		return errList, err
+	}
+
+	err = inTx(ctx, s.db, func(tx *sql.Tx) error {
+		for path := range indexed {
+			if exists[path] {
+				continue
+			}
+			if _, err := tx.ExecContext(ctx, "DELETE FROM dags WHERE path = ?", path); err != nil {
+				return err
+			}
+		}
+		for _, file := range changed {
+			var readErr string
+			if file.err != nil {
+				readErr = file.err.Error()
+			}
+			if _, err := tx.ExecContext(ctx, `
+INSERT INTO dags (path, name, mod_time, size, error) VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (path) DO UPDATE SET name = excluded.name, mod_time = excluded.mod_time,
+	size = excluded.size, error = excluded.error`,
+				file.Path, file.Name, file.state.modTime, file.state.size, readErr,
+			); err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx, "DELETE FROM dag_tags WHERE path = ?", file.Path); err != nil {
+				return err
+			}
+			if file.dag == nil {
+				continue
+			}
+			for _, tag := range file.dag.Tags {
+				if _, err := tx.ExecContext(ctx,
+					"INSERT OR IGNORE INTO dag_tags (path, tag) VALUES (?, ?)", file.Path, tag,
+				); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	return errList, err
+}
+
+// query returns the DAGs of the query selecting the name, the path and the
+// error.
+func (s *DAGStore) query(ctx context.Context, query string, args ...any) ([]indexedDAG, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ret []indexedDAG
+	for rows.Next() {
+		var dag indexedDAG
+		if err := rows.Scan(&dag.name, &dag.path, &dag.err); err != nil {
+			return nil, err
+		}
+		ret = append(ret, dag)
+	}
+	return ret, rows.Err()
+}
+
+// loadMetadata loads the metadata of the DAG files in parallel. The DAGs
+// failed to be loaded, e.g. removed since the sync, are left out and
+// reported in errs.
+func (s *DAGStore) loadMetadata(ctx context.Context, dags []indexedDAG) ([]*digraph.DAG, []string, error) {
+	loaded := make([]*digraph.DAG, len(dags))
+	loadErrs := make([]error, len(dags))
+	if err := persistence.ReadBatch(ctx, len(dags), func(i int) {
+		loaded[i], loadErrs[i] = s.GetMetadata(ctx, dags[i].path)
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		ret  []*digraph.DAG
+		errs []string
+	)
+	for i, dag := range loaded {
+		if loadErrs[i] != nil {
+			errs = append(errs, fmt.Sprintf("reading %s failed: %s", dags[i].name, loadErrs[i]))
+			continue
+		}
+		ret = append(ret, dag)
+	}
+	return ret, errs, nil
+}
@@ -0,0 +1,106 @@
+package sqlite
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/persistence"
+	"github.com/dagu-org/dagu/internal/persistence/local"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDAGStore(t *testing.T) {
+	ctx := context.Background()
+	dagsDir := t.TempDir()
+	teamDir := t.TempDir()
+	writeDAG := func(dir, name, spec string) string {
+		t.Helper()
+		file := filepath.Join(dir, name+".yaml")
+		require.NoError(t, os.WriteFile(file, []byte(spec), 0600))
+		return file
+	}
+	writeDAG(dagsDir, "backup", "tags: daily\nsteps:\n  - name: step\n    command: \"true\"\n")
+	writeDAG(dagsDir, "cleanup", "tags: daily,cleanup\nsteps:\n  - name: step\n    command: \"true\"\n")
+	writeDAG(teamDir, "report", "tags: weekly\nsteps:\n  - name: step\n    command: \"true\"\n")
+	writeDAG(dagsDir, "broken", "steps: [")
+
+	db, err := Open(ctx, filepath.Join(t.TempDir(), FileName))
+	require.NoError(t, err)
+	defer db.Close()
+	store := NewDAGStore(db, dagsDir, local.WithSearchPaths(teamDir))
+
+	names := func(dags []*digraph.DAG) []string {
+		var ret []string
+		for _, dag := range dags {
+			ret = append(ret, dag.Name)
+		}
+		return ret
+	}
+	list := func(args persistence.DAGListPaginationArgs) *persistence.DagListPaginationResult {
+		t.Helper()
+		if args.Page == 0 {
+			args.Page, args.Limit = 1, 100
+		}
+		result, err := store.ListPagination(ctx, args)
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("List", func(t *testing.T) {
+		result := list(persistence.DAGListPaginationArgs{})
+		assert.Equal(t, []string{"backup", "cleanup", "report"}, names(result.DagList))
+		assert.Equal(t, 3, result.Count)
+		require.Len(t, result.ErrorList, 1)
+		assert.Contains(t, result.ErrorList[0], "broken")
+	})
+	t.Run("Page", func(t *testing.T) {
+		result := list(persistence.DAGListPaginationArgs{Page: 2, Limit: 2})
+		assert.Equal(t, []string{"report"}, names(result.DagList))
+		assert.Equal(t, 3, result.Count)
+	})
+	t.Run("NameAndTag", func(t *testing.T) {
+		result := list(persistence.DAGListPaginationArgs{Name: "UP"})
+		assert.Equal(t, []string{"backup", "cleanup"}, names(result.DagList))
+
+		result = list(persistence.DAGListPaginationArgs{Tag: "CLEANUP"})
+		assert.Equal(t, []string{"cleanup"}, names(result.DagList))
+	})
+	t.Run("Filter", func(t *testing.T) {
+		result := list(persistence.DAGListPaginationArgs{Page: 1, Limit: 1, Filter: func(dag *digraph.DAG) bool {
+			return dag.Name != "backup"
+		}})
+		assert.Equal(t, []string{"cleanup"}, names(result.DagList))
+		assert.Equal(t, 2, result.Count)
+	})
+	t.Run("TagList", func(t *testing.T) {
+		tags, errs, err := store.TagList(ctx)
+		require.NoError(t, err)
+		require.Len(t, errs, 1)
+		sort.Strings(tags)
+		assert.Equal(t, []string{"cleanup", "daily", "weekly"}, tags)
+	})
+	t.Run("Changes", func(t *testing.T) {
+		_, err := store.Create(ctx, "archive", []byte("tags: monthly\nsteps:\n  - name: step\n    command: \"true\"\n"))
+		require.NoError(t, err)
+		require.NoError(t, store.Delete(ctx, "backup"))
+		// The file is rewritten with the same size, so only its modification
+		// time tells the change.
+		file := filepath.Join(teamDir, "report.yaml")
+		require.NoError(t, os.WriteFile(file, []byte("tags: yearly\nsteps:\n  - name: step\n    command: \"true\"\n"), 0600))
+		require.NoError(t, os.Chtimes(file, time.Now(), time.Now().Add(time.Second)))
+
+		result := list(persistence.DAGListPaginationArgs{})
+		assert.Equal(t, []string{"archive", "cleanup", "report"}, names(result.DagList))
+
+		result = list(persistence.DAGListPaginationArgs{Tag: "yearly"})
+		assert.Equal(t, []string{"report"}, names(result.DagList))
+		result = list(persistence.DAGListPaginationArgs{Tag: "weekly"})
+		assert.Empty(t, result.DagList)
+	})
+}
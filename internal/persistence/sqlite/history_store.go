@@ -0,0 +1,370 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/crypt"
+	"github.com/dagu-org/dagu/internal/persistence"
+	"github.com/dagu-org/dagu/internal/persistence/model"
+)
+
+var (
+	errKeyEmpty    = errors.New("dagFile is empty")
+	errRunNotOpen  = errors.New("the run is not open")
+	errInvalidFile = errors.New("invalid status file")
+)
+
+// runFilePrefix is the prefix of the File of the model.StatusFile of a run,
+// followed by the ID of the run.
+const runFilePrefix = "sqlite:runs/"
+
+var _ persistence.HistoryStore = (*HistoryStore)(nil)
+
+// HistoryStore stores the statuses of the runs in the database. The keys
+// are the locations of the DAGs like the file store.
+type HistoryStore struct {
+	db                *sql.DB
+	latestStatusToday bool
+
+	// mu guards the run being written.
+	mu  sync.Mutex
+	run *openRun
+}
+
+// openRun is the run opened to write its status.
+type openRun struct {
+	key       string
+	requestID string
+	startedAt time.Time
+	// id is the ID of the row of the run, or zero until the first status is
+	// written.
+	id int64
+}
+
+type HistoryStoreOption func(*HistoryStore)
+
+// WithLatestStatusToday sets whether the latest status is only the status
+// of the run started today.
+func WithLatestStatusToday(latestStatusToday bool) HistoryStoreOption {
+	return func(s *HistoryStore) {
+		s.latestStatusToday = latestStatusToday
+	}
+}
+
+// NewHistoryStore creates a history store on the database opened by Open.
+func NewHistoryStore(db *sql.DB, opts ...HistoryStoreOption) *HistoryStore {
+	s := &HistoryStore{db: db, latestStatusToday: true}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *HistoryStore) Open(_ context.Context, key string, timestamp time.Time, requestID string) error {
+	if key == "" {
+		return errKeyEmpty
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.run = &openRun{key: key, requestID: requestID, startedAt: timestamp}
+	return nil
+}
+
+// Write writes the status of the open run. The row of the run is created
+// by the first write, so the runs without any status are not stored.
+func (s *HistoryStore) Write(ctx context.Context, status model.Status) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.run == nil {
+		return errRunNotOpen
+	}
+	data, err := encodeStatus(status)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UnixMilli()
+
+	if s.run.id != 0 {
+		_, err := s.db.ExecContext(ctx,
+			"UPDATE runs SET status = ?, updated_at = ? WHERE id = ?", data, now, s.run.id,
+		)
+		return err
+	}
+	res, err := s.db.ExecContext(ctx,
+		"INSERT INTO runs (dag_key, request_id, started_at, updated_at, status) VALUES (?, ?, ?, ?, ?)",
+		s.run.key, s.run.requestID, s.run.startedAt.UnixMilli(), now, data,
+	)
+	if err != nil {
+		return err
+	}
+	s.run.id, err = res.LastInsertId()
+	return err
+}
+
+func (s *HistoryStore) Close(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.run = nil
+	return nil
+}
+
+func (s *HistoryStore) Update(ctx context.Context, key, requestID string, status model.Status) error {
+	if requestID == "" {
+		return fmt.Errorf("%w : %s", persistence.ErrRequestIDNotFound, requestID)
+	}
+	data, err := encodeStatus(status)
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, `
+UPDATE runs SET status = ?, updated_at = ? WHERE id = (
+	SELECT id FROM runs WHERE dag_key = ? AND request_id = ? ORDER BY started_at DESC, id DESC LIMIT 1
+)`, data, time.Now().UnixMilli(), key, requestID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("%w : %s", persistence.ErrRequestIDNotFound, requestID)
+	}
+	return nil
+}
+
+func (s *HistoryStore) ReadStatusRecent(ctx context.Context, key string, itemLimit int) []model.StatusFile {
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT id, status FROM runs WHERE dag_key = ? ORDER BY started_at DESC, id DESC LIMIT ?", key, itemLimit,
+	)
+	if err != nil {
+		return nil
+	}
+	defer rows.Close()
+
+	var ret []model.StatusFile
+	for rows.Next() {
+		var (
+			id   int64
+			data []byte
+		)
+		if err := rows.Scan(&id, &data); err != nil {
+			continue
+		}
+		status, err := decodeStatus(data)
+		if err != nil {
+			continue
+		}
+		ret = append(ret, model.StatusFile{File: runFile(id), Status: *status})
+	}
+	return ret
+}
+
+// ReadStatusToday reads the status of the latest run. It returns
+// ErrNoStatusDataToday if the DAG has no run, or if the latest run started
+// before today when only the statuses of today are read.
+func (s *HistoryStore) ReadStatusToday(ctx context.Context, key string) (*model.Status, error) {
+	var (
+		startedAt int64
+		data      []byte
+	)
+	err := s.db.QueryRowContext(ctx,
+		"SELECT started_at, status FROM runs WHERE dag_key = ? ORDER BY started_at DESC, id DESC LIMIT 1", key,
+	).Scan(&startedAt, &data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, persistence.ErrNoStatusDataToday
+	}
+	if err != nil {
+		return nil, err
+	}
+	startOfDay := time.Now().UTC().Truncate(24 * time.Hour)
+	if s.latestStatusToday && time.UnixMilli(startedAt).Before(startOfDay) {
+		return nil, persistence.ErrNoStatusDataToday
+	}
+	return decodeStatus(data)
+}
+
+// BatchReadLatest reads the latest statuses of the DAGs. Each of them is a
+// query on the index of the runs, so they're read one by one.
+func (s *HistoryStore) BatchReadLatest(ctx context.Context, keys []string) []persistence.LatestStatus {
+	ret := make([]persistence.LatestStatus, len(keys))
+	for i, key := range keys {
+		status, err := s.ReadStatusToday(ctx, key)
+		ret[i] = persistence.LatestStatus{Status: status, Err: err}
+	}
+	return ret
+}
+
+func (s *HistoryStore) FindByRequestID(ctx context.Context, key string, requestID string) (*model.StatusFile, error) {
+	if requestID == "" {
+		return nil, fmt.Errorf("%w : %s", persistence.ErrRequestIDNotFound, requestID)
+	}
+	var (
+		id   int64
+		data []byte
+	)
+	err := s.db.QueryRowContext(ctx,
+		"SELECT id, status FROM runs WHERE dag_key = ? AND request_id = ? ORDER BY started_at DESC, id DESC LIMIT 1",
+		key, requestID,
+	).Scan(&id, &data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w : %s", persistence.ErrRequestIDNotFound, requestID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	status, err := decodeStatus(data)
+	if err != nil {
+		return nil, err
+	}
+	return &model.StatusFile{File: runFile(id), Status: *status}, nil
+}
+
+// ReadStatusFile reads the status of the run in the File returned by the
+// store.
+func (s *HistoryStore) ReadStatusFile(ctx context.Context, file string) (*model.Status, error) {
+	id, err := strconv.ParseInt(strings.TrimPrefix(file, runFilePrefix), 10, 64)
+	if err != nil || !strings.HasPrefix(file, runFilePrefix) {
+		return nil, fmt.Errorf("%w: %s", errInvalidFile, file)
+	}
+	var data []byte
+	err = s.db.QueryRowContext(ctx, "SELECT status FROM runs WHERE id = ?", id).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", persistence.ErrNoStatusData, file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decodeStatus(data)
+}
+
+func (s *HistoryStore) RemoveAll(ctx context.Context, key string) error {
+	return inTx(ctx, s.db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM runs WHERE dag_key = ?", key); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, "DELETE FROM idempotency_keys WHERE dag_key = ?", key)
+		return err
+	})
+}
+
+// RemoveOld removes the runs last updated before the retention days and
+// the idempotency keys reserved before them.
+func (s *HistoryStore) RemoveOld(ctx context.Context, key string, retentionDays int) error {
+	if retentionDays < 0 {
+		return nil
+	}
+	oldDate := time.Now().AddDate(0, 0, -retentionDays).UnixMilli()
+	return inTx(ctx, s.db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx,
+			"DELETE FROM runs WHERE dag_key = ? AND updated_at < ?", key, oldDate,
+		); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx,
+			"DELETE FROM idempotency_keys WHERE dag_key = ? AND created_at < ?", key, oldDate,
+		)
+		return err
+	})
+}
+
+func (s *HistoryStore) Rename(ctx context.Context, oldKey, newKey string) error {
+	if !filepath.IsAbs(oldKey) || !filepath.IsAbs(newKey) {
+		return fmt.Errorf("invalid path: %s -> %s", oldKey, newKey)
+	}
+	return inTx(ctx, s.db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx,
+			"UPDATE runs SET dag_key = ? WHERE dag_key = ?", newKey, oldKey,
+		); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx,
+			"UPDATE OR REPLACE idempotency_keys SET dag_key = ? WHERE dag_key = ?", newKey, oldKey,
+		)
+		return err
+	})
+}
+
+// ReserveIdempotencyKey reserves the idempotency key of the DAG for the run
+// with the request ID. The key is the primary key of its row, so only one
+// of the concurrent starts with the same key reserves it. It returns the
+// request ID of the run the key is reserved for, which is requestID if the
+// key was not reserved yet.
+func (s *HistoryStore) ReserveIdempotencyKey(ctx context.Context, key, idempotencyKey, requestID string) (string, error) {
+	if key == "" {
+		return "", errKeyEmpty
+	}
+	var reserved string
+	err := inTx(ctx, s.db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO idempotency_keys (dag_key, idempotency_key, request_id, created_at) VALUES (?, ?, ?, ?)
+ON CONFLICT (dag_key, idempotency_key) DO NOTHING`,
+			key, idempotencyKey, requestID, time.Now().UnixMilli(),
+		); err != nil {
+			return err
+		}
+		return tx.QueryRowContext(ctx,
+			"SELECT request_id FROM idempotency_keys WHERE dag_key = ? AND idempotency_key = ?",
+			key, idempotencyKey,
+		).Scan(&reserved)
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to reserve the idempotency key: %w", err)
+	}
+	return reserved, nil
+}
+
+// ReleaseIdempotencyKey releases the idempotency key reserved for the run
+// with the request ID. The key reserved for another run is kept.
+func (s *HistoryStore) ReleaseIdempotencyKey(ctx context.Context, key, idempotencyKey, requestID string) error {
+	if key == "" {
+		return errKeyEmpty
+	}
+	_, err := s.db.ExecContext(ctx,
+		"DELETE FROM idempotency_keys WHERE dag_key = ? AND idempotency_key = ? AND request_id = ?",
+		key, idempotencyKey, requestID,
+	)
+	return err
+}
+
+// inTx runs the function in a transaction committed if it succeeds.
+func inTx(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// encodeStatus encodes the status in JSON, encrypted if the encryption is
+// enabled like the status files.
+func encodeStatus(status model.Status) ([]byte, error) {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return nil, err
+	}
+	return crypt.SealLine(data), nil
+}
+
+func decodeStatus(data []byte) (*model.Status, error) {
+	data, err := crypt.OpenLine(data)
+	if err != nil {
+		return nil, err
+	}
+	return model.StatusFromJSON(string(data))
+}
+
+func runFile(id int64) string {
+	return runFilePrefix + strconv.FormatInt(id, 10)
+}
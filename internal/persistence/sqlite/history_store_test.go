@@ -0,0 +1,179 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/persistence"
+	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openTestDB(t *testing.T) (string, *HistoryStore) {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), FileName)
+	db, err := Open(context.Background(), file)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	return file, NewHistoryStore(db)
+}
+
+func writeRun(t *testing.T, store *HistoryStore, dag *digraph.DAG, startedAt time.Time, requestID string, status scheduler.Status) {
+	t.Helper()
+
+	ctx := context.Background()
+	require.NoError(t, store.Open(ctx, dag.Location, startedAt, requestID))
+	st := model.NewStatusFactory(dag).Create(requestID, scheduler.StatusRunning, 0, startedAt)
+	require.NoError(t, store.Write(ctx, st))
+	st.Status = status
+	require.NoError(t, store.Write(ctx, st))
+	require.NoError(t, store.Close(ctx))
+}
+
+func TestHistoryStore(t *testing.T) {
+	ctx := context.Background()
+	_, store := openTestDB(t)
+	dag := &digraph.DAG{Name: "test", Location: "/dags/test.yaml"}
+	other := &digraph.DAG{Name: "other", Location: "/dags/other.yaml"}
+
+	_, err := store.ReadStatusToday(ctx, dag.Location)
+	require.ErrorIs(t, err, persistence.ErrNoStatusDataToday)
+
+	now := time.Now()
+	writeRun(t, store, dag, now.Add(-time.Minute*2), "request-1", scheduler.StatusError)
+	writeRun(t, store, dag, now.Add(-time.Minute), "request-2", scheduler.StatusSuccess)
+	writeRun(t, store, other, now, "request-3", scheduler.StatusSuccess)
+
+	t.Run("ReadStatusToday", func(t *testing.T) {
+		status, err := store.ReadStatusToday(ctx, dag.Location)
+		require.NoError(t, err)
+		assert.Equal(t, "request-2", status.RequestID)
+		assert.Equal(t, scheduler.StatusSuccess, status.Status)
+	})
+	t.Run("ReadStatusRecent", func(t *testing.T) {
+		files := store.ReadStatusRecent(ctx, dag.Location, 10)
+		require.Len(t, files, 2)
+		assert.Equal(t, "request-2", files[0].Status.RequestID)
+		assert.Equal(t, "request-1", files[1].Status.RequestID)
+
+		status, err := store.ReadStatusFile(ctx, files[1].File)
+		require.NoError(t, err)
+		assert.Equal(t, "request-1", status.RequestID)
+
+		_, err = store.ReadStatusFile(ctx, "/data/test.dat")
+		require.ErrorIs(t, err, errInvalidFile)
+	})
+	t.Run("BatchReadLatest", func(t *testing.T) {
+		results := store.BatchReadLatest(ctx, []string{other.Location, "/dags/missing.yaml"})
+		require.Len(t, results, 2)
+		require.NoError(t, results[0].Err)
+		assert.Equal(t, "request-3", results[0].Status.RequestID)
+		assert.ErrorIs(t, results[1].Err, persistence.ErrNoStatusDataToday)
+	})
+	t.Run("FindByRequestIDAndUpdate", func(t *testing.T) {
+		file, err := store.FindByRequestID(ctx, dag.Location, "request-1")
+		require.NoError(t, err)
+		assert.Equal(t, scheduler.StatusError, file.Status.Status)
+
+		file.Status.Status = scheduler.StatusSuccess
+		require.NoError(t, store.Update(ctx, dag.Location, "request-1", file.Status))
+		file, err = store.FindByRequestID(ctx, dag.Location, "request-1")
+		require.NoError(t, err)
+		assert.Equal(t, scheduler.StatusSuccess, file.Status.Status)
+
+		_, err = store.FindByRequestID(ctx, dag.Location, "missing")
+		require.ErrorIs(t, err, persistence.ErrRequestIDNotFound)
+		err = store.Update(ctx, dag.Location, "missing", file.Status)
+		require.ErrorIs(t, err, persistence.ErrRequestIDNotFound)
+	})
+	t.Run("Rename", func(t *testing.T) {
+		renamed := "/dags/renamed.yaml"
+		require.NoError(t, store.Rename(ctx, dag.Location, renamed))
+		assert.Empty(t, store.ReadStatusRecent(ctx, dag.Location, 10))
+		assert.Len(t, store.ReadStatusRecent(ctx, renamed, 10), 2)
+		require.NoError(t, store.Rename(ctx, renamed, dag.Location))
+
+		require.Error(t, store.Rename(ctx, "test", "renamed"))
+	})
+	t.Run("RemoveOld", func(t *testing.T) {
+		require.NoError(t, store.RemoveOld(ctx, dag.Location, 1))
+		assert.Len(t, store.ReadStatusRecent(ctx, dag.Location, 10), 2)
+
+		require.NoError(t, store.RemoveAll(ctx, dag.Location))
+		assert.Empty(t, store.ReadStatusRecent(ctx, dag.Location, 10))
+		// The histories of the other DAGs are kept.
+		assert.Len(t, store.ReadStatusRecent(ctx, other.Location, 10), 1)
+	})
+}
+
+func TestHistoryStore_LatestStatusToday(t *testing.T) {
+	ctx := context.Background()
+	_, store := openTestDB(t)
+	dag := &digraph.DAG{Name: "test", Location: "/dags/test.yaml"}
+
+	writeRun(t, store, dag, time.Now().AddDate(0, 0, -2), "request-1", scheduler.StatusSuccess)
+
+	_, err := store.ReadStatusToday(ctx, dag.Location)
+	require.ErrorIs(t, err, persistence.ErrNoStatusDataToday)
+
+	store.latestStatusToday = false
+	status, err := store.ReadStatusToday(ctx, dag.Location)
+	require.NoError(t, err)
+	assert.Equal(t, "request-1", status.RequestID)
+}
+
+func TestHistoryStore_IdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+	file, store := openTestDB(t)
+	key := "/dags/test.yaml"
+
+	// The processes starting the runs open the database on their own.
+	const n = 10
+	var (
+		wg       sync.WaitGroup
+		reserved = make([]string, n)
+	)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			db, err := Open(ctx, file)
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer db.Close()
+			reserved[i], err = NewHistoryStore(db).ReserveIdempotencyKey(ctx, key, "key-1", fmt.Sprintf("request-%d", i))
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+	for _, id := range reserved {
+		assert.Equal(t, reserved[0], id)
+	}
+
+	// The key reserved for another run is kept.
+	require.NoError(t, store.ReleaseIdempotencyKey(ctx, key, "key-1", "another"))
+	id, err := store.ReserveIdempotencyKey(ctx, key, "key-1", "request-x")
+	require.NoError(t, err)
+	assert.Equal(t, reserved[0], id)
+
+	require.NoError(t, store.ReleaseIdempotencyKey(ctx, key, "key-1", reserved[0]))
+	id, err = store.ReserveIdempotencyKey(ctx, key, "key-1", "request-x")
+	require.NoError(t, err)
+	assert.Equal(t, "request-x", id)
+
+	// The keys are removed with the histories.
+	require.NoError(t, store.RemoveAll(ctx, key))
+	id, err = store.ReserveIdempotencyKey(ctx, key, "key-1", "request-y")
+	require.NoError(t, err)
+	assert.Equal(t, "request-y", id)
+}
@@ -0,0 +1,98 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// migrations are the statements migrating the schema from each version to
+// the next one. The version of the schema is the user_version of the
+// database, i.e. the number of the migrations applied. The migrations
+// released must not be changed; add a new one instead.
+var migrations = []string{
+	// 1: the runs and the metadata of the DAGs.
+	`
+CREATE TABLE runs (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	dag_key TEXT NOT NULL,
+	request_id TEXT NOT NULL,
+	-- started_at is the time the run was opened in Unix milliseconds.
+	started_at INTEGER NOT NULL,
+	updated_at INTEGER NOT NULL,
+	status BLOB NOT NULL
+);
+CREATE INDEX runs_dag_key_started_at ON runs (dag_key, started_at);
+CREATE INDEX runs_dag_key_request_id ON runs (dag_key, request_id);
+
+CREATE TABLE dags (
+	path TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	-- mod_time and size tell whether the file changed since it was read.
+	mod_time INTEGER NOT NULL,
+	size INTEGER NOT NULL,
+	-- error is the error of reading the file, or empty.
+	error TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX dags_name ON dags (name);
+
+CREATE TABLE dag_tags (
+	path TEXT NOT NULL REFERENCES dags (path) ON DELETE CASCADE,
+	tag TEXT NOT NULL,
+	PRIMARY KEY (path, tag)
+);
+`,
+	// 2: the idempotency keys reserved for the runs.
+	`
+CREATE TABLE idempotency_keys (
+	dag_key TEXT NOT NULL,
+	idempotency_key TEXT NOT NULL,
+	request_id TEXT NOT NULL,
+	created_at INTEGER NOT NULL,
+	PRIMARY KEY (dag_key, idempotency_key)
+);
+`,
+}
+
+// migrate applies the migrations not applied to the database yet. Each
+// migration is applied in a transaction with the version, so the processes
+// opening the database at the same time apply it only once.
+func migrate(ctx context.Context, db *sql.DB) error {
+	for {
+		done, err := migrateNext(ctx, db)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// migrateNext applies the next migration. It returns true if the schema is
+// already the latest.
+func migrateNext(ctx context.Context, db *sql.DB) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var version int
+	if err := tx.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version); err != nil {
+		return false, err
+	}
+	if version > len(migrations) {
+		return false, fmt.Errorf("the schema version %d is newer than the latest version %d", version, len(migrations))
+	}
+	if version == len(migrations) {
+		return true, nil
+	}
+	if _, err := tx.ExecContext(ctx, migrations[version]); err != nil {
+		return false, fmt.Errorf("migration %d failed: %w", version+1, err)
+	}
+	// PRAGMA doesn't take the parameters.
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("PRAGMA user_version = %d", version+1)); err != nil {
+		return false, err
+	}
+	return false, tx.Commit()
+}
@@ -0,0 +1,30 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), "data", FileName)
+
+	db, err := Open(ctx, file)
+	require.NoError(t, err)
+	var version int
+	require.NoError(t, db.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version))
+	require.Equal(t, len(migrations), version)
+
+	// The migrations applied are not applied again.
+	require.NoError(t, migrate(ctx, db))
+
+	// The database of a newer version is not opened.
+	_, err = db.ExecContext(ctx, "PRAGMA user_version = 1000")
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	_, err = Open(ctx, file)
+	require.ErrorContains(t, err, "newer than the latest version")
+}
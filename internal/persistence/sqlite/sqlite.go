@@ -0,0 +1,45 @@
+// Package sqlite stores the run histories and the metadata of the DAGs in a
+// SQLite database, so that a single binary can run without the status files.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	// Register the "sqlite" driver.
+	_ "modernc.org/sqlite"
+)
+
+// FileName is the name of the database file in the data directory.
+const FileName = "dagu.db"
+
+// Open opens the database file and migrates its schema to the latest
+// version. The file is created if it doesn't exist.
+func Open(ctx context.Context, file string) (*sql.DB, error) {
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create the directory of %s: %w", file, err)
+	}
+
+	// The database is shared by the server, the scheduler and the agents,
+	// so the writers wait for each other instead of failing as busy. The
+	// transactions take the write lock when they begin, since a read lock
+	// can't be upgraded while another process is writing.
+	query := url.Values{}
+	query.Add("_pragma", "busy_timeout(10000)")
+	query.Add("_pragma", "journal_mode(WAL)")
+	query.Add("_pragma", "foreign_keys(1)")
+	query.Add("_txlock", "immediate")
+	db, err := sql.Open("sqlite", "file:"+file+"?"+query.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", file, err)
+	}
+	if err := migrate(ctx, db); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to migrate %s: %w", file, err)
+	}
+	return db, nil
+}
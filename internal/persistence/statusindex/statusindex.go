@@ -0,0 +1,334 @@
+// Package statusindex keeps the latest statuses of the DAGs in memory so
+// that the server can list the DAGs without reading the status files.
+package statusindex
+
+import (
+	"context"
+	"crypto/md5" // nolint // gosec
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/persistence"
+	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/dagu-org/dagu/internal/sock"
+)
+
+// Index is a history store keeping the latest status of each DAG in memory.
+// The statuses are read from the underlying store when they are requested
+// for the first time (or the first time in a day) and refreshed when the
+// agents notify the server that the status of a DAG has changed.
+type Index struct {
+	persistence.HistoryStore
+
+	mu      sync.RWMutex
+	entries map[string]entry
+	// changes is the time the latest status of each DAG last changed.
+	changes map[string]time.Time
+	// createdAt is the time the index was created. The changes before it
+	// are unknown.
+	createdAt time.Time
+	// gens is the generation of the entry of each DAG, incremented on each
+	// change of the entry. The statuses read while the entry changed are
+	// not stored as they may be older than the change.
+	gens map[string]uint64
+
+	// observer is called with the status read on each notification.
+	observer func(key string, status *model.Status)
+}
+
+type entry struct {
+	latest persistence.LatestStatus
+	// day is the day the status was read. The entries of the previous days
+	// are read again as the latest status of today may differ.
+	day string
+}
+
+var (
+	_ persistence.HistoryStore        = (*Index)(nil)
+	_ persistence.StatusChangeTracker = (*Index)(nil)
+)
+
+// Option is an option of the index.
+type Option func(*Index)
+
+// WithObserver sets the function called with the latest status of the DAG
+// each time an agent notifies the change, e.g. to collect the metrics.
+func WithObserver(fn func(key string, status *model.Status)) Option {
+	return func(idx *Index) {
+		idx.observer = fn
+	}
+}
+
+// New creates a new index on top of the history store.
+func New(store persistence.HistoryStore, opts ...Option) *Index {
+	idx := &Index{
+		HistoryStore: store,
+		entries:      make(map[string]entry),
+		changes:      make(map[string]time.Time),
+		createdAt:    time.Now(),
+		gens:         make(map[string]uint64),
+	}
+	for _, opt := range opts {
+		opt(idx)
+	}
+	return idx
+}
+
+// BatchReadLatest returns the latest statuses from memory. Only the DAGs
+// not in the index yet are read from the underlying store.
+func (idx *Index) BatchReadLatest(ctx context.Context, keys []string) []persistence.LatestStatus {
+	ret := make([]persistence.LatestStatus, len(keys))
+	today := dayOf(time.Now())
+
+	var (
+		missing []int
+		gens    []uint64
+	)
+	idx.mu.RLock()
+	for i, key := range keys {
+		if e, ok := idx.entries[key]; ok && e.day == today {
+			ret[i] = e.latest
+			continue
+		}
+		missing = append(missing, i)
+		gens = append(gens, idx.gens[key])
+	}
+	idx.mu.RUnlock()
+
+	if len(missing) == 0 {
+		return ret
+	}
+
+	missingKeys := make([]string, 0, len(missing))
+	for _, i := range missing {
+		missingKeys = append(missingKeys, keys[i])
+	}
+	results := idx.HistoryStore.BatchReadLatest(ctx, missingKeys)
+	for j, i := range missing {
+		ret[i] = results[j]
+		idx.store(keys[i], results[j], today, gens[j])
+	}
+	return ret
+}
+
+// ReadStatusToday reads the latest status from the underlying store and
+// updates the index with it.
+func (idx *Index) ReadStatusToday(ctx context.Context, key string) (*model.Status, error) {
+	idx.mu.RLock()
+	gen := idx.gens[key]
+	idx.mu.RUnlock()
+	return idx.readStatusToday(ctx, key, gen)
+}
+
+func (idx *Index) readStatusToday(ctx context.Context, key string, gen uint64) (*model.Status, error) {
+	status, err := idx.HistoryStore.ReadStatusToday(ctx, key)
+	idx.store(key, persistence.LatestStatus{Status: status, Err: err}, dayOf(time.Now()), gen)
+	return status, err
+}
+
+// Refresh reads the latest status of the DAG again. The statuses being
+// read when it's called are older than the change notified, so they're
+// not stored.
+func (idx *Index) Refresh(ctx context.Context, key string) error {
+	idx.mu.Lock()
+	idx.gens[key]++
+	gen := idx.gens[key]
+	idx.mu.Unlock()
+
+	status, err := idx.readStatusToday(ctx, key, gen)
+	if err == nil && idx.observer != nil {
+		idx.observer(key, status)
+	}
+	if errors.Is(err, persistence.ErrNoStatusData) || errors.Is(err, persistence.ErrNoStatusDataToday) {
+		return nil
+	}
+	return err
+}
+
+func (idx *Index) Update(ctx context.Context, key, requestID string, status model.Status) error {
+	defer idx.invalidate(key)
+	return idx.HistoryStore.Update(ctx, key, requestID, status)
+}
+
+func (idx *Index) RemoveAll(ctx context.Context, key string) error {
+	defer idx.forget(key)
+	return idx.HistoryStore.RemoveAll(ctx, key)
+}
+
+func (idx *Index) RemoveOld(ctx context.Context, key string, retentionDays int) error {
+	defer idx.invalidate(key)
+	return idx.HistoryStore.RemoveOld(ctx, key, retentionDays)
+}
+
+func (idx *Index) Rename(ctx context.Context, oldKey, newKey string) error {
+	defer idx.forget(oldKey)
+	defer idx.invalidate(newKey)
+	return idx.HistoryStore.Rename(ctx, oldKey, newKey)
+}
+
+// ChangedSince returns true if the latest status of the DAG changed at or
+// after the time. It's also true if the changes are unknown, i.e. the time
+// is before the index was created or the status has not been read yet.
+func (idx *Index) ChangedSince(key string, since time.Time) bool {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	if since.Before(idx.createdAt) {
+		return true
+	}
+	changedAt, ok := idx.changes[key]
+	return !ok || !changedAt.Before(since)
+}
+
+// MarkChanged records that the DAG changed now, e.g. it was suspended.
+func (idx *Index) MarkChanged(key string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.changes[key] = time.Now()
+}
+
+// store stores the result of reading the latest status if the entry has
+// not changed since the generation the read started at. The errors other
+// than having no status are not stored so that the status is read again.
+func (idx *Index) store(key string, latest persistence.LatestStatus, day string, gen uint64) {
+	if latest.Err != nil &&
+		!errors.Is(latest.Err, persistence.ErrNoStatusData) &&
+		!errors.Is(latest.Err, persistence.ErrNoStatusDataToday) {
+		idx.invalidate(key)
+		return
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	if idx.gens[key] != gen {
+		return
+	}
+	idx.gens[key]++
+	if prev, ok := idx.entries[key]; !ok || !sameStatus(prev.latest, latest) {
+		idx.changes[key] = time.Now()
+	}
+	idx.entries[key] = entry{latest: latest, day: day}
+}
+
+// invalidate removes the statuses so that they are read again, and records
+// the change as the status may differ when it's read.
+func (idx *Index) invalidate(keys ...string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	now := time.Now()
+	for _, key := range keys {
+		delete(idx.entries, key)
+		idx.changes[key] = now
+		idx.gens[key]++
+	}
+}
+
+// forget removes everything about the DAGs removed from the store but
+// their generations, which tell the reads overlapping the removal.
+func (idx *Index) forget(keys ...string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for _, key := range keys {
+		delete(idx.entries, key)
+		delete(idx.changes, key)
+		idx.gens[key]++
+	}
+}
+
+// sameStatus returns true if the statuses are listed in the same way.
+func sameStatus(a, b persistence.LatestStatus) bool {
+	if a.Status == nil || b.Status == nil {
+		return a.Status == nil && b.Status == nil && errors.Is(a.Err, persistence.ErrNoStatusData) == errors.Is(b.Err, persistence.ErrNoStatusData)
+	}
+	x, y := a.Status, b.Status
+	return x.RequestID == y.RequestID &&
+		x.Status == y.Status &&
+		x.PID == y.PID &&
+		x.StartedAt == y.StartedAt &&
+		x.FinishedAt == y.FinishedAt &&
+		x.Log == y.Log &&
+		x.Params == y.Params &&
+		maps.Equal(x.Labels, y.Labels)
+}
+
+func dayOf(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
+
+var refreshRe = regexp.MustCompile(`^/statuses/refresh[/]?$`)
+
+// Serve listens on the unix socket for the notifications from the agents
+// until the context is canceled.
+func (idx *Index) Serve(ctx context.Context, addr string) error {
+	srv, err := sock.NewServer(addr, func(w http.ResponseWriter, r *http.Request) {
+		idx.handleHTTP(ctx, w, r)
+	})
+	if err != nil {
+		return err
+	}
+	listen := make(chan error, 1)
+	go func() {
+		if err := <-listen; err != nil {
+			return
+		}
+		<-ctx.Done()
+		_ = srv.Shutdown(context.WithoutCancel(ctx))
+	}()
+	if err := srv.Serve(ctx, listen); err != nil && !errors.Is(err, sock.ErrServerRequestedShutdown) {
+		return err
+	}
+	return nil
+}
+
+func (idx *Index) handleHTTP(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "text/plain")
+	if r.Method != http.MethodPost || !refreshRe.MatchString(r.URL.Path) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("Not found"))
+		return
+	}
+	key := r.URL.Query().Get("key")
+	if key == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("key is required"))
+		return
+	}
+	if err := idx.Refresh(ctx, key); err != nil {
+		logger.Error(ctx, "Failed to refresh the status index", "key", key, "err", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("OK"))
+}
+
+// Notify tells the server listening on the address that the status of the
+// DAG has changed.
+func Notify(addr, key string) error {
+	resp, err := sock.NewClient(addr).Request(
+		http.MethodPost, "/statuses/refresh?key="+url.QueryEscape(key),
+	)
+	if err != nil {
+		return err
+	}
+	if resp != "OK" {
+		return fmt.Errorf("failed to refresh the status index: %s", resp)
+	}
+	return nil
+}
+
+// SockAddr returns the address of the unix socket the server listens on
+// for the data directory. The servers with different data directories
+// listen on different sockets.
+func SockAddr(dataDir string) string {
+	hash := md5.Sum([]byte(filepath.Clean(dataDir))) // nolint // gosec
+	return filepath.Join("/tmp", fmt.Sprintf("@dagu-server-%x.sock", hash))
+}
@@ -0,0 +1,207 @@
+package statusindex_test
+
+import (
+	"context"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/persistence"
+	"github.com/dagu-org/dagu/internal/persistence/jsondb"
+	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/dagu-org/dagu/internal/persistence/statusindex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeStatus(t *testing.T, store persistence.HistoryStore, dag *digraph.DAG, requestID string, status scheduler.Status) {
+	t.Helper()
+
+	ctx := context.Background()
+	require.NoError(t, store.Open(ctx, dag.Location, time.Now(), requestID))
+	st := model.NewStatusFactory(dag).Create(requestID, status, 0, time.Now())
+	require.NoError(t, store.Write(ctx, st))
+	require.NoError(t, store.Close(ctx))
+}
+
+func TestIndex(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+	dag := &digraph.DAG{Name: "test", Location: filepath.Join(tmpDir, "test.yaml")}
+	other := &digraph.DAG{Name: "other", Location: filepath.Join(tmpDir, "other.yaml")}
+	keys := []string{dag.Location, other.Location}
+
+	// The agents write the statuses through their own stores.
+	agentStore := jsondb.New(tmpDir)
+	observed := make(chan string, 10)
+	idx := statusindex.New(jsondb.New(tmpDir), statusindex.WithObserver(func(_ string, status *model.Status) {
+		observed <- status.RequestID
+	}))
+
+	writeStatus(t, agentStore, dag, "request-1", scheduler.StatusRunning)
+
+	results := idx.BatchReadLatest(ctx, keys)
+	require.Len(t, results, 2)
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, "request-1", results[0].Status.RequestID)
+	assert.ErrorIs(t, results[1].Err, persistence.ErrNoStatusDataToday)
+
+	t.Run("ServeFromMemory", func(t *testing.T) {
+		writeStatus(t, agentStore, dag, "request-2", scheduler.StatusSuccess)
+
+		results := idx.BatchReadLatest(ctx, keys)
+		assert.Equal(t, "request-1", results[0].Status.RequestID)
+	})
+	t.Run("Notify", func(t *testing.T) {
+		addr := filepath.Join(tmpDir, "server.sock")
+		serveCtx, cancel := context.WithCancel(ctx)
+		done := make(chan error)
+		go func() {
+			done <- idx.Serve(serveCtx, addr)
+		}()
+		require.Eventually(t, func() bool {
+			return statusindex.Notify(addr, dag.Location) == nil
+		}, time.Second*5, time.Millisecond*50)
+
+		results := idx.BatchReadLatest(ctx, keys)
+		assert.Equal(t, "request-2", results[0].Status.RequestID)
+		assert.Equal(t, scheduler.StatusSuccess, results[0].Status.Status)
+		// The observer is called only on the notifications.
+		assert.Equal(t, "request-2", <-observed)
+		assert.Empty(t, observed)
+
+		cancel()
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+		case <-time.After(time.Second * 5):
+			t.Fatal("the server did not stop")
+		}
+	})
+	t.Run("InvalidateOnUpdate", func(t *testing.T) {
+		writeStatus(t, agentStore, other, "request-3", scheduler.StatusError)
+
+		results := idx.BatchReadLatest(ctx, keys)
+		assert.ErrorIs(t, results[1].Err, persistence.ErrNoStatusDataToday)
+
+		st := *results[0].Status
+		st.Status = scheduler.StatusError
+		require.NoError(t, idx.Update(ctx, dag.Location, st.RequestID, st))
+
+		results = idx.BatchReadLatest(ctx, keys)
+		assert.Equal(t, scheduler.StatusError, results[0].Status.Status)
+		// The status of the other DAG is still kept in memory.
+		assert.ErrorIs(t, results[1].Err, persistence.ErrNoStatusDataToday)
+	})
+}
+
+// blockingStore blocks the first batch read after reading the statuses
+// until it's released.
+type blockingStore struct {
+	persistence.HistoryStore
+	blocked atomic.Bool
+	read    chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingStore) BatchReadLatest(ctx context.Context, keys []string) []persistence.LatestStatus {
+	ret := s.HistoryStore.BatchReadLatest(ctx, keys)
+	if s.blocked.CompareAndSwap(false, true) {
+		close(s.read)
+		<-s.release
+	}
+	return ret
+}
+
+func TestIndex_RefreshDuringBatchRead(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+	dag := &digraph.DAG{Name: "test", Location: filepath.Join(tmpDir, "test.yaml")}
+	keys := []string{dag.Location}
+
+	agentStore := jsondb.New(tmpDir)
+	store := &blockingStore{
+		HistoryStore: jsondb.New(tmpDir),
+		read:         make(chan struct{}),
+		release:      make(chan struct{}),
+	}
+	idx := statusindex.New(store)
+
+	writeStatus(t, agentStore, dag, "request-1", scheduler.StatusRunning)
+
+	done := make(chan []persistence.LatestStatus)
+	go func() {
+		done <- idx.BatchReadLatest(ctx, keys)
+	}()
+	<-store.read
+
+	// The agent notifies the new status while the old one is being read.
+	writeStatus(t, agentStore, dag, "request-2", scheduler.StatusSuccess)
+	require.NoError(t, idx.Refresh(ctx, dag.Location))
+	close(store.release)
+	results := <-done
+	assert.Equal(t, "request-1", results[0].Status.RequestID)
+
+	// The status read before the notification doesn't replace the newer one.
+	results = idx.BatchReadLatest(ctx, keys)
+	assert.Equal(t, "request-2", results[0].Status.RequestID)
+	assert.Equal(t, scheduler.StatusSuccess, results[0].Status.Status)
+}
+
+func TestIndex_ChangedSince(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+	dag := &digraph.DAG{Name: "test", Location: filepath.Join(tmpDir, "test.yaml")}
+	other := &digraph.DAG{Name: "other", Location: filepath.Join(tmpDir, "other.yaml")}
+	keys := []string{dag.Location, other.Location}
+
+	agentStore := jsondb.New(tmpDir)
+	idx := statusindex.New(jsondb.New(tmpDir))
+
+	writeStatus(t, agentStore, dag, "request-1", scheduler.StatusSuccess)
+
+	// The changes before the index was created are unknown.
+	assert.True(t, idx.ChangedSince(dag.Location, time.Now().Add(-time.Hour)))
+
+	// The statuses not read yet are unknown.
+	since := time.Now()
+	assert.True(t, idx.ChangedSince(dag.Location, since))
+
+	_ = idx.BatchReadLatest(ctx, keys)
+	since = time.Now()
+	assert.False(t, idx.ChangedSince(dag.Location, since))
+	assert.False(t, idx.ChangedSince(other.Location, since))
+
+	t.Run("SameStatus", func(t *testing.T) {
+		require.NoError(t, idx.Refresh(ctx, dag.Location))
+		assert.False(t, idx.ChangedSince(dag.Location, since))
+	})
+	t.Run("StatusChanged", func(t *testing.T) {
+		writeStatus(t, agentStore, dag, "request-2", scheduler.StatusSuccess)
+		require.NoError(t, idx.Refresh(ctx, dag.Location))
+		assert.True(t, idx.ChangedSince(dag.Location, since))
+		assert.False(t, idx.ChangedSince(other.Location, since))
+	})
+	t.Run("MarkChanged", func(t *testing.T) {
+		since := time.Now()
+		idx.MarkChanged(other.Location)
+		assert.True(t, idx.ChangedSince(other.Location, since))
+	})
+	t.Run("RemoveAll", func(t *testing.T) {
+		since := time.Now()
+		require.NoError(t, idx.RemoveAll(ctx, dag.Location))
+		// The DAG is unknown again after it's removed.
+		assert.True(t, idx.ChangedSince(dag.Location, since))
+
+		_ = idx.BatchReadLatest(ctx, keys)
+		assert.False(t, idx.ChangedSince(dag.Location, time.Now()))
+	})
+}
+
+func TestSockAddr(t *testing.T) {
+	assert.Equal(t, statusindex.SockAddr("/data"), statusindex.SockAddr("/data/"))
+	assert.NotEqual(t, statusindex.SockAddr("/data"), statusindex.SockAddr("/other"))
+}
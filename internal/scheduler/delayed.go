@@ -0,0 +1,50 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/persistence"
+)
+
+// delayedStartInterval is the interval to check the runs registered to be
+// started later. It bounds how late the runs are started.
+const delayedStartInterval = 5 * time.Second
+
+// delayedStarter periodically starts the runs registered to be started
+// later once they are due.
+type delayedStarter struct {
+	interval time.Duration
+	start    func(ctx context.Context, now time.Time) ([]persistence.DelayedStart, error)
+}
+
+// run starts the due runs on start and then at the interval until the
+// context is canceled or the stop channel is closed.
+func (d *delayedStarter) run(ctx context.Context, stop <-chan struct{}) {
+	d.startOnce(ctx)
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			d.startOnce(ctx)
+		case <-stop:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (d *delayedStarter) startOnce(ctx context.Context) {
+	started, err := d.start(ctx, time.Now())
+	if err != nil {
+		logger.Error(ctx, "Failed to start the delayed runs", "err", err)
+	}
+	for _, run := range started {
+		logger.Info(ctx, "Started the delayed run", "DAG", run.DAG, "reqId", run.RequestID, "startAt", run.StartAt.Format(time.RFC3339))
+	}
+}
@@ -10,10 +10,9 @@ import (
 	"time"
 
 	"github.com/dagu-org/dagu/internal/client"
-	"github.com/dagu-org/dagu/internal/fileutil"
 	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/namespace"
 	"github.com/dagu-org/dagu/internal/scheduler/filenotify"
-	"github.com/robfig/cron/v3"
 
 	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/fsnotify/fsnotify"
@@ -22,20 +21,22 @@ import (
 var _ entryReader = (*entryReaderImpl)(nil)
 
 type entryReaderImpl struct {
-	dagsDir    string
-	dagsLock   sync.Mutex
+	// dagsDirs is the directories of the DAGs in the order of precedence.
+	dagsDirs []string
+	dagsLock sync.Mutex
+	// dags is the DAGs by the path of the file.
 	dags       map[string]*digraph.DAG
 	jobCreator jobCreator
 	client     client.Client
 }
 
 type jobCreator interface {
-	CreateJob(dag *digraph.DAG, next time.Time, schedule cron.Schedule) job
+	CreateJob(dag *digraph.DAG, next time.Time, schedule digraph.Schedule) job
 }
 
-func newEntryReader(dagsDir string, jobCreator jobCreator, client client.Client) *entryReaderImpl {
+func newEntryReader(dagsDirs []string, jobCreator jobCreator, client client.Client) *entryReaderImpl {
 	return &entryReaderImpl{
-		dagsDir:    dagsDir,
+		dagsDirs:   dagsDirs,
 		dagsLock:   sync.Mutex{},
 		dags:       map[string]*digraph.DAG{},
 		jobCreator: jobCreator,
@@ -60,20 +61,29 @@ func (er *entryReaderImpl) Read(ctx context.Context, now time.Time) ([]*entry, e
 		for _, schedule := range schedules {
 			next := schedule.Parsed.Next(now)
 			entries = append(entries, &entry{
-				Next:      schedule.Parsed.Next(now),
-				Job:       er.jobCreator.CreateJob(dag, next, schedule.Parsed),
+				Next:      next,
+				Job:       er.jobCreator.CreateJob(dag, next, schedule),
 				EntryType: entryType,
 			})
 		}
 	}
 
+	// The DAGs with the same name in more than one directory are not
+	// scheduled because the suspension and the history can't tell them
+	// apart.
+	files := make(map[string][]string)
+	for file, dag := range er.dags {
+		files[dag.ID()] = append(files[dag.ID()], file)
+	}
+
 	for _, dag := range er.dags {
-		id := strings.TrimSuffix(
-			filepath.Base(dag.Location),
-			filepath.Ext(dag.Location),
-		)
+		id := dag.ID()
+		if len(files[id]) > 1 {
+			logger.Error(ctx, "DAG is not scheduled because the name is ambiguous", "DAG", id, "files", strings.Join(files[id], ","))
+			continue
+		}
 
-		if er.client.IsSuspended(ctx, id) {
+		if er.isSuspended(ctx, id, now) {
 			continue
 		}
 		addEntriesFn(dag, dag.Schedule, entryTypeStart)
@@ -84,30 +94,96 @@ func (er *entryReaderImpl) Read(ctx context.Context, now time.Time) ([]*entry, e
 	return entries, nil
 }
 
+// isSuspended returns true if the DAG is suspended. An expired suspension
+// is lifted so that the DAG is scheduled again.
+func (er *entryReaderImpl) isSuspended(ctx context.Context, id string, now time.Time) bool {
+	suspension, err := er.client.GetSuspension(ctx, id)
+	if err != nil {
+		logger.Error(ctx, "Failed to read the suspension", "dag", id, "err", err)
+		return er.client.IsSuspended(ctx, id)
+	}
+	if suspension == nil {
+		return false
+	}
+	if suspension.Expired(now) {
+		logger.Info(ctx, "Suspension expired; resuming the DAG", "dag", id, "reason", suspension.Reason)
+		if err := er.client.ToggleSuspend(ctx, id, false); err != nil {
+			logger.Error(ctx, "Failed to resume the DAG", "dag", id, "err", err)
+		}
+		return false
+	}
+	return true
+}
+
 func (er *entryReaderImpl) initDAGs(ctx context.Context) error {
 	er.dagsLock.Lock()
 	defer er.dagsLock.Unlock()
 
-	fis, err := os.ReadDir(er.dagsDir)
+	var ids []string
+	for _, dir := range er.dagsDirs {
+		namespaces, err := namespace.List(dir)
+		if err != nil {
+			return err
+		}
+		for _, ns := range append([]string{""}, namespaces...) {
+			loaded, err := er.loadDir(ctx, filepath.Join(dir, ns))
+			if err != nil {
+				return err
+			}
+			ids = append(ids, loaded...)
+		}
+	}
+
+	logger.Info(ctx, "Scheduler initialized", "specs", strings.Join(ids, ","))
+	return nil
+}
+
+// loadDir loads the DAGs in the directory of the DAGs or a namespace and
+// returns their IDs.
+func (er *entryReaderImpl) loadDir(ctx context.Context, dir string) ([]string, error) {
+	fis, err := os.ReadDir(dir)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	var fileNames []string
+	var ids []string
 	for _, fi := range fis {
-		if fileutil.IsYAMLFile(fi.Name()) {
-			dag, err := digraph.Load(ctx, filepath.Join(er.dagsDir, fi.Name()), digraph.OnlyMetadata(), digraph.WithoutEval())
+		if namespace.IsDAGFile(fi.Name()) {
+			dag, err := er.load(ctx, filepath.Join(dir, fi.Name()))
 			if err != nil {
 				logger.Error(ctx, "DAG load failed", "err", err, "DAG", fi.Name())
 				continue
 			}
-			er.dags[fi.Name()] = dag
-			fileNames = append(fileNames, fi.Name())
+			ids = append(ids, dag.ID())
 		}
 	}
+	return ids, nil
+}
 
-	logger.Info(ctx, "Scheduler initialized", "specs", strings.Join(fileNames, ","))
-	return nil
+// load loads the metadata of the DAG file.
+func (er *entryReaderImpl) load(ctx context.Context, filePath string) (*digraph.DAG, error) {
+	dag, err := digraph.Load(ctx, filePath, digraph.OnlyMetadata(), digraph.WithoutEval(),
+		digraph.WithNamespace(namespace.Of(er.dagsDirs, filePath)))
+	if err != nil {
+		return nil, err
+	}
+	er.dags[filePath] = dag
+	return dag, nil
+}
+
+// isNamespaceDir returns whether the path is the directory of a namespace.
+func (er *entryReaderImpl) isNamespaceDir(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || !info.IsDir() || !namespace.Valid(filepath.Base(path)) {
+		return false
+	}
+	parent := filepath.Dir(path)
+	for _, dir := range er.dagsDirs {
+		if filepath.Clean(dir) == parent {
+			return true
+		}
+	}
+	return false
 }
 
 func (er *entryReaderImpl) watchDags(ctx context.Context, done chan any) {
@@ -120,7 +196,13 @@ func (er *entryReaderImpl) watchDags(ctx context.Context, done chan any) {
 	defer func() {
 		_ = watcher.Close()
 	}()
-	_ = watcher.Add(er.dagsDir)
+	for _, dir := range er.dagsDirs {
+		_ = watcher.Add(dir)
+		namespaces, _ := namespace.List(dir)
+		for _, ns := range namespaces {
+			_ = watcher.Add(filepath.Join(dir, ns))
+		}
+	}
 
 	for {
 		select {
@@ -130,22 +212,34 @@ func (er *entryReaderImpl) watchDags(ctx context.Context, done chan any) {
 			if !ok {
 				return
 			}
-			if !fileutil.IsYAMLFile(event.Name) {
+			filePath := filepath.Clean(event.Name)
+			if event.Op == fsnotify.Create && er.isNamespaceDir(filePath) {
+				// Watch the new namespace and load the DAGs already in it.
+				_ = watcher.Add(filePath)
+				er.dagsLock.Lock()
+				ids, err := er.loadDir(ctx, filePath)
+				er.dagsLock.Unlock()
+				if err != nil {
+					logger.Error(ctx, "Namespace load failed", "err", err, "dir", filePath)
+				} else {
+					logger.Info(ctx, "Namespace added", "dir", filePath, "DAGs", strings.Join(ids, ","))
+				}
+				continue
+			}
+			if !namespace.IsDAGFile(event.Name) {
 				continue
 			}
 			er.dagsLock.Lock()
 			if event.Op == fsnotify.Create || event.Op == fsnotify.Write {
-				filePath := filepath.Join(er.dagsDir, filepath.Base(event.Name))
-				dag, err := digraph.Load(ctx, filePath, digraph.OnlyMetadata(), digraph.WithoutEval())
+				dag, err := er.load(ctx, filePath)
 				if err != nil {
 					logger.Error(ctx, "DAG load failed", "err", err, "file", event.Name)
 				} else {
-					er.dags[filepath.Base(event.Name)] = dag
-					logger.Info(ctx, "DAG added/updated", "DAG", filepath.Base(event.Name))
+					logger.Info(ctx, "DAG added/updated", "DAG", dag.ID())
 				}
 			}
 			if event.Op == fsnotify.Rename || event.Op == fsnotify.Remove {
-				delete(er.dags, filepath.Base(event.Name))
+				delete(er.dags, filePath)
 				logger.Info(ctx, "DAG removed", "DAG", filepath.Base(event.Name))
 			}
 			er.dagsLock.Unlock()
@@ -10,6 +10,7 @@ import (
 	"github.com/dagu-org/dagu/internal/build"
 	"github.com/dagu-org/dagu/internal/client"
 	"github.com/dagu-org/dagu/internal/fileutil"
+	"github.com/dagu-org/dagu/internal/persistence"
 	"github.com/dagu-org/dagu/internal/persistence/jsondb"
 	"github.com/dagu-org/dagu/internal/persistence/local"
 	"github.com/dagu-org/dagu/internal/persistence/local/storage"
@@ -28,7 +29,7 @@ func TestReadEntries(t *testing.T) {
 
 		now := time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC).Add(-time.Second)
 		entryReader := newEntryReader(
-			filepath.Join(testdataDir, "invalid_directory"),
+			[]string{filepath.Join(testdataDir, "invalid_directory")},
 			&mockJobFactory{},
 			cli,
 		)
@@ -38,7 +39,7 @@ func TestReadEntries(t *testing.T) {
 		require.Len(t, entries, 0)
 
 		entryReader = newEntryReader(
-			testdataDir,
+			[]string{testdataDir},
 			&mockJobFactory{},
 			cli,
 		)
@@ -73,6 +74,129 @@ func TestReadEntries(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, len(entries)-1, len(lives))
 	})
+	t.Run("SuspensionExpiry", func(t *testing.T) {
+		tmpDir, cli := setupTest(t)
+		defer func() {
+			_ = os.RemoveAll(tmpDir)
+		}()
+
+		entryReader := newEntryReader([]string{testdataDir}, &mockJobFactory{}, cli)
+		done := make(chan any)
+		defer close(done)
+		require.NoError(t, entryReader.Start(context.Background(), done))
+
+		now := time.Now()
+		entries, err := entryReader.Read(context.Background(), now)
+		require.NoError(t, err)
+
+		err = cli.Suspend(context.Background(), "scheduled_job", persistence.Suspension{
+			Reason:    "maintenance",
+			ExpiresAt: now.Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		lives, err := entryReader.Read(context.Background(), now)
+		require.NoError(t, err)
+		require.Equal(t, len(entries)-1, len(lives))
+
+		// The suspension is lifted after the expiry.
+		lives, err = entryReader.Read(context.Background(), now.Add(2*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, len(entries), len(lives))
+		suspension, err := cli.GetSuspension(context.Background(), "scheduled_job")
+		require.NoError(t, err)
+		require.Nil(t, suspension)
+	})
+	t.Run("SearchPaths", func(t *testing.T) {
+		tmpDir, cli := setupTest(t)
+		defer func() {
+			_ = os.RemoveAll(tmpDir)
+		}()
+
+		teamDir := filepath.Join(tmpDir, "team")
+		require.NoError(t, os.MkdirAll(teamDir, 0755))
+		spec := []byte("schedule: \"* * * * *\"\nsteps:\n  - name: step\n    command: \"true\"\n")
+		require.NoError(t, os.WriteFile(filepath.Join(teamDir, "team_job.yaml"), spec, 0600))
+		// The name is also in the testdata directory.
+		require.NoError(t, os.WriteFile(filepath.Join(teamDir, "scheduled_job.yaml"), spec, 0600))
+
+		entryReader := newEntryReader([]string{testdataDir, teamDir}, &mockJobFactory{}, cli)
+		done := make(chan any)
+		defer close(done)
+		require.NoError(t, entryReader.Start(context.Background(), done))
+
+		entries, err := entryReader.Read(context.Background(), time.Now())
+		require.NoError(t, err)
+		names := make(map[string]bool)
+		for _, e := range entries {
+			names[e.Job.GetDAG(context.Background()).Name] = true
+		}
+		require.True(t, names["team_job"])
+		// The DAGs with the ambiguous name are not scheduled.
+		require.False(t, names["scheduled_job"])
+	})
+	t.Run("Namespace", func(t *testing.T) {
+		tmpDir, cli := setupTest(t)
+		defer func() {
+			_ = os.RemoveAll(tmpDir)
+		}()
+
+		dagsDir := filepath.Join(tmpDir, "dags")
+		spec := []byte("schedule: \"* * * * *\"\nsteps:\n  - name: step\n    command: \"true\"\n")
+		// The same name in different namespaces is not ambiguous.
+		for _, dir := range []string{dagsDir, filepath.Join(dagsDir, "team-a")} {
+			require.NoError(t, os.MkdirAll(dir, 0755))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "etl.yaml"), spec, 0600))
+		}
+
+		entryReader := newEntryReader([]string{dagsDir}, &mockJobFactory{}, cli)
+		done := make(chan any)
+		defer close(done)
+		require.NoError(t, entryReader.Start(context.Background(), done))
+
+		entries, err := entryReader.Read(context.Background(), time.Now())
+		require.NoError(t, err)
+		var ids []string
+		for _, e := range entries {
+			ids = append(ids, e.Job.GetDAG(context.Background()).ID())
+		}
+		require.ElementsMatch(t, []string{"etl", "team-a/etl"}, ids)
+	})
+	t.Run("MultipleSchedules", func(t *testing.T) {
+		tmpDir, cli := setupTest(t)
+		defer func() {
+			_ = os.RemoveAll(tmpDir)
+		}()
+
+		dagsDir := filepath.Join(tmpDir, "dags")
+		require.NoError(t, os.MkdirAll(dagsDir, 0755))
+		spec := []byte("schedule:\n  - \"0 9 * * MON-FRI\"\n  - \"0 22 * * SAT\"\nsteps:\n  - name: step\n    command: \"true\"\n")
+		require.NoError(t, os.WriteFile(filepath.Join(dagsDir, "report.yaml"), spec, 0600))
+
+		entryReader := newEntryReader([]string{dagsDir}, &jobCreatorImpl{Client: cli}, cli)
+		done := make(chan any)
+		defer close(done)
+		require.NoError(t, entryReader.Start(context.Background(), done))
+
+		// Friday
+		now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.Local)
+		entries, err := entryReader.Read(context.Background(), now)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+
+		// Each schedule has its own entry to record the schedule of the run.
+		nexts := make(map[string]time.Time)
+		for _, e := range entries {
+			j, ok := e.Job.(*jobImpl)
+			require.True(t, ok)
+			require.Equal(t, e.Next, j.Next)
+			nexts[j.Schedule.Expression] = e.Next
+		}
+		require.Equal(t, map[string]time.Time{
+			"0 9 * * MON-FRI": time.Date(2024, 3, 4, 9, 0, 0, 0, time.Local),
+			"0 22 * * SAT":    time.Date(2024, 3, 2, 22, 0, 0, 0, time.Local),
+		}, nexts)
+	})
 }
 
 var testdataDir = filepath.Join(fileutil.MustGetwd(), "testdata")
@@ -100,5 +224,9 @@ func setupTest(t *testing.T) (string, client.Client) {
 		storage.NewStorage(cfg.Paths.SuspendFlagsDir),
 	)
 
-	return tmpDir, client.New(dagStore, historyStore, flagStore, "", cfg.WorkDir)
+	delayedStartStore := local.NewDelayedStartStore(
+		storage.NewStorage(filepath.Join(tmpDir, local.DelayedStartDirName)),
+	)
+
+	return tmpDir, client.New(dagStore, historyStore, flagStore, delayedStartStore, "", cfg.WorkDir)
 }
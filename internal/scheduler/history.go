@@ -0,0 +1,46 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/logger"
+)
+
+// historyCompactor periodically rolls up the status files of the old runs
+// into the monthly summaries of the DAGs.
+type historyCompactor struct {
+	interval  time.Duration
+	afterDays int
+	rollUp    func(ctx context.Context, before time.Time) (int, error)
+}
+
+// run compacts the histories on start and then at the interval until the
+// context is canceled or the stop channel is closed.
+func (c *historyCompactor) run(ctx context.Context, stop <-chan struct{}) {
+	c.compactOnce(ctx)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.compactOnce(ctx)
+		case <-stop:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *historyCompactor) compactOnce(ctx context.Context) {
+	n, err := c.rollUp(ctx, time.Now().AddDate(0, 0, -c.afterDays))
+	if err != nil {
+		logger.Error(ctx, "Failed to compact the histories", "err", err)
+	}
+	if n > 0 {
+		logger.Info(ctx, "Rolled up the old status files into the monthly summaries", "files", n)
+	}
+}
@@ -9,8 +9,8 @@ import (
 	"github.com/dagu-org/dagu/internal/client"
 	"github.com/dagu-org/dagu/internal/digraph"
 	dagscheduler "github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/persistence/model"
 	"github.com/dagu-org/dagu/internal/stringutil"
-	"github.com/robfig/cron/v3"
 )
 
 var (
@@ -28,7 +28,7 @@ type jobCreatorImpl struct {
 	Client     client.Client
 }
 
-func (jf jobCreatorImpl) CreateJob(dag *digraph.DAG, next time.Time, schedule cron.Schedule) job {
+func (jf jobCreatorImpl) CreateJob(dag *digraph.DAG, next time.Time, schedule digraph.Schedule) job {
 	return &jobImpl{
 		DAG:        dag,
 		Executable: jf.Executable,
@@ -46,8 +46,10 @@ type jobImpl struct {
 	Executable string
 	WorkDir    string
 	Next       time.Time
-	Schedule   cron.Schedule
-	Client     client.Client
+	// Schedule is the schedule of the DAG the job is created for. A DAG
+	// with more than one schedule has a job for each of them.
+	Schedule digraph.Schedule
+	Client   client.Client
 }
 
 func (j *jobImpl) GetDAG(_ context.Context) *digraph.DAG {
@@ -87,7 +89,15 @@ func (j *jobImpl) Start(ctx context.Context) error {
 		}
 	}
 
-	return j.Client.Start(ctx, j.DAG, client.StartOptions{Quiet: true})
+	// The time of the schedule is the logical date of the run, which may
+	// differ from the time the run actually starts.
+	return j.Client.Start(ctx, j.DAG, client.StartOptions{
+		Quiet:         true,
+		Trigger:       model.TriggerSchedule,
+		Schedule:      j.Schedule.Expression,
+		ScheduledTime: j.Next,
+		ExecutionDate: j.Next,
+	})
 }
 
 func (j *jobImpl) Prev(_ context.Context) time.Time {
@@ -95,7 +105,7 @@ func (j *jobImpl) Prev(_ context.Context) time.Time {
 	// we need to do it manually.
 	// The idea is to get the next schedule time and subtract the duration of the schedule.
 	// This will give us the previous schedule time.
-	t := j.Schedule.Next(j.Next.Add(time.Second))
+	t := j.Schedule.Parsed.Next(j.Next.Add(time.Second))
 	return j.Next.Add(-t.Sub(j.Next))
 }
 
@@ -107,7 +117,8 @@ func (j *jobImpl) Stop(ctx context.Context) error {
 	if latestStatus.Status != dagscheduler.StatusRunning {
 		return errJobIsNotRunning
 	}
-	return j.Client.Stop(ctx, j.DAG)
+	_, err = j.Client.Stop(ctx, j.DAG, latestStatus.RequestID)
+	return err
 }
 
 func (j *jobImpl) Restart(ctx context.Context) error {
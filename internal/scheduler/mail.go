@@ -0,0 +1,44 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/mailer"
+)
+
+// mailRedeliverer periodically sends the mails that the agents queued but
+// could not deliver before they exited.
+type mailRedeliverer struct {
+	interval  time.Duration
+	redeliver func(ctx context.Context) (mailer.RedeliverResult, error)
+}
+
+// run redelivers the mails at the interval until the context is canceled or
+// the stop channel is closed.
+func (r *mailRedeliverer) run(ctx context.Context, stop <-chan struct{}) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.redeliverOnce(ctx)
+		case <-stop:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *mailRedeliverer) redeliverOnce(ctx context.Context) {
+	ret, err := r.redeliver(ctx)
+	if err != nil {
+		logger.Error(ctx, "Failed to redeliver the mails", "err", err)
+	}
+	if ret.Delivered > 0 || ret.Failed > 0 || ret.Dead > 0 {
+		logger.Info(ctx, "Redelivered the queued mails", "delivered", ret.Delivered, "failed", ret.Failed, "dead", ret.Dead)
+	}
+}
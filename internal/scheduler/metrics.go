@@ -0,0 +1,169 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/metrics"
+)
+
+// Metrics contains the metrics of the scheduler service.
+// The metrics are exposed in the Prometheus text format.
+type Metrics struct {
+	mu sync.Mutex
+
+	// entriesLoaded is the number of entries read at the last tick.
+	entriesLoaded int
+	// triggersFired is the number of the entries invoked by the type.
+	triggersFired map[entryType]int64
+	// failedStarts is the number of the entries failed to invoke by the type.
+	failedStarts map[entryType]int64
+	// latencySum and latencyCount are the sum and count of the delay between
+	// the scheduled time and the actual time of the invocation.
+	latencySum   time.Duration
+	latencyCount int64
+	latencyMax   time.Duration
+	// zombieRuns is the number of the runs found running without a live
+	// process.
+	zombieRuns int64
+	// tickLatency is the delay between the time of the tick and the time
+	// the scheduler processed it.
+	tickLatency *metrics.Histogram
+}
+
+func newMetrics() *Metrics {
+	return &Metrics{
+		triggersFired: make(map[entryType]int64),
+		failedStarts:  make(map[entryType]int64),
+		tickLatency:   metrics.NewHistogram(metrics.LatencyBuckets),
+	}
+}
+
+// tickProcessed records the delay of processing the tick.
+func (m *Metrics) tickProcessed(tick, processed time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tickLatency.Observe(max(processed.Sub(tick), 0).Seconds())
+}
+
+func (m *Metrics) setEntriesLoaded(n int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entriesLoaded = n
+}
+
+// triggerFired records the invocation of the entry scheduled at the given
+// time. The latency is the delay from the scheduled time.
+func (m *Metrics) triggerFired(typ entryType, scheduled, invoked time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.triggersFired[typ]++
+
+	latency := invoked.Sub(scheduled)
+	if latency < 0 {
+		latency = 0
+	}
+	m.latencySum += latency
+	m.latencyCount++
+	if latency > m.latencyMax {
+		m.latencyMax = latency
+	}
+}
+
+func (m *Metrics) failedStart(typ entryType) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.failedStarts[typ]++
+}
+
+func (m *Metrics) zombieRunDetected() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.zombieRuns++
+}
+
+// WriteTo writes the metrics in the Prometheus text format.
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p := metrics.NewPrinter(w)
+	p.Printf("# HELP dagu_scheduler_entries_loaded Number of entries read at the last tick.\n")
+	p.Printf("# TYPE dagu_scheduler_entries_loaded gauge\n")
+	p.Printf("dagu_scheduler_entries_loaded %d\n", m.entriesLoaded)
+
+	p.Printf("# HELP dagu_scheduler_triggers_fired_total Number of entries invoked.\n")
+	p.Printf("# TYPE dagu_scheduler_triggers_fired_total counter\n")
+	for _, typ := range sortedEntryTypes(m.triggersFired) {
+		p.Printf("dagu_scheduler_triggers_fired_total{operation=%q} %d\n", strings.ToLower(typ.String()), m.triggersFired[typ])
+	}
+
+	p.Printf("# HELP dagu_scheduler_failed_starts_total Number of entries failed to invoke.\n")
+	p.Printf("# TYPE dagu_scheduler_failed_starts_total counter\n")
+	for _, typ := range sortedEntryTypes(m.failedStarts) {
+		p.Printf("dagu_scheduler_failed_starts_total{operation=%q} %d\n", strings.ToLower(typ.String()), m.failedStarts[typ])
+	}
+
+	p.Printf("# HELP dagu_scheduler_trigger_latency_seconds Delay between the scheduled time and the invocation.\n")
+	p.Printf("# TYPE dagu_scheduler_trigger_latency_seconds summary\n")
+	p.Printf("dagu_scheduler_trigger_latency_seconds_sum %g\n", m.latencySum.Seconds())
+	p.Printf("dagu_scheduler_trigger_latency_seconds_count %d\n", m.latencyCount)
+
+	p.Printf("# HELP dagu_scheduler_trigger_latency_max_seconds Maximum delay between the scheduled time and the invocation.\n")
+	p.Printf("# TYPE dagu_scheduler_trigger_latency_max_seconds gauge\n")
+	p.Printf("dagu_scheduler_trigger_latency_max_seconds %g\n", m.latencyMax.Seconds())
+
+	p.Printf("# HELP dagu_scheduler_zombie_runs_total Number of runs found running without a live process.\n")
+	p.Printf("# TYPE dagu_scheduler_zombie_runs_total counter\n")
+	p.Printf("dagu_scheduler_zombie_runs_total %d\n", m.zombieRuns)
+
+	p.Printf("# HELP dagu_scheduler_tick_latency_seconds Delay between the time of the tick and the time the scheduler processed it.\n")
+	p.Printf("# TYPE dagu_scheduler_tick_latency_seconds histogram\n")
+	p.Write(func(w io.Writer) (int64, error) {
+		return m.tickLatency.Write(w, "dagu_scheduler_tick_latency_seconds", "")
+	})
+
+	return p.Result()
+}
+
+func sortedEntryTypes(m map[entryType]int64) []entryType {
+	var ret []entryType
+	for typ := range m {
+		ret = append(ret, typ)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i] < ret[j] })
+	return ret
+}
+
+// serveMetrics serves the metrics on the address until the context is
+// canceled or the scheduler is stopped.
+func (s *Scheduler) serveMetrics(ctx context.Context, addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler(s.metrics))
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-s.stop:
+		}
+		_ = server.Close()
+	}()
+
+	logger.Info(ctx, "Serving scheduler metrics", "address", addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Error(ctx, "Failed to serve scheduler metrics", "err", err)
+	}
+}
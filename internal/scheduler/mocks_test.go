@@ -5,15 +5,15 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/dagu-org/dagu/internal/client"
 	"github.com/dagu-org/dagu/internal/digraph"
-	"github.com/robfig/cron/v3"
 )
 
 var _ jobCreator = (*mockJobFactory)(nil)
 
 type mockJobFactory struct{}
 
-func (f *mockJobFactory) CreateJob(dag *digraph.DAG, _ time.Time, _ cron.Schedule) job {
+func (f *mockJobFactory) CreateJob(dag *digraph.DAG, _ time.Time, _ digraph.Schedule) job {
 	return newMockJob(dag)
 }
 
@@ -40,6 +40,7 @@ type mockJob struct {
 	StopCount    atomic.Int32
 	RestartCount atomic.Int32
 	Panic        error
+	Err          error
 }
 
 func newMockJob(dag *digraph.DAG) *mockJob {
@@ -58,7 +59,7 @@ func (j *mockJob) Start(_ context.Context) error {
 	if j.Panic != nil {
 		panic(j.Panic)
 	}
-	return nil
+	return j.Err
 }
 
 func (j *mockJob) Stop(_ context.Context) error {
@@ -74,3 +75,19 @@ func (j *mockJob) Restart(_ context.Context) error {
 func (j *mockJob) String() string {
 	return j.Name
 }
+
+var _ staleRunRecoverer = (*mockRecoverer)(nil)
+
+type mockRecoverer struct {
+	Runs  []client.RecoveredRun
+	Count atomic.Int32
+}
+
+// RecoverAllStaleRuns returns the runs only on the first call as the runs
+// are marked as failed once recovered.
+func (r *mockRecoverer) RecoverAllStaleRuns(_ context.Context) ([]client.RecoveredRun, error) {
+	if r.Count.Add(1) > 1 {
+		return nil, nil
+	}
+	return r.Runs, nil
+}
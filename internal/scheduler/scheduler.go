@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"syscall"
@@ -16,6 +18,9 @@ import (
 	"github.com/dagu-org/dagu/internal/config"
 	"github.com/dagu-org/dagu/internal/digraph"
 	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/mailer"
+	"github.com/dagu-org/dagu/internal/persistence/jsondb"
+	"github.com/dagu-org/dagu/internal/sentry"
 )
 
 type Scheduler struct {
@@ -24,6 +29,28 @@ type Scheduler struct {
 	stop        chan struct{}
 	running     atomic.Bool
 	location    *time.Location
+	metrics     *Metrics
+	metricsAddr string
+
+	// client is used to drain the runs started by the scheduler on shutdown.
+	client client.Client
+	// gracePeriod is the time to wait for the running DAGs on shutdown.
+	gracePeriod time.Duration
+	// shutdownMarker is the file to persist the shutdown marker.
+	shutdownMarker string
+	// resumeInterruptedRuns enables retrying the stale runs on start.
+	resumeInterruptedRuns bool
+	// zombieDetector corrects the status of the zombie runs periodically.
+	// It's nil if disabled.
+	zombieDetector *zombieDetector
+	// mailRedeliverer sends the mails left by the agents periodically.
+	// It's nil if disabled.
+	mailRedeliverer *mailRedeliverer
+	// historyCompactor rolls up the old status files periodically.
+	// It's nil if disabled.
+	historyCompactor *historyCompactor
+	// delayedStarter starts the runs registered to be started later.
+	delayedStarter *delayedStarter
 }
 
 // TODO: refactor to remove ctx from the constructor
@@ -33,8 +60,49 @@ func New(cfg *config.Config, cli client.Client) *Scheduler {
 		Client:     cli,
 		Executable: cfg.Paths.Executable,
 	}
-	entryReader := newEntryReader(cfg.Paths.DAGsDir, jobCreator, cli)
-	return newScheduler(entryReader, cfg.Paths.LogDir, cfg.Location)
+	entryReader := newEntryReader(cfg.Paths.DAGDirs(), jobCreator, cli)
+	s := newScheduler(entryReader, cfg.Paths.LogDir, cfg.Location)
+	s.metricsAddr = cfg.Scheduler.MetricsAddr
+	s.client = cli
+	s.gracePeriod = cfg.ShutdownGracePeriod
+	s.shutdownMarker = filepath.Join(cfg.Paths.DataDir, ShutdownMarkerFile)
+	s.resumeInterruptedRuns = cfg.Scheduler.ResumeInterruptedRuns
+	s.delayedStarter = &delayedStarter{
+		interval: delayedStartInterval,
+		start:    cli.StartDelayedRuns,
+	}
+	if cfg.Scheduler.ZombieCheckInterval > 0 {
+		s.zombieDetector = &zombieDetector{
+			recoverer: cli,
+			interval:  cfg.Scheduler.ZombieCheckInterval,
+			metrics:   s.metrics,
+		}
+		if cfg.Scheduler.ZombieNotify {
+			s.zombieDetector.notify = notifyZombieRun
+		}
+	}
+	if cfg.MailQueue.RedeliverInterval > 0 {
+		opts := mailer.QueueOptions{
+			Dir:         filepath.Join(cfg.Paths.DataDir, mailer.QueueDirName),
+			MaxAttempts: cfg.MailQueue.MaxAttempts,
+		}
+		s.mailRedeliverer = &mailRedeliverer{
+			interval: cfg.MailQueue.RedeliverInterval,
+			redeliver: func(ctx context.Context) (mailer.RedeliverResult, error) {
+				return mailer.Redeliver(ctx, opts)
+			},
+		}
+	}
+	// The histories in the database are not compacted.
+	if cfg.HistoryCompaction.Interval > 0 && cfg.Persistence.Backend != config.PersistenceBackendSQLite {
+		db := jsondb.New(cfg.Paths.DataDir, jsondb.WithDAGsDirs(cfg.Paths.DAGDirs()...))
+		s.historyCompactor = &historyCompactor{
+			interval:  cfg.HistoryCompaction.Interval,
+			afterDays: cfg.HistoryCompaction.AfterDays,
+			rollUp:    db.RollUp,
+		}
+	}
+	return s
 }
 
 type entryReader interface {
@@ -105,9 +173,15 @@ func newScheduler(entryReader entryReader, logDir string, location *time.Locatio
 		logDir:      logDir,
 		stop:        make(chan struct{}),
 		location:    location,
+		metrics:     newMetrics(),
 	}
 }
 
+// Metrics returns the metrics of the scheduler.
+func (s *Scheduler) Metrics() *Metrics {
+	return s.metrics
+}
+
 func (s *Scheduler) Start(ctx context.Context) error {
 	sig := make(chan os.Signal, 1)
 	done := make(chan any)
@@ -121,6 +195,24 @@ func (s *Scheduler) Start(ctx context.Context) error {
 		sig, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT,
 	)
 
+	if s.metricsAddr != "" {
+		go s.serveMetrics(ctx, s.metricsAddr)
+	}
+
+	s.recoverStaleRuns(ctx)
+	if s.zombieDetector != nil {
+		go s.zombieDetector.run(ctx, s.stop)
+	}
+	if s.mailRedeliverer != nil {
+		go s.mailRedeliverer.run(ctx, s.stop)
+	}
+	if s.historyCompactor != nil {
+		go s.historyCompactor.run(ctx, s.stop)
+	}
+	if s.delayedStarter != nil {
+		go s.delayedStarter.run(ctx, s.stop)
+	}
+
 	go func() {
 		select {
 		case <-done:
@@ -133,19 +225,74 @@ func (s *Scheduler) Start(ctx context.Context) error {
 	}()
 
 	s.start(ctx)
+	s.drain(ctx)
 
 	return nil
 }
 
+// recoverStaleRuns marks the runs interrupted by a crash as failed. If
+// resuming is enabled, the runs are retried from the last completed step.
+func (s *Scheduler) recoverStaleRuns(ctx context.Context) {
+	if s.client == nil {
+		return
+	}
+
+	recovered, err := s.client.RecoverAllStaleRuns(ctx)
+	if err != nil {
+		logger.Error(ctx, "Failed to recover the interrupted runs", "err", err)
+		return
+	}
+	if !s.resumeInterruptedRuns {
+		return
+	}
+	for _, run := range recovered {
+		go func(run client.RecoveredRun) {
+			logger.Info(ctx, "Resuming the interrupted run", "DAG", run.DAG.Name, "reqId", run.Status.RequestID)
+			if err := s.client.Retry(ctx, run.DAG, run.Status.RequestID); err != nil {
+				logger.Error(ctx, "Failed to resume the interrupted run", "DAG", run.DAG.Name, "reqId", run.Status.RequestID, "err", err)
+			}
+		}(run)
+	}
+}
+
+// ShutdownMarkerFile is the name of the shutdown marker of the scheduler in
+// the data directory.
+const ShutdownMarkerFile = "scheduler.shutdown"
+
+// drain waits for the DAGs started by the scheduler to finish and persists
+// the shutdown marker.
+func (s *Scheduler) drain(ctx context.Context) {
+	if s.client == nil {
+		return
+	}
+
+	logger.Info(ctx, "Waiting for the running DAGs to finish", "gracePeriod", s.gracePeriod)
+	result := s.client.Drain(context.WithoutCancel(ctx), s.gracePeriod)
+	if !result.Clean {
+		logger.Warn(ctx, "Stopped the DAGs not finished within the grace period", "DAGs", result.Stopped)
+	}
+
+	if s.shutdownMarker == "" {
+		return
+	}
+	if err := client.WriteShutdownMarker(s.shutdownMarker, "scheduler", result); err != nil {
+		logger.Error(ctx, "Failed to persist the shutdown marker", "err", err)
+	}
+}
+
 func (s *Scheduler) start(ctx context.Context) {
 	// TODO: refactor this to use a ticker
 	t := now().Truncate(time.Minute)
 	timer := time.NewTimer(0)
 
 	s.running.Store(true)
-	for {
+	for first := true; ; first = false {
 		select {
 		case <-timer.C:
+			if !first {
+				// The first tick is the start of the minute in the past.
+				s.metrics.tickProcessed(t, now())
+			}
 			s.run(ctx, t)
 			t = s.nextTick(t)
 			_ = timer.Stop()
@@ -159,21 +306,23 @@ func (s *Scheduler) start(ctx context.Context) {
 	}
 }
 
-func (s *Scheduler) run(ctx context.Context, now time.Time) {
-	entries, err := s.entryReader.Read(ctx, now.Add(-time.Second).In(s.location))
+func (s *Scheduler) run(ctx context.Context, tick time.Time) {
+	entries, err := s.entryReader.Read(ctx, tick.Add(-time.Second).In(s.location))
 	if err != nil {
 		logger.Error(ctx, "Scheduler failed to read DAG entries", "err", err)
 		return
 	}
+	s.metrics.setEntriesLoaded(len(entries))
 	sort.SliceStable(entries, func(i, j int) bool {
 		return entries[i].Next.Before(entries[j].Next)
 	})
 	for _, e := range entries {
 		t := e.Next
-		if t.After(now) {
+		if t.After(tick) {
 			break
 		}
 		go func(e *entry) {
+			s.metrics.triggerFired(e.EntryType, e.Next, now())
 			if err := e.Invoke(ctx); err != nil {
 				if errors.Is(err, errJobFinished) {
 					logger.Info(ctx, "DAG is already finished", "DAG", e.Job, "err", err)
@@ -181,8 +330,14 @@ func (s *Scheduler) run(ctx context.Context, now time.Time) {
 					logger.Info(ctx, "DAG is already running", "DAG", e.Job, "err", err)
 				} else if errors.Is(err, errJobSkipped) {
 					logger.Info(ctx, "DAG is skipped", "DAG", e.Job, "err", err)
+				} else if errors.Is(err, client.ErrDraining) {
+					logger.Info(ctx, "DAG is not started while shutting down", "DAG", e.Job)
 				} else {
+					s.metrics.failedStart(e.EntryType)
 					logger.Error(ctx, "DAG execution failed", "DAG", e.Job, "operation", e.EntryType.String(), "err", err)
+					sentry.CaptureError(ctx, fmt.Errorf("failed to %s the DAG: %w", strings.ToLower(e.EntryType.String()), err), map[string]string{
+						sentry.TagDAG: e.Job.String(),
+					})
 				}
 			}
 		}(e)
@@ -1,10 +1,19 @@
 package scheduler
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/dagu-org/dagu/internal/client"
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/mailer"
+	"github.com/dagu-org/dagu/internal/persistence"
+	"github.com/dagu-org/dagu/internal/persistence/model"
 	"github.com/stretchr/testify/require"
 )
 
@@ -63,6 +72,120 @@ func TestScheduler(t *testing.T) {
 		time.Sleep(time.Second + time.Millisecond*100)
 		require.Equal(t, int32(1), entryReader.Entries[0].Job.(*mockJob).RestartCount.Load())
 	})
+	t.Run("Metrics", func(t *testing.T) {
+		now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		setFixedTime(now.Add(2 * time.Second))
+		t.Cleanup(func() { setFixedTime(time.Time{}) })
+
+		entryReader := &mockEntryReader{
+			Entries: []*entry{
+				{Job: &mockJob{}, Next: now},
+				{Job: &mockJob{Err: errors.New("failed to start")}, Next: now},
+				{Job: &mockJob{}, Next: now.Add(time.Minute)},
+			},
+		}
+
+		schedulerInstance := newScheduler(entryReader, testHomeDir, time.Local)
+		schedulerInstance.run(context.Background(), now)
+
+		var buf bytes.Buffer
+		require.Eventually(t, func() bool {
+			buf.Reset()
+			_, err := schedulerInstance.Metrics().WriteTo(&buf)
+			require.NoError(t, err)
+			return strings.Contains(buf.String(), `dagu_scheduler_failed_starts_total{operation="start"} 1`)
+		}, time.Second, 10*time.Millisecond)
+
+		metrics := buf.String()
+		require.Contains(t, metrics, "dagu_scheduler_entries_loaded 3\n")
+		require.Contains(t, metrics, `dagu_scheduler_triggers_fired_total{operation="start"} 2`)
+		require.Contains(t, metrics, "dagu_scheduler_trigger_latency_seconds_sum 4\n")
+		require.Contains(t, metrics, "dagu_scheduler_trigger_latency_seconds_count 2\n")
+		require.Contains(t, metrics, "dagu_scheduler_trigger_latency_max_seconds 2\n")
+	})
+	t.Run("TickLatency", func(t *testing.T) {
+		metrics := newMetrics()
+		now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		metrics.tickProcessed(now, now.Add(300*time.Millisecond))
+		metrics.tickProcessed(now, now.Add(-time.Second))
+
+		var buf bytes.Buffer
+		_, err := metrics.WriteTo(&buf)
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), "# TYPE dagu_scheduler_tick_latency_seconds histogram\n")
+		require.Contains(t, buf.String(), `dagu_scheduler_tick_latency_seconds_bucket{le="0.25"} 1`)
+		require.Contains(t, buf.String(), `dagu_scheduler_tick_latency_seconds_bucket{le="0.5"} 2`)
+		require.Contains(t, buf.String(), `dagu_scheduler_tick_latency_seconds_bucket{le="+Inf"} 2`)
+		require.Contains(t, buf.String(), "dagu_scheduler_tick_latency_seconds_count 2\n")
+	})
+	t.Run("ZombieDetector", func(t *testing.T) {
+		recoverer := &mockRecoverer{
+			Runs: []client.RecoveredRun{
+				{DAG: &digraph.DAG{Name: "zombie"}, Status: model.Status{RequestID: "req-1"}},
+			},
+		}
+		metrics := newMetrics()
+		var notified atomic.Int32
+		detector := &zombieDetector{
+			recoverer: recoverer,
+			interval:  10 * time.Millisecond,
+			metrics:   metrics,
+			notify: func(_ context.Context, run client.RecoveredRun) error {
+				require.Equal(t, "req-1", run.Status.RequestID)
+				notified.Add(1)
+				return nil
+			},
+		}
+
+		stop := make(chan struct{})
+		go detector.run(context.Background(), stop)
+		require.Eventually(t, func() bool {
+			return recoverer.Count.Load() > 1
+		}, time.Second, 10*time.Millisecond)
+		close(stop)
+
+		require.Equal(t, int32(1), notified.Load())
+
+		var buf bytes.Buffer
+		_, err := metrics.WriteTo(&buf)
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), "dagu_scheduler_zombie_runs_total 1\n")
+	})
+	t.Run("MailRedeliverer", func(t *testing.T) {
+		var count atomic.Int32
+		redeliverer := &mailRedeliverer{
+			interval: 10 * time.Millisecond,
+			redeliver: func(_ context.Context) (mailer.RedeliverResult, error) {
+				count.Add(1)
+				return mailer.RedeliverResult{Delivered: 1}, nil
+			},
+		}
+
+		stop := make(chan struct{})
+		go redeliverer.run(context.Background(), stop)
+		require.Eventually(t, func() bool {
+			return count.Load() > 1
+		}, time.Second, 10*time.Millisecond)
+		close(stop)
+	})
+	t.Run("DelayedStarter", func(t *testing.T) {
+		var count atomic.Int32
+		starter := &delayedStarter{
+			interval: 10 * time.Millisecond,
+			start: func(_ context.Context, now time.Time) ([]persistence.DelayedStart, error) {
+				require.False(t, now.IsZero())
+				count.Add(1)
+				return []persistence.DelayedStart{{DAG: "/dags/etl.yaml", RequestID: "req-1"}}, nil
+			},
+		}
+
+		stop := make(chan struct{})
+		go starter.run(context.Background(), stop)
+		require.Eventually(t, func() bool {
+			return count.Load() > 1
+		}, time.Second, 10*time.Millisecond)
+		close(stop)
+	})
 	t.Run("NextTick", func(t *testing.T) {
 		now := time.Date(2020, 1, 1, 1, 0, 50, 0, time.UTC)
 		setFixedTime(now)
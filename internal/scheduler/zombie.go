@@ -0,0 +1,87 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/client"
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/mailer"
+)
+
+// staleRunRecoverer recovers the runs recorded as running without a live
+// process.
+type staleRunRecoverer interface {
+	RecoverAllStaleRuns(ctx context.Context) ([]client.RecoveredRun, error)
+}
+
+// zombieDetector periodically scans the histories for the runs stuck in
+// the running status whose process is gone and marks them as failed.
+type zombieDetector struct {
+	recoverer staleRunRecoverer
+	interval  time.Duration
+	metrics   *Metrics
+	// notify is called for each zombie run found. It's nil if the
+	// notification is disabled.
+	notify func(ctx context.Context, run client.RecoveredRun) error
+}
+
+// run scans the histories at the interval until the context is canceled or
+// the stop channel is closed.
+func (d *zombieDetector) run(ctx context.Context, stop <-chan struct{}) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			d.detect(ctx)
+		case <-stop:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (d *zombieDetector) detect(ctx context.Context) {
+	recovered, err := d.recoverer.RecoverAllStaleRuns(ctx)
+	if err != nil {
+		logger.Error(ctx, "Failed to detect zombie runs", "err", err)
+		return
+	}
+	for _, run := range recovered {
+		logger.Warn(ctx, "Zombie run detected", "DAG", run.DAG.Name, "reqId", run.Status.RequestID)
+		if d.metrics != nil {
+			d.metrics.zombieRunDetected()
+		}
+		if d.notify == nil {
+			continue
+		}
+		if err := d.notify(ctx, run); err != nil {
+			logger.Error(ctx, "Failed to notify the zombie run", "DAG", run.DAG.Name, "reqId", run.Status.RequestID, "err", err)
+		}
+	}
+}
+
+// notifyZombieRun sends the error mail of the DAG for the zombie run if the
+// DAG is configured to send mails on failure.
+func notifyZombieRun(ctx context.Context, run client.RecoveredRun) error {
+	dag, err := digraph.Load(ctx, run.DAG.Location)
+	if err != nil {
+		return fmt.Errorf("failed to load DAG: %w", err)
+	}
+	if dag.MailOn == nil || !dag.MailOn.Failure || dag.ErrorMail == nil || dag.SMTP == nil {
+		return nil
+	}
+
+	m := mailer.New(dag.SMTP.MailerConfig())
+	subject := fmt.Sprintf("%s %s (%s)", dag.ErrorMail.Prefix, dag.Name, run.Status.Status)
+	body := fmt.Sprintf(
+		"The run %s of %s was found running without a live process and was marked as failed.",
+		run.Status.RequestID, dag.Name,
+	)
+	return m.Send(ctx, dag.ErrorMail.From, []string{dag.ErrorMail.To}, subject, body, nil)
+}
@@ -0,0 +1,261 @@
+// Package sentry reports the panics and the errors of the DAG runs and the
+// services to Sentry so that the operators see them without reading the
+// logs.
+package sentry
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/dagu-org/dagu/internal/build"
+	"github.com/dagu-org/dagu/internal/logger"
+)
+
+// Levels of the events.
+const (
+	LevelError = "error"
+	LevelFatal = "fatal"
+)
+
+// Names of the tags of the events.
+const (
+	TagDAG       = "dag"
+	TagRequestID = "request_id"
+	TagStep      = "step"
+)
+
+const sendTimeout = 5 * time.Second
+
+// Options is the options of the Sentry client.
+type Options struct {
+	// DSN is the client key of the Sentry project,
+	// e.g. "https://<key>@o0.ingest.sentry.io/<project>".
+	DSN string
+	// Environment is the environment of the events, e.g. "production".
+	Environment string
+}
+
+// Client sends the events to the Sentry project.
+type Client struct {
+	endpoint    string
+	auth        string
+	dsn         string
+	environment string
+	serverName  string
+	httpClient  *http.Client
+}
+
+// New creates a client sending the events to the project of the DSN.
+func New(opts Options) (*Client, error) {
+	u, err := url.Parse(opts.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Sentry DSN: %w", err)
+	}
+	if u.User == nil || u.User.Username() == "" {
+		return nil, fmt.Errorf("invalid Sentry DSN: the public key is missing")
+	}
+	idx := strings.LastIndex(u.Path, "/")
+	if idx < 0 || u.Path[idx+1:] == "" {
+		return nil, fmt.Errorf("invalid Sentry DSN: the project ID is missing")
+	}
+	project := u.Path[idx+1:]
+	endpoint := fmt.Sprintf("%s://%s%s/api/%s/envelope/", u.Scheme, u.Host, u.Path[:idx], project)
+
+	serverName, _ := os.Hostname()
+	return &Client{
+		endpoint: endpoint,
+		auth: fmt.Sprintf("Sentry sentry_version=7, sentry_client=%s/%s, sentry_key=%s",
+			build.Slug, build.Version, u.User.Username()),
+		dsn:         opts.DSN,
+		environment: opts.Environment,
+		serverName:  serverName,
+		httpClient:  &http.Client{Timeout: sendTimeout},
+	}, nil
+}
+
+// Event is a panic or an error reported to Sentry.
+type Event struct {
+	Level string
+	// Type is the type of the exception, e.g. "panic".
+	Type string
+	// Message is the message of the exception.
+	Message string
+	// Tags is the run context of the event: the DAG, the request ID and
+	// the step.
+	Tags map[string]string
+	// Extra is the additional data of the event such as the stack trace.
+	Extra map[string]any
+}
+
+// event is the payload of the event in the Sentry format.
+type event struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   string            `json:"timestamp"`
+	Level       string            `json:"level"`
+	Platform    string            `json:"platform"`
+	Logger      string            `json:"logger"`
+	ServerName  string            `json:"server_name,omitempty"`
+	Release     string            `json:"release,omitempty"`
+	Environment string            `json:"environment,omitempty"`
+	Exception   exceptions        `json:"exception"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Extra       map[string]any    `json:"extra,omitempty"`
+}
+
+type exceptions struct {
+	Values []exception `json:"values"`
+}
+
+type exception struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// Capture sends the event to Sentry.
+func (c *Client) Capture(ctx context.Context, ev Event) error {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return err
+	}
+	payload := event{
+		EventID:     hex.EncodeToString(id),
+		Timestamp:   time.Now().UTC().Format(time.RFC3339Nano),
+		Level:       ev.Level,
+		Platform:    "go",
+		Logger:      build.Slug,
+		ServerName:  c.serverName,
+		Release:     build.Version,
+		Environment: c.environment,
+		Exception:   exceptions{Values: []exception{{Type: ev.Type, Value: ev.Message}}},
+		Tags:        ev.Tags,
+		Extra:       ev.Extra,
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode the event: %w", err)
+	}
+
+	// The envelope is the header, the item header, and the event, each on
+	// its own line.
+	var body bytes.Buffer
+	_ = json.NewEncoder(&body).Encode(map[string]string{
+		"event_id": payload.EventID,
+		"dsn":      c.dsn,
+		"sent_at":  payload.Timestamp,
+	})
+	_ = json.NewEncoder(&body).Encode(map[string]any{"type": "event", "length": len(data)})
+	body.Write(data)
+	body.WriteByte('\n')
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", c.auth)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d from Sentry", resp.StatusCode)
+	}
+	return nil
+}
+
+type contextKey struct{}
+
+type tagsContextKey struct{}
+
+// WithClient returns a context reporting the events to the client.
+func WithClient(ctx context.Context, c *Client) context.Context {
+	return context.WithValue(ctx, contextKey{}, c)
+}
+
+// FromContext returns the client of the context or nil.
+func FromContext(ctx context.Context) *Client {
+	c, _ := ctx.Value(contextKey{}).(*Client)
+	return c
+}
+
+// WithTags returns a context whose events carry the tags in addition to
+// the ones of the parent context.
+func WithTags(ctx context.Context, tags map[string]string) context.Context {
+	merged := make(map[string]string)
+	for k, v := range tagsFromContext(ctx) {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, tagsContextKey{}, merged)
+}
+
+func tagsFromContext(ctx context.Context) map[string]string {
+	tags, _ := ctx.Value(tagsContextKey{}).(map[string]string)
+	return tags
+}
+
+// CaptureError reports the error with the run context if the context has
+// a client. The failure to send the event is only logged.
+func CaptureError(ctx context.Context, err error, tags map[string]string) {
+	capture(ctx, Event{
+		Level:   LevelError,
+		Type:    fmt.Sprintf("%T", err),
+		Message: err.Error(),
+		Tags:    tags,
+	})
+}
+
+// CapturePanic reports the recovered panic and its stack trace if the
+// context has a client.
+func CapturePanic(ctx context.Context, panicObj any, stack []byte, tags map[string]string) {
+	capture(ctx, Event{
+		Level:   LevelFatal,
+		Type:    "panic",
+		Message: fmt.Sprint(panicObj),
+		Tags:    tags,
+		Extra:   map[string]any{"stack": string(stack)},
+	})
+}
+
+// Recover reports the panic of the goroutine and panics again. It must be
+// deferred directly, e.g. defer sentry.Recover(ctx).
+func Recover(ctx context.Context) {
+	if panicObj := recover(); panicObj != nil {
+		CapturePanic(ctx, panicObj, debug.Stack(), nil)
+		panic(panicObj)
+	}
+}
+
+func capture(ctx context.Context, ev Event) {
+	c := FromContext(ctx)
+	if c == nil {
+		return
+	}
+	if ctxTags := tagsFromContext(ctx); len(ctxTags) > 0 {
+		tags := make(map[string]string)
+		for k, v := range ctxTags {
+			tags[k] = v
+		}
+		for k, v := range ev.Tags {
+			tags[k] = v
+		}
+		ev.Tags = tags
+	}
+	// Send the event even if the context is canceled, e.g. on a signal.
+	if err := c.Capture(context.WithoutCancel(ctx), ev); err != nil {
+		logger.Warn(ctx, "Failed to send the event to Sentry", "err", err)
+	}
+}
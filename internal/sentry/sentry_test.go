@@ -0,0 +1,94 @@
+package sentry
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type request struct {
+	path  string
+	auth  string
+	event map[string]any
+}
+
+func newServer(t *testing.T) (*httptest.Server, chan request) {
+	t.Helper()
+	reqs := make(chan request, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		// The third line of the envelope is the event.
+		scanner := bufio.NewScanner(r.Body)
+		var lines []string
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		var event map[string]any
+		if len(lines) == 3 {
+			_ = json.Unmarshal([]byte(lines[2]), &event)
+		}
+		reqs <- request{path: r.URL.Path, auth: r.Header.Get("X-Sentry-Auth"), event: event}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, reqs
+}
+
+func TestCapture(t *testing.T) {
+	srv, reqs := newServer(t)
+	dsn := strings.Replace(srv.URL, "http://", "http://public@", 1) + "/42"
+	client, err := New(Options{DSN: dsn, Environment: "test"})
+	require.NoError(t, err)
+
+	ctx := WithClient(context.Background(), client)
+	ctx = WithTags(ctx, map[string]string{TagDAG: "etl", TagRequestID: "req"})
+
+	t.Run("Error", func(t *testing.T) {
+		CaptureError(ctx, errors.New("step failed"), map[string]string{TagStep: "extract"})
+
+		req := <-reqs
+		require.Equal(t, "/api/42/envelope/", req.path)
+		require.Contains(t, req.auth, "sentry_key=public")
+		require.Equal(t, "error", req.event["level"])
+		require.Equal(t, "test", req.event["environment"])
+		require.Equal(t, map[string]any{"dag": "etl", "request_id": "req", "step": "extract"}, req.event["tags"])
+		values := req.event["exception"].(map[string]any)["values"].([]any)
+		require.Equal(t, "step failed", values[0].(map[string]any)["value"])
+	})
+	t.Run("Panic", func(t *testing.T) {
+		func() {
+			defer func() {
+				// Recover panics again after reporting.
+				require.Equal(t, "boom", recover())
+			}()
+			defer Recover(ctx)
+			panic("boom")
+		}()
+
+		req := <-reqs
+		require.Equal(t, "fatal", req.event["level"])
+		require.Equal(t, "etl", req.event["tags"].(map[string]any)["dag"])
+		require.Contains(t, req.event["extra"].(map[string]any)["stack"], "sentry")
+	})
+	t.Run("NoClient", func(t *testing.T) {
+		// Nothing is sent without a client.
+		CaptureError(context.Background(), errors.New("ignored"), nil)
+		require.Empty(t, reqs)
+	})
+}
+
+func TestNew(t *testing.T) {
+	for _, dsn := range []string{"https://sentry.io/1", "https://key@sentry.io/", "://"} {
+		_, err := New(Options{DSN: dsn})
+		require.Error(t, err, dsn)
+	}
+
+	client, err := New(Options{DSN: "https://key@example.com/sentry/7"})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/sentry/api/7/envelope/", client.endpoint)
+}
@@ -0,0 +1,147 @@
+// Package statsd pushes the metrics of the DAG runs to a StatsD server or
+// the Datadog agent over UDP.
+package statsd
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Names of the tags attached to the metrics.
+const (
+	TagDAG    = "dag"
+	TagStatus = "status"
+	TagStep   = "step"
+)
+
+// Options is the options of the StatsD client.
+type Options struct {
+	// Addr is the UDP address of the StatsD server (e.g. "127.0.0.1:8125").
+	Addr string
+	// Prefix is prepended to the names of the metrics (e.g. "dagu.").
+	Prefix string
+	// Tags is the names of the tags attached to the metrics. All the tags
+	// are attached if it's empty.
+	Tags []string
+	// Datadog enables the DogStatsD tags. The values of the tags are
+	// appended to the names of the metrics if it's disabled, because the
+	// plain StatsD has no tags.
+	Datadog bool
+}
+
+// Tag is a dimension of a metric.
+type Tag struct {
+	Name  string
+	Value string
+}
+
+// Client sends the metrics to the StatsD server. The metrics are sent
+// without waiting for the server, so the errors only mean the packet
+// couldn't be written.
+type Client struct {
+	conn    net.Conn
+	prefix  string
+	tags    map[string]bool
+	datadog bool
+}
+
+// New creates a client sending the metrics to the address.
+func New(opts Options) (*Client, error) {
+	for _, tag := range opts.Tags {
+		switch tag {
+		case TagDAG, TagStatus, TagStep:
+		default:
+			return nil, fmt.Errorf("unknown StatsD tag %q", tag)
+		}
+	}
+	conn, err := net.Dial("udp", opts.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to StatsD %s: %w", opts.Addr, err)
+	}
+	var tags map[string]bool
+	if len(opts.Tags) > 0 {
+		tags = make(map[string]bool, len(opts.Tags))
+		for _, tag := range opts.Tags {
+			tags[tag] = true
+		}
+	}
+	return &Client{
+		conn:    conn,
+		prefix:  opts.Prefix,
+		tags:    tags,
+		datadog: opts.Datadog,
+	}, nil
+}
+
+// Count adds the value to the counter.
+func (c *Client) Count(name string, value int64, tags ...Tag) error {
+	return c.send(name, strconv.FormatInt(value, 10), "c", tags)
+}
+
+// Timing records the duration in milliseconds.
+func (c *Client) Timing(name string, d time.Duration, tags ...Tag) error {
+	return c.send(name, strconv.FormatInt(d.Milliseconds(), 10), "ms", tags)
+}
+
+// Close closes the connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+func (c *Client) send(name, value, typ string, tags []Tag) error {
+	_, err := c.conn.Write([]byte(c.format(name, value, typ, tags)))
+	return err
+}
+
+// format builds the line of the metric, e.g. "dagu.run.finished:1|c|#dag:x"
+// for Datadog and "dagu.run.finished.x:1|c" for the plain StatsD.
+func (c *Client) format(name, value, typ string, tags []Tag) string {
+	var sb strings.Builder
+	sb.WriteString(c.prefix)
+	sb.WriteString(name)
+
+	var dogTags []string
+	for _, tag := range tags {
+		if c.tags != nil && !c.tags[tag.Name] {
+			continue
+		}
+		if c.datadog {
+			dogTags = append(dogTags, tag.Name+":"+sanitize(tag.Value, ":./"))
+		} else {
+			sb.WriteString(".")
+			sb.WriteString(sanitize(tag.Value, ""))
+		}
+	}
+
+	sb.WriteString(":")
+	sb.WriteString(value)
+	sb.WriteString("|")
+	sb.WriteString(typ)
+	if len(dogTags) > 0 {
+		sb.WriteString("|#")
+		sb.WriteString(strings.Join(dogTags, ","))
+	}
+	return sb.String()
+}
+
+// sanitize replaces the characters other than letters, digits, "-", "_"
+// and the allowed ones with underscores so that the value doesn't break
+// the line format.
+func sanitize(value, allowed string) string {
+	if value == "" {
+		return "none"
+	}
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		case strings.ContainsRune(allowed, r):
+			return r
+		default:
+			return '_'
+		}
+	}, value)
+}
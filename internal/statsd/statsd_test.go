@@ -0,0 +1,63 @@
+package statsd
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	listen := func(t *testing.T) net.PacketConn {
+		t.Helper()
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = conn.Close() })
+		return conn
+	}
+	receive := func(t *testing.T, conn net.PacketConn) string {
+		t.Helper()
+		buf := make([]byte, 1024)
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+		return string(buf[:n])
+	}
+	tags := []Tag{{TagDAG, "my dag"}, {TagStatus, "success"}, {TagStep, "step.1"}}
+
+	t.Run("Datadog", func(t *testing.T) {
+		conn := listen(t)
+		cli, err := New(Options{Addr: conn.LocalAddr().String(), Prefix: "dagu.", Datadog: true})
+		require.NoError(t, err)
+		defer cli.Close()
+
+		require.NoError(t, cli.Count("step.finished", 1, tags...))
+		require.Equal(t, "dagu.step.finished:1|c|#dag:my_dag,status:success,step:step.1", receive(t, conn))
+
+		require.NoError(t, cli.Timing("step.duration", 1500*time.Millisecond, tags...))
+		require.Equal(t, "dagu.step.duration:1500|ms|#dag:my_dag,status:success,step:step.1", receive(t, conn))
+	})
+	t.Run("Plain", func(t *testing.T) {
+		conn := listen(t)
+		cli, err := New(Options{Addr: conn.LocalAddr().String(), Prefix: "dagu."})
+		require.NoError(t, err)
+		defer cli.Close()
+
+		require.NoError(t, cli.Count("step.finished", 1, tags...))
+		require.Equal(t, "dagu.step.finished.my_dag.success.step_1:1|c", receive(t, conn))
+	})
+	t.Run("SelectedTags", func(t *testing.T) {
+		conn := listen(t)
+		cli, err := New(Options{Addr: conn.LocalAddr().String(), Tags: []string{TagDAG, TagStatus}, Datadog: true})
+		require.NoError(t, err)
+		defer cli.Close()
+
+		require.NoError(t, cli.Count("step.finished", 1, tags...))
+		require.Equal(t, "step.finished:1|c|#dag:my_dag,status:success", receive(t, conn))
+	})
+	t.Run("UnknownTag", func(t *testing.T) {
+		_, err := New(Options{Addr: "127.0.0.1:8125", Tags: []string{"host"}})
+		require.Error(t, err)
+	})
+}
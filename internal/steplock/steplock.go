@@ -0,0 +1,82 @@
+// Package steplock serializes the steps sharing a lock key across the DAG
+// runs, including the runs of different DAGs. Each key is a file in the
+// locks directory under the data directory, locked with flock(2) by the
+// agent running the step. The lock is released by the kernel when the agent
+// exits, so a crashed run never leaves a stale lock behind.
+package steplock
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+)
+
+// DirName is the name of the directory of the locks in the data directory.
+const DirName = "locks"
+
+// Locker acquires the locks in a directory.
+type Locker struct {
+	dir string
+}
+
+// New returns the locker of the locks in the directory.
+func New(dir string) *Locker {
+	return &Locker{dir: dir}
+}
+
+// Lock is a lock held by the process.
+type Lock struct {
+	key  string
+	file *os.File
+}
+
+// Path returns the file of the lock of the key. The key is hashed as it may
+// contain any character.
+func (l *Locker) Path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(l.dir, hex.EncodeToString(sum[:16])+".lock")
+}
+
+// TryLock acquires the lock of the key without waiting. It returns false if
+// the lock is held by another step.
+func (l *Locker) TryLock(key string) (*Lock, bool, error) {
+	if err := os.MkdirAll(l.dir, 0755); err != nil {
+		return nil, false, err
+	}
+	file, err := os.OpenFile(l.Path(key), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		_ = file.Close()
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	// The holder is recorded to find out who holds a lock for a long time.
+	if err := file.Truncate(0); err == nil {
+		_, _ = fmt.Fprintf(file, "key: %s\npid: %d\nsince: %s\n", key, os.Getpid(), time.Now().Format(time.RFC3339))
+	}
+	return &Lock{key: key, file: file}, true, nil
+}
+
+// Key returns the key of the lock.
+func (l *Lock) Key() string {
+	return l.key
+}
+
+// Unlock releases the lock.
+func (l *Lock) Unlock() error {
+	if err := syscall.Flock(int(l.file.Fd()), syscall.LOCK_UN); err != nil {
+		_ = l.file.Close()
+		return err
+	}
+	return l.file.Close()
+}
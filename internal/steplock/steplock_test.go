@@ -0,0 +1,33 @@
+package steplock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTryLock(t *testing.T) {
+	locker := New(t.TempDir())
+
+	lock, ok, err := locker.TryLock("db-migration")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "db-migration", lock.Key())
+
+	// The lock is exclusive even within the process.
+	_, ok, err = locker.TryLock("db-migration")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// The other keys are independent.
+	other, ok, err := locker.TryLock("../other/key")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NoError(t, other.Unlock())
+
+	require.NoError(t, lock.Unlock())
+	lock, ok, err = locker.TryLock("db-migration")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NoError(t, lock.Unlock())
+}
@@ -71,7 +71,11 @@ func Setup(t *testing.T, opts ...TestHelperOption) Helper {
 		storage.NewStorage(cfg.Paths.SuspendFlagsDir),
 	)
 
-	client := client.New(dagStore, historyStore, flagStore, cfg.Paths.Executable, cfg.WorkDir)
+	delayedStartStore := local.NewDelayedStartStore(
+		storage.NewStorage(filepath.Join(cfg.Paths.DataDir, local.DelayedStartDirName)),
+	)
+
+	client := client.New(dagStore, historyStore, flagStore, delayedStartStore, cfg.Paths.Executable, cfg.WorkDir)
 
 	helper := Helper{
 		Context:      createDefaultContext(),
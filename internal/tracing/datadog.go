@@ -0,0 +1,67 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// datadogExporter sends the spans to the trace API of the Datadog agent.
+type datadogExporter struct {
+	opts   Options
+	client *http.Client
+}
+
+// datadogSpan is a span in the v0.3 format of the Datadog agent.
+type datadogSpan struct {
+	TraceID  uint64            `json:"trace_id"`
+	SpanID   uint64            `json:"span_id"`
+	ParentID uint64            `json:"parent_id"`
+	Name     string            `json:"name"`
+	Resource string            `json:"resource"`
+	Service  string            `json:"service"`
+	Type     string            `json:"type"`
+	Start    int64             `json:"start"`
+	Duration int64             `json:"duration"`
+	Error    int32             `json:"error"`
+	Meta     map[string]string `json:"meta,omitempty"`
+}
+
+func (e *datadogExporter) Export(ctx context.Context, spans []Span) error {
+	trace := make([]datadogSpan, 0, len(spans))
+	for _, span := range spans {
+		s := datadogSpan{
+			TraceID:  span.TraceID,
+			SpanID:   span.SpanID,
+			ParentID: span.ParentID,
+			Name:     span.Name,
+			Resource: span.Resource,
+			Service:  e.opts.Service,
+			Type:     "custom",
+			Start:    span.Start.UnixNano(),
+			Duration: span.Duration.Nanoseconds(),
+			Meta:     span.Attributes,
+		}
+		if span.Error {
+			s.Error = 1
+		}
+		trace = append(trace, s)
+	}
+	body, err := json.Marshal([][]datadogSpan{trace})
+	if err != nil {
+		return fmt.Errorf("failed to encode the trace: %w", err)
+	}
+
+	url := strings.TrimSuffix(e.opts.Endpoint, "/") + "/v0.3/traces"
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Datadog-Trace-Count", strconv.Itoa(1))
+	return send(ctx, e.client, req)
+}
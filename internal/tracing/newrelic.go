@@ -0,0 +1,80 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// newRelicExporter sends the spans to the New Relic Trace API.
+type newRelicExporter struct {
+	opts   Options
+	client *http.Client
+}
+
+// newRelicPayload is a batch of spans in the New Relic format.
+type newRelicPayload struct {
+	Common newRelicCommon `json:"common"`
+	Spans  []newRelicSpan `json:"spans"`
+}
+
+type newRelicCommon struct {
+	Attributes map[string]any `json:"attributes"`
+}
+
+type newRelicSpan struct {
+	TraceID    string         `json:"trace.id"`
+	ID         string         `json:"id"`
+	Timestamp  int64          `json:"timestamp"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+func (e *newRelicExporter) Export(ctx context.Context, spans []Span) error {
+	payload := newRelicPayload{
+		Common: newRelicCommon{Attributes: map[string]any{"service.name": e.opts.Service}},
+	}
+	for _, span := range spans {
+		attrs := map[string]any{
+			"name":        span.Name,
+			"resource":    span.Resource,
+			"duration.ms": float64(span.Duration.Microseconds()) / 1000,
+		}
+		if span.ParentID != 0 {
+			attrs["parent.id"] = formatID(span.ParentID)
+		}
+		if span.Error {
+			attrs["error"] = true
+		}
+		for k, v := range span.Attributes {
+			attrs[k] = v
+		}
+		payload.Spans = append(payload.Spans, newRelicSpan{
+			TraceID:    formatID(span.TraceID),
+			ID:         formatID(span.SpanID),
+			Timestamp:  span.Start.UnixMilli(),
+			Attributes: attrs,
+		})
+	}
+	body, err := json.Marshal([]newRelicPayload{payload})
+	if err != nil {
+		return fmt.Errorf("failed to encode the trace: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, e.opts.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Api-Key", e.opts.APIKey)
+	req.Header.Set("Data-Format", "newrelic")
+	req.Header.Set("Data-Format-Version", "1")
+	return send(ctx, e.client, req)
+}
+
+// formatID formats the ID in hex as the IDs of the New Relic agents.
+func formatID(id uint64) string {
+	return strconv.FormatUint(id, 16)
+}
@@ -0,0 +1,109 @@
+// Package tracing exports the traces of the DAG runs directly to the APM
+// services so that the runs appear in the existing accounts without running
+// a collector.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// Names of the exporters.
+const (
+	ExporterDatadog  = "datadog"
+	ExporterNewRelic = "newrelic"
+)
+
+// Default options of the exporters.
+const (
+	defaultService          = "dagu"
+	defaultDatadogEndpoint  = "http://localhost:8126"
+	defaultNewRelicEndpoint = "https://trace-api.newrelic.com/trace/v1"
+	defaultTimeout          = 10 * time.Second
+)
+
+// Options is the options of the exporter.
+type Options struct {
+	// Exporter is the name of the exporter: datadog or newrelic.
+	Exporter string
+	// Endpoint is the URL of the Datadog agent or the New Relic Trace API.
+	// The default of the exporter is used if it's empty.
+	Endpoint string
+	// Service is the name of the service of the spans.
+	Service string
+	// APIKey is the license key of New Relic.
+	APIKey string
+}
+
+// Span is a unit of work in the trace of a DAG run: the run or a step.
+type Span struct {
+	TraceID  uint64
+	SpanID   uint64
+	ParentID uint64
+	// Name is the operation of the span, e.g. "dag.run".
+	Name string
+	// Resource is the name of the DAG or the step.
+	Resource string
+	Start    time.Time
+	Duration time.Duration
+	Error    bool
+	// Attributes is the metadata of the span such as the request ID.
+	Attributes map[string]string
+}
+
+// Exporter sends the spans of a trace to the APM service.
+type Exporter interface {
+	Export(ctx context.Context, spans []Span) error
+}
+
+// NewExporter creates the exporter selected in the options.
+func NewExporter(opts Options) (Exporter, error) {
+	if opts.Service == "" {
+		opts.Service = defaultService
+	}
+	client := &http.Client{Timeout: defaultTimeout}
+	switch opts.Exporter {
+	case ExporterDatadog:
+		if opts.Endpoint == "" {
+			opts.Endpoint = defaultDatadogEndpoint
+		}
+		return &datadogExporter{opts: opts, client: client}, nil
+	case ExporterNewRelic:
+		if opts.APIKey == "" {
+			return nil, fmt.Errorf("the API key is required for the %s exporter", ExporterNewRelic)
+		}
+		if opts.Endpoint == "" {
+			opts.Endpoint = defaultNewRelicEndpoint
+		}
+		return &newRelicExporter{opts: opts, client: client}, nil
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", opts.Exporter)
+	}
+}
+
+// NewID returns a random ID of a trace or a span. The IDs are 63 bits
+// like the ones of the Datadog tracers, and never zero, which means no
+// parent.
+func NewID() uint64 {
+	for {
+		if id := rand.Uint64() >> 1; id != 0 {
+			return id
+		}
+	}
+}
+
+// send sends the request and fails on the non-2xx responses.
+func send(ctx context.Context, client *http.Client, req *http.Request) error {
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, req.URL)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExporter(t *testing.T) {
+	start := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	spans := []Span{
+		{
+			TraceID:    1,
+			SpanID:     1,
+			Name:       "dag.run",
+			Resource:   "etl",
+			Start:      start,
+			Duration:   2 * time.Second,
+			Error:      true,
+			Attributes: map[string]string{"request_id": "req"},
+		},
+		{
+			TraceID:  1,
+			SpanID:   2,
+			ParentID: 1,
+			Name:     "dag.step",
+			Resource: "extract",
+			Start:    start,
+			Duration: time.Second,
+		},
+	}
+	type request struct {
+		method string
+		path   string
+		header http.Header
+		body   []byte
+	}
+	server := func(t *testing.T) (*httptest.Server, chan request) {
+		t.Helper()
+		reqs := make(chan request, 1)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			reqs <- request{method: r.Method, path: r.URL.Path, header: r.Header, body: body}
+		}))
+		t.Cleanup(srv.Close)
+		return srv, reqs
+	}
+
+	t.Run("Datadog", func(t *testing.T) {
+		srv, reqs := server(t)
+		exporter, err := NewExporter(Options{Exporter: ExporterDatadog, Endpoint: srv.URL})
+		require.NoError(t, err)
+		require.NoError(t, exporter.Export(context.Background(), spans))
+
+		req := <-reqs
+		require.Equal(t, http.MethodPut, req.method)
+		require.Equal(t, "/v0.3/traces", req.path)
+		var traces [][]datadogSpan
+		require.NoError(t, json.Unmarshal(req.body, &traces))
+		require.Len(t, traces, 1)
+		require.Len(t, traces[0], 2)
+		require.Equal(t, "dagu", traces[0][0].Service)
+		require.Equal(t, int32(1), traces[0][0].Error)
+		require.Equal(t, start.UnixNano(), traces[0][0].Start)
+		require.Equal(t, "req", traces[0][0].Meta["request_id"])
+		require.Equal(t, uint64(1), traces[0][1].ParentID)
+		require.Equal(t, time.Second.Nanoseconds(), traces[0][1].Duration)
+	})
+	t.Run("NewRelic", func(t *testing.T) {
+		srv, reqs := server(t)
+		exporter, err := NewExporter(Options{Exporter: ExporterNewRelic, Endpoint: srv.URL, APIKey: "key", Service: "batch"})
+		require.NoError(t, err)
+		require.NoError(t, exporter.Export(context.Background(), spans))
+
+		req := <-reqs
+		require.Equal(t, http.MethodPost, req.method)
+		require.Equal(t, "key", req.header.Get("Api-Key"))
+		require.Equal(t, "newrelic", req.header.Get("Data-Format"))
+		var payloads []newRelicPayload
+		require.NoError(t, json.Unmarshal(req.body, &payloads))
+		require.Len(t, payloads, 1)
+		require.Equal(t, "batch", payloads[0].Common.Attributes["service.name"])
+		require.Len(t, payloads[0].Spans, 2)
+		require.Equal(t, start.UnixMilli(), payloads[0].Spans[0].Timestamp)
+		require.Equal(t, true, payloads[0].Spans[0].Attributes["error"])
+		require.Equal(t, "1", payloads[0].Spans[1].Attributes["parent.id"])
+		require.Equal(t, float64(1000), payloads[0].Spans[1].Attributes["duration.ms"])
+	})
+	t.Run("ErrorStatus", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer srv.Close()
+		exporter, err := NewExporter(Options{Exporter: ExporterDatadog, Endpoint: srv.URL})
+		require.NoError(t, err)
+		require.Error(t, exporter.Export(context.Background(), spans))
+	})
+	t.Run("InvalidOptions", func(t *testing.T) {
+		_, err := NewExporter(Options{Exporter: "jaeger"})
+		require.Error(t, err)
+		_, err = NewExporter(Options{Exporter: ExporterNewRelic})
+		require.Error(t, err)
+	})
+}
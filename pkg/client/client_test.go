@@ -0,0 +1,81 @@
+package client_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/dagu-org/dagu/pkg/client/v1"
+	"github.com/dagu-org/dagu/pkg/client/v1/dags"
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+func TestClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok || user != "user" || password != "password" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/dags":
+			require.Equal(t, "daily", r.URL.Query().Get("searchTag"))
+			_ = json.NewEncoder(w).Encode(&models.ListDagsResponse{
+				DAGs: []*models.DagListItem{{
+					File: swag.String("backup.yaml"),
+					DAG:  &models.Dag{Name: swag.String("backup")},
+				}},
+				Errors:    []string{},
+				HasError:  swag.Bool(false),
+				PageCount: swag.Int64(1),
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/dags/backup":
+			var body dags.PostDagActionBody
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Equal(t, "start", *body.Action)
+			_ = json.NewEncoder(w).Encode(&models.PostDagActionResponse{RequestID: "request-id"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(&models.APIError{
+				Message: swag.String("not found"),
+			})
+		}
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	cli := v1.NewHTTPClientWithConfig(nil, v1.DefaultTransportConfig().WithHost(u.Host))
+	auth := func(op *runtime.ClientOperation) {
+		op.AuthInfo = httptransport.BasicAuth("user", "password")
+	}
+
+	t.Run("ListDags", func(t *testing.T) {
+		resp, err := cli.Dags.ListDags(dags.NewListDagsParams().WithSearchTag(swag.String("daily")), auth)
+		require.NoError(t, err)
+		require.Len(t, resp.Payload.DAGs, 1)
+		require.Equal(t, "backup", *resp.Payload.DAGs[0].DAG.Name)
+	})
+	t.Run("PostDagAction", func(t *testing.T) {
+		resp, err := cli.Dags.PostDagAction(dags.NewPostDagActionParams().
+			WithDagID("backup").
+			WithBody(dags.PostDagActionBody{Action: swag.String("start")}), auth)
+		require.NoError(t, err)
+		require.Equal(t, "request-id", resp.Payload.RequestID)
+	})
+	t.Run("Error", func(t *testing.T) {
+		_, err := cli.Dags.GetDagDetails(dags.NewGetDagDetailsParams().WithDagID("missing"), auth)
+		var apiErr *dags.GetDagDetailsDefault
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusNotFound, apiErr.Code())
+		require.Equal(t, "not found", *apiErr.Payload.Message)
+	})
+}
@@ -0,0 +1,27 @@
+// Package client is the home of the Go clients of the Dagu REST API.
+//
+// The clients are generated from the OpenAPI schema (api.v1.yaml) with
+// `make api` and versioned by the API path they talk to: package v1 is the
+// client of /api/v1. A breaking change to the API gets a new package so
+// that the tools built on an older version keep working.
+//
+// Example:
+//
+//	cli := v1.NewHTTPClientWithConfig(nil, v1.DefaultTransportConfig().
+//		WithHost("localhost:8080"))
+//	resp, err := cli.Dags.ListDags(dags.NewListDagsParams())
+//	if err != nil {
+//		return err
+//	}
+//	for _, item := range resp.Payload.DAGs {
+//		fmt.Println(*item.DAG.Name)
+//	}
+//
+// The requests to a server with basic authentication can be sent with the
+// auth writer of github.com/go-openapi/runtime/client:
+//
+//	auth := httptransport.BasicAuth("user", "password")
+//	resp, err := cli.Dags.ListDags(dags.NewListDagsParams(), func(op *runtime.ClientOperation) {
+//		op.AuthInfo = auth
+//	})
+package client
@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewCancelQueuedRunParams creates a new CancelQueuedRunParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCancelQueuedRunParams() *CancelQueuedRunParams {
+	return &CancelQueuedRunParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCancelQueuedRunParamsWithTimeout creates a new CancelQueuedRunParams object
+// with the ability to set a timeout on a request.
+func NewCancelQueuedRunParamsWithTimeout(timeout time.Duration) *CancelQueuedRunParams {
+	return &CancelQueuedRunParams{
+		timeout: timeout,
+	}
+}
+
+// NewCancelQueuedRunParamsWithContext creates a new CancelQueuedRunParams object
+// with the ability to set a context for a request.
+func NewCancelQueuedRunParamsWithContext(ctx context.Context) *CancelQueuedRunParams {
+	return &CancelQueuedRunParams{
+		Context: ctx,
+	}
+}
+
+// NewCancelQueuedRunParamsWithHTTPClient creates a new CancelQueuedRunParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCancelQueuedRunParamsWithHTTPClient(client *http.Client) *CancelQueuedRunParams {
+	return &CancelQueuedRunParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CancelQueuedRunParams contains all the parameters to send to the API endpoint
+
+	for the cancel queued run operation.
+
+	Typically these are written to a http.Request.
+*/
+type CancelQueuedRunParams struct {
+
+	// RequestID.
+	RequestID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the cancel queued run params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CancelQueuedRunParams) WithDefaults() *CancelQueuedRunParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the cancel queued run params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CancelQueuedRunParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the cancel queued run params
+func (o *CancelQueuedRunParams) WithTimeout(timeout time.Duration) *CancelQueuedRunParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the cancel queued run params
+func (o *CancelQueuedRunParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the cancel queued run params
+func (o *CancelQueuedRunParams) WithContext(ctx context.Context) *CancelQueuedRunParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the cancel queued run params
+func (o *CancelQueuedRunParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the cancel queued run params
+func (o *CancelQueuedRunParams) WithHTTPClient(client *http.Client) *CancelQueuedRunParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the cancel queued run params
+func (o *CancelQueuedRunParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithRequestID adds the requestID to the cancel queued run params
+func (o *CancelQueuedRunParams) WithRequestID(requestID string) *CancelQueuedRunParams {
+	o.SetRequestID(requestID)
+	return o
+}
+
+// SetRequestID adds the requestId to the cancel queued run params
+func (o *CancelQueuedRunParams) SetRequestID(requestID string) {
+	o.RequestID = requestID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CancelQueuedRunParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param requestId
+	if err := r.SetPathParam("requestId", o.RequestID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// CancelQueuedRunReader is a Reader for the CancelQueuedRun structure.
+type CancelQueuedRunReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CancelQueuedRunReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCancelQueuedRunOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewCancelQueuedRunDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCancelQueuedRunOK creates a CancelQueuedRunOK with default headers values
+func NewCancelQueuedRunOK() *CancelQueuedRunOK {
+	return &CancelQueuedRunOK{}
+}
+
+/*
+CancelQueuedRunOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type CancelQueuedRunOK struct {
+}
+
+// IsSuccess returns true when this cancel queued run o k response has a 2xx status code
+func (o *CancelQueuedRunOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this cancel queued run o k response has a 3xx status code
+func (o *CancelQueuedRunOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cancel queued run o k response has a 4xx status code
+func (o *CancelQueuedRunOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this cancel queued run o k response has a 5xx status code
+func (o *CancelQueuedRunOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cancel queued run o k response a status code equal to that given
+func (o *CancelQueuedRunOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the cancel queued run o k response
+func (o *CancelQueuedRunOK) Code() int {
+	return 200
+}
+
+func (o *CancelQueuedRunOK) Error() string {
+	return fmt.Sprintf("[DELETE /queue/{requestId}][%d] cancelQueuedRunOK ", 200)
+}
+
+func (o *CancelQueuedRunOK) String() string {
+	return fmt.Sprintf("[DELETE /queue/{requestId}][%d] cancelQueuedRunOK ", 200)
+}
+
+func (o *CancelQueuedRunOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewCancelQueuedRunDefault creates a CancelQueuedRunDefault with default headers values
+func NewCancelQueuedRunDefault(code int) *CancelQueuedRunDefault {
+	return &CancelQueuedRunDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+CancelQueuedRunDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type CancelQueuedRunDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this cancel queued run default response has a 2xx status code
+func (o *CancelQueuedRunDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this cancel queued run default response has a 3xx status code
+func (o *CancelQueuedRunDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this cancel queued run default response has a 4xx status code
+func (o *CancelQueuedRunDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this cancel queued run default response has a 5xx status code
+func (o *CancelQueuedRunDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this cancel queued run default response a status code equal to that given
+func (o *CancelQueuedRunDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the cancel queued run default response
+func (o *CancelQueuedRunDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CancelQueuedRunDefault) Error() string {
+	return fmt.Sprintf("[DELETE /queue/{requestId}][%d] cancelQueuedRun default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CancelQueuedRunDefault) String() string {
+	return fmt.Sprintf("[DELETE /queue/{requestId}][%d] cancelQueuedRun default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CancelQueuedRunDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *CancelQueuedRunDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
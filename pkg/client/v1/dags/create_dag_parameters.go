@@ -0,0 +1,146 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewCreateDagParams creates a new CreateDagParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCreateDagParams() *CreateDagParams {
+	return &CreateDagParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateDagParamsWithTimeout creates a new CreateDagParams object
+// with the ability to set a timeout on a request.
+func NewCreateDagParamsWithTimeout(timeout time.Duration) *CreateDagParams {
+	return &CreateDagParams{
+		timeout: timeout,
+	}
+}
+
+// NewCreateDagParamsWithContext creates a new CreateDagParams object
+// with the ability to set a context for a request.
+func NewCreateDagParamsWithContext(ctx context.Context) *CreateDagParams {
+	return &CreateDagParams{
+		Context: ctx,
+	}
+}
+
+// NewCreateDagParamsWithHTTPClient creates a new CreateDagParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCreateDagParamsWithHTTPClient(client *http.Client) *CreateDagParams {
+	return &CreateDagParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CreateDagParams contains all the parameters to send to the API endpoint
+
+	for the create dag operation.
+
+	Typically these are written to a http.Request.
+*/
+type CreateDagParams struct {
+
+	// Body.
+	Body CreateDagBody
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the create dag params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateDagParams) WithDefaults() *CreateDagParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the create dag params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateDagParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the create dag params
+func (o *CreateDagParams) WithTimeout(timeout time.Duration) *CreateDagParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create dag params
+func (o *CreateDagParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create dag params
+func (o *CreateDagParams) WithContext(ctx context.Context) *CreateDagParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create dag params
+func (o *CreateDagParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create dag params
+func (o *CreateDagParams) WithHTTPClient(client *http.Client) *CreateDagParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create dag params
+func (o *CreateDagParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the create dag params
+func (o *CreateDagParams) WithBody(body CreateDagBody) *CreateDagParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the create dag params
+func (o *CreateDagParams) SetBody(body CreateDagBody) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateDagParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,260 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// CreateDagReader is a Reader for the CreateDag structure.
+type CreateDagReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateDagReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCreateDagOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewCreateDagDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCreateDagOK creates a CreateDagOK with default headers values
+func NewCreateDagOK() *CreateDagOK {
+	return &CreateDagOK{}
+}
+
+/*
+CreateDagOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type CreateDagOK struct {
+	Payload *models.CreateDagResponse
+}
+
+// IsSuccess returns true when this create dag o k response has a 2xx status code
+func (o *CreateDagOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this create dag o k response has a 3xx status code
+func (o *CreateDagOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create dag o k response has a 4xx status code
+func (o *CreateDagOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create dag o k response has a 5xx status code
+func (o *CreateDagOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create dag o k response a status code equal to that given
+func (o *CreateDagOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the create dag o k response
+func (o *CreateDagOK) Code() int {
+	return 200
+}
+
+func (o *CreateDagOK) Error() string {
+	return fmt.Sprintf("[POST /dags][%d] createDagOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateDagOK) String() string {
+	return fmt.Sprintf("[POST /dags][%d] createDagOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateDagOK) GetPayload() *models.CreateDagResponse {
+	return o.Payload
+}
+
+func (o *CreateDagOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.CreateDagResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateDagDefault creates a CreateDagDefault with default headers values
+func NewCreateDagDefault(code int) *CreateDagDefault {
+	return &CreateDagDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+CreateDagDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type CreateDagDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this create dag default response has a 2xx status code
+func (o *CreateDagDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this create dag default response has a 3xx status code
+func (o *CreateDagDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this create dag default response has a 4xx status code
+func (o *CreateDagDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this create dag default response has a 5xx status code
+func (o *CreateDagDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this create dag default response a status code equal to that given
+func (o *CreateDagDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the create dag default response
+func (o *CreateDagDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CreateDagDefault) Error() string {
+	return fmt.Sprintf("[POST /dags][%d] createDag default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CreateDagDefault) String() string {
+	return fmt.Sprintf("[POST /dags][%d] createDag default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CreateDagDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *CreateDagDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+CreateDagBody create dag body
+swagger:model CreateDagBody
+*/
+type CreateDagBody struct {
+
+	// action
+	// Required: true
+	Action *string `json:"action"`
+
+	// value
+	// Required: true
+	Value *string `json:"value"`
+}
+
+// Validate validates this create dag body
+func (o *CreateDagBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateValue(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CreateDagBody) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("body"+"."+"action", "body", o.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CreateDagBody) validateValue(formats strfmt.Registry) error {
+
+	if err := validate.Required("body"+"."+"value", "body", o.Value); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this create dag body based on context it is used
+func (o *CreateDagBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateDagBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateDagBody) UnmarshalBinary(b []byte) error {
+	var res CreateDagBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,936 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new dags API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for dags API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption is the option for Client methods
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	CancelQueuedRun(params *CancelQueuedRunParams, opts ...ClientOption) (*CancelQueuedRunOK, error)
+
+	CreateDag(params *CreateDagParams, opts ...ClientOption) (*CreateDagOK, error)
+
+	DeleteDag(params *DeleteDagParams, opts ...ClientOption) (*DeleteDagOK, error)
+
+	GetArtifact(params *GetArtifactParams, writer io.Writer, opts ...ClientOption) (*GetArtifactOK, error)
+
+	GetDagAnalytics(params *GetDagAnalyticsParams, opts ...ClientOption) (*GetDagAnalyticsOK, error)
+
+	GetDagConfigSources(params *GetDagConfigSourcesParams, opts ...ClientOption) (*GetDagConfigSourcesOK, error)
+
+	GetDagDetails(params *GetDagDetailsParams, opts ...ClientOption) (*GetDagDetailsOK, error)
+
+	GetDagFailures(params *GetDagFailuresParams, opts ...ClientOption) (*GetDagFailuresOK, error)
+
+	GetDagGraph(params *GetDagGraphParams, writer io.Writer, opts ...ClientOption) (*GetDagGraphOK, error)
+
+	GetRunLineage(params *GetRunLineageParams, opts ...ClientOption) (*GetRunLineageOK, error)
+
+	GetScheduleCalendar(params *GetScheduleCalendarParams, writer io.Writer, opts ...ClientOption) (*GetScheduleCalendarOK, error)
+
+	GetTimeline(params *GetTimelineParams, opts ...ClientOption) (*GetTimelineOK, error)
+
+	ListDags(params *ListDagsParams, opts ...ClientOption) (*ListDagsOK, error)
+
+	ListQueuedRuns(params *ListQueuedRunsParams, opts ...ClientOption) (*ListQueuedRunsOK, error)
+
+	ListTags(params *ListTagsParams, opts ...ClientOption) (*ListTagsOK, error)
+
+	PostDagAction(params *PostDagActionParams, opts ...ClientOption) (*PostDagActionOK, error)
+
+	PostRunNote(params *PostRunNoteParams, opts ...ClientOption) (*PostRunNoteOK, error)
+
+	RetryDagStep(params *RetryDagStepParams, opts ...ClientOption) (*RetryDagStepOK, error)
+
+	SearchDags(params *SearchDagsParams, opts ...ClientOption) (*SearchDagsOK, error)
+
+	SignalDagStep(params *SignalDagStepParams, opts ...ClientOption) (*SignalDagStepOK, error)
+
+	SkipDagStep(params *SkipDagStepParams, opts ...ClientOption) (*SkipDagStepOK, error)
+
+	StartDag(params *StartDagParams, opts ...ClientOption) (*StartDagOK, error)
+
+	UpdateQueuedRun(params *UpdateQueuedRunParams, opts ...ClientOption) (*UpdateQueuedRunOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+CancelQueuedRun Cancels a queued run. The run is removed from the queue and never started.
+*/
+func (a *Client) CancelQueuedRun(params *CancelQueuedRunParams, opts ...ClientOption) (*CancelQueuedRunOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCancelQueuedRunParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "cancelQueuedRun",
+		Method:             "DELETE",
+		PathPattern:        "/queue/{requestId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &CancelQueuedRunReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CancelQueuedRunOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*CancelQueuedRunDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+CreateDag Creates a new DAG.
+*/
+func (a *Client) CreateDag(params *CreateDagParams, opts ...ClientOption) (*CreateDagOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateDagParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "createDag",
+		Method:             "POST",
+		PathPattern:        "/dags",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &CreateDagReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateDagOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*CreateDagDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+DeleteDag Deletes a DAG.
+*/
+func (a *Client) DeleteDag(params *DeleteDagParams, opts ...ClientOption) (*DeleteDagOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteDagParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteDag",
+		Method:             "DELETE",
+		PathPattern:        "/dags/{dagId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteDagReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteDagOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeleteDagDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetArtifact Downloads an artifact produced by a DAG run.
+*/
+func (a *Client) GetArtifact(params *GetArtifactParams, writer io.Writer, opts ...ClientOption) (*GetArtifactOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetArtifactParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getArtifact",
+		Method:             "GET",
+		PathPattern:        "/dags/{dagId}/requests/{requestId}/artifacts/{artifactName}",
+		ProducesMediaTypes: []string{"application/json", "application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetArtifactReader{formats: a.formats, writer: writer},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetArtifactOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetArtifactDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetDagAnalytics Returns the duration and resource usage trends of the recent runs of a DAG.
+*/
+func (a *Client) GetDagAnalytics(params *GetDagAnalyticsParams, opts ...ClientOption) (*GetDagAnalyticsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetDagAnalyticsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getDagAnalytics",
+		Method:             "GET",
+		PathPattern:        "/dags/{dagId}/analytics",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetDagAnalyticsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetDagAnalyticsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetDagAnalyticsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetDagConfigSources Returns the effective configuration values of a DAG and the base config or the DAG file each value comes from, for debugging the base config chain.
+*/
+func (a *Client) GetDagConfigSources(params *GetDagConfigSourcesParams, opts ...ClientOption) (*GetDagConfigSourcesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetDagConfigSourcesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getDagConfigSources",
+		Method:             "GET",
+		PathPattern:        "/dags/{dagId}/config-sources",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetDagConfigSourcesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetDagConfigSourcesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetDagConfigSourcesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetDagDetails Returns details of a DAG.
+*/
+func (a *Client) GetDagDetails(params *GetDagDetailsParams, opts ...ClientOption) (*GetDagDetailsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetDagDetailsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getDagDetails",
+		Method:             "GET",
+		PathPattern:        "/dags/{dagId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetDagDetailsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetDagDetailsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetDagDetailsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetDagFailures Returns the steps of a DAG that failed most often in the recent runs with their most common errors.
+*/
+func (a *Client) GetDagFailures(params *GetDagFailuresParams, opts ...ClientOption) (*GetDagFailuresOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetDagFailuresParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getDagFailures",
+		Method:             "GET",
+		PathPattern:        "/dags/{dagId}/failures",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetDagFailuresReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetDagFailuresOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetDagFailuresDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetDagGraph Renders the graph of the steps of a DAG with the statuses of a run as an SVG image.
+*/
+func (a *Client) GetDagGraph(params *GetDagGraphParams, writer io.Writer, opts ...ClientOption) (*GetDagGraphOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetDagGraphParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getDagGraph",
+		Method:             "GET",
+		PathPattern:        "/dags/{dagId}/graph.svg",
+		ProducesMediaTypes: []string{"application/json", "image/svg+xml"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetDagGraphReader{formats: a.formats, writer: writer},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetDagGraphOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetDagGraphDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetRunLineage Returns the lineage of a DAG run, the runs it comes from back to its origin and the runs it triggered.
+*/
+func (a *Client) GetRunLineage(params *GetRunLineageParams, opts ...ClientOption) (*GetRunLineageOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetRunLineageParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getRunLineage",
+		Method:             "GET",
+		PathPattern:        "/dags/{dagId}/requests/{requestId}/lineage",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetRunLineageReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetRunLineageOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetRunLineageDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetScheduleCalendar Exports the scheduled runs of the DAGs as an iCalendar feed.
+*/
+func (a *Client) GetScheduleCalendar(params *GetScheduleCalendarParams, writer io.Writer, opts ...ClientOption) (*GetScheduleCalendarOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetScheduleCalendarParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getScheduleCalendar",
+		Method:             "GET",
+		PathPattern:        "/calendar.ics",
+		ProducesMediaTypes: []string{"application/json", "text/calendar"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetScheduleCalendarReader{formats: a.formats, writer: writer},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetScheduleCalendarOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetScheduleCalendarDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetTimeline Simulates the scheduler to list the runs expected in the next hours, including the ones skipped because the DAG is suspended or out of its time window.
+*/
+func (a *Client) GetTimeline(params *GetTimelineParams, opts ...ClientOption) (*GetTimelineOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetTimelineParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getTimeline",
+		Method:             "GET",
+		PathPattern:        "/timeline",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetTimelineReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetTimelineOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetTimelineDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ListDags Returns a list of DAGs.
+*/
+func (a *Client) ListDags(params *ListDagsParams, opts ...ClientOption) (*ListDagsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListDagsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listDags",
+		Method:             "GET",
+		PathPattern:        "/dags",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListDagsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListDagsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListDagsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ListQueuedRuns Returns the runs waiting to be started by the scheduler in the order they are started, with their position in the queue of their DAG and the reason they are waiting.
+*/
+func (a *Client) ListQueuedRuns(params *ListQueuedRunsParams, opts ...ClientOption) (*ListQueuedRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListQueuedRunsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listQueuedRuns",
+		Method:             "GET",
+		PathPattern:        "/queue",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListQueuedRunsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListQueuedRunsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListQueuedRunsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ListTags Returns a list of tags.
+*/
+func (a *Client) ListTags(params *ListTagsParams, opts ...ClientOption) (*ListTagsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListTagsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listTags",
+		Method:             "GET",
+		PathPattern:        "/tags",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListTagsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListTagsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListTagsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+PostDagAction Performs an action on a DAG.
+*/
+func (a *Client) PostDagAction(params *PostDagActionParams, opts ...ClientOption) (*PostDagActionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostDagActionParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "postDagAction",
+		Method:             "POST",
+		PathPattern:        "/dags/{dagId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostDagActionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostDagActionOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostDagActionDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+PostRunNote Attaches a note to a DAG run.
+*/
+func (a *Client) PostRunNote(params *PostRunNoteParams, opts ...ClientOption) (*PostRunNoteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostRunNoteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "postRunNote",
+		Method:             "POST",
+		PathPattern:        "/dags/{dagId}/requests/{requestId}/notes",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostRunNoteReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostRunNoteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostRunNoteDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+RetryDagStep Retries a single step of a DAG run.
+*/
+func (a *Client) RetryDagStep(params *RetryDagStepParams, opts ...ClientOption) (*RetryDagStepOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRetryDagStepParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "retryDagStep",
+		Method:             "POST",
+		PathPattern:        "/dags/{dagId}/requests/{requestId}/steps/{stepName}/retry",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RetryDagStepReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RetryDagStepOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RetryDagStepDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+SearchDags Searches for DAGs.
+*/
+func (a *Client) SearchDags(params *SearchDagsParams, opts ...ClientOption) (*SearchDagsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSearchDagsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "searchDags",
+		Method:             "GET",
+		PathPattern:        "/search",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SearchDagsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SearchDagsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*SearchDagsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+SignalDagStep Sends a signal to the process group of a running step without stopping the DAG run, e.g. SIGHUP to make a long-running step reload its config or reopen its log files.
+*/
+func (a *Client) SignalDagStep(params *SignalDagStepParams, opts ...ClientOption) (*SignalDagStepOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSignalDagStepParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "signalDagStep",
+		Method:             "POST",
+		PathPattern:        "/dags/{dagId}/requests/{requestId}/steps/{stepName}/signal",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SignalDagStepReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SignalDagStepOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*SignalDagStepDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+SkipDagStep Marks a step of a queued or running DAG run which has not started yet to be skipped. The steps after it run as if it succeeded.
+*/
+func (a *Client) SkipDagStep(params *SkipDagStepParams, opts ...ClientOption) (*SkipDagStepOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSkipDagStepParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "skipDagStep",
+		Method:             "POST",
+		PathPattern:        "/dags/{dagId}/requests/{requestId}/steps/{stepName}/skip",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SkipDagStepReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SkipDagStepOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*SkipDagStepDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+StartDag Starts a DAG with the parameters, e.g. from a webhook of GitHub Actions or Airflow. The DAG runs asynchronously and the request ID of the run is returned. When the request ID is given and the run already exists, the run is returned without starting the DAG again.
+*/
+func (a *Client) StartDag(params *StartDagParams, opts ...ClientOption) (*StartDagOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewStartDagParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "startDag",
+		Method:             "POST",
+		PathPattern:        "/dags/{dagId}/start",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &StartDagReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*StartDagOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*StartDagDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+UpdateQueuedRun Changes the priority of a queued run. The due runs of a DAG are started in the order of the priority, the highest first.
+*/
+func (a *Client) UpdateQueuedRun(params *UpdateQueuedRunParams, opts ...ClientOption) (*UpdateQueuedRunOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateQueuedRunParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "updateQueuedRun",
+		Method:             "PATCH",
+		PathPattern:        "/queue/{requestId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UpdateQueuedRunReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateQueuedRunOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UpdateQueuedRunDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeleteDagParams creates a new DeleteDagParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDeleteDagParams() *DeleteDagParams {
+	return &DeleteDagParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteDagParamsWithTimeout creates a new DeleteDagParams object
+// with the ability to set a timeout on a request.
+func NewDeleteDagParamsWithTimeout(timeout time.Duration) *DeleteDagParams {
+	return &DeleteDagParams{
+		timeout: timeout,
+	}
+}
+
+// NewDeleteDagParamsWithContext creates a new DeleteDagParams object
+// with the ability to set a context for a request.
+func NewDeleteDagParamsWithContext(ctx context.Context) *DeleteDagParams {
+	return &DeleteDagParams{
+		Context: ctx,
+	}
+}
+
+// NewDeleteDagParamsWithHTTPClient creates a new DeleteDagParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDeleteDagParamsWithHTTPClient(client *http.Client) *DeleteDagParams {
+	return &DeleteDagParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+DeleteDagParams contains all the parameters to send to the API endpoint
+
+	for the delete dag operation.
+
+	Typically these are written to a http.Request.
+*/
+type DeleteDagParams struct {
+
+	// DagID.
+	DagID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the delete dag params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteDagParams) WithDefaults() *DeleteDagParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the delete dag params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteDagParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the delete dag params
+func (o *DeleteDagParams) WithTimeout(timeout time.Duration) *DeleteDagParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete dag params
+func (o *DeleteDagParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete dag params
+func (o *DeleteDagParams) WithContext(ctx context.Context) *DeleteDagParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete dag params
+func (o *DeleteDagParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete dag params
+func (o *DeleteDagParams) WithHTTPClient(client *http.Client) *DeleteDagParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete dag params
+func (o *DeleteDagParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDagID adds the dagID to the delete dag params
+func (o *DeleteDagParams) WithDagID(dagID string) *DeleteDagParams {
+	o.SetDagID(dagID)
+	return o
+}
+
+// SetDagID adds the dagId to the delete dag params
+func (o *DeleteDagParams) SetDagID(dagID string) {
+	o.DagID = dagID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteDagParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param dagId
+	if err := r.SetPathParam("dagId", o.DagID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
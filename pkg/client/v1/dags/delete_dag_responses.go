@@ -0,0 +1,170 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// DeleteDagReader is a Reader for the DeleteDag structure.
+type DeleteDagReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteDagReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDeleteDagOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewDeleteDagDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteDagOK creates a DeleteDagOK with default headers values
+func NewDeleteDagOK() *DeleteDagOK {
+	return &DeleteDagOK{}
+}
+
+/*
+DeleteDagOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type DeleteDagOK struct {
+}
+
+// IsSuccess returns true when this delete dag o k response has a 2xx status code
+func (o *DeleteDagOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this delete dag o k response has a 3xx status code
+func (o *DeleteDagOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete dag o k response has a 4xx status code
+func (o *DeleteDagOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete dag o k response has a 5xx status code
+func (o *DeleteDagOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete dag o k response a status code equal to that given
+func (o *DeleteDagOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the delete dag o k response
+func (o *DeleteDagOK) Code() int {
+	return 200
+}
+
+func (o *DeleteDagOK) Error() string {
+	return fmt.Sprintf("[DELETE /dags/{dagId}][%d] deleteDagOK ", 200)
+}
+
+func (o *DeleteDagOK) String() string {
+	return fmt.Sprintf("[DELETE /dags/{dagId}][%d] deleteDagOK ", 200)
+}
+
+func (o *DeleteDagOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteDagDefault creates a DeleteDagDefault with default headers values
+func NewDeleteDagDefault(code int) *DeleteDagDefault {
+	return &DeleteDagDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+DeleteDagDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type DeleteDagDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this delete dag default response has a 2xx status code
+func (o *DeleteDagDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this delete dag default response has a 3xx status code
+func (o *DeleteDagDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this delete dag default response has a 4xx status code
+func (o *DeleteDagDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this delete dag default response has a 5xx status code
+func (o *DeleteDagDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this delete dag default response a status code equal to that given
+func (o *DeleteDagDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the delete dag default response
+func (o *DeleteDagDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteDagDefault) Error() string {
+	return fmt.Sprintf("[DELETE /dags/{dagId}][%d] deleteDag default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeleteDagDefault) String() string {
+	return fmt.Sprintf("[DELETE /dags/{dagId}][%d] deleteDag default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeleteDagDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *DeleteDagDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
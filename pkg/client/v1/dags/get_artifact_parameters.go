@@ -0,0 +1,186 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetArtifactParams creates a new GetArtifactParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetArtifactParams() *GetArtifactParams {
+	return &GetArtifactParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetArtifactParamsWithTimeout creates a new GetArtifactParams object
+// with the ability to set a timeout on a request.
+func NewGetArtifactParamsWithTimeout(timeout time.Duration) *GetArtifactParams {
+	return &GetArtifactParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetArtifactParamsWithContext creates a new GetArtifactParams object
+// with the ability to set a context for a request.
+func NewGetArtifactParamsWithContext(ctx context.Context) *GetArtifactParams {
+	return &GetArtifactParams{
+		Context: ctx,
+	}
+}
+
+// NewGetArtifactParamsWithHTTPClient creates a new GetArtifactParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetArtifactParamsWithHTTPClient(client *http.Client) *GetArtifactParams {
+	return &GetArtifactParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetArtifactParams contains all the parameters to send to the API endpoint
+
+	for the get artifact operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetArtifactParams struct {
+
+	// ArtifactName.
+	ArtifactName string
+
+	// DagID.
+	DagID string
+
+	// RequestID.
+	RequestID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get artifact params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetArtifactParams) WithDefaults() *GetArtifactParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get artifact params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetArtifactParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get artifact params
+func (o *GetArtifactParams) WithTimeout(timeout time.Duration) *GetArtifactParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get artifact params
+func (o *GetArtifactParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get artifact params
+func (o *GetArtifactParams) WithContext(ctx context.Context) *GetArtifactParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get artifact params
+func (o *GetArtifactParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get artifact params
+func (o *GetArtifactParams) WithHTTPClient(client *http.Client) *GetArtifactParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get artifact params
+func (o *GetArtifactParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithArtifactName adds the artifactName to the get artifact params
+func (o *GetArtifactParams) WithArtifactName(artifactName string) *GetArtifactParams {
+	o.SetArtifactName(artifactName)
+	return o
+}
+
+// SetArtifactName adds the artifactName to the get artifact params
+func (o *GetArtifactParams) SetArtifactName(artifactName string) {
+	o.ArtifactName = artifactName
+}
+
+// WithDagID adds the dagID to the get artifact params
+func (o *GetArtifactParams) WithDagID(dagID string) *GetArtifactParams {
+	o.SetDagID(dagID)
+	return o
+}
+
+// SetDagID adds the dagId to the get artifact params
+func (o *GetArtifactParams) SetDagID(dagID string) {
+	o.DagID = dagID
+}
+
+// WithRequestID adds the requestID to the get artifact params
+func (o *GetArtifactParams) WithRequestID(requestID string) *GetArtifactParams {
+	o.SetRequestID(requestID)
+	return o
+}
+
+// SetRequestID adds the requestId to the get artifact params
+func (o *GetArtifactParams) SetRequestID(requestID string) {
+	o.RequestID = requestID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetArtifactParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param artifactName
+	if err := r.SetPathParam("artifactName", o.ArtifactName); err != nil {
+		return err
+	}
+
+	// path param dagId
+	if err := r.SetPathParam("dagId", o.DagID); err != nil {
+		return err
+	}
+
+	// path param requestId
+	if err := r.SetPathParam("requestId", o.RequestID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// GetArtifactReader is a Reader for the GetArtifact structure.
+type GetArtifactReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetArtifactReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetArtifactOK(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetArtifactDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetArtifactOK creates a GetArtifactOK with default headers values
+func NewGetArtifactOK(writer io.Writer) *GetArtifactOK {
+	return &GetArtifactOK{
+
+		Payload: writer,
+	}
+}
+
+/*
+GetArtifactOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type GetArtifactOK struct {
+	Payload io.Writer
+}
+
+// IsSuccess returns true when this get artifact o k response has a 2xx status code
+func (o *GetArtifactOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get artifact o k response has a 3xx status code
+func (o *GetArtifactOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get artifact o k response has a 4xx status code
+func (o *GetArtifactOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get artifact o k response has a 5xx status code
+func (o *GetArtifactOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get artifact o k response a status code equal to that given
+func (o *GetArtifactOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get artifact o k response
+func (o *GetArtifactOK) Code() int {
+	return 200
+}
+
+func (o *GetArtifactOK) Error() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/requests/{requestId}/artifacts/{artifactName}][%d] getArtifactOK  %+v", 200, o.Payload)
+}
+
+func (o *GetArtifactOK) String() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/requests/{requestId}/artifacts/{artifactName}][%d] getArtifactOK  %+v", 200, o.Payload)
+}
+
+func (o *GetArtifactOK) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *GetArtifactOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetArtifactDefault creates a GetArtifactDefault with default headers values
+func NewGetArtifactDefault(code int) *GetArtifactDefault {
+	return &GetArtifactDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetArtifactDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type GetArtifactDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this get artifact default response has a 2xx status code
+func (o *GetArtifactDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get artifact default response has a 3xx status code
+func (o *GetArtifactDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get artifact default response has a 4xx status code
+func (o *GetArtifactDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get artifact default response has a 5xx status code
+func (o *GetArtifactDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get artifact default response a status code equal to that given
+func (o *GetArtifactDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get artifact default response
+func (o *GetArtifactDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetArtifactDefault) Error() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/requests/{requestId}/artifacts/{artifactName}][%d] getArtifact default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetArtifactDefault) String() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/requests/{requestId}/artifacts/{artifactName}][%d] getArtifact default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetArtifactDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *GetArtifactDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
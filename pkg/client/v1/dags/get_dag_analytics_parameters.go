@@ -0,0 +1,217 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetDagAnalyticsParams creates a new GetDagAnalyticsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetDagAnalyticsParams() *GetDagAnalyticsParams {
+	return &GetDagAnalyticsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetDagAnalyticsParamsWithTimeout creates a new GetDagAnalyticsParams object
+// with the ability to set a timeout on a request.
+func NewGetDagAnalyticsParamsWithTimeout(timeout time.Duration) *GetDagAnalyticsParams {
+	return &GetDagAnalyticsParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetDagAnalyticsParamsWithContext creates a new GetDagAnalyticsParams object
+// with the ability to set a context for a request.
+func NewGetDagAnalyticsParamsWithContext(ctx context.Context) *GetDagAnalyticsParams {
+	return &GetDagAnalyticsParams{
+		Context: ctx,
+	}
+}
+
+// NewGetDagAnalyticsParamsWithHTTPClient creates a new GetDagAnalyticsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetDagAnalyticsParamsWithHTTPClient(client *http.Client) *GetDagAnalyticsParams {
+	return &GetDagAnalyticsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetDagAnalyticsParams contains all the parameters to send to the API endpoint
+
+	for the get dag analytics operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetDagAnalyticsParams struct {
+
+	// DagID.
+	DagID string
+
+	/* Limit.
+
+	   The number of the recent runs to analyze.
+	*/
+	Limit *int64
+
+	/* Threshold.
+
+	   The ratio of the latest duration of a step to its baseline above which the step is regarded as regressed.
+	*/
+	Threshold *float64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get dag analytics params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetDagAnalyticsParams) WithDefaults() *GetDagAnalyticsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get dag analytics params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetDagAnalyticsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get dag analytics params
+func (o *GetDagAnalyticsParams) WithTimeout(timeout time.Duration) *GetDagAnalyticsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get dag analytics params
+func (o *GetDagAnalyticsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get dag analytics params
+func (o *GetDagAnalyticsParams) WithContext(ctx context.Context) *GetDagAnalyticsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get dag analytics params
+func (o *GetDagAnalyticsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get dag analytics params
+func (o *GetDagAnalyticsParams) WithHTTPClient(client *http.Client) *GetDagAnalyticsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get dag analytics params
+func (o *GetDagAnalyticsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDagID adds the dagID to the get dag analytics params
+func (o *GetDagAnalyticsParams) WithDagID(dagID string) *GetDagAnalyticsParams {
+	o.SetDagID(dagID)
+	return o
+}
+
+// SetDagID adds the dagId to the get dag analytics params
+func (o *GetDagAnalyticsParams) SetDagID(dagID string) {
+	o.DagID = dagID
+}
+
+// WithLimit adds the limit to the get dag analytics params
+func (o *GetDagAnalyticsParams) WithLimit(limit *int64) *GetDagAnalyticsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the get dag analytics params
+func (o *GetDagAnalyticsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithThreshold adds the threshold to the get dag analytics params
+func (o *GetDagAnalyticsParams) WithThreshold(threshold *float64) *GetDagAnalyticsParams {
+	o.SetThreshold(threshold)
+	return o
+}
+
+// SetThreshold adds the threshold to the get dag analytics params
+func (o *GetDagAnalyticsParams) SetThreshold(threshold *float64) {
+	o.Threshold = threshold
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetDagAnalyticsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param dagId
+	if err := r.SetPathParam("dagId", o.DagID); err != nil {
+		return err
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Threshold != nil {
+
+		// query param threshold
+		var qrThreshold float64
+
+		if o.Threshold != nil {
+			qrThreshold = *o.Threshold
+		}
+		qThreshold := swag.FormatFloat64(qrThreshold)
+		if qThreshold != "" {
+
+			if err := r.SetQueryParam("threshold", qThreshold); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// GetDagAnalyticsReader is a Reader for the GetDagAnalytics structure.
+type GetDagAnalyticsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetDagAnalyticsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetDagAnalyticsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetDagAnalyticsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetDagAnalyticsOK creates a GetDagAnalyticsOK with default headers values
+func NewGetDagAnalyticsOK() *GetDagAnalyticsOK {
+	return &GetDagAnalyticsOK{}
+}
+
+/*
+GetDagAnalyticsOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type GetDagAnalyticsOK struct {
+	Payload *models.DagAnalyticsResponse
+}
+
+// IsSuccess returns true when this get dag analytics o k response has a 2xx status code
+func (o *GetDagAnalyticsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get dag analytics o k response has a 3xx status code
+func (o *GetDagAnalyticsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get dag analytics o k response has a 4xx status code
+func (o *GetDagAnalyticsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get dag analytics o k response has a 5xx status code
+func (o *GetDagAnalyticsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get dag analytics o k response a status code equal to that given
+func (o *GetDagAnalyticsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get dag analytics o k response
+func (o *GetDagAnalyticsOK) Code() int {
+	return 200
+}
+
+func (o *GetDagAnalyticsOK) Error() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/analytics][%d] getDagAnalyticsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetDagAnalyticsOK) String() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/analytics][%d] getDagAnalyticsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetDagAnalyticsOK) GetPayload() *models.DagAnalyticsResponse {
+	return o.Payload
+}
+
+func (o *GetDagAnalyticsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.DagAnalyticsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetDagAnalyticsDefault creates a GetDagAnalyticsDefault with default headers values
+func NewGetDagAnalyticsDefault(code int) *GetDagAnalyticsDefault {
+	return &GetDagAnalyticsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetDagAnalyticsDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type GetDagAnalyticsDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this get dag analytics default response has a 2xx status code
+func (o *GetDagAnalyticsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get dag analytics default response has a 3xx status code
+func (o *GetDagAnalyticsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get dag analytics default response has a 4xx status code
+func (o *GetDagAnalyticsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get dag analytics default response has a 5xx status code
+func (o *GetDagAnalyticsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get dag analytics default response a status code equal to that given
+func (o *GetDagAnalyticsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get dag analytics default response
+func (o *GetDagAnalyticsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetDagAnalyticsDefault) Error() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/analytics][%d] getDagAnalytics default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetDagAnalyticsDefault) String() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/analytics][%d] getDagAnalytics default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetDagAnalyticsDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *GetDagAnalyticsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
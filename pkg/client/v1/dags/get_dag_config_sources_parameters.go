@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetDagConfigSourcesParams creates a new GetDagConfigSourcesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetDagConfigSourcesParams() *GetDagConfigSourcesParams {
+	return &GetDagConfigSourcesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetDagConfigSourcesParamsWithTimeout creates a new GetDagConfigSourcesParams object
+// with the ability to set a timeout on a request.
+func NewGetDagConfigSourcesParamsWithTimeout(timeout time.Duration) *GetDagConfigSourcesParams {
+	return &GetDagConfigSourcesParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetDagConfigSourcesParamsWithContext creates a new GetDagConfigSourcesParams object
+// with the ability to set a context for a request.
+func NewGetDagConfigSourcesParamsWithContext(ctx context.Context) *GetDagConfigSourcesParams {
+	return &GetDagConfigSourcesParams{
+		Context: ctx,
+	}
+}
+
+// NewGetDagConfigSourcesParamsWithHTTPClient creates a new GetDagConfigSourcesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetDagConfigSourcesParamsWithHTTPClient(client *http.Client) *GetDagConfigSourcesParams {
+	return &GetDagConfigSourcesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetDagConfigSourcesParams contains all the parameters to send to the API endpoint
+
+	for the get dag config sources operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetDagConfigSourcesParams struct {
+
+	// DagID.
+	DagID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get dag config sources params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetDagConfigSourcesParams) WithDefaults() *GetDagConfigSourcesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get dag config sources params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetDagConfigSourcesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get dag config sources params
+func (o *GetDagConfigSourcesParams) WithTimeout(timeout time.Duration) *GetDagConfigSourcesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get dag config sources params
+func (o *GetDagConfigSourcesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get dag config sources params
+func (o *GetDagConfigSourcesParams) WithContext(ctx context.Context) *GetDagConfigSourcesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get dag config sources params
+func (o *GetDagConfigSourcesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get dag config sources params
+func (o *GetDagConfigSourcesParams) WithHTTPClient(client *http.Client) *GetDagConfigSourcesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get dag config sources params
+func (o *GetDagConfigSourcesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDagID adds the dagID to the get dag config sources params
+func (o *GetDagConfigSourcesParams) WithDagID(dagID string) *GetDagConfigSourcesParams {
+	o.SetDagID(dagID)
+	return o
+}
+
+// SetDagID adds the dagId to the get dag config sources params
+func (o *GetDagConfigSourcesParams) SetDagID(dagID string) {
+	o.DagID = dagID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetDagConfigSourcesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param dagId
+	if err := r.SetPathParam("dagId", o.DagID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
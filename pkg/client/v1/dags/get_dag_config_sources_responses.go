@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// GetDagConfigSourcesReader is a Reader for the GetDagConfigSources structure.
+type GetDagConfigSourcesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetDagConfigSourcesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetDagConfigSourcesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetDagConfigSourcesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetDagConfigSourcesOK creates a GetDagConfigSourcesOK with default headers values
+func NewGetDagConfigSourcesOK() *GetDagConfigSourcesOK {
+	return &GetDagConfigSourcesOK{}
+}
+
+/*
+GetDagConfigSourcesOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type GetDagConfigSourcesOK struct {
+	Payload *models.DagConfigSourcesResponse
+}
+
+// IsSuccess returns true when this get dag config sources o k response has a 2xx status code
+func (o *GetDagConfigSourcesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get dag config sources o k response has a 3xx status code
+func (o *GetDagConfigSourcesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get dag config sources o k response has a 4xx status code
+func (o *GetDagConfigSourcesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get dag config sources o k response has a 5xx status code
+func (o *GetDagConfigSourcesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get dag config sources o k response a status code equal to that given
+func (o *GetDagConfigSourcesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get dag config sources o k response
+func (o *GetDagConfigSourcesOK) Code() int {
+	return 200
+}
+
+func (o *GetDagConfigSourcesOK) Error() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/config-sources][%d] getDagConfigSourcesOK  %+v", 200, o.Payload)
+}
+
+func (o *GetDagConfigSourcesOK) String() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/config-sources][%d] getDagConfigSourcesOK  %+v", 200, o.Payload)
+}
+
+func (o *GetDagConfigSourcesOK) GetPayload() *models.DagConfigSourcesResponse {
+	return o.Payload
+}
+
+func (o *GetDagConfigSourcesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.DagConfigSourcesResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetDagConfigSourcesDefault creates a GetDagConfigSourcesDefault with default headers values
+func NewGetDagConfigSourcesDefault(code int) *GetDagConfigSourcesDefault {
+	return &GetDagConfigSourcesDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetDagConfigSourcesDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type GetDagConfigSourcesDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this get dag config sources default response has a 2xx status code
+func (o *GetDagConfigSourcesDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get dag config sources default response has a 3xx status code
+func (o *GetDagConfigSourcesDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get dag config sources default response has a 4xx status code
+func (o *GetDagConfigSourcesDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get dag config sources default response has a 5xx status code
+func (o *GetDagConfigSourcesDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get dag config sources default response a status code equal to that given
+func (o *GetDagConfigSourcesDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get dag config sources default response
+func (o *GetDagConfigSourcesDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetDagConfigSourcesDefault) Error() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/config-sources][%d] getDagConfigSources default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetDagConfigSourcesDefault) String() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/config-sources][%d] getDagConfigSources default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetDagConfigSourcesDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *GetDagConfigSourcesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetDagDetailsParams creates a new GetDagDetailsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetDagDetailsParams() *GetDagDetailsParams {
+	return &GetDagDetailsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetDagDetailsParamsWithTimeout creates a new GetDagDetailsParams object
+// with the ability to set a timeout on a request.
+func NewGetDagDetailsParamsWithTimeout(timeout time.Duration) *GetDagDetailsParams {
+	return &GetDagDetailsParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetDagDetailsParamsWithContext creates a new GetDagDetailsParams object
+// with the ability to set a context for a request.
+func NewGetDagDetailsParamsWithContext(ctx context.Context) *GetDagDetailsParams {
+	return &GetDagDetailsParams{
+		Context: ctx,
+	}
+}
+
+// NewGetDagDetailsParamsWithHTTPClient creates a new GetDagDetailsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetDagDetailsParamsWithHTTPClient(client *http.Client) *GetDagDetailsParams {
+	return &GetDagDetailsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetDagDetailsParams contains all the parameters to send to the API endpoint
+
+	for the get dag details operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetDagDetailsParams struct {
+
+	// DagID.
+	DagID string
+
+	// File.
+	File *string
+
+	/* Labels.
+
+	   Filters the history by labels (e.g. customer=acme,backfill=true).
+	*/
+	Labels *string
+
+	// Step.
+	Step *string
+
+	// Tab.
+	Tab *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get dag details params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetDagDetailsParams) WithDefaults() *GetDagDetailsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get dag details params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetDagDetailsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get dag details params
+func (o *GetDagDetailsParams) WithTimeout(timeout time.Duration) *GetDagDetailsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get dag details params
+func (o *GetDagDetailsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get dag details params
+func (o *GetDagDetailsParams) WithContext(ctx context.Context) *GetDagDetailsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get dag details params
+func (o *GetDagDetailsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get dag details params
+func (o *GetDagDetailsParams) WithHTTPClient(client *http.Client) *GetDagDetailsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get dag details params
+func (o *GetDagDetailsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDagID adds the dagID to the get dag details params
+func (o *GetDagDetailsParams) WithDagID(dagID string) *GetDagDetailsParams {
+	o.SetDagID(dagID)
+	return o
+}
+
+// SetDagID adds the dagId to the get dag details params
+func (o *GetDagDetailsParams) SetDagID(dagID string) {
+	o.DagID = dagID
+}
+
+// WithFile adds the file to the get dag details params
+func (o *GetDagDetailsParams) WithFile(file *string) *GetDagDetailsParams {
+	o.SetFile(file)
+	return o
+}
+
+// SetFile adds the file to the get dag details params
+func (o *GetDagDetailsParams) SetFile(file *string) {
+	o.File = file
+}
+
+// WithLabels adds the labels to the get dag details params
+func (o *GetDagDetailsParams) WithLabels(labels *string) *GetDagDetailsParams {
+	o.SetLabels(labels)
+	return o
+}
+
+// SetLabels adds the labels to the get dag details params
+func (o *GetDagDetailsParams) SetLabels(labels *string) {
+	o.Labels = labels
+}
+
+// WithStep adds the step to the get dag details params
+func (o *GetDagDetailsParams) WithStep(step *string) *GetDagDetailsParams {
+	o.SetStep(step)
+	return o
+}
+
+// SetStep adds the step to the get dag details params
+func (o *GetDagDetailsParams) SetStep(step *string) {
+	o.Step = step
+}
+
+// WithTab adds the tab to the get dag details params
+func (o *GetDagDetailsParams) WithTab(tab *string) *GetDagDetailsParams {
+	o.SetTab(tab)
+	return o
+}
+
+// SetTab adds the tab to the get dag details params
+func (o *GetDagDetailsParams) SetTab(tab *string) {
+	o.Tab = tab
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetDagDetailsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param dagId
+	if err := r.SetPathParam("dagId", o.DagID); err != nil {
+		return err
+	}
+
+	if o.File != nil {
+
+		// query param file
+		var qrFile string
+
+		if o.File != nil {
+			qrFile = *o.File
+		}
+		qFile := qrFile
+		if qFile != "" {
+
+			if err := r.SetQueryParam("file", qFile); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Labels != nil {
+
+		// query param labels
+		var qrLabels string
+
+		if o.Labels != nil {
+			qrLabels = *o.Labels
+		}
+		qLabels := qrLabels
+		if qLabels != "" {
+
+			if err := r.SetQueryParam("labels", qLabels); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Step != nil {
+
+		// query param step
+		var qrStep string
+
+		if o.Step != nil {
+			qrStep = *o.Step
+		}
+		qStep := qrStep
+		if qStep != "" {
+
+			if err := r.SetQueryParam("step", qStep); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Tab != nil {
+
+		// query param tab
+		var qrTab string
+
+		if o.Tab != nil {
+			qrTab = *o.Tab
+		}
+		qTab := qrTab
+		if qTab != "" {
+
+			if err := r.SetQueryParam("tab", qTab); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// GetDagDetailsReader is a Reader for the GetDagDetails structure.
+type GetDagDetailsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetDagDetailsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetDagDetailsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetDagDetailsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetDagDetailsOK creates a GetDagDetailsOK with default headers values
+func NewGetDagDetailsOK() *GetDagDetailsOK {
+	return &GetDagDetailsOK{}
+}
+
+/*
+GetDagDetailsOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type GetDagDetailsOK struct {
+	Payload *models.GetDagDetailsResponse
+}
+
+// IsSuccess returns true when this get dag details o k response has a 2xx status code
+func (o *GetDagDetailsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get dag details o k response has a 3xx status code
+func (o *GetDagDetailsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get dag details o k response has a 4xx status code
+func (o *GetDagDetailsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get dag details o k response has a 5xx status code
+func (o *GetDagDetailsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get dag details o k response a status code equal to that given
+func (o *GetDagDetailsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get dag details o k response
+func (o *GetDagDetailsOK) Code() int {
+	return 200
+}
+
+func (o *GetDagDetailsOK) Error() string {
+	return fmt.Sprintf("[GET /dags/{dagId}][%d] getDagDetailsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetDagDetailsOK) String() string {
+	return fmt.Sprintf("[GET /dags/{dagId}][%d] getDagDetailsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetDagDetailsOK) GetPayload() *models.GetDagDetailsResponse {
+	return o.Payload
+}
+
+func (o *GetDagDetailsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GetDagDetailsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetDagDetailsDefault creates a GetDagDetailsDefault with default headers values
+func NewGetDagDetailsDefault(code int) *GetDagDetailsDefault {
+	return &GetDagDetailsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetDagDetailsDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type GetDagDetailsDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this get dag details default response has a 2xx status code
+func (o *GetDagDetailsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get dag details default response has a 3xx status code
+func (o *GetDagDetailsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get dag details default response has a 4xx status code
+func (o *GetDagDetailsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get dag details default response has a 5xx status code
+func (o *GetDagDetailsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get dag details default response a status code equal to that given
+func (o *GetDagDetailsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get dag details default response
+func (o *GetDagDetailsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetDagDetailsDefault) Error() string {
+	return fmt.Sprintf("[GET /dags/{dagId}][%d] getDagDetails default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetDagDetailsDefault) String() string {
+	return fmt.Sprintf("[GET /dags/{dagId}][%d] getDagDetails default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetDagDetailsDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *GetDagDetailsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
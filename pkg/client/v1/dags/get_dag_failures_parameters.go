@@ -0,0 +1,217 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetDagFailuresParams creates a new GetDagFailuresParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetDagFailuresParams() *GetDagFailuresParams {
+	return &GetDagFailuresParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetDagFailuresParamsWithTimeout creates a new GetDagFailuresParams object
+// with the ability to set a timeout on a request.
+func NewGetDagFailuresParamsWithTimeout(timeout time.Duration) *GetDagFailuresParams {
+	return &GetDagFailuresParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetDagFailuresParamsWithContext creates a new GetDagFailuresParams object
+// with the ability to set a context for a request.
+func NewGetDagFailuresParamsWithContext(ctx context.Context) *GetDagFailuresParams {
+	return &GetDagFailuresParams{
+		Context: ctx,
+	}
+}
+
+// NewGetDagFailuresParamsWithHTTPClient creates a new GetDagFailuresParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetDagFailuresParamsWithHTTPClient(client *http.Client) *GetDagFailuresParams {
+	return &GetDagFailuresParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetDagFailuresParams contains all the parameters to send to the API endpoint
+
+	for the get dag failures operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetDagFailuresParams struct {
+
+	// DagID.
+	DagID string
+
+	/* Days.
+
+	   Analyzes only the runs started in the last days.
+	*/
+	Days *int64
+
+	/* Limit.
+
+	   The number of the recent runs to analyze.
+	*/
+	Limit *int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get dag failures params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetDagFailuresParams) WithDefaults() *GetDagFailuresParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get dag failures params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetDagFailuresParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get dag failures params
+func (o *GetDagFailuresParams) WithTimeout(timeout time.Duration) *GetDagFailuresParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get dag failures params
+func (o *GetDagFailuresParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get dag failures params
+func (o *GetDagFailuresParams) WithContext(ctx context.Context) *GetDagFailuresParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get dag failures params
+func (o *GetDagFailuresParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get dag failures params
+func (o *GetDagFailuresParams) WithHTTPClient(client *http.Client) *GetDagFailuresParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get dag failures params
+func (o *GetDagFailuresParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDagID adds the dagID to the get dag failures params
+func (o *GetDagFailuresParams) WithDagID(dagID string) *GetDagFailuresParams {
+	o.SetDagID(dagID)
+	return o
+}
+
+// SetDagID adds the dagId to the get dag failures params
+func (o *GetDagFailuresParams) SetDagID(dagID string) {
+	o.DagID = dagID
+}
+
+// WithDays adds the days to the get dag failures params
+func (o *GetDagFailuresParams) WithDays(days *int64) *GetDagFailuresParams {
+	o.SetDays(days)
+	return o
+}
+
+// SetDays adds the days to the get dag failures params
+func (o *GetDagFailuresParams) SetDays(days *int64) {
+	o.Days = days
+}
+
+// WithLimit adds the limit to the get dag failures params
+func (o *GetDagFailuresParams) WithLimit(limit *int64) *GetDagFailuresParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the get dag failures params
+func (o *GetDagFailuresParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetDagFailuresParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param dagId
+	if err := r.SetPathParam("dagId", o.DagID); err != nil {
+		return err
+	}
+
+	if o.Days != nil {
+
+		// query param days
+		var qrDays int64
+
+		if o.Days != nil {
+			qrDays = *o.Days
+		}
+		qDays := swag.FormatInt64(qrDays)
+		if qDays != "" {
+
+			if err := r.SetQueryParam("days", qDays); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
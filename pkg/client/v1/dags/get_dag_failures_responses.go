@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// GetDagFailuresReader is a Reader for the GetDagFailures structure.
+type GetDagFailuresReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetDagFailuresReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetDagFailuresOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetDagFailuresDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetDagFailuresOK creates a GetDagFailuresOK with default headers values
+func NewGetDagFailuresOK() *GetDagFailuresOK {
+	return &GetDagFailuresOK{}
+}
+
+/*
+GetDagFailuresOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type GetDagFailuresOK struct {
+	Payload *models.DagFailuresResponse
+}
+
+// IsSuccess returns true when this get dag failures o k response has a 2xx status code
+func (o *GetDagFailuresOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get dag failures o k response has a 3xx status code
+func (o *GetDagFailuresOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get dag failures o k response has a 4xx status code
+func (o *GetDagFailuresOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get dag failures o k response has a 5xx status code
+func (o *GetDagFailuresOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get dag failures o k response a status code equal to that given
+func (o *GetDagFailuresOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get dag failures o k response
+func (o *GetDagFailuresOK) Code() int {
+	return 200
+}
+
+func (o *GetDagFailuresOK) Error() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/failures][%d] getDagFailuresOK  %+v", 200, o.Payload)
+}
+
+func (o *GetDagFailuresOK) String() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/failures][%d] getDagFailuresOK  %+v", 200, o.Payload)
+}
+
+func (o *GetDagFailuresOK) GetPayload() *models.DagFailuresResponse {
+	return o.Payload
+}
+
+func (o *GetDagFailuresOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.DagFailuresResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetDagFailuresDefault creates a GetDagFailuresDefault with default headers values
+func NewGetDagFailuresDefault(code int) *GetDagFailuresDefault {
+	return &GetDagFailuresDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetDagFailuresDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type GetDagFailuresDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this get dag failures default response has a 2xx status code
+func (o *GetDagFailuresDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get dag failures default response has a 3xx status code
+func (o *GetDagFailuresDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get dag failures default response has a 4xx status code
+func (o *GetDagFailuresDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get dag failures default response has a 5xx status code
+func (o *GetDagFailuresDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get dag failures default response a status code equal to that given
+func (o *GetDagFailuresDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get dag failures default response
+func (o *GetDagFailuresDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetDagFailuresDefault) Error() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/failures][%d] getDagFailures default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetDagFailuresDefault) String() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/failures][%d] getDagFailures default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetDagFailuresDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *GetDagFailuresDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
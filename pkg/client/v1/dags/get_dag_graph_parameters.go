@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetDagGraphParams creates a new GetDagGraphParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetDagGraphParams() *GetDagGraphParams {
+	return &GetDagGraphParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetDagGraphParamsWithTimeout creates a new GetDagGraphParams object
+// with the ability to set a timeout on a request.
+func NewGetDagGraphParamsWithTimeout(timeout time.Duration) *GetDagGraphParams {
+	return &GetDagGraphParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetDagGraphParamsWithContext creates a new GetDagGraphParams object
+// with the ability to set a context for a request.
+func NewGetDagGraphParamsWithContext(ctx context.Context) *GetDagGraphParams {
+	return &GetDagGraphParams{
+		Context: ctx,
+	}
+}
+
+// NewGetDagGraphParamsWithHTTPClient creates a new GetDagGraphParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetDagGraphParamsWithHTTPClient(client *http.Client) *GetDagGraphParams {
+	return &GetDagGraphParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetDagGraphParams contains all the parameters to send to the API endpoint
+
+	for the get dag graph operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetDagGraphParams struct {
+
+	// DagID.
+	DagID string
+
+	/* RequestID.
+
+	   The request ID of the run. The latest run is used if not specified.
+	*/
+	RequestID *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get dag graph params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetDagGraphParams) WithDefaults() *GetDagGraphParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get dag graph params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetDagGraphParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get dag graph params
+func (o *GetDagGraphParams) WithTimeout(timeout time.Duration) *GetDagGraphParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get dag graph params
+func (o *GetDagGraphParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get dag graph params
+func (o *GetDagGraphParams) WithContext(ctx context.Context) *GetDagGraphParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get dag graph params
+func (o *GetDagGraphParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get dag graph params
+func (o *GetDagGraphParams) WithHTTPClient(client *http.Client) *GetDagGraphParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get dag graph params
+func (o *GetDagGraphParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDagID adds the dagID to the get dag graph params
+func (o *GetDagGraphParams) WithDagID(dagID string) *GetDagGraphParams {
+	o.SetDagID(dagID)
+	return o
+}
+
+// SetDagID adds the dagId to the get dag graph params
+func (o *GetDagGraphParams) SetDagID(dagID string) {
+	o.DagID = dagID
+}
+
+// WithRequestID adds the requestID to the get dag graph params
+func (o *GetDagGraphParams) WithRequestID(requestID *string) *GetDagGraphParams {
+	o.SetRequestID(requestID)
+	return o
+}
+
+// SetRequestID adds the requestId to the get dag graph params
+func (o *GetDagGraphParams) SetRequestID(requestID *string) {
+	o.RequestID = requestID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetDagGraphParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param dagId
+	if err := r.SetPathParam("dagId", o.DagID); err != nil {
+		return err
+	}
+
+	if o.RequestID != nil {
+
+		// query param requestId
+		var qrRequestID string
+
+		if o.RequestID != nil {
+			qrRequestID = *o.RequestID
+		}
+		qRequestID := qrRequestID
+		if qRequestID != "" {
+
+			if err := r.SetQueryParam("requestId", qRequestID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
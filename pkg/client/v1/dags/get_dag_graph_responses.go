@@ -0,0 +1,184 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// GetDagGraphReader is a Reader for the GetDagGraph structure.
+type GetDagGraphReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetDagGraphReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetDagGraphOK(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetDagGraphDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetDagGraphOK creates a GetDagGraphOK with default headers values
+func NewGetDagGraphOK(writer io.Writer) *GetDagGraphOK {
+	return &GetDagGraphOK{
+
+		Payload: writer,
+	}
+}
+
+/*
+GetDagGraphOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type GetDagGraphOK struct {
+	Payload io.Writer
+}
+
+// IsSuccess returns true when this get dag graph o k response has a 2xx status code
+func (o *GetDagGraphOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get dag graph o k response has a 3xx status code
+func (o *GetDagGraphOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get dag graph o k response has a 4xx status code
+func (o *GetDagGraphOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get dag graph o k response has a 5xx status code
+func (o *GetDagGraphOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get dag graph o k response a status code equal to that given
+func (o *GetDagGraphOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get dag graph o k response
+func (o *GetDagGraphOK) Code() int {
+	return 200
+}
+
+func (o *GetDagGraphOK) Error() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/graph.svg][%d] getDagGraphOK  %+v", 200, o.Payload)
+}
+
+func (o *GetDagGraphOK) String() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/graph.svg][%d] getDagGraphOK  %+v", 200, o.Payload)
+}
+
+func (o *GetDagGraphOK) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *GetDagGraphOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetDagGraphDefault creates a GetDagGraphDefault with default headers values
+func NewGetDagGraphDefault(code int) *GetDagGraphDefault {
+	return &GetDagGraphDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetDagGraphDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type GetDagGraphDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this get dag graph default response has a 2xx status code
+func (o *GetDagGraphDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get dag graph default response has a 3xx status code
+func (o *GetDagGraphDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get dag graph default response has a 4xx status code
+func (o *GetDagGraphDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get dag graph default response has a 5xx status code
+func (o *GetDagGraphDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get dag graph default response a status code equal to that given
+func (o *GetDagGraphDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get dag graph default response
+func (o *GetDagGraphDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetDagGraphDefault) Error() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/graph.svg][%d] getDagGraph default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetDagGraphDefault) String() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/graph.svg][%d] getDagGraph default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetDagGraphDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *GetDagGraphDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
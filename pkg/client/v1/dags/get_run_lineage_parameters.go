@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetRunLineageParams creates a new GetRunLineageParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetRunLineageParams() *GetRunLineageParams {
+	return &GetRunLineageParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetRunLineageParamsWithTimeout creates a new GetRunLineageParams object
+// with the ability to set a timeout on a request.
+func NewGetRunLineageParamsWithTimeout(timeout time.Duration) *GetRunLineageParams {
+	return &GetRunLineageParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetRunLineageParamsWithContext creates a new GetRunLineageParams object
+// with the ability to set a context for a request.
+func NewGetRunLineageParamsWithContext(ctx context.Context) *GetRunLineageParams {
+	return &GetRunLineageParams{
+		Context: ctx,
+	}
+}
+
+// NewGetRunLineageParamsWithHTTPClient creates a new GetRunLineageParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetRunLineageParamsWithHTTPClient(client *http.Client) *GetRunLineageParams {
+	return &GetRunLineageParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetRunLineageParams contains all the parameters to send to the API endpoint
+
+	for the get run lineage operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetRunLineageParams struct {
+
+	// DagID.
+	DagID string
+
+	// RequestID.
+	RequestID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get run lineage params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetRunLineageParams) WithDefaults() *GetRunLineageParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get run lineage params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetRunLineageParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get run lineage params
+func (o *GetRunLineageParams) WithTimeout(timeout time.Duration) *GetRunLineageParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get run lineage params
+func (o *GetRunLineageParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get run lineage params
+func (o *GetRunLineageParams) WithContext(ctx context.Context) *GetRunLineageParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get run lineage params
+func (o *GetRunLineageParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get run lineage params
+func (o *GetRunLineageParams) WithHTTPClient(client *http.Client) *GetRunLineageParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get run lineage params
+func (o *GetRunLineageParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDagID adds the dagID to the get run lineage params
+func (o *GetRunLineageParams) WithDagID(dagID string) *GetRunLineageParams {
+	o.SetDagID(dagID)
+	return o
+}
+
+// SetDagID adds the dagId to the get run lineage params
+func (o *GetRunLineageParams) SetDagID(dagID string) {
+	o.DagID = dagID
+}
+
+// WithRequestID adds the requestID to the get run lineage params
+func (o *GetRunLineageParams) WithRequestID(requestID string) *GetRunLineageParams {
+	o.SetRequestID(requestID)
+	return o
+}
+
+// SetRequestID adds the requestId to the get run lineage params
+func (o *GetRunLineageParams) SetRequestID(requestID string) {
+	o.RequestID = requestID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetRunLineageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param dagId
+	if err := r.SetPathParam("dagId", o.DagID); err != nil {
+		return err
+	}
+
+	// path param requestId
+	if err := r.SetPathParam("requestId", o.RequestID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
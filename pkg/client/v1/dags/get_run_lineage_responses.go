@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// GetRunLineageReader is a Reader for the GetRunLineage structure.
+type GetRunLineageReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetRunLineageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetRunLineageOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetRunLineageDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetRunLineageOK creates a GetRunLineageOK with default headers values
+func NewGetRunLineageOK() *GetRunLineageOK {
+	return &GetRunLineageOK{}
+}
+
+/*
+GetRunLineageOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type GetRunLineageOK struct {
+	Payload *models.RunLineageResponse
+}
+
+// IsSuccess returns true when this get run lineage o k response has a 2xx status code
+func (o *GetRunLineageOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get run lineage o k response has a 3xx status code
+func (o *GetRunLineageOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get run lineage o k response has a 4xx status code
+func (o *GetRunLineageOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get run lineage o k response has a 5xx status code
+func (o *GetRunLineageOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get run lineage o k response a status code equal to that given
+func (o *GetRunLineageOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get run lineage o k response
+func (o *GetRunLineageOK) Code() int {
+	return 200
+}
+
+func (o *GetRunLineageOK) Error() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/requests/{requestId}/lineage][%d] getRunLineageOK  %+v", 200, o.Payload)
+}
+
+func (o *GetRunLineageOK) String() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/requests/{requestId}/lineage][%d] getRunLineageOK  %+v", 200, o.Payload)
+}
+
+func (o *GetRunLineageOK) GetPayload() *models.RunLineageResponse {
+	return o.Payload
+}
+
+func (o *GetRunLineageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RunLineageResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetRunLineageDefault creates a GetRunLineageDefault with default headers values
+func NewGetRunLineageDefault(code int) *GetRunLineageDefault {
+	return &GetRunLineageDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetRunLineageDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type GetRunLineageDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this get run lineage default response has a 2xx status code
+func (o *GetRunLineageDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get run lineage default response has a 3xx status code
+func (o *GetRunLineageDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get run lineage default response has a 4xx status code
+func (o *GetRunLineageDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get run lineage default response has a 5xx status code
+func (o *GetRunLineageDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get run lineage default response a status code equal to that given
+func (o *GetRunLineageDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get run lineage default response
+func (o *GetRunLineageDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetRunLineageDefault) Error() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/requests/{requestId}/lineage][%d] getRunLineage default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetRunLineageDefault) String() string {
+	return fmt.Sprintf("[GET /dags/{dagId}/requests/{requestId}/lineage][%d] getRunLineage default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetRunLineageDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *GetRunLineageDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetScheduleCalendarParams creates a new GetScheduleCalendarParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetScheduleCalendarParams() *GetScheduleCalendarParams {
+	return &GetScheduleCalendarParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetScheduleCalendarParamsWithTimeout creates a new GetScheduleCalendarParams object
+// with the ability to set a timeout on a request.
+func NewGetScheduleCalendarParamsWithTimeout(timeout time.Duration) *GetScheduleCalendarParams {
+	return &GetScheduleCalendarParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetScheduleCalendarParamsWithContext creates a new GetScheduleCalendarParams object
+// with the ability to set a context for a request.
+func NewGetScheduleCalendarParamsWithContext(ctx context.Context) *GetScheduleCalendarParams {
+	return &GetScheduleCalendarParams{
+		Context: ctx,
+	}
+}
+
+// NewGetScheduleCalendarParamsWithHTTPClient creates a new GetScheduleCalendarParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetScheduleCalendarParamsWithHTTPClient(client *http.Client) *GetScheduleCalendarParams {
+	return &GetScheduleCalendarParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetScheduleCalendarParams contains all the parameters to send to the API endpoint
+
+	for the get schedule calendar operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetScheduleCalendarParams struct {
+
+	/* Days.
+
+	   The number of the days from now to export the scheduled runs.
+	*/
+	Days *int64
+
+	// SearchName.
+	SearchName *string
+
+	// SearchTag.
+	SearchTag *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get schedule calendar params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetScheduleCalendarParams) WithDefaults() *GetScheduleCalendarParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get schedule calendar params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetScheduleCalendarParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get schedule calendar params
+func (o *GetScheduleCalendarParams) WithTimeout(timeout time.Duration) *GetScheduleCalendarParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get schedule calendar params
+func (o *GetScheduleCalendarParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get schedule calendar params
+func (o *GetScheduleCalendarParams) WithContext(ctx context.Context) *GetScheduleCalendarParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get schedule calendar params
+func (o *GetScheduleCalendarParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get schedule calendar params
+func (o *GetScheduleCalendarParams) WithHTTPClient(client *http.Client) *GetScheduleCalendarParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get schedule calendar params
+func (o *GetScheduleCalendarParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDays adds the days to the get schedule calendar params
+func (o *GetScheduleCalendarParams) WithDays(days *int64) *GetScheduleCalendarParams {
+	o.SetDays(days)
+	return o
+}
+
+// SetDays adds the days to the get schedule calendar params
+func (o *GetScheduleCalendarParams) SetDays(days *int64) {
+	o.Days = days
+}
+
+// WithSearchName adds the searchName to the get schedule calendar params
+func (o *GetScheduleCalendarParams) WithSearchName(searchName *string) *GetScheduleCalendarParams {
+	o.SetSearchName(searchName)
+	return o
+}
+
+// SetSearchName adds the searchName to the get schedule calendar params
+func (o *GetScheduleCalendarParams) SetSearchName(searchName *string) {
+	o.SearchName = searchName
+}
+
+// WithSearchTag adds the searchTag to the get schedule calendar params
+func (o *GetScheduleCalendarParams) WithSearchTag(searchTag *string) *GetScheduleCalendarParams {
+	o.SetSearchTag(searchTag)
+	return o
+}
+
+// SetSearchTag adds the searchTag to the get schedule calendar params
+func (o *GetScheduleCalendarParams) SetSearchTag(searchTag *string) {
+	o.SearchTag = searchTag
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetScheduleCalendarParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Days != nil {
+
+		// query param days
+		var qrDays int64
+
+		if o.Days != nil {
+			qrDays = *o.Days
+		}
+		qDays := swag.FormatInt64(qrDays)
+		if qDays != "" {
+
+			if err := r.SetQueryParam("days", qDays); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.SearchName != nil {
+
+		// query param searchName
+		var qrSearchName string
+
+		if o.SearchName != nil {
+			qrSearchName = *o.SearchName
+		}
+		qSearchName := qrSearchName
+		if qSearchName != "" {
+
+			if err := r.SetQueryParam("searchName", qSearchName); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.SearchTag != nil {
+
+		// query param searchTag
+		var qrSearchTag string
+
+		if o.SearchTag != nil {
+			qrSearchTag = *o.SearchTag
+		}
+		qSearchTag := qrSearchTag
+		if qSearchTag != "" {
+
+			if err := r.SetQueryParam("searchTag", qSearchTag); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
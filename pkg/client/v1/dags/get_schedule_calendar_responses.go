@@ -0,0 +1,184 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// GetScheduleCalendarReader is a Reader for the GetScheduleCalendar structure.
+type GetScheduleCalendarReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetScheduleCalendarReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetScheduleCalendarOK(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetScheduleCalendarDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetScheduleCalendarOK creates a GetScheduleCalendarOK with default headers values
+func NewGetScheduleCalendarOK(writer io.Writer) *GetScheduleCalendarOK {
+	return &GetScheduleCalendarOK{
+
+		Payload: writer,
+	}
+}
+
+/*
+GetScheduleCalendarOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type GetScheduleCalendarOK struct {
+	Payload io.Writer
+}
+
+// IsSuccess returns true when this get schedule calendar o k response has a 2xx status code
+func (o *GetScheduleCalendarOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get schedule calendar o k response has a 3xx status code
+func (o *GetScheduleCalendarOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get schedule calendar o k response has a 4xx status code
+func (o *GetScheduleCalendarOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get schedule calendar o k response has a 5xx status code
+func (o *GetScheduleCalendarOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get schedule calendar o k response a status code equal to that given
+func (o *GetScheduleCalendarOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get schedule calendar o k response
+func (o *GetScheduleCalendarOK) Code() int {
+	return 200
+}
+
+func (o *GetScheduleCalendarOK) Error() string {
+	return fmt.Sprintf("[GET /calendar.ics][%d] getScheduleCalendarOK  %+v", 200, o.Payload)
+}
+
+func (o *GetScheduleCalendarOK) String() string {
+	return fmt.Sprintf("[GET /calendar.ics][%d] getScheduleCalendarOK  %+v", 200, o.Payload)
+}
+
+func (o *GetScheduleCalendarOK) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *GetScheduleCalendarOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetScheduleCalendarDefault creates a GetScheduleCalendarDefault with default headers values
+func NewGetScheduleCalendarDefault(code int) *GetScheduleCalendarDefault {
+	return &GetScheduleCalendarDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetScheduleCalendarDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type GetScheduleCalendarDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this get schedule calendar default response has a 2xx status code
+func (o *GetScheduleCalendarDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get schedule calendar default response has a 3xx status code
+func (o *GetScheduleCalendarDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get schedule calendar default response has a 4xx status code
+func (o *GetScheduleCalendarDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get schedule calendar default response has a 5xx status code
+func (o *GetScheduleCalendarDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get schedule calendar default response a status code equal to that given
+func (o *GetScheduleCalendarDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get schedule calendar default response
+func (o *GetScheduleCalendarDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetScheduleCalendarDefault) Error() string {
+	return fmt.Sprintf("[GET /calendar.ics][%d] getScheduleCalendar default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetScheduleCalendarDefault) String() string {
+	return fmt.Sprintf("[GET /calendar.ics][%d] getScheduleCalendar default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetScheduleCalendarDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *GetScheduleCalendarDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
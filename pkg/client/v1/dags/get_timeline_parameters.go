@@ -0,0 +1,226 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetTimelineParams creates a new GetTimelineParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetTimelineParams() *GetTimelineParams {
+	return &GetTimelineParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetTimelineParamsWithTimeout creates a new GetTimelineParams object
+// with the ability to set a timeout on a request.
+func NewGetTimelineParamsWithTimeout(timeout time.Duration) *GetTimelineParams {
+	return &GetTimelineParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetTimelineParamsWithContext creates a new GetTimelineParams object
+// with the ability to set a context for a request.
+func NewGetTimelineParamsWithContext(ctx context.Context) *GetTimelineParams {
+	return &GetTimelineParams{
+		Context: ctx,
+	}
+}
+
+// NewGetTimelineParamsWithHTTPClient creates a new GetTimelineParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetTimelineParamsWithHTTPClient(client *http.Client) *GetTimelineParams {
+	return &GetTimelineParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetTimelineParams contains all the parameters to send to the API endpoint
+
+	for the get timeline operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetTimelineParams struct {
+
+	/* Hours.
+
+	   The number of the hours from now to simulate.
+	*/
+	Hours *int64
+
+	// SearchName.
+	SearchName *string
+
+	// SearchTag.
+	SearchTag *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get timeline params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetTimelineParams) WithDefaults() *GetTimelineParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get timeline params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetTimelineParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get timeline params
+func (o *GetTimelineParams) WithTimeout(timeout time.Duration) *GetTimelineParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get timeline params
+func (o *GetTimelineParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get timeline params
+func (o *GetTimelineParams) WithContext(ctx context.Context) *GetTimelineParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get timeline params
+func (o *GetTimelineParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get timeline params
+func (o *GetTimelineParams) WithHTTPClient(client *http.Client) *GetTimelineParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get timeline params
+func (o *GetTimelineParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithHours adds the hours to the get timeline params
+func (o *GetTimelineParams) WithHours(hours *int64) *GetTimelineParams {
+	o.SetHours(hours)
+	return o
+}
+
+// SetHours adds the hours to the get timeline params
+func (o *GetTimelineParams) SetHours(hours *int64) {
+	o.Hours = hours
+}
+
+// WithSearchName adds the searchName to the get timeline params
+func (o *GetTimelineParams) WithSearchName(searchName *string) *GetTimelineParams {
+	o.SetSearchName(searchName)
+	return o
+}
+
+// SetSearchName adds the searchName to the get timeline params
+func (o *GetTimelineParams) SetSearchName(searchName *string) {
+	o.SearchName = searchName
+}
+
+// WithSearchTag adds the searchTag to the get timeline params
+func (o *GetTimelineParams) WithSearchTag(searchTag *string) *GetTimelineParams {
+	o.SetSearchTag(searchTag)
+	return o
+}
+
+// SetSearchTag adds the searchTag to the get timeline params
+func (o *GetTimelineParams) SetSearchTag(searchTag *string) {
+	o.SearchTag = searchTag
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetTimelineParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Hours != nil {
+
+		// query param hours
+		var qrHours int64
+
+		if o.Hours != nil {
+			qrHours = *o.Hours
+		}
+		qHours := swag.FormatInt64(qrHours)
+		if qHours != "" {
+
+			if err := r.SetQueryParam("hours", qHours); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.SearchName != nil {
+
+		// query param searchName
+		var qrSearchName string
+
+		if o.SearchName != nil {
+			qrSearchName = *o.SearchName
+		}
+		qSearchName := qrSearchName
+		if qSearchName != "" {
+
+			if err := r.SetQueryParam("searchName", qSearchName); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.SearchTag != nil {
+
+		// query param searchTag
+		var qrSearchTag string
+
+		if o.SearchTag != nil {
+			qrSearchTag = *o.SearchTag
+		}
+		qSearchTag := qrSearchTag
+		if qSearchTag != "" {
+
+			if err := r.SetQueryParam("searchTag", qSearchTag); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
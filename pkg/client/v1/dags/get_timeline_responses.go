@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// GetTimelineReader is a Reader for the GetTimeline structure.
+type GetTimelineReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetTimelineReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetTimelineOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetTimelineDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetTimelineOK creates a GetTimelineOK with default headers values
+func NewGetTimelineOK() *GetTimelineOK {
+	return &GetTimelineOK{}
+}
+
+/*
+GetTimelineOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type GetTimelineOK struct {
+	Payload *models.TimelineResponse
+}
+
+// IsSuccess returns true when this get timeline o k response has a 2xx status code
+func (o *GetTimelineOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get timeline o k response has a 3xx status code
+func (o *GetTimelineOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get timeline o k response has a 4xx status code
+func (o *GetTimelineOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get timeline o k response has a 5xx status code
+func (o *GetTimelineOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get timeline o k response a status code equal to that given
+func (o *GetTimelineOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get timeline o k response
+func (o *GetTimelineOK) Code() int {
+	return 200
+}
+
+func (o *GetTimelineOK) Error() string {
+	return fmt.Sprintf("[GET /timeline][%d] getTimelineOK  %+v", 200, o.Payload)
+}
+
+func (o *GetTimelineOK) String() string {
+	return fmt.Sprintf("[GET /timeline][%d] getTimelineOK  %+v", 200, o.Payload)
+}
+
+func (o *GetTimelineOK) GetPayload() *models.TimelineResponse {
+	return o.Payload
+}
+
+func (o *GetTimelineOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.TimelineResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetTimelineDefault creates a GetTimelineDefault with default headers values
+func NewGetTimelineDefault(code int) *GetTimelineDefault {
+	return &GetTimelineDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetTimelineDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type GetTimelineDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this get timeline default response has a 2xx status code
+func (o *GetTimelineDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get timeline default response has a 3xx status code
+func (o *GetTimelineDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get timeline default response has a 4xx status code
+func (o *GetTimelineDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get timeline default response has a 5xx status code
+func (o *GetTimelineDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get timeline default response a status code equal to that given
+func (o *GetTimelineDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get timeline default response
+func (o *GetTimelineDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetTimelineDefault) Error() string {
+	return fmt.Sprintf("[GET /timeline][%d] getTimeline default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetTimelineDefault) String() string {
+	return fmt.Sprintf("[GET /timeline][%d] getTimeline default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetTimelineDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *GetTimelineDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,322 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListDagsParams creates a new ListDagsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListDagsParams() *ListDagsParams {
+	return &ListDagsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListDagsParamsWithTimeout creates a new ListDagsParams object
+// with the ability to set a timeout on a request.
+func NewListDagsParamsWithTimeout(timeout time.Duration) *ListDagsParams {
+	return &ListDagsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListDagsParamsWithContext creates a new ListDagsParams object
+// with the ability to set a context for a request.
+func NewListDagsParamsWithContext(ctx context.Context) *ListDagsParams {
+	return &ListDagsParams{
+		Context: ctx,
+	}
+}
+
+// NewListDagsParamsWithHTTPClient creates a new ListDagsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListDagsParamsWithHTTPClient(client *http.Client) *ListDagsParams {
+	return &ListDagsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListDagsParams contains all the parameters to send to the API endpoint
+
+	for the list dags operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListDagsParams struct {
+
+	// Limit.
+	Limit *int64
+
+	/* Namespace.
+
+	   Returns only the DAGs in the namespace.
+	*/
+	Namespace *string
+
+	// Page.
+	Page *int64
+
+	// SearchName.
+	SearchName *string
+
+	// SearchTag.
+	SearchTag *string
+
+	/* Since.
+
+	   Returns only the DAGs that changed since the time (RFC3339), usually the Timestamp of the previous response.
+	*/
+	Since *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list dags params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListDagsParams) WithDefaults() *ListDagsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list dags params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListDagsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list dags params
+func (o *ListDagsParams) WithTimeout(timeout time.Duration) *ListDagsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list dags params
+func (o *ListDagsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list dags params
+func (o *ListDagsParams) WithContext(ctx context.Context) *ListDagsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list dags params
+func (o *ListDagsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list dags params
+func (o *ListDagsParams) WithHTTPClient(client *http.Client) *ListDagsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list dags params
+func (o *ListDagsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLimit adds the limit to the list dags params
+func (o *ListDagsParams) WithLimit(limit *int64) *ListDagsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list dags params
+func (o *ListDagsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithNamespace adds the namespace to the list dags params
+func (o *ListDagsParams) WithNamespace(namespace *string) *ListDagsParams {
+	o.SetNamespace(namespace)
+	return o
+}
+
+// SetNamespace adds the namespace to the list dags params
+func (o *ListDagsParams) SetNamespace(namespace *string) {
+	o.Namespace = namespace
+}
+
+// WithPage adds the page to the list dags params
+func (o *ListDagsParams) WithPage(page *int64) *ListDagsParams {
+	o.SetPage(page)
+	return o
+}
+
+// SetPage adds the page to the list dags params
+func (o *ListDagsParams) SetPage(page *int64) {
+	o.Page = page
+}
+
+// WithSearchName adds the searchName to the list dags params
+func (o *ListDagsParams) WithSearchName(searchName *string) *ListDagsParams {
+	o.SetSearchName(searchName)
+	return o
+}
+
+// SetSearchName adds the searchName to the list dags params
+func (o *ListDagsParams) SetSearchName(searchName *string) {
+	o.SearchName = searchName
+}
+
+// WithSearchTag adds the searchTag to the list dags params
+func (o *ListDagsParams) WithSearchTag(searchTag *string) *ListDagsParams {
+	o.SetSearchTag(searchTag)
+	return o
+}
+
+// SetSearchTag adds the searchTag to the list dags params
+func (o *ListDagsParams) SetSearchTag(searchTag *string) {
+	o.SearchTag = searchTag
+}
+
+// WithSince adds the since to the list dags params
+func (o *ListDagsParams) WithSince(since *string) *ListDagsParams {
+	o.SetSince(since)
+	return o
+}
+
+// SetSince adds the since to the list dags params
+func (o *ListDagsParams) SetSince(since *string) {
+	o.Since = since
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListDagsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Namespace != nil {
+
+		// query param namespace
+		var qrNamespace string
+
+		if o.Namespace != nil {
+			qrNamespace = *o.Namespace
+		}
+		qNamespace := qrNamespace
+		if qNamespace != "" {
+
+			if err := r.SetQueryParam("namespace", qNamespace); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Page != nil {
+
+		// query param page
+		var qrPage int64
+
+		if o.Page != nil {
+			qrPage = *o.Page
+		}
+		qPage := swag.FormatInt64(qrPage)
+		if qPage != "" {
+
+			if err := r.SetQueryParam("page", qPage); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.SearchName != nil {
+
+		// query param searchName
+		var qrSearchName string
+
+		if o.SearchName != nil {
+			qrSearchName = *o.SearchName
+		}
+		qSearchName := qrSearchName
+		if qSearchName != "" {
+
+			if err := r.SetQueryParam("searchName", qSearchName); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.SearchTag != nil {
+
+		// query param searchTag
+		var qrSearchTag string
+
+		if o.SearchTag != nil {
+			qrSearchTag = *o.SearchTag
+		}
+		qSearchTag := qrSearchTag
+		if qSearchTag != "" {
+
+			if err := r.SetQueryParam("searchTag", qSearchTag); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Since != nil {
+
+		// query param since
+		var qrSince string
+
+		if o.Since != nil {
+			qrSince = *o.Since
+		}
+		qSince := qrSince
+		if qSince != "" {
+
+			if err := r.SetQueryParam("since", qSince); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// ListDagsReader is a Reader for the ListDags structure.
+type ListDagsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListDagsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListDagsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListDagsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListDagsOK creates a ListDagsOK with default headers values
+func NewListDagsOK() *ListDagsOK {
+	return &ListDagsOK{}
+}
+
+/*
+ListDagsOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type ListDagsOK struct {
+	Payload *models.ListDagsResponse
+}
+
+// IsSuccess returns true when this list dags o k response has a 2xx status code
+func (o *ListDagsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list dags o k response has a 3xx status code
+func (o *ListDagsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list dags o k response has a 4xx status code
+func (o *ListDagsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list dags o k response has a 5xx status code
+func (o *ListDagsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list dags o k response a status code equal to that given
+func (o *ListDagsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list dags o k response
+func (o *ListDagsOK) Code() int {
+	return 200
+}
+
+func (o *ListDagsOK) Error() string {
+	return fmt.Sprintf("[GET /dags][%d] listDagsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListDagsOK) String() string {
+	return fmt.Sprintf("[GET /dags][%d] listDagsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListDagsOK) GetPayload() *models.ListDagsResponse {
+	return o.Payload
+}
+
+func (o *ListDagsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ListDagsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListDagsDefault creates a ListDagsDefault with default headers values
+func NewListDagsDefault(code int) *ListDagsDefault {
+	return &ListDagsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ListDagsDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type ListDagsDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this list dags default response has a 2xx status code
+func (o *ListDagsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this list dags default response has a 3xx status code
+func (o *ListDagsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this list dags default response has a 4xx status code
+func (o *ListDagsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this list dags default response has a 5xx status code
+func (o *ListDagsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this list dags default response a status code equal to that given
+func (o *ListDagsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the list dags default response
+func (o *ListDagsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListDagsDefault) Error() string {
+	return fmt.Sprintf("[GET /dags][%d] listDags default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListDagsDefault) String() string {
+	return fmt.Sprintf("[GET /dags][%d] listDags default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListDagsDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *ListDagsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListQueuedRunsParams creates a new ListQueuedRunsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListQueuedRunsParams() *ListQueuedRunsParams {
+	return &ListQueuedRunsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListQueuedRunsParamsWithTimeout creates a new ListQueuedRunsParams object
+// with the ability to set a timeout on a request.
+func NewListQueuedRunsParamsWithTimeout(timeout time.Duration) *ListQueuedRunsParams {
+	return &ListQueuedRunsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListQueuedRunsParamsWithContext creates a new ListQueuedRunsParams object
+// with the ability to set a context for a request.
+func NewListQueuedRunsParamsWithContext(ctx context.Context) *ListQueuedRunsParams {
+	return &ListQueuedRunsParams{
+		Context: ctx,
+	}
+}
+
+// NewListQueuedRunsParamsWithHTTPClient creates a new ListQueuedRunsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListQueuedRunsParamsWithHTTPClient(client *http.Client) *ListQueuedRunsParams {
+	return &ListQueuedRunsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListQueuedRunsParams contains all the parameters to send to the API endpoint
+
+	for the list queued runs operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListQueuedRunsParams struct {
+
+	/* DagID.
+
+	   Only the runs of the DAG are returned if it's set.
+	*/
+	DagID *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list queued runs params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListQueuedRunsParams) WithDefaults() *ListQueuedRunsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list queued runs params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListQueuedRunsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list queued runs params
+func (o *ListQueuedRunsParams) WithTimeout(timeout time.Duration) *ListQueuedRunsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list queued runs params
+func (o *ListQueuedRunsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list queued runs params
+func (o *ListQueuedRunsParams) WithContext(ctx context.Context) *ListQueuedRunsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list queued runs params
+func (o *ListQueuedRunsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list queued runs params
+func (o *ListQueuedRunsParams) WithHTTPClient(client *http.Client) *ListQueuedRunsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list queued runs params
+func (o *ListQueuedRunsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDagID adds the dagID to the list queued runs params
+func (o *ListQueuedRunsParams) WithDagID(dagID *string) *ListQueuedRunsParams {
+	o.SetDagID(dagID)
+	return o
+}
+
+// SetDagID adds the dagId to the list queued runs params
+func (o *ListQueuedRunsParams) SetDagID(dagID *string) {
+	o.DagID = dagID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListQueuedRunsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.DagID != nil {
+
+		// query param dagId
+		var qrDagID string
+
+		if o.DagID != nil {
+			qrDagID = *o.DagID
+		}
+		qDagID := qrDagID
+		if qDagID != "" {
+
+			if err := r.SetQueryParam("dagId", qDagID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
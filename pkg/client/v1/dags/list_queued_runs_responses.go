@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// ListQueuedRunsReader is a Reader for the ListQueuedRuns structure.
+type ListQueuedRunsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListQueuedRunsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListQueuedRunsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListQueuedRunsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListQueuedRunsOK creates a ListQueuedRunsOK with default headers values
+func NewListQueuedRunsOK() *ListQueuedRunsOK {
+	return &ListQueuedRunsOK{}
+}
+
+/*
+ListQueuedRunsOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type ListQueuedRunsOK struct {
+	Payload *models.QueueResponse
+}
+
+// IsSuccess returns true when this list queued runs o k response has a 2xx status code
+func (o *ListQueuedRunsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list queued runs o k response has a 3xx status code
+func (o *ListQueuedRunsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list queued runs o k response has a 4xx status code
+func (o *ListQueuedRunsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list queued runs o k response has a 5xx status code
+func (o *ListQueuedRunsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list queued runs o k response a status code equal to that given
+func (o *ListQueuedRunsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list queued runs o k response
+func (o *ListQueuedRunsOK) Code() int {
+	return 200
+}
+
+func (o *ListQueuedRunsOK) Error() string {
+	return fmt.Sprintf("[GET /queue][%d] listQueuedRunsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListQueuedRunsOK) String() string {
+	return fmt.Sprintf("[GET /queue][%d] listQueuedRunsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListQueuedRunsOK) GetPayload() *models.QueueResponse {
+	return o.Payload
+}
+
+func (o *ListQueuedRunsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.QueueResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListQueuedRunsDefault creates a ListQueuedRunsDefault with default headers values
+func NewListQueuedRunsDefault(code int) *ListQueuedRunsDefault {
+	return &ListQueuedRunsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ListQueuedRunsDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type ListQueuedRunsDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this list queued runs default response has a 2xx status code
+func (o *ListQueuedRunsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this list queued runs default response has a 3xx status code
+func (o *ListQueuedRunsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this list queued runs default response has a 4xx status code
+func (o *ListQueuedRunsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this list queued runs default response has a 5xx status code
+func (o *ListQueuedRunsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this list queued runs default response a status code equal to that given
+func (o *ListQueuedRunsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the list queued runs default response
+func (o *ListQueuedRunsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListQueuedRunsDefault) Error() string {
+	return fmt.Sprintf("[GET /queue][%d] listQueuedRuns default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListQueuedRunsDefault) String() string {
+	return fmt.Sprintf("[GET /queue][%d] listQueuedRuns default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListQueuedRunsDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *ListQueuedRunsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
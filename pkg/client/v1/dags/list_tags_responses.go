@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// ListTagsReader is a Reader for the ListTags structure.
+type ListTagsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListTagsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListTagsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListTagsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListTagsOK creates a ListTagsOK with default headers values
+func NewListTagsOK() *ListTagsOK {
+	return &ListTagsOK{}
+}
+
+/*
+ListTagsOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type ListTagsOK struct {
+	Payload *models.ListTagResponse
+}
+
+// IsSuccess returns true when this list tags o k response has a 2xx status code
+func (o *ListTagsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list tags o k response has a 3xx status code
+func (o *ListTagsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list tags o k response has a 4xx status code
+func (o *ListTagsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list tags o k response has a 5xx status code
+func (o *ListTagsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list tags o k response a status code equal to that given
+func (o *ListTagsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list tags o k response
+func (o *ListTagsOK) Code() int {
+	return 200
+}
+
+func (o *ListTagsOK) Error() string {
+	return fmt.Sprintf("[GET /tags][%d] listTagsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListTagsOK) String() string {
+	return fmt.Sprintf("[GET /tags][%d] listTagsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListTagsOK) GetPayload() *models.ListTagResponse {
+	return o.Payload
+}
+
+func (o *ListTagsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ListTagResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListTagsDefault creates a ListTagsDefault with default headers values
+func NewListTagsDefault(code int) *ListTagsDefault {
+	return &ListTagsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ListTagsDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type ListTagsDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this list tags default response has a 2xx status code
+func (o *ListTagsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this list tags default response has a 3xx status code
+func (o *ListTagsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this list tags default response has a 4xx status code
+func (o *ListTagsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this list tags default response has a 5xx status code
+func (o *ListTagsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this list tags default response a status code equal to that given
+func (o *ListTagsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the list tags default response
+func (o *ListTagsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListTagsDefault) Error() string {
+	return fmt.Sprintf("[GET /tags][%d] listTags default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListTagsDefault) String() string {
+	return fmt.Sprintf("[GET /tags][%d] listTags default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListTagsDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *ListTagsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewPostDagActionParams creates a new PostDagActionParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPostDagActionParams() *PostDagActionParams {
+	return &PostDagActionParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPostDagActionParamsWithTimeout creates a new PostDagActionParams object
+// with the ability to set a timeout on a request.
+func NewPostDagActionParamsWithTimeout(timeout time.Duration) *PostDagActionParams {
+	return &PostDagActionParams{
+		timeout: timeout,
+	}
+}
+
+// NewPostDagActionParamsWithContext creates a new PostDagActionParams object
+// with the ability to set a context for a request.
+func NewPostDagActionParamsWithContext(ctx context.Context) *PostDagActionParams {
+	return &PostDagActionParams{
+		Context: ctx,
+	}
+}
+
+// NewPostDagActionParamsWithHTTPClient creates a new PostDagActionParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPostDagActionParamsWithHTTPClient(client *http.Client) *PostDagActionParams {
+	return &PostDagActionParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PostDagActionParams contains all the parameters to send to the API endpoint
+
+	for the post dag action operation.
+
+	Typically these are written to a http.Request.
+*/
+type PostDagActionParams struct {
+
+	// Body.
+	Body PostDagActionBody
+
+	// DagID.
+	DagID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the post dag action params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostDagActionParams) WithDefaults() *PostDagActionParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the post dag action params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostDagActionParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the post dag action params
+func (o *PostDagActionParams) WithTimeout(timeout time.Duration) *PostDagActionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the post dag action params
+func (o *PostDagActionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the post dag action params
+func (o *PostDagActionParams) WithContext(ctx context.Context) *PostDagActionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the post dag action params
+func (o *PostDagActionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the post dag action params
+func (o *PostDagActionParams) WithHTTPClient(client *http.Client) *PostDagActionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the post dag action params
+func (o *PostDagActionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the post dag action params
+func (o *PostDagActionParams) WithBody(body PostDagActionBody) *PostDagActionParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the post dag action params
+func (o *PostDagActionParams) SetBody(body PostDagActionBody) {
+	o.Body = body
+}
+
+// WithDagID adds the dagID to the post dag action params
+func (o *PostDagActionParams) WithDagID(dagID string) *PostDagActionParams {
+	o.SetDagID(dagID)
+	return o
+}
+
+// SetDagID adds the dagId to the post dag action params
+func (o *PostDagActionParams) SetDagID(dagID string) {
+	o.DagID = dagID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PostDagActionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	// path param dagId
+	if err := r.SetPathParam("dagId", o.DagID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
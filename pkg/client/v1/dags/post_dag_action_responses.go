@@ -0,0 +1,336 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// PostDagActionReader is a Reader for the PostDagAction structure.
+type PostDagActionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PostDagActionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPostDagActionOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewPostDagActionDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPostDagActionOK creates a PostDagActionOK with default headers values
+func NewPostDagActionOK() *PostDagActionOK {
+	return &PostDagActionOK{}
+}
+
+/*
+PostDagActionOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type PostDagActionOK struct {
+	Payload *models.PostDagActionResponse
+}
+
+// IsSuccess returns true when this post dag action o k response has a 2xx status code
+func (o *PostDagActionOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this post dag action o k response has a 3xx status code
+func (o *PostDagActionOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post dag action o k response has a 4xx status code
+func (o *PostDagActionOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this post dag action o k response has a 5xx status code
+func (o *PostDagActionOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post dag action o k response a status code equal to that given
+func (o *PostDagActionOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the post dag action o k response
+func (o *PostDagActionOK) Code() int {
+	return 200
+}
+
+func (o *PostDagActionOK) Error() string {
+	return fmt.Sprintf("[POST /dags/{dagId}][%d] postDagActionOK  %+v", 200, o.Payload)
+}
+
+func (o *PostDagActionOK) String() string {
+	return fmt.Sprintf("[POST /dags/{dagId}][%d] postDagActionOK  %+v", 200, o.Payload)
+}
+
+func (o *PostDagActionOK) GetPayload() *models.PostDagActionResponse {
+	return o.Payload
+}
+
+func (o *PostDagActionOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.PostDagActionResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostDagActionDefault creates a PostDagActionDefault with default headers values
+func NewPostDagActionDefault(code int) *PostDagActionDefault {
+	return &PostDagActionDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+PostDagActionDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type PostDagActionDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this post dag action default response has a 2xx status code
+func (o *PostDagActionDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this post dag action default response has a 3xx status code
+func (o *PostDagActionDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this post dag action default response has a 4xx status code
+func (o *PostDagActionDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this post dag action default response has a 5xx status code
+func (o *PostDagActionDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this post dag action default response a status code equal to that given
+func (o *PostDagActionDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the post dag action default response
+func (o *PostDagActionDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PostDagActionDefault) Error() string {
+	return fmt.Sprintf("[POST /dags/{dagId}][%d] postDagAction default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostDagActionDefault) String() string {
+	return fmt.Sprintf("[POST /dags/{dagId}][%d] postDagAction default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostDagActionDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *PostDagActionDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+PostDagActionBody post dag action body
+swagger:model PostDagActionBody
+*/
+type PostDagActionBody struct {
+
+	// action
+	// Required: true
+	// Enum: [start suspend stop retry mark-success mark-failed mark-skipped save rename]
+	Action *string `json:"action"`
+
+	// The delay after which the scheduler starts the run, e.g. "30m".
+	Delay string `json:"delay,omitempty"`
+
+	// The time in RFC 3339 the suspension is lifted.
+	ExpiresAt string `json:"expiresAt,omitempty"`
+
+	// The name of the step to start the run from. The steps it depends on are skipped.
+	From string `json:"from,omitempty"`
+
+	// idempotency key
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
+
+	// labels
+	Labels string `json:"labels,omitempty"`
+
+	// params
+	Params string `json:"params,omitempty"`
+
+	// The reason of the suspension.
+	Reason string `json:"reason,omitempty"`
+
+	// request Id
+	RequestID string `json:"requestId,omitempty"`
+
+	// The time in RFC 3339 the scheduler starts the run. The run is started immediately if neither startAt nor delay is set.
+	StartAt string `json:"startAt,omitempty"`
+
+	// step
+	Step string `json:"step,omitempty"`
+
+	// The name of the step to run with the steps it depends on. The other steps are skipped.
+	Target string `json:"target,omitempty"`
+
+	// value
+	Value string `json:"value,omitempty"`
+}
+
+// Validate validates this post dag action body
+func (o *PostDagActionBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var postDagActionBodyTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["start","suspend","stop","retry","mark-success","mark-failed","mark-skipped","save","rename"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		postDagActionBodyTypeActionPropEnum = append(postDagActionBodyTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// PostDagActionBodyActionStart captures enum value "start"
+	PostDagActionBodyActionStart string = "start"
+
+	// PostDagActionBodyActionSuspend captures enum value "suspend"
+	PostDagActionBodyActionSuspend string = "suspend"
+
+	// PostDagActionBodyActionStop captures enum value "stop"
+	PostDagActionBodyActionStop string = "stop"
+
+	// PostDagActionBodyActionRetry captures enum value "retry"
+	PostDagActionBodyActionRetry string = "retry"
+
+	// PostDagActionBodyActionMarkDashSuccess captures enum value "mark-success"
+	PostDagActionBodyActionMarkDashSuccess string = "mark-success"
+
+	// PostDagActionBodyActionMarkDashFailed captures enum value "mark-failed"
+	PostDagActionBodyActionMarkDashFailed string = "mark-failed"
+
+	// PostDagActionBodyActionMarkDashSkipped captures enum value "mark-skipped"
+	PostDagActionBodyActionMarkDashSkipped string = "mark-skipped"
+
+	// PostDagActionBodyActionSave captures enum value "save"
+	PostDagActionBodyActionSave string = "save"
+
+	// PostDagActionBodyActionRename captures enum value "rename"
+	PostDagActionBodyActionRename string = "rename"
+)
+
+// prop value enum
+func (o *PostDagActionBody) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, postDagActionBodyTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *PostDagActionBody) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("body"+"."+"action", "body", o.Action); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := o.validateActionEnum("body"+"."+"action", "body", *o.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post dag action body based on context it is used
+func (o *PostDagActionBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostDagActionBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostDagActionBody) UnmarshalBinary(b []byte) error {
+	var res PostDagActionBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
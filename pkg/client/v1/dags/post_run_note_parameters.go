@@ -0,0 +1,184 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewPostRunNoteParams creates a new PostRunNoteParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPostRunNoteParams() *PostRunNoteParams {
+	return &PostRunNoteParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPostRunNoteParamsWithTimeout creates a new PostRunNoteParams object
+// with the ability to set a timeout on a request.
+func NewPostRunNoteParamsWithTimeout(timeout time.Duration) *PostRunNoteParams {
+	return &PostRunNoteParams{
+		timeout: timeout,
+	}
+}
+
+// NewPostRunNoteParamsWithContext creates a new PostRunNoteParams object
+// with the ability to set a context for a request.
+func NewPostRunNoteParamsWithContext(ctx context.Context) *PostRunNoteParams {
+	return &PostRunNoteParams{
+		Context: ctx,
+	}
+}
+
+// NewPostRunNoteParamsWithHTTPClient creates a new PostRunNoteParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPostRunNoteParamsWithHTTPClient(client *http.Client) *PostRunNoteParams {
+	return &PostRunNoteParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PostRunNoteParams contains all the parameters to send to the API endpoint
+
+	for the post run note operation.
+
+	Typically these are written to a http.Request.
+*/
+type PostRunNoteParams struct {
+
+	// Body.
+	Body PostRunNoteBody
+
+	// DagID.
+	DagID string
+
+	// RequestID.
+	RequestID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the post run note params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostRunNoteParams) WithDefaults() *PostRunNoteParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the post run note params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostRunNoteParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the post run note params
+func (o *PostRunNoteParams) WithTimeout(timeout time.Duration) *PostRunNoteParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the post run note params
+func (o *PostRunNoteParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the post run note params
+func (o *PostRunNoteParams) WithContext(ctx context.Context) *PostRunNoteParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the post run note params
+func (o *PostRunNoteParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the post run note params
+func (o *PostRunNoteParams) WithHTTPClient(client *http.Client) *PostRunNoteParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the post run note params
+func (o *PostRunNoteParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the post run note params
+func (o *PostRunNoteParams) WithBody(body PostRunNoteBody) *PostRunNoteParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the post run note params
+func (o *PostRunNoteParams) SetBody(body PostRunNoteBody) {
+	o.Body = body
+}
+
+// WithDagID adds the dagID to the post run note params
+func (o *PostRunNoteParams) WithDagID(dagID string) *PostRunNoteParams {
+	o.SetDagID(dagID)
+	return o
+}
+
+// SetDagID adds the dagId to the post run note params
+func (o *PostRunNoteParams) SetDagID(dagID string) {
+	o.DagID = dagID
+}
+
+// WithRequestID adds the requestID to the post run note params
+func (o *PostRunNoteParams) WithRequestID(requestID string) *PostRunNoteParams {
+	o.SetRequestID(requestID)
+	return o
+}
+
+// SetRequestID adds the requestId to the post run note params
+func (o *PostRunNoteParams) SetRequestID(requestID string) {
+	o.RequestID = requestID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PostRunNoteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	// path param dagId
+	if err := r.SetPathParam("dagId", o.DagID); err != nil {
+		return err
+	}
+
+	// path param requestId
+	if err := r.SetPathParam("requestId", o.RequestID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// PostRunNoteReader is a Reader for the PostRunNote structure.
+type PostRunNoteReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PostRunNoteReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPostRunNoteOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewPostRunNoteDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPostRunNoteOK creates a PostRunNoteOK with default headers values
+func NewPostRunNoteOK() *PostRunNoteOK {
+	return &PostRunNoteOK{}
+}
+
+/*
+PostRunNoteOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type PostRunNoteOK struct {
+	Payload *models.PostRunNoteResponse
+}
+
+// IsSuccess returns true when this post run note o k response has a 2xx status code
+func (o *PostRunNoteOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this post run note o k response has a 3xx status code
+func (o *PostRunNoteOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post run note o k response has a 4xx status code
+func (o *PostRunNoteOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this post run note o k response has a 5xx status code
+func (o *PostRunNoteOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post run note o k response a status code equal to that given
+func (o *PostRunNoteOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the post run note o k response
+func (o *PostRunNoteOK) Code() int {
+	return 200
+}
+
+func (o *PostRunNoteOK) Error() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/requests/{requestId}/notes][%d] postRunNoteOK  %+v", 200, o.Payload)
+}
+
+func (o *PostRunNoteOK) String() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/requests/{requestId}/notes][%d] postRunNoteOK  %+v", 200, o.Payload)
+}
+
+func (o *PostRunNoteOK) GetPayload() *models.PostRunNoteResponse {
+	return o.Payload
+}
+
+func (o *PostRunNoteOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.PostRunNoteResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostRunNoteDefault creates a PostRunNoteDefault with default headers values
+func NewPostRunNoteDefault(code int) *PostRunNoteDefault {
+	return &PostRunNoteDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+PostRunNoteDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type PostRunNoteDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this post run note default response has a 2xx status code
+func (o *PostRunNoteDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this post run note default response has a 3xx status code
+func (o *PostRunNoteDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this post run note default response has a 4xx status code
+func (o *PostRunNoteDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this post run note default response has a 5xx status code
+func (o *PostRunNoteDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this post run note default response a status code equal to that given
+func (o *PostRunNoteDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the post run note default response
+func (o *PostRunNoteDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PostRunNoteDefault) Error() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/requests/{requestId}/notes][%d] postRunNote default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostRunNoteDefault) String() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/requests/{requestId}/notes][%d] postRunNote default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostRunNoteDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *PostRunNoteDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+PostRunNoteBody post run note body
+swagger:model PostRunNoteBody
+*/
+type PostRunNoteBody struct {
+
+	// text
+	// Required: true
+	Text *string `json:"text"`
+}
+
+// Validate validates this post run note body
+func (o *PostRunNoteBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateText(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostRunNoteBody) validateText(formats strfmt.Registry) error {
+
+	if err := validate.Required("body"+"."+"text", "body", o.Text); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post run note body based on context it is used
+func (o *PostRunNoteBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostRunNoteBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostRunNoteBody) UnmarshalBinary(b []byte) error {
+	var res PostRunNoteBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
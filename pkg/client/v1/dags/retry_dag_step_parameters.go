@@ -0,0 +1,186 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewRetryDagStepParams creates a new RetryDagStepParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewRetryDagStepParams() *RetryDagStepParams {
+	return &RetryDagStepParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRetryDagStepParamsWithTimeout creates a new RetryDagStepParams object
+// with the ability to set a timeout on a request.
+func NewRetryDagStepParamsWithTimeout(timeout time.Duration) *RetryDagStepParams {
+	return &RetryDagStepParams{
+		timeout: timeout,
+	}
+}
+
+// NewRetryDagStepParamsWithContext creates a new RetryDagStepParams object
+// with the ability to set a context for a request.
+func NewRetryDagStepParamsWithContext(ctx context.Context) *RetryDagStepParams {
+	return &RetryDagStepParams{
+		Context: ctx,
+	}
+}
+
+// NewRetryDagStepParamsWithHTTPClient creates a new RetryDagStepParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewRetryDagStepParamsWithHTTPClient(client *http.Client) *RetryDagStepParams {
+	return &RetryDagStepParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+RetryDagStepParams contains all the parameters to send to the API endpoint
+
+	for the retry dag step operation.
+
+	Typically these are written to a http.Request.
+*/
+type RetryDagStepParams struct {
+
+	// DagID.
+	DagID string
+
+	// RequestID.
+	RequestID string
+
+	// StepName.
+	StepName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the retry dag step params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RetryDagStepParams) WithDefaults() *RetryDagStepParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the retry dag step params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RetryDagStepParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the retry dag step params
+func (o *RetryDagStepParams) WithTimeout(timeout time.Duration) *RetryDagStepParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the retry dag step params
+func (o *RetryDagStepParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the retry dag step params
+func (o *RetryDagStepParams) WithContext(ctx context.Context) *RetryDagStepParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the retry dag step params
+func (o *RetryDagStepParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the retry dag step params
+func (o *RetryDagStepParams) WithHTTPClient(client *http.Client) *RetryDagStepParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the retry dag step params
+func (o *RetryDagStepParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDagID adds the dagID to the retry dag step params
+func (o *RetryDagStepParams) WithDagID(dagID string) *RetryDagStepParams {
+	o.SetDagID(dagID)
+	return o
+}
+
+// SetDagID adds the dagId to the retry dag step params
+func (o *RetryDagStepParams) SetDagID(dagID string) {
+	o.DagID = dagID
+}
+
+// WithRequestID adds the requestID to the retry dag step params
+func (o *RetryDagStepParams) WithRequestID(requestID string) *RetryDagStepParams {
+	o.SetRequestID(requestID)
+	return o
+}
+
+// SetRequestID adds the requestId to the retry dag step params
+func (o *RetryDagStepParams) SetRequestID(requestID string) {
+	o.RequestID = requestID
+}
+
+// WithStepName adds the stepName to the retry dag step params
+func (o *RetryDagStepParams) WithStepName(stepName string) *RetryDagStepParams {
+	o.SetStepName(stepName)
+	return o
+}
+
+// SetStepName adds the stepName to the retry dag step params
+func (o *RetryDagStepParams) SetStepName(stepName string) {
+	o.StepName = stepName
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RetryDagStepParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param dagId
+	if err := r.SetPathParam("dagId", o.DagID); err != nil {
+		return err
+	}
+
+	// path param requestId
+	if err := r.SetPathParam("requestId", o.RequestID); err != nil {
+		return err
+	}
+
+	// path param stepName
+	if err := r.SetPathParam("stepName", o.StepName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
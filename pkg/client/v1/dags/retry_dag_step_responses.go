@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// RetryDagStepReader is a Reader for the RetryDagStep structure.
+type RetryDagStepReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RetryDagStepReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRetryDagStepOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewRetryDagStepDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewRetryDagStepOK creates a RetryDagStepOK with default headers values
+func NewRetryDagStepOK() *RetryDagStepOK {
+	return &RetryDagStepOK{}
+}
+
+/*
+RetryDagStepOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type RetryDagStepOK struct {
+	Payload *models.PostDagActionResponse
+}
+
+// IsSuccess returns true when this retry dag step o k response has a 2xx status code
+func (o *RetryDagStepOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this retry dag step o k response has a 3xx status code
+func (o *RetryDagStepOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this retry dag step o k response has a 4xx status code
+func (o *RetryDagStepOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this retry dag step o k response has a 5xx status code
+func (o *RetryDagStepOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this retry dag step o k response a status code equal to that given
+func (o *RetryDagStepOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the retry dag step o k response
+func (o *RetryDagStepOK) Code() int {
+	return 200
+}
+
+func (o *RetryDagStepOK) Error() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/requests/{requestId}/steps/{stepName}/retry][%d] retryDagStepOK  %+v", 200, o.Payload)
+}
+
+func (o *RetryDagStepOK) String() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/requests/{requestId}/steps/{stepName}/retry][%d] retryDagStepOK  %+v", 200, o.Payload)
+}
+
+func (o *RetryDagStepOK) GetPayload() *models.PostDagActionResponse {
+	return o.Payload
+}
+
+func (o *RetryDagStepOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.PostDagActionResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRetryDagStepDefault creates a RetryDagStepDefault with default headers values
+func NewRetryDagStepDefault(code int) *RetryDagStepDefault {
+	return &RetryDagStepDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+RetryDagStepDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type RetryDagStepDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this retry dag step default response has a 2xx status code
+func (o *RetryDagStepDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this retry dag step default response has a 3xx status code
+func (o *RetryDagStepDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this retry dag step default response has a 4xx status code
+func (o *RetryDagStepDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this retry dag step default response has a 5xx status code
+func (o *RetryDagStepDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this retry dag step default response a status code equal to that given
+func (o *RetryDagStepDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the retry dag step default response
+func (o *RetryDagStepDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *RetryDagStepDefault) Error() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/requests/{requestId}/steps/{stepName}/retry][%d] retryDagStep default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *RetryDagStepDefault) String() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/requests/{requestId}/steps/{stepName}/retry][%d] retryDagStep default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *RetryDagStepDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *RetryDagStepDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
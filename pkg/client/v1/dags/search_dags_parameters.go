@@ -0,0 +1,187 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewSearchDagsParams creates a new SearchDagsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSearchDagsParams() *SearchDagsParams {
+	return &SearchDagsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSearchDagsParamsWithTimeout creates a new SearchDagsParams object
+// with the ability to set a timeout on a request.
+func NewSearchDagsParamsWithTimeout(timeout time.Duration) *SearchDagsParams {
+	return &SearchDagsParams{
+		timeout: timeout,
+	}
+}
+
+// NewSearchDagsParamsWithContext creates a new SearchDagsParams object
+// with the ability to set a context for a request.
+func NewSearchDagsParamsWithContext(ctx context.Context) *SearchDagsParams {
+	return &SearchDagsParams{
+		Context: ctx,
+	}
+}
+
+// NewSearchDagsParamsWithHTTPClient creates a new SearchDagsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSearchDagsParamsWithHTTPClient(client *http.Client) *SearchDagsParams {
+	return &SearchDagsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SearchDagsParams contains all the parameters to send to the API endpoint
+
+	for the search dags operation.
+
+	Typically these are written to a http.Request.
+*/
+type SearchDagsParams struct {
+
+	/* Labels.
+
+	   Returns only the DAGs with a recent run having the labels (e.g. customer=acme,backfill=true).
+	*/
+	Labels *string
+
+	// Q.
+	Q string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the search dags params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SearchDagsParams) WithDefaults() *SearchDagsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the search dags params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SearchDagsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the search dags params
+func (o *SearchDagsParams) WithTimeout(timeout time.Duration) *SearchDagsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the search dags params
+func (o *SearchDagsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the search dags params
+func (o *SearchDagsParams) WithContext(ctx context.Context) *SearchDagsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the search dags params
+func (o *SearchDagsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the search dags params
+func (o *SearchDagsParams) WithHTTPClient(client *http.Client) *SearchDagsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the search dags params
+func (o *SearchDagsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLabels adds the labels to the search dags params
+func (o *SearchDagsParams) WithLabels(labels *string) *SearchDagsParams {
+	o.SetLabels(labels)
+	return o
+}
+
+// SetLabels adds the labels to the search dags params
+func (o *SearchDagsParams) SetLabels(labels *string) {
+	o.Labels = labels
+}
+
+// WithQ adds the q to the search dags params
+func (o *SearchDagsParams) WithQ(q string) *SearchDagsParams {
+	o.SetQ(q)
+	return o
+}
+
+// SetQ adds the q to the search dags params
+func (o *SearchDagsParams) SetQ(q string) {
+	o.Q = q
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SearchDagsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Labels != nil {
+
+		// query param labels
+		var qrLabels string
+
+		if o.Labels != nil {
+			qrLabels = *o.Labels
+		}
+		qLabels := qrLabels
+		if qLabels != "" {
+
+			if err := r.SetQueryParam("labels", qLabels); err != nil {
+				return err
+			}
+		}
+	}
+
+	// query param q
+	qrQ := o.Q
+	qQ := qrQ
+	if qQ != "" {
+
+		if err := r.SetQueryParam("q", qQ); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// SearchDagsReader is a Reader for the SearchDags structure.
+type SearchDagsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SearchDagsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSearchDagsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewSearchDagsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewSearchDagsOK creates a SearchDagsOK with default headers values
+func NewSearchDagsOK() *SearchDagsOK {
+	return &SearchDagsOK{}
+}
+
+/*
+SearchDagsOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type SearchDagsOK struct {
+	Payload *models.SearchDagsResponse
+}
+
+// IsSuccess returns true when this search dags o k response has a 2xx status code
+func (o *SearchDagsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this search dags o k response has a 3xx status code
+func (o *SearchDagsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this search dags o k response has a 4xx status code
+func (o *SearchDagsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this search dags o k response has a 5xx status code
+func (o *SearchDagsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this search dags o k response a status code equal to that given
+func (o *SearchDagsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the search dags o k response
+func (o *SearchDagsOK) Code() int {
+	return 200
+}
+
+func (o *SearchDagsOK) Error() string {
+	return fmt.Sprintf("[GET /search][%d] searchDagsOK  %+v", 200, o.Payload)
+}
+
+func (o *SearchDagsOK) String() string {
+	return fmt.Sprintf("[GET /search][%d] searchDagsOK  %+v", 200, o.Payload)
+}
+
+func (o *SearchDagsOK) GetPayload() *models.SearchDagsResponse {
+	return o.Payload
+}
+
+func (o *SearchDagsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.SearchDagsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSearchDagsDefault creates a SearchDagsDefault with default headers values
+func NewSearchDagsDefault(code int) *SearchDagsDefault {
+	return &SearchDagsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+SearchDagsDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type SearchDagsDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this search dags default response has a 2xx status code
+func (o *SearchDagsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this search dags default response has a 3xx status code
+func (o *SearchDagsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this search dags default response has a 4xx status code
+func (o *SearchDagsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this search dags default response has a 5xx status code
+func (o *SearchDagsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this search dags default response a status code equal to that given
+func (o *SearchDagsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the search dags default response
+func (o *SearchDagsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *SearchDagsDefault) Error() string {
+	return fmt.Sprintf("[GET /search][%d] searchDags default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SearchDagsDefault) String() string {
+	return fmt.Sprintf("[GET /search][%d] searchDags default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SearchDagsDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *SearchDagsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
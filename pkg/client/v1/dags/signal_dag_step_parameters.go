@@ -0,0 +1,207 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// NewSignalDagStepParams creates a new SignalDagStepParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSignalDagStepParams() *SignalDagStepParams {
+	return &SignalDagStepParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSignalDagStepParamsWithTimeout creates a new SignalDagStepParams object
+// with the ability to set a timeout on a request.
+func NewSignalDagStepParamsWithTimeout(timeout time.Duration) *SignalDagStepParams {
+	return &SignalDagStepParams{
+		timeout: timeout,
+	}
+}
+
+// NewSignalDagStepParamsWithContext creates a new SignalDagStepParams object
+// with the ability to set a context for a request.
+func NewSignalDagStepParamsWithContext(ctx context.Context) *SignalDagStepParams {
+	return &SignalDagStepParams{
+		Context: ctx,
+	}
+}
+
+// NewSignalDagStepParamsWithHTTPClient creates a new SignalDagStepParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSignalDagStepParamsWithHTTPClient(client *http.Client) *SignalDagStepParams {
+	return &SignalDagStepParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SignalDagStepParams contains all the parameters to send to the API endpoint
+
+	for the signal dag step operation.
+
+	Typically these are written to a http.Request.
+*/
+type SignalDagStepParams struct {
+
+	// Body.
+	Body *models.SignalStepBody
+
+	// DagID.
+	DagID string
+
+	// RequestID.
+	RequestID string
+
+	// StepName.
+	StepName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the signal dag step params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SignalDagStepParams) WithDefaults() *SignalDagStepParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the signal dag step params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SignalDagStepParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the signal dag step params
+func (o *SignalDagStepParams) WithTimeout(timeout time.Duration) *SignalDagStepParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the signal dag step params
+func (o *SignalDagStepParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the signal dag step params
+func (o *SignalDagStepParams) WithContext(ctx context.Context) *SignalDagStepParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the signal dag step params
+func (o *SignalDagStepParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the signal dag step params
+func (o *SignalDagStepParams) WithHTTPClient(client *http.Client) *SignalDagStepParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the signal dag step params
+func (o *SignalDagStepParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the signal dag step params
+func (o *SignalDagStepParams) WithBody(body *models.SignalStepBody) *SignalDagStepParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the signal dag step params
+func (o *SignalDagStepParams) SetBody(body *models.SignalStepBody) {
+	o.Body = body
+}
+
+// WithDagID adds the dagID to the signal dag step params
+func (o *SignalDagStepParams) WithDagID(dagID string) *SignalDagStepParams {
+	o.SetDagID(dagID)
+	return o
+}
+
+// SetDagID adds the dagId to the signal dag step params
+func (o *SignalDagStepParams) SetDagID(dagID string) {
+	o.DagID = dagID
+}
+
+// WithRequestID adds the requestID to the signal dag step params
+func (o *SignalDagStepParams) WithRequestID(requestID string) *SignalDagStepParams {
+	o.SetRequestID(requestID)
+	return o
+}
+
+// SetRequestID adds the requestId to the signal dag step params
+func (o *SignalDagStepParams) SetRequestID(requestID string) {
+	o.RequestID = requestID
+}
+
+// WithStepName adds the stepName to the signal dag step params
+func (o *SignalDagStepParams) WithStepName(stepName string) *SignalDagStepParams {
+	o.SetStepName(stepName)
+	return o
+}
+
+// SetStepName adds the stepName to the signal dag step params
+func (o *SignalDagStepParams) SetStepName(stepName string) {
+	o.StepName = stepName
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SignalDagStepParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param dagId
+	if err := r.SetPathParam("dagId", o.DagID); err != nil {
+		return err
+	}
+
+	// path param requestId
+	if err := r.SetPathParam("requestId", o.RequestID); err != nil {
+		return err
+	}
+
+	// path param stepName
+	if err := r.SetPathParam("stepName", o.StepName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// SignalDagStepReader is a Reader for the SignalDagStep structure.
+type SignalDagStepReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SignalDagStepReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSignalDagStepOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewSignalDagStepDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewSignalDagStepOK creates a SignalDagStepOK with default headers values
+func NewSignalDagStepOK() *SignalDagStepOK {
+	return &SignalDagStepOK{}
+}
+
+/*
+SignalDagStepOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type SignalDagStepOK struct {
+	Payload *models.PostDagActionResponse
+}
+
+// IsSuccess returns true when this signal dag step o k response has a 2xx status code
+func (o *SignalDagStepOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this signal dag step o k response has a 3xx status code
+func (o *SignalDagStepOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this signal dag step o k response has a 4xx status code
+func (o *SignalDagStepOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this signal dag step o k response has a 5xx status code
+func (o *SignalDagStepOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this signal dag step o k response a status code equal to that given
+func (o *SignalDagStepOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the signal dag step o k response
+func (o *SignalDagStepOK) Code() int {
+	return 200
+}
+
+func (o *SignalDagStepOK) Error() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/requests/{requestId}/steps/{stepName}/signal][%d] signalDagStepOK  %+v", 200, o.Payload)
+}
+
+func (o *SignalDagStepOK) String() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/requests/{requestId}/steps/{stepName}/signal][%d] signalDagStepOK  %+v", 200, o.Payload)
+}
+
+func (o *SignalDagStepOK) GetPayload() *models.PostDagActionResponse {
+	return o.Payload
+}
+
+func (o *SignalDagStepOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.PostDagActionResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSignalDagStepDefault creates a SignalDagStepDefault with default headers values
+func NewSignalDagStepDefault(code int) *SignalDagStepDefault {
+	return &SignalDagStepDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+SignalDagStepDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type SignalDagStepDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this signal dag step default response has a 2xx status code
+func (o *SignalDagStepDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this signal dag step default response has a 3xx status code
+func (o *SignalDagStepDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this signal dag step default response has a 4xx status code
+func (o *SignalDagStepDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this signal dag step default response has a 5xx status code
+func (o *SignalDagStepDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this signal dag step default response a status code equal to that given
+func (o *SignalDagStepDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the signal dag step default response
+func (o *SignalDagStepDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *SignalDagStepDefault) Error() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/requests/{requestId}/steps/{stepName}/signal][%d] signalDagStep default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SignalDagStepDefault) String() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/requests/{requestId}/steps/{stepName}/signal][%d] signalDagStep default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SignalDagStepDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *SignalDagStepDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
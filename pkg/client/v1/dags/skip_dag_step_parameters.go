@@ -0,0 +1,186 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewSkipDagStepParams creates a new SkipDagStepParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSkipDagStepParams() *SkipDagStepParams {
+	return &SkipDagStepParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSkipDagStepParamsWithTimeout creates a new SkipDagStepParams object
+// with the ability to set a timeout on a request.
+func NewSkipDagStepParamsWithTimeout(timeout time.Duration) *SkipDagStepParams {
+	return &SkipDagStepParams{
+		timeout: timeout,
+	}
+}
+
+// NewSkipDagStepParamsWithContext creates a new SkipDagStepParams object
+// with the ability to set a context for a request.
+func NewSkipDagStepParamsWithContext(ctx context.Context) *SkipDagStepParams {
+	return &SkipDagStepParams{
+		Context: ctx,
+	}
+}
+
+// NewSkipDagStepParamsWithHTTPClient creates a new SkipDagStepParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSkipDagStepParamsWithHTTPClient(client *http.Client) *SkipDagStepParams {
+	return &SkipDagStepParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SkipDagStepParams contains all the parameters to send to the API endpoint
+
+	for the skip dag step operation.
+
+	Typically these are written to a http.Request.
+*/
+type SkipDagStepParams struct {
+
+	// DagID.
+	DagID string
+
+	// RequestID.
+	RequestID string
+
+	// StepName.
+	StepName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the skip dag step params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SkipDagStepParams) WithDefaults() *SkipDagStepParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the skip dag step params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SkipDagStepParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the skip dag step params
+func (o *SkipDagStepParams) WithTimeout(timeout time.Duration) *SkipDagStepParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the skip dag step params
+func (o *SkipDagStepParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the skip dag step params
+func (o *SkipDagStepParams) WithContext(ctx context.Context) *SkipDagStepParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the skip dag step params
+func (o *SkipDagStepParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the skip dag step params
+func (o *SkipDagStepParams) WithHTTPClient(client *http.Client) *SkipDagStepParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the skip dag step params
+func (o *SkipDagStepParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDagID adds the dagID to the skip dag step params
+func (o *SkipDagStepParams) WithDagID(dagID string) *SkipDagStepParams {
+	o.SetDagID(dagID)
+	return o
+}
+
+// SetDagID adds the dagId to the skip dag step params
+func (o *SkipDagStepParams) SetDagID(dagID string) {
+	o.DagID = dagID
+}
+
+// WithRequestID adds the requestID to the skip dag step params
+func (o *SkipDagStepParams) WithRequestID(requestID string) *SkipDagStepParams {
+	o.SetRequestID(requestID)
+	return o
+}
+
+// SetRequestID adds the requestId to the skip dag step params
+func (o *SkipDagStepParams) SetRequestID(requestID string) {
+	o.RequestID = requestID
+}
+
+// WithStepName adds the stepName to the skip dag step params
+func (o *SkipDagStepParams) WithStepName(stepName string) *SkipDagStepParams {
+	o.SetStepName(stepName)
+	return o
+}
+
+// SetStepName adds the stepName to the skip dag step params
+func (o *SkipDagStepParams) SetStepName(stepName string) {
+	o.StepName = stepName
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SkipDagStepParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param dagId
+	if err := r.SetPathParam("dagId", o.DagID); err != nil {
+		return err
+	}
+
+	// path param requestId
+	if err := r.SetPathParam("requestId", o.RequestID); err != nil {
+		return err
+	}
+
+	// path param stepName
+	if err := r.SetPathParam("stepName", o.StepName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
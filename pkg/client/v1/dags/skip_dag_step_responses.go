@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// SkipDagStepReader is a Reader for the SkipDagStep structure.
+type SkipDagStepReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SkipDagStepReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSkipDagStepOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewSkipDagStepDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewSkipDagStepOK creates a SkipDagStepOK with default headers values
+func NewSkipDagStepOK() *SkipDagStepOK {
+	return &SkipDagStepOK{}
+}
+
+/*
+SkipDagStepOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type SkipDagStepOK struct {
+	Payload *models.PostDagActionResponse
+}
+
+// IsSuccess returns true when this skip dag step o k response has a 2xx status code
+func (o *SkipDagStepOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this skip dag step o k response has a 3xx status code
+func (o *SkipDagStepOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this skip dag step o k response has a 4xx status code
+func (o *SkipDagStepOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this skip dag step o k response has a 5xx status code
+func (o *SkipDagStepOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this skip dag step o k response a status code equal to that given
+func (o *SkipDagStepOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the skip dag step o k response
+func (o *SkipDagStepOK) Code() int {
+	return 200
+}
+
+func (o *SkipDagStepOK) Error() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/requests/{requestId}/steps/{stepName}/skip][%d] skipDagStepOK  %+v", 200, o.Payload)
+}
+
+func (o *SkipDagStepOK) String() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/requests/{requestId}/steps/{stepName}/skip][%d] skipDagStepOK  %+v", 200, o.Payload)
+}
+
+func (o *SkipDagStepOK) GetPayload() *models.PostDagActionResponse {
+	return o.Payload
+}
+
+func (o *SkipDagStepOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.PostDagActionResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSkipDagStepDefault creates a SkipDagStepDefault with default headers values
+func NewSkipDagStepDefault(code int) *SkipDagStepDefault {
+	return &SkipDagStepDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+SkipDagStepDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type SkipDagStepDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this skip dag step default response has a 2xx status code
+func (o *SkipDagStepDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this skip dag step default response has a 3xx status code
+func (o *SkipDagStepDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this skip dag step default response has a 4xx status code
+func (o *SkipDagStepDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this skip dag step default response has a 5xx status code
+func (o *SkipDagStepDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this skip dag step default response a status code equal to that given
+func (o *SkipDagStepDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the skip dag step default response
+func (o *SkipDagStepDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *SkipDagStepDefault) Error() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/requests/{requestId}/steps/{stepName}/skip][%d] skipDagStep default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SkipDagStepDefault) String() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/requests/{requestId}/steps/{stepName}/skip][%d] skipDagStep default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SkipDagStepDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *SkipDagStepDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// NewStartDagParams creates a new StartDagParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewStartDagParams() *StartDagParams {
+	return &StartDagParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewStartDagParamsWithTimeout creates a new StartDagParams object
+// with the ability to set a timeout on a request.
+func NewStartDagParamsWithTimeout(timeout time.Duration) *StartDagParams {
+	return &StartDagParams{
+		timeout: timeout,
+	}
+}
+
+// NewStartDagParamsWithContext creates a new StartDagParams object
+// with the ability to set a context for a request.
+func NewStartDagParamsWithContext(ctx context.Context) *StartDagParams {
+	return &StartDagParams{
+		Context: ctx,
+	}
+}
+
+// NewStartDagParamsWithHTTPClient creates a new StartDagParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewStartDagParamsWithHTTPClient(client *http.Client) *StartDagParams {
+	return &StartDagParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+StartDagParams contains all the parameters to send to the API endpoint
+
+	for the start dag operation.
+
+	Typically these are written to a http.Request.
+*/
+type StartDagParams struct {
+
+	// Body.
+	Body *models.StartDagBody
+
+	// DagID.
+	DagID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the start dag params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *StartDagParams) WithDefaults() *StartDagParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the start dag params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *StartDagParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the start dag params
+func (o *StartDagParams) WithTimeout(timeout time.Duration) *StartDagParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the start dag params
+func (o *StartDagParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the start dag params
+func (o *StartDagParams) WithContext(ctx context.Context) *StartDagParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the start dag params
+func (o *StartDagParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the start dag params
+func (o *StartDagParams) WithHTTPClient(client *http.Client) *StartDagParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the start dag params
+func (o *StartDagParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the start dag params
+func (o *StartDagParams) WithBody(body *models.StartDagBody) *StartDagParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the start dag params
+func (o *StartDagParams) SetBody(body *models.StartDagBody) {
+	o.Body = body
+}
+
+// WithDagID adds the dagID to the start dag params
+func (o *StartDagParams) WithDagID(dagID string) *StartDagParams {
+	o.SetDagID(dagID)
+	return o
+}
+
+// SetDagID adds the dagId to the start dag params
+func (o *StartDagParams) SetDagID(dagID string) {
+	o.DagID = dagID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *StartDagParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param dagId
+	if err := r.SetPathParam("dagId", o.DagID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// StartDagReader is a Reader for the StartDag structure.
+type StartDagReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *StartDagReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewStartDagOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewStartDagDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewStartDagOK creates a StartDagOK with default headers values
+func NewStartDagOK() *StartDagOK {
+	return &StartDagOK{}
+}
+
+/*
+StartDagOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type StartDagOK struct {
+	Payload *models.PostDagActionResponse
+}
+
+// IsSuccess returns true when this start dag o k response has a 2xx status code
+func (o *StartDagOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this start dag o k response has a 3xx status code
+func (o *StartDagOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this start dag o k response has a 4xx status code
+func (o *StartDagOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this start dag o k response has a 5xx status code
+func (o *StartDagOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this start dag o k response a status code equal to that given
+func (o *StartDagOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the start dag o k response
+func (o *StartDagOK) Code() int {
+	return 200
+}
+
+func (o *StartDagOK) Error() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/start][%d] startDagOK  %+v", 200, o.Payload)
+}
+
+func (o *StartDagOK) String() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/start][%d] startDagOK  %+v", 200, o.Payload)
+}
+
+func (o *StartDagOK) GetPayload() *models.PostDagActionResponse {
+	return o.Payload
+}
+
+func (o *StartDagOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.PostDagActionResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStartDagDefault creates a StartDagDefault with default headers values
+func NewStartDagDefault(code int) *StartDagDefault {
+	return &StartDagDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+StartDagDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type StartDagDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this start dag default response has a 2xx status code
+func (o *StartDagDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this start dag default response has a 3xx status code
+func (o *StartDagDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this start dag default response has a 4xx status code
+func (o *StartDagDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this start dag default response has a 5xx status code
+func (o *StartDagDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this start dag default response a status code equal to that given
+func (o *StartDagDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the start dag default response
+func (o *StartDagDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *StartDagDefault) Error() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/start][%d] startDag default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *StartDagDefault) String() string {
+	return fmt.Sprintf("[POST /dags/{dagId}/start][%d] startDag default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *StartDagDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *StartDagDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewUpdateQueuedRunParams creates a new UpdateQueuedRunParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewUpdateQueuedRunParams() *UpdateQueuedRunParams {
+	return &UpdateQueuedRunParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateQueuedRunParamsWithTimeout creates a new UpdateQueuedRunParams object
+// with the ability to set a timeout on a request.
+func NewUpdateQueuedRunParamsWithTimeout(timeout time.Duration) *UpdateQueuedRunParams {
+	return &UpdateQueuedRunParams{
+		timeout: timeout,
+	}
+}
+
+// NewUpdateQueuedRunParamsWithContext creates a new UpdateQueuedRunParams object
+// with the ability to set a context for a request.
+func NewUpdateQueuedRunParamsWithContext(ctx context.Context) *UpdateQueuedRunParams {
+	return &UpdateQueuedRunParams{
+		Context: ctx,
+	}
+}
+
+// NewUpdateQueuedRunParamsWithHTTPClient creates a new UpdateQueuedRunParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewUpdateQueuedRunParamsWithHTTPClient(client *http.Client) *UpdateQueuedRunParams {
+	return &UpdateQueuedRunParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UpdateQueuedRunParams contains all the parameters to send to the API endpoint
+
+	for the update queued run operation.
+
+	Typically these are written to a http.Request.
+*/
+type UpdateQueuedRunParams struct {
+
+	// Body.
+	Body UpdateQueuedRunBody
+
+	// RequestID.
+	RequestID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the update queued run params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateQueuedRunParams) WithDefaults() *UpdateQueuedRunParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the update queued run params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateQueuedRunParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the update queued run params
+func (o *UpdateQueuedRunParams) WithTimeout(timeout time.Duration) *UpdateQueuedRunParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update queued run params
+func (o *UpdateQueuedRunParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update queued run params
+func (o *UpdateQueuedRunParams) WithContext(ctx context.Context) *UpdateQueuedRunParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update queued run params
+func (o *UpdateQueuedRunParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update queued run params
+func (o *UpdateQueuedRunParams) WithHTTPClient(client *http.Client) *UpdateQueuedRunParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update queued run params
+func (o *UpdateQueuedRunParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the update queued run params
+func (o *UpdateQueuedRunParams) WithBody(body UpdateQueuedRunBody) *UpdateQueuedRunParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the update queued run params
+func (o *UpdateQueuedRunParams) SetBody(body UpdateQueuedRunBody) {
+	o.Body = body
+}
+
+// WithRequestID adds the requestID to the update queued run params
+func (o *UpdateQueuedRunParams) WithRequestID(requestID string) *UpdateQueuedRunParams {
+	o.SetRequestID(requestID)
+	return o
+}
+
+// SetRequestID adds the requestId to the update queued run params
+func (o *UpdateQueuedRunParams) SetRequestID(requestID string) {
+	o.RequestID = requestID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateQueuedRunParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	// path param requestId
+	if err := r.SetPathParam("requestId", o.RequestID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
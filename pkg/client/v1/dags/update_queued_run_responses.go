@@ -0,0 +1,243 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dags
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/dagu-org/dagu/pkg/client/v1/models"
+)
+
+// UpdateQueuedRunReader is a Reader for the UpdateQueuedRun structure.
+type UpdateQueuedRunReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateQueuedRunReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpdateQueuedRunOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewUpdateQueuedRunDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUpdateQueuedRunOK creates a UpdateQueuedRunOK with default headers values
+func NewUpdateQueuedRunOK() *UpdateQueuedRunOK {
+	return &UpdateQueuedRunOK{}
+}
+
+/*
+UpdateQueuedRunOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type UpdateQueuedRunOK struct {
+	Payload *models.QueuedRun
+}
+
+// IsSuccess returns true when this update queued run o k response has a 2xx status code
+func (o *UpdateQueuedRunOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this update queued run o k response has a 3xx status code
+func (o *UpdateQueuedRunOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update queued run o k response has a 4xx status code
+func (o *UpdateQueuedRunOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update queued run o k response has a 5xx status code
+func (o *UpdateQueuedRunOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update queued run o k response a status code equal to that given
+func (o *UpdateQueuedRunOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the update queued run o k response
+func (o *UpdateQueuedRunOK) Code() int {
+	return 200
+}
+
+func (o *UpdateQueuedRunOK) Error() string {
+	return fmt.Sprintf("[PATCH /queue/{requestId}][%d] updateQueuedRunOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateQueuedRunOK) String() string {
+	return fmt.Sprintf("[PATCH /queue/{requestId}][%d] updateQueuedRunOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateQueuedRunOK) GetPayload() *models.QueuedRun {
+	return o.Payload
+}
+
+func (o *UpdateQueuedRunOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.QueuedRun)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateQueuedRunDefault creates a UpdateQueuedRunDefault with default headers values
+func NewUpdateQueuedRunDefault(code int) *UpdateQueuedRunDefault {
+	return &UpdateQueuedRunDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+UpdateQueuedRunDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type UpdateQueuedRunDefault struct {
+	_statusCode int
+
+	Payload *models.APIError
+}
+
+// IsSuccess returns true when this update queued run default response has a 2xx status code
+func (o *UpdateQueuedRunDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this update queued run default response has a 3xx status code
+func (o *UpdateQueuedRunDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this update queued run default response has a 4xx status code
+func (o *UpdateQueuedRunDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this update queued run default response has a 5xx status code
+func (o *UpdateQueuedRunDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this update queued run default response a status code equal to that given
+func (o *UpdateQueuedRunDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the update queued run default response
+func (o *UpdateQueuedRunDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UpdateQueuedRunDefault) Error() string {
+	return fmt.Sprintf("[PATCH /queue/{requestId}][%d] updateQueuedRun default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdateQueuedRunDefault) String() string {
+	return fmt.Sprintf("[PATCH /queue/{requestId}][%d] updateQueuedRun default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdateQueuedRunDefault) GetPayload() *models.APIError {
+	return o.Payload
+}
+
+func (o *UpdateQueuedRunDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+UpdateQueuedRunBody update queued run body
+swagger:model UpdateQueuedRunBody
+*/
+type UpdateQueuedRunBody struct {
+
+	// priority
+	// Required: true
+	Priority *int64 `json:"Priority"`
+}
+
+// Validate validates this update queued run body
+func (o *UpdateQueuedRunBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validatePriority(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *UpdateQueuedRunBody) validatePriority(formats strfmt.Registry) error {
+
+	if err := validate.Required("body"+"."+"Priority", "body", o.Priority); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this update queued run body based on context it is used
+func (o *UpdateQueuedRunBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *UpdateQueuedRunBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *UpdateQueuedRunBody) UnmarshalBinary(b []byte) error {
+	var res UpdateQueuedRunBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,105 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ConfigValueSource config value source
+//
+// swagger:model configValueSource
+type ConfigValueSource struct {
+
+	// The file the value comes from.
+	// Required: true
+	File *string `json:"File"`
+
+	// The path of the value, e.g. "smtp" or "handlerOn.failure.command".
+	// Required: true
+	Key *string `json:"Key"`
+
+	// The value in YAML.
+	// Required: true
+	Value *string `json:"Value"`
+}
+
+// Validate validates this config value source
+func (m *ConfigValueSource) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFile(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateValue(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ConfigValueSource) validateFile(formats strfmt.Registry) error {
+
+	if err := validate.Required("File", "body", m.File); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ConfigValueSource) validateKey(formats strfmt.Registry) error {
+
+	if err := validate.Required("Key", "body", m.Key); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ConfigValueSource) validateValue(formats strfmt.Registry) error {
+
+	if err := validate.Required("Value", "body", m.Value); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this config value source based on context it is used
+func (m *ConfigValueSource) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ConfigValueSource) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ConfigValueSource) UnmarshalBinary(b []byte) error {
+	var res ConfigValueSource
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
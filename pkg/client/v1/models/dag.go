@@ -0,0 +1,229 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Dag dag
+//
+// swagger:model dag
+type Dag struct {
+
+	// default params
+	// Required: true
+	DefaultParams *string `json:"DefaultParams"`
+
+	// description
+	// Required: true
+	Description *string `json:"Description"`
+
+	// group
+	// Required: true
+	Group *string `json:"Group"`
+
+	// name
+	// Required: true
+	Name *string `json:"Name"`
+
+	// The namespace of the DAG, which is empty for the DAGs not in any namespace.
+	Namespace string `json:"Namespace,omitempty"`
+
+	// params
+	// Required: true
+	Params []string `json:"Params"`
+
+	// schedule
+	// Required: true
+	Schedule []*Schedule `json:"Schedule"`
+
+	// tags
+	// Required: true
+	Tags []string `json:"Tags"`
+}
+
+// Validate validates this dag
+func (m *Dag) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDefaultParams(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDescription(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateGroup(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateParams(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSchedule(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTags(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Dag) validateDefaultParams(formats strfmt.Registry) error {
+
+	if err := validate.Required("DefaultParams", "body", m.DefaultParams); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Dag) validateDescription(formats strfmt.Registry) error {
+
+	if err := validate.Required("Description", "body", m.Description); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Dag) validateGroup(formats strfmt.Registry) error {
+
+	if err := validate.Required("Group", "body", m.Group); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Dag) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("Name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Dag) validateParams(formats strfmt.Registry) error {
+
+	if err := validate.Required("Params", "body", m.Params); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Dag) validateSchedule(formats strfmt.Registry) error {
+
+	if err := validate.Required("Schedule", "body", m.Schedule); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Schedule); i++ {
+		if swag.IsZero(m.Schedule[i]) { // not required
+			continue
+		}
+
+		if m.Schedule[i] != nil {
+			if err := m.Schedule[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Schedule" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Schedule" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Dag) validateTags(formats strfmt.Registry) error {
+
+	if err := validate.Required("Tags", "body", m.Tags); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this dag based on the context it is used
+func (m *Dag) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateSchedule(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Dag) contextValidateSchedule(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Schedule); i++ {
+
+		if m.Schedule[i] != nil {
+
+			if swag.IsZero(m.Schedule[i]) { // not required
+				return nil
+			}
+
+			if err := m.Schedule[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Schedule" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Schedule" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Dag) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Dag) UnmarshalBinary(b []byte) error {
+	var res Dag
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
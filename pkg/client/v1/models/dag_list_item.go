@@ -0,0 +1,296 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DagListItem dag list item
+//
+// swagger:model dagListItem
+type DagListItem struct {
+
+	// d a g
+	// Required: true
+	DAG *Dag `json:"DAG"`
+
+	// dir
+	// Required: true
+	Dir *string `json:"Dir"`
+
+	// error
+	// Required: true
+	Error *string `json:"Error"`
+
+	// error t
+	// Required: true
+	ErrorT *string `json:"ErrorT"`
+
+	// file
+	// Required: true
+	File *string `json:"File"`
+
+	// remote node
+	RemoteNode string `json:"RemoteNode,omitempty"`
+
+	// status
+	// Required: true
+	Status *DagStatus `json:"Status"`
+
+	// suspended
+	// Required: true
+	Suspended *bool `json:"Suspended"`
+
+	// suspension
+	Suspension *Suspension `json:"Suspension,omitempty"`
+}
+
+// Validate validates this dag list item
+func (m *DagListItem) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDAG(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDir(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateError(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateErrorT(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFile(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSuspended(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSuspension(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DagListItem) validateDAG(formats strfmt.Registry) error {
+
+	if err := validate.Required("DAG", "body", m.DAG); err != nil {
+		return err
+	}
+
+	if m.DAG != nil {
+		if err := m.DAG.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("DAG")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("DAG")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *DagListItem) validateDir(formats strfmt.Registry) error {
+
+	if err := validate.Required("Dir", "body", m.Dir); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagListItem) validateError(formats strfmt.Registry) error {
+
+	if err := validate.Required("Error", "body", m.Error); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagListItem) validateErrorT(formats strfmt.Registry) error {
+
+	if err := validate.Required("ErrorT", "body", m.ErrorT); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagListItem) validateFile(formats strfmt.Registry) error {
+
+	if err := validate.Required("File", "body", m.File); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagListItem) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("Status", "body", m.Status); err != nil {
+		return err
+	}
+
+	if m.Status != nil {
+		if err := m.Status.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Status")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("Status")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *DagListItem) validateSuspended(formats strfmt.Registry) error {
+
+	if err := validate.Required("Suspended", "body", m.Suspended); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagListItem) validateSuspension(formats strfmt.Registry) error {
+	if swag.IsZero(m.Suspension) { // not required
+		return nil
+	}
+
+	if m.Suspension != nil {
+		if err := m.Suspension.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Suspension")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("Suspension")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this dag list item based on the context it is used
+func (m *DagListItem) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDAG(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateStatus(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSuspension(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DagListItem) contextValidateDAG(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.DAG != nil {
+
+		if err := m.DAG.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("DAG")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("DAG")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *DagListItem) contextValidateStatus(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Status != nil {
+
+		if err := m.Status.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Status")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("Status")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *DagListItem) contextValidateSuspension(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Suspension != nil {
+
+		if swag.IsZero(m.Suspension) { // not required
+			return nil
+		}
+
+		if err := m.Suspension.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Suspension")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("Suspension")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DagListItem) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DagListItem) UnmarshalBinary(b []byte) error {
+	var res DagListItem
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
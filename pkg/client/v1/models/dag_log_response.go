@@ -0,0 +1,188 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DagLogResponse dag log response
+//
+// swagger:model dagLogResponse
+type DagLogResponse struct {
+
+	// grid data
+	// Required: true
+	GridData []*DagLogGridItem `json:"GridData"`
+
+	// logs
+	// Required: true
+	Logs []*DagStatusFile `json:"Logs"`
+}
+
+// Validate validates this dag log response
+func (m *DagLogResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateGridData(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLogs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DagLogResponse) validateGridData(formats strfmt.Registry) error {
+
+	if err := validate.Required("GridData", "body", m.GridData); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.GridData); i++ {
+		if swag.IsZero(m.GridData[i]) { // not required
+			continue
+		}
+
+		if m.GridData[i] != nil {
+			if err := m.GridData[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("GridData" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("GridData" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *DagLogResponse) validateLogs(formats strfmt.Registry) error {
+
+	if err := validate.Required("Logs", "body", m.Logs); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Logs); i++ {
+		if swag.IsZero(m.Logs[i]) { // not required
+			continue
+		}
+
+		if m.Logs[i] != nil {
+			if err := m.Logs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Logs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Logs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this dag log response based on the context it is used
+func (m *DagLogResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateGridData(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateLogs(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DagLogResponse) contextValidateGridData(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.GridData); i++ {
+
+		if m.GridData[i] != nil {
+
+			if swag.IsZero(m.GridData[i]) { // not required
+				return nil
+			}
+
+			if err := m.GridData[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("GridData" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("GridData" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *DagLogResponse) contextValidateLogs(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Logs); i++ {
+
+		if m.Logs[i] != nil {
+
+			if swag.IsZero(m.Logs[i]) { // not required
+				return nil
+			}
+
+			if err := m.Logs[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Logs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Logs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DagLogResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DagLogResponse) UnmarshalBinary(b []byte) error {
+	var res DagLogResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,88 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DagSchedulerLogResponse dag scheduler log response
+//
+// swagger:model dagSchedulerLogResponse
+type DagSchedulerLogResponse struct {
+
+	// content
+	// Required: true
+	Content *string `json:"Content"`
+
+	// log file
+	// Required: true
+	LogFile *string `json:"LogFile"`
+}
+
+// Validate validates this dag scheduler log response
+func (m *DagSchedulerLogResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateContent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLogFile(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DagSchedulerLogResponse) validateContent(formats strfmt.Registry) error {
+
+	if err := validate.Required("Content", "body", m.Content); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagSchedulerLogResponse) validateLogFile(formats strfmt.Registry) error {
+
+	if err := validate.Required("LogFile", "body", m.LogFile); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this dag scheduler log response based on context it is used
+func (m *DagSchedulerLogResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DagSchedulerLogResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DagSchedulerLogResponse) UnmarshalBinary(b []byte) error {
+	var res DagSchedulerLogResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
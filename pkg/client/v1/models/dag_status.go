@@ -0,0 +1,210 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DagStatus dag status
+//
+// swagger:model dagStatus
+type DagStatus struct {
+
+	// finished at
+	// Required: true
+	FinishedAt *string `json:"FinishedAt"`
+
+	// labels
+	Labels map[string]string `json:"Labels,omitempty"`
+
+	// log
+	// Required: true
+	Log *string `json:"Log"`
+
+	// name
+	// Required: true
+	Name *string `json:"Name"`
+
+	// params
+	// Required: true
+	Params *string `json:"Params"`
+
+	// pid
+	// Required: true
+	Pid *int64 `json:"Pid"`
+
+	// request Id
+	// Required: true
+	RequestID *string `json:"RequestId"`
+
+	// started at
+	// Required: true
+	StartedAt *string `json:"StartedAt"`
+
+	// status
+	// Required: true
+	Status *int64 `json:"Status"`
+
+	// status text
+	// Required: true
+	StatusText *string `json:"StatusText"`
+}
+
+// Validate validates this dag status
+func (m *DagStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFinishedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLog(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateParams(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePid(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRequestID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatusText(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DagStatus) validateFinishedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("FinishedAt", "body", m.FinishedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagStatus) validateLog(formats strfmt.Registry) error {
+
+	if err := validate.Required("Log", "body", m.Log); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagStatus) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("Name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagStatus) validateParams(formats strfmt.Registry) error {
+
+	if err := validate.Required("Params", "body", m.Params); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagStatus) validatePid(formats strfmt.Registry) error {
+
+	if err := validate.Required("Pid", "body", m.Pid); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagStatus) validateRequestID(formats strfmt.Registry) error {
+
+	if err := validate.Required("RequestId", "body", m.RequestID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagStatus) validateStartedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("StartedAt", "body", m.StartedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagStatus) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("Status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagStatus) validateStatusText(formats strfmt.Registry) error {
+
+	if err := validate.Required("StatusText", "body", m.StatusText); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this dag status based on context it is used
+func (m *DagStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DagStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DagStatus) UnmarshalBinary(b []byte) error {
+	var res DagStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
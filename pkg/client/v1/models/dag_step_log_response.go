@@ -0,0 +1,142 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DagStepLogResponse dag step log response
+//
+// swagger:model dagStepLogResponse
+type DagStepLogResponse struct {
+
+	// content
+	// Required: true
+	Content *string `json:"Content"`
+
+	// log file
+	// Required: true
+	LogFile *string `json:"LogFile"`
+
+	// step
+	// Required: true
+	Step *StatusNode `json:"Step"`
+}
+
+// Validate validates this dag step log response
+func (m *DagStepLogResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateContent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLogFile(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStep(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DagStepLogResponse) validateContent(formats strfmt.Registry) error {
+
+	if err := validate.Required("Content", "body", m.Content); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagStepLogResponse) validateLogFile(formats strfmt.Registry) error {
+
+	if err := validate.Required("LogFile", "body", m.LogFile); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DagStepLogResponse) validateStep(formats strfmt.Registry) error {
+
+	if err := validate.Required("Step", "body", m.Step); err != nil {
+		return err
+	}
+
+	if m.Step != nil {
+		if err := m.Step.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Step")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("Step")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this dag step log response based on the context it is used
+func (m *DagStepLogResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateStep(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DagStepLogResponse) contextValidateStep(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Step != nil {
+
+		if err := m.Step.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Step")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("Step")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DagStepLogResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DagStepLogResponse) UnmarshalBinary(b []byte) error {
+	var res DagStepLogResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,168 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// LineageRun lineage run
+//
+// swagger:model lineageRun
+type LineageRun struct {
+
+	// The ID of the DAG.
+	// Required: true
+	DAG *string `json:"DAG"`
+
+	// The ID of the DAG of the parent run.
+	ParentDAG string `json:"ParentDAG,omitempty"`
+
+	// The request ID of the run that started the run as a sub workflow.
+	ParentRequestID string `json:"ParentRequestId,omitempty"`
+
+	// request Id
+	// Required: true
+	RequestID *string `json:"RequestId"`
+
+	// The request ID of the run of the same DAG retried by the run.
+	RetryOf string `json:"RetryOf,omitempty"`
+
+	// The cron expression of the schedule that started the run.
+	Schedule string `json:"Schedule,omitempty"`
+
+	// started at
+	// Required: true
+	StartedAt *string `json:"StartedAt"`
+
+	// status
+	// Required: true
+	Status *int64 `json:"Status"`
+
+	// status text
+	// Required: true
+	StatusText *string `json:"StatusText"`
+
+	// What started the run, "manual", "api", "schedule", "restart", "retry" or "parent". It's empty for the runs recorded before the lineage.
+	// Required: true
+	Trigger *string `json:"Trigger"`
+}
+
+// Validate validates this lineage run
+func (m *LineageRun) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDAG(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRequestID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatusText(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTrigger(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LineageRun) validateDAG(formats strfmt.Registry) error {
+
+	if err := validate.Required("DAG", "body", m.DAG); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LineageRun) validateRequestID(formats strfmt.Registry) error {
+
+	if err := validate.Required("RequestId", "body", m.RequestID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LineageRun) validateStartedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("StartedAt", "body", m.StartedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LineageRun) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("Status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LineageRun) validateStatusText(formats strfmt.Registry) error {
+
+	if err := validate.Required("StatusText", "body", m.StatusText); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LineageRun) validateTrigger(formats strfmt.Registry) error {
+
+	if err := validate.Required("Trigger", "body", m.Trigger); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this lineage run based on context it is used
+func (m *LineageRun) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LineageRun) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LineageRun) UnmarshalBinary(b []byte) error {
+	var res LineageRun
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostDagActionResponse post dag action response
+//
+// swagger:model postDagActionResponse
+type PostDagActionResponse struct {
+
+	// new dag ID
+	NewDagID string `json:"NewDagID,omitempty"`
+
+	// request Id
+	RequestID string `json:"RequestId,omitempty"`
+
+	// The result of the stop action.
+	// Enum: [signalled already-stopped not-running]
+	StopResult string `json:"StopResult,omitempty"`
+
+	// warnings
+	Warnings []*LintWarning `json:"Warnings"`
+}
+
+// Validate validates this post dag action response
+func (m *PostDagActionResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStopResult(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateWarnings(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var postDagActionResponseTypeStopResultPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["signalled","already-stopped","not-running"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		postDagActionResponseTypeStopResultPropEnum = append(postDagActionResponseTypeStopResultPropEnum, v)
+	}
+}
+
+const (
+
+	// PostDagActionResponseStopResultSignalled captures enum value "signalled"
+	PostDagActionResponseStopResultSignalled string = "signalled"
+
+	// PostDagActionResponseStopResultAlreadyDashStopped captures enum value "already-stopped"
+	PostDagActionResponseStopResultAlreadyDashStopped string = "already-stopped"
+
+	// PostDagActionResponseStopResultNotDashRunning captures enum value "not-running"
+	PostDagActionResponseStopResultNotDashRunning string = "not-running"
+)
+
+// prop value enum
+func (m *PostDagActionResponse) validateStopResultEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, postDagActionResponseTypeStopResultPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PostDagActionResponse) validateStopResult(formats strfmt.Registry) error {
+	if swag.IsZero(m.StopResult) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStopResultEnum("StopResult", "body", m.StopResult); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostDagActionResponse) validateWarnings(formats strfmt.Registry) error {
+	if swag.IsZero(m.Warnings) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Warnings); i++ {
+		if swag.IsZero(m.Warnings[i]) { // not required
+			continue
+		}
+
+		if m.Warnings[i] != nil {
+			if err := m.Warnings[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Warnings" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Warnings" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post dag action response based on the context it is used
+func (m *PostDagActionResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateWarnings(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostDagActionResponse) contextValidateWarnings(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Warnings); i++ {
+
+		if m.Warnings[i] != nil {
+
+			if swag.IsZero(m.Warnings[i]) { // not required
+				return nil
+			}
+
+			if err := m.Warnings[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Warnings" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Warnings" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostDagActionResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostDagActionResponse) UnmarshalBinary(b []byte) error {
+	var res PostDagActionResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
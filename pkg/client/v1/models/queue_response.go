@@ -0,0 +1,124 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// QueueResponse queue response
+//
+// swagger:model queueResponse
+type QueueResponse struct {
+
+	// The queued runs in the order they are started.
+	// Required: true
+	Runs []*QueuedRun `json:"Runs"`
+}
+
+// Validate validates this queue response
+func (m *QueueResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRuns(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *QueueResponse) validateRuns(formats strfmt.Registry) error {
+
+	if err := validate.Required("Runs", "body", m.Runs); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Runs); i++ {
+		if swag.IsZero(m.Runs[i]) { // not required
+			continue
+		}
+
+		if m.Runs[i] != nil {
+			if err := m.Runs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Runs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Runs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this queue response based on the context it is used
+func (m *QueueResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRuns(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *QueueResponse) contextValidateRuns(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Runs); i++ {
+
+		if m.Runs[i] != nil {
+
+			if swag.IsZero(m.Runs[i]) { // not required
+				return nil
+			}
+
+			if err := m.Runs[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Runs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Runs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *QueueResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *QueueResponse) UnmarshalBinary(b []byte) error {
+	var res QueueResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
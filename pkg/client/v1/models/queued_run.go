@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// QueuedRun queued run
+//
+// swagger:model queuedRun
+type QueuedRun struct {
+
+	// The ID of the DAG. It's the location of the DAG file if the DAG can't be loaded.
+	// Required: true
+	DAG *string `json:"DAG"`
+
+	// The time the run was queued in RFC 3339.
+	// Required: true
+	EnqueuedAt *string `json:"EnqueuedAt"`
+
+	// labels
+	Labels map[string]string `json:"Labels,omitempty"`
+
+	// params
+	Params string `json:"Params,omitempty"`
+
+	// The 1-based position of the run in the queue of its DAG.
+	// Required: true
+	Position *int64 `json:"Position"`
+
+	// priority
+	// Required: true
+	Priority *int64 `json:"Priority"`
+
+	// Why the run is waiting, "delayed" (for its start time), "concurrency" (for the current run of the DAG to finish) or "due" (to be started in the next round of the scheduler).
+	// Required: true
+	Reason *string `json:"Reason"`
+
+	// request Id
+	// Required: true
+	RequestID *string `json:"RequestId"`
+
+	// The names of the steps to skip in the run.
+	SkipSteps []string `json:"SkipSteps"`
+
+	// The earliest time the run is started in RFC 3339.
+	// Required: true
+	StartAt *string `json:"StartAt"`
+}
+
+// Validate validates this queued run
+func (m *QueuedRun) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDAG(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEnqueuedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePosition(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePriority(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReason(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRequestID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *QueuedRun) validateDAG(formats strfmt.Registry) error {
+
+	if err := validate.Required("DAG", "body", m.DAG); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *QueuedRun) validateEnqueuedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("EnqueuedAt", "body", m.EnqueuedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *QueuedRun) validatePosition(formats strfmt.Registry) error {
+
+	if err := validate.Required("Position", "body", m.Position); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *QueuedRun) validatePriority(formats strfmt.Registry) error {
+
+	if err := validate.Required("Priority", "body", m.Priority); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *QueuedRun) validateReason(formats strfmt.Registry) error {
+
+	if err := validate.Required("Reason", "body", m.Reason); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *QueuedRun) validateRequestID(formats strfmt.Registry) error {
+
+	if err := validate.Required("RequestId", "body", m.RequestID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *QueuedRun) validateStartAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("StartAt", "body", m.StartAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this queued run based on context it is used
+func (m *QueuedRun) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *QueuedRun) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *QueuedRun) UnmarshalBinary(b []byte) error {
+	var res QueuedRun
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
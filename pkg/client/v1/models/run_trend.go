@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// RunTrend run trend
+//
+// swagger:model runTrend
+type RunTrend struct {
+
+	// duration ms
+	// Required: true
+	DurationMs *int64 `json:"DurationMs"`
+
+	// request Id
+	// Required: true
+	RequestID *string `json:"RequestId"`
+
+	// started at
+	// Required: true
+	StartedAt *string `json:"StartedAt"`
+
+	// status
+	// Required: true
+	Status *int64 `json:"Status"`
+
+	// status text
+	// Required: true
+	StatusText *string `json:"StatusText"`
+}
+
+// Validate validates this run trend
+func (m *RunTrend) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDurationMs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRequestID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatusText(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RunTrend) validateDurationMs(formats strfmt.Registry) error {
+
+	if err := validate.Required("DurationMs", "body", m.DurationMs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RunTrend) validateRequestID(formats strfmt.Registry) error {
+
+	if err := validate.Required("RequestId", "body", m.RequestID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RunTrend) validateStartedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("StartedAt", "body", m.StartedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RunTrend) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("Status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RunTrend) validateStatusText(formats strfmt.Registry) error {
+
+	if err := validate.Required("StatusText", "body", m.StatusText); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this run trend based on context it is used
+func (m *RunTrend) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RunTrend) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RunTrend) UnmarshalBinary(b []byte) error {
+	var res RunTrend
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
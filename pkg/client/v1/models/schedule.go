@@ -0,0 +1,83 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Schedule schedule
+//
+// swagger:model schedule
+type Schedule struct {
+
+	// The cron expression without the time zone prefix.
+	Cron string `json:"Cron,omitempty"`
+
+	// expression
+	// Required: true
+	Expression *string `json:"Expression"`
+
+	// The next fire time in RFC 3339.
+	NextRun string `json:"NextRun,omitempty"`
+
+	// The last fire time in RFC 3339.
+	PrevRun string `json:"PrevRun,omitempty"`
+
+	// The time zone the schedule is evaluated in.
+	Timezone string `json:"Timezone,omitempty"`
+}
+
+// Validate validates this schedule
+func (m *Schedule) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateExpression(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Schedule) validateExpression(formats strfmt.Registry) error {
+
+	if err := validate.Required("Expression", "body", m.Expression); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this schedule based on context it is used
+func (m *Schedule) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Schedule) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Schedule) UnmarshalBinary(b []byte) error {
+	var res Schedule
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// SearchDagsMatchItem search dags match item
+//
+// swagger:model searchDagsMatchItem
+type SearchDagsMatchItem struct {
+
+	// line
+	Line string `json:"Line,omitempty"`
+
+	// line number
+	LineNumber int64 `json:"LineNumber,omitempty"`
+
+	// start line
+	StartLine int64 `json:"StartLine,omitempty"`
+}
+
+// Validate validates this search dags match item
+func (m *SearchDagsMatchItem) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this search dags match item based on context it is used
+func (m *SearchDagsMatchItem) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SearchDagsMatchItem) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SearchDagsMatchItem) UnmarshalBinary(b []byte) error {
+	var res SearchDagsMatchItem
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
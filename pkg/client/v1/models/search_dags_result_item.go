@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// SearchDagsResultItem search dags result item
+//
+// swagger:model searchDagsResultItem
+type SearchDagsResultItem struct {
+
+	// d a g
+	DAG *Dag `json:"DAG,omitempty"`
+
+	// matches
+	Matches []*SearchDagsMatchItem `json:"Matches"`
+
+	// name
+	Name string `json:"Name,omitempty"`
+}
+
+// Validate validates this search dags result item
+func (m *SearchDagsResultItem) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDAG(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMatches(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SearchDagsResultItem) validateDAG(formats strfmt.Registry) error {
+	if swag.IsZero(m.DAG) { // not required
+		return nil
+	}
+
+	if m.DAG != nil {
+		if err := m.DAG.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("DAG")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("DAG")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *SearchDagsResultItem) validateMatches(formats strfmt.Registry) error {
+	if swag.IsZero(m.Matches) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Matches); i++ {
+		if swag.IsZero(m.Matches[i]) { // not required
+			continue
+		}
+
+		if m.Matches[i] != nil {
+			if err := m.Matches[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Matches" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Matches" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this search dags result item based on the context it is used
+func (m *SearchDagsResultItem) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDAG(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMatches(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SearchDagsResultItem) contextValidateDAG(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.DAG != nil {
+
+		if swag.IsZero(m.DAG) { // not required
+			return nil
+		}
+
+		if err := m.DAG.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("DAG")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("DAG")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *SearchDagsResultItem) contextValidateMatches(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Matches); i++ {
+
+		if m.Matches[i] != nil {
+
+			if swag.IsZero(m.Matches[i]) { // not required
+				return nil
+			}
+
+			if err := m.Matches[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Matches" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Matches" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SearchDagsResultItem) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SearchDagsResultItem) UnmarshalBinary(b []byte) error {
+	var res SearchDagsResultItem
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// StartDagBody start dag body
+//
+// swagger:model startDagBody
+type StartDagBody struct {
+
+	// The named parameters of the run.
+	Params map[string]string `json:"params,omitempty"`
+
+	// The request ID of the run, up to 64 letters, digits, hyphens and underscores. A random ID is used when it's empty.
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// Validate validates this start dag body
+func (m *StartDagBody) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this start dag body based on context it is used
+func (m *StartDagBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StartDagBody) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StartDagBody) UnmarshalBinary(b []byte) error {
+	var res StartDagBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,209 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// StepFailures step failures
+//
+// swagger:model stepFailures
+type StepFailures struct {
+
+	// The most common errors of the step, the most common first.
+	// Required: true
+	Errors []*ErrorCount `json:"Errors"`
+
+	// failure rate
+	// Required: true
+	FailureRate *float64 `json:"FailureRate"`
+
+	// failures
+	// Required: true
+	Failures *int64 `json:"Failures"`
+
+	// last failed at
+	// Required: true
+	LastFailedAt *string `json:"LastFailedAt"`
+
+	// name
+	// Required: true
+	Name *string `json:"Name"`
+
+	// The number of the runs in which the step was executed.
+	// Required: true
+	Runs *int64 `json:"Runs"`
+}
+
+// Validate validates this step failures
+func (m *StepFailures) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateErrors(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFailureRate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFailures(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastFailedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRuns(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StepFailures) validateErrors(formats strfmt.Registry) error {
+
+	if err := validate.Required("Errors", "body", m.Errors); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Errors); i++ {
+		if swag.IsZero(m.Errors[i]) { // not required
+			continue
+		}
+
+		if m.Errors[i] != nil {
+			if err := m.Errors[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Errors" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Errors" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *StepFailures) validateFailureRate(formats strfmt.Registry) error {
+
+	if err := validate.Required("FailureRate", "body", m.FailureRate); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StepFailures) validateFailures(formats strfmt.Registry) error {
+
+	if err := validate.Required("Failures", "body", m.Failures); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StepFailures) validateLastFailedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("LastFailedAt", "body", m.LastFailedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StepFailures) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("Name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StepFailures) validateRuns(formats strfmt.Registry) error {
+
+	if err := validate.Required("Runs", "body", m.Runs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this step failures based on the context it is used
+func (m *StepFailures) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateErrors(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StepFailures) contextValidateErrors(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Errors); i++ {
+
+		if m.Errors[i] != nil {
+
+			if swag.IsZero(m.Errors[i]) { // not required
+				return nil
+			}
+
+			if err := m.Errors[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Errors" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Errors" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StepFailures) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StepFailures) UnmarshalBinary(b []byte) error {
+	var res StepFailures
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
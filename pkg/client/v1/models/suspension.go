@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// Suspension suspension
+//
+// swagger:model suspension
+type Suspension struct {
+
+	// The time the suspension is lifted. It's empty if the DAG is suspended until it's resumed.
+	ExpiresAt string `json:"ExpiresAt,omitempty"`
+
+	// reason
+	Reason string `json:"Reason,omitempty"`
+
+	// suspended at
+	SuspendedAt string `json:"SuspendedAt,omitempty"`
+}
+
+// Validate validates this suspension
+func (m *Suspension) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this suspension based on context it is used
+func (m *Suspension) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Suspension) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Suspension) UnmarshalBinary(b []byte) error {
+	var res Suspension
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TimelineResponse timeline response
+//
+// swagger:model timelineResponse
+type TimelineResponse struct {
+
+	// The start of the simulated period in RFC 3339.
+	// Required: true
+	From *string `json:"From"`
+
+	// The runs sorted by time.
+	// Required: true
+	Runs []*TimelineRun `json:"Runs"`
+
+	// The end of the simulated period in RFC 3339.
+	// Required: true
+	To *string `json:"To"`
+}
+
+// Validate validates this timeline response
+func (m *TimelineResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFrom(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRuns(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTo(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TimelineResponse) validateFrom(formats strfmt.Registry) error {
+
+	if err := validate.Required("From", "body", m.From); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TimelineResponse) validateRuns(formats strfmt.Registry) error {
+
+	if err := validate.Required("Runs", "body", m.Runs); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Runs); i++ {
+		if swag.IsZero(m.Runs[i]) { // not required
+			continue
+		}
+
+		if m.Runs[i] != nil {
+			if err := m.Runs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Runs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Runs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *TimelineResponse) validateTo(formats strfmt.Registry) error {
+
+	if err := validate.Required("To", "body", m.To); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this timeline response based on the context it is used
+func (m *TimelineResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRuns(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TimelineResponse) contextValidateRuns(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Runs); i++ {
+
+		if m.Runs[i] != nil {
+
+			if swag.IsZero(m.Runs[i]) { // not required
+				return nil
+			}
+
+			if err := m.Runs[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Runs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("Runs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TimelineResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TimelineResponse) UnmarshalBinary(b []byte) error {
+	var res TimelineResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
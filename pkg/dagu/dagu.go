@@ -0,0 +1,185 @@
+// Package dagu runs DAGs in the process of the caller.
+//
+// It's the library mode of Dagu: the DAG is loaded, the graph is built and
+// the steps are executed by the same scheduler as the dagu CLI, without
+// starting the dagu binary. The status of the runs is stored in the same
+// format as the CLI so the runs can be viewed in the Web UI by pointing it
+// at the same data directory.
+//
+// Example:
+//
+//	status, err := dagu.Run(ctx, []byte(spec), dagu.Options{
+//		Params:  []string{"DATE=2024-01-01"},
+//		DataDir: "/var/lib/myservice/dagu",
+//		Logger:  slog.Default(),
+//	})
+package dagu
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/dagu-org/dagu/internal/agent"
+	"github.com/dagu-org/dagu/internal/client"
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/scheduler"
+	"github.com/dagu-org/dagu/internal/logger"
+	"github.com/dagu-org/dagu/internal/notifier"
+	"github.com/dagu-org/dagu/internal/persistence/jsondb"
+	"github.com/dagu-org/dagu/internal/persistence/local"
+	"github.com/dagu-org/dagu/internal/persistence/local/storage"
+	"github.com/dagu-org/dagu/internal/persistence/model"
+	"github.com/google/uuid"
+)
+
+// Status is the status of a run of a DAG.
+type Status = model.Status
+
+// The final status of a run.
+const (
+	StatusSuccess = scheduler.StatusSuccess
+	StatusError   = scheduler.StatusError
+	StatusCancel  = scheduler.StatusCancel
+)
+
+// Options is the options of a run.
+type Options struct {
+	// Name is the name of the DAG. It's used when the spec has no name.
+	Name string
+	// Params is the parameters of the DAG, e.g. []string{"FOO=bar"}.
+	Params []string
+	// RequestID is the ID of the run. A new ID is generated if it's empty.
+	RequestID string
+	// DataDir is the directory of the status of the runs and the logs. The
+	// status is stored in the same format as the CLI. Defaults to the dagu
+	// directory in the temporary directory of the system.
+	DataDir string
+	// LogDir is the directory of the log files of the steps. Defaults to
+	// the logs directory in DataDir.
+	LogDir string
+	// Logger receives the logs of the run. The logs are discarded if it's
+	// nil.
+	Logger *slog.Logger
+	// Executable is the path to the dagu binary used to run the sub
+	// workflows. Defaults to dagu in the PATH.
+	Executable string
+	// PluginsDir is the directory of the notifier plugins used by the
+	// notify field of the DAG.
+	PluginsDir string
+}
+
+// ErrNoName is returned when the DAG has no name.
+var ErrNoName = errors.New("the DAG has no name")
+
+// RunFile loads the DAG from the file and runs it. The name of the file is
+// used as the name of the DAG if the spec has no name.
+func RunFile(ctx context.Context, file string, opts Options) (*Status, error) {
+	dag, err := digraph.Load(ctx, file, digraph.WithParams(opts.Params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load DAG from %s: %w", file, err)
+	}
+	return run(ctx, dag, opts)
+}
+
+// Run loads the DAG from the spec and runs it until it finishes. It
+// returns the final status of the run and an error if the run failed.
+// Cancelling the context stops the run.
+func Run(ctx context.Context, spec []byte, opts Options) (*Status, error) {
+	dag, err := digraph.LoadYAML(ctx, spec, digraph.WithParams(opts.Params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load DAG: %w", err)
+	}
+	if dag.Name == "" {
+		dag.Name = opts.Name
+	}
+	if dag.Name == "" {
+		return nil, ErrNoName
+	}
+	// The history of the runs is keyed by the location of the DAG.
+	dag.Location = dag.Name
+	return run(ctx, dag, opts)
+}
+
+func run(ctx context.Context, dag *digraph.DAG, opts Options) (*Status, error) {
+	dataDir := opts.DataDir
+	if dataDir == "" {
+		dataDir = filepath.Join(os.TempDir(), "dagu")
+	}
+	logDir := opts.LogDir
+	if logDir == "" {
+		logDir = filepath.Join(dataDir, "logs")
+	}
+	historyStore := jsondb.New(filepath.Join(dataDir, "data"))
+	dagsDir := filepath.Join(dataDir, "dags")
+	if err := os.MkdirAll(dagsDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to initialize directory %s: %w", dagsDir, err)
+	}
+	dagStore := local.NewDAGStore(dagsDir)
+	executable := opts.Executable
+	if executable == "" {
+		executable = "dagu"
+	}
+
+	requestID := opts.RequestID
+	if requestID == "" {
+		id, err := uuid.NewRandom()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate request ID: %w", err)
+		}
+		requestID = id.String()
+	}
+
+	loggerOpts := []logger.Option{logger.WithQuiet()}
+	if opts.Logger != nil {
+		loggerOpts = append(loggerOpts, logger.WithHandler(opts.Logger.Handler()))
+	}
+	ctx = logger.WithLogger(ctx, logger.NewLogger(loggerOpts...))
+
+	runLogDir := filepath.Join(logDir, dag.Name)
+	if dag.LogDir != "" {
+		runLogDir = filepath.Join(dag.LogDir, dag.Name)
+	}
+	if err := os.MkdirAll(runLogDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to initialize directory %s: %w", runLogDir, err)
+	}
+	logFile := filepath.Join(runLogDir, fmt.Sprintf("agent_%s.log", requestID))
+
+	cli := client.New(
+		dagStore,
+		historyStore,
+		local.NewFlagStore(storage.NewStorage(filepath.Join(dataDir, "suspend"))),
+		local.NewDelayedStartStore(storage.NewStorage(filepath.Join(dataDir, local.DelayedStartDirName))),
+		executable,
+		"",
+	)
+	notifiers, err := notifier.Load(opts.PluginsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	agt := agent.New(requestID, dag, runLogDir, logFile, cli, dagStore, historyStore, agent.Options{
+		Notifiers: notifiers,
+	})
+
+	// Stop the run when the context is cancelled.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			agt.Signal(context.WithoutCancel(ctx), os.Interrupt)
+		case <-done:
+		}
+	}()
+
+	err = agt.Run(ctx)
+	status := agt.Status()
+	if err != nil {
+		return &status, fmt.Errorf("failed to run DAG %s (requestID: %s): %w", dag.Name, requestID, err)
+	}
+	return &status, nil
+}
@@ -0,0 +1,112 @@
+package dagu_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dagu-org/dagu/pkg/dagu"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var buf bytes.Buffer
+		status, err := dagu.Run(context.Background(), []byte(`
+params: NAME=world
+steps:
+  - name: hello
+    command: echo hello $NAME
+    output: OUT
+`), dagu.Options{
+			Name:    "library-success",
+			Params:  []string{"NAME=dagu"},
+			DataDir: t.TempDir(),
+			Logger:  slog.New(slog.NewTextHandler(&buf, nil)),
+		})
+		require.NoError(t, err)
+		require.Equal(t, dagu.StatusSuccess, status.Status)
+		require.Len(t, status.Nodes, 1)
+		require.Equal(t, "hello dagu", status.Nodes[0].Step.OutputVariables.Variables()["OUT"])
+		require.Contains(t, buf.String(), "DAG execution finished")
+	})
+	t.Run("Failure", func(t *testing.T) {
+		status, err := dagu.Run(context.Background(), []byte(`
+steps:
+  - name: fail
+    command: "false"
+`), dagu.Options{Name: "library-failure", DataDir: t.TempDir()})
+		require.Error(t, err)
+		require.Equal(t, dagu.StatusError, status.Status)
+	})
+	t.Run("Cancel", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+		status, _ := dagu.Run(ctx, []byte(`
+steps:
+  - name: sleep
+    command: sleep 10
+`), dagu.Options{Name: "library-cancel", DataDir: t.TempDir()})
+		require.Equal(t, dagu.StatusCancel, status.Status)
+	})
+	t.Run("NoName", func(t *testing.T) {
+		_, err := dagu.Run(context.Background(), []byte(`
+steps:
+  - name: hello
+    command: echo hello
+`), dagu.Options{DataDir: t.TempDir()})
+		require.ErrorIs(t, err, dagu.ErrNoName)
+	})
+	t.Run("RunFile", func(t *testing.T) {
+		dir := t.TempDir()
+		file := filepath.Join(dir, "library-file.yaml")
+		require.NoError(t, os.WriteFile(file, []byte(`
+steps:
+  - name: hello
+    command: echo hello
+`), 0600))
+		status, err := dagu.RunFile(context.Background(), file, dagu.Options{DataDir: dir})
+		require.NoError(t, err)
+		require.Equal(t, "library-file", status.Name)
+		require.Equal(t, dagu.StatusSuccess, status.Status)
+	})
+}
+
+type testExecutor struct {
+	stdout io.Writer
+	step   dagu.Step
+}
+
+func (e *testExecutor) SetStdout(out io.Writer) { e.stdout = out }
+func (e *testExecutor) SetStderr(io.Writer)     {}
+func (e *testExecutor) Kill(os.Signal) error    { return nil }
+
+func (e *testExecutor) Run(context.Context) error {
+	_, err := fmt.Fprintf(e.stdout, "queued %s", e.step.ExecutorConfig.Config["queue"])
+	return err
+}
+
+func TestRegisterExecutor(t *testing.T) {
+	dagu.RegisterExecutor("library-test", func(_ context.Context, step dagu.Step) (dagu.Executor, error) {
+		return &testExecutor{step: step}, nil
+	})
+
+	status, err := dagu.Run(context.Background(), []byte(`
+steps:
+  - name: submit
+    executor:
+      type: library-test
+      config:
+        queue: batch
+    output: OUT
+`), dagu.Options{Name: "library-executor", DataDir: t.TempDir()})
+	require.NoError(t, err)
+	require.Equal(t, "queued batch", status.Nodes[0].Step.OutputVariables.Variables()["OUT"])
+}
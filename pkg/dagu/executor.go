@@ -0,0 +1,27 @@
+package dagu
+
+import (
+	"github.com/dagu-org/dagu/internal/digraph"
+	"github.com/dagu-org/dagu/internal/digraph/executor"
+)
+
+// Executor runs a step. The executor may implement ExitCoder to report
+// the exit code of the step.
+type Executor = executor.Executor
+
+// ExitCoder is implemented by the executors that have an exit code.
+type ExitCoder = executor.ExitCoder
+
+// Step is the definition of a step.
+type Step = digraph.Step
+
+// ExecutorCreator creates the executor of a step.
+type ExecutorCreator = executor.Creator
+
+// RegisterExecutor registers the executor of the type used in the
+// executor field of the steps. It replaces the executor of the same type,
+// including the built-in ones. It must be called before running the DAGs,
+// e.g. in the init function of the package.
+func RegisterExecutor(executorType string, creator ExecutorCreator) {
+	executor.Register(executorType, creator)
+}
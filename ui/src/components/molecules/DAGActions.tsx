@@ -53,7 +53,7 @@ function DAGActions({
       requestId?: string;
       params?: string;
     }) => {
-      const url = `${getConfig().apiURL}/dags/${params.name}?remoteNode=${
+      const url = `${getConfig().apiURL}/dags/${encodeURIComponent(params.name)}?remoteNode=${
         appBarContext.selectedRemoteNode || 'local'
       }`;
       const ret = await fetch(url, {
@@ -20,7 +20,7 @@ function DAGEditButtons({ name }: Props) {
             alert('DAG name cannot contain space');
             return;
           }
-          const url = `${getConfig().apiURL}/dags/${name}?remoteNode=${
+          const url = `${getConfig().apiURL}/dags/${encodeURIComponent(name)}?remoteNode=${
             appBarContext.selectedRemoteNode || 'local'
           }`;
           const resp = await fetch(url, {
@@ -34,7 +34,7 @@ function DAGEditButtons({ name }: Props) {
             }),
           });
           if (resp.ok) {
-            window.location.href = `/dags/${val}`;
+            window.location.href = `/dags/${encodeURIComponent(val)}`;
           } else {
             const e = await resp.text();
             alert(e);
@@ -48,7 +48,7 @@ function DAGEditButtons({ name }: Props) {
           if (!confirm('Are you sure to delete the DAG?')) {
             return;
           }
-          const url = `${getConfig().apiURL}/dags/${name}`;
+          const url = `${getConfig().apiURL}/dags/${encodeURIComponent(name)}`;
           const resp = await fetch(url, {
             method: 'DELETE',
             headers: {
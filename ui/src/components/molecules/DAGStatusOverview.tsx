@@ -1,5 +1,6 @@
 import React from 'react';
-import { Status } from '../../models';
+import moment from 'moment-timezone';
+import { SchedulerStatus, Status } from '../../models';
 import StatusChip from '../atoms/StatusChip';
 import { Stack } from '@mui/material';
 import LabeledItem from '../atoms/LabeledItem';
@@ -12,7 +13,7 @@ type Props = {
 };
 
 function DAGStatusOverview({ status, name, file = '' }: Props) {
-  const url = `/dags/${name}/scheduler-log?&file=${encodeURI(file)}`;
+  const url = `/dags/${encodeURIComponent(name)}/scheduler-log?&file=${encodeURI(file)}`;
   if (!status) {
     return null;
   }
@@ -25,11 +26,21 @@ function DAGStatusOverview({ status, name, file = '' }: Props) {
       <Stack direction="row" sx={{ alignItems: 'center' }} spacing={2}>
         <LabeledItem label="Started At">{status.StartedAt}</LabeledItem>
         <LabeledItem label="Finished At">{status.FinishedAt}</LabeledItem>
+        {status.Status === SchedulerStatus.Running && status.Heartbeat ? (
+          <LabeledItem label="Last Heartbeat">
+            {moment(status.Heartbeat).fromNow()}
+          </LabeledItem>
+        ) : null}
       </Stack>
       <LabeledItem label="Params">{status.Params}</LabeledItem>
       <LabeledItem label="Scheduler Log">
         <Link to={url}>{status.Log}</Link>
       </LabeledItem>
+      {status.Notes?.map((note, i) => (
+        <LabeledItem key={i} label={`Note (${note.CreatedAt})`}>
+          {note.Text}
+        </LabeledItem>
+      ))}
     </Stack>
   );
 }
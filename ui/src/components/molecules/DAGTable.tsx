@@ -118,7 +118,7 @@ const defaultColumns = [
         return getValue();
       } else {
         const name = data.DAGStatus.File.replace(/.y[a]{0,1}ml$/, '');
-        const url = `/dags/${encodeURI(name)}`;
+        const url = `/dags/${encodeURIComponent(name)}`;
         return (
           <div
             style={{
@@ -72,6 +72,8 @@ const Graph: React.FC<Props> = ({
       "<i class='fas fa-check-circle' style='color: #16a34a'></i>",
     [NodeStatus.Skipped]:
       "<i class='fas fa-forward' style='color: #64748b'></i>",
+    [NodeStatus.Cached]:
+      "<i class='fas fa-database' style='color: #16a34a'></i>",
   };
   if (!animate) {
     // Remove animations if disabled
@@ -115,7 +117,10 @@ const Graph: React.FC<Props> = ({
             linkStyles.push(
               `linkStyle ${linkIndex} stroke:#ef4444,stroke-width:1.8px,stroke-dasharray:3`
             );
-          } else if (status === NodeStatus.Success) {
+          } else if (
+            status === NodeStatus.Success ||
+            status === NodeStatus.Cached
+          ) {
             // Solid line with success color
             dat.push(`${depId} --> ${id};`);
             linkStyles.push(
@@ -240,4 +245,5 @@ const graphStatusMap = {
   [NodeStatus.Cancel]: ':::cancel',
   [NodeStatus.Success]: ':::done',
   [NodeStatus.Skipped]: ':::skipped',
+  [NodeStatus.Cached]: ':::done',
 };
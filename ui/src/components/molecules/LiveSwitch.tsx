@@ -14,7 +14,7 @@ function LiveSwitch({ DAG, refresh, inputProps }: Props) {
   const [checked, setChecked] = React.useState(!DAG.Suspended);
   const onSubmit = React.useCallback(
     async (params: { name: string; action: string; value: string }) => {
-      const url = `${getConfig().apiURL}/dags/${params.name}?remoteNode=${
+      const url = `${getConfig().apiURL}/dags/${encodeURIComponent(params.name)}?remoteNode=${
         appBarContext.selectedRemoteNode || 'local'
       }`;
       const ret = await fetch(url, {
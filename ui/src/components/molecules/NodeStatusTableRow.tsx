@@ -22,7 +22,7 @@ function NodeStatusTableRow({
   file,
   onRequireModal,
 }: Props) {
-  const url = `/dags/${name}/log?file=${file}&step=${encodeURIComponent(node.Step.Name)}`;
+  const url = `/dags/${encodeURIComponent(name)}/log?file=${file}&step=${encodeURIComponent(node.Step.Name)}`;
   const buttonStyle = {
     margin: '0px',
     padding: '0px',
@@ -30,6 +30,14 @@ function NodeStatusTableRow({
     background: 'none',
     outline: 'none',
   };
+  // The run of the sub DAG is found in the history of the sub DAG.
+  let subUrl = '';
+  if (node.Step.Run && node.SubRequestId) {
+    const subName = node.Step.Run.split('/')
+      .pop()
+      ?.replace(/\.ya?ml$/, '');
+    subUrl = `/dags/${encodeURIComponent(subName ?? '')}/history`;
+  }
   let args = '';
   if (node.Step.Args) {
     // Use uninterpolated args to avoid render issues with very long params
@@ -38,7 +46,15 @@ function NodeStatusTableRow({
   return (
     <StyledTableRow>
       <TableCell> {rownum} </TableCell>
-      <TableCell> {node.Step.Name} </TableCell>
+      <TableCell>
+        {subUrl ? (
+          <Link to={subUrl} title={node.SubRequestId}>
+            {node.Step.Name}
+          </Link>
+        ) : (
+          node.Step.Name
+        )}
+      </TableCell>
       <TableCell>
         <MultilineText>{node.Step.Description}</MultilineText>
       </TableCell>
@@ -68,6 +68,12 @@ function StatusUpdateModal({ visible, dismissModal, step, onSubmit }: Props) {
             >
               Mark Failed
             </Button>
+            <Button
+              variant="outlined"
+              onClick={() => onSubmit(step, 'mark-skipped')}
+            >
+              Mark Skipped
+            </Button>
           </Stack>
           <Stack direction="row" alignContent="center" justifyContent="center">
             <Button variant="outlined" color="error" onClick={dismissModal}>
@@ -1,6 +1,6 @@
 import { Box, Button, Stack } from '@mui/material';
 import React from 'react';
-import { GetDAGResponse } from '../../models/api';
+import { GetDAGResponse, LintWarning } from '../../models/api';
 import { DAGContext } from '../../contexts/DAGContext';
 import { DAG, Step } from '../../models';
 import DAGEditor from '../atoms/DAGEditor';
@@ -134,9 +134,9 @@ function DAGSpec({ data }: Props) {
                           </span>
                         }
                         onClick={async () => {
-                          const url = `${getConfig().apiURL}/dags/${
+                          const url = `${getConfig().apiURL}/dags/${encodeURIComponent(
                             props.name
-                          }?remoteNode=${
+                          )}?remoteNode=${
                             appBarContext.selectedRemoteNode || 'local'
                           }`;
                           const resp = await fetch(url, {
@@ -150,6 +150,20 @@ function DAGSpec({ data }: Props) {
                             }),
                           });
                           if (resp.ok) {
+                            const body = await resp.json();
+                            const warnings: LintWarning[] = body.Warnings || [];
+                            if (warnings.length > 0) {
+                              alert(
+                                'Saved with warnings:\n' +
+                                  warnings
+                                    .map((w) =>
+                                      w.Step
+                                        ? `${w.Step}: ${w.Message}`
+                                        : w.Message
+                                    )
+                                    .join('\n')
+                              );
+                            }
                             setEditing(false);
                             props.refresh();
                           } else {
@@ -21,6 +21,7 @@ export const nodeStatusColorMapping = {
   [NodeStatus.Cancel]: statusColorMapping[SchedulerStatus.Cancel],
   [NodeStatus.Success]: statusColorMapping[SchedulerStatus.Success],
   [NodeStatus.Skipped]: statusColorMapping[SchedulerStatus.Skipped_Unused],
+  [NodeStatus.Cached]: statusColorMapping[SchedulerStatus.Success],
 };
 
 export const stepTabColStyles = [
@@ -12,7 +12,7 @@ export function useDAGPostAPI(opts: Options) {
   const appBarContext = React.useContext(AppBarContext);
   const doPost = React.useCallback(
     async (action: string, step?: string) => {
-      const url = `${getConfig().apiURL}/dags/${opts.name}?remoteNode=${
+      const url = `${getConfig().apiURL}/dags/${encodeURIComponent(opts.name)}?remoteNode=${
         appBarContext.selectedRemoteNode || 'local'
       }`;
       const ret = await fetch(url, {
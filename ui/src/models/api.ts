@@ -6,6 +6,7 @@ import {
   Schedule,
   SchedulerStatus,
   StatusFile,
+  Suspension,
 } from './index';
 
 export type GetDAGResponse = {
@@ -21,6 +22,11 @@ export type GetDAGResponse = {
   Errors: string[];
 };
 
+export type LintWarning = {
+  Step?: string;
+  Message: string;
+};
+
 export type GetSearchResponse = {
   Errors: string[];
   Results: SearchResult[];
@@ -59,6 +65,7 @@ export type ListWorkflowsResponse = {
   Errors?: string[];
   HasError: boolean;
   PageCount: number;
+  Timestamp?: string;
 };
 
 export type WorkflowListItem = {
@@ -66,13 +73,16 @@ export type WorkflowListItem = {
   Dir: string;
   Status?: WorkflowStatus;
   Suspended: boolean;
+  Suspension?: Suspension;
   ErrorT: string;
   DAG: Workflow;
+  RemoteNode?: string;
 };
 
 export type Workflow = {
   Name: string;
   Group: string;
+  Namespace?: string;
   Tags: string[];
   Description: string;
   Params: string[];
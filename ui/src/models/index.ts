@@ -22,10 +22,18 @@ export type Status = {
   OnSuccess?: Node;
   OnFailure?: Node;
   OnCancel?: Node;
+  OnTimeout?: Node;
   StartedAt: string;
   FinishedAt: string;
+  Heartbeat?: string;
   Log: string;
   Params: string;
+  Notes?: Note[];
+};
+
+export type Note = {
+  Text: string;
+  CreatedAt: string;
 };
 
 export function Handlers(s: Status) {
@@ -39,6 +47,9 @@ export function Handlers(s: Status) {
   if (s.OnCancel) {
     r.push(s.OnCancel);
   }
+  if (s.OnTimeout) {
+    r.push(s.OnTimeout);
+  }
   if (s.OnExit) {
     r.push(s.OnExit);
   }
@@ -55,6 +66,7 @@ export type DAG = {
   Name: string;
   Schedule: Schedule[];
   Group: string;
+  Namespace?: string;
   Tags: string[];
   Description: string;
   Env: string[];
@@ -72,6 +84,10 @@ export type DAG = {
 
 export type Schedule = {
   Expression: string;
+  Cron?: string;
+  Timezone?: string;
+  NextRun?: string;
+  PrevRun?: string;
 };
 
 export type HandlerOn = {
@@ -87,9 +103,16 @@ export type DAGStatus = {
   DAG: DAG;
   Status?: Status;
   Suspended: boolean;
+  Suspension?: Suspension;
   ErrorT: string;
 };
 
+export type Suspension = {
+  Reason?: string;
+  SuspendedAt?: string;
+  ExpiresAt?: string;
+};
+
 export enum DAGDataType {
   DAG = 0,
   Group,
@@ -181,6 +204,7 @@ export enum NodeStatus {
   Cancel,
   Success,
   Skipped,
+  Cached,
 }
 
 export type Node = {
@@ -193,6 +217,7 @@ export type Node = {
   DoneCount: number;
   Error: string;
   StatusText: string;
+  SubRequestId?: string;
 };
 
 export type StatusFile = {
@@ -29,11 +29,11 @@ function DAGDetails() {
   const { pathname } = useLocation();
 
   const baseUrl = useMemo(
-    () => `/dags/${encodeURI(params.name!)}`,
+    () => `/dags/${encodeURIComponent(params.name!)}`,
     [params.name]
   );
   const { data, isValidating, mutate } = useSWR<GetDAGResponse>(
-    `/dags/${params.name}?tab=${params.tab ?? ''}&${new URLSearchParams(
+    `/dags/${encodeURIComponent(params.name)}?tab=${params.tab ?? ''}&${new URLSearchParams(
       window.location.search
     ).toString()}&remoteNode=${appBarContext.selectedRemoteNode || 'local'}`,
     null,